@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/spf13/cobra"
+)
+
+func newBackupCommand(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup FILE",
+		Short: "Back up the database of the server",
+		Long: "Back up the database of the server while it runs.\n" +
+			"The backup is a SQLite database file, written once it has been received completely.\n" +
+			"The server requires its ADMIN_TOKEN, given with --token.",
+		Args: exactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output := args[0]
+
+			// Receive the backup next to the output, so that a failed backup leaves no partial file
+			f, err := os.CreateTemp(filepath.Dir(output), "."+filepath.Base(output)+".*")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(f.Name())
+			defer f.Close()
+
+			client := opts.databaseClient()
+			stream, err := client.BackupDatabase(cmd.Context(), connect.NewRequest(&v1.BackupDatabaseRequest{}))
+			if err != nil {
+				return err
+			}
+			defer stream.Close()
+
+			for stream.Receive() {
+				if _, err := f.Write(stream.Msg().Chunk); err != nil {
+					return fmt.Errorf("failed to write backup: %w", err)
+				}
+			}
+			if err := stream.Err(); err != nil {
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to write backup: %w", err)
+			}
+			return os.Rename(f.Name(), output)
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"golang.org/x/net/http2"
+)
+
+// Protocols the client can call the server with.
+const (
+	protocolConnect = "connect"
+	protocolGRPC    = "grpc"
+	protocolGRPCWeb = "grpc-web"
+)
+
+var protocols = []string{protocolConnect, protocolGRPC, protocolGRPCWeb}
+
+// actorHeader is the header the server records as the actor of the changes.
+const actorHeader = "X-Actor"
+
+func validateProtocol(protocol string) error {
+	for _, p := range protocols {
+		if protocol == p {
+			return nil
+		}
+	}
+	return usageError{fmt.Errorf("unknown protocol %q, expected one of %s", protocol, strings.Join(protocols, ", "))}
+}
+
+// httpClient returns the client used to call the server.
+// gRPC needs HTTP/2, which is spoken in clear text (h2c) to http:// servers.
+func (o *options) httpClient() *http.Client {
+	var transport http.RoundTripper = http.DefaultTransport
+	if o.protocol == protocolGRPC && strings.HasPrefix(o.serverURL, "http://") {
+		transport = &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, addr)
+			},
+		}
+	}
+	return &http.Client{Transport: &headerTransport{base: transport, token: o.token, actor: o.actor}}
+}
+
+// clientOptions returns the options selecting the protocol.
+func (o *options) clientOptions() []connect.ClientOption {
+	switch o.protocol {
+	case protocolGRPC:
+		return []connect.ClientOption{connect.WithGRPC()}
+	case protocolGRPCWeb:
+		return []connect.ClientOption{connect.WithGRPCWeb()}
+	default:
+		return nil
+	}
+}
+
+func (o *options) todoClient() v1connect.TodoServiceClient {
+	return v1connect.NewTodoServiceClient(o.httpClient(), o.serverURL, o.clientOptions()...)
+}
+
+func (o *options) transferClient() v1connect.TransferServiceClient {
+	return v1connect.NewTransferServiceClient(o.httpClient(), o.serverURL, o.clientOptions()...)
+}
+
+func (o *options) databaseClient() v1connect.DatabaseServiceClient {
+	return v1connect.NewDatabaseServiceClient(o.httpClient(), o.serverURL, o.clientOptions()...)
+}
+
+// headerTransport adds the credentials and the actor to every request.
+type headerTransport struct {
+	base  http.RoundTripper
+	token string
+	actor string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.token == "" && t.actor == "" {
+		return t.base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	if t.actor != "" {
+		req.Header.Set(actorHeader, t.actor)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by the client.
+const (
+	envConfig   = "ONIONGO_CONFIG"
+	envServer   = "ONIONGO_SERVER"
+	envProtocol = "ONIONGO_PROTOCOL"
+	envToken    = "ONIONGO_TOKEN"
+	envActor    = "ONIONGO_ACTOR"
+)
+
+// config is the content of the config file.
+type config struct {
+	Server   string `yaml:"server"`
+	Protocol string `yaml:"protocol"`
+	Token    string `yaml:"token"`
+	Actor    string `yaml:"actor"`
+}
+
+// defaultConfigPath returns the path of the config file in the user config directory.
+func defaultConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "oniongo", "config.yaml"), nil
+}
+
+// loadConfig reads the config file at path, or at the default path when path is empty.
+// A missing file at the default path is an empty config.
+func loadConfig(path string) (*config, error) {
+	explicit := path != ""
+	if !explicit {
+		var err error
+		if path, err = defaultConfigPath(); err != nil {
+			return &config{}, nil
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicit {
+		return &config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	cfg := &config{}
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// envOr returns the value of the environment variable, or def when it is empty.
+func envOr(name, def string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return def
+}
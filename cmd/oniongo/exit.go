@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes of the client. Errors returned by the server exit with
+// exitServerError plus their Connect code, e.g. 15 for not_found.
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitServerError = 10
+)
+
+// usageError is an error in the arguments or the flags of a command.
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string { return e.err.Error() }
+
+func (e usageError) Unwrap() error { return e.err }
+
+// exitCode returns the exit code for the error returned by a command.
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return exitServerError + int(connectErr.Code())
+	}
+	var usageErr usageError
+	// cobra reports unknown subcommands with a plain error
+	if errors.As(err, &usageErr) || strings.HasPrefix(err.Error(), "unknown command ") {
+		return exitUsage
+	}
+	return exitError
+}
+
+// usageArgs makes the errors of an argument validator usage errors.
+func usageArgs(validate cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := validate(cmd, args); err != nil {
+			return usageError{err}
+		}
+		return nil
+	}
+}
+
+var noArgs = usageArgs(cobra.NoArgs)
+
+func exactArgs(n int) cobra.PositionalArgs {
+	return usageArgs(cobra.ExactArgs(n))
+}
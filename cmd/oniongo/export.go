@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"connectrpc.com/connect"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/spf13/cobra"
+)
+
+func newExportCommand(opts *options) *cobra.Command {
+	var (
+		format         string
+		output         string
+		tagIDs         []string
+		matchAllTags   bool
+		projectID      string
+		actionableOnly bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export todos",
+		Args:  noArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			todoFormat, err := resolveFormat(format, output)
+			if err != nil {
+				return err
+			}
+
+			req := &v1.ExportTodosRequest{
+				Format:         todoFormat,
+				TagIds:         tagIDs,
+				TagMatch:       v1.TagMatchMode_TAG_MATCH_MODE_ANY,
+				ActionableOnly: actionableOnly,
+			}
+			if matchAllTags {
+				req.TagMatch = v1.TagMatchMode_TAG_MATCH_MODE_ALL
+			}
+			if projectID != "" {
+				req.ProjectId = &projectID
+			}
+
+			var w io.Writer = cmd.OutOrStdout()
+			if output != "" && output != "-" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+
+			client := opts.transferClient()
+			stream, err := client.ExportTodos(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			defer stream.Close()
+
+			for stream.Receive() {
+				if _, err := w.Write(stream.Msg().Chunk); err != nil {
+					return fmt.Errorf("failed to write todos: %w", err)
+				}
+			}
+			return stream.Err()
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "", formatUsage)
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write the todos to (default: stdout)")
+	_ = cmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(formatNames, cobra.ShellCompDirectiveNoFileComp))
+	cmd.Flags().StringSliceVar(&tagIDs, "tag", nil, "export only todos with the tag (repeatable)")
+	cmd.Flags().BoolVar(&matchAllTags, "all-tags", false, "export only todos with all of the tags")
+	cmd.Flags().StringVar(&projectID, "project", "", "export only todos of the project")
+	cmd.Flags().BoolVar(&actionableOnly, "actionable", false, "export only todos that are not blocked")
+	return cmd
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+)
+
+// formatNames are the names accepted by --format, in the order they are completed.
+var formatNames = []string{"jsonl", "csv", "markdown", "todotxt"}
+
+// formats maps the names accepted by --format to the protobuf formats.
+var formats = map[string]v1.TodoFormat{
+	"jsonl":    v1.TodoFormat_TODO_FORMAT_JSONL,
+	"csv":      v1.TodoFormat_TODO_FORMAT_CSV,
+	"markdown": v1.TodoFormat_TODO_FORMAT_MARKDOWN,
+	"todotxt":  v1.TodoFormat_TODO_FORMAT_TODOTXT,
+}
+
+// extensions maps file extensions to the names of their formats.
+var extensions = map[string]string{
+	".jsonl":  "jsonl",
+	".ndjson": "jsonl",
+	".csv":    "csv",
+	".md":     "markdown",
+	".txt":    "todotxt",
+}
+
+const formatUsage = "format of the todos: jsonl, csv, markdown or todotxt (default: guessed from the file extension)"
+
+// resolveFormat returns the format named by name, or the format guessed from the extension of path.
+func resolveFormat(name string, path string) (v1.TodoFormat, error) {
+	if name == "" {
+		name = extensions[strings.ToLower(filepath.Ext(path))]
+		if name == "" {
+			return v1.TodoFormat_TODO_FORMAT_UNSPECIFIED, usageError{fmt.Errorf("--format is required")}
+		}
+	}
+	format, ok := formats[strings.ToLower(name)]
+	if !ok {
+		return v1.TodoFormat_TODO_FORMAT_UNSPECIFIED, usageError{fmt.Errorf("unknown format %q", name)}
+	}
+	return format, nil
+}
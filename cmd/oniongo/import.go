@@ -0,0 +1,173 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/spf13/cobra"
+)
+
+// importChunkSize is the size of the content carried by each request message.
+const importChunkSize = 64 * 1024
+
+// sourceNames are the names accepted by --from, in the order they are completed.
+var sourceNames = []string{"todoist", "trello", "github"}
+
+// sources maps the names accepted by --from to the protobuf sources.
+var sources = map[string]v1.ImportSource{
+	"todoist": v1.ImportSource_IMPORT_SOURCE_TODOIST,
+	"trello":  v1.ImportSource_IMPORT_SOURCE_TRELLO,
+	"github":  v1.ImportSource_IMPORT_SOURCE_GITHUB,
+}
+
+func newImportCommand(opts *options) *cobra.Command {
+	var (
+		format string
+		from   string
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import todos from a file, or from stdin with -",
+		Long: `Import todos from a file, or from stdin with -.
+
+With --from, the file is the JSON export of another tool:
+  todoist  the response of the Todoist Sync API for all resources
+  trello   a Trello board exported as JSON
+  github   a JSON array of GitHub issues, e.g. from "gh issue list --json ..."
+Items are remembered by their identifiers in the tool, so the same file can be imported again.`,
+		Args: exactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var r io.Reader = cmd.InOrStdin()
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+
+			client := opts.transferClient()
+			if from != "" {
+				source, ok := sources[strings.ToLower(from)]
+				if !ok {
+					return usageError{fmt.Errorf("unknown tool %q", from)}
+				}
+				stream := client.ImportFromSource(cmd.Context())
+				// Send the metadata, then the content in chunks
+				err := stream.Send(&v1.ImportFromSourceRequest{
+					Payload: &v1.ImportFromSourceRequest_Metadata{
+						Metadata: &v1.ImportFromSourceMetadata{Source: source, DryRun: dryRun},
+					},
+				})
+				if err == nil {
+					err = sendChunks(r, func(chunk []byte) error {
+						return stream.Send(&v1.ImportFromSourceRequest{
+							Payload: &v1.ImportFromSourceRequest_Chunk{Chunk: chunk},
+						})
+					})
+				}
+				if err != nil && !errors.Is(err, io.EOF) {
+					return err
+				}
+				// A failed send is reported by CloseAndReceive
+				res, err := stream.CloseAndReceive()
+				if err != nil {
+					return err
+				}
+				printSourceImportReport(cmd.OutOrStdout(), res.Msg)
+				return nil
+			}
+
+			todoFormat, err := resolveFormat(format, args[0])
+			if err != nil {
+				return err
+			}
+			stream := client.ImportTodos(cmd.Context())
+			// Send the metadata, then the content in chunks
+			err = stream.Send(&v1.ImportTodosRequest{
+				Payload: &v1.ImportTodosRequest_Metadata{
+					Metadata: &v1.ImportTodosMetadata{Format: todoFormat, DryRun: dryRun},
+				},
+			})
+			if err == nil {
+				err = sendChunks(r, func(chunk []byte) error {
+					return stream.Send(&v1.ImportTodosRequest{
+						Payload: &v1.ImportTodosRequest_Chunk{Chunk: chunk},
+					})
+				})
+			}
+			if err != nil && !errors.Is(err, io.EOF) {
+				return err
+			}
+			// A failed send is reported by CloseAndReceive
+			res, err := stream.CloseAndReceive()
+			if err != nil {
+				return err
+			}
+			printImportReport(cmd.OutOrStdout(), res.Msg)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "", formatUsage)
+	cmd.Flags().StringVar(&from, "from", "", "tool the file was exported from: todoist, trello or github")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report what would be imported without importing it")
+	cmd.MarkFlagsMutuallyExclusive("format", "from")
+	_ = cmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(formatNames, cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.RegisterFlagCompletionFunc("from", cobra.FixedCompletions(sourceNames, cobra.ShellCompDirectiveNoFileComp))
+	return cmd
+}
+
+// sendChunks sends the content read from r in chunks.
+// It returns io.EOF when the stream was closed by the server.
+func sendChunks(r io.Reader, send func(chunk []byte) error) error {
+	buf := make([]byte, importChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if sendErr := send(buf[:n]); sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read todos: %w", err)
+		}
+	}
+}
+
+func printImportReport(w io.Writer, report *v1.ImportTodosResponse) {
+	if report.DryRun {
+		fmt.Fprintf(w, "%d todos would be imported (dry run)\n", report.Imported)
+	} else {
+		fmt.Fprintf(w, "%d todos imported\n", report.Imported)
+	}
+	for _, d := range report.Duplicates {
+		fmt.Fprintf(w, "line %d: skipped, todo %s already exists\n", d.Line, d.Id)
+	}
+	for _, e := range report.Errors {
+		fmt.Fprintf(w, "line %d: %s\n", e.Line, e.Message)
+	}
+}
+
+func printSourceImportReport(w io.Writer, report *v1.ImportFromSourceResponse) {
+	verb := "imported"
+	if report.DryRun {
+		verb = "would be imported (dry run)"
+	}
+	fmt.Fprintf(w, "%d projects, %d tags and %d todos %s, %d items already imported\n",
+		report.Projects, report.Tags, report.Todos, verb, report.Skipped)
+	for _, e := range report.Errors {
+		kind := strings.ToLower(strings.TrimPrefix(e.Kind.String(), "IMPORT_ITEM_KIND_"))
+		fmt.Fprintf(w, "%s %s: %s\n", kind, e.ExternalId, e.Message)
+	}
+}
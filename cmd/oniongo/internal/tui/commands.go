@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	tea "github.com/charmbracelet/bubbletea"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+)
+
+// requestTimeout bounds each call to the server.
+const requestTimeout = 10 * time.Second
+
+// todosLoadedMsg carries the todo items listed by the server.
+type todosLoadedMsg struct {
+	todos []*v1.Todo
+	err   error
+}
+
+// refreshMsg asks for the todo items to be listed again.
+type refreshMsg struct{}
+
+// actionDoneMsg reports the result of a change made to a todo item.
+type actionDoneMsg struct {
+	status string
+	err    error
+}
+
+// load lists the todo items.
+func (m *Model) load() tea.Cmd {
+	client, ctx := m.client, m.ctx
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+		res, err := client.GetTodos(ctx, connect.NewRequest(&v1.GetTodosRequest{}))
+		if err != nil {
+			return todosLoadedMsg{err: err}
+		}
+		return todosLoadedMsg{todos: res.Msg.Todos}
+	}
+}
+
+// scheduleRefresh lists the todo items again after the refresh interval.
+// Nothing is scheduled when the interval is not positive.
+func (m *Model) scheduleRefresh() tea.Cmd {
+	if m.refreshInterval <= 0 {
+		return nil
+	}
+	return tea.Tick(m.refreshInterval, func(time.Time) tea.Msg {
+		return refreshMsg{}
+	})
+}
+
+// act calls the server to change a todo item and reports status when the call succeeds.
+func (m *Model) act(status string, call func(ctx context.Context) error) tea.Cmd {
+	ctx := m.ctx
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+		if err := call(ctx); err != nil {
+			return actionDoneMsg{err: err}
+		}
+		return actionDoneMsg{status: status}
+	}
+}
+
+func (m *Model) startTodo(todo *v1.Todo) tea.Cmd {
+	return m.act("Started "+todo.Title, func(ctx context.Context) error {
+		_, err := m.client.StartTodo(ctx, connect.NewRequest(&v1.StartTodoRequest{Id: todo.Id}))
+		return err
+	})
+}
+
+func (m *Model) completeTodo(todo *v1.Todo) tea.Cmd {
+	return m.act("Completed "+todo.Title, func(ctx context.Context) error {
+		_, err := m.client.CompleteTodo(ctx, connect.NewRequest(&v1.CompleteTodoRequest{Id: todo.Id}))
+		return err
+	})
+}
+
+func (m *Model) deleteTodo(todo *v1.Todo) tea.Cmd {
+	return m.act("Deleted "+todo.Title, func(ctx context.Context) error {
+		_, err := m.client.DeleteTodo(ctx, connect.NewRequest(&v1.DeleteTodoRequest{Id: todo.Id}))
+		return err
+	})
+}
+
+func (m *Model) updateTodo(id, title, body string) tea.Cmd {
+	return m.act("Saved "+title, func(ctx context.Context) error {
+		_, err := m.client.UpdateTodo(ctx, connect.NewRequest(&v1.UpdateTodoRequest{Id: id, Title: title, Body: &body}))
+		return err
+	})
+}
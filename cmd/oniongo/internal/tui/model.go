@@ -0,0 +1,357 @@
+// Package tui is the full-screen terminal UI of the oniongo command line client.
+package tui
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+)
+
+// mode is what the keys are currently typed into.
+type mode int
+
+const (
+	modeList mode = iota
+	modeFilter
+	modeEditTitle
+	modeEditBody
+	modeConfirmDelete
+)
+
+// statusGroups are the groups of the list, in the order they are shown.
+var statusGroups = []struct {
+	status v1.TodoStatus
+	title  string
+}{
+	{v1.TodoStatus_TODO_STATUS_IN_PROGRESS, "In progress"},
+	{v1.TodoStatus_TODO_STATUS_NOT_STARTED, "Not started"},
+	{v1.TodoStatus_TODO_STATUS_ON_HOLD, "On hold"},
+	{v1.TodoStatus_TODO_STATUS_COMPLETED, "Completed"},
+	{v1.TodoStatus_TODO_STATUS_CANCELLED, "Cancelled"},
+}
+
+// Options configure the terminal UI.
+type Options struct {
+	// RefreshInterval is how often the todo items are listed again.
+	// They are listed only on demand when it is not positive.
+	RefreshInterval time.Duration
+}
+
+// Model is the state of the terminal UI. It lists the todo items of the server
+// grouped by status, and changes them through the client.
+type Model struct {
+	ctx             context.Context
+	client          v1connect.TodoServiceClient
+	refreshInterval time.Duration
+
+	todos      []*v1.Todo
+	loaded     bool
+	selectedID string
+	cursor     int
+	detail     bool
+
+	mode      mode
+	filter    textinput.Model
+	title     textinput.Model
+	body      textarea.Model
+	editingID string
+
+	status string
+	err    error
+
+	width  int
+	height int
+}
+
+// New returns the model of the terminal UI listing the todo items through client.
+func New(ctx context.Context, client v1connect.TodoServiceClient, opts Options) *Model {
+	filter := textinput.New()
+	filter.Prompt = "/"
+	filter.Placeholder = "filter"
+	_ = filter.Cursor.SetMode(cursor.CursorStatic)
+
+	title := textinput.New()
+	title.Prompt = "Title: "
+	title.CharLimit = 200
+	_ = title.Cursor.SetMode(cursor.CursorStatic)
+
+	body := textarea.New()
+	body.Placeholder = "Body"
+	body.ShowLineNumbers = false
+	_ = body.Cursor.SetMode(cursor.CursorStatic)
+
+	return &Model{
+		ctx:             ctx,
+		client:          client,
+		refreshInterval: opts.RefreshInterval,
+		filter:          filter,
+		title:           title,
+		body:            body,
+	}
+}
+
+// Init lists the todo items and schedules the first refresh.
+func (m *Model) Init() tea.Cmd {
+	return tea.Batch(m.load(), m.scheduleRefresh())
+}
+
+// Update handles the keys and the results of the calls to the server.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.body.SetWidth(max(msg.Width/2-4, 20))
+		m.body.SetHeight(max(msg.Height-8, 3))
+		return m, nil
+
+	case todosLoadedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.todos = msg.todos
+		m.loaded = true
+		m.restoreCursor()
+		return m, nil
+
+	case refreshMsg:
+		return m, tea.Batch(m.load(), m.scheduleRefresh())
+
+	case actionDoneMsg:
+		m.status, m.err = msg.status, msg.err
+		return m, m.load()
+
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+		switch m.mode {
+		case modeFilter:
+			return m.updateFilter(msg)
+		case modeEditTitle:
+			return m.updateEditTitle(msg)
+		case modeEditBody:
+			return m.updateEditBody(msg)
+		case modeConfirmDelete:
+			return m.updateConfirmDelete(msg)
+		default:
+			return m.updateList(msg)
+		}
+	}
+	return m, nil
+}
+
+func (m *Model) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	selected := m.selected()
+	m.status, m.err = "", nil
+
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "up", "k":
+		m.moveCursor(m.cursor - 1)
+	case "down", "j":
+		m.moveCursor(m.cursor + 1)
+	case "home", "g":
+		m.moveCursor(0)
+	case "end", "G":
+		m.moveCursor(len(m.visible()) - 1)
+	case "enter", "tab":
+		m.detail = !m.detail
+	case "esc":
+		if m.detail {
+			m.detail = false
+		} else {
+			m.filter.SetValue("")
+			m.restoreCursor()
+		}
+	case "/":
+		m.mode = modeFilter
+		return m, m.filter.Focus()
+	case "r":
+		return m, m.load()
+	case "s":
+		if selected != nil {
+			return m, m.startTodo(selected)
+		}
+	case "c":
+		if selected != nil {
+			return m, m.completeTodo(selected)
+		}
+	case "d", "delete":
+		if selected != nil {
+			m.mode = modeConfirmDelete
+			m.editingID = selected.Id
+		}
+	case "e":
+		if selected != nil {
+			m.mode = modeEditTitle
+			m.editingID = selected.Id
+			m.title.SetValue(selected.Title)
+			m.title.CursorEnd()
+			return m, m.title.Focus()
+		}
+	case "b":
+		if selected != nil {
+			m.mode = modeEditBody
+			m.editingID = selected.Id
+			m.detail = true
+			m.body.SetValue(selected.Body)
+			return m, m.body.Focus()
+		}
+	}
+	return m, nil
+}
+
+func (m *Model) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.mode = modeList
+		m.filter.Blur()
+		return m, nil
+	case tea.KeyEsc:
+		m.mode = modeList
+		m.filter.Blur()
+		m.filter.SetValue("")
+		m.restoreCursor()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(msg)
+	m.restoreCursor()
+	return m, cmd
+}
+
+func (m *Model) updateEditTitle(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.mode = modeList
+		m.title.Blur()
+		todo := m.find(m.editingID)
+		title := strings.TrimSpace(m.title.Value())
+		if todo == nil || title == "" || title == todo.Title {
+			return m, nil
+		}
+		return m, m.updateTodo(todo.Id, title, todo.Body)
+	case tea.KeyEsc:
+		m.mode = modeList
+		m.title.Blur()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.title, cmd = m.title.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) updateEditBody(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlS:
+		m.mode = modeList
+		m.body.Blur()
+		todo := m.find(m.editingID)
+		if todo == nil || m.body.Value() == todo.Body {
+			return m, nil
+		}
+		return m, m.updateTodo(todo.Id, todo.Title, m.body.Value())
+	case tea.KeyEsc:
+		m.mode = modeList
+		m.body.Blur()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.body, cmd = m.body.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) updateConfirmDelete(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.mode = modeList
+	todo := m.find(m.editingID)
+	if todo == nil || msg.String() != "y" {
+		return m, nil
+	}
+	return m, m.deleteTodo(todo)
+}
+
+// todoGroup is a group of the list.
+type todoGroup struct {
+	title string
+	todos []*v1.Todo
+}
+
+// groups returns the todo items that match the filter, grouped by status.
+// Empty groups are left out.
+func (m *Model) groups() []todoGroup {
+	filter := strings.ToLower(strings.TrimSpace(m.filter.Value()))
+	byStatus := make(map[v1.TodoStatus][]*v1.Todo)
+	for _, todo := range m.todos {
+		if filter != "" &&
+			!strings.Contains(strings.ToLower(todo.Title), filter) &&
+			!strings.Contains(strings.ToLower(todo.Body), filter) &&
+			!strings.Contains(strings.ToLower(todo.StatusId), filter) {
+			continue
+		}
+		byStatus[todo.Status] = append(byStatus[todo.Status], todo)
+	}
+
+	groups := make([]todoGroup, 0, len(statusGroups))
+	for _, g := range statusGroups {
+		if todos := byStatus[g.status]; len(todos) > 0 {
+			groups = append(groups, todoGroup{title: g.title, todos: todos})
+		}
+	}
+	return groups
+}
+
+// visible returns the todo items in the order they are listed.
+func (m *Model) visible() []*v1.Todo {
+	var todos []*v1.Todo
+	for _, group := range m.groups() {
+		todos = append(todos, group.todos...)
+	}
+	return todos
+}
+
+// selected returns the todo item under the cursor, or nil when the list is empty.
+func (m *Model) selected() *v1.Todo {
+	todos := m.visible()
+	if m.cursor < 0 || m.cursor >= len(todos) {
+		return nil
+	}
+	return todos[m.cursor]
+}
+
+func (m *Model) find(id string) *v1.Todo {
+	for _, todo := range m.todos {
+		if todo.Id == id {
+			return todo
+		}
+	}
+	return nil
+}
+
+func (m *Model) moveCursor(cursor int) {
+	todos := m.visible()
+	m.cursor = min(max(cursor, 0), max(len(todos)-1, 0))
+	if m.cursor < len(todos) {
+		m.selectedID = todos[m.cursor].Id
+	}
+}
+
+// restoreCursor keeps the cursor on the selected todo item after the list changed,
+// or keeps its position when the todo item is no longer listed.
+func (m *Model) restoreCursor() {
+	for i, todo := range m.visible() {
+		if todo.Id == m.selectedID {
+			m.cursor = i
+			return
+		}
+	}
+	m.moveCursor(m.cursor)
+}
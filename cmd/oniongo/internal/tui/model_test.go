@@ -0,0 +1,291 @@
+package tui
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	tea "github.com/charmbracelet/bubbletea"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTodoService keeps the todo items in memory behind the Connect handler of the todo service.
+type fakeTodoService struct {
+	v1connect.UnimplementedTodoServiceHandler
+
+	mu    sync.Mutex
+	todos []*v1.Todo
+}
+
+func (s *fakeTodoService) find(id string) (*v1.Todo, error) {
+	for _, todo := range s.todos {
+		if todo.Id == id {
+			return todo, nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, nil)
+}
+
+func (s *fakeTodoService) setStatus(id string, status v1.TodoStatus, statusID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	todo, err := s.find(id)
+	if err != nil {
+		return err
+	}
+	todo.Status, todo.StatusId = status, statusID
+	return nil
+}
+
+func (s *fakeTodoService) GetTodos(context.Context, *connect.Request[v1.GetTodosRequest]) (*connect.Response[v1.GetTodosResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return connect.NewResponse(&v1.GetTodosResponse{Todos: s.todos}), nil
+}
+
+func (s *fakeTodoService) StartTodo(_ context.Context, req *connect.Request[v1.StartTodoRequest]) (*connect.Response[v1.StartTodoResponse], error) {
+	if err := s.setStatus(req.Msg.Id, v1.TodoStatus_TODO_STATUS_IN_PROGRESS, "IN_PROGRESS"); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.StartTodoResponse{}), nil
+}
+
+func (s *fakeTodoService) CompleteTodo(_ context.Context, req *connect.Request[v1.CompleteTodoRequest]) (*connect.Response[v1.CompleteTodoResponse], error) {
+	if err := s.setStatus(req.Msg.Id, v1.TodoStatus_TODO_STATUS_COMPLETED, "COMPLETED"); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.CompleteTodoResponse{}), nil
+}
+
+func (s *fakeTodoService) UpdateTodo(_ context.Context, req *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	todo, err := s.find(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	todo.Title, todo.Body = req.Msg.Title, req.Msg.GetBody()
+	return connect.NewResponse(&v1.UpdateTodoResponse{}), nil
+}
+
+func (s *fakeTodoService) DeleteTodo(_ context.Context, req *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, todo := range s.todos {
+		if todo.Id == req.Msg.Id {
+			s.todos = append(s.todos[:i], s.todos[i+1:]...)
+			return connect.NewResponse(&v1.DeleteTodoResponse{}), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, nil)
+}
+
+// newTestModel starts the fake service in process and returns a model listing its todo items.
+func newTestModel(t *testing.T, todos ...*v1.Todo) (*Model, *fakeTodoService) {
+	service := &fakeTodoService{todos: todos}
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewTodoServiceHandler(service))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := v1connect.NewTodoServiceClient(server.Client(), server.URL)
+	m := New(context.Background(), client, Options{})
+	run(m, m.Init())
+	run(m, func() tea.Msg { return tea.WindowSizeMsg{Width: 120, Height: 40} })
+	return m, service
+}
+
+// run runs cmd and sends the messages it produces to the model, then runs the commands
+// returned by the model in turn, as the program loop does.
+func run(m *Model, cmd tea.Cmd) {
+	queue := []tea.Cmd{cmd}
+	for len(queue) > 0 {
+		cmd, queue = queue[0], queue[1:]
+		if cmd == nil {
+			continue
+		}
+		switch msg := cmd().(type) {
+		case nil:
+		case tea.BatchMsg:
+			queue = append(queue, msg...)
+		default:
+			_, next := m.Update(msg)
+			queue = append(queue, next)
+		}
+	}
+}
+
+// press sends the keys to the model one by one.
+func press(m *Model, keys ...string) {
+	for _, key := range keys {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		switch key {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "ctrl+s":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlS}
+		case "backspace":
+			msg = tea.KeyMsg{Type: tea.KeyBackspace}
+		}
+		run(m, func() tea.Msg { return msg })
+	}
+}
+
+func titles(todos []*v1.Todo) []string {
+	result := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		result = append(result, todo.Title)
+	}
+	return result
+}
+
+func testTodos() []*v1.Todo {
+	return []*v1.Todo{
+		{Id: "1", Title: "Buy milk", Body: "2 bottles", Status: v1.TodoStatus_TODO_STATUS_NOT_STARTED, StatusId: "NOT_STARTED"},
+		{Id: "2", Title: "Write report", Status: v1.TodoStatus_TODO_STATUS_IN_PROGRESS, StatusId: "IN_PROGRESS"},
+		{Id: "3", Title: "Call the bank", Status: v1.TodoStatus_TODO_STATUS_COMPLETED, StatusId: "COMPLETED"},
+		{Id: "4", Title: "Buy bread", Status: v1.TodoStatus_TODO_STATUS_NOT_STARTED, StatusId: "NOT_STARTED"},
+	}
+}
+
+func TestModel(t *testing.T) {
+	t.Run("lists the todo items grouped by status", func(t *testing.T) {
+		// Given
+		m, _ := newTestModel(t, testTodos()...)
+
+		// When
+		view := m.View()
+
+		// Then
+		require.Equal(t, []string{"Write report", "Buy milk", "Buy bread", "Call the bank"}, titles(m.visible()))
+		require.Contains(t, view, "In progress (1)")
+		require.Contains(t, view, "Not started (2)")
+		require.Contains(t, view, "Completed (1)")
+	})
+
+	t.Run("filters the list by the typed text", func(t *testing.T) {
+		// Given
+		m, _ := newTestModel(t, testTodos()...)
+
+		// When
+		press(m, "/", "b", "u", "y", "enter")
+
+		// Then
+		require.Equal(t, []string{"Buy milk", "Buy bread"}, titles(m.visible()))
+		require.NotContains(t, m.View(), "Write report")
+
+		// When the filter is cleared
+		press(m, "esc")
+
+		// Then
+		require.Len(t, m.visible(), 4)
+	})
+
+	t.Run("opens the detail pane with the body", func(t *testing.T) {
+		// Given
+		m, _ := newTestModel(t, testTodos()...)
+
+		// When
+		press(m, "j", "enter")
+
+		// Then
+		require.Equal(t, "Buy milk", m.selected().Title)
+		require.Contains(t, m.View(), "2 bottles")
+	})
+
+	t.Run("starts and completes the selected todo item", func(t *testing.T) {
+		// Given
+		m, service := newTestModel(t, testTodos()...)
+
+		// When
+		press(m, "j", "s")
+
+		// Then
+		require.Equal(t, v1.TodoStatus_TODO_STATUS_IN_PROGRESS, service.todos[0].Status)
+		require.Equal(t, "Started Buy milk", m.status)
+		// The cursor follows the todo item to its new group
+		require.Equal(t, "Buy milk", m.selected().Title)
+
+		// When
+		press(m, "c")
+
+		// Then
+		require.Equal(t, v1.TodoStatus_TODO_STATUS_COMPLETED, service.todos[0].Status)
+		require.Contains(t, m.View(), "Completed (2)")
+	})
+
+	t.Run("deletes the selected todo item after confirmation", func(t *testing.T) {
+		// Given
+		m, service := newTestModel(t, testTodos()...)
+
+		// When the deletion is not confirmed
+		press(m, "d", "n")
+
+		// Then
+		require.Len(t, service.todos, 4)
+
+		// When
+		press(m, "d")
+		require.Contains(t, m.View(), `Delete "Write report"? (y/n)`)
+		press(m, "y")
+
+		// Then
+		require.Equal(t, []string{"Buy milk", "Call the bank", "Buy bread"}, titles(service.todos))
+		require.Len(t, m.visible(), 3)
+	})
+
+	t.Run("edits the title and the body inline", func(t *testing.T) {
+		// Given
+		m, service := newTestModel(t, testTodos()...)
+
+		// When
+		press(m, "e", "backspace", "backspace", "backspace", "backspace", "backspace", "backspace", "p", "l", "a", "n", "enter")
+
+		// Then
+		require.Equal(t, "Write plan", service.todos[1].Title)
+
+		// When
+		press(m, "b", "D", "r", "a", "f", "t", "ctrl+s")
+
+		// Then
+		require.Equal(t, "Draft", service.todos[1].Body)
+		require.Equal(t, "Write plan", service.todos[1].Title)
+		require.Contains(t, m.View(), "Draft")
+	})
+
+	t.Run("shows the changes made elsewhere on refresh", func(t *testing.T) {
+		// Given
+		m, service := newTestModel(t, testTodos()...)
+		service.mu.Lock()
+		service.todos = append(service.todos, &v1.Todo{Id: "5", Title: "Added elsewhere", Status: v1.TodoStatus_TODO_STATUS_NOT_STARTED})
+		service.mu.Unlock()
+
+		// When
+		run(m, func() tea.Msg { return refreshMsg{} })
+
+		// Then
+		require.Contains(t, m.View(), "Added elsewhere")
+	})
+
+	t.Run("shows the errors of the server", func(t *testing.T) {
+		// Given
+		m, service := newTestModel(t, testTodos()...)
+		service.mu.Lock()
+		service.todos = service.todos[1:]
+		service.mu.Unlock()
+
+		// When the todo item deleted elsewhere is started
+		press(m, "j", "s")
+
+		// Then
+		require.Error(t, m.err)
+		require.Contains(t, m.View(), "Error: not_found")
+	})
+}
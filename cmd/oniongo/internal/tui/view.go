@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+)
+
+// timeLayout is the layout of the times in the detail pane.
+const timeLayout = "2006-01-02 15:04"
+
+var (
+	headerStyle   = lipgloss.NewStyle().Bold(true)
+	groupStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	doneStyle     = lipgloss.NewStyle().Faint(true).Strikethrough(true)
+	mutedStyle    = lipgloss.NewStyle().Faint(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	paneStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+)
+
+// helpText lists the keys of the list.
+const helpText = "↑/↓ move • enter details • / filter • s start • c complete • d delete • e edit title • b edit body • r refresh • q quit"
+
+// View renders the list, the detail pane when it is open, and the footer.
+func (m *Model) View() string {
+	header := headerStyle.Render("oniongo") + mutedStyle.Render(fmt.Sprintf("  %d todo items", len(m.todos)))
+	if filter := m.filter.Value(); filter != "" && m.mode != modeFilter {
+		header += mutedStyle.Render("  filter: " + filter)
+	}
+
+	footer := m.footer()
+	listHeight := 0
+	if m.height > 0 {
+		listHeight = max(m.height-lipgloss.Height(header)-lipgloss.Height(footer), 1)
+	}
+
+	body := m.listView(listHeight)
+	if m.detail {
+		listWidth := m.width / 2
+		if listWidth > 0 {
+			body = lipgloss.NewStyle().Width(listWidth).Render(body)
+		}
+		body = lipgloss.JoinHorizontal(lipgloss.Top, body, m.detailView())
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, footer)
+}
+
+// listView renders the groups of the list, scrolled to keep the cursor visible within height lines.
+// Nothing is cut when height is not positive.
+func (m *Model) listView(height int) string {
+	if !m.loaded {
+		return mutedStyle.Render("Loading...")
+	}
+	groups := m.groups()
+	if len(groups) == 0 {
+		return mutedStyle.Render("No todo items")
+	}
+
+	var lines []string
+	cursorLine, index := 0, 0
+	for _, group := range groups {
+		lines = append(lines, groupStyle.Render(fmt.Sprintf("%s (%d)", group.title, len(group.todos))))
+		for _, todo := range group.todos {
+			line := "  " + todo.Title
+			if isFinished(todo) {
+				line = "  " + doneStyle.Render(todo.Title)
+			}
+			if todo.StatusId != "" && todo.GetProjectId() != "" {
+				line += mutedStyle.Render(" [" + todo.StatusId + "]")
+			}
+			if index == m.cursor {
+				line = selectedStyle.Render("> " + todo.Title)
+				cursorLine = len(lines)
+			}
+			lines = append(lines, line)
+			index++
+		}
+	}
+
+	if height > 0 && len(lines) > height {
+		offset := min(max(cursorLine-height/2, 0), len(lines)-height)
+		lines = lines[offset : offset+height]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// detailView renders the fields and the body of the selected todo item,
+// or the editor of the body while it is edited.
+func (m *Model) detailView() string {
+	style := paneStyle
+	if m.width > 0 {
+		style = style.Width(max(m.width-m.width/2-2, 20))
+	}
+	if m.mode == modeEditBody {
+		return style.Render(headerStyle.Render("Editing body") + "\n" + m.body.View())
+	}
+
+	todo := m.selected()
+	if todo == nil {
+		return style.Render(mutedStyle.Render("Nothing selected"))
+	}
+
+	var b strings.Builder
+	b.WriteString(headerStyle.Render(todo.Title) + "\n")
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "%s %s\n", mutedStyle.Render(name+":"), value)
+		}
+	}
+	field("Status", todo.StatusId)
+	field("ID", todo.Id)
+	field("Project", todo.GetProjectId())
+	field("Parent", todo.GetParentId())
+	field("Tags", strings.Join(todo.TagIds, ", "))
+	field("Blocked by", strings.Join(todo.BlockerIds, ", "))
+	field("Created", formatTime(todo.CreatedAt))
+	field("Updated", formatTime(todo.UpdatedAt))
+	field("Completed", formatTime(todo.GetCompletedAt()))
+	if todo.Body != "" {
+		b.WriteString("\n" + todo.Body)
+	}
+	return style.Render(strings.TrimRight(b.String(), "\n"))
+}
+
+// footer renders the result of the last change and the input or the keys of the current mode.
+func (m *Model) footer() string {
+	var status string
+	switch {
+	case m.err != nil:
+		status = errorStyle.Render("Error: " + m.err.Error())
+	case m.status != "":
+		status = m.status
+	}
+
+	var input string
+	switch m.mode {
+	case modeFilter:
+		input = m.filter.View()
+	case modeEditTitle:
+		input = m.title.View() + mutedStyle.Render("  enter save • esc cancel")
+	case modeEditBody:
+		input = mutedStyle.Render("ctrl+s save • esc cancel")
+	case modeConfirmDelete:
+		title := ""
+		if todo := m.find(m.editingID); todo != nil {
+			title = todo.Title
+		}
+		input = fmt.Sprintf("Delete %q? (y/n)", title)
+	default:
+		input = mutedStyle.Render(helpText)
+	}
+	return status + "\n" + input
+}
+
+func isFinished(todo *v1.Todo) bool {
+	return todo.Status == v1.TodoStatus_TODO_STATUS_COMPLETED || todo.Status == v1.TodoStatus_TODO_STATUS_CANCELLED
+}
+
+func formatTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).Local().Format(timeLayout)
+}
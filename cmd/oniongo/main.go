@@ -0,0 +1,15 @@
+// Command oniongo is the command line client of the oniongo server.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	err := newRootCommand().Execute()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+	}
+	os.Exit(exitCode(err))
+}
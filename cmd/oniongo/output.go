@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// Formats the todo subcommands print their results in.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+var outputs = []string{outputTable, outputJSON, outputYAML}
+
+// timeLayout is the layout of the times in tables.
+const timeLayout = "2006-01-02 15:04"
+
+// outputFormat is the value of the --output flag. Unknown formats are rejected when the flags are parsed.
+type outputFormat string
+
+func (f *outputFormat) String() string { return string(*f) }
+
+func (f *outputFormat) Type() string { return "format" }
+
+func (f *outputFormat) Set(value string) error {
+	for _, o := range outputs {
+		if value == o {
+			*f = outputFormat(value)
+			return nil
+		}
+	}
+	return fmt.Errorf("expected one of %s", strings.Join(outputs, ", "))
+}
+
+// addOutputFlag adds the --output flag to a todo subcommand.
+func addOutputFlag(cmd *cobra.Command, format *outputFormat) {
+	*format = outputTable
+	cmd.Flags().VarP(format, "output", "o", "output format: table, json or yaml")
+	_ = cmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(outputs, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// printTodo prints a todo item, as a list of its fields in table format.
+func printTodo(w io.Writer, format outputFormat, todo *v1.Todo) error {
+	if format != outputTable {
+		return printMessage(w, format, todo)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(tw, "%s:\t%s\n", name, value)
+		}
+	}
+	field("ID", todo.Id)
+	field("Title", todo.Title)
+	field("Status", todo.StatusId)
+	field("Project", todo.GetProjectId())
+	field("Parent", todo.GetParentId())
+	field("Tags", strings.Join(todo.TagIds, ", "))
+	field("Blocked by", strings.Join(todo.BlockerIds, ", "))
+	field("Created", formatTime(todo.CreatedAt))
+	field("Updated", formatTime(todo.UpdatedAt))
+	field("Completed", formatTime(todo.GetCompletedAt()))
+	if todo.CommentCount > 0 {
+		field("Comments", fmt.Sprint(todo.CommentCount))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if todo.Body != "" {
+		_, err := fmt.Fprintf(w, "\n%s\n", todo.Body)
+		return err
+	}
+	return nil
+}
+
+// printTodos prints a list of todo items, one per row in table format.
+// The JSON and YAML formats print the response message as it is.
+func printTodos(w io.Writer, format outputFormat, res *v1.GetTodosResponse) error {
+	if format != outputTable {
+		return printMessage(w, format, res)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSTATUS\tTITLE\tUPDATED")
+	for _, todo := range res.Todos {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", todo.Id, todo.StatusId, todo.Title, formatTime(todo.UpdatedAt))
+	}
+	return tw.Flush()
+}
+
+// printMessage prints a message in the JSON mapping of protobuf, or in YAML with the same keys.
+func printMessage(w io.Writer, format outputFormat, msg proto.Message) error {
+	content, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if format == outputJSON {
+		_, err = fmt.Fprintf(w, "%s\n", content)
+		return err
+	}
+
+	// JSON is YAML in flow style. Decoding into a node keeps the order of the keys.
+	var node yaml.Node
+	if err := yaml.Unmarshal(content, &node); err != nil {
+		return err
+	}
+	plainStyle(&node)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// plainStyle clears the style of the node and its descendants, so that the mappings and
+// sequences are printed in block style and the strings are quoted only when they need to be.
+func plainStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		plainStyle(child)
+	}
+}
+
+func formatTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).Local().Format(timeLayout)
+}
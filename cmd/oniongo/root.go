@@ -0,0 +1,114 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Defaults of the settings that are not given by a flag, the environment or the config file.
+const (
+	defaultServerURL = "http://localhost:8080"
+	defaultProtocol  = protocolConnect
+)
+
+// options are the flags shared by all subcommands.
+type options struct {
+	configPath string
+	serverURL  string
+	protocol   string
+	token      string
+	actor      string
+
+	resolved bool
+}
+
+// resolve fills the settings that were not given as flags from the environment,
+// then from the config file, then from the defaults.
+func (o *options) resolve(cmd *cobra.Command) error {
+	if o.resolved {
+		return nil
+	}
+	path := o.configPath
+	if path == "" {
+		path = envOr(envConfig, "")
+	}
+	cfg, err := loadConfig(path)
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	settings := []struct {
+		flag, env string
+		value     *string
+		config    string
+		def       string
+	}{
+		{"server", envServer, &o.serverURL, cfg.Server, defaultServerURL},
+		{"protocol", envProtocol, &o.protocol, cfg.Protocol, defaultProtocol},
+		{"token", envToken, &o.token, cfg.Token, ""},
+		{"actor", envActor, &o.actor, cfg.Actor, ""},
+	}
+	for _, s := range settings {
+		if flags.Changed(s.flag) {
+			continue
+		}
+		*s.value = envOr(s.env, s.config)
+		if *s.value == "" {
+			*s.value = s.def
+		}
+	}
+	if err := validateProtocol(o.protocol); err != nil {
+		return err
+	}
+	o.resolved = true
+	return nil
+}
+
+func newRootCommand() *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   "oniongo",
+		Short: "Command line client of the oniongo server",
+		Long: `Command line client of the oniongo server.
+
+Settings are taken from the flags, then from the environment, then from the config file:
+  --server    ONIONGO_SERVER    server     URL of the server (default ` + defaultServerURL + `)
+  --protocol  ONIONGO_PROTOCOL  protocol   connect, grpc or grpc-web (default ` + defaultProtocol + `)
+  --token     ONIONGO_TOKEN     token      bearer token sent to the server
+  --actor     ONIONGO_ACTOR     actor      name recorded in the history of the changes
+The config file is YAML, read from oniongo/config.yaml in the user config directory
+(~/.config on Linux) unless --config or ONIONGO_CONFIG is set.`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return opts.resolve(cmd)
+		},
+	}
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return usageError{err}
+	})
+
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&opts.configPath, "config", "", "config file (env ONIONGO_CONFIG)")
+	flags.StringVar(&opts.serverURL, "server", "", "URL of the oniongo server (env ONIONGO_SERVER)")
+	flags.StringVar(&opts.protocol, "protocol", "", "protocol used to call the server: connect, grpc or grpc-web (env ONIONGO_PROTOCOL)")
+	flags.StringVar(&opts.token, "token", "", "bearer token sent to the server (env ONIONGO_TOKEN)")
+	flags.StringVar(&opts.actor, "actor", "", "name recorded in the history of the changes (env ONIONGO_ACTOR)")
+	_ = cmd.RegisterFlagCompletionFunc("protocol", cobra.FixedCompletions(protocols, cobra.ShellCompDirectiveNoFileComp))
+
+	cmd.AddCommand(
+		newAddCommand(opts),
+		newListCommand(opts),
+		newShowCommand(opts),
+		newEditCommand(opts),
+		newStartCommand(opts),
+		newDoneCommand(opts),
+		newRemoveCommand(opts),
+		newTUICommand(opts),
+		newExportCommand(opts),
+		newImportCommand(opts),
+		newBackupCommand(opts),
+	)
+	return cmd
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/spf13/cobra"
+)
+
+// completionTimeout bounds the call listing the todo items for shell completion.
+const completionTimeout = 2 * time.Second
+
+func newAddCommand(opts *options) *cobra.Command {
+	var (
+		body      string
+		parentID  string
+		projectID string
+		output    outputFormat
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add <title>",
+		Short: "Create a todo item",
+		Args:  exactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &v1.CreateTodoRequest{Title: args[0]}
+			if cmd.Flags().Changed("body") {
+				req.Body = &body
+			}
+			if parentID != "" {
+				req.ParentId = &parentID
+			}
+			if projectID != "" {
+				req.ProjectId = &projectID
+			}
+
+			res, err := opts.todoClient().CreateTodo(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return printTodo(cmd.OutOrStdout(), output, res.Msg.Todo)
+		},
+	}
+
+	cmd.Flags().StringVar(&body, "body", "", "body of the todo item")
+	cmd.Flags().StringVar(&parentID, "parent", "", "create the todo item as a subtask of the given todo item")
+	cmd.Flags().StringVar(&projectID, "project", "", "create the todo item in the project")
+	_ = cmd.RegisterFlagCompletionFunc("parent", completeTodoIDs(opts))
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newListCommand(opts *options) *cobra.Command {
+	var (
+		tagIDs         []string
+		matchAllTags   bool
+		parentID       string
+		projectID      string
+		actionableOnly bool
+		output         outputFormat
+	)
+
+	cmd := &cobra.Command{
+		Use:     "ls",
+		Aliases: []string{"list"},
+		Short:   "List todo items",
+		Args:    noArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &v1.GetTodosRequest{
+				TagIds:         tagIDs,
+				TagMatch:       v1.TagMatchMode_TAG_MATCH_MODE_ANY,
+				ActionableOnly: actionableOnly,
+			}
+			if matchAllTags {
+				req.TagMatch = v1.TagMatchMode_TAG_MATCH_MODE_ALL
+			}
+			if parentID != "" {
+				req.ParentId = &parentID
+			}
+			if projectID != "" {
+				req.ProjectId = &projectID
+			}
+
+			res, err := opts.todoClient().GetTodos(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return printTodos(cmd.OutOrStdout(), output, res.Msg)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&tagIDs, "tag", nil, "list only todo items with the tag (repeatable)")
+	cmd.Flags().BoolVar(&matchAllTags, "all-tags", false, "list only todo items with all of the tags")
+	cmd.Flags().StringVar(&parentID, "parent", "", "list only the direct subtasks of the todo item")
+	cmd.Flags().StringVar(&projectID, "project", "", "list only todo items of the project")
+	cmd.Flags().BoolVar(&actionableOnly, "actionable", false, "list only todo items that are not blocked")
+	_ = cmd.RegisterFlagCompletionFunc("parent", completeTodoIDs(opts))
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newShowCommand(opts *options) *cobra.Command {
+	var (
+		version int64
+		output  outputFormat
+	)
+
+	cmd := &cobra.Command{
+		Use:               "show <id>",
+		Short:             "Show a todo item",
+		Args:              exactArgs(1),
+		ValidArgsFunction: completeTodoIDs(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &v1.GetTodoRequest{Id: args[0]}
+			if version > 0 {
+				req.PointInTime = &v1.GetTodoRequest_Version{Version: version}
+			}
+
+			res, err := opts.todoClient().GetTodo(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return printTodo(cmd.OutOrStdout(), output, res.Msg.Todo)
+		},
+	}
+
+	cmd.Flags().Int64Var(&version, "version", 0, "show the todo item as it was at a version of its history")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newEditCommand(opts *options) *cobra.Command {
+	var (
+		title  string
+		body   string
+		output outputFormat
+	)
+
+	cmd := &cobra.Command{
+		Use:               "edit <id>",
+		Short:             "Change the title or the body of a todo item",
+		Args:              exactArgs(1),
+		ValidArgsFunction: completeTodoIDs(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := opts.todoClient()
+			req := &v1.UpdateTodoRequest{Id: args[0], Title: title}
+			if cmd.Flags().Changed("body") {
+				req.Body = &body
+			}
+			// The title is required, so keep the current one when it is not given
+			if !cmd.Flags().Changed("title") {
+				res, err := client.GetTodo(cmd.Context(), connect.NewRequest(&v1.GetTodoRequest{Id: args[0]}))
+				if err != nil {
+					return err
+				}
+				req.Title = res.Msg.Todo.Title
+			}
+
+			if _, err := client.UpdateTodo(cmd.Context(), connect.NewRequest(req)); err != nil {
+				return err
+			}
+			return showTodo(cmd, opts, args[0], output)
+		},
+	}
+
+	cmd.Flags().StringVar(&title, "title", "", "new title")
+	cmd.Flags().StringVar(&body, "body", "", "new body")
+	cmd.MarkFlagsOneRequired("title", "body")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newStartCommand(opts *options) *cobra.Command {
+	var output outputFormat
+
+	cmd := &cobra.Command{
+		Use:               "start <id>",
+		Short:             "Start working on a todo item",
+		Args:              exactArgs(1),
+		ValidArgsFunction: completeTodoIDs(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &v1.StartTodoRequest{Id: args[0]}
+			if _, err := opts.todoClient().StartTodo(cmd.Context(), connect.NewRequest(req)); err != nil {
+				return err
+			}
+			return showTodo(cmd, opts, args[0], output)
+		},
+	}
+
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newDoneCommand(opts *options) *cobra.Command {
+	var (
+		cascade bool
+		output  outputFormat
+	)
+
+	cmd := &cobra.Command{
+		Use:               "done <id>",
+		Short:             "Complete a todo item",
+		Args:              exactArgs(1),
+		ValidArgsFunction: completeTodoIDs(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &v1.CompleteTodoRequest{Id: args[0], Cascade: cascade}
+			if _, err := opts.todoClient().CompleteTodo(cmd.Context(), connect.NewRequest(req)); err != nil {
+				return err
+			}
+			return showTodo(cmd, opts, args[0], output)
+		},
+	}
+
+	cmd.Flags().BoolVar(&cascade, "cascade", false, "complete the open subtasks too")
+	addOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newRemoveCommand(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "rm <id>...",
+		Short:             "Delete todo items",
+		Args:              usageArgs(cobra.MinimumNArgs(1)),
+		ValidArgsFunction: completeTodoIDs(opts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := opts.todoClient()
+			for _, id := range args {
+				req := &v1.DeleteTodoRequest{Id: id}
+				if _, err := client.DeleteTodo(cmd.Context(), connect.NewRequest(req)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// showTodo prints the todo item after a change.
+func showTodo(cmd *cobra.Command, opts *options, id string, output outputFormat) error {
+	res, err := opts.todoClient().GetTodo(cmd.Context(), connect.NewRequest(&v1.GetTodoRequest{Id: id}))
+	if err != nil {
+		return err
+	}
+	return printTodo(cmd.OutOrStdout(), output, res.Msg.Todo)
+}
+
+// completeTodoIDs completes the IDs of the todo items, described by their titles.
+// Nothing is completed when the server cannot be reached.
+func completeTodoIDs(opts *options) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if err := opts.resolve(cmd); err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+		defer cancel()
+		res, err := opts.todoClient().GetTodos(ctx, connect.NewRequest(&v1.GetTodosRequest{}))
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		completions := make([]cobra.Completion, 0, len(res.Msg.Todos))
+		for _, todo := range res.Msg.Todos {
+			completions = append(completions, cobra.CompletionWithDesc(todo.Id, todo.Title))
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/iktakahiro/oniongo/cmd/oniongo/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// defaultRefreshInterval is how often the terminal UI lists the todo items again.
+const defaultRefreshInterval = 2 * time.Second
+
+func newTUICommand(opts *options) *cobra.Command {
+	var refresh time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "tui",
+		Short: "Manage todo items in a full-screen terminal UI",
+		Args:  noArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model := tui.New(cmd.Context(), opts.todoClient(), tui.Options{RefreshInterval: refresh})
+			_, err := tea.NewProgram(model,
+				tea.WithAltScreen(),
+				tea.WithContext(cmd.Context()),
+				tea.WithInput(cmd.InOrStdin()),
+				tea.WithOutput(cmd.OutOrStdout()),
+			).Run()
+			return err
+		},
+	}
+
+	cmd.Flags().DurationVar(&refresh, "refresh", defaultRefreshInterval, "how often the todo items are listed again, 0 to refresh only with r")
+	return cmd
+}
@@ -3,19 +3,30 @@ package main
 import (
 	"context"
 	"errors"
+	"expvar"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/grpcreflect"
+	"github.com/iktakahiro/oniongo/internal/api/graphql"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
 	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	v2connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2/oniongov2connect"
 	"github.com/iktakahiro/oniongo/internal/api/grpc/middleware"
+	"github.com/iktakahiro/oniongo/internal/api/rest"
+	"github.com/iktakahiro/oniongo/internal/application/attachmentapp"
+	"github.com/iktakahiro/oniongo/internal/application/webhookapp"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/cache"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/di"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
 	"github.com/rs/cors"
@@ -25,6 +36,17 @@ import (
 )
 
 func main() {
+	storage := flag.String("storage", "sqlite", "where the data is stored: sqlite or memory")
+	flag.Parse()
+
+	switch *storage {
+	case "sqlite":
+	case "memory":
+		db.UseInMemoryDatabase()
+	default:
+		log.Fatalf("unknown storage %q: must be sqlite or memory", *storage)
+	}
+
 	if err := db.Migrate(); err != nil {
 		log.Fatalf("failed to migrate: %v", err)
 	}
@@ -40,29 +62,151 @@ func main() {
 	}
 
 	// Set service names for reflection
-	reflector := grpcreflect.NewStaticReflector(
+	serviceNames := []string{
 		v1connect.TodoServiceName,
-	)
+		v1connect.TagServiceName,
+		v1connect.ProjectServiceName,
+		v1connect.CommentServiceName,
+		v1connect.AttachmentServiceName,
+		v1connect.HistoryServiceName,
+		v1connect.TransferServiceName,
+		v1connect.WebhookServiceName,
+		v2connect.TodoServiceName,
+	}
+
+	// The database backups are served only to the holders of the admin token
+	adminToken := os.Getenv("ADMIN_TOKEN")
+	if adminToken != "" {
+		serviceNames = append(serviceNames, v1connect.DatabaseServiceName)
+	}
+	reflector := grpcreflect.NewStaticReflector(serviceNames...)
 
 	todoServiceHandler, err := do.Invoke[v1connect.TodoServiceHandler](injector)
 	if err != nil {
 		log.Fatalf("failed to invoke todo service handler: %v", err)
 	}
 
+	todoServiceHandlerV2, err := do.Invoke[v2connect.TodoServiceHandler](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke todo service handler v2: %v", err)
+	}
+
+	tagServiceHandler, err := do.Invoke[v1connect.TagServiceHandler](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke tag service handler: %v", err)
+	}
+
+	projectServiceHandler, err := do.Invoke[v1connect.ProjectServiceHandler](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke project service handler: %v", err)
+	}
+
+	commentServiceHandler, err := do.Invoke[v1connect.CommentServiceHandler](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke comment service handler: %v", err)
+	}
+
+	attachmentServiceHandler, err := do.Invoke[v1connect.AttachmentServiceHandler](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke attachment service handler: %v", err)
+	}
+
+	historyServiceHandler, err := do.Invoke[v1connect.HistoryServiceHandler](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke history service handler: %v", err)
+	}
+
+	transferServiceHandler, err := do.Invoke[v1connect.TransferServiceHandler](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke transfer service handler: %v", err)
+	}
+
+	webhookServiceHandler, err := do.Invoke[v1connect.WebhookServiceHandler](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke webhook service handler: %v", err)
+	}
+
+	graphqlResolver, err := do.Invoke[*graphql.Resolver](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke graphql resolver: %v", err)
+	}
+
+	collectGarbageUseCase, err := do.Invoke[attachmentapp.CollectGarbageUseCase](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke collect garbage use case: %v", err)
+	}
+
+	// Get the interval of the attachment garbage collection, default to 1 hour
+	gcInterval := time.Hour
+	if intervalStr := os.Getenv("ATTACHMENT_GC_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			gcInterval = d
+		}
+	}
+
+	// Publish the hits and misses of the todo cache at /debug/vars
+	cacheStats, err := do.Invoke[*cache.Stats](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke cache stats: %v", err)
+	}
+	expvar.Publish("todo_cache", expvar.Func(func() any { return cacheStats.Map() }))
+
+	dispatchWebhooksUseCase, err := do.Invoke[webhookapp.DispatchWebhooksUseCase](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke dispatch webhooks use case: %v", err)
+	}
+
+	// Get the interval of the webhook dispatch, default to 5 seconds
+	dispatchInterval := 5 * time.Second
+	if intervalStr := os.Getenv("WEBHOOK_DISPATCH_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			dispatchInterval = d
+		}
+	}
+
+	handlerOptions := []connect.HandlerOption{
+		connect.WithCompressMinBytes(2048),
+		connect.WithSendMaxBytes(4 * 1024 * 1024),
+		connect.WithReadMaxBytes(4 * 1024 * 1024),
+		connect.WithInterceptors(
+			middleware.NewLoggingInterceptor(),
+			middleware.NewActorInterceptor(),
+		),
+	}
+
 	mux := http.NewServeMux()
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
-	mux.Handle(v1connect.NewTodoServiceHandler(
-		todoServiceHandler,
-		[]connect.HandlerOption{
-			connect.WithCompressMinBytes(2048),
-			connect.WithSendMaxBytes(4 * 1024 * 1024),
-			connect.WithReadMaxBytes(4 * 1024 * 1024),
-			connect.WithInterceptors(
-				middleware.NewLoggingInterceptor(),
-			),
-		}...,
-	))
+	mux.Handle(v1connect.NewTodoServiceHandler(todoServiceHandler, handlerOptions...))
+	mux.Handle(v1connect.NewTagServiceHandler(tagServiceHandler, handlerOptions...))
+	mux.Handle(v1connect.NewProjectServiceHandler(projectServiceHandler, handlerOptions...))
+	mux.Handle(v1connect.NewCommentServiceHandler(commentServiceHandler, handlerOptions...))
+	mux.Handle(v1connect.NewAttachmentServiceHandler(attachmentServiceHandler, handlerOptions...))
+	mux.Handle(v1connect.NewHistoryServiceHandler(historyServiceHandler, handlerOptions...))
+	mux.Handle(v1connect.NewTransferServiceHandler(transferServiceHandler, handlerOptions...))
+	mux.Handle(v1connect.NewWebhookServiceHandler(webhookServiceHandler, handlerOptions...))
+	mux.Handle(v2connect.NewTodoServiceHandler(todoServiceHandlerV2, handlerOptions...))
+
+	// Serve the REST routes of the google.api.http annotations with the Connect handlers
+	restHandler, err := rest.NewHandler(mux, v1.File_oniongo_v1_todo_proto.Services().ByName("TodoService"))
+	if err != nil {
+		log.Fatalf("failed to create REST handler: %v", err)
+	}
+	mux.Handle("/v1/", restHandler)
+	mux.Handle("GET /openapi.yaml", rest.NewOpenAPIHandler())
+	mux.Handle("GET /debug/vars", expvar.Handler())
+	mux.Handle("/graphql", middleware.NewActorHandler(graphql.NewHandler(graphqlResolver)))
+	if adminToken != "" {
+		mountBackupService(mux, injector, handlerOptions, adminToken)
+	} else {
+		log.Printf("ADMIN_TOKEN is not set: the %s is not started", v1connect.DatabaseServiceName)
+	}
+
+	// Get the origins allowed to call the server, default to any origin
+	allowedOrigins := []string{"*"}
+	if originsStr := os.Getenv("CORS_ALLOWED_ORIGINS"); originsStr != "" {
+		allowedOrigins = strings.Split(originsStr, ",")
+	}
 
 	corsOption := cors.New(cors.Options{
 		AllowedMethods: []string{
@@ -74,7 +218,7 @@ func main() {
 			http.MethodDelete,
 			http.MethodPatch,
 		},
-		AllowedOrigins: []string{"*"},
+		AllowedOrigins: allowedOrigins,
 		AllowedHeaders: []string{"*"},
 		MaxAge:         int(2 * time.Hour / time.Second),
 	})
@@ -99,10 +243,79 @@ func main() {
 		}
 	}()
 
+	gcCtx, stopGC := context.WithCancel(context.Background())
+	defer stopGC()
+	go collectAttachmentGarbage(gcCtx, collectGarbageUseCase, gcInterval)
+
+	dispatchCtx, stopDispatch := context.WithCancel(context.Background())
+	defer stopDispatch()
+	go dispatchWebhooks(dispatchCtx, dispatchWebhooksUseCase, dispatchInterval)
+
 	<-signals
+	stopGC()
+	stopDispatch()
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatalf("HTTP shutdown: %v", err)
 	}
+	if err := injector.Shutdown(); err != nil {
+		log.Printf("failed to shut down services: %v", err)
+	}
+}
+
+// mountBackupService mounts the DatabaseService, which requires adminToken as a bearer token.
+func mountBackupService(mux *http.ServeMux, injector *do.Injector, handlerOptions []connect.HandlerOption, adminToken string) {
+	databaseServiceHandler, err := do.Invoke[v1connect.DatabaseServiceHandler](injector)
+	if err != nil {
+		log.Fatalf("failed to invoke database service handler: %v", err)
+	}
+
+	options := append(slices.Clone(handlerOptions), connect.WithInterceptors(middleware.NewAdminTokenInterceptor(adminToken)))
+	mux.Handle(v1connect.NewDatabaseServiceHandler(databaseServiceHandler, options...))
+}
+
+// collectAttachmentGarbage deletes the orphaned attachment blobs every interval until ctx is done.
+func collectAttachmentGarbage(ctx context.Context, useCase attachmentapp.CollectGarbageUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := useCase.Execute(ctx, attachmentapp.CollectGarbageRequest{})
+			if err != nil {
+				log.Printf("failed to collect attachment garbage: %v", err)
+				continue
+			}
+			if len(result.DeletedKeys) > 0 {
+				log.Printf("deleted %d orphaned attachment blobs", len(result.DeletedKeys))
+			}
+		}
+	}
+}
+
+// dispatchWebhooks delivers the queued webhook events and retries the due deliveries
+// every interval until ctx is done.
+func dispatchWebhooks(ctx context.Context, useCase webhookapp.DispatchWebhooksUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := useCase.Execute(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("failed to dispatch webhooks: %v", err)
+				}
+				continue
+			}
+			if result.Succeeded > 0 || result.Failed > 0 {
+				log.Printf("webhook deliveries: %d succeeded, %d failed", result.Succeeded, result.Failed)
+			}
+		}
+	}
 }
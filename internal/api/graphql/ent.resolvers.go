@@ -0,0 +1,49 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.70
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/api/graphql/generated"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id uuid.UUID) (entgen.Noder, error) {
+	return r.client.Noder(ctx, id, entgen.WithNodeType(r.nodeType))
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []uuid.UUID) ([]entgen.Noder, error) {
+	return r.client.Noders(ctx, ids, entgen.WithNodeType(r.nodeType))
+}
+
+// Projects is the resolver for the projects field.
+func (r *queryResolver) Projects(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *entgen.ProjectOrder, where *entgen.ProjectWhereInput) (*entgen.ProjectConnection, error) {
+	return r.client.ProjectSchema.Query().
+		Paginate(ctx, after, first, before, last,
+			entgen.WithProjectOrder(orderBy),
+			entgen.WithProjectFilter(where.Filter),
+		)
+}
+
+// Todos is the resolver for the todos field.
+func (r *queryResolver) Todos(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *entgen.TodoOrder, where *entgen.TodoWhereInput) (*entgen.TodoConnection, error) {
+	return r.client.TodoSchema.Query().
+		Where(todoschema.DeletedAtIsNil()).
+		Paginate(ctx, after, first, before, last,
+			entgen.WithTodoOrder(orderBy),
+			entgen.WithTodoFilter(where.Filter),
+		)
+}
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+type queryResolver struct{ *Resolver }
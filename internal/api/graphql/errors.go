@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes set in the code extension of the errors, named after the Connect error codes.
+const (
+	codeNotFound           = "NOT_FOUND"
+	codeAlreadyExists      = "ALREADY_EXISTS"
+	codeInvalidArgument    = "INVALID_ARGUMENT"
+	codeFailedPrecondition = "FAILED_PRECONDITION"
+	codeDataLoss           = "DATA_LOSS"
+)
+
+// presentError adds the code and the reason of the error to its extensions, so that clients
+// can tell domain errors apart without parsing the messages.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	code := errorCode(err)
+	if code == "" {
+		return gqlErr
+	}
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = make(map[string]any)
+	}
+	gqlErr.Extensions["code"] = code
+	if domainErr, ok := domainerr.As(err); ok {
+		gqlErr.Extensions["reason"] = domainErr.Reason()
+	}
+	return gqlErr
+}
+
+// errorCode returns the code of a domain error, or an empty string for other errors.
+func errorCode(err error) string {
+	if entgen.IsNotFound(err) {
+		return codeNotFound
+	}
+	switch domainerr.KindOf(err) {
+	case domainerr.KindNotFound:
+		return codeNotFound
+	case domainerr.KindAlreadyExists:
+		return codeAlreadyExists
+	case domainerr.KindInvalidArgument:
+		return codeInvalidArgument
+	case domainerr.KindFailedPrecondition:
+		return codeFailedPrecondition
+	case domainerr.KindDataLoss:
+		return codeDataLoss
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	domainHistory "github.com/iktakahiro/oniongo/internal/domain/history"
+	domainProject "github.com/iktakahiro/oniongo/internal/domain/project"
+	domainTag "github.com/iktakahiro/oniongo/internal/domain/tag"
+	domainTodo "github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "todo not found", err: &domainTodo.NotFoundError{ID: domainTodo.TodoID(uuid.New())}, want: codeNotFound},
+		{name: "project not found", err: &domainProject.NotFoundError{ID: domainProject.ProjectID(uuid.New())}, want: codeNotFound},
+		{name: "version not found", err: &domainHistory.VersionNotFoundError{}, want: codeNotFound},
+		{name: "wrapped todo validation error", err: fmt.Errorf("failed to set title: %w", &domainTodo.ValidationError{Field: "title"}), want: codeInvalidArgument},
+		{name: "project validation error", err: &domainProject.ValidationError{Field: "name"}, want: codeInvalidArgument},
+		{name: "state error", err: &domainTodo.StateError{}, want: codeFailedPrecondition},
+		{name: "tag validation error", err: &domainTag.ValidationError{Field: "color"}, want: codeInvalidArgument},
+		{name: "other error", err: errors.New("database is locked"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorCode(tt.err))
+		})
+	}
+}
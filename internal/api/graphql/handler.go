@@ -0,0 +1,28 @@
+package graphql
+
+import (
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/iktakahiro/oniongo/internal/api/graphql/generated"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// maxComplexity bounds the number of fields resolved by a query,
+// so that a single query cannot walk the whole graph of todos.
+const maxComplexity = 1000
+
+// NewHandler returns the handler of the GraphQL API, which accepts queries with GET and POST.
+func NewHandler(r *Resolver) http.Handler {
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: r}))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.SetErrorPresenter(presentError)
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(maxComplexity))
+	return srv
+}
@@ -0,0 +1,26 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+type CreateProjectInput struct {
+	// The project gets the default workflow.
+	Name string `json:"name"`
+}
+
+type CreateTodoInput struct {
+	Title string  `json:"title"`
+	Body  *string `json:"body,omitempty"`
+	// Creates the todo as a subtask of the given todo.
+	ParentID *uuid.UUID `json:"parentID,omitempty"`
+	// Creates the todo in the given project. Subtasks without it are created in the project of their parent.
+	ProjectID *uuid.UUID `json:"projectID,omitempty"`
+}
+
+type UpdateTodoInput struct {
+	Title string  `json:"title"`
+	Body  *string `json:"body,omitempty"`
+}
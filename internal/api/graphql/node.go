@@ -0,0 +1,33 @@
+package graphql
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// nodeType returns the table of the node with the ID. The IDs are UUIDs,
+// so unlike integer IDs the table cannot be told from the ID itself.
+func (r *Resolver) nodeType(ctx context.Context, id uuid.UUID) (string, error) {
+	exists, err := r.client.TodoSchema.Query().
+		Where(todoschema.ID(id), todoschema.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return todoschema.Table, nil
+	}
+
+	exists, err = r.client.ProjectSchema.Query().Where(projectschema.ID(id)).Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return projectschema.Table, nil
+	}
+	return "", entgql.ErrNodeNotFound(id)
+}
@@ -0,0 +1,97 @@
+// Package graphql serves the GraphQL API of todos and projects. The queries read the
+// ent types with the Relay connections and WhereInputs generated by entgql, and the
+// mutations are made with the use cases of the application layer.
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/projectapp"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/samber/do"
+)
+
+// Resolver is the root resolver of the GraphQL API.
+type Resolver struct {
+	client               *entgen.Client
+	createTodoUseCase    todoapp.CreateTodoUseCase
+	updateTodoUseCase    todoapp.UpdateTodoUseCase
+	startTodoUseCase     todoapp.StartTodoUseCase
+	completeTodoUseCase  todoapp.CompleteTodoUseCase
+	reopenTodoUseCase    todoapp.ReopenTodoUseCase
+	cancelTodoUseCase    todoapp.CancelTodoUseCase
+	pauseTodoUseCase     todoapp.PauseTodoUseCase
+	resumeTodoUseCase    todoapp.ResumeTodoUseCase
+	deleteTodoUseCase    todoapp.DeleteTodoUseCase
+	createProjectUseCase projectapp.CreateProjectUseCase
+	deleteProjectUseCase projectapp.DeleteProjectUseCase
+}
+
+// NewResolver creates a new Resolver.
+func NewResolver(i *do.Injector) (*Resolver, error) {
+	client, err := db.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+	createTodoUseCase, err := do.Invoke[todoapp.CreateTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke create todo use case: %w", err)
+	}
+	updateTodoUseCase, err := do.Invoke[todoapp.UpdateTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke update todo use case: %w", err)
+	}
+	startTodoUseCase, err := do.Invoke[todoapp.StartTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke start todo use case: %w", err)
+	}
+	completeTodoUseCase, err := do.Invoke[todoapp.CompleteTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke complete todo use case: %w", err)
+	}
+	reopenTodoUseCase, err := do.Invoke[todoapp.ReopenTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke reopen todo use case: %w", err)
+	}
+	cancelTodoUseCase, err := do.Invoke[todoapp.CancelTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke cancel todo use case: %w", err)
+	}
+	pauseTodoUseCase, err := do.Invoke[todoapp.PauseTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke pause todo use case: %w", err)
+	}
+	resumeTodoUseCase, err := do.Invoke[todoapp.ResumeTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke resume todo use case: %w", err)
+	}
+	deleteTodoUseCase, err := do.Invoke[todoapp.DeleteTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delete todo use case: %w", err)
+	}
+	createProjectUseCase, err := do.Invoke[projectapp.CreateProjectUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke create project use case: %w", err)
+	}
+	deleteProjectUseCase, err := do.Invoke[projectapp.DeleteProjectUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delete project use case: %w", err)
+	}
+
+	return &Resolver{
+		client:               client,
+		createTodoUseCase:    createTodoUseCase,
+		updateTodoUseCase:    updateTodoUseCase,
+		startTodoUseCase:     startTodoUseCase,
+		completeTodoUseCase:  completeTodoUseCase,
+		reopenTodoUseCase:    reopenTodoUseCase,
+		cancelTodoUseCase:    cancelTodoUseCase,
+		pauseTodoUseCase:     pauseTodoUseCase,
+		resumeTodoUseCase:    resumeTodoUseCase,
+		deleteTodoUseCase:    deleteTodoUseCase,
+		createProjectUseCase: createProjectUseCase,
+		deleteProjectUseCase: deleteProjectUseCase,
+	}, nil
+}
@@ -0,0 +1,203 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/enttest"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_todoapp"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEntClient(t *testing.T) *entgen.Client {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(db.MigrateOptions()...),
+	)
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// graphQLErrors is the errors of a GraphQL response.
+type graphQLErrors []struct {
+	Message    string         `json:"message"`
+	Extensions map[string]any `json:"extensions"`
+}
+
+func TestQueryResolver_Todos(t *testing.T) {
+	// Given
+	entClient := newTestEntClient(t)
+	ctx := t.Context()
+	project := entClient.ProjectSchema.Create().SetName("Home").SetWorkflow(schema.WorkflowDefinition{}).SaveX(ctx)
+	createdAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	for i, title := range []string{"Buy milk", "Clean the room", "Call mom"} {
+		entClient.TodoSchema.Create().
+			SetTitle(title).
+			SetStatus(todoschema.StatusIN_PROGRESS).
+			SetProject(project).
+			SetCreatedAt(createdAt.Add(time.Duration(i) * time.Hour)).
+			SaveX(ctx)
+	}
+	entClient.TodoSchema.Create().SetTitle("Buy bread").SaveX(ctx)
+	c := client.New(NewHandler(&Resolver{client: entClient}))
+
+	// When
+	var resp struct {
+		Todos struct {
+			TotalCount int
+			PageInfo   struct{ HasNextPage bool }
+			Edges      []struct {
+				Node struct {
+					Title   string
+					Project struct{ Name string }
+				}
+			}
+		}
+	}
+	c.MustPost(`query($where: TodoWhereInput) {
+		todos(first: 2, where: $where, orderBy: {field: CREATED_AT, direction: DESC}) {
+			totalCount
+			pageInfo { hasNextPage }
+			edges { node { title project { name } } }
+		}
+	}`, &resp, client.Var("where", map[string]any{
+		"statusIn":       []string{"IN_PROGRESS"},
+		"hasProjectWith": []map[string]any{{"name": "Home"}},
+	}))
+
+	// Then
+	assert.Equal(t, 3, resp.Todos.TotalCount)
+	assert.True(t, resp.Todos.PageInfo.HasNextPage)
+	require.Len(t, resp.Todos.Edges, 2)
+	assert.Equal(t, "Call mom", resp.Todos.Edges[0].Node.Title)
+	assert.Equal(t, "Clean the room", resp.Todos.Edges[1].Node.Title)
+	assert.Equal(t, "Home", resp.Todos.Edges[0].Node.Project.Name)
+}
+
+func TestQueryResolver_Node(t *testing.T) {
+	// Given
+	entClient := newTestEntClient(t)
+	created := entClient.TodoSchema.Create().SetTitle("Buy milk").SaveX(t.Context())
+	c := client.New(NewHandler(&Resolver{client: entClient}))
+
+	t.Run("resolves a todo", func(t *testing.T) {
+		// When
+		var resp struct {
+			Node struct{ Title string }
+		}
+		c.MustPost(`query($id: ID!) { node(id: $id) { ... on Todo { title } } }`, &resp, client.Var("id", created.ID))
+
+		// Then
+		assert.Equal(t, "Buy milk", resp.Node.Title)
+	})
+
+	t.Run("returns NOT_FOUND for an unknown ID", func(t *testing.T) {
+		// When
+		resp, err := c.RawPost(`query($id: ID!) { node(id: $id) { id } }`, client.Var("id", uuid.New()))
+
+		// Then
+		require.NoError(t, err)
+		var errs graphQLErrors
+		require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+		require.Len(t, errs, 1)
+		assert.Equal(t, codeNotFound, errs[0].Extensions["code"])
+	})
+}
+
+func TestMutationResolver_CreateTodo(t *testing.T) {
+	// Given
+	entClient := newTestEntClient(t)
+	parent := entClient.TodoSchema.Create().SetTitle("Groceries").SaveX(t.Context())
+	parentID := todo.TodoID(parent.ID)
+	createTodoUseCase := mock_todoapp.NewMockCreateTodoUseCase(t)
+	createTodoUseCase.EXPECT().
+		Execute(mock.Anything, todoapp.CreateTodoRequest{Title: "Buy milk", Body: "2 bottles", ParentID: &parentID}).
+		RunAndReturn(func(ctx context.Context, req todoapp.CreateTodoRequest) (*todo.Todo, error) {
+			newTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), req.Title, req.Body)
+			require.NoError(t, err)
+			// The repository writes the todo created by the use case
+			entClient.TodoSchema.Create().
+				SetID(newTodo.ID().UUID()).
+				SetTitle(newTodo.Title()).
+				SetBody(req.Body).
+				SetParentID(parent.ID).
+				SaveX(ctx)
+			return newTodo, nil
+		})
+	c := client.New(NewHandler(&Resolver{client: entClient, createTodoUseCase: createTodoUseCase}))
+
+	// When
+	var resp struct {
+		CreateTodo struct {
+			Title  string
+			Body   string
+			Parent struct{ Title string }
+		}
+	}
+	c.MustPost(`mutation($input: CreateTodoInput!) { createTodo(input: $input) { title body parent { title } } }`, &resp,
+		client.Var("input", map[string]any{"title": "Buy milk", "body": "2 bottles", "parentID": parent.ID}))
+
+	// Then
+	assert.Equal(t, "Buy milk", resp.CreateTodo.Title)
+	assert.Equal(t, "2 bottles", resp.CreateTodo.Body)
+	assert.Equal(t, "Groceries", resp.CreateTodo.Parent.Title)
+}
+
+func TestMutationResolver_CompleteTodo(t *testing.T) {
+	t.Run("completes the todo with the use case", func(t *testing.T) {
+		// Given
+		entClient := newTestEntClient(t)
+		created := entClient.TodoSchema.Create().SetTitle("Buy milk").SaveX(t.Context())
+		completeTodoUseCase := mock_todoapp.NewMockCompleteTodoUseCase(t)
+		completeTodoUseCase.EXPECT().
+			Execute(mock.Anything, todoapp.CompleteTodoRequest{ID: todo.TodoID(created.ID), Cascade: true}).
+			RunAndReturn(func(ctx context.Context, req todoapp.CompleteTodoRequest) error {
+				entClient.TodoSchema.UpdateOneID(created.ID).SetStatus(todoschema.StatusCOMPLETED).ExecX(ctx)
+				return nil
+			})
+		c := client.New(NewHandler(&Resolver{client: entClient, completeTodoUseCase: completeTodoUseCase}))
+
+		// When
+		var resp struct {
+			CompleteTodo struct{ Status string }
+		}
+		c.MustPost(`mutation($id: ID!) { completeTodo(id: $id, cascade: true) { status } }`, &resp, client.Var("id", created.ID))
+
+		// Then
+		assert.Equal(t, "COMPLETED", resp.CompleteTodo.Status)
+	})
+
+	t.Run("returns the code of the domain error", func(t *testing.T) {
+		// Given
+		id := uuid.New()
+		completeTodoUseCase := mock_todoapp.NewMockCompleteTodoUseCase(t)
+		completeTodoUseCase.EXPECT().
+			Execute(mock.Anything, todoapp.CompleteTodoRequest{ID: todo.TodoID(id)}).
+			Return(&todo.StateError{Message: "todo is already completed"})
+		c := client.New(NewHandler(&Resolver{client: newTestEntClient(t), completeTodoUseCase: completeTodoUseCase}))
+
+		// When
+		resp, err := c.RawPost(`mutation($id: ID!) { completeTodo(id: $id) { status } }`, client.Var("id", id))
+
+		// Then
+		require.NoError(t, err)
+		var errs graphQLErrors
+		require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+		require.Len(t, errs, 1)
+		assert.Equal(t, "todo is already completed", errs[0].Message)
+		assert.Equal(t, codeFailedPrecondition, errs[0].Extensions["code"])
+	})
+}
@@ -0,0 +1,130 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.70
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/api/graphql/generated"
+	"github.com/iktakahiro/oniongo/internal/api/graphql/model"
+	"github.com/iktakahiro/oniongo/internal/application/projectapp"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+)
+
+// CreateTodo is the resolver for the createTodo field.
+func (r *mutationResolver) CreateTodo(ctx context.Context, input model.CreateTodoInput) (*entgen.TodoSchema, error) {
+	req := todoapp.CreateTodoRequest{Title: input.Title}
+	if input.Body != nil {
+		req.Body = *input.Body
+	}
+	if input.ParentID != nil {
+		parentID := todo.TodoID(*input.ParentID)
+		req.ParentID = &parentID
+	}
+	if input.ProjectID != nil {
+		projectID := project.ProjectID(*input.ProjectID)
+		req.ProjectID = &projectID
+	}
+	created, err := r.createTodoUseCase.Execute(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.TodoSchema.Get(ctx, created.ID().UUID())
+}
+
+// UpdateTodo is the resolver for the updateTodo field.
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id uuid.UUID, input model.UpdateTodoInput) (*entgen.TodoSchema, error) {
+	body := ""
+	if input.Body != nil {
+		body = *input.Body
+	}
+	req := todoapp.UpdateTodoRequest{ID: todo.TodoID(id), Title: &input.Title, Body: &body}
+	if err := r.updateTodoUseCase.Execute(ctx, req); err != nil {
+		return nil, err
+	}
+	return r.client.TodoSchema.Get(ctx, id)
+}
+
+// StartTodo is the resolver for the startTodo field.
+func (r *mutationResolver) StartTodo(ctx context.Context, id uuid.UUID) (*entgen.TodoSchema, error) {
+	if err := r.startTodoUseCase.Execute(ctx, todoapp.StartTodoRequest{ID: todo.TodoID(id)}); err != nil {
+		return nil, err
+	}
+	return r.client.TodoSchema.Get(ctx, id)
+}
+
+// CompleteTodo is the resolver for the completeTodo field.
+func (r *mutationResolver) CompleteTodo(ctx context.Context, id uuid.UUID, cascade bool) (*entgen.TodoSchema, error) {
+	if err := r.completeTodoUseCase.Execute(ctx, todoapp.CompleteTodoRequest{ID: todo.TodoID(id), Cascade: cascade}); err != nil {
+		return nil, err
+	}
+	return r.client.TodoSchema.Get(ctx, id)
+}
+
+// ReopenTodo is the resolver for the reopenTodo field.
+func (r *mutationResolver) ReopenTodo(ctx context.Context, id uuid.UUID) (*entgen.TodoSchema, error) {
+	if err := r.reopenTodoUseCase.Execute(ctx, todoapp.ReopenTodoRequest{ID: todo.TodoID(id)}); err != nil {
+		return nil, err
+	}
+	return r.client.TodoSchema.Get(ctx, id)
+}
+
+// CancelTodo is the resolver for the cancelTodo field.
+func (r *mutationResolver) CancelTodo(ctx context.Context, id uuid.UUID) (*entgen.TodoSchema, error) {
+	if err := r.cancelTodoUseCase.Execute(ctx, todoapp.CancelTodoRequest{ID: todo.TodoID(id)}); err != nil {
+		return nil, err
+	}
+	return r.client.TodoSchema.Get(ctx, id)
+}
+
+// PauseTodo is the resolver for the pauseTodo field.
+func (r *mutationResolver) PauseTodo(ctx context.Context, id uuid.UUID) (*entgen.TodoSchema, error) {
+	if err := r.pauseTodoUseCase.Execute(ctx, todoapp.PauseTodoRequest{ID: todo.TodoID(id)}); err != nil {
+		return nil, err
+	}
+	return r.client.TodoSchema.Get(ctx, id)
+}
+
+// ResumeTodo is the resolver for the resumeTodo field.
+func (r *mutationResolver) ResumeTodo(ctx context.Context, id uuid.UUID) (*entgen.TodoSchema, error) {
+	if err := r.resumeTodoUseCase.Execute(ctx, todoapp.ResumeTodoRequest{ID: todo.TodoID(id)}); err != nil {
+		return nil, err
+	}
+	return r.client.TodoSchema.Get(ctx, id)
+}
+
+// DeleteTodo is the resolver for the deleteTodo field.
+func (r *mutationResolver) DeleteTodo(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	if err := r.deleteTodoUseCase.Execute(ctx, todoapp.DeleteTodoRequest{ID: todo.TodoID(id)}); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// CreateProject is the resolver for the createProject field.
+func (r *mutationResolver) CreateProject(ctx context.Context, input model.CreateProjectInput) (*entgen.ProjectSchema, error) {
+	created, err := r.createProjectUseCase.Execute(ctx, projectapp.CreateProjectRequest{Name: input.Name})
+	if err != nil {
+		return nil, err
+	}
+	return r.client.ProjectSchema.Get(ctx, created.ID().UUID())
+}
+
+// DeleteProject is the resolver for the deleteProject field.
+func (r *mutationResolver) DeleteProject(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	if err := r.deleteProjectUseCase.Execute(ctx, projectapp.DeleteProjectRequest{ID: project.ProjectID(id)}); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
@@ -0,0 +1,146 @@
+// Package connecterr converts the errors returned by the use cases to Connect errors.
+package connecterr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+// Domain is the domain of the ErrorInfo details, which scopes the reasons.
+const Domain = "oniongo.iktakahiro.github.com"
+
+// ReasonInternal is the reason of the errors outside the domain taxonomy.
+const ReasonInternal = "INTERNAL"
+
+// ReasonInvalidArgument is the reason of the request fields that the handlers fail to parse,
+// such as malformed IDs, before the use cases can validate them.
+const ReasonInvalidArgument = "INVALID_ARGUMENT"
+
+// CorrelationIDKey is the ErrorInfo metadata key of the ID that the internal errors are logged with.
+const CorrelationIDKey = "correlation_id"
+
+var codes = map[domainerr.Kind]connect.Code{
+	domainerr.KindNotFound:           connect.CodeNotFound,
+	domainerr.KindAlreadyExists:      connect.CodeAlreadyExists,
+	domainerr.KindInvalidArgument:    connect.CodeInvalidArgument,
+	domainerr.KindFailedPrecondition: connect.CodeFailedPrecondition,
+	domainerr.KindDataLoss:           connect.CodeDataLoss,
+}
+
+// From converts err to a Connect error whose details tell clients what went wrong:
+// an ErrorInfo with the reason of the domain error, a BadRequest with the invalid
+// fields and a PreconditionFailure with the state that refused the operation.
+//
+// Errors outside the taxonomy are logged and replaced by an internal error that
+// carries only the correlation ID of the log entry, so that their messages do not
+// leak to clients. Connect errors and the errors of canceled contexts are kept.
+func From(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+	if errors.Is(err, context.Canceled) {
+		return connect.NewError(connect.CodeCanceled, err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+
+	domainErr, ok := domainerr.As(err)
+	if !ok {
+		return internalError(err)
+	}
+	code, ok := codes[domainErr.Kind()]
+	if !ok {
+		return internalError(err)
+	}
+
+	connectErr = connect.NewError(code, err)
+	info := &errdetails.ErrorInfo{Reason: domainErr.Reason(), Domain: Domain}
+	if metadataErr, ok := domainErr.(domainerr.MetadataError); ok {
+		info.Metadata = metadataErr.Metadata()
+	}
+	addDetail(connectErr, info)
+
+	if fieldErr, ok := domainErr.(domainerr.FieldViolationError); ok {
+		if violations := fieldErr.FieldViolations(); len(violations) > 0 {
+			badRequest := &errdetails.BadRequest{}
+			for _, v := range violations {
+				badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       v.Field,
+					Description: v.Description,
+				})
+			}
+			addDetail(connectErr, badRequest)
+		}
+	}
+
+	if preconditionErr, ok := domainErr.(domainerr.PreconditionViolationError); ok {
+		if violations := preconditionErr.PreconditionViolations(); len(violations) > 0 {
+			failure := &errdetails.PreconditionFailure{}
+			for _, v := range violations {
+				failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+					Type:        v.Type,
+					Subject:     v.Subject,
+					Description: v.Description,
+				})
+			}
+			addDetail(connectErr, failure)
+		}
+	}
+
+	return connectErr
+}
+
+// InvalidField returns an invalid argument error that reports field as a BadRequest field
+// violation, with the same details as the validation errors of the domain. The handlers use
+// it for the fields they fail to parse, so that the messages of the parsers do not reach clients.
+func InvalidField(field, description string) error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: %s", field, description))
+	addDetail(connectErr, &errdetails.ErrorInfo{Reason: ReasonInvalidArgument, Domain: Domain})
+	addDetail(connectErr, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+	return connectErr
+}
+
+// InvalidID returns the error of an ID field that is not a UUID.
+func InvalidID(field string) error {
+	return InvalidField(field, "must be a UUID")
+}
+
+// internalError logs err and returns an internal error that refers to the log entry.
+func internalError(err error) error {
+	correlationID := uuid.NewString()
+	log.Printf("Internal error %s: %v", correlationID, err)
+
+	connectErr := connect.NewError(
+		connect.CodeInternal,
+		errors.New("internal error, correlation ID "+correlationID),
+	)
+	addDetail(connectErr, &errdetails.ErrorInfo{
+		Reason:   ReasonInternal,
+		Domain:   Domain,
+		Metadata: map[string]string{CorrelationIDKey: correlationID},
+	})
+	return connectErr
+}
+
+func addDetail(connectErr *connect.Error, msg proto.Message) {
+	// NewErrorDetail fails only for messages that cannot be marshaled.
+	if detail, err := connect.NewErrorDetail(msg); err == nil {
+		connectErr.AddDetail(detail)
+	}
+}
@@ -0,0 +1,156 @@
+package connecterr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFrom(t *testing.T) {
+	t.Run("reports the reason and the metadata of a domain error", func(t *testing.T) {
+		// Given
+		id := todo.NewTodoID()
+		err := fmt.Errorf("failed to find todo: %w", &todo.NotFoundError{ID: id})
+
+		// When
+		connectErr := asConnectError(t, From(err))
+
+		// Then
+		assert.Equal(t, connect.CodeNotFound, connectErr.Code())
+		info := findDetail[*errdetails.ErrorInfo](t, connectErr)
+		assert.Equal(t, todo.ReasonNotFound, info.GetReason())
+		assert.Equal(t, Domain, info.GetDomain())
+		assert.Equal(t, map[string]string{"todo_id": id.String()}, info.GetMetadata())
+	})
+
+	t.Run("reports the invalid field of a validation error", func(t *testing.T) {
+		// Given
+		err := &todo.ValidationError{Field: "title", Message: "title cannot be empty"}
+
+		// When
+		connectErr := asConnectError(t, From(err))
+
+		// Then
+		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+		assert.Equal(t, todo.ReasonInvalidArgument, findDetail[*errdetails.ErrorInfo](t, connectErr).GetReason())
+		violations := findDetail[*errdetails.BadRequest](t, connectErr).GetFieldViolations()
+		require.Len(t, violations, 1)
+		assert.Equal(t, "title", violations[0].GetField())
+		assert.Equal(t, "title cannot be empty", violations[0].GetDescription())
+	})
+
+	t.Run("omits the field violations of a validation error without a field", func(t *testing.T) {
+		// Given
+		err := &todo.ValidationError{Message: "todo is invalid"}
+
+		// When
+		connectErr := asConnectError(t, From(err))
+
+		// Then
+		assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+		assert.Len(t, connectErr.Details(), 1)
+	})
+
+	t.Run("reports the current status of a state error", func(t *testing.T) {
+		// Given
+		err := &todo.StateError{
+			Current:   todo.TodoStatusCompleted,
+			Attempted: todo.TodoStatusInProgress,
+			Message:   "todo is already completed",
+		}
+
+		// When
+		connectErr := asConnectError(t, From(err))
+
+		// Then
+		assert.Equal(t, connect.CodeFailedPrecondition, connectErr.Code())
+		info := findDetail[*errdetails.ErrorInfo](t, connectErr)
+		assert.Equal(t, todo.ReasonInvalidTransition, info.GetReason())
+		assert.Equal(t, "COMPLETED", info.GetMetadata()["current_status"])
+		violations := findDetail[*errdetails.PreconditionFailure](t, connectErr).GetViolations()
+		require.Len(t, violations, 1)
+		assert.Equal(t, "TODO_STATUS", violations[0].GetType())
+		assert.Equal(t, "COMPLETED", violations[0].GetSubject())
+		assert.Equal(t, "todo is already completed", violations[0].GetDescription())
+	})
+
+	t.Run("reports a comment state error as a failed precondition", func(t *testing.T) {
+		// When
+		connectErr := asConnectError(t, From(&comment.StateError{Message: "comment is already deleted"}))
+
+		// Then
+		assert.Equal(t, connect.CodeFailedPrecondition, connectErr.Code())
+		assert.Equal(t, comment.ReasonDeleted, findDetail[*errdetails.ErrorInfo](t, connectErr).GetReason())
+	})
+
+	t.Run("hides the message of an internal error behind a correlation ID", func(t *testing.T) {
+		// Given
+		err := fmt.Errorf("failed to execute transaction: %w", errors.New("database is locked"))
+
+		// When
+		connectErr := asConnectError(t, From(err))
+
+		// Then
+		assert.Equal(t, connect.CodeInternal, connectErr.Code())
+		assert.NotContains(t, connectErr.Message(), "database is locked")
+		info := findDetail[*errdetails.ErrorInfo](t, connectErr)
+		assert.Equal(t, ReasonInternal, info.GetReason())
+		correlationID := info.GetMetadata()[CorrelationIDKey]
+		assert.NotEmpty(t, correlationID)
+		assert.Contains(t, connectErr.Message(), correlationID)
+	})
+
+	t.Run("keeps Connect errors", func(t *testing.T) {
+		// Given
+		err := connect.NewError(connect.CodeInvalidArgument, errors.New("invalid UUID"))
+
+		// When
+		converted := From(err)
+
+		// Then
+		assert.Same(t, err, converted)
+	})
+
+	t.Run("reports a canceled context as canceled", func(t *testing.T) {
+		// When
+		connectErr := asConnectError(t, From(fmt.Errorf("failed to find todos: %w", context.Canceled)))
+
+		// Then
+		assert.Equal(t, connect.CodeCanceled, connectErr.Code())
+	})
+
+	t.Run("returns nil for no error", func(t *testing.T) {
+		assert.NoError(t, From(nil))
+	})
+}
+
+func asConnectError(t *testing.T, err error) *connect.Error {
+	t.Helper()
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	return connectErr
+}
+
+// findDetail returns the first detail of the error of the type T.
+func findDetail[T proto.Message](t *testing.T, connectErr *connect.Error) T {
+	t.Helper()
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		require.NoError(t, err)
+		if msg, ok := value.(T); ok {
+			return msg
+		}
+	}
+	var zero T
+	require.Failf(t, "detail not found", "the error has no %T detail", zero)
+	return zero
+}
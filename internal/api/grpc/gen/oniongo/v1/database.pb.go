@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: oniongo/v1/database.proto
+
+package oniongov1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BackupDatabaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupDatabaseRequest) Reset() {
+	*x = BackupDatabaseRequest{}
+	mi := &file_oniongo_v1_database_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseRequest) ProtoMessage() {}
+
+func (x *BackupDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_database_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_database_proto_rawDescGZIP(), []int{0}
+}
+
+// The content of the SQLite database file in chunks
+type BackupDatabaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupDatabaseResponse) Reset() {
+	*x = BackupDatabaseResponse{}
+	mi := &file_oniongo_v1_database_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseResponse) ProtoMessage() {}
+
+func (x *BackupDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_database_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_database_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BackupDatabaseResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+var File_oniongo_v1_database_proto protoreflect.FileDescriptor
+
+const file_oniongo_v1_database_proto_rawDesc = "" +
+	"\n" +
+	"\x19oniongo/v1/database.proto\x12\n" +
+	"oniongo.v1\"\x17\n" +
+	"\x15BackupDatabaseRequest\".\n" +
+	"\x16BackupDatabaseResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk2l\n" +
+	"\x0fDatabaseService\x12Y\n" +
+	"\x0eBackupDatabase\x12!.oniongo.v1.BackupDatabaseRequest\x1a\".oniongo.v1.BackupDatabaseResponse0\x01B\xb2\x01\n" +
+	"\x0ecom.oniongo.v1B\rDatabaseProtoP\x01ZHgithub.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1;oniongov1\xa2\x02\x03OXX\xaa\x02\n" +
+	"Oniongo.V1\xca\x02\n" +
+	"Oniongo\\V1\xe2\x02\x16Oniongo\\V1\\GPBMetadata\xea\x02\vOniongo::V1b\x06proto3"
+
+var (
+	file_oniongo_v1_database_proto_rawDescOnce sync.Once
+	file_oniongo_v1_database_proto_rawDescData []byte
+)
+
+func file_oniongo_v1_database_proto_rawDescGZIP() []byte {
+	file_oniongo_v1_database_proto_rawDescOnce.Do(func() {
+		file_oniongo_v1_database_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_oniongo_v1_database_proto_rawDesc), len(file_oniongo_v1_database_proto_rawDesc)))
+	})
+	return file_oniongo_v1_database_proto_rawDescData
+}
+
+var file_oniongo_v1_database_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_oniongo_v1_database_proto_goTypes = []any{
+	(*BackupDatabaseRequest)(nil),  // 0: oniongo.v1.BackupDatabaseRequest
+	(*BackupDatabaseResponse)(nil), // 1: oniongo.v1.BackupDatabaseResponse
+}
+var file_oniongo_v1_database_proto_depIdxs = []int32{
+	0, // 0: oniongo.v1.DatabaseService.BackupDatabase:input_type -> oniongo.v1.BackupDatabaseRequest
+	1, // 1: oniongo.v1.DatabaseService.BackupDatabase:output_type -> oniongo.v1.BackupDatabaseResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_oniongo_v1_database_proto_init() }
+func file_oniongo_v1_database_proto_init() {
+	if File_oniongo_v1_database_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_oniongo_v1_database_proto_rawDesc), len(file_oniongo_v1_database_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_oniongo_v1_database_proto_goTypes,
+		DependencyIndexes: file_oniongo_v1_database_proto_depIdxs,
+		MessageInfos:      file_oniongo_v1_database_proto_msgTypes,
+	}.Build()
+	File_oniongo_v1_database_proto = out.File
+	file_oniongo_v1_database_proto_goTypes = nil
+	file_oniongo_v1_database_proto_depIdxs = nil
+}
@@ -0,0 +1,592 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: oniongo/v1/history.proto
+
+package oniongov1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HistoryOperation is the kind of mutation recorded by a history entry
+type HistoryOperation int32
+
+const (
+	HistoryOperation_HISTORY_OPERATION_UNSPECIFIED HistoryOperation = 0
+	HistoryOperation_HISTORY_OPERATION_CREATE      HistoryOperation = 1
+	HistoryOperation_HISTORY_OPERATION_UPDATE      HistoryOperation = 2
+	HistoryOperation_HISTORY_OPERATION_DELETE      HistoryOperation = 3
+)
+
+// Enum value maps for HistoryOperation.
+var (
+	HistoryOperation_name = map[int32]string{
+		0: "HISTORY_OPERATION_UNSPECIFIED",
+		1: "HISTORY_OPERATION_CREATE",
+		2: "HISTORY_OPERATION_UPDATE",
+		3: "HISTORY_OPERATION_DELETE",
+	}
+	HistoryOperation_value = map[string]int32{
+		"HISTORY_OPERATION_UNSPECIFIED": 0,
+		"HISTORY_OPERATION_CREATE":      1,
+		"HISTORY_OPERATION_UPDATE":      2,
+		"HISTORY_OPERATION_DELETE":      3,
+	}
+)
+
+func (x HistoryOperation) Enum() *HistoryOperation {
+	p := new(HistoryOperation)
+	*p = x
+	return p
+}
+
+func (x HistoryOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistoryOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_oniongo_v1_history_proto_enumTypes[0].Descriptor()
+}
+
+func (HistoryOperation) Type() protoreflect.EnumType {
+	return &file_oniongo_v1_history_proto_enumTypes[0]
+}
+
+func (x HistoryOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoryOperation.Descriptor instead.
+func (HistoryOperation) EnumDescriptor() ([]byte, []int) {
+	return file_oniongo_v1_history_proto_rawDescGZIP(), []int{0}
+}
+
+// FieldChange is the value of a field of a todo before and after a mutation.
+// Empty values mean the field was not set. Lists of IDs are sorted and
+// separated by commas, and times are formatted in RFC 3339.
+type FieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// title, body, status, status_id, parent_id, project_id, tag_ids, blocker_ids or completed_at
+	Field         string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_oniongo_v1_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// HistoryEntry is the immutable record of a mutation of a todo item
+type HistoryEntry struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TodoId string                 `protobuf:"bytes,2,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	// The project the todo belonged to
+	ProjectId *string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	// Who made the mutation, taken from the X-Actor request header
+	Actor      string           `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Operation  HistoryOperation `protobuf:"varint,5,opt,name=operation,proto3,enum=oniongo.v1.HistoryOperation" json:"operation,omitempty"`
+	Changes    []*FieldChange   `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	OccurredAt int64            `protobuf:"varint,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Version of the todo the mutation created, counting from 1
+	Version       int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryEntry) Reset() {
+	*x = HistoryEntry{}
+	mi := &file_oniongo_v1_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryEntry) ProtoMessage() {}
+
+func (x *HistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryEntry.ProtoReflect.Descriptor instead.
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HistoryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetTodoId() string {
+	if x != nil {
+		return x.TodoId
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *HistoryEntry) GetOperation() HistoryOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return HistoryOperation_HISTORY_OPERATION_UNSPECIFIED
+}
+
+func (x *HistoryEntry) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *HistoryEntry) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *HistoryEntry) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetTodoHistoryRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TodoId string                 `protobuf:"bytes,1,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	// Defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodoHistoryRequest) Reset() {
+	*x = GetTodoHistoryRequest{}
+	mi := &file_oniongo_v1_history_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodoHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodoHistoryRequest) ProtoMessage() {}
+
+func (x *GetTodoHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_history_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodoHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTodoHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTodoHistoryRequest) GetTodoId() string {
+	if x != nil {
+		return x.TodoId
+	}
+	return ""
+}
+
+func (x *GetTodoHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTodoHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetTodoHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first
+	Entries []*HistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodoHistoryResponse) Reset() {
+	*x = GetTodoHistoryResponse{}
+	mi := &file_oniongo_v1_history_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodoHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodoHistoryResponse) ProtoMessage() {}
+
+func (x *GetTodoHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_history_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodoHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTodoHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_history_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTodoHistoryResponse) GetEntries() []*HistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetTodoHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListActivityRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Defaults to 50
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityRequest) Reset() {
+	*x = ListActivityRequest{}
+	mi := &file_oniongo_v1_history_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityRequest) ProtoMessage() {}
+
+func (x *ListActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_history_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListActivityRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_history_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListActivityRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListActivityRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListActivityRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first
+	Entries []*HistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityResponse) Reset() {
+	*x = ListActivityResponse{}
+	mi := &file_oniongo_v1_history_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityResponse) ProtoMessage() {}
+
+func (x *ListActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_history_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListActivityResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_history_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListActivityResponse) GetEntries() []*HistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListActivityResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_oniongo_v1_history_proto protoreflect.FileDescriptor
+
+const file_oniongo_v1_history_proto_rawDesc = "" +
+	"\n" +
+	"\x18oniongo/v1/history.proto\x12\n" +
+	"oniongo.v1\x1a\x1bbuf/validate/validate.proto\"Q\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xaa\x02\n" +
+	"\fHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atodo_id\x18\x02 \x01(\tR\x06todoId\x12\"\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tH\x00R\tprojectId\x88\x01\x01\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12:\n" +
+	"\toperation\x18\x05 \x01(\x0e2\x1c.oniongo.v1.HistoryOperationR\toperation\x121\n" +
+	"\achanges\x18\x06 \x03(\v2\x17.oniongo.v1.FieldChangeR\achanges\x12\x1f\n" +
+	"\voccurred_at\x18\a \x01(\x03R\n" +
+	"occurredAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversionB\r\n" +
+	"\v_project_id\"\x81\x01\n" +
+	"\x15GetTodoHistoryRequest\x12!\n" +
+	"\atodo_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06todoId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"t\n" +
+	"\x16GetTodoHistoryResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.oniongo.v1.HistoryEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x85\x01\n" +
+	"\x13ListActivityRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"r\n" +
+	"\x14ListActivityResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.oniongo.v1.HistoryEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*\x8f\x01\n" +
+	"\x10HistoryOperation\x12!\n" +
+	"\x1dHISTORY_OPERATION_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18HISTORY_OPERATION_CREATE\x10\x01\x12\x1c\n" +
+	"\x18HISTORY_OPERATION_UPDATE\x10\x02\x12\x1c\n" +
+	"\x18HISTORY_OPERATION_DELETE\x10\x032\xbc\x01\n" +
+	"\x0eHistoryService\x12W\n" +
+	"\x0eGetTodoHistory\x12!.oniongo.v1.GetTodoHistoryRequest\x1a\".oniongo.v1.GetTodoHistoryResponse\x12Q\n" +
+	"\fListActivity\x12\x1f.oniongo.v1.ListActivityRequest\x1a .oniongo.v1.ListActivityResponseB\xb1\x01\n" +
+	"\x0ecom.oniongo.v1B\fHistoryProtoP\x01ZHgithub.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1;oniongov1\xa2\x02\x03OXX\xaa\x02\n" +
+	"Oniongo.V1\xca\x02\n" +
+	"Oniongo\\V1\xe2\x02\x16Oniongo\\V1\\GPBMetadata\xea\x02\vOniongo::V1b\x06proto3"
+
+var (
+	file_oniongo_v1_history_proto_rawDescOnce sync.Once
+	file_oniongo_v1_history_proto_rawDescData []byte
+)
+
+func file_oniongo_v1_history_proto_rawDescGZIP() []byte {
+	file_oniongo_v1_history_proto_rawDescOnce.Do(func() {
+		file_oniongo_v1_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_oniongo_v1_history_proto_rawDesc), len(file_oniongo_v1_history_proto_rawDesc)))
+	})
+	return file_oniongo_v1_history_proto_rawDescData
+}
+
+var file_oniongo_v1_history_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_oniongo_v1_history_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_oniongo_v1_history_proto_goTypes = []any{
+	(HistoryOperation)(0),          // 0: oniongo.v1.HistoryOperation
+	(*FieldChange)(nil),            // 1: oniongo.v1.FieldChange
+	(*HistoryEntry)(nil),           // 2: oniongo.v1.HistoryEntry
+	(*GetTodoHistoryRequest)(nil),  // 3: oniongo.v1.GetTodoHistoryRequest
+	(*GetTodoHistoryResponse)(nil), // 4: oniongo.v1.GetTodoHistoryResponse
+	(*ListActivityRequest)(nil),    // 5: oniongo.v1.ListActivityRequest
+	(*ListActivityResponse)(nil),   // 6: oniongo.v1.ListActivityResponse
+}
+var file_oniongo_v1_history_proto_depIdxs = []int32{
+	0, // 0: oniongo.v1.HistoryEntry.operation:type_name -> oniongo.v1.HistoryOperation
+	1, // 1: oniongo.v1.HistoryEntry.changes:type_name -> oniongo.v1.FieldChange
+	2, // 2: oniongo.v1.GetTodoHistoryResponse.entries:type_name -> oniongo.v1.HistoryEntry
+	2, // 3: oniongo.v1.ListActivityResponse.entries:type_name -> oniongo.v1.HistoryEntry
+	3, // 4: oniongo.v1.HistoryService.GetTodoHistory:input_type -> oniongo.v1.GetTodoHistoryRequest
+	5, // 5: oniongo.v1.HistoryService.ListActivity:input_type -> oniongo.v1.ListActivityRequest
+	4, // 6: oniongo.v1.HistoryService.GetTodoHistory:output_type -> oniongo.v1.GetTodoHistoryResponse
+	6, // 7: oniongo.v1.HistoryService.ListActivity:output_type -> oniongo.v1.ListActivityResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_oniongo_v1_history_proto_init() }
+func file_oniongo_v1_history_proto_init() {
+	if File_oniongo_v1_history_proto != nil {
+		return
+	}
+	file_oniongo_v1_history_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_oniongo_v1_history_proto_rawDesc), len(file_oniongo_v1_history_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_oniongo_v1_history_proto_goTypes,
+		DependencyIndexes: file_oniongo_v1_history_proto_depIdxs,
+		EnumInfos:         file_oniongo_v1_history_proto_enumTypes,
+		MessageInfos:      file_oniongo_v1_history_proto_msgTypes,
+	}.Build()
+	File_oniongo_v1_history_proto = out.File
+	file_oniongo_v1_history_proto_goTypes = nil
+	file_oniongo_v1_history_proto_depIdxs = nil
+}
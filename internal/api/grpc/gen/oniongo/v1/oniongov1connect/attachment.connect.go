@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: oniongo/v1/attachment.proto
+
+package oniongov1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AttachmentServiceName is the fully-qualified name of the AttachmentService service.
+	AttachmentServiceName = "oniongo.v1.AttachmentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AttachmentServiceUploadAttachmentProcedure is the fully-qualified name of the AttachmentService's
+	// UploadAttachment RPC.
+	AttachmentServiceUploadAttachmentProcedure = "/oniongo.v1.AttachmentService/UploadAttachment"
+	// AttachmentServiceDownloadAttachmentProcedure is the fully-qualified name of the
+	// AttachmentService's DownloadAttachment RPC.
+	AttachmentServiceDownloadAttachmentProcedure = "/oniongo.v1.AttachmentService/DownloadAttachment"
+	// AttachmentServiceListAttachmentsProcedure is the fully-qualified name of the AttachmentService's
+	// ListAttachments RPC.
+	AttachmentServiceListAttachmentsProcedure = "/oniongo.v1.AttachmentService/ListAttachments"
+	// AttachmentServiceDeleteAttachmentProcedure is the fully-qualified name of the AttachmentService's
+	// DeleteAttachment RPC.
+	AttachmentServiceDeleteAttachmentProcedure = "/oniongo.v1.AttachmentService/DeleteAttachment"
+)
+
+// AttachmentServiceClient is a client for the oniongo.v1.AttachmentService service.
+type AttachmentServiceClient interface {
+	// UploadAttachment attaches a file to a todo item.
+	// The content is streamed, so files may be larger than a single message.
+	UploadAttachment(context.Context) *connect.ClientStreamForClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
+	// DownloadAttachment streams the content of an attachment
+	DownloadAttachment(context.Context, *connect.Request[v1.DownloadAttachmentRequest]) (*connect.ServerStreamForClient[v1.DownloadAttachmentResponse], error)
+	// ListAttachments retrieves the attachments of a todo item
+	ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error)
+	// DeleteAttachment deletes an attachment and its content
+	DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error)
+}
+
+// NewAttachmentServiceClient constructs a client for the oniongo.v1.AttachmentService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAttachmentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AttachmentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	attachmentServiceMethods := v1.File_oniongo_v1_attachment_proto.Services().ByName("AttachmentService").Methods()
+	return &attachmentServiceClient{
+		uploadAttachment: connect.NewClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse](
+			httpClient,
+			baseURL+AttachmentServiceUploadAttachmentProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("UploadAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		downloadAttachment: connect.NewClient[v1.DownloadAttachmentRequest, v1.DownloadAttachmentResponse](
+			httpClient,
+			baseURL+AttachmentServiceDownloadAttachmentProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("DownloadAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+		listAttachments: connect.NewClient[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse](
+			httpClient,
+			baseURL+AttachmentServiceListAttachmentsProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("ListAttachments")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAttachment: connect.NewClient[v1.DeleteAttachmentRequest, v1.DeleteAttachmentResponse](
+			httpClient,
+			baseURL+AttachmentServiceDeleteAttachmentProcedure,
+			connect.WithSchema(attachmentServiceMethods.ByName("DeleteAttachment")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// attachmentServiceClient implements AttachmentServiceClient.
+type attachmentServiceClient struct {
+	uploadAttachment   *connect.Client[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse]
+	downloadAttachment *connect.Client[v1.DownloadAttachmentRequest, v1.DownloadAttachmentResponse]
+	listAttachments    *connect.Client[v1.ListAttachmentsRequest, v1.ListAttachmentsResponse]
+	deleteAttachment   *connect.Client[v1.DeleteAttachmentRequest, v1.DeleteAttachmentResponse]
+}
+
+// UploadAttachment calls oniongo.v1.AttachmentService.UploadAttachment.
+func (c *attachmentServiceClient) UploadAttachment(ctx context.Context) *connect.ClientStreamForClient[v1.UploadAttachmentRequest, v1.UploadAttachmentResponse] {
+	return c.uploadAttachment.CallClientStream(ctx)
+}
+
+// DownloadAttachment calls oniongo.v1.AttachmentService.DownloadAttachment.
+func (c *attachmentServiceClient) DownloadAttachment(ctx context.Context, req *connect.Request[v1.DownloadAttachmentRequest]) (*connect.ServerStreamForClient[v1.DownloadAttachmentResponse], error) {
+	return c.downloadAttachment.CallServerStream(ctx, req)
+}
+
+// ListAttachments calls oniongo.v1.AttachmentService.ListAttachments.
+func (c *attachmentServiceClient) ListAttachments(ctx context.Context, req *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
+	return c.listAttachments.CallUnary(ctx, req)
+}
+
+// DeleteAttachment calls oniongo.v1.AttachmentService.DeleteAttachment.
+func (c *attachmentServiceClient) DeleteAttachment(ctx context.Context, req *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error) {
+	return c.deleteAttachment.CallUnary(ctx, req)
+}
+
+// AttachmentServiceHandler is an implementation of the oniongo.v1.AttachmentService service.
+type AttachmentServiceHandler interface {
+	// UploadAttachment attaches a file to a todo item.
+	// The content is streamed, so files may be larger than a single message.
+	UploadAttachment(context.Context, *connect.ClientStream[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error)
+	// DownloadAttachment streams the content of an attachment
+	DownloadAttachment(context.Context, *connect.Request[v1.DownloadAttachmentRequest], *connect.ServerStream[v1.DownloadAttachmentResponse]) error
+	// ListAttachments retrieves the attachments of a todo item
+	ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error)
+	// DeleteAttachment deletes an attachment and its content
+	DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error)
+}
+
+// NewAttachmentServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAttachmentServiceHandler(svc AttachmentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	attachmentServiceMethods := v1.File_oniongo_v1_attachment_proto.Services().ByName("AttachmentService").Methods()
+	attachmentServiceUploadAttachmentHandler := connect.NewClientStreamHandler(
+		AttachmentServiceUploadAttachmentProcedure,
+		svc.UploadAttachment,
+		connect.WithSchema(attachmentServiceMethods.ByName("UploadAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceDownloadAttachmentHandler := connect.NewServerStreamHandler(
+		AttachmentServiceDownloadAttachmentProcedure,
+		svc.DownloadAttachment,
+		connect.WithSchema(attachmentServiceMethods.ByName("DownloadAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceListAttachmentsHandler := connect.NewUnaryHandler(
+		AttachmentServiceListAttachmentsProcedure,
+		svc.ListAttachments,
+		connect.WithSchema(attachmentServiceMethods.ByName("ListAttachments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceDeleteAttachmentHandler := connect.NewUnaryHandler(
+		AttachmentServiceDeleteAttachmentProcedure,
+		svc.DeleteAttachment,
+		connect.WithSchema(attachmentServiceMethods.ByName("DeleteAttachment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/oniongo.v1.AttachmentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AttachmentServiceUploadAttachmentProcedure:
+			attachmentServiceUploadAttachmentHandler.ServeHTTP(w, r)
+		case AttachmentServiceDownloadAttachmentProcedure:
+			attachmentServiceDownloadAttachmentHandler.ServeHTTP(w, r)
+		case AttachmentServiceListAttachmentsProcedure:
+			attachmentServiceListAttachmentsHandler.ServeHTTP(w, r)
+		case AttachmentServiceDeleteAttachmentProcedure:
+			attachmentServiceDeleteAttachmentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAttachmentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAttachmentServiceHandler struct{}
+
+func (UnimplementedAttachmentServiceHandler) UploadAttachment(context.Context, *connect.ClientStream[v1.UploadAttachmentRequest]) (*connect.Response[v1.UploadAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.AttachmentService.UploadAttachment is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) DownloadAttachment(context.Context, *connect.Request[v1.DownloadAttachmentRequest], *connect.ServerStream[v1.DownloadAttachmentResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.AttachmentService.DownloadAttachment is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) ListAttachments(context.Context, *connect.Request[v1.ListAttachmentsRequest]) (*connect.Response[v1.ListAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.AttachmentService.ListAttachments is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) DeleteAttachment(context.Context, *connect.Request[v1.DeleteAttachmentRequest]) (*connect.Response[v1.DeleteAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.AttachmentService.DeleteAttachment is not implemented"))
+}
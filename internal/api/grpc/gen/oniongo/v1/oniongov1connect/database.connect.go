@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: oniongo/v1/database.proto
+
+package oniongov1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DatabaseServiceName is the fully-qualified name of the DatabaseService service.
+	DatabaseServiceName = "oniongo.v1.DatabaseService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DatabaseServiceBackupDatabaseProcedure is the fully-qualified name of the DatabaseService's
+	// BackupDatabase RPC.
+	DatabaseServiceBackupDatabaseProcedure = "/oniongo.v1.DatabaseService/BackupDatabase"
+)
+
+// DatabaseServiceClient is a client for the oniongo.v1.DatabaseService service.
+type DatabaseServiceClient interface {
+	// BackupDatabase streams a consistent snapshot of the database, taken while the server runs.
+	// The content is a SQLite database file.
+	BackupDatabase(context.Context, *connect.Request[v1.BackupDatabaseRequest]) (*connect.ServerStreamForClient[v1.BackupDatabaseResponse], error)
+}
+
+// NewDatabaseServiceClient constructs a client for the oniongo.v1.DatabaseService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DatabaseServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	databaseServiceMethods := v1.File_oniongo_v1_database_proto.Services().ByName("DatabaseService").Methods()
+	return &databaseServiceClient{
+		backupDatabase: connect.NewClient[v1.BackupDatabaseRequest, v1.BackupDatabaseResponse](
+			httpClient,
+			baseURL+DatabaseServiceBackupDatabaseProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("BackupDatabase")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// databaseServiceClient implements DatabaseServiceClient.
+type databaseServiceClient struct {
+	backupDatabase *connect.Client[v1.BackupDatabaseRequest, v1.BackupDatabaseResponse]
+}
+
+// BackupDatabase calls oniongo.v1.DatabaseService.BackupDatabase.
+func (c *databaseServiceClient) BackupDatabase(ctx context.Context, req *connect.Request[v1.BackupDatabaseRequest]) (*connect.ServerStreamForClient[v1.BackupDatabaseResponse], error) {
+	return c.backupDatabase.CallServerStream(ctx, req)
+}
+
+// DatabaseServiceHandler is an implementation of the oniongo.v1.DatabaseService service.
+type DatabaseServiceHandler interface {
+	// BackupDatabase streams a consistent snapshot of the database, taken while the server runs.
+	// The content is a SQLite database file.
+	BackupDatabase(context.Context, *connect.Request[v1.BackupDatabaseRequest], *connect.ServerStream[v1.BackupDatabaseResponse]) error
+}
+
+// NewDatabaseServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	databaseServiceMethods := v1.File_oniongo_v1_database_proto.Services().ByName("DatabaseService").Methods()
+	databaseServiceBackupDatabaseHandler := connect.NewServerStreamHandler(
+		DatabaseServiceBackupDatabaseProcedure,
+		svc.BackupDatabase,
+		connect.WithSchema(databaseServiceMethods.ByName("BackupDatabase")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/oniongo.v1.DatabaseService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DatabaseServiceBackupDatabaseProcedure:
+			databaseServiceBackupDatabaseHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDatabaseServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDatabaseServiceHandler struct{}
+
+func (UnimplementedDatabaseServiceHandler) BackupDatabase(context.Context, *connect.Request[v1.BackupDatabaseRequest], *connect.ServerStream[v1.BackupDatabaseResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.DatabaseService.BackupDatabase is not implemented"))
+}
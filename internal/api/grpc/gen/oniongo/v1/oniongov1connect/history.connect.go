@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: oniongo/v1/history.proto
+
+package oniongov1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// HistoryServiceName is the fully-qualified name of the HistoryService service.
+	HistoryServiceName = "oniongo.v1.HistoryService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// HistoryServiceGetTodoHistoryProcedure is the fully-qualified name of the HistoryService's
+	// GetTodoHistory RPC.
+	HistoryServiceGetTodoHistoryProcedure = "/oniongo.v1.HistoryService/GetTodoHistory"
+	// HistoryServiceListActivityProcedure is the fully-qualified name of the HistoryService's
+	// ListActivity RPC.
+	HistoryServiceListActivityProcedure = "/oniongo.v1.HistoryService/ListActivity"
+)
+
+// HistoryServiceClient is a client for the oniongo.v1.HistoryService service.
+type HistoryServiceClient interface {
+	// GetTodoHistory retrieves the history of a todo item, including a deleted one
+	GetTodoHistory(context.Context, *connect.Request[v1.GetTodoHistoryRequest]) (*connect.Response[v1.GetTodoHistoryResponse], error)
+	// ListActivity retrieves the history of all todo items of a project
+	ListActivity(context.Context, *connect.Request[v1.ListActivityRequest]) (*connect.Response[v1.ListActivityResponse], error)
+}
+
+// NewHistoryServiceClient constructs a client for the oniongo.v1.HistoryService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewHistoryServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) HistoryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	historyServiceMethods := v1.File_oniongo_v1_history_proto.Services().ByName("HistoryService").Methods()
+	return &historyServiceClient{
+		getTodoHistory: connect.NewClient[v1.GetTodoHistoryRequest, v1.GetTodoHistoryResponse](
+			httpClient,
+			baseURL+HistoryServiceGetTodoHistoryProcedure,
+			connect.WithSchema(historyServiceMethods.ByName("GetTodoHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		listActivity: connect.NewClient[v1.ListActivityRequest, v1.ListActivityResponse](
+			httpClient,
+			baseURL+HistoryServiceListActivityProcedure,
+			connect.WithSchema(historyServiceMethods.ByName("ListActivity")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// historyServiceClient implements HistoryServiceClient.
+type historyServiceClient struct {
+	getTodoHistory *connect.Client[v1.GetTodoHistoryRequest, v1.GetTodoHistoryResponse]
+	listActivity   *connect.Client[v1.ListActivityRequest, v1.ListActivityResponse]
+}
+
+// GetTodoHistory calls oniongo.v1.HistoryService.GetTodoHistory.
+func (c *historyServiceClient) GetTodoHistory(ctx context.Context, req *connect.Request[v1.GetTodoHistoryRequest]) (*connect.Response[v1.GetTodoHistoryResponse], error) {
+	return c.getTodoHistory.CallUnary(ctx, req)
+}
+
+// ListActivity calls oniongo.v1.HistoryService.ListActivity.
+func (c *historyServiceClient) ListActivity(ctx context.Context, req *connect.Request[v1.ListActivityRequest]) (*connect.Response[v1.ListActivityResponse], error) {
+	return c.listActivity.CallUnary(ctx, req)
+}
+
+// HistoryServiceHandler is an implementation of the oniongo.v1.HistoryService service.
+type HistoryServiceHandler interface {
+	// GetTodoHistory retrieves the history of a todo item, including a deleted one
+	GetTodoHistory(context.Context, *connect.Request[v1.GetTodoHistoryRequest]) (*connect.Response[v1.GetTodoHistoryResponse], error)
+	// ListActivity retrieves the history of all todo items of a project
+	ListActivity(context.Context, *connect.Request[v1.ListActivityRequest]) (*connect.Response[v1.ListActivityResponse], error)
+}
+
+// NewHistoryServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewHistoryServiceHandler(svc HistoryServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	historyServiceMethods := v1.File_oniongo_v1_history_proto.Services().ByName("HistoryService").Methods()
+	historyServiceGetTodoHistoryHandler := connect.NewUnaryHandler(
+		HistoryServiceGetTodoHistoryProcedure,
+		svc.GetTodoHistory,
+		connect.WithSchema(historyServiceMethods.ByName("GetTodoHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	historyServiceListActivityHandler := connect.NewUnaryHandler(
+		HistoryServiceListActivityProcedure,
+		svc.ListActivity,
+		connect.WithSchema(historyServiceMethods.ByName("ListActivity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/oniongo.v1.HistoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case HistoryServiceGetTodoHistoryProcedure:
+			historyServiceGetTodoHistoryHandler.ServeHTTP(w, r)
+		case HistoryServiceListActivityProcedure:
+			historyServiceListActivityHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedHistoryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedHistoryServiceHandler struct{}
+
+func (UnimplementedHistoryServiceHandler) GetTodoHistory(context.Context, *connect.Request[v1.GetTodoHistoryRequest]) (*connect.Response[v1.GetTodoHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.HistoryService.GetTodoHistory is not implemented"))
+}
+
+func (UnimplementedHistoryServiceHandler) ListActivity(context.Context, *connect.Request[v1.ListActivityRequest]) (*connect.Response[v1.ListActivityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.HistoryService.ListActivity is not implemented"))
+}
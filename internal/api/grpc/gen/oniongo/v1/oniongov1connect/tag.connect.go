@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: oniongo/v1/tag.proto
+
+package oniongov1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TagServiceName is the fully-qualified name of the TagService service.
+	TagServiceName = "oniongo.v1.TagService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TagServiceCreateTagProcedure is the fully-qualified name of the TagService's CreateTag RPC.
+	TagServiceCreateTagProcedure = "/oniongo.v1.TagService/CreateTag"
+	// TagServiceListTagsProcedure is the fully-qualified name of the TagService's ListTags RPC.
+	TagServiceListTagsProcedure = "/oniongo.v1.TagService/ListTags"
+	// TagServiceRenameTagProcedure is the fully-qualified name of the TagService's RenameTag RPC.
+	TagServiceRenameTagProcedure = "/oniongo.v1.TagService/RenameTag"
+	// TagServiceDeleteTagProcedure is the fully-qualified name of the TagService's DeleteTag RPC.
+	TagServiceDeleteTagProcedure = "/oniongo.v1.TagService/DeleteTag"
+)
+
+// TagServiceClient is a client for the oniongo.v1.TagService service.
+type TagServiceClient interface {
+	// CreateTag creates a new tag
+	CreateTag(context.Context, *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error)
+	// ListTags retrieves all tags
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+	// RenameTag changes the name of a tag
+	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
+	// DeleteTag deletes a tag and detaches it from all todo items
+	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+}
+
+// NewTagServiceClient constructs a client for the oniongo.v1.TagService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTagServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TagServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tagServiceMethods := v1.File_oniongo_v1_tag_proto.Services().ByName("TagService").Methods()
+	return &tagServiceClient{
+		createTag: connect.NewClient[v1.CreateTagRequest, v1.CreateTagResponse](
+			httpClient,
+			baseURL+TagServiceCreateTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("CreateTag")),
+			connect.WithClientOptions(opts...),
+		),
+		listTags: connect.NewClient[v1.ListTagsRequest, v1.ListTagsResponse](
+			httpClient,
+			baseURL+TagServiceListTagsProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("ListTags")),
+			connect.WithClientOptions(opts...),
+		),
+		renameTag: connect.NewClient[v1.RenameTagRequest, v1.RenameTagResponse](
+			httpClient,
+			baseURL+TagServiceRenameTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("RenameTag")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTag: connect.NewClient[v1.DeleteTagRequest, v1.DeleteTagResponse](
+			httpClient,
+			baseURL+TagServiceDeleteTagProcedure,
+			connect.WithSchema(tagServiceMethods.ByName("DeleteTag")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tagServiceClient implements TagServiceClient.
+type tagServiceClient struct {
+	createTag *connect.Client[v1.CreateTagRequest, v1.CreateTagResponse]
+	listTags  *connect.Client[v1.ListTagsRequest, v1.ListTagsResponse]
+	renameTag *connect.Client[v1.RenameTagRequest, v1.RenameTagResponse]
+	deleteTag *connect.Client[v1.DeleteTagRequest, v1.DeleteTagResponse]
+}
+
+// CreateTag calls oniongo.v1.TagService.CreateTag.
+func (c *tagServiceClient) CreateTag(ctx context.Context, req *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error) {
+	return c.createTag.CallUnary(ctx, req)
+}
+
+// ListTags calls oniongo.v1.TagService.ListTags.
+func (c *tagServiceClient) ListTags(ctx context.Context, req *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return c.listTags.CallUnary(ctx, req)
+}
+
+// RenameTag calls oniongo.v1.TagService.RenameTag.
+func (c *tagServiceClient) RenameTag(ctx context.Context, req *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error) {
+	return c.renameTag.CallUnary(ctx, req)
+}
+
+// DeleteTag calls oniongo.v1.TagService.DeleteTag.
+func (c *tagServiceClient) DeleteTag(ctx context.Context, req *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error) {
+	return c.deleteTag.CallUnary(ctx, req)
+}
+
+// TagServiceHandler is an implementation of the oniongo.v1.TagService service.
+type TagServiceHandler interface {
+	// CreateTag creates a new tag
+	CreateTag(context.Context, *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error)
+	// ListTags retrieves all tags
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+	// RenameTag changes the name of a tag
+	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
+	// DeleteTag deletes a tag and detaches it from all todo items
+	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+}
+
+// NewTagServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTagServiceHandler(svc TagServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tagServiceMethods := v1.File_oniongo_v1_tag_proto.Services().ByName("TagService").Methods()
+	tagServiceCreateTagHandler := connect.NewUnaryHandler(
+		TagServiceCreateTagProcedure,
+		svc.CreateTag,
+		connect.WithSchema(tagServiceMethods.ByName("CreateTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceListTagsHandler := connect.NewUnaryHandler(
+		TagServiceListTagsProcedure,
+		svc.ListTags,
+		connect.WithSchema(tagServiceMethods.ByName("ListTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceRenameTagHandler := connect.NewUnaryHandler(
+		TagServiceRenameTagProcedure,
+		svc.RenameTag,
+		connect.WithSchema(tagServiceMethods.ByName("RenameTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tagServiceDeleteTagHandler := connect.NewUnaryHandler(
+		TagServiceDeleteTagProcedure,
+		svc.DeleteTag,
+		connect.WithSchema(tagServiceMethods.ByName("DeleteTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/oniongo.v1.TagService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TagServiceCreateTagProcedure:
+			tagServiceCreateTagHandler.ServeHTTP(w, r)
+		case TagServiceListTagsProcedure:
+			tagServiceListTagsHandler.ServeHTTP(w, r)
+		case TagServiceRenameTagProcedure:
+			tagServiceRenameTagHandler.ServeHTTP(w, r)
+		case TagServiceDeleteTagProcedure:
+			tagServiceDeleteTagHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTagServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTagServiceHandler struct{}
+
+func (UnimplementedTagServiceHandler) CreateTag(context.Context, *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TagService.CreateTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TagService.ListTags is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TagService.RenameTag is not implemented"))
+}
+
+func (UnimplementedTagServiceHandler) DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TagService.DeleteTag is not implemented"))
+}
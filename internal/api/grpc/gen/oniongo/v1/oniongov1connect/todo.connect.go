@@ -39,6 +39,8 @@ const (
 	TodoServiceGetTodoProcedure = "/oniongo.v1.TodoService/GetTodo"
 	// TodoServiceGetTodosProcedure is the fully-qualified name of the TodoService's GetTodos RPC.
 	TodoServiceGetTodosProcedure = "/oniongo.v1.TodoService/GetTodos"
+	// TodoServiceSearchTodosProcedure is the fully-qualified name of the TodoService's SearchTodos RPC.
+	TodoServiceSearchTodosProcedure = "/oniongo.v1.TodoService/SearchTodos"
 	// TodoServiceUpdateTodoProcedure is the fully-qualified name of the TodoService's UpdateTodo RPC.
 	TodoServiceUpdateTodoProcedure = "/oniongo.v1.TodoService/UpdateTodo"
 	// TodoServiceStartTodoProcedure is the fully-qualified name of the TodoService's StartTodo RPC.
@@ -46,26 +48,84 @@ const (
 	// TodoServiceCompleteTodoProcedure is the fully-qualified name of the TodoService's CompleteTodo
 	// RPC.
 	TodoServiceCompleteTodoProcedure = "/oniongo.v1.TodoService/CompleteTodo"
+	// TodoServiceReopenTodoProcedure is the fully-qualified name of the TodoService's ReopenTodo RPC.
+	TodoServiceReopenTodoProcedure = "/oniongo.v1.TodoService/ReopenTodo"
+	// TodoServiceCancelTodoProcedure is the fully-qualified name of the TodoService's CancelTodo RPC.
+	TodoServiceCancelTodoProcedure = "/oniongo.v1.TodoService/CancelTodo"
+	// TodoServicePauseTodoProcedure is the fully-qualified name of the TodoService's PauseTodo RPC.
+	TodoServicePauseTodoProcedure = "/oniongo.v1.TodoService/PauseTodo"
+	// TodoServiceResumeTodoProcedure is the fully-qualified name of the TodoService's ResumeTodo RPC.
+	TodoServiceResumeTodoProcedure = "/oniongo.v1.TodoService/ResumeTodo"
+	// TodoServiceTransitionTodoProcedure is the fully-qualified name of the TodoService's
+	// TransitionTodo RPC.
+	TodoServiceTransitionTodoProcedure = "/oniongo.v1.TodoService/TransitionTodo"
+	// TodoServiceRevertTodoProcedure is the fully-qualified name of the TodoService's RevertTodo RPC.
+	TodoServiceRevertTodoProcedure = "/oniongo.v1.TodoService/RevertTodo"
 	// TodoServiceDeleteTodoProcedure is the fully-qualified name of the TodoService's DeleteTodo RPC.
 	TodoServiceDeleteTodoProcedure = "/oniongo.v1.TodoService/DeleteTodo"
+	// TodoServiceAddTodoTagProcedure is the fully-qualified name of the TodoService's AddTodoTag RPC.
+	TodoServiceAddTodoTagProcedure = "/oniongo.v1.TodoService/AddTodoTag"
+	// TodoServiceRemoveTodoTagProcedure is the fully-qualified name of the TodoService's RemoveTodoTag
+	// RPC.
+	TodoServiceRemoveTodoTagProcedure = "/oniongo.v1.TodoService/RemoveTodoTag"
+	// TodoServiceMoveTodoProcedure is the fully-qualified name of the TodoService's MoveTodo RPC.
+	TodoServiceMoveTodoProcedure = "/oniongo.v1.TodoService/MoveTodo"
+	// TodoServiceAddDependencyProcedure is the fully-qualified name of the TodoService's AddDependency
+	// RPC.
+	TodoServiceAddDependencyProcedure = "/oniongo.v1.TodoService/AddDependency"
+	// TodoServiceRemoveDependencyProcedure is the fully-qualified name of the TodoService's
+	// RemoveDependency RPC.
+	TodoServiceRemoveDependencyProcedure = "/oniongo.v1.TodoService/RemoveDependency"
 )
 
 // TodoServiceClient is a client for the oniongo.v1.TodoService service.
 type TodoServiceClient interface {
 	// CreateTodo creates a new todo item
 	CreateTodo(context.Context, *connect.Request[v1.CreateTodoRequest]) (*connect.Response[v1.CreateTodoResponse], error)
-	// GetTodo retrieves a todo item by its ID
+	// GetTodo retrieves a todo item by its ID, optionally with its subtree
 	GetTodo(context.Context, *connect.Request[v1.GetTodoRequest]) (*connect.Response[v1.GetTodoResponse], error)
-	// GetTodos retrieves all todo items
+	// GetTodos retrieves all todo items, optionally filtered by tags, parent, project or actionability
 	GetTodos(context.Context, *connect.Request[v1.GetTodosRequest]) (*connect.Response[v1.GetTodosResponse], error)
+	// SearchTodos finds todo items by full-text search over their titles and bodies
+	SearchTodos(context.Context, *connect.Request[v1.SearchTodosRequest]) (*connect.Response[v1.SearchTodosResponse], error)
 	// UpdateTodo updates an existing todo item
 	UpdateTodo(context.Context, *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error)
-	// StartTodo changes the todo status to in progress
+	// StartTodo changes the todo status from not started to in progress.
+	// It fails while any blocker is unfinished.
 	StartTodo(context.Context, *connect.Request[v1.StartTodoRequest]) (*connect.Response[v1.StartTodoResponse], error)
-	// CompleteTodo changes the todo status to completed
+	// CompleteTodo changes the todo status from in progress to completed.
+	// It fails while subtasks are open unless cascade is set,
+	// and while any blocker is unfinished.
 	CompleteTodo(context.Context, *connect.Request[v1.CompleteTodoRequest]) (*connect.Response[v1.CompleteTodoResponse], error)
+	// ReopenTodo changes a completed or cancelled todo back to not started
+	ReopenTodo(context.Context, *connect.Request[v1.ReopenTodoRequest]) (*connect.Response[v1.ReopenTodoResponse], error)
+	// CancelTodo changes an unfinished todo to cancelled
+	CancelTodo(context.Context, *connect.Request[v1.CancelTodoRequest]) (*connect.Response[v1.CancelTodoResponse], error)
+	// PauseTodo changes the todo status from in progress to on hold
+	PauseTodo(context.Context, *connect.Request[v1.PauseTodoRequest]) (*connect.Response[v1.PauseTodoResponse], error)
+	// ResumeTodo changes the todo status from on hold back to in progress.
+	// It fails while any blocker is unfinished.
+	ResumeTodo(context.Context, *connect.Request[v1.ResumeTodoRequest]) (*connect.Response[v1.ResumeTodoResponse], error)
+	// TransitionTodo moves a todo item to the given status.
+	// Todo items in a project can only follow the transitions of the project workflow.
+	// It fails while any blocker is unfinished unless the status is in the todo category.
+	TransitionTodo(context.Context, *connect.Request[v1.TransitionTodoRequest]) (*connect.Response[v1.TransitionTodoResponse], error)
+	// RevertTodo restores the title, body and status of a todo item from a version in its history.
+	// The restore is recorded as a new version, and the status must be reachable
+	// from the current status like in TransitionTodo.
+	RevertTodo(context.Context, *connect.Request[v1.RevertTodoRequest]) (*connect.Response[v1.RevertTodoResponse], error)
 	// DeleteTodo deletes a todo item
 	DeleteTodo(context.Context, *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error)
+	// AddTodoTag attaches a tag to a todo item
+	AddTodoTag(context.Context, *connect.Request[v1.AddTodoTagRequest]) (*connect.Response[v1.AddTodoTagResponse], error)
+	// RemoveTodoTag detaches a tag from a todo item
+	RemoveTodoTag(context.Context, *connect.Request[v1.RemoveTodoTagRequest]) (*connect.Response[v1.RemoveTodoTagResponse], error)
+	// MoveTodo re-parents a todo item together with its subtasks
+	MoveTodo(context.Context, *connect.Request[v1.MoveTodoRequest]) (*connect.Response[v1.MoveTodoResponse], error)
+	// AddDependency makes a todo item blocked by another todo item
+	AddDependency(context.Context, *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error)
+	// RemoveDependency removes a blocked-by relationship
+	RemoveDependency(context.Context, *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error)
 }
 
 // NewTodoServiceClient constructs a client for the oniongo.v1.TodoService service. By default, it
@@ -97,6 +157,12 @@ func NewTodoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(todoServiceMethods.ByName("GetTodos")),
 			connect.WithClientOptions(opts...),
 		),
+		searchTodos: connect.NewClient[v1.SearchTodosRequest, v1.SearchTodosResponse](
+			httpClient,
+			baseURL+TodoServiceSearchTodosProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("SearchTodos")),
+			connect.WithClientOptions(opts...),
+		),
 		updateTodo: connect.NewClient[v1.UpdateTodoRequest, v1.UpdateTodoResponse](
 			httpClient,
 			baseURL+TodoServiceUpdateTodoProcedure,
@@ -115,24 +181,102 @@ func NewTodoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(todoServiceMethods.ByName("CompleteTodo")),
 			connect.WithClientOptions(opts...),
 		),
+		reopenTodo: connect.NewClient[v1.ReopenTodoRequest, v1.ReopenTodoResponse](
+			httpClient,
+			baseURL+TodoServiceReopenTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ReopenTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelTodo: connect.NewClient[v1.CancelTodoRequest, v1.CancelTodoResponse](
+			httpClient,
+			baseURL+TodoServiceCancelTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("CancelTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseTodo: connect.NewClient[v1.PauseTodoRequest, v1.PauseTodoResponse](
+			httpClient,
+			baseURL+TodoServicePauseTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("PauseTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeTodo: connect.NewClient[v1.ResumeTodoRequest, v1.ResumeTodoResponse](
+			httpClient,
+			baseURL+TodoServiceResumeTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ResumeTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		transitionTodo: connect.NewClient[v1.TransitionTodoRequest, v1.TransitionTodoResponse](
+			httpClient,
+			baseURL+TodoServiceTransitionTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("TransitionTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		revertTodo: connect.NewClient[v1.RevertTodoRequest, v1.RevertTodoResponse](
+			httpClient,
+			baseURL+TodoServiceRevertTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("RevertTodo")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteTodo: connect.NewClient[v1.DeleteTodoRequest, v1.DeleteTodoResponse](
 			httpClient,
 			baseURL+TodoServiceDeleteTodoProcedure,
 			connect.WithSchema(todoServiceMethods.ByName("DeleteTodo")),
 			connect.WithClientOptions(opts...),
 		),
+		addTodoTag: connect.NewClient[v1.AddTodoTagRequest, v1.AddTodoTagResponse](
+			httpClient,
+			baseURL+TodoServiceAddTodoTagProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("AddTodoTag")),
+			connect.WithClientOptions(opts...),
+		),
+		removeTodoTag: connect.NewClient[v1.RemoveTodoTagRequest, v1.RemoveTodoTagResponse](
+			httpClient,
+			baseURL+TodoServiceRemoveTodoTagProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("RemoveTodoTag")),
+			connect.WithClientOptions(opts...),
+		),
+		moveTodo: connect.NewClient[v1.MoveTodoRequest, v1.MoveTodoResponse](
+			httpClient,
+			baseURL+TodoServiceMoveTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("MoveTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		addDependency: connect.NewClient[v1.AddDependencyRequest, v1.AddDependencyResponse](
+			httpClient,
+			baseURL+TodoServiceAddDependencyProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("AddDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		removeDependency: connect.NewClient[v1.RemoveDependencyRequest, v1.RemoveDependencyResponse](
+			httpClient,
+			baseURL+TodoServiceRemoveDependencyProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("RemoveDependency")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // todoServiceClient implements TodoServiceClient.
 type todoServiceClient struct {
-	createTodo   *connect.Client[v1.CreateTodoRequest, v1.CreateTodoResponse]
-	getTodo      *connect.Client[v1.GetTodoRequest, v1.GetTodoResponse]
-	getTodos     *connect.Client[v1.GetTodosRequest, v1.GetTodosResponse]
-	updateTodo   *connect.Client[v1.UpdateTodoRequest, v1.UpdateTodoResponse]
-	startTodo    *connect.Client[v1.StartTodoRequest, v1.StartTodoResponse]
-	completeTodo *connect.Client[v1.CompleteTodoRequest, v1.CompleteTodoResponse]
-	deleteTodo   *connect.Client[v1.DeleteTodoRequest, v1.DeleteTodoResponse]
+	createTodo       *connect.Client[v1.CreateTodoRequest, v1.CreateTodoResponse]
+	getTodo          *connect.Client[v1.GetTodoRequest, v1.GetTodoResponse]
+	getTodos         *connect.Client[v1.GetTodosRequest, v1.GetTodosResponse]
+	searchTodos      *connect.Client[v1.SearchTodosRequest, v1.SearchTodosResponse]
+	updateTodo       *connect.Client[v1.UpdateTodoRequest, v1.UpdateTodoResponse]
+	startTodo        *connect.Client[v1.StartTodoRequest, v1.StartTodoResponse]
+	completeTodo     *connect.Client[v1.CompleteTodoRequest, v1.CompleteTodoResponse]
+	reopenTodo       *connect.Client[v1.ReopenTodoRequest, v1.ReopenTodoResponse]
+	cancelTodo       *connect.Client[v1.CancelTodoRequest, v1.CancelTodoResponse]
+	pauseTodo        *connect.Client[v1.PauseTodoRequest, v1.PauseTodoResponse]
+	resumeTodo       *connect.Client[v1.ResumeTodoRequest, v1.ResumeTodoResponse]
+	transitionTodo   *connect.Client[v1.TransitionTodoRequest, v1.TransitionTodoResponse]
+	revertTodo       *connect.Client[v1.RevertTodoRequest, v1.RevertTodoResponse]
+	deleteTodo       *connect.Client[v1.DeleteTodoRequest, v1.DeleteTodoResponse]
+	addTodoTag       *connect.Client[v1.AddTodoTagRequest, v1.AddTodoTagResponse]
+	removeTodoTag    *connect.Client[v1.RemoveTodoTagRequest, v1.RemoveTodoTagResponse]
+	moveTodo         *connect.Client[v1.MoveTodoRequest, v1.MoveTodoResponse]
+	addDependency    *connect.Client[v1.AddDependencyRequest, v1.AddDependencyResponse]
+	removeDependency *connect.Client[v1.RemoveDependencyRequest, v1.RemoveDependencyResponse]
 }
 
 // CreateTodo calls oniongo.v1.TodoService.CreateTodo.
@@ -150,6 +294,11 @@ func (c *todoServiceClient) GetTodos(ctx context.Context, req *connect.Request[v
 	return c.getTodos.CallUnary(ctx, req)
 }
 
+// SearchTodos calls oniongo.v1.TodoService.SearchTodos.
+func (c *todoServiceClient) SearchTodos(ctx context.Context, req *connect.Request[v1.SearchTodosRequest]) (*connect.Response[v1.SearchTodosResponse], error) {
+	return c.searchTodos.CallUnary(ctx, req)
+}
+
 // UpdateTodo calls oniongo.v1.TodoService.UpdateTodo.
 func (c *todoServiceClient) UpdateTodo(ctx context.Context, req *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error) {
 	return c.updateTodo.CallUnary(ctx, req)
@@ -165,27 +314,114 @@ func (c *todoServiceClient) CompleteTodo(ctx context.Context, req *connect.Reque
 	return c.completeTodo.CallUnary(ctx, req)
 }
 
+// ReopenTodo calls oniongo.v1.TodoService.ReopenTodo.
+func (c *todoServiceClient) ReopenTodo(ctx context.Context, req *connect.Request[v1.ReopenTodoRequest]) (*connect.Response[v1.ReopenTodoResponse], error) {
+	return c.reopenTodo.CallUnary(ctx, req)
+}
+
+// CancelTodo calls oniongo.v1.TodoService.CancelTodo.
+func (c *todoServiceClient) CancelTodo(ctx context.Context, req *connect.Request[v1.CancelTodoRequest]) (*connect.Response[v1.CancelTodoResponse], error) {
+	return c.cancelTodo.CallUnary(ctx, req)
+}
+
+// PauseTodo calls oniongo.v1.TodoService.PauseTodo.
+func (c *todoServiceClient) PauseTodo(ctx context.Context, req *connect.Request[v1.PauseTodoRequest]) (*connect.Response[v1.PauseTodoResponse], error) {
+	return c.pauseTodo.CallUnary(ctx, req)
+}
+
+// ResumeTodo calls oniongo.v1.TodoService.ResumeTodo.
+func (c *todoServiceClient) ResumeTodo(ctx context.Context, req *connect.Request[v1.ResumeTodoRequest]) (*connect.Response[v1.ResumeTodoResponse], error) {
+	return c.resumeTodo.CallUnary(ctx, req)
+}
+
+// TransitionTodo calls oniongo.v1.TodoService.TransitionTodo.
+func (c *todoServiceClient) TransitionTodo(ctx context.Context, req *connect.Request[v1.TransitionTodoRequest]) (*connect.Response[v1.TransitionTodoResponse], error) {
+	return c.transitionTodo.CallUnary(ctx, req)
+}
+
+// RevertTodo calls oniongo.v1.TodoService.RevertTodo.
+func (c *todoServiceClient) RevertTodo(ctx context.Context, req *connect.Request[v1.RevertTodoRequest]) (*connect.Response[v1.RevertTodoResponse], error) {
+	return c.revertTodo.CallUnary(ctx, req)
+}
+
 // DeleteTodo calls oniongo.v1.TodoService.DeleteTodo.
 func (c *todoServiceClient) DeleteTodo(ctx context.Context, req *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error) {
 	return c.deleteTodo.CallUnary(ctx, req)
 }
 
+// AddTodoTag calls oniongo.v1.TodoService.AddTodoTag.
+func (c *todoServiceClient) AddTodoTag(ctx context.Context, req *connect.Request[v1.AddTodoTagRequest]) (*connect.Response[v1.AddTodoTagResponse], error) {
+	return c.addTodoTag.CallUnary(ctx, req)
+}
+
+// RemoveTodoTag calls oniongo.v1.TodoService.RemoveTodoTag.
+func (c *todoServiceClient) RemoveTodoTag(ctx context.Context, req *connect.Request[v1.RemoveTodoTagRequest]) (*connect.Response[v1.RemoveTodoTagResponse], error) {
+	return c.removeTodoTag.CallUnary(ctx, req)
+}
+
+// MoveTodo calls oniongo.v1.TodoService.MoveTodo.
+func (c *todoServiceClient) MoveTodo(ctx context.Context, req *connect.Request[v1.MoveTodoRequest]) (*connect.Response[v1.MoveTodoResponse], error) {
+	return c.moveTodo.CallUnary(ctx, req)
+}
+
+// AddDependency calls oniongo.v1.TodoService.AddDependency.
+func (c *todoServiceClient) AddDependency(ctx context.Context, req *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error) {
+	return c.addDependency.CallUnary(ctx, req)
+}
+
+// RemoveDependency calls oniongo.v1.TodoService.RemoveDependency.
+func (c *todoServiceClient) RemoveDependency(ctx context.Context, req *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error) {
+	return c.removeDependency.CallUnary(ctx, req)
+}
+
 // TodoServiceHandler is an implementation of the oniongo.v1.TodoService service.
 type TodoServiceHandler interface {
 	// CreateTodo creates a new todo item
 	CreateTodo(context.Context, *connect.Request[v1.CreateTodoRequest]) (*connect.Response[v1.CreateTodoResponse], error)
-	// GetTodo retrieves a todo item by its ID
+	// GetTodo retrieves a todo item by its ID, optionally with its subtree
 	GetTodo(context.Context, *connect.Request[v1.GetTodoRequest]) (*connect.Response[v1.GetTodoResponse], error)
-	// GetTodos retrieves all todo items
+	// GetTodos retrieves all todo items, optionally filtered by tags, parent, project or actionability
 	GetTodos(context.Context, *connect.Request[v1.GetTodosRequest]) (*connect.Response[v1.GetTodosResponse], error)
+	// SearchTodos finds todo items by full-text search over their titles and bodies
+	SearchTodos(context.Context, *connect.Request[v1.SearchTodosRequest]) (*connect.Response[v1.SearchTodosResponse], error)
 	// UpdateTodo updates an existing todo item
 	UpdateTodo(context.Context, *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error)
-	// StartTodo changes the todo status to in progress
+	// StartTodo changes the todo status from not started to in progress.
+	// It fails while any blocker is unfinished.
 	StartTodo(context.Context, *connect.Request[v1.StartTodoRequest]) (*connect.Response[v1.StartTodoResponse], error)
-	// CompleteTodo changes the todo status to completed
+	// CompleteTodo changes the todo status from in progress to completed.
+	// It fails while subtasks are open unless cascade is set,
+	// and while any blocker is unfinished.
 	CompleteTodo(context.Context, *connect.Request[v1.CompleteTodoRequest]) (*connect.Response[v1.CompleteTodoResponse], error)
+	// ReopenTodo changes a completed or cancelled todo back to not started
+	ReopenTodo(context.Context, *connect.Request[v1.ReopenTodoRequest]) (*connect.Response[v1.ReopenTodoResponse], error)
+	// CancelTodo changes an unfinished todo to cancelled
+	CancelTodo(context.Context, *connect.Request[v1.CancelTodoRequest]) (*connect.Response[v1.CancelTodoResponse], error)
+	// PauseTodo changes the todo status from in progress to on hold
+	PauseTodo(context.Context, *connect.Request[v1.PauseTodoRequest]) (*connect.Response[v1.PauseTodoResponse], error)
+	// ResumeTodo changes the todo status from on hold back to in progress.
+	// It fails while any blocker is unfinished.
+	ResumeTodo(context.Context, *connect.Request[v1.ResumeTodoRequest]) (*connect.Response[v1.ResumeTodoResponse], error)
+	// TransitionTodo moves a todo item to the given status.
+	// Todo items in a project can only follow the transitions of the project workflow.
+	// It fails while any blocker is unfinished unless the status is in the todo category.
+	TransitionTodo(context.Context, *connect.Request[v1.TransitionTodoRequest]) (*connect.Response[v1.TransitionTodoResponse], error)
+	// RevertTodo restores the title, body and status of a todo item from a version in its history.
+	// The restore is recorded as a new version, and the status must be reachable
+	// from the current status like in TransitionTodo.
+	RevertTodo(context.Context, *connect.Request[v1.RevertTodoRequest]) (*connect.Response[v1.RevertTodoResponse], error)
 	// DeleteTodo deletes a todo item
 	DeleteTodo(context.Context, *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error)
+	// AddTodoTag attaches a tag to a todo item
+	AddTodoTag(context.Context, *connect.Request[v1.AddTodoTagRequest]) (*connect.Response[v1.AddTodoTagResponse], error)
+	// RemoveTodoTag detaches a tag from a todo item
+	RemoveTodoTag(context.Context, *connect.Request[v1.RemoveTodoTagRequest]) (*connect.Response[v1.RemoveTodoTagResponse], error)
+	// MoveTodo re-parents a todo item together with its subtasks
+	MoveTodo(context.Context, *connect.Request[v1.MoveTodoRequest]) (*connect.Response[v1.MoveTodoResponse], error)
+	// AddDependency makes a todo item blocked by another todo item
+	AddDependency(context.Context, *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error)
+	// RemoveDependency removes a blocked-by relationship
+	RemoveDependency(context.Context, *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error)
 }
 
 // NewTodoServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -213,6 +449,12 @@ func NewTodoServiceHandler(svc TodoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(todoServiceMethods.ByName("GetTodos")),
 		connect.WithHandlerOptions(opts...),
 	)
+	todoServiceSearchTodosHandler := connect.NewUnaryHandler(
+		TodoServiceSearchTodosProcedure,
+		svc.SearchTodos,
+		connect.WithSchema(todoServiceMethods.ByName("SearchTodos")),
+		connect.WithHandlerOptions(opts...),
+	)
 	todoServiceUpdateTodoHandler := connect.NewUnaryHandler(
 		TodoServiceUpdateTodoProcedure,
 		svc.UpdateTodo,
@@ -231,12 +473,78 @@ func NewTodoServiceHandler(svc TodoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(todoServiceMethods.ByName("CompleteTodo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	todoServiceReopenTodoHandler := connect.NewUnaryHandler(
+		TodoServiceReopenTodoProcedure,
+		svc.ReopenTodo,
+		connect.WithSchema(todoServiceMethods.ByName("ReopenTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceCancelTodoHandler := connect.NewUnaryHandler(
+		TodoServiceCancelTodoProcedure,
+		svc.CancelTodo,
+		connect.WithSchema(todoServiceMethods.ByName("CancelTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServicePauseTodoHandler := connect.NewUnaryHandler(
+		TodoServicePauseTodoProcedure,
+		svc.PauseTodo,
+		connect.WithSchema(todoServiceMethods.ByName("PauseTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceResumeTodoHandler := connect.NewUnaryHandler(
+		TodoServiceResumeTodoProcedure,
+		svc.ResumeTodo,
+		connect.WithSchema(todoServiceMethods.ByName("ResumeTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceTransitionTodoHandler := connect.NewUnaryHandler(
+		TodoServiceTransitionTodoProcedure,
+		svc.TransitionTodo,
+		connect.WithSchema(todoServiceMethods.ByName("TransitionTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceRevertTodoHandler := connect.NewUnaryHandler(
+		TodoServiceRevertTodoProcedure,
+		svc.RevertTodo,
+		connect.WithSchema(todoServiceMethods.ByName("RevertTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
 	todoServiceDeleteTodoHandler := connect.NewUnaryHandler(
 		TodoServiceDeleteTodoProcedure,
 		svc.DeleteTodo,
 		connect.WithSchema(todoServiceMethods.ByName("DeleteTodo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	todoServiceAddTodoTagHandler := connect.NewUnaryHandler(
+		TodoServiceAddTodoTagProcedure,
+		svc.AddTodoTag,
+		connect.WithSchema(todoServiceMethods.ByName("AddTodoTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceRemoveTodoTagHandler := connect.NewUnaryHandler(
+		TodoServiceRemoveTodoTagProcedure,
+		svc.RemoveTodoTag,
+		connect.WithSchema(todoServiceMethods.ByName("RemoveTodoTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceMoveTodoHandler := connect.NewUnaryHandler(
+		TodoServiceMoveTodoProcedure,
+		svc.MoveTodo,
+		connect.WithSchema(todoServiceMethods.ByName("MoveTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceAddDependencyHandler := connect.NewUnaryHandler(
+		TodoServiceAddDependencyProcedure,
+		svc.AddDependency,
+		connect.WithSchema(todoServiceMethods.ByName("AddDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceRemoveDependencyHandler := connect.NewUnaryHandler(
+		TodoServiceRemoveDependencyProcedure,
+		svc.RemoveDependency,
+		connect.WithSchema(todoServiceMethods.ByName("RemoveDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/oniongo.v1.TodoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TodoServiceCreateTodoProcedure:
@@ -245,14 +553,38 @@ func NewTodoServiceHandler(svc TodoServiceHandler, opts ...connect.HandlerOption
 			todoServiceGetTodoHandler.ServeHTTP(w, r)
 		case TodoServiceGetTodosProcedure:
 			todoServiceGetTodosHandler.ServeHTTP(w, r)
+		case TodoServiceSearchTodosProcedure:
+			todoServiceSearchTodosHandler.ServeHTTP(w, r)
 		case TodoServiceUpdateTodoProcedure:
 			todoServiceUpdateTodoHandler.ServeHTTP(w, r)
 		case TodoServiceStartTodoProcedure:
 			todoServiceStartTodoHandler.ServeHTTP(w, r)
 		case TodoServiceCompleteTodoProcedure:
 			todoServiceCompleteTodoHandler.ServeHTTP(w, r)
+		case TodoServiceReopenTodoProcedure:
+			todoServiceReopenTodoHandler.ServeHTTP(w, r)
+		case TodoServiceCancelTodoProcedure:
+			todoServiceCancelTodoHandler.ServeHTTP(w, r)
+		case TodoServicePauseTodoProcedure:
+			todoServicePauseTodoHandler.ServeHTTP(w, r)
+		case TodoServiceResumeTodoProcedure:
+			todoServiceResumeTodoHandler.ServeHTTP(w, r)
+		case TodoServiceTransitionTodoProcedure:
+			todoServiceTransitionTodoHandler.ServeHTTP(w, r)
+		case TodoServiceRevertTodoProcedure:
+			todoServiceRevertTodoHandler.ServeHTTP(w, r)
 		case TodoServiceDeleteTodoProcedure:
 			todoServiceDeleteTodoHandler.ServeHTTP(w, r)
+		case TodoServiceAddTodoTagProcedure:
+			todoServiceAddTodoTagHandler.ServeHTTP(w, r)
+		case TodoServiceRemoveTodoTagProcedure:
+			todoServiceRemoveTodoTagHandler.ServeHTTP(w, r)
+		case TodoServiceMoveTodoProcedure:
+			todoServiceMoveTodoHandler.ServeHTTP(w, r)
+		case TodoServiceAddDependencyProcedure:
+			todoServiceAddDependencyHandler.ServeHTTP(w, r)
+		case TodoServiceRemoveDependencyProcedure:
+			todoServiceRemoveDependencyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -274,6 +606,10 @@ func (UnimplementedTodoServiceHandler) GetTodos(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.GetTodos is not implemented"))
 }
 
+func (UnimplementedTodoServiceHandler) SearchTodos(context.Context, *connect.Request[v1.SearchTodosRequest]) (*connect.Response[v1.SearchTodosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.SearchTodos is not implemented"))
+}
+
 func (UnimplementedTodoServiceHandler) UpdateTodo(context.Context, *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.UpdateTodo is not implemented"))
 }
@@ -286,6 +622,50 @@ func (UnimplementedTodoServiceHandler) CompleteTodo(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.CompleteTodo is not implemented"))
 }
 
+func (UnimplementedTodoServiceHandler) ReopenTodo(context.Context, *connect.Request[v1.ReopenTodoRequest]) (*connect.Response[v1.ReopenTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.ReopenTodo is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) CancelTodo(context.Context, *connect.Request[v1.CancelTodoRequest]) (*connect.Response[v1.CancelTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.CancelTodo is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) PauseTodo(context.Context, *connect.Request[v1.PauseTodoRequest]) (*connect.Response[v1.PauseTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.PauseTodo is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ResumeTodo(context.Context, *connect.Request[v1.ResumeTodoRequest]) (*connect.Response[v1.ResumeTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.ResumeTodo is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) TransitionTodo(context.Context, *connect.Request[v1.TransitionTodoRequest]) (*connect.Response[v1.TransitionTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.TransitionTodo is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) RevertTodo(context.Context, *connect.Request[v1.RevertTodoRequest]) (*connect.Response[v1.RevertTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.RevertTodo is not implemented"))
+}
+
 func (UnimplementedTodoServiceHandler) DeleteTodo(context.Context, *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.DeleteTodo is not implemented"))
 }
+
+func (UnimplementedTodoServiceHandler) AddTodoTag(context.Context, *connect.Request[v1.AddTodoTagRequest]) (*connect.Response[v1.AddTodoTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.AddTodoTag is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) RemoveTodoTag(context.Context, *connect.Request[v1.RemoveTodoTagRequest]) (*connect.Response[v1.RemoveTodoTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.RemoveTodoTag is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) MoveTodo(context.Context, *connect.Request[v1.MoveTodoRequest]) (*connect.Response[v1.MoveTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.MoveTodo is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) AddDependency(context.Context, *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.AddDependency is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) RemoveDependency(context.Context, *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TodoService.RemoveDependency is not implemented"))
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: oniongo/v1/transfer.proto
+
+package oniongov1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TransferServiceName is the fully-qualified name of the TransferService service.
+	TransferServiceName = "oniongo.v1.TransferService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TransferServiceExportTodosProcedure is the fully-qualified name of the TransferService's
+	// ExportTodos RPC.
+	TransferServiceExportTodosProcedure = "/oniongo.v1.TransferService/ExportTodos"
+	// TransferServiceImportTodosProcedure is the fully-qualified name of the TransferService's
+	// ImportTodos RPC.
+	TransferServiceImportTodosProcedure = "/oniongo.v1.TransferService/ImportTodos"
+	// TransferServiceImportFromSourceProcedure is the fully-qualified name of the TransferService's
+	// ImportFromSource RPC.
+	TransferServiceImportFromSourceProcedure = "/oniongo.v1.TransferService/ImportFromSource"
+)
+
+// TransferServiceClient is a client for the oniongo.v1.TransferService service.
+type TransferServiceClient interface {
+	// ExportTodos streams the todo items that match the filters as a file
+	ExportTodos(context.Context, *connect.Request[v1.ExportTodosRequest]) (*connect.ServerStreamForClient[v1.ExportTodosResponse], error)
+	// ImportTodos creates the todo items of a streamed file.
+	// Lines that fail validation or whose ID already exists are skipped and reported.
+	ImportTodos(context.Context) *connect.ClientStreamForClient[v1.ImportTodosRequest, v1.ImportTodosResponse]
+	// ImportFromSource creates the projects, tags and todo items of a streamed export file of another tool.
+	// Items are recorded with their identifiers in the tool, so importing the same file again skips them.
+	ImportFromSource(context.Context) *connect.ClientStreamForClient[v1.ImportFromSourceRequest, v1.ImportFromSourceResponse]
+}
+
+// NewTransferServiceClient constructs a client for the oniongo.v1.TransferService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTransferServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TransferServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	transferServiceMethods := v1.File_oniongo_v1_transfer_proto.Services().ByName("TransferService").Methods()
+	return &transferServiceClient{
+		exportTodos: connect.NewClient[v1.ExportTodosRequest, v1.ExportTodosResponse](
+			httpClient,
+			baseURL+TransferServiceExportTodosProcedure,
+			connect.WithSchema(transferServiceMethods.ByName("ExportTodos")),
+			connect.WithClientOptions(opts...),
+		),
+		importTodos: connect.NewClient[v1.ImportTodosRequest, v1.ImportTodosResponse](
+			httpClient,
+			baseURL+TransferServiceImportTodosProcedure,
+			connect.WithSchema(transferServiceMethods.ByName("ImportTodos")),
+			connect.WithClientOptions(opts...),
+		),
+		importFromSource: connect.NewClient[v1.ImportFromSourceRequest, v1.ImportFromSourceResponse](
+			httpClient,
+			baseURL+TransferServiceImportFromSourceProcedure,
+			connect.WithSchema(transferServiceMethods.ByName("ImportFromSource")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// transferServiceClient implements TransferServiceClient.
+type transferServiceClient struct {
+	exportTodos      *connect.Client[v1.ExportTodosRequest, v1.ExportTodosResponse]
+	importTodos      *connect.Client[v1.ImportTodosRequest, v1.ImportTodosResponse]
+	importFromSource *connect.Client[v1.ImportFromSourceRequest, v1.ImportFromSourceResponse]
+}
+
+// ExportTodos calls oniongo.v1.TransferService.ExportTodos.
+func (c *transferServiceClient) ExportTodos(ctx context.Context, req *connect.Request[v1.ExportTodosRequest]) (*connect.ServerStreamForClient[v1.ExportTodosResponse], error) {
+	return c.exportTodos.CallServerStream(ctx, req)
+}
+
+// ImportTodos calls oniongo.v1.TransferService.ImportTodos.
+func (c *transferServiceClient) ImportTodos(ctx context.Context) *connect.ClientStreamForClient[v1.ImportTodosRequest, v1.ImportTodosResponse] {
+	return c.importTodos.CallClientStream(ctx)
+}
+
+// ImportFromSource calls oniongo.v1.TransferService.ImportFromSource.
+func (c *transferServiceClient) ImportFromSource(ctx context.Context) *connect.ClientStreamForClient[v1.ImportFromSourceRequest, v1.ImportFromSourceResponse] {
+	return c.importFromSource.CallClientStream(ctx)
+}
+
+// TransferServiceHandler is an implementation of the oniongo.v1.TransferService service.
+type TransferServiceHandler interface {
+	// ExportTodos streams the todo items that match the filters as a file
+	ExportTodos(context.Context, *connect.Request[v1.ExportTodosRequest], *connect.ServerStream[v1.ExportTodosResponse]) error
+	// ImportTodos creates the todo items of a streamed file.
+	// Lines that fail validation or whose ID already exists are skipped and reported.
+	ImportTodos(context.Context, *connect.ClientStream[v1.ImportTodosRequest]) (*connect.Response[v1.ImportTodosResponse], error)
+	// ImportFromSource creates the projects, tags and todo items of a streamed export file of another tool.
+	// Items are recorded with their identifiers in the tool, so importing the same file again skips them.
+	ImportFromSource(context.Context, *connect.ClientStream[v1.ImportFromSourceRequest]) (*connect.Response[v1.ImportFromSourceResponse], error)
+}
+
+// NewTransferServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTransferServiceHandler(svc TransferServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	transferServiceMethods := v1.File_oniongo_v1_transfer_proto.Services().ByName("TransferService").Methods()
+	transferServiceExportTodosHandler := connect.NewServerStreamHandler(
+		TransferServiceExportTodosProcedure,
+		svc.ExportTodos,
+		connect.WithSchema(transferServiceMethods.ByName("ExportTodos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transferServiceImportTodosHandler := connect.NewClientStreamHandler(
+		TransferServiceImportTodosProcedure,
+		svc.ImportTodos,
+		connect.WithSchema(transferServiceMethods.ByName("ImportTodos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transferServiceImportFromSourceHandler := connect.NewClientStreamHandler(
+		TransferServiceImportFromSourceProcedure,
+		svc.ImportFromSource,
+		connect.WithSchema(transferServiceMethods.ByName("ImportFromSource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/oniongo.v1.TransferService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TransferServiceExportTodosProcedure:
+			transferServiceExportTodosHandler.ServeHTTP(w, r)
+		case TransferServiceImportTodosProcedure:
+			transferServiceImportTodosHandler.ServeHTTP(w, r)
+		case TransferServiceImportFromSourceProcedure:
+			transferServiceImportFromSourceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTransferServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTransferServiceHandler struct{}
+
+func (UnimplementedTransferServiceHandler) ExportTodos(context.Context, *connect.Request[v1.ExportTodosRequest], *connect.ServerStream[v1.ExportTodosResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TransferService.ExportTodos is not implemented"))
+}
+
+func (UnimplementedTransferServiceHandler) ImportTodos(context.Context, *connect.ClientStream[v1.ImportTodosRequest]) (*connect.Response[v1.ImportTodosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TransferService.ImportTodos is not implemented"))
+}
+
+func (UnimplementedTransferServiceHandler) ImportFromSource(context.Context, *connect.ClientStream[v1.ImportFromSourceRequest]) (*connect.Response[v1.ImportFromSourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v1.TransferService.ImportFromSource is not implemented"))
+}
@@ -0,0 +1,764 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: oniongo/v1/project.proto
+
+package oniongov1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WorkflowStatusCategory groups workflow statuses by how far the work has gone
+type WorkflowStatusCategory int32
+
+const (
+	WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_UNSPECIFIED WorkflowStatusCategory = 0
+	// Work has not started yet
+	WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_TODO WorkflowStatusCategory = 1
+	// Work is ongoing
+	WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_DOING WorkflowStatusCategory = 2
+	// Work is finished
+	WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_DONE WorkflowStatusCategory = 3
+)
+
+// Enum value maps for WorkflowStatusCategory.
+var (
+	WorkflowStatusCategory_name = map[int32]string{
+		0: "WORKFLOW_STATUS_CATEGORY_UNSPECIFIED",
+		1: "WORKFLOW_STATUS_CATEGORY_TODO",
+		2: "WORKFLOW_STATUS_CATEGORY_DOING",
+		3: "WORKFLOW_STATUS_CATEGORY_DONE",
+	}
+	WorkflowStatusCategory_value = map[string]int32{
+		"WORKFLOW_STATUS_CATEGORY_UNSPECIFIED": 0,
+		"WORKFLOW_STATUS_CATEGORY_TODO":        1,
+		"WORKFLOW_STATUS_CATEGORY_DOING":       2,
+		"WORKFLOW_STATUS_CATEGORY_DONE":        3,
+	}
+)
+
+func (x WorkflowStatusCategory) Enum() *WorkflowStatusCategory {
+	p := new(WorkflowStatusCategory)
+	*p = x
+	return p
+}
+
+func (x WorkflowStatusCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkflowStatusCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_oniongo_v1_project_proto_enumTypes[0].Descriptor()
+}
+
+func (WorkflowStatusCategory) Type() protoreflect.EnumType {
+	return &file_oniongo_v1_project_proto_enumTypes[0]
+}
+
+func (x WorkflowStatusCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkflowStatusCategory.Descriptor instead.
+func (WorkflowStatusCategory) EnumDescriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{0}
+}
+
+// WorkflowStatus is a single status, or board column, of a project workflow
+type WorkflowStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category      WorkflowStatusCategory `protobuf:"varint,3,opt,name=category,proto3,enum=oniongo.v1.WorkflowStatusCategory" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowStatus) Reset() {
+	*x = WorkflowStatus{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowStatus) ProtoMessage() {}
+
+func (x *WorkflowStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowStatus.ProtoReflect.Descriptor instead.
+func (*WorkflowStatus) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorkflowStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkflowStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkflowStatus) GetCategory() WorkflowStatusCategory {
+	if x != nil {
+		return x.Category
+	}
+	return WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_UNSPECIFIED
+}
+
+// WorkflowTransition is an allowed move from one workflow status to another
+type WorkflowTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowTransition) Reset() {
+	*x = WorkflowTransition{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowTransition) ProtoMessage() {}
+
+func (x *WorkflowTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowTransition.ProtoReflect.Descriptor instead.
+func (*WorkflowTransition) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorkflowTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WorkflowTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Project groups todo items that share a workflow
+type Project struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Statuses in order. New todo items start in the first one.
+	Statuses      []*WorkflowStatus     `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Transitions   []*WorkflowTransition `protobuf:"bytes,4,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	CreatedAt     int64                 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                 `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Project) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetStatuses() []*WorkflowStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *Project) GetTransitions() []*WorkflowTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *Project) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Project) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Statuses of the workflow. Leave empty to use the default
+	// "todo", "doing" and "done" workflow where any status can move to any other.
+	Statuses      []*WorkflowStatus     `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Transitions   []*WorkflowTransition `protobuf:"bytes,3,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetStatuses() []*WorkflowStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetTransitions() []*WorkflowTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type CreateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectResponse) ProtoMessage() {}
+
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type ListProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{5}
+}
+
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectResponse) Reset() {
+	*x = GetProjectResponse{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectResponse) ProtoMessage() {}
+
+func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	mi := &file_oniongo_v1_project_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_project_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_project_proto_rawDescGZIP(), []int{10}
+}
+
+var File_oniongo_v1_project_proto protoreflect.FileDescriptor
+
+const file_oniongo_v1_project_proto_rawDesc = "" +
+	"\n" +
+	"\x18oniongo/v1/project.proto\x12\n" +
+	"oniongo.v1\x1a\x1bbuf/validate/validate.proto\"\xaa\x01\n" +
+	"\x0eWorkflowStatus\x12-\n" +
+	"\x02id\x18\x01 \x01(\tB\x1d\xbaH\x1ar\x182\x16^[a-z][a-z0-9_]{0,31}$R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04name\x12J\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\".oniongo.v1.WorkflowStatusCategoryB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\bcategory\"J\n" +
+	"\x12WorkflowTransition\x12\x1b\n" +
+	"\x04from\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04from\x12\x17\n" +
+	"\x02to\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02to\"\xe5\x01\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\bstatuses\x18\x03 \x03(\v2\x1a.oniongo.v1.WorkflowStatusR\bstatuses\x12@\n" +
+	"\vtransitions\x18\x04 \x03(\v2\x1e.oniongo.v1.WorkflowTransitionR\vtransitions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\xb9\x01\n" +
+	"\x14CreateProjectRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04name\x12@\n" +
+	"\bstatuses\x18\x02 \x03(\v2\x1a.oniongo.v1.WorkflowStatusB\b\xbaH\x05\x92\x01\x02\x10\x14R\bstatuses\x12@\n" +
+	"\vtransitions\x18\x03 \x03(\v2\x1e.oniongo.v1.WorkflowTransitionR\vtransitions\"F\n" +
+	"\x15CreateProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.oniongo.v1.ProjectR\aproject\"\x15\n" +
+	"\x13ListProjectsRequest\"G\n" +
+	"\x14ListProjectsResponse\x12/\n" +
+	"\bprojects\x18\x01 \x03(\v2\x13.oniongo.v1.ProjectR\bprojects\"-\n" +
+	"\x11GetProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"C\n" +
+	"\x12GetProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.oniongo.v1.ProjectR\aproject\"0\n" +
+	"\x14DeleteProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x17\n" +
+	"\x15DeleteProjectResponse*\xac\x01\n" +
+	"\x16WorkflowStatusCategory\x12(\n" +
+	"$WORKFLOW_STATUS_CATEGORY_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dWORKFLOW_STATUS_CATEGORY_TODO\x10\x01\x12\"\n" +
+	"\x1eWORKFLOW_STATUS_CATEGORY_DOING\x10\x02\x12!\n" +
+	"\x1dWORKFLOW_STATUS_CATEGORY_DONE\x10\x032\xdc\x02\n" +
+	"\x0eProjectService\x12T\n" +
+	"\rCreateProject\x12 .oniongo.v1.CreateProjectRequest\x1a!.oniongo.v1.CreateProjectResponse\x12Q\n" +
+	"\fListProjects\x12\x1f.oniongo.v1.ListProjectsRequest\x1a .oniongo.v1.ListProjectsResponse\x12K\n" +
+	"\n" +
+	"GetProject\x12\x1d.oniongo.v1.GetProjectRequest\x1a\x1e.oniongo.v1.GetProjectResponse\x12T\n" +
+	"\rDeleteProject\x12 .oniongo.v1.DeleteProjectRequest\x1a!.oniongo.v1.DeleteProjectResponseB\xb1\x01\n" +
+	"\x0ecom.oniongo.v1B\fProjectProtoP\x01ZHgithub.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1;oniongov1\xa2\x02\x03OXX\xaa\x02\n" +
+	"Oniongo.V1\xca\x02\n" +
+	"Oniongo\\V1\xe2\x02\x16Oniongo\\V1\\GPBMetadata\xea\x02\vOniongo::V1b\x06proto3"
+
+var (
+	file_oniongo_v1_project_proto_rawDescOnce sync.Once
+	file_oniongo_v1_project_proto_rawDescData []byte
+)
+
+func file_oniongo_v1_project_proto_rawDescGZIP() []byte {
+	file_oniongo_v1_project_proto_rawDescOnce.Do(func() {
+		file_oniongo_v1_project_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_oniongo_v1_project_proto_rawDesc), len(file_oniongo_v1_project_proto_rawDesc)))
+	})
+	return file_oniongo_v1_project_proto_rawDescData
+}
+
+var file_oniongo_v1_project_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_oniongo_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_oniongo_v1_project_proto_goTypes = []any{
+	(WorkflowStatusCategory)(0),   // 0: oniongo.v1.WorkflowStatusCategory
+	(*WorkflowStatus)(nil),        // 1: oniongo.v1.WorkflowStatus
+	(*WorkflowTransition)(nil),    // 2: oniongo.v1.WorkflowTransition
+	(*Project)(nil),               // 3: oniongo.v1.Project
+	(*CreateProjectRequest)(nil),  // 4: oniongo.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil), // 5: oniongo.v1.CreateProjectResponse
+	(*ListProjectsRequest)(nil),   // 6: oniongo.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),  // 7: oniongo.v1.ListProjectsResponse
+	(*GetProjectRequest)(nil),     // 8: oniongo.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),    // 9: oniongo.v1.GetProjectResponse
+	(*DeleteProjectRequest)(nil),  // 10: oniongo.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil), // 11: oniongo.v1.DeleteProjectResponse
+}
+var file_oniongo_v1_project_proto_depIdxs = []int32{
+	0,  // 0: oniongo.v1.WorkflowStatus.category:type_name -> oniongo.v1.WorkflowStatusCategory
+	1,  // 1: oniongo.v1.Project.statuses:type_name -> oniongo.v1.WorkflowStatus
+	2,  // 2: oniongo.v1.Project.transitions:type_name -> oniongo.v1.WorkflowTransition
+	1,  // 3: oniongo.v1.CreateProjectRequest.statuses:type_name -> oniongo.v1.WorkflowStatus
+	2,  // 4: oniongo.v1.CreateProjectRequest.transitions:type_name -> oniongo.v1.WorkflowTransition
+	3,  // 5: oniongo.v1.CreateProjectResponse.project:type_name -> oniongo.v1.Project
+	3,  // 6: oniongo.v1.ListProjectsResponse.projects:type_name -> oniongo.v1.Project
+	3,  // 7: oniongo.v1.GetProjectResponse.project:type_name -> oniongo.v1.Project
+	4,  // 8: oniongo.v1.ProjectService.CreateProject:input_type -> oniongo.v1.CreateProjectRequest
+	6,  // 9: oniongo.v1.ProjectService.ListProjects:input_type -> oniongo.v1.ListProjectsRequest
+	8,  // 10: oniongo.v1.ProjectService.GetProject:input_type -> oniongo.v1.GetProjectRequest
+	10, // 11: oniongo.v1.ProjectService.DeleteProject:input_type -> oniongo.v1.DeleteProjectRequest
+	5,  // 12: oniongo.v1.ProjectService.CreateProject:output_type -> oniongo.v1.CreateProjectResponse
+	7,  // 13: oniongo.v1.ProjectService.ListProjects:output_type -> oniongo.v1.ListProjectsResponse
+	9,  // 14: oniongo.v1.ProjectService.GetProject:output_type -> oniongo.v1.GetProjectResponse
+	11, // 15: oniongo.v1.ProjectService.DeleteProject:output_type -> oniongo.v1.DeleteProjectResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_oniongo_v1_project_proto_init() }
+func file_oniongo_v1_project_proto_init() {
+	if File_oniongo_v1_project_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_oniongo_v1_project_proto_rawDesc), len(file_oniongo_v1_project_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_oniongo_v1_project_proto_goTypes,
+		DependencyIndexes: file_oniongo_v1_project_proto_depIdxs,
+		EnumInfos:         file_oniongo_v1_project_proto_enumTypes,
+		MessageInfos:      file_oniongo_v1_project_proto_msgTypes,
+	}.Build()
+	File_oniongo_v1_project_proto = out.File
+	file_oniongo_v1_project_proto_goTypes = nil
+	file_oniongo_v1_project_proto_depIdxs = nil
+}
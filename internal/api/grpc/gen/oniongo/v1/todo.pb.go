@@ -8,8 +8,10 @@ package oniongov1
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -30,6 +32,8 @@ const (
 	TodoStatus_TODO_STATUS_NOT_STARTED TodoStatus = 1
 	TodoStatus_TODO_STATUS_IN_PROGRESS TodoStatus = 2
 	TodoStatus_TODO_STATUS_COMPLETED   TodoStatus = 3
+	TodoStatus_TODO_STATUS_CANCELLED   TodoStatus = 4
+	TodoStatus_TODO_STATUS_ON_HOLD     TodoStatus = 5
 )
 
 // Enum value maps for TodoStatus.
@@ -39,12 +43,16 @@ var (
 		1: "TODO_STATUS_NOT_STARTED",
 		2: "TODO_STATUS_IN_PROGRESS",
 		3: "TODO_STATUS_COMPLETED",
+		4: "TODO_STATUS_CANCELLED",
+		5: "TODO_STATUS_ON_HOLD",
 	}
 	TodoStatus_value = map[string]int32{
 		"TODO_STATUS_UNSPECIFIED": 0,
 		"TODO_STATUS_NOT_STARTED": 1,
 		"TODO_STATUS_IN_PROGRESS": 2,
 		"TODO_STATUS_COMPLETED":   3,
+		"TODO_STATUS_CANCELLED":   4,
+		"TODO_STATUS_ON_HOLD":     5,
 	}
 )
 
@@ -75,16 +83,81 @@ func (TodoStatus) EnumDescriptor() ([]byte, []int) {
 	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{0}
 }
 
+// TagMatchMode specifies how tag filters are combined
+type TagMatchMode int32
+
+const (
+	// Defaults to TAG_MATCH_MODE_ANY
+	TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED TagMatchMode = 0
+	// Matches todo items that have at least one of the tags
+	TagMatchMode_TAG_MATCH_MODE_ANY TagMatchMode = 1
+	// Matches todo items that have all of the tags
+	TagMatchMode_TAG_MATCH_MODE_ALL TagMatchMode = 2
+)
+
+// Enum value maps for TagMatchMode.
+var (
+	TagMatchMode_name = map[int32]string{
+		0: "TAG_MATCH_MODE_UNSPECIFIED",
+		1: "TAG_MATCH_MODE_ANY",
+		2: "TAG_MATCH_MODE_ALL",
+	}
+	TagMatchMode_value = map[string]int32{
+		"TAG_MATCH_MODE_UNSPECIFIED": 0,
+		"TAG_MATCH_MODE_ANY":         1,
+		"TAG_MATCH_MODE_ALL":         2,
+	}
+)
+
+func (x TagMatchMode) Enum() *TagMatchMode {
+	p := new(TagMatchMode)
+	*p = x
+	return p
+}
+
+func (x TagMatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_oniongo_v1_todo_proto_enumTypes[1].Descriptor()
+}
+
+func (TagMatchMode) Type() protoreflect.EnumType {
+	return &file_oniongo_v1_todo_proto_enumTypes[1]
+}
+
+func (x TagMatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatchMode.Descriptor instead.
+func (TagMatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{1}
+}
+
 // Todo represents a todo item
 type Todo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	Status        TodoStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=oniongo.v1.TodoStatus" json:"status,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CompletedAt   *int64                 `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body        string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Status      TodoStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=oniongo.v1.TodoStatus" json:"status,omitempty"`
+	CreatedAt   int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt *int64                 `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	TagIds      []string               `protobuf:"bytes,8,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// ID of the parent todo item. Unset for root todo items.
+	ParentId *string `protobuf:"bytes,9,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// IDs of the todo items that block this todo item
+	BlockerIds []string `protobuf:"bytes,10,rep,name=blocker_ids,json=blockerIds,proto3" json:"blocker_ids,omitempty"`
+	// Status in the project workflow, such as "in_review".
+	// Todo items without a project use the TodoStatus name, such as "IN_PROGRESS".
+	StatusId string `protobuf:"bytes,11,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	// ID of the project the todo item belongs to. Unset for todo items without a project.
+	ProjectId *string `protobuf:"bytes,12,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	// Number of comments on the todo item, deleted comments excluded
+	CommentCount  int32 `protobuf:"varint,13,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -168,17 +241,178 @@ func (x *Todo) GetCompletedAt() int64 {
 	return 0
 }
 
-type CreateTodoRequest struct {
+func (x *Todo) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *Todo) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *Todo) GetBlockerIds() []string {
+	if x != nil {
+		return x.BlockerIds
+	}
+	return nil
+}
+
+func (x *Todo) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+func (x *Todo) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *Todo) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+// TodoProgress counts the subtasks below a todo item
+type TodoProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Completed     int32                  `protobuf:"varint,1,opt,name=completed,proto3" json:"completed,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TodoProgress) Reset() {
+	*x = TodoProgress{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodoProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodoProgress) ProtoMessage() {}
+
+func (x *TodoProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodoProgress.ProtoReflect.Descriptor instead.
+func (*TodoProgress) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TodoProgress) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *TodoProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// TodoNode is a todo item together with its subtasks
+type TodoNode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Body          *string                `protobuf:"bytes,2,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	Children      []*TodoNode            `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	Progress      *TodoProgress          `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TodoNode) Reset() {
+	*x = TodoNode{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodoNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodoNode) ProtoMessage() {}
+
+func (x *TodoNode) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodoNode.ProtoReflect.Descriptor instead.
+func (*TodoNode) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TodoNode) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+func (x *TodoNode) GetChildren() []*TodoNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *TodoNode) GetProgress() *TodoProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type CreateTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body  *string                `protobuf:"bytes,2,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	// Creates the todo item as a subtask of the given todo item
+	ParentId *string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// Creates the todo item in the initial status of the project workflow.
+	// Subtasks without it are created in the project of their parent.
+	ProjectId     *string `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTodoRequest) Reset() {
 	*x = CreateTodoRequest{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[1]
+	mi := &file_oniongo_v1_todo_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -187,10 +421,1288 @@ func (x *CreateTodoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTodoRequest) ProtoMessage() {}
+func (*CreateTodoRequest) ProtoMessage() {}
+
+func (x *CreateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTodoRequest.ProtoReflect.Descriptor instead.
+func (*CreateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTodoRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTodoRequest) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *CreateTodoRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTodoRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+type CreateTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTodoResponse) Reset() {
+	*x = CreateTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTodoResponse) ProtoMessage() {}
+
+func (x *CreateTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTodoResponse.ProtoReflect.Descriptor instead.
+func (*CreateTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type GetTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Returns the whole subtree with progress counts in GetTodoResponse.subtree.
+	// It cannot be combined with a point in time.
+	IncludeSubtree bool `protobuf:"varint,2,opt,name=include_subtree,json=includeSubtree,proto3" json:"include_subtree,omitempty"`
+	// Returns the todo as it was at a point in its history instead of its current state.
+	// Deleted todos can be read at a point before their deletion.
+	//
+	// Types that are valid to be assigned to PointInTime:
+	//
+	//	*GetTodoRequest_AsOf
+	//	*GetTodoRequest_Version
+	PointInTime   isGetTodoRequest_PointInTime `protobuf_oneof:"point_in_time"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodoRequest) Reset() {
+	*x = GetTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodoRequest) ProtoMessage() {}
+
+func (x *GetTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodoRequest.ProtoReflect.Descriptor instead.
+func (*GetTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTodoRequest) GetIncludeSubtree() bool {
+	if x != nil {
+		return x.IncludeSubtree
+	}
+	return false
+}
+
+func (x *GetTodoRequest) GetPointInTime() isGetTodoRequest_PointInTime {
+	if x != nil {
+		return x.PointInTime
+	}
+	return nil
+}
+
+func (x *GetTodoRequest) GetAsOf() int64 {
+	if x != nil {
+		if x, ok := x.PointInTime.(*GetTodoRequest_AsOf); ok {
+			return x.AsOf
+		}
+	}
+	return 0
+}
+
+func (x *GetTodoRequest) GetVersion() int64 {
+	if x != nil {
+		if x, ok := x.PointInTime.(*GetTodoRequest_Version); ok {
+			return x.Version
+		}
+	}
+	return 0
+}
+
+type isGetTodoRequest_PointInTime interface {
+	isGetTodoRequest_PointInTime()
+}
+
+type GetTodoRequest_AsOf struct {
+	// Unix time in seconds. Changes made during that second are included.
+	AsOf int64 `protobuf:"varint,3,opt,name=as_of,json=asOf,proto3,oneof"`
+}
+
+type GetTodoRequest_Version struct {
+	// Version from the history of the todo, counting from 1
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3,oneof"`
+}
+
+func (*GetTodoRequest_AsOf) isGetTodoRequest_PointInTime() {}
+
+func (*GetTodoRequest_Version) isGetTodoRequest_PointInTime() {}
+
+type GetTodoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Todo  *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	// Set only when include_subtree is requested
+	Subtree *TodoNode `protobuf:"bytes,2,opt,name=subtree,proto3" json:"subtree,omitempty"`
+	// Version the todo was read at. Set only when a point in time is requested.
+	Version       int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodoResponse) Reset() {
+	*x = GetTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodoResponse) ProtoMessage() {}
+
+func (x *GetTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodoResponse.ProtoReflect.Descriptor instead.
+func (*GetTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+func (x *GetTodoResponse) GetSubtree() *TodoNode {
+	if x != nil {
+		return x.Subtree
+	}
+	return nil
+}
+
+func (x *GetTodoResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetTodosRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TagIds   []string               `protobuf:"bytes,1,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	TagMatch TagMatchMode           `protobuf:"varint,2,opt,name=tag_match,json=tagMatch,proto3,enum=oniongo.v1.TagMatchMode" json:"tag_match,omitempty"`
+	// Lists only the direct subtasks of the given todo item
+	ParentId *string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// Lists only unfinished todo items whose blockers are all finished
+	ActionableOnly bool `protobuf:"varint,4,opt,name=actionable_only,json=actionableOnly,proto3" json:"actionable_only,omitempty"`
+	// Lists only the todo items in the given project
+	ProjectId     *string `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodosRequest) Reset() {
+	*x = GetTodosRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodosRequest) ProtoMessage() {}
+
+func (x *GetTodosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodosRequest.ProtoReflect.Descriptor instead.
+func (*GetTodosRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTodosRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *GetTodosRequest) GetTagMatch() TagMatchMode {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *GetTodosRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *GetTodosRequest) GetActionableOnly() bool {
+	if x != nil {
+		return x.ActionableOnly
+	}
+	return false
+}
+
+func (x *GetTodosRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+type GetTodosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todos         []*Todo                `protobuf:"bytes,1,rep,name=todos,proto3" json:"todos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodosResponse) Reset() {
+	*x = GetTodosResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodosResponse) ProtoMessage() {}
+
+func (x *GetTodosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodosResponse.ProtoReflect.Descriptor instead.
+func (*GetTodosResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTodosResponse) GetTodos() []*Todo {
+	if x != nil {
+		return x.Todos
+	}
+	return nil
+}
+
+type SearchTodosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Words are all required, "double quotes" match a phrase
+	// and a trailing * matches a prefix, e.g. `"release notes" deploy*`
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Defaults to 20
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTodosRequest) Reset() {
+	*x = SearchTodosRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTodosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTodosRequest) ProtoMessage() {}
+
+func (x *SearchTodosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTodosRequest.ProtoReflect.Descriptor instead.
+func (*SearchTodosRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchTodosRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTodosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchTodosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// TodoSearchHit is a todo item found by SearchTodos
+type TodoSearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Todo  *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	// Relevance of the match. Higher is better.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// The title with the matched words enclosed in <mark> and </mark>
+	TitleSnippet string `protobuf:"bytes,3,opt,name=title_snippet,json=titleSnippet,proto3" json:"title_snippet,omitempty"`
+	// The part of the body around the matched words, highlighted like title_snippet.
+	// Empty when only the title matches.
+	BodySnippet   string `protobuf:"bytes,4,opt,name=body_snippet,json=bodySnippet,proto3" json:"body_snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TodoSearchHit) Reset() {
+	*x = TodoSearchHit{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TodoSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TodoSearchHit) ProtoMessage() {}
+
+func (x *TodoSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TodoSearchHit.ProtoReflect.Descriptor instead.
+func (*TodoSearchHit) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TodoSearchHit) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+func (x *TodoSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TodoSearchHit) GetTitleSnippet() string {
+	if x != nil {
+		return x.TitleSnippet
+	}
+	return ""
+}
+
+func (x *TodoSearchHit) GetBodySnippet() string {
+	if x != nil {
+		return x.BodySnippet
+	}
+	return ""
+}
+
+type SearchTodosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Best match first
+	Hits []*TodoSearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTodosResponse) Reset() {
+	*x = SearchTodosResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTodosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTodosResponse) ProtoMessage() {}
+
+func (x *SearchTodosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTodosResponse.ProtoReflect.Descriptor instead.
+func (*SearchTodosResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchTodosResponse) GetHits() []*TodoSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchTodosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required unless update_mask leaves it out.
+	Title string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body  *string `protobuf:"bytes,3,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	// Fields to update: "title" and "body", or "*" for both. Without a mask, the title and
+	// the body are replaced, and a missing body clears it. PATCH /v1/todos/{id} names the
+	// fields of its request body.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTodoRequest) Reset() {
+	*x = UpdateTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTodoRequest) ProtoMessage() {}
+
+func (x *UpdateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTodoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTodoRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTodoRequest) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *UpdateTodoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTodoResponse) Reset() {
+	*x = UpdateTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTodoResponse) ProtoMessage() {}
+
+func (x *UpdateTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTodoResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{13}
+}
+
+type StartTodoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTodoRequest) Reset() {
+	*x = StartTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTodoRequest) ProtoMessage() {}
+
+func (x *StartTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTodoRequest.ProtoReflect.Descriptor instead.
+func (*StartTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StartTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StartTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTodoResponse) Reset() {
+	*x = StartTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTodoResponse) ProtoMessage() {}
+
+func (x *StartTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTodoResponse.ProtoReflect.Descriptor instead.
+func (*StartTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{15}
+}
+
+type CompleteTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Completes open subtasks too instead of failing
+	Cascade       bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTodoRequest) Reset() {
+	*x = CompleteTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTodoRequest) ProtoMessage() {}
+
+func (x *CompleteTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTodoRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CompleteTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteTodoRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type CompleteTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTodoResponse) Reset() {
+	*x = CompleteTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTodoResponse) ProtoMessage() {}
+
+func (x *CompleteTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTodoResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{17}
+}
+
+type ReopenTodoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTodoRequest) Reset() {
+	*x = ReopenTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTodoRequest) ProtoMessage() {}
+
+func (x *ReopenTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTodoRequest.ProtoReflect.Descriptor instead.
+func (*ReopenTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReopenTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReopenTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTodoResponse) Reset() {
+	*x = ReopenTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTodoResponse) ProtoMessage() {}
+
+func (x *ReopenTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTodoResponse.ProtoReflect.Descriptor instead.
+func (*ReopenTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{19}
+}
+
+type CancelTodoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTodoRequest) Reset() {
+	*x = CancelTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTodoRequest) ProtoMessage() {}
+
+func (x *CancelTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTodoRequest.ProtoReflect.Descriptor instead.
+func (*CancelTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTodoResponse) Reset() {
+	*x = CancelTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTodoResponse) ProtoMessage() {}
+
+func (x *CancelTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTodoResponse.ProtoReflect.Descriptor instead.
+func (*CancelTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{21}
+}
+
+type PauseTodoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseTodoRequest) Reset() {
+	*x = PauseTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTodoRequest) ProtoMessage() {}
+
+func (x *PauseTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTodoRequest.ProtoReflect.Descriptor instead.
+func (*PauseTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PauseTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PauseTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseTodoResponse) Reset() {
+	*x = PauseTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTodoResponse) ProtoMessage() {}
+
+func (x *PauseTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTodoResponse.ProtoReflect.Descriptor instead.
+func (*PauseTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{23}
+}
+
+type ResumeTodoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTodoRequest) Reset() {
+	*x = ResumeTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTodoRequest) ProtoMessage() {}
+
+func (x *ResumeTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTodoRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResumeTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResumeTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTodoResponse) Reset() {
+	*x = ResumeTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTodoResponse) ProtoMessage() {}
+
+func (x *ResumeTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTodoResponse.ProtoReflect.Descriptor instead.
+func (*ResumeTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{25}
+}
+
+type TransitionTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Status to move to, in the same form as Todo.status_id
+	StatusId      string `protobuf:"bytes,2,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionTodoRequest) Reset() {
+	*x = TransitionTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionTodoRequest) ProtoMessage() {}
+
+func (x *TransitionTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionTodoRequest.ProtoReflect.Descriptor instead.
+func (*TransitionTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TransitionTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransitionTodoRequest) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+type TransitionTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionTodoResponse) Reset() {
+	*x = TransitionTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionTodoResponse) ProtoMessage() {}
+
+func (x *TransitionTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionTodoResponse.ProtoReflect.Descriptor instead.
+func (*TransitionTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{27}
+}
+
+type RevertTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version from the history of the todo to restore, counting from 1
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertTodoRequest) Reset() {
+	*x = RevertTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTodoRequest) ProtoMessage() {}
 
-func (x *CreateTodoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[1]
+func (x *RevertTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,46 +1713,46 @@ func (x *CreateTodoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTodoRequest.ProtoReflect.Descriptor instead.
-func (*CreateTodoRequest) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RevertTodoRequest.ProtoReflect.Descriptor instead.
+func (*RevertTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateTodoRequest) GetTitle() string {
+func (x *RevertTodoRequest) GetId() string {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateTodoRequest) GetBody() string {
-	if x != nil && x.Body != nil {
-		return *x.Body
+func (x *RevertTodoRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type CreateTodoResponse struct {
+type RevertTodoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTodoResponse) Reset() {
-	*x = CreateTodoResponse{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[2]
+func (x *RevertTodoResponse) Reset() {
+	*x = RevertTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTodoResponse) String() string {
+func (x *RevertTodoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTodoResponse) ProtoMessage() {}
+func (*RevertTodoResponse) ProtoMessage() {}
 
-func (x *CreateTodoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[2]
+func (x *RevertTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,33 +1763,33 @@ func (x *CreateTodoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTodoResponse.ProtoReflect.Descriptor instead.
-func (*CreateTodoResponse) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RevertTodoResponse.ProtoReflect.Descriptor instead.
+func (*RevertTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{29}
 }
 
-type GetTodoRequest struct {
+type DeleteTodoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTodoRequest) Reset() {
-	*x = GetTodoRequest{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[3]
+func (x *DeleteTodoRequest) Reset() {
+	*x = DeleteTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTodoRequest) String() string {
+func (x *DeleteTodoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTodoRequest) ProtoMessage() {}
+func (*DeleteTodoRequest) ProtoMessage() {}
 
-func (x *GetTodoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[3]
+func (x *DeleteTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,40 +1800,39 @@ func (x *GetTodoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTodoRequest.ProtoReflect.Descriptor instead.
-func (*GetTodoRequest) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteTodoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetTodoRequest) GetId() string {
+func (x *DeleteTodoRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetTodoResponse struct {
+type DeleteTodoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTodoResponse) Reset() {
-	*x = GetTodoResponse{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[4]
+func (x *DeleteTodoResponse) Reset() {
+	*x = DeleteTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTodoResponse) String() string {
+func (x *DeleteTodoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTodoResponse) ProtoMessage() {}
+func (*DeleteTodoResponse) ProtoMessage() {}
 
-func (x *GetTodoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[4]
+func (x *DeleteTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,39 +1843,34 @@ func (x *GetTodoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTodoResponse.ProtoReflect.Descriptor instead.
-func (*GetTodoResponse) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *GetTodoResponse) GetTodo() *Todo {
-	if x != nil {
-		return x.Todo
-	}
-	return nil
+// Deprecated: Use DeleteTodoResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{31}
 }
 
-type GetTodosRequest struct {
+type AddTodoTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTodosRequest) Reset() {
-	*x = GetTodosRequest{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[5]
+func (x *AddTodoTagRequest) Reset() {
+	*x = AddTodoTagRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTodosRequest) String() string {
+func (x *AddTodoTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTodosRequest) ProtoMessage() {}
+func (*AddTodoTagRequest) ProtoMessage() {}
 
-func (x *GetTodosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[5]
+func (x *AddTodoTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,33 +1881,46 @@ func (x *GetTodosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTodosRequest.ProtoReflect.Descriptor instead.
-func (*GetTodosRequest) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AddTodoTagRequest.ProtoReflect.Descriptor instead.
+func (*AddTodoTagRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{32}
 }
 
-type GetTodosResponse struct {
+func (x *AddTodoTagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddTodoTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type AddTodoTagResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Todos         []*Todo                `protobuf:"bytes,1,rep,name=todos,proto3" json:"todos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTodosResponse) Reset() {
-	*x = GetTodosResponse{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[6]
+func (x *AddTodoTagResponse) Reset() {
+	*x = AddTodoTagResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTodosResponse) String() string {
+func (x *AddTodoTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTodosResponse) ProtoMessage() {}
+func (*AddTodoTagResponse) ProtoMessage() {}
 
-func (x *GetTodosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[6]
+func (x *AddTodoTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,42 +1931,34 @@ func (x *GetTodosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTodosResponse.ProtoReflect.Descriptor instead.
-func (*GetTodosResponse) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *GetTodosResponse) GetTodos() []*Todo {
-	if x != nil {
-		return x.Todos
-	}
-	return nil
+// Deprecated: Use AddTodoTagResponse.ProtoReflect.Descriptor instead.
+func (*AddTodoTagResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{33}
 }
 
-type UpdateTodoRequest struct {
+type RemoveTodoTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Body          *string                `protobuf:"bytes,3,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTodoRequest) Reset() {
-	*x = UpdateTodoRequest{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[7]
+func (x *RemoveTodoTagRequest) Reset() {
+	*x = RemoveTodoTagRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTodoRequest) String() string {
+func (x *RemoveTodoTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTodoRequest) ProtoMessage() {}
+func (*RemoveTodoTagRequest) ProtoMessage() {}
 
-func (x *UpdateTodoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[7]
+func (x *RemoveTodoTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,53 +1969,46 @@ func (x *UpdateTodoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTodoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTodoRequest) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RemoveTodoTagRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTodoTagRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateTodoRequest) GetId() string {
+func (x *RemoveTodoTagRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateTodoRequest) GetTitle() string {
+func (x *RemoveTodoTagRequest) GetTagId() string {
 	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *UpdateTodoRequest) GetBody() string {
-	if x != nil && x.Body != nil {
-		return *x.Body
+		return x.TagId
 	}
 	return ""
 }
 
-type UpdateTodoResponse struct {
+type RemoveTodoTagResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTodoResponse) Reset() {
-	*x = UpdateTodoResponse{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[8]
+func (x *RemoveTodoTagResponse) Reset() {
+	*x = RemoveTodoTagResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTodoResponse) String() string {
+func (x *RemoveTodoTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTodoResponse) ProtoMessage() {}
+func (*RemoveTodoTagResponse) ProtoMessage() {}
 
-func (x *UpdateTodoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[8]
+func (x *RemoveTodoTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,33 +2019,35 @@ func (x *UpdateTodoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTodoResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTodoResponse) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RemoveTodoTagResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTodoTagResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{35}
 }
 
-type StartTodoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type MoveTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The new parent. Unset moves the todo item to the root.
+	ParentId      *string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTodoRequest) Reset() {
-	*x = StartTodoRequest{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[9]
+func (x *MoveTodoRequest) Reset() {
+	*x = MoveTodoRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTodoRequest) String() string {
+func (x *MoveTodoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTodoRequest) ProtoMessage() {}
+func (*MoveTodoRequest) ProtoMessage() {}
 
-func (x *StartTodoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[9]
+func (x *MoveTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,39 +2058,46 @@ func (x *StartTodoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTodoRequest.ProtoReflect.Descriptor instead.
-func (*StartTodoRequest) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MoveTodoRequest.ProtoReflect.Descriptor instead.
+func (*MoveTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *StartTodoRequest) GetId() string {
+func (x *MoveTodoRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type StartTodoResponse struct {
+func (x *MoveTodoRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+type MoveTodoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTodoResponse) Reset() {
-	*x = StartTodoResponse{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[10]
+func (x *MoveTodoResponse) Reset() {
+	*x = MoveTodoResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTodoResponse) String() string {
+func (x *MoveTodoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTodoResponse) ProtoMessage() {}
+func (*MoveTodoResponse) ProtoMessage() {}
 
-func (x *StartTodoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[10]
+func (x *MoveTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,33 +2108,35 @@ func (x *StartTodoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTodoResponse.ProtoReflect.Descriptor instead.
-func (*StartTodoResponse) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MoveTodoResponse.ProtoReflect.Descriptor instead.
+func (*MoveTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{37}
 }
 
-type CompleteTodoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type AddDependencyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The todo item that blocks the todo item
+	BlockerId     string `protobuf:"bytes,2,opt,name=blocker_id,json=blockerId,proto3" json:"blocker_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteTodoRequest) Reset() {
-	*x = CompleteTodoRequest{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[11]
+func (x *AddDependencyRequest) Reset() {
+	*x = AddDependencyRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteTodoRequest) String() string {
+func (x *AddDependencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteTodoRequest) ProtoMessage() {}
+func (*AddDependencyRequest) ProtoMessage() {}
 
-func (x *CompleteTodoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[11]
+func (x *AddDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,39 +2147,46 @@ func (x *CompleteTodoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteTodoRequest.ProtoReflect.Descriptor instead.
-func (*CompleteTodoRequest) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AddDependencyRequest.ProtoReflect.Descriptor instead.
+func (*AddDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CompleteTodoRequest) GetId() string {
+func (x *AddDependencyRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type CompleteTodoResponse struct {
+func (x *AddDependencyRequest) GetBlockerId() string {
+	if x != nil {
+		return x.BlockerId
+	}
+	return ""
+}
+
+type AddDependencyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteTodoResponse) Reset() {
-	*x = CompleteTodoResponse{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[12]
+func (x *AddDependencyResponse) Reset() {
+	*x = AddDependencyResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteTodoResponse) String() string {
+func (x *AddDependencyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteTodoResponse) ProtoMessage() {}
+func (*AddDependencyResponse) ProtoMessage() {}
 
-func (x *CompleteTodoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[12]
+func (x *AddDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,33 +2197,34 @@ func (x *CompleteTodoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteTodoResponse.ProtoReflect.Descriptor instead.
-func (*CompleteTodoResponse) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AddDependencyResponse.ProtoReflect.Descriptor instead.
+func (*AddDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{39}
 }
 
-type DeleteTodoRequest struct {
+type RemoveDependencyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BlockerId     string                 `protobuf:"bytes,2,opt,name=blocker_id,json=blockerId,proto3" json:"blocker_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTodoRequest) Reset() {
-	*x = DeleteTodoRequest{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[13]
+func (x *RemoveDependencyRequest) Reset() {
+	*x = RemoveDependencyRequest{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTodoRequest) String() string {
+func (x *RemoveDependencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTodoRequest) ProtoMessage() {}
+func (*RemoveDependencyRequest) ProtoMessage() {}
 
-func (x *DeleteTodoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[13]
+func (x *RemoveDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,39 +2235,46 @@ func (x *DeleteTodoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTodoRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTodoRequest) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RemoveDependencyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DeleteTodoRequest) GetId() string {
+func (x *RemoveDependencyRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteTodoResponse struct {
+func (x *RemoveDependencyRequest) GetBlockerId() string {
+	if x != nil {
+		return x.BlockerId
+	}
+	return ""
+}
+
+type RemoveDependencyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTodoResponse) Reset() {
-	*x = DeleteTodoResponse{}
-	mi := &file_oniongo_v1_todo_proto_msgTypes[14]
+func (x *RemoveDependencyResponse) Reset() {
+	*x = RemoveDependencyResponse{}
+	mi := &file_oniongo_v1_todo_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTodoResponse) String() string {
+func (x *RemoveDependencyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTodoResponse) ProtoMessage() {}
+func (*RemoveDependencyResponse) ProtoMessage() {}
 
-func (x *DeleteTodoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oniongo_v1_todo_proto_msgTypes[14]
+func (x *RemoveDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_todo_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,9 +2285,9 @@ func (x *DeleteTodoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTodoResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTodoResponse) Descriptor() ([]byte, []int) {
-	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RemoveDependencyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_todo_proto_rawDescGZIP(), []int{41}
 }
 
 var File_oniongo_v1_todo_proto protoreflect.FileDescriptor
@@ -765,7 +2295,7 @@ var File_oniongo_v1_todo_proto protoreflect.FileDescriptor
 const file_oniongo_v1_todo_proto_rawDesc = "" +
 	"\n" +
 	"\x15oniongo/v1/todo.proto\x12\n" +
-	"oniongo.v1\x1a\x1bbuf/validate/validate.proto\"\xe7\x01\n" +
+	"oniongo.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\"\xc6\x03\n" +
 	"\x04Todo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -775,52 +2305,178 @@ const file_oniongo_v1_todo_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x12&\n" +
-	"\fcompleted_at\x18\a \x01(\x03H\x00R\vcompletedAt\x88\x01\x01B\x0f\n" +
-	"\r_completed_at\"T\n" +
+	"\fcompleted_at\x18\a \x01(\x03H\x00R\vcompletedAt\x88\x01\x01\x12\x17\n" +
+	"\atag_ids\x18\b \x03(\tR\x06tagIds\x12 \n" +
+	"\tparent_id\x18\t \x01(\tH\x01R\bparentId\x88\x01\x01\x12\x1f\n" +
+	"\vblocker_ids\x18\n" +
+	" \x03(\tR\n" +
+	"blockerIds\x12\x1b\n" +
+	"\tstatus_id\x18\v \x01(\tR\bstatusId\x12\"\n" +
+	"\n" +
+	"project_id\x18\f \x01(\tH\x02R\tprojectId\x88\x01\x01\x12#\n" +
+	"\rcomment_count\x18\r \x01(\x05R\fcommentCountB\x0f\n" +
+	"\r_completed_atB\f\n" +
+	"\n" +
+	"_parent_idB\r\n" +
+	"\v_project_id\"B\n" +
+	"\fTodoProgress\x12\x1c\n" +
+	"\tcompleted\x18\x01 \x01(\x05R\tcompleted\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x98\x01\n" +
+	"\bTodoNode\x12$\n" +
+	"\x04todo\x18\x01 \x01(\v2\x10.oniongo.v1.TodoR\x04todo\x120\n" +
+	"\bchildren\x18\x02 \x03(\v2\x14.oniongo.v1.TodoNodeR\bchildren\x124\n" +
+	"\bprogress\x18\x03 \x01(\v2\x18.oniongo.v1.TodoProgressR\bprogress\"\xcb\x01\n" +
 	"\x11CreateTodoRequest\x12\x1d\n" +
 	"\x05title\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05title\x12\x17\n" +
-	"\x04body\x18\x02 \x01(\tH\x00R\x04body\x88\x01\x01B\a\n" +
-	"\x05_body\"\x14\n" +
-	"\x12CreateTodoResponse\"*\n" +
+	"\x04body\x18\x02 \x01(\tH\x00R\x04body\x88\x01\x01\x12*\n" +
+	"\tparent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\bparentId\x88\x01\x01\x12,\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\tprojectId\x88\x01\x01B\a\n" +
+	"\x05_bodyB\f\n" +
+	"\n" +
+	"_parent_idB\r\n" +
+	"\v_project_id\":\n" +
+	"\x12CreateTodoResponse\x12$\n" +
+	"\x04todo\x18\x01 \x01(\v2\x10.oniongo.v1.TodoR\x04todo\"\xa9\x01\n" +
 	"\x0eGetTodoRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"7\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12'\n" +
+	"\x0finclude_subtree\x18\x02 \x01(\bR\x0eincludeSubtree\x12\x1e\n" +
+	"\x05as_of\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x04asOf\x12#\n" +
+	"\aversion\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\aversionB\x0f\n" +
+	"\rpoint_in_time\"\x81\x01\n" +
 	"\x0fGetTodoResponse\x12$\n" +
-	"\x04todo\x18\x01 \x01(\v2\x10.oniongo.v1.TodoR\x04todo\"\x11\n" +
-	"\x0fGetTodosRequest\":\n" +
+	"\x04todo\x18\x01 \x01(\v2\x10.oniongo.v1.TodoR\x04todo\x12.\n" +
+	"\asubtree\x18\x02 \x01(\v2\x14.oniongo.v1.TodoNodeR\asubtree\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\"\x90\x02\n" +
+	"\x0fGetTodosRequest\x12&\n" +
+	"\atag_ids\x18\x01 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\x06tagIds\x125\n" +
+	"\ttag_match\x18\x02 \x01(\x0e2\x18.oniongo.v1.TagMatchModeR\btagMatch\x12*\n" +
+	"\tparent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\bparentId\x88\x01\x01\x12'\n" +
+	"\x0factionable_only\x18\x04 \x01(\bR\x0eactionableOnly\x12,\n" +
+	"\n" +
+	"project_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\tprojectId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_idB\r\n" +
+	"\v_project_id\":\n" +
 	"\x10GetTodosResponse\x12&\n" +
-	"\x05todos\x18\x01 \x03(\v2\x10.oniongo.v1.TodoR\x05todos\"n\n" +
+	"\x05todos\x18\x01 \x03(\v2\x10.oniongo.v1.TodoR\x05todos\"}\n" +
+	"\x12SearchTodosRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x05query\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x93\x01\n" +
+	"\rTodoSearchHit\x12$\n" +
+	"\x04todo\x18\x01 \x01(\v2\x10.oniongo.v1.TodoR\x04todo\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12#\n" +
+	"\rtitle_snippet\x18\x03 \x01(\tR\ftitleSnippet\x12!\n" +
+	"\fbody_snippet\x18\x04 \x01(\tR\vbodySnippet\"l\n" +
+	"\x13SearchTodosResponse\x12-\n" +
+	"\x04hits\x18\x01 \x03(\v2\x19.oniongo.v1.TodoSearchHitR\x04hits\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa2\x01\n" +
 	"\x11UpdateTodoRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
-	"\x05title\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05title\x12\x17\n" +
-	"\x04body\x18\x03 \x01(\tH\x00R\x04body\x88\x01\x01B\a\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x17\n" +
+	"\x04body\x18\x03 \x01(\tH\x00R\x04body\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\a\n" +
 	"\x05_body\"\x14\n" +
 	"\x12UpdateTodoResponse\",\n" +
 	"\x10StartTodoRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x13\n" +
-	"\x11StartTodoResponse\"/\n" +
+	"\x11StartTodoResponse\"I\n" +
 	"\x13CompleteTodoRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x16\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"\x16\n" +
 	"\x14CompleteTodoResponse\"-\n" +
+	"\x11ReopenTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
+	"\x12ReopenTodoResponse\"-\n" +
+	"\x11CancelTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
+	"\x12CancelTodoResponse\",\n" +
+	"\x10PauseTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x13\n" +
+	"\x11PauseTodoResponse\"-\n" +
+	"\x11ResumeTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
+	"\x12ResumeTodoResponse\"Y\n" +
+	"\x15TransitionTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12&\n" +
+	"\tstatus_id\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18 R\bstatusId\"\x18\n" +
+	"\x16TransitionTodoResponse\"P\n" +
+	"\x11RevertTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12!\n" +
+	"\aversion\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\aversion\"\x14\n" +
+	"\x12RevertTodoResponse\"-\n" +
 	"\x11DeleteTodoRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
-	"\x12DeleteTodoResponse*~\n" +
+	"\x12DeleteTodoResponse\"N\n" +
+	"\x11AddTodoTagRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1f\n" +
+	"\x06tag_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05tagId\"\x14\n" +
+	"\x12AddTodoTagResponse\"Q\n" +
+	"\x14RemoveTodoTagRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1f\n" +
+	"\x06tag_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05tagId\"\x17\n" +
+	"\x15RemoveTodoTagResponse\"e\n" +
+	"\x0fMoveTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12*\n" +
+	"\tparent_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\bparentId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_id\"\x12\n" +
+	"\x10MoveTodoResponse\"Y\n" +
+	"\x14AddDependencyRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12'\n" +
+	"\n" +
+	"blocker_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tblockerId\"\x17\n" +
+	"\x15AddDependencyResponse\"\\\n" +
+	"\x17RemoveDependencyRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12'\n" +
+	"\n" +
+	"blocker_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tblockerId\"\x1a\n" +
+	"\x18RemoveDependencyResponse*\xb2\x01\n" +
 	"\n" +
 	"TodoStatus\x12\x1b\n" +
 	"\x17TODO_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17TODO_STATUS_NOT_STARTED\x10\x01\x12\x1b\n" +
 	"\x17TODO_STATUS_IN_PROGRESS\x10\x02\x12\x19\n" +
-	"\x15TODO_STATUS_COMPLETED\x10\x032\x9c\x04\n" +
-	"\vTodoService\x12K\n" +
+	"\x15TODO_STATUS_COMPLETED\x10\x03\x12\x19\n" +
+	"\x15TODO_STATUS_CANCELLED\x10\x04\x12\x17\n" +
+	"\x13TODO_STATUS_ON_HOLD\x10\x05*^\n" +
+	"\fTagMatchMode\x12\x1e\n" +
+	"\x1aTAG_MATCH_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ANY\x10\x01\x12\x16\n" +
+	"\x12TAG_MATCH_MODE_ALL\x10\x022\x9b\r\n" +
+	"\vTodoService\x12a\n" +
+	"\n" +
+	"CreateTodo\x12\x1d.oniongo.v1.CreateTodoRequest\x1a\x1e.oniongo.v1.CreateTodoResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/todos\x12Z\n" +
+	"\aGetTodo\x12\x1a.oniongo.v1.GetTodoRequest\x1a\x1b.oniongo.v1.GetTodoResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/todos/{id}\x12X\n" +
+	"\bGetTodos\x12\x1b.oniongo.v1.GetTodosRequest\x1a\x1c.oniongo.v1.GetTodosResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/todos\x12N\n" +
+	"\vSearchTodos\x12\x1e.oniongo.v1.SearchTodosRequest\x1a\x1f.oniongo.v1.SearchTodosResponse\x12f\n" +
+	"\n" +
+	"UpdateTodo\x12\x1d.oniongo.v1.UpdateTodoRequest\x1a\x1e.oniongo.v1.UpdateTodoResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/todos/{id}\x12i\n" +
+	"\tStartTodo\x12\x1c.oniongo.v1.StartTodoRequest\x1a\x1d.oniongo.v1.StartTodoResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/todos/{id}:start\x12u\n" +
+	"\fCompleteTodo\x12\x1f.oniongo.v1.CompleteTodoRequest\x1a .oniongo.v1.CompleteTodoResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/todos/{id}:complete\x12K\n" +
 	"\n" +
-	"CreateTodo\x12\x1d.oniongo.v1.CreateTodoRequest\x1a\x1e.oniongo.v1.CreateTodoResponse\x12B\n" +
-	"\aGetTodo\x12\x1a.oniongo.v1.GetTodoRequest\x1a\x1b.oniongo.v1.GetTodoResponse\x12E\n" +
-	"\bGetTodos\x12\x1b.oniongo.v1.GetTodosRequest\x1a\x1c.oniongo.v1.GetTodosResponse\x12K\n" +
+	"ReopenTodo\x12\x1d.oniongo.v1.ReopenTodoRequest\x1a\x1e.oniongo.v1.ReopenTodoResponse\x12K\n" +
 	"\n" +
-	"UpdateTodo\x12\x1d.oniongo.v1.UpdateTodoRequest\x1a\x1e.oniongo.v1.UpdateTodoResponse\x12H\n" +
-	"\tStartTodo\x12\x1c.oniongo.v1.StartTodoRequest\x1a\x1d.oniongo.v1.StartTodoResponse\x12Q\n" +
-	"\fCompleteTodo\x12\x1f.oniongo.v1.CompleteTodoRequest\x1a .oniongo.v1.CompleteTodoResponse\x12K\n" +
+	"CancelTodo\x12\x1d.oniongo.v1.CancelTodoRequest\x1a\x1e.oniongo.v1.CancelTodoResponse\x12H\n" +
+	"\tPauseTodo\x12\x1c.oniongo.v1.PauseTodoRequest\x1a\x1d.oniongo.v1.PauseTodoResponse\x12K\n" +
 	"\n" +
-	"DeleteTodo\x12\x1d.oniongo.v1.DeleteTodoRequest\x1a\x1e.oniongo.v1.DeleteTodoResponseB\xae\x01\n" +
+	"ResumeTodo\x12\x1d.oniongo.v1.ResumeTodoRequest\x1a\x1e.oniongo.v1.ResumeTodoResponse\x12W\n" +
+	"\x0eTransitionTodo\x12!.oniongo.v1.TransitionTodoRequest\x1a\".oniongo.v1.TransitionTodoResponse\x12K\n" +
+	"\n" +
+	"RevertTodo\x12\x1d.oniongo.v1.RevertTodoRequest\x1a\x1e.oniongo.v1.RevertTodoResponse\x12c\n" +
+	"\n" +
+	"DeleteTodo\x12\x1d.oniongo.v1.DeleteTodoRequest\x1a\x1e.oniongo.v1.DeleteTodoResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/todos/{id}\x12K\n" +
+	"\n" +
+	"AddTodoTag\x12\x1d.oniongo.v1.AddTodoTagRequest\x1a\x1e.oniongo.v1.AddTodoTagResponse\x12T\n" +
+	"\rRemoveTodoTag\x12 .oniongo.v1.RemoveTodoTagRequest\x1a!.oniongo.v1.RemoveTodoTagResponse\x12E\n" +
+	"\bMoveTodo\x12\x1b.oniongo.v1.MoveTodoRequest\x1a\x1c.oniongo.v1.MoveTodoResponse\x12T\n" +
+	"\rAddDependency\x12 .oniongo.v1.AddDependencyRequest\x1a!.oniongo.v1.AddDependencyResponse\x12]\n" +
+	"\x10RemoveDependency\x12#.oniongo.v1.RemoveDependencyRequest\x1a$.oniongo.v1.RemoveDependencyResponseB\xae\x01\n" +
 	"\x0ecom.oniongo.v1B\tTodoProtoP\x01ZHgithub.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1;oniongov1\xa2\x02\x03OXX\xaa\x02\n" +
 	"Oniongo.V1\xca\x02\n" +
 	"Oniongo\\V1\xe2\x02\x16Oniongo\\V1\\GPBMetadata\xea\x02\vOniongo::V1b\x06proto3"
@@ -837,49 +2493,111 @@ func file_oniongo_v1_todo_proto_rawDescGZIP() []byte {
 	return file_oniongo_v1_todo_proto_rawDescData
 }
 
-var file_oniongo_v1_todo_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_oniongo_v1_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_oniongo_v1_todo_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_oniongo_v1_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_oniongo_v1_todo_proto_goTypes = []any{
-	(TodoStatus)(0),              // 0: oniongo.v1.TodoStatus
-	(*Todo)(nil),                 // 1: oniongo.v1.Todo
-	(*CreateTodoRequest)(nil),    // 2: oniongo.v1.CreateTodoRequest
-	(*CreateTodoResponse)(nil),   // 3: oniongo.v1.CreateTodoResponse
-	(*GetTodoRequest)(nil),       // 4: oniongo.v1.GetTodoRequest
-	(*GetTodoResponse)(nil),      // 5: oniongo.v1.GetTodoResponse
-	(*GetTodosRequest)(nil),      // 6: oniongo.v1.GetTodosRequest
-	(*GetTodosResponse)(nil),     // 7: oniongo.v1.GetTodosResponse
-	(*UpdateTodoRequest)(nil),    // 8: oniongo.v1.UpdateTodoRequest
-	(*UpdateTodoResponse)(nil),   // 9: oniongo.v1.UpdateTodoResponse
-	(*StartTodoRequest)(nil),     // 10: oniongo.v1.StartTodoRequest
-	(*StartTodoResponse)(nil),    // 11: oniongo.v1.StartTodoResponse
-	(*CompleteTodoRequest)(nil),  // 12: oniongo.v1.CompleteTodoRequest
-	(*CompleteTodoResponse)(nil), // 13: oniongo.v1.CompleteTodoResponse
-	(*DeleteTodoRequest)(nil),    // 14: oniongo.v1.DeleteTodoRequest
-	(*DeleteTodoResponse)(nil),   // 15: oniongo.v1.DeleteTodoResponse
+	(TodoStatus)(0),                  // 0: oniongo.v1.TodoStatus
+	(TagMatchMode)(0),                // 1: oniongo.v1.TagMatchMode
+	(*Todo)(nil),                     // 2: oniongo.v1.Todo
+	(*TodoProgress)(nil),             // 3: oniongo.v1.TodoProgress
+	(*TodoNode)(nil),                 // 4: oniongo.v1.TodoNode
+	(*CreateTodoRequest)(nil),        // 5: oniongo.v1.CreateTodoRequest
+	(*CreateTodoResponse)(nil),       // 6: oniongo.v1.CreateTodoResponse
+	(*GetTodoRequest)(nil),           // 7: oniongo.v1.GetTodoRequest
+	(*GetTodoResponse)(nil),          // 8: oniongo.v1.GetTodoResponse
+	(*GetTodosRequest)(nil),          // 9: oniongo.v1.GetTodosRequest
+	(*GetTodosResponse)(nil),         // 10: oniongo.v1.GetTodosResponse
+	(*SearchTodosRequest)(nil),       // 11: oniongo.v1.SearchTodosRequest
+	(*TodoSearchHit)(nil),            // 12: oniongo.v1.TodoSearchHit
+	(*SearchTodosResponse)(nil),      // 13: oniongo.v1.SearchTodosResponse
+	(*UpdateTodoRequest)(nil),        // 14: oniongo.v1.UpdateTodoRequest
+	(*UpdateTodoResponse)(nil),       // 15: oniongo.v1.UpdateTodoResponse
+	(*StartTodoRequest)(nil),         // 16: oniongo.v1.StartTodoRequest
+	(*StartTodoResponse)(nil),        // 17: oniongo.v1.StartTodoResponse
+	(*CompleteTodoRequest)(nil),      // 18: oniongo.v1.CompleteTodoRequest
+	(*CompleteTodoResponse)(nil),     // 19: oniongo.v1.CompleteTodoResponse
+	(*ReopenTodoRequest)(nil),        // 20: oniongo.v1.ReopenTodoRequest
+	(*ReopenTodoResponse)(nil),       // 21: oniongo.v1.ReopenTodoResponse
+	(*CancelTodoRequest)(nil),        // 22: oniongo.v1.CancelTodoRequest
+	(*CancelTodoResponse)(nil),       // 23: oniongo.v1.CancelTodoResponse
+	(*PauseTodoRequest)(nil),         // 24: oniongo.v1.PauseTodoRequest
+	(*PauseTodoResponse)(nil),        // 25: oniongo.v1.PauseTodoResponse
+	(*ResumeTodoRequest)(nil),        // 26: oniongo.v1.ResumeTodoRequest
+	(*ResumeTodoResponse)(nil),       // 27: oniongo.v1.ResumeTodoResponse
+	(*TransitionTodoRequest)(nil),    // 28: oniongo.v1.TransitionTodoRequest
+	(*TransitionTodoResponse)(nil),   // 29: oniongo.v1.TransitionTodoResponse
+	(*RevertTodoRequest)(nil),        // 30: oniongo.v1.RevertTodoRequest
+	(*RevertTodoResponse)(nil),       // 31: oniongo.v1.RevertTodoResponse
+	(*DeleteTodoRequest)(nil),        // 32: oniongo.v1.DeleteTodoRequest
+	(*DeleteTodoResponse)(nil),       // 33: oniongo.v1.DeleteTodoResponse
+	(*AddTodoTagRequest)(nil),        // 34: oniongo.v1.AddTodoTagRequest
+	(*AddTodoTagResponse)(nil),       // 35: oniongo.v1.AddTodoTagResponse
+	(*RemoveTodoTagRequest)(nil),     // 36: oniongo.v1.RemoveTodoTagRequest
+	(*RemoveTodoTagResponse)(nil),    // 37: oniongo.v1.RemoveTodoTagResponse
+	(*MoveTodoRequest)(nil),          // 38: oniongo.v1.MoveTodoRequest
+	(*MoveTodoResponse)(nil),         // 39: oniongo.v1.MoveTodoResponse
+	(*AddDependencyRequest)(nil),     // 40: oniongo.v1.AddDependencyRequest
+	(*AddDependencyResponse)(nil),    // 41: oniongo.v1.AddDependencyResponse
+	(*RemoveDependencyRequest)(nil),  // 42: oniongo.v1.RemoveDependencyRequest
+	(*RemoveDependencyResponse)(nil), // 43: oniongo.v1.RemoveDependencyResponse
+	(*fieldmaskpb.FieldMask)(nil),    // 44: google.protobuf.FieldMask
 }
 var file_oniongo_v1_todo_proto_depIdxs = []int32{
 	0,  // 0: oniongo.v1.Todo.status:type_name -> oniongo.v1.TodoStatus
-	1,  // 1: oniongo.v1.GetTodoResponse.todo:type_name -> oniongo.v1.Todo
-	1,  // 2: oniongo.v1.GetTodosResponse.todos:type_name -> oniongo.v1.Todo
-	2,  // 3: oniongo.v1.TodoService.CreateTodo:input_type -> oniongo.v1.CreateTodoRequest
-	4,  // 4: oniongo.v1.TodoService.GetTodo:input_type -> oniongo.v1.GetTodoRequest
-	6,  // 5: oniongo.v1.TodoService.GetTodos:input_type -> oniongo.v1.GetTodosRequest
-	8,  // 6: oniongo.v1.TodoService.UpdateTodo:input_type -> oniongo.v1.UpdateTodoRequest
-	10, // 7: oniongo.v1.TodoService.StartTodo:input_type -> oniongo.v1.StartTodoRequest
-	12, // 8: oniongo.v1.TodoService.CompleteTodo:input_type -> oniongo.v1.CompleteTodoRequest
-	14, // 9: oniongo.v1.TodoService.DeleteTodo:input_type -> oniongo.v1.DeleteTodoRequest
-	3,  // 10: oniongo.v1.TodoService.CreateTodo:output_type -> oniongo.v1.CreateTodoResponse
-	5,  // 11: oniongo.v1.TodoService.GetTodo:output_type -> oniongo.v1.GetTodoResponse
-	7,  // 12: oniongo.v1.TodoService.GetTodos:output_type -> oniongo.v1.GetTodosResponse
-	9,  // 13: oniongo.v1.TodoService.UpdateTodo:output_type -> oniongo.v1.UpdateTodoResponse
-	11, // 14: oniongo.v1.TodoService.StartTodo:output_type -> oniongo.v1.StartTodoResponse
-	13, // 15: oniongo.v1.TodoService.CompleteTodo:output_type -> oniongo.v1.CompleteTodoResponse
-	15, // 16: oniongo.v1.TodoService.DeleteTodo:output_type -> oniongo.v1.DeleteTodoResponse
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	2,  // 1: oniongo.v1.TodoNode.todo:type_name -> oniongo.v1.Todo
+	4,  // 2: oniongo.v1.TodoNode.children:type_name -> oniongo.v1.TodoNode
+	3,  // 3: oniongo.v1.TodoNode.progress:type_name -> oniongo.v1.TodoProgress
+	2,  // 4: oniongo.v1.CreateTodoResponse.todo:type_name -> oniongo.v1.Todo
+	2,  // 5: oniongo.v1.GetTodoResponse.todo:type_name -> oniongo.v1.Todo
+	4,  // 6: oniongo.v1.GetTodoResponse.subtree:type_name -> oniongo.v1.TodoNode
+	1,  // 7: oniongo.v1.GetTodosRequest.tag_match:type_name -> oniongo.v1.TagMatchMode
+	2,  // 8: oniongo.v1.GetTodosResponse.todos:type_name -> oniongo.v1.Todo
+	2,  // 9: oniongo.v1.TodoSearchHit.todo:type_name -> oniongo.v1.Todo
+	12, // 10: oniongo.v1.SearchTodosResponse.hits:type_name -> oniongo.v1.TodoSearchHit
+	44, // 11: oniongo.v1.UpdateTodoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 12: oniongo.v1.TodoService.CreateTodo:input_type -> oniongo.v1.CreateTodoRequest
+	7,  // 13: oniongo.v1.TodoService.GetTodo:input_type -> oniongo.v1.GetTodoRequest
+	9,  // 14: oniongo.v1.TodoService.GetTodos:input_type -> oniongo.v1.GetTodosRequest
+	11, // 15: oniongo.v1.TodoService.SearchTodos:input_type -> oniongo.v1.SearchTodosRequest
+	14, // 16: oniongo.v1.TodoService.UpdateTodo:input_type -> oniongo.v1.UpdateTodoRequest
+	16, // 17: oniongo.v1.TodoService.StartTodo:input_type -> oniongo.v1.StartTodoRequest
+	18, // 18: oniongo.v1.TodoService.CompleteTodo:input_type -> oniongo.v1.CompleteTodoRequest
+	20, // 19: oniongo.v1.TodoService.ReopenTodo:input_type -> oniongo.v1.ReopenTodoRequest
+	22, // 20: oniongo.v1.TodoService.CancelTodo:input_type -> oniongo.v1.CancelTodoRequest
+	24, // 21: oniongo.v1.TodoService.PauseTodo:input_type -> oniongo.v1.PauseTodoRequest
+	26, // 22: oniongo.v1.TodoService.ResumeTodo:input_type -> oniongo.v1.ResumeTodoRequest
+	28, // 23: oniongo.v1.TodoService.TransitionTodo:input_type -> oniongo.v1.TransitionTodoRequest
+	30, // 24: oniongo.v1.TodoService.RevertTodo:input_type -> oniongo.v1.RevertTodoRequest
+	32, // 25: oniongo.v1.TodoService.DeleteTodo:input_type -> oniongo.v1.DeleteTodoRequest
+	34, // 26: oniongo.v1.TodoService.AddTodoTag:input_type -> oniongo.v1.AddTodoTagRequest
+	36, // 27: oniongo.v1.TodoService.RemoveTodoTag:input_type -> oniongo.v1.RemoveTodoTagRequest
+	38, // 28: oniongo.v1.TodoService.MoveTodo:input_type -> oniongo.v1.MoveTodoRequest
+	40, // 29: oniongo.v1.TodoService.AddDependency:input_type -> oniongo.v1.AddDependencyRequest
+	42, // 30: oniongo.v1.TodoService.RemoveDependency:input_type -> oniongo.v1.RemoveDependencyRequest
+	6,  // 31: oniongo.v1.TodoService.CreateTodo:output_type -> oniongo.v1.CreateTodoResponse
+	8,  // 32: oniongo.v1.TodoService.GetTodo:output_type -> oniongo.v1.GetTodoResponse
+	10, // 33: oniongo.v1.TodoService.GetTodos:output_type -> oniongo.v1.GetTodosResponse
+	13, // 34: oniongo.v1.TodoService.SearchTodos:output_type -> oniongo.v1.SearchTodosResponse
+	15, // 35: oniongo.v1.TodoService.UpdateTodo:output_type -> oniongo.v1.UpdateTodoResponse
+	17, // 36: oniongo.v1.TodoService.StartTodo:output_type -> oniongo.v1.StartTodoResponse
+	19, // 37: oniongo.v1.TodoService.CompleteTodo:output_type -> oniongo.v1.CompleteTodoResponse
+	21, // 38: oniongo.v1.TodoService.ReopenTodo:output_type -> oniongo.v1.ReopenTodoResponse
+	23, // 39: oniongo.v1.TodoService.CancelTodo:output_type -> oniongo.v1.CancelTodoResponse
+	25, // 40: oniongo.v1.TodoService.PauseTodo:output_type -> oniongo.v1.PauseTodoResponse
+	27, // 41: oniongo.v1.TodoService.ResumeTodo:output_type -> oniongo.v1.ResumeTodoResponse
+	29, // 42: oniongo.v1.TodoService.TransitionTodo:output_type -> oniongo.v1.TransitionTodoResponse
+	31, // 43: oniongo.v1.TodoService.RevertTodo:output_type -> oniongo.v1.RevertTodoResponse
+	33, // 44: oniongo.v1.TodoService.DeleteTodo:output_type -> oniongo.v1.DeleteTodoResponse
+	35, // 45: oniongo.v1.TodoService.AddTodoTag:output_type -> oniongo.v1.AddTodoTagResponse
+	37, // 46: oniongo.v1.TodoService.RemoveTodoTag:output_type -> oniongo.v1.RemoveTodoTagResponse
+	39, // 47: oniongo.v1.TodoService.MoveTodo:output_type -> oniongo.v1.MoveTodoResponse
+	41, // 48: oniongo.v1.TodoService.AddDependency:output_type -> oniongo.v1.AddDependencyResponse
+	43, // 49: oniongo.v1.TodoService.RemoveDependency:output_type -> oniongo.v1.RemoveDependencyResponse
+	31, // [31:50] is the sub-list for method output_type
+	12, // [12:31] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_oniongo_v1_todo_proto_init() }
@@ -888,15 +2606,21 @@ func file_oniongo_v1_todo_proto_init() {
 		return
 	}
 	file_oniongo_v1_todo_proto_msgTypes[0].OneofWrappers = []any{}
-	file_oniongo_v1_todo_proto_msgTypes[1].OneofWrappers = []any{}
+	file_oniongo_v1_todo_proto_msgTypes[3].OneofWrappers = []any{}
+	file_oniongo_v1_todo_proto_msgTypes[5].OneofWrappers = []any{
+		(*GetTodoRequest_AsOf)(nil),
+		(*GetTodoRequest_Version)(nil),
+	}
 	file_oniongo_v1_todo_proto_msgTypes[7].OneofWrappers = []any{}
+	file_oniongo_v1_todo_proto_msgTypes[12].OneofWrappers = []any{}
+	file_oniongo_v1_todo_proto_msgTypes[36].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_oniongo_v1_todo_proto_rawDesc), len(file_oniongo_v1_todo_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      2,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
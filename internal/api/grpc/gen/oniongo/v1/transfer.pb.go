@@ -0,0 +1,1089 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: oniongo/v1/transfer.proto
+
+package oniongov1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TodoFormat is a file format todo items are imported from and exported to
+type TodoFormat int32
+
+const (
+	TodoFormat_TODO_FORMAT_UNSPECIFIED TodoFormat = 0
+	// One JSON object per line with every field of a todo item
+	TodoFormat_TODO_FORMAT_JSONL TodoFormat = 1
+	// Comma separated values with a header row
+	TodoFormat_TODO_FORMAT_CSV TodoFormat = 2
+	// Markdown task list, "- [ ]" for open and "- [x]" for completed todo items
+	TodoFormat_TODO_FORMAT_MARKDOWN TodoFormat = 3
+	// todo.txt, one todo item per line without the body
+	TodoFormat_TODO_FORMAT_TODOTXT TodoFormat = 4
+)
+
+// Enum value maps for TodoFormat.
+var (
+	TodoFormat_name = map[int32]string{
+		0: "TODO_FORMAT_UNSPECIFIED",
+		1: "TODO_FORMAT_JSONL",
+		2: "TODO_FORMAT_CSV",
+		3: "TODO_FORMAT_MARKDOWN",
+		4: "TODO_FORMAT_TODOTXT",
+	}
+	TodoFormat_value = map[string]int32{
+		"TODO_FORMAT_UNSPECIFIED": 0,
+		"TODO_FORMAT_JSONL":       1,
+		"TODO_FORMAT_CSV":         2,
+		"TODO_FORMAT_MARKDOWN":    3,
+		"TODO_FORMAT_TODOTXT":     4,
+	}
+)
+
+func (x TodoFormat) Enum() *TodoFormat {
+	p := new(TodoFormat)
+	*p = x
+	return p
+}
+
+func (x TodoFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TodoFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_oniongo_v1_transfer_proto_enumTypes[0].Descriptor()
+}
+
+func (TodoFormat) Type() protoreflect.EnumType {
+	return &file_oniongo_v1_transfer_proto_enumTypes[0]
+}
+
+func (x TodoFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TodoFormat.Descriptor instead.
+func (TodoFormat) EnumDescriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+// ImportSource is another tool whose export files can be imported
+type ImportSource int32
+
+const (
+	ImportSource_IMPORT_SOURCE_UNSPECIFIED ImportSource = 0
+	// Response of the Todoist Sync API for all resources
+	ImportSource_IMPORT_SOURCE_TODOIST ImportSource = 1
+	// Trello board exported as JSON
+	ImportSource_IMPORT_SOURCE_TRELLO ImportSource = 2
+	// JSON array of GitHub issues from the REST API or "gh issue list --json"
+	ImportSource_IMPORT_SOURCE_GITHUB ImportSource = 3
+)
+
+// Enum value maps for ImportSource.
+var (
+	ImportSource_name = map[int32]string{
+		0: "IMPORT_SOURCE_UNSPECIFIED",
+		1: "IMPORT_SOURCE_TODOIST",
+		2: "IMPORT_SOURCE_TRELLO",
+		3: "IMPORT_SOURCE_GITHUB",
+	}
+	ImportSource_value = map[string]int32{
+		"IMPORT_SOURCE_UNSPECIFIED": 0,
+		"IMPORT_SOURCE_TODOIST":     1,
+		"IMPORT_SOURCE_TRELLO":      2,
+		"IMPORT_SOURCE_GITHUB":      3,
+	}
+)
+
+func (x ImportSource) Enum() *ImportSource {
+	p := new(ImportSource)
+	*p = x
+	return p
+}
+
+func (x ImportSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_oniongo_v1_transfer_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportSource) Type() protoreflect.EnumType {
+	return &file_oniongo_v1_transfer_proto_enumTypes[1]
+}
+
+func (x ImportSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportSource.Descriptor instead.
+func (ImportSource) EnumDescriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+// ImportItemKind is the kind of entity an item of another tool is imported as
+type ImportItemKind int32
+
+const (
+	ImportItemKind_IMPORT_ITEM_KIND_UNSPECIFIED ImportItemKind = 0
+	ImportItemKind_IMPORT_ITEM_KIND_PROJECT     ImportItemKind = 1
+	ImportItemKind_IMPORT_ITEM_KIND_TAG         ImportItemKind = 2
+	ImportItemKind_IMPORT_ITEM_KIND_TODO        ImportItemKind = 3
+)
+
+// Enum value maps for ImportItemKind.
+var (
+	ImportItemKind_name = map[int32]string{
+		0: "IMPORT_ITEM_KIND_UNSPECIFIED",
+		1: "IMPORT_ITEM_KIND_PROJECT",
+		2: "IMPORT_ITEM_KIND_TAG",
+		3: "IMPORT_ITEM_KIND_TODO",
+	}
+	ImportItemKind_value = map[string]int32{
+		"IMPORT_ITEM_KIND_UNSPECIFIED": 0,
+		"IMPORT_ITEM_KIND_PROJECT":     1,
+		"IMPORT_ITEM_KIND_TAG":         2,
+		"IMPORT_ITEM_KIND_TODO":        3,
+	}
+)
+
+func (x ImportItemKind) Enum() *ImportItemKind {
+	p := new(ImportItemKind)
+	*p = x
+	return p
+}
+
+func (x ImportItemKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportItemKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_oniongo_v1_transfer_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportItemKind) Type() protoreflect.EnumType {
+	return &file_oniongo_v1_transfer_proto_enumTypes[2]
+}
+
+func (x ImportItemKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportItemKind.Descriptor instead.
+func (ImportItemKind) EnumDescriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+type ExportTodosRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format TodoFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=oniongo.v1.TodoFormat" json:"format,omitempty"`
+	// The filters work like in GetTodosRequest
+	TagIds         []string     `protobuf:"bytes,2,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	TagMatch       TagMatchMode `protobuf:"varint,3,opt,name=tag_match,json=tagMatch,proto3,enum=oniongo.v1.TagMatchMode" json:"tag_match,omitempty"`
+	ProjectId      *string      `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	ActionableOnly bool         `protobuf:"varint,5,opt,name=actionable_only,json=actionableOnly,proto3" json:"actionable_only,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportTodosRequest) Reset() {
+	*x = ExportTodosRequest{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTodosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTodosRequest) ProtoMessage() {}
+
+func (x *ExportTodosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTodosRequest.ProtoReflect.Descriptor instead.
+func (*ExportTodosRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportTodosRequest) GetFormat() TodoFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TodoFormat_TODO_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportTodosRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *ExportTodosRequest) GetTagMatch() TagMatchMode {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *ExportTodosRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *ExportTodosRequest) GetActionableOnly() bool {
+	if x != nil {
+		return x.ActionableOnly
+	}
+	return false
+}
+
+// The content of the exported file in chunks
+type ExportTodosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTodosResponse) Reset() {
+	*x = ExportTodosResponse{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTodosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTodosResponse) ProtoMessage() {}
+
+func (x *ExportTodosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTodosResponse.ProtoReflect.Descriptor instead.
+func (*ExportTodosResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportTodosResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// ImportTodosMetadata describes a file before its content is uploaded
+type ImportTodosMetadata struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format TodoFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=oniongo.v1.TodoFormat" json:"format,omitempty"`
+	// Reports what would be imported without saving anything
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTodosMetadata) Reset() {
+	*x = ImportTodosMetadata{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTodosMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTodosMetadata) ProtoMessage() {}
+
+func (x *ImportTodosMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTodosMetadata.ProtoReflect.Descriptor instead.
+func (*ImportTodosMetadata) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportTodosMetadata) GetFormat() TodoFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TodoFormat_TODO_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportTodosMetadata) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// The first message of an import carries the metadata,
+// and the following messages carry the content in chunks.
+type ImportTodosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportTodosRequest_Metadata
+	//	*ImportTodosRequest_Chunk
+	Payload       isImportTodosRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTodosRequest) Reset() {
+	*x = ImportTodosRequest{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTodosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTodosRequest) ProtoMessage() {}
+
+func (x *ImportTodosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTodosRequest.ProtoReflect.Descriptor instead.
+func (*ImportTodosRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportTodosRequest) GetPayload() isImportTodosRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportTodosRequest) GetMetadata() *ImportTodosMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTodosRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *ImportTodosRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportTodosRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportTodosRequest_Payload interface {
+	isImportTodosRequest_Payload()
+}
+
+type ImportTodosRequest_Metadata struct {
+	Metadata *ImportTodosMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type ImportTodosRequest_Chunk struct {
+	// At most 1 MiB per message
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportTodosRequest_Metadata) isImportTodosRequest_Payload() {}
+
+func (*ImportTodosRequest_Chunk) isImportTodosRequest_Payload() {}
+
+// ImportDuplicate is a skipped line whose todo item ID already exists
+type ImportDuplicate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDuplicate) Reset() {
+	*x = ImportDuplicate{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDuplicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDuplicate) ProtoMessage() {}
+
+func (x *ImportDuplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDuplicate.ProtoReflect.Descriptor instead.
+func (*ImportDuplicate) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportDuplicate) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportDuplicate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ImportLineError is a skipped line that could not be read or failed validation
+type ImportLineError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportLineError) Reset() {
+	*x = ImportLineError{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportLineError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportLineError) ProtoMessage() {}
+
+func (x *ImportLineError) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportLineError.ProtoReflect.Descriptor instead.
+func (*ImportLineError) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportLineError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportLineError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportTodosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of todo items created, or that would be created in a dry run
+	Imported      int32              `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Duplicates    []*ImportDuplicate `protobuf:"bytes,2,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Errors        []*ImportLineError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	DryRun        bool               `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTodosResponse) Reset() {
+	*x = ImportTodosResponse{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTodosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTodosResponse) ProtoMessage() {}
+
+func (x *ImportTodosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTodosResponse.ProtoReflect.Descriptor instead.
+func (*ImportTodosResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImportTodosResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportTodosResponse) GetDuplicates() []*ImportDuplicate {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+func (x *ImportTodosResponse) GetErrors() []*ImportLineError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportTodosResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportFromSourceMetadata describes an export file of another tool before its content is uploaded
+type ImportFromSourceMetadata struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Source ImportSource           `protobuf:"varint,1,opt,name=source,proto3,enum=oniongo.v1.ImportSource" json:"source,omitempty"`
+	// Reports what would be imported without saving anything
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFromSourceMetadata) Reset() {
+	*x = ImportFromSourceMetadata{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFromSourceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFromSourceMetadata) ProtoMessage() {}
+
+func (x *ImportFromSourceMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFromSourceMetadata.ProtoReflect.Descriptor instead.
+func (*ImportFromSourceMetadata) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImportFromSourceMetadata) GetSource() ImportSource {
+	if x != nil {
+		return x.Source
+	}
+	return ImportSource_IMPORT_SOURCE_UNSPECIFIED
+}
+
+func (x *ImportFromSourceMetadata) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// The first message of an import carries the metadata,
+// and the following messages carry the content in chunks.
+type ImportFromSourceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportFromSourceRequest_Metadata
+	//	*ImportFromSourceRequest_Chunk
+	Payload       isImportFromSourceRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFromSourceRequest) Reset() {
+	*x = ImportFromSourceRequest{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFromSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFromSourceRequest) ProtoMessage() {}
+
+func (x *ImportFromSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFromSourceRequest.ProtoReflect.Descriptor instead.
+func (*ImportFromSourceRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ImportFromSourceRequest) GetPayload() isImportFromSourceRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportFromSourceRequest) GetMetadata() *ImportFromSourceMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportFromSourceRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *ImportFromSourceRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportFromSourceRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportFromSourceRequest_Payload interface {
+	isImportFromSourceRequest_Payload()
+}
+
+type ImportFromSourceRequest_Metadata struct {
+	Metadata *ImportFromSourceMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type ImportFromSourceRequest_Chunk struct {
+	// At most 1 MiB per message
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportFromSourceRequest_Metadata) isImportFromSourceRequest_Payload() {}
+
+func (*ImportFromSourceRequest_Chunk) isImportFromSourceRequest_Payload() {}
+
+// ImportItemError is an item of another tool that could not be imported
+type ImportItemError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  ImportItemKind         `protobuf:"varint,1,opt,name=kind,proto3,enum=oniongo.v1.ImportItemKind" json:"kind,omitempty"`
+	// Identifier of the item in the other tool
+	ExternalId    string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportItemError) Reset() {
+	*x = ImportItemError{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportItemError) ProtoMessage() {}
+
+func (x *ImportItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportItemError.ProtoReflect.Descriptor instead.
+func (*ImportItemError) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportItemError) GetKind() ImportItemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ImportItemKind_IMPORT_ITEM_KIND_UNSPECIFIED
+}
+
+func (x *ImportItemError) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ImportItemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportFromSourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Numbers of entities created, or that would be created in a dry run
+	Projects int32 `protobuf:"varint,1,opt,name=projects,proto3" json:"projects,omitempty"`
+	Tags     int32 `protobuf:"varint,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	Todos    int32 `protobuf:"varint,3,opt,name=todos,proto3" json:"todos,omitempty"`
+	// Number of items imported by an earlier run
+	Skipped       int32              `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Errors        []*ImportItemError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	DryRun        bool               `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFromSourceResponse) Reset() {
+	*x = ImportFromSourceResponse{}
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFromSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFromSourceResponse) ProtoMessage() {}
+
+func (x *ImportFromSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v1_transfer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFromSourceResponse.ProtoReflect.Descriptor instead.
+func (*ImportFromSourceResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v1_transfer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportFromSourceResponse) GetProjects() int32 {
+	if x != nil {
+		return x.Projects
+	}
+	return 0
+}
+
+func (x *ImportFromSourceResponse) GetTags() int32 {
+	if x != nil {
+		return x.Tags
+	}
+	return 0
+}
+
+func (x *ImportFromSourceResponse) GetTodos() int32 {
+	if x != nil {
+		return x.Todos
+	}
+	return 0
+}
+
+func (x *ImportFromSourceResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportFromSourceResponse) GetErrors() []*ImportItemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportFromSourceResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+var File_oniongo_v1_transfer_proto protoreflect.FileDescriptor
+
+const file_oniongo_v1_transfer_proto_rawDesc = "" +
+	"\n" +
+	"\x19oniongo/v1/transfer.proto\x12\n" +
+	"oniongo.v1\x1a\x1bbuf/validate/validate.proto\x1a\x15oniongo/v1/todo.proto\"\x95\x02\n" +
+	"\x12ExportTodosRequest\x12:\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x16.oniongo.v1.TodoFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x12&\n" +
+	"\atag_ids\x18\x02 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\x06tagIds\x125\n" +
+	"\ttag_match\x18\x03 \x01(\x0e2\x18.oniongo.v1.TagMatchModeR\btagMatch\x12,\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\tprojectId\x88\x01\x01\x12'\n" +
+	"\x0factionable_only\x18\x05 \x01(\bR\x0eactionableOnlyB\r\n" +
+	"\v_project_id\"+\n" +
+	"\x13ExportTodosResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"j\n" +
+	"\x13ImportTodosMetadata\x12:\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x16.oniongo.v1.TodoFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x88\x01\n" +
+	"\x12ImportTodosRequest\x12=\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1f.oniongo.v1.ImportTodosMetadataH\x00R\bmetadata\x12!\n" +
+	"\x05chunk\x18\x02 \x01(\fB\t\xbaH\x06z\x04\x18\x80\x80@H\x00R\x05chunkB\x10\n" +
+	"\apayload\x12\x05\xbaH\x02\b\x01\"5\n" +
+	"\x0fImportDuplicate\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"?\n" +
+	"\x0fImportLineError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xbc\x01\n" +
+	"\x13ImportTodosResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12;\n" +
+	"\n" +
+	"duplicates\x18\x02 \x03(\v2\x1b.oniongo.v1.ImportDuplicateR\n" +
+	"duplicates\x123\n" +
+	"\x06errors\x18\x03 \x03(\v2\x1b.oniongo.v1.ImportLineErrorR\x06errors\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"q\n" +
+	"\x18ImportFromSourceMetadata\x12<\n" +
+	"\x06source\x18\x01 \x01(\x0e2\x18.oniongo.v1.ImportSourceB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06source\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x92\x01\n" +
+	"\x17ImportFromSourceRequest\x12B\n" +
+	"\bmetadata\x18\x01 \x01(\v2$.oniongo.v1.ImportFromSourceMetadataH\x00R\bmetadata\x12!\n" +
+	"\x05chunk\x18\x02 \x01(\fB\t\xbaH\x06z\x04\x18\x80\x80@H\x00R\x05chunkB\x10\n" +
+	"\apayload\x12\x05\xbaH\x02\b\x01\"|\n" +
+	"\x0fImportItemError\x12.\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1a.oniongo.v1.ImportItemKindR\x04kind\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xc8\x01\n" +
+	"\x18ImportFromSourceResponse\x12\x1a\n" +
+	"\bprojects\x18\x01 \x01(\x05R\bprojects\x12\x12\n" +
+	"\x04tags\x18\x02 \x01(\x05R\x04tags\x12\x14\n" +
+	"\x05todos\x18\x03 \x01(\x05R\x05todos\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\x123\n" +
+	"\x06errors\x18\x05 \x03(\v2\x1b.oniongo.v1.ImportItemErrorR\x06errors\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun*\x88\x01\n" +
+	"\n" +
+	"TodoFormat\x12\x1b\n" +
+	"\x17TODO_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TODO_FORMAT_JSONL\x10\x01\x12\x13\n" +
+	"\x0fTODO_FORMAT_CSV\x10\x02\x12\x18\n" +
+	"\x14TODO_FORMAT_MARKDOWN\x10\x03\x12\x17\n" +
+	"\x13TODO_FORMAT_TODOTXT\x10\x04*|\n" +
+	"\fImportSource\x12\x1d\n" +
+	"\x19IMPORT_SOURCE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15IMPORT_SOURCE_TODOIST\x10\x01\x12\x18\n" +
+	"\x14IMPORT_SOURCE_TRELLO\x10\x02\x12\x18\n" +
+	"\x14IMPORT_SOURCE_GITHUB\x10\x03*\x85\x01\n" +
+	"\x0eImportItemKind\x12 \n" +
+	"\x1cIMPORT_ITEM_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18IMPORT_ITEM_KIND_PROJECT\x10\x01\x12\x18\n" +
+	"\x14IMPORT_ITEM_KIND_TAG\x10\x02\x12\x19\n" +
+	"\x15IMPORT_ITEM_KIND_TODO\x10\x032\x96\x02\n" +
+	"\x0fTransferService\x12P\n" +
+	"\vExportTodos\x12\x1e.oniongo.v1.ExportTodosRequest\x1a\x1f.oniongo.v1.ExportTodosResponse0\x01\x12P\n" +
+	"\vImportTodos\x12\x1e.oniongo.v1.ImportTodosRequest\x1a\x1f.oniongo.v1.ImportTodosResponse(\x01\x12_\n" +
+	"\x10ImportFromSource\x12#.oniongo.v1.ImportFromSourceRequest\x1a$.oniongo.v1.ImportFromSourceResponse(\x01B\xb2\x01\n" +
+	"\x0ecom.oniongo.v1B\rTransferProtoP\x01ZHgithub.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1;oniongov1\xa2\x02\x03OXX\xaa\x02\n" +
+	"Oniongo.V1\xca\x02\n" +
+	"Oniongo\\V1\xe2\x02\x16Oniongo\\V1\\GPBMetadata\xea\x02\vOniongo::V1b\x06proto3"
+
+var (
+	file_oniongo_v1_transfer_proto_rawDescOnce sync.Once
+	file_oniongo_v1_transfer_proto_rawDescData []byte
+)
+
+func file_oniongo_v1_transfer_proto_rawDescGZIP() []byte {
+	file_oniongo_v1_transfer_proto_rawDescOnce.Do(func() {
+		file_oniongo_v1_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_oniongo_v1_transfer_proto_rawDesc), len(file_oniongo_v1_transfer_proto_rawDesc)))
+	})
+	return file_oniongo_v1_transfer_proto_rawDescData
+}
+
+var file_oniongo_v1_transfer_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_oniongo_v1_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_oniongo_v1_transfer_proto_goTypes = []any{
+	(TodoFormat)(0),                  // 0: oniongo.v1.TodoFormat
+	(ImportSource)(0),                // 1: oniongo.v1.ImportSource
+	(ImportItemKind)(0),              // 2: oniongo.v1.ImportItemKind
+	(*ExportTodosRequest)(nil),       // 3: oniongo.v1.ExportTodosRequest
+	(*ExportTodosResponse)(nil),      // 4: oniongo.v1.ExportTodosResponse
+	(*ImportTodosMetadata)(nil),      // 5: oniongo.v1.ImportTodosMetadata
+	(*ImportTodosRequest)(nil),       // 6: oniongo.v1.ImportTodosRequest
+	(*ImportDuplicate)(nil),          // 7: oniongo.v1.ImportDuplicate
+	(*ImportLineError)(nil),          // 8: oniongo.v1.ImportLineError
+	(*ImportTodosResponse)(nil),      // 9: oniongo.v1.ImportTodosResponse
+	(*ImportFromSourceMetadata)(nil), // 10: oniongo.v1.ImportFromSourceMetadata
+	(*ImportFromSourceRequest)(nil),  // 11: oniongo.v1.ImportFromSourceRequest
+	(*ImportItemError)(nil),          // 12: oniongo.v1.ImportItemError
+	(*ImportFromSourceResponse)(nil), // 13: oniongo.v1.ImportFromSourceResponse
+	(TagMatchMode)(0),                // 14: oniongo.v1.TagMatchMode
+}
+var file_oniongo_v1_transfer_proto_depIdxs = []int32{
+	0,  // 0: oniongo.v1.ExportTodosRequest.format:type_name -> oniongo.v1.TodoFormat
+	14, // 1: oniongo.v1.ExportTodosRequest.tag_match:type_name -> oniongo.v1.TagMatchMode
+	0,  // 2: oniongo.v1.ImportTodosMetadata.format:type_name -> oniongo.v1.TodoFormat
+	5,  // 3: oniongo.v1.ImportTodosRequest.metadata:type_name -> oniongo.v1.ImportTodosMetadata
+	7,  // 4: oniongo.v1.ImportTodosResponse.duplicates:type_name -> oniongo.v1.ImportDuplicate
+	8,  // 5: oniongo.v1.ImportTodosResponse.errors:type_name -> oniongo.v1.ImportLineError
+	1,  // 6: oniongo.v1.ImportFromSourceMetadata.source:type_name -> oniongo.v1.ImportSource
+	10, // 7: oniongo.v1.ImportFromSourceRequest.metadata:type_name -> oniongo.v1.ImportFromSourceMetadata
+	2,  // 8: oniongo.v1.ImportItemError.kind:type_name -> oniongo.v1.ImportItemKind
+	12, // 9: oniongo.v1.ImportFromSourceResponse.errors:type_name -> oniongo.v1.ImportItemError
+	3,  // 10: oniongo.v1.TransferService.ExportTodos:input_type -> oniongo.v1.ExportTodosRequest
+	6,  // 11: oniongo.v1.TransferService.ImportTodos:input_type -> oniongo.v1.ImportTodosRequest
+	11, // 12: oniongo.v1.TransferService.ImportFromSource:input_type -> oniongo.v1.ImportFromSourceRequest
+	4,  // 13: oniongo.v1.TransferService.ExportTodos:output_type -> oniongo.v1.ExportTodosResponse
+	9,  // 14: oniongo.v1.TransferService.ImportTodos:output_type -> oniongo.v1.ImportTodosResponse
+	13, // 15: oniongo.v1.TransferService.ImportFromSource:output_type -> oniongo.v1.ImportFromSourceResponse
+	13, // [13:16] is the sub-list for method output_type
+	10, // [10:13] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_oniongo_v1_transfer_proto_init() }
+func file_oniongo_v1_transfer_proto_init() {
+	if File_oniongo_v1_transfer_proto != nil {
+		return
+	}
+	file_oniongo_v1_todo_proto_init()
+	file_oniongo_v1_transfer_proto_msgTypes[0].OneofWrappers = []any{}
+	file_oniongo_v1_transfer_proto_msgTypes[3].OneofWrappers = []any{
+		(*ImportTodosRequest_Metadata)(nil),
+		(*ImportTodosRequest_Chunk)(nil),
+	}
+	file_oniongo_v1_transfer_proto_msgTypes[8].OneofWrappers = []any{
+		(*ImportFromSourceRequest_Metadata)(nil),
+		(*ImportFromSourceRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_oniongo_v1_transfer_proto_rawDesc), len(file_oniongo_v1_transfer_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_oniongo_v1_transfer_proto_goTypes,
+		DependencyIndexes: file_oniongo_v1_transfer_proto_depIdxs,
+		EnumInfos:         file_oniongo_v1_transfer_proto_enumTypes,
+		MessageInfos:      file_oniongo_v1_transfer_proto_msgTypes,
+	}.Build()
+	File_oniongo_v1_transfer_proto = out.File
+	file_oniongo_v1_transfer_proto_goTypes = nil
+	file_oniongo_v1_transfer_proto_depIdxs = nil
+}
@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: oniongo/v2/todo.proto
+
+package oniongov2connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v2 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TodoServiceName is the fully-qualified name of the TodoService service.
+	TodoServiceName = "oniongo.v2.TodoService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TodoServiceCreateTodoProcedure is the fully-qualified name of the TodoService's CreateTodo RPC.
+	TodoServiceCreateTodoProcedure = "/oniongo.v2.TodoService/CreateTodo"
+	// TodoServiceGetTodoProcedure is the fully-qualified name of the TodoService's GetTodo RPC.
+	TodoServiceGetTodoProcedure = "/oniongo.v2.TodoService/GetTodo"
+	// TodoServiceListTodosProcedure is the fully-qualified name of the TodoService's ListTodos RPC.
+	TodoServiceListTodosProcedure = "/oniongo.v2.TodoService/ListTodos"
+	// TodoServiceUpdateTodoProcedure is the fully-qualified name of the TodoService's UpdateTodo RPC.
+	TodoServiceUpdateTodoProcedure = "/oniongo.v2.TodoService/UpdateTodo"
+	// TodoServiceDeleteTodoProcedure is the fully-qualified name of the TodoService's DeleteTodo RPC.
+	TodoServiceDeleteTodoProcedure = "/oniongo.v2.TodoService/DeleteTodo"
+)
+
+// TodoServiceClient is a client for the oniongo.v2.TodoService service.
+type TodoServiceClient interface {
+	// CreateTodo creates a new todo item
+	CreateTodo(context.Context, *connect.Request[v2.CreateTodoRequest]) (*connect.Response[v2.CreateTodoResponse], error)
+	// GetTodo retrieves a todo item by its ID
+	GetTodo(context.Context, *connect.Request[v2.GetTodoRequest]) (*connect.Response[v2.GetTodoResponse], error)
+	// ListTodos retrieves todo items, optionally filtered by tags, parent, project or actionability
+	ListTodos(context.Context, *connect.Request[v2.ListTodosRequest]) (*connect.Response[v2.ListTodosResponse], error)
+	// UpdateTodo updates the fields of a todo item in update_mask and returns the todo item
+	UpdateTodo(context.Context, *connect.Request[v2.UpdateTodoRequest]) (*connect.Response[v2.UpdateTodoResponse], error)
+	// DeleteTodo deletes a todo item
+	DeleteTodo(context.Context, *connect.Request[v2.DeleteTodoRequest]) (*connect.Response[v2.DeleteTodoResponse], error)
+}
+
+// NewTodoServiceClient constructs a client for the oniongo.v2.TodoService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTodoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TodoServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	todoServiceMethods := v2.File_oniongo_v2_todo_proto.Services().ByName("TodoService").Methods()
+	return &todoServiceClient{
+		createTodo: connect.NewClient[v2.CreateTodoRequest, v2.CreateTodoResponse](
+			httpClient,
+			baseURL+TodoServiceCreateTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("CreateTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		getTodo: connect.NewClient[v2.GetTodoRequest, v2.GetTodoResponse](
+			httpClient,
+			baseURL+TodoServiceGetTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("GetTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		listTodos: connect.NewClient[v2.ListTodosRequest, v2.ListTodosResponse](
+			httpClient,
+			baseURL+TodoServiceListTodosProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ListTodos")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTodo: connect.NewClient[v2.UpdateTodoRequest, v2.UpdateTodoResponse](
+			httpClient,
+			baseURL+TodoServiceUpdateTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("UpdateTodo")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTodo: connect.NewClient[v2.DeleteTodoRequest, v2.DeleteTodoResponse](
+			httpClient,
+			baseURL+TodoServiceDeleteTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("DeleteTodo")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// todoServiceClient implements TodoServiceClient.
+type todoServiceClient struct {
+	createTodo *connect.Client[v2.CreateTodoRequest, v2.CreateTodoResponse]
+	getTodo    *connect.Client[v2.GetTodoRequest, v2.GetTodoResponse]
+	listTodos  *connect.Client[v2.ListTodosRequest, v2.ListTodosResponse]
+	updateTodo *connect.Client[v2.UpdateTodoRequest, v2.UpdateTodoResponse]
+	deleteTodo *connect.Client[v2.DeleteTodoRequest, v2.DeleteTodoResponse]
+}
+
+// CreateTodo calls oniongo.v2.TodoService.CreateTodo.
+func (c *todoServiceClient) CreateTodo(ctx context.Context, req *connect.Request[v2.CreateTodoRequest]) (*connect.Response[v2.CreateTodoResponse], error) {
+	return c.createTodo.CallUnary(ctx, req)
+}
+
+// GetTodo calls oniongo.v2.TodoService.GetTodo.
+func (c *todoServiceClient) GetTodo(ctx context.Context, req *connect.Request[v2.GetTodoRequest]) (*connect.Response[v2.GetTodoResponse], error) {
+	return c.getTodo.CallUnary(ctx, req)
+}
+
+// ListTodos calls oniongo.v2.TodoService.ListTodos.
+func (c *todoServiceClient) ListTodos(ctx context.Context, req *connect.Request[v2.ListTodosRequest]) (*connect.Response[v2.ListTodosResponse], error) {
+	return c.listTodos.CallUnary(ctx, req)
+}
+
+// UpdateTodo calls oniongo.v2.TodoService.UpdateTodo.
+func (c *todoServiceClient) UpdateTodo(ctx context.Context, req *connect.Request[v2.UpdateTodoRequest]) (*connect.Response[v2.UpdateTodoResponse], error) {
+	return c.updateTodo.CallUnary(ctx, req)
+}
+
+// DeleteTodo calls oniongo.v2.TodoService.DeleteTodo.
+func (c *todoServiceClient) DeleteTodo(ctx context.Context, req *connect.Request[v2.DeleteTodoRequest]) (*connect.Response[v2.DeleteTodoResponse], error) {
+	return c.deleteTodo.CallUnary(ctx, req)
+}
+
+// TodoServiceHandler is an implementation of the oniongo.v2.TodoService service.
+type TodoServiceHandler interface {
+	// CreateTodo creates a new todo item
+	CreateTodo(context.Context, *connect.Request[v2.CreateTodoRequest]) (*connect.Response[v2.CreateTodoResponse], error)
+	// GetTodo retrieves a todo item by its ID
+	GetTodo(context.Context, *connect.Request[v2.GetTodoRequest]) (*connect.Response[v2.GetTodoResponse], error)
+	// ListTodos retrieves todo items, optionally filtered by tags, parent, project or actionability
+	ListTodos(context.Context, *connect.Request[v2.ListTodosRequest]) (*connect.Response[v2.ListTodosResponse], error)
+	// UpdateTodo updates the fields of a todo item in update_mask and returns the todo item
+	UpdateTodo(context.Context, *connect.Request[v2.UpdateTodoRequest]) (*connect.Response[v2.UpdateTodoResponse], error)
+	// DeleteTodo deletes a todo item
+	DeleteTodo(context.Context, *connect.Request[v2.DeleteTodoRequest]) (*connect.Response[v2.DeleteTodoResponse], error)
+}
+
+// NewTodoServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTodoServiceHandler(svc TodoServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	todoServiceMethods := v2.File_oniongo_v2_todo_proto.Services().ByName("TodoService").Methods()
+	todoServiceCreateTodoHandler := connect.NewUnaryHandler(
+		TodoServiceCreateTodoProcedure,
+		svc.CreateTodo,
+		connect.WithSchema(todoServiceMethods.ByName("CreateTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceGetTodoHandler := connect.NewUnaryHandler(
+		TodoServiceGetTodoProcedure,
+		svc.GetTodo,
+		connect.WithSchema(todoServiceMethods.ByName("GetTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceListTodosHandler := connect.NewUnaryHandler(
+		TodoServiceListTodosProcedure,
+		svc.ListTodos,
+		connect.WithSchema(todoServiceMethods.ByName("ListTodos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceUpdateTodoHandler := connect.NewUnaryHandler(
+		TodoServiceUpdateTodoProcedure,
+		svc.UpdateTodo,
+		connect.WithSchema(todoServiceMethods.ByName("UpdateTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceDeleteTodoHandler := connect.NewUnaryHandler(
+		TodoServiceDeleteTodoProcedure,
+		svc.DeleteTodo,
+		connect.WithSchema(todoServiceMethods.ByName("DeleteTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/oniongo.v2.TodoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TodoServiceCreateTodoProcedure:
+			todoServiceCreateTodoHandler.ServeHTTP(w, r)
+		case TodoServiceGetTodoProcedure:
+			todoServiceGetTodoHandler.ServeHTTP(w, r)
+		case TodoServiceListTodosProcedure:
+			todoServiceListTodosHandler.ServeHTTP(w, r)
+		case TodoServiceUpdateTodoProcedure:
+			todoServiceUpdateTodoHandler.ServeHTTP(w, r)
+		case TodoServiceDeleteTodoProcedure:
+			todoServiceDeleteTodoHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTodoServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTodoServiceHandler struct{}
+
+func (UnimplementedTodoServiceHandler) CreateTodo(context.Context, *connect.Request[v2.CreateTodoRequest]) (*connect.Response[v2.CreateTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v2.TodoService.CreateTodo is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) GetTodo(context.Context, *connect.Request[v2.GetTodoRequest]) (*connect.Response[v2.GetTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v2.TodoService.GetTodo is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ListTodos(context.Context, *connect.Request[v2.ListTodosRequest]) (*connect.Response[v2.ListTodosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v2.TodoService.ListTodos is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) UpdateTodo(context.Context, *connect.Request[v2.UpdateTodoRequest]) (*connect.Response[v2.UpdateTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v2.TodoService.UpdateTodo is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) DeleteTodo(context.Context, *connect.Request[v2.DeleteTodoRequest]) (*connect.Response[v2.DeleteTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("oniongo.v2.TodoService.DeleteTodo is not implemented"))
+}
@@ -0,0 +1,888 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: oniongo/v2/todo.proto
+
+package oniongov2
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TodoStatus represents the status of a todo item
+type TodoStatus int32
+
+const (
+	TodoStatus_TODO_STATUS_UNSPECIFIED TodoStatus = 0
+	TodoStatus_TODO_STATUS_NOT_STARTED TodoStatus = 1
+	TodoStatus_TODO_STATUS_IN_PROGRESS TodoStatus = 2
+	TodoStatus_TODO_STATUS_COMPLETED   TodoStatus = 3
+	TodoStatus_TODO_STATUS_CANCELLED   TodoStatus = 4
+	TodoStatus_TODO_STATUS_ON_HOLD     TodoStatus = 5
+)
+
+// Enum value maps for TodoStatus.
+var (
+	TodoStatus_name = map[int32]string{
+		0: "TODO_STATUS_UNSPECIFIED",
+		1: "TODO_STATUS_NOT_STARTED",
+		2: "TODO_STATUS_IN_PROGRESS",
+		3: "TODO_STATUS_COMPLETED",
+		4: "TODO_STATUS_CANCELLED",
+		5: "TODO_STATUS_ON_HOLD",
+	}
+	TodoStatus_value = map[string]int32{
+		"TODO_STATUS_UNSPECIFIED": 0,
+		"TODO_STATUS_NOT_STARTED": 1,
+		"TODO_STATUS_IN_PROGRESS": 2,
+		"TODO_STATUS_COMPLETED":   3,
+		"TODO_STATUS_CANCELLED":   4,
+		"TODO_STATUS_ON_HOLD":     5,
+	}
+)
+
+func (x TodoStatus) Enum() *TodoStatus {
+	p := new(TodoStatus)
+	*p = x
+	return p
+}
+
+func (x TodoStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TodoStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_oniongo_v2_todo_proto_enumTypes[0].Descriptor()
+}
+
+func (TodoStatus) Type() protoreflect.EnumType {
+	return &file_oniongo_v2_todo_proto_enumTypes[0]
+}
+
+func (x TodoStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TodoStatus.Descriptor instead.
+func (TodoStatus) EnumDescriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{0}
+}
+
+// Todo represents a todo item.
+// Only title, body, parent_id and project_id are read from requests; the other fields are output only.
+type Todo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Empty when the todo item has no body
+	Body      string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Status    TodoStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=oniongo.v2.TodoStatus" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Unset until the todo item is completed
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	TagIds      []string               `protobuf:"bytes,8,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// ID of the parent todo item. Unset for root todo items.
+	ParentId *string `protobuf:"bytes,9,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// IDs of the todo items that block this todo item
+	BlockerIds []string `protobuf:"bytes,10,rep,name=blocker_ids,json=blockerIds,proto3" json:"blocker_ids,omitempty"`
+	// Status in the project workflow, such as "in_review".
+	// Todo items without a project use the TodoStatus name, such as "IN_PROGRESS".
+	StatusId string `protobuf:"bytes,11,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	// ID of the project the todo item belongs to. Unset for todo items without a project.
+	ProjectId *string `protobuf:"bytes,12,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	// Number of comments on the todo item, deleted comments excluded
+	CommentCount  int32 `protobuf:"varint,13,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Todo) Reset() {
+	*x = Todo{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Todo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Todo) ProtoMessage() {}
+
+func (x *Todo) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Todo.ProtoReflect.Descriptor instead.
+func (*Todo) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Todo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Todo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Todo) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Todo) GetStatus() TodoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TodoStatus_TODO_STATUS_UNSPECIFIED
+}
+
+func (x *Todo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Todo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Todo) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Todo) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *Todo) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *Todo) GetBlockerIds() []string {
+	if x != nil {
+		return x.BlockerIds
+	}
+	return nil
+}
+
+func (x *Todo) GetStatusId() string {
+	if x != nil {
+		return x.StatusId
+	}
+	return ""
+}
+
+func (x *Todo) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *Todo) GetCommentCount() int32 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+type CreateTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The todo item to create. Subtasks without project_id are created in the project of their parent.
+	Todo          *Todo `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTodoRequest) Reset() {
+	*x = CreateTodoRequest{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTodoRequest) ProtoMessage() {}
+
+func (x *CreateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTodoRequest.ProtoReflect.Descriptor instead.
+func (*CreateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTodoRequest) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type CreateTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTodoResponse) Reset() {
+	*x = CreateTodoResponse{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTodoResponse) ProtoMessage() {}
+
+func (x *CreateTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTodoResponse.ProtoReflect.Descriptor instead.
+func (*CreateTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type GetTodoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodoRequest) Reset() {
+	*x = GetTodoRequest{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodoRequest) ProtoMessage() {}
+
+func (x *GetTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodoRequest.ProtoReflect.Descriptor instead.
+func (*GetTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTodoResponse) Reset() {
+	*x = GetTodoResponse{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTodoResponse) ProtoMessage() {}
+
+func (x *GetTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTodoResponse.ProtoReflect.Descriptor instead.
+func (*GetTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type ListTodosRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TagIds []string               `protobuf:"bytes,1,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// Lists only the todo items that have all of the tags instead of any of them
+	MatchAllTags bool `protobuf:"varint,2,opt,name=match_all_tags,json=matchAllTags,proto3" json:"match_all_tags,omitempty"`
+	// Lists only the direct subtasks of the given todo item
+	ParentId *string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	// Lists only unfinished todo items whose blockers are all finished
+	ActionableOnly bool `protobuf:"varint,4,opt,name=actionable_only,json=actionableOnly,proto3" json:"actionable_only,omitempty"`
+	// Lists only the todo items in the given project
+	ProjectId     *string `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTodosRequest) Reset() {
+	*x = ListTodosRequest{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTodosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTodosRequest) ProtoMessage() {}
+
+func (x *ListTodosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTodosRequest.ProtoReflect.Descriptor instead.
+func (*ListTodosRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTodosRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *ListTodosRequest) GetMatchAllTags() bool {
+	if x != nil {
+		return x.MatchAllTags
+	}
+	return false
+}
+
+func (x *ListTodosRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *ListTodosRequest) GetActionableOnly() bool {
+	if x != nil {
+		return x.ActionableOnly
+	}
+	return false
+}
+
+func (x *ListTodosRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+type ListTodosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todos         []*Todo                `protobuf:"bytes,1,rep,name=todos,proto3" json:"todos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTodosResponse) Reset() {
+	*x = ListTodosResponse{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTodosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTodosResponse) ProtoMessage() {}
+
+func (x *ListTodosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTodosResponse.ProtoReflect.Descriptor instead.
+func (*ListTodosResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTodosResponse) GetTodos() []*Todo {
+	if x != nil {
+		return x.Todos
+	}
+	return nil
+}
+
+type UpdateTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The new values of the fields in update_mask. The fields outside it are ignored.
+	Todo *Todo `protobuf:"bytes,2,opt,name=todo,proto3" json:"todo,omitempty"`
+	// Fields to update: "title" and "body", or "*" for both.
+	// A field in the mask without a value in todo is cleared, which fails for the title.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTodoRequest) Reset() {
+	*x = UpdateTodoRequest{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTodoRequest) ProtoMessage() {}
+
+func (x *UpdateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTodoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTodoRequest) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+func (x *UpdateTodoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTodoResponse) Reset() {
+	*x = UpdateTodoResponse{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTodoResponse) ProtoMessage() {}
+
+func (x *UpdateTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTodoResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type DeleteTodoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTodoRequest) Reset() {
+	*x = DeleteTodoRequest{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTodoRequest) ProtoMessage() {}
+
+func (x *DeleteTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTodoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTodoRequest) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTodoResponse) Reset() {
+	*x = DeleteTodoResponse{}
+	mi := &file_oniongo_v2_todo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTodoResponse) ProtoMessage() {}
+
+func (x *DeleteTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oniongo_v2_todo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTodoResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTodoResponse) Descriptor() ([]byte, []int) {
+	return file_oniongo_v2_todo_proto_rawDescGZIP(), []int{10}
+}
+
+var File_oniongo_v2_todo_proto protoreflect.FileDescriptor
+
+const file_oniongo_v2_todo_proto_rawDesc = "" +
+	"\n" +
+	"\x15oniongo/v2/todo.proto\x12\n" +
+	"oniongo.v2\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x98\x04\n" +
+	"\x04Todo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12.\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.oniongo.v2.TodoStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x17\n" +
+	"\atag_ids\x18\b \x03(\tR\x06tagIds\x12*\n" +
+	"\tparent_id\x18\t \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\bparentId\x88\x01\x01\x12\x1f\n" +
+	"\vblocker_ids\x18\n" +
+	" \x03(\tR\n" +
+	"blockerIds\x12\x1b\n" +
+	"\tstatus_id\x18\v \x01(\tR\bstatusId\x12,\n" +
+	"\n" +
+	"project_id\x18\f \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\tprojectId\x88\x01\x01\x12#\n" +
+	"\rcomment_count\x18\r \x01(\x05R\fcommentCountB\f\n" +
+	"\n" +
+	"_parent_idB\r\n" +
+	"\v_project_id\"A\n" +
+	"\x11CreateTodoRequest\x12,\n" +
+	"\x04todo\x18\x01 \x01(\v2\x10.oniongo.v2.TodoB\x06\xbaH\x03\xc8\x01\x01R\x04todo\":\n" +
+	"\x12CreateTodoResponse\x12$\n" +
+	"\x04todo\x18\x01 \x01(\v2\x10.oniongo.v2.TodoR\x04todo\"*\n" +
+	"\x0eGetTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"7\n" +
+	"\x0fGetTodoResponse\x12$\n" +
+	"\x04todo\x18\x01 \x01(\v2\x10.oniongo.v2.TodoR\x04todo\"\x80\x02\n" +
+	"\x10ListTodosRequest\x12&\n" +
+	"\atag_ids\x18\x01 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\x06tagIds\x12$\n" +
+	"\x0ematch_all_tags\x18\x02 \x01(\bR\fmatchAllTags\x12*\n" +
+	"\tparent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\bparentId\x88\x01\x01\x12'\n" +
+	"\x0factionable_only\x18\x04 \x01(\bR\x0eactionableOnly\x12,\n" +
+	"\n" +
+	"project_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\tprojectId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_idB\r\n" +
+	"\v_project_id\";\n" +
+	"\x11ListTodosResponse\x12&\n" +
+	"\x05todos\x18\x01 \x03(\v2\x10.oniongo.v2.TodoR\x05todos\"\xa0\x01\n" +
+	"\x11UpdateTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12,\n" +
+	"\x04todo\x18\x02 \x01(\v2\x10.oniongo.v2.TodoB\x06\xbaH\x03\xc8\x01\x01R\x04todo\x12C\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"updateMask\":\n" +
+	"\x12UpdateTodoResponse\x12$\n" +
+	"\x04todo\x18\x01 \x01(\v2\x10.oniongo.v2.TodoR\x04todo\"-\n" +
+	"\x11DeleteTodoRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x14\n" +
+	"\x12DeleteTodoResponse*\xb2\x01\n" +
+	"\n" +
+	"TodoStatus\x12\x1b\n" +
+	"\x17TODO_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TODO_STATUS_NOT_STARTED\x10\x01\x12\x1b\n" +
+	"\x17TODO_STATUS_IN_PROGRESS\x10\x02\x12\x19\n" +
+	"\x15TODO_STATUS_COMPLETED\x10\x03\x12\x19\n" +
+	"\x15TODO_STATUS_CANCELLED\x10\x04\x12\x17\n" +
+	"\x13TODO_STATUS_ON_HOLD\x10\x052\x82\x03\n" +
+	"\vTodoService\x12K\n" +
+	"\n" +
+	"CreateTodo\x12\x1d.oniongo.v2.CreateTodoRequest\x1a\x1e.oniongo.v2.CreateTodoResponse\x12B\n" +
+	"\aGetTodo\x12\x1a.oniongo.v2.GetTodoRequest\x1a\x1b.oniongo.v2.GetTodoResponse\x12H\n" +
+	"\tListTodos\x12\x1c.oniongo.v2.ListTodosRequest\x1a\x1d.oniongo.v2.ListTodosResponse\x12K\n" +
+	"\n" +
+	"UpdateTodo\x12\x1d.oniongo.v2.UpdateTodoRequest\x1a\x1e.oniongo.v2.UpdateTodoResponse\x12K\n" +
+	"\n" +
+	"DeleteTodo\x12\x1d.oniongo.v2.DeleteTodoRequest\x1a\x1e.oniongo.v2.DeleteTodoResponseB\xae\x01\n" +
+	"\x0ecom.oniongo.v2B\tTodoProtoP\x01ZHgithub.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2;oniongov2\xa2\x02\x03OXX\xaa\x02\n" +
+	"Oniongo.V2\xca\x02\n" +
+	"Oniongo\\V2\xe2\x02\x16Oniongo\\V2\\GPBMetadata\xea\x02\vOniongo::V2b\x06proto3"
+
+var (
+	file_oniongo_v2_todo_proto_rawDescOnce sync.Once
+	file_oniongo_v2_todo_proto_rawDescData []byte
+)
+
+func file_oniongo_v2_todo_proto_rawDescGZIP() []byte {
+	file_oniongo_v2_todo_proto_rawDescOnce.Do(func() {
+		file_oniongo_v2_todo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_oniongo_v2_todo_proto_rawDesc), len(file_oniongo_v2_todo_proto_rawDesc)))
+	})
+	return file_oniongo_v2_todo_proto_rawDescData
+}
+
+var file_oniongo_v2_todo_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_oniongo_v2_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_oniongo_v2_todo_proto_goTypes = []any{
+	(TodoStatus)(0),               // 0: oniongo.v2.TodoStatus
+	(*Todo)(nil),                  // 1: oniongo.v2.Todo
+	(*CreateTodoRequest)(nil),     // 2: oniongo.v2.CreateTodoRequest
+	(*CreateTodoResponse)(nil),    // 3: oniongo.v2.CreateTodoResponse
+	(*GetTodoRequest)(nil),        // 4: oniongo.v2.GetTodoRequest
+	(*GetTodoResponse)(nil),       // 5: oniongo.v2.GetTodoResponse
+	(*ListTodosRequest)(nil),      // 6: oniongo.v2.ListTodosRequest
+	(*ListTodosResponse)(nil),     // 7: oniongo.v2.ListTodosResponse
+	(*UpdateTodoRequest)(nil),     // 8: oniongo.v2.UpdateTodoRequest
+	(*UpdateTodoResponse)(nil),    // 9: oniongo.v2.UpdateTodoResponse
+	(*DeleteTodoRequest)(nil),     // 10: oniongo.v2.DeleteTodoRequest
+	(*DeleteTodoResponse)(nil),    // 11: oniongo.v2.DeleteTodoResponse
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 13: google.protobuf.FieldMask
+}
+var file_oniongo_v2_todo_proto_depIdxs = []int32{
+	0,  // 0: oniongo.v2.Todo.status:type_name -> oniongo.v2.TodoStatus
+	12, // 1: oniongo.v2.Todo.created_at:type_name -> google.protobuf.Timestamp
+	12, // 2: oniongo.v2.Todo.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 3: oniongo.v2.Todo.completed_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: oniongo.v2.CreateTodoRequest.todo:type_name -> oniongo.v2.Todo
+	1,  // 5: oniongo.v2.CreateTodoResponse.todo:type_name -> oniongo.v2.Todo
+	1,  // 6: oniongo.v2.GetTodoResponse.todo:type_name -> oniongo.v2.Todo
+	1,  // 7: oniongo.v2.ListTodosResponse.todos:type_name -> oniongo.v2.Todo
+	1,  // 8: oniongo.v2.UpdateTodoRequest.todo:type_name -> oniongo.v2.Todo
+	13, // 9: oniongo.v2.UpdateTodoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 10: oniongo.v2.UpdateTodoResponse.todo:type_name -> oniongo.v2.Todo
+	2,  // 11: oniongo.v2.TodoService.CreateTodo:input_type -> oniongo.v2.CreateTodoRequest
+	4,  // 12: oniongo.v2.TodoService.GetTodo:input_type -> oniongo.v2.GetTodoRequest
+	6,  // 13: oniongo.v2.TodoService.ListTodos:input_type -> oniongo.v2.ListTodosRequest
+	8,  // 14: oniongo.v2.TodoService.UpdateTodo:input_type -> oniongo.v2.UpdateTodoRequest
+	10, // 15: oniongo.v2.TodoService.DeleteTodo:input_type -> oniongo.v2.DeleteTodoRequest
+	3,  // 16: oniongo.v2.TodoService.CreateTodo:output_type -> oniongo.v2.CreateTodoResponse
+	5,  // 17: oniongo.v2.TodoService.GetTodo:output_type -> oniongo.v2.GetTodoResponse
+	7,  // 18: oniongo.v2.TodoService.ListTodos:output_type -> oniongo.v2.ListTodosResponse
+	9,  // 19: oniongo.v2.TodoService.UpdateTodo:output_type -> oniongo.v2.UpdateTodoResponse
+	11, // 20: oniongo.v2.TodoService.DeleteTodo:output_type -> oniongo.v2.DeleteTodoResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_oniongo_v2_todo_proto_init() }
+func file_oniongo_v2_todo_proto_init() {
+	if File_oniongo_v2_todo_proto != nil {
+		return
+	}
+	file_oniongo_v2_todo_proto_msgTypes[0].OneofWrappers = []any{}
+	file_oniongo_v2_todo_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_oniongo_v2_todo_proto_rawDesc), len(file_oniongo_v2_todo_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_oniongo_v2_todo_proto_goTypes,
+		DependencyIndexes: file_oniongo_v2_todo_proto_depIdxs,
+		EnumInfos:         file_oniongo_v2_todo_proto_enumTypes,
+		MessageInfos:      file_oniongo_v2_todo_proto_msgTypes,
+	}.Build()
+	File_oniongo_v2_todo_proto = out.File
+	file_oniongo_v2_todo_proto_goTypes = nil
+	file_oniongo_v2_todo_proto_depIdxs = nil
+}
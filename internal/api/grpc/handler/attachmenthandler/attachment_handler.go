@@ -0,0 +1,41 @@
+package attachmenthandler
+
+import (
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/samber/do"
+)
+
+// attachmentServiceHandler combines all individual handlers to implement AttachmentServiceHandler
+type attachmentServiceHandler struct {
+	*uploadAttachmentHandler
+	*downloadAttachmentHandler
+	*listAttachmentsHandler
+	*deleteAttachmentHandler
+}
+
+// NewAttachmentServiceHandler creates a new AttachmentServiceHandler using composition
+func NewAttachmentServiceHandler(i *do.Injector) (v1connect.AttachmentServiceHandler, error) {
+	uploadHandler, err := newUploadAttachmentHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	downloadHandler, err := newDownloadAttachmentHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	listHandler, err := newListAttachmentsHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	deleteHandler, err := newDeleteAttachmentHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachmentServiceHandler{
+		uploadAttachmentHandler:   uploadHandler,
+		downloadAttachmentHandler: downloadHandler,
+		listAttachmentsHandler:    listHandler,
+		deleteAttachmentHandler:   deleteHandler,
+	}, nil
+}
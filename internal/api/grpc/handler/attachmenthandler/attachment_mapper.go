@@ -0,0 +1,19 @@
+package attachmenthandler
+
+import (
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+)
+
+// domainAttachmentToProto converts a domain Attachment to a protobuf Attachment
+func domainAttachmentToProto(domainAttachment *attachment.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:          domainAttachment.ID().String(),
+		TodoId:      domainAttachment.TodoID().String(),
+		Filename:    domainAttachment.Filename(),
+		ContentType: domainAttachment.ContentType(),
+		Size:        domainAttachment.Size(),
+		Checksum:    domainAttachment.Checksum(),
+		CreatedAt:   domainAttachment.CreatedAt().Unix(),
+	}
+}
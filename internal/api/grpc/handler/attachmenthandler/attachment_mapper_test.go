@@ -0,0 +1,34 @@
+package attachmenthandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainAttachmentToProto(t *testing.T) {
+	// Given
+	id := uuid.New()
+	todoID := todo.NewTodoID()
+	createdAt := time.Now().UTC()
+	checksum := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	domainAttachment := attachment.ReconstructAttachment(
+		id, todoID, "hello.txt", "text/plain; charset=utf-8", 5, checksum, createdAt,
+	)
+
+	// When
+	result := domainAttachmentToProto(domainAttachment)
+
+	// Then
+	assert.Equal(t, id.String(), result.Id)
+	assert.Equal(t, todoID.String(), result.TodoId)
+	assert.Equal(t, "hello.txt", result.Filename)
+	assert.Equal(t, "text/plain; charset=utf-8", result.ContentType)
+	assert.Equal(t, int64(5), result.Size)
+	assert.Equal(t, checksum, result.Checksum)
+	assert.Equal(t, createdAt.Unix(), result.CreatedAt)
+}
@@ -0,0 +1,50 @@
+package attachmenthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/attachmentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/samber/do"
+)
+
+// DeleteAttachmentHandler handles DeleteAttachment requests
+type deleteAttachmentHandler struct {
+	useCase attachmentapp.DeleteAttachmentUseCase
+}
+
+func newDeleteAttachmentHandler(i *do.Injector) (*deleteAttachmentHandler, error) {
+	deleteAttachmentUseCase, err := do.Invoke[attachmentapp.DeleteAttachmentUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delete attachment use case: %w", err)
+	}
+	return &deleteAttachmentHandler{useCase: deleteAttachmentUseCase}, nil
+}
+
+func (h deleteAttachmentHandler) DeleteAttachment(
+	ctx context.Context,
+	req *connect.Request[v1.DeleteAttachmentRequest],
+) (*connect.Response[v1.DeleteAttachmentResponse], error) {
+	// Parse attachment ID
+	attachmentID, err := attachment.NewAttachmentIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := attachmentapp.DeleteAttachmentRequest{
+		ID: attachmentID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.DeleteAttachmentResponse{}), nil
+}
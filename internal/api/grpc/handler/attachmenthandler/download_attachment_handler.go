@@ -0,0 +1,76 @@
+package attachmenthandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/attachmentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/samber/do"
+)
+
+// downloadChunkSize is the size of the content carried by each response message.
+const downloadChunkSize = 64 * 1024
+
+// DownloadAttachmentHandler handles DownloadAttachment requests
+type downloadAttachmentHandler struct {
+	useCase attachmentapp.DownloadAttachmentUseCase
+}
+
+func newDownloadAttachmentHandler(i *do.Injector) (*downloadAttachmentHandler, error) {
+	downloadAttachmentUseCase, err := do.Invoke[attachmentapp.DownloadAttachmentUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke download attachment use case: %w", err)
+	}
+	return &downloadAttachmentHandler{useCase: downloadAttachmentUseCase}, nil
+}
+
+func (h downloadAttachmentHandler) DownloadAttachment(
+	ctx context.Context,
+	req *connect.Request[v1.DownloadAttachmentRequest],
+	stream *connect.ServerStream[v1.DownloadAttachmentResponse],
+) error {
+	// Parse attachment ID
+	attachmentID, err := attachment.NewAttachmentIDFromString(req.Msg.Id)
+	if err != nil {
+		return connecterr.InvalidID("id")
+	}
+
+	// Execute use case
+	result, err := h.useCase.Execute(ctx, attachmentapp.DownloadAttachmentRequest{ID: attachmentID})
+	if err != nil {
+		return connecterr.From(err)
+	}
+	defer result.Content.Close()
+
+	// Send the attachment first, then the content in chunks
+	err = stream.Send(&v1.DownloadAttachmentResponse{
+		Payload: &v1.DownloadAttachmentResponse_Attachment{Attachment: domainAttachmentToProto(result.Attachment)},
+	})
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := io.ReadFull(result.Content, buf)
+		if n > 0 {
+			sendErr := stream.Send(&v1.DownloadAttachmentResponse{
+				Payload: &v1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return connecterr.From(err)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package attachmenthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/attachmentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// ListAttachmentsHandler handles ListAttachments requests
+type listAttachmentsHandler struct {
+	useCase attachmentapp.ListAttachmentsUseCase
+}
+
+func newListAttachmentsHandler(i *do.Injector) (*listAttachmentsHandler, error) {
+	listAttachmentsUseCase, err := do.Invoke[attachmentapp.ListAttachmentsUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke list attachments use case: %w", err)
+	}
+	return &listAttachmentsHandler{useCase: listAttachmentsUseCase}, nil
+}
+
+func (h listAttachmentsHandler) ListAttachments(
+	ctx context.Context,
+	req *connect.Request[v1.ListAttachmentsRequest],
+) (*connect.Response[v1.ListAttachmentsResponse], error) {
+	// Parse todo ID
+	todoID, err := todo.NewTodoIDFromString(req.Msg.TodoId)
+	if err != nil {
+		return nil, connecterr.InvalidID("todo_id")
+	}
+
+	// Execute use case
+	attachments, err := h.useCase.Execute(ctx, attachmentapp.ListAttachmentsRequest{TodoID: todoID})
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	pbAttachments := make([]*v1.Attachment, len(attachments))
+	for i, domainAttachment := range attachments {
+		pbAttachments[i] = domainAttachmentToProto(domainAttachment)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.ListAttachmentsResponse{
+		Attachments: pbAttachments,
+	}), nil
+}
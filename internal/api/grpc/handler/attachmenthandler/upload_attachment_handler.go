@@ -0,0 +1,105 @@
+package attachmenthandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/attachmentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// UploadAttachmentHandler handles UploadAttachment requests
+type uploadAttachmentHandler struct {
+	useCase attachmentapp.UploadAttachmentUseCase
+}
+
+func newUploadAttachmentHandler(i *do.Injector) (*uploadAttachmentHandler, error) {
+	uploadAttachmentUseCase, err := do.Invoke[attachmentapp.UploadAttachmentUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke upload attachment use case: %w", err)
+	}
+	return &uploadAttachmentHandler{useCase: uploadAttachmentUseCase}, nil
+}
+
+func (h uploadAttachmentHandler) UploadAttachment(
+	ctx context.Context,
+	stream *connect.ClientStream[v1.UploadAttachmentRequest],
+) (*connect.Response[v1.UploadAttachmentResponse], error) {
+	// The first message carries the metadata
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("metadata is required"))
+	}
+	metadata := stream.Msg().GetMetadata()
+	if metadata == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("the first message must carry the metadata"))
+	}
+
+	// Parse todo ID
+	todoID, err := todo.NewTodoIDFromString(metadata.TodoId)
+	if err != nil {
+		return nil, connecterr.InvalidID("metadata.todo_id")
+	}
+
+	// Create use case request
+	content := &chunkReader{stream: stream}
+	useCaseReq := attachmentapp.UploadAttachmentRequest{
+		TodoID:      todoID,
+		Filename:    metadata.Filename,
+		ContentType: metadata.ContentType,
+		Content:     content,
+	}
+
+	// Execute use case
+	result, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		// Errors of the stream itself take precedence over the storage errors they cause
+		if content.err != nil {
+			return nil, content.err
+		}
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.UploadAttachmentResponse{
+		Attachment: domainAttachmentToProto(result),
+	}), nil
+}
+
+// chunkReader reads the content carried by the chunks of an upload stream.
+type chunkReader struct {
+	stream *connect.ClientStream[v1.UploadAttachmentRequest]
+	chunk  []byte
+	err    error
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if !r.stream.Receive() {
+			if err := r.stream.Err(); err != nil {
+				r.err = err
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		if _, ok := r.stream.Msg().Payload.(*v1.UploadAttachmentRequest_Chunk); !ok {
+			r.err = connect.NewError(connect.CodeInvalidArgument, errors.New("only the first message may carry the metadata"))
+			return 0, r.err
+		}
+		r.chunk = r.stream.Msg().GetChunk()
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
@@ -0,0 +1,55 @@
+package commenthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// AddCommentHandler handles AddComment requests
+type addCommentHandler struct {
+	useCase commentapp.AddCommentUseCase
+}
+
+func newAddCommentHandler(i *do.Injector) (*addCommentHandler, error) {
+	addCommentUseCase, err := do.Invoke[commentapp.AddCommentUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke add comment use case: %w", err)
+	}
+	return &addCommentHandler{useCase: addCommentUseCase}, nil
+}
+
+func (h addCommentHandler) AddComment(
+	ctx context.Context,
+	req *connect.Request[v1.AddCommentRequest],
+) (*connect.Response[v1.AddCommentResponse], error) {
+	// Parse todo ID
+	todoID, err := todo.NewTodoIDFromString(req.Msg.TodoId)
+	if err != nil {
+		return nil, connecterr.InvalidID("todo_id")
+	}
+
+	// Create use case request
+	useCaseReq := commentapp.AddCommentRequest{
+		TodoID: todoID,
+		Author: req.Msg.Author,
+		Body:   req.Msg.Body,
+	}
+
+	// Execute use case
+	domainComment, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.AddCommentResponse{
+		Comment: domainCommentToProto(domainComment),
+	}), nil
+}
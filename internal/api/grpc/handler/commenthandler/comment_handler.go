@@ -0,0 +1,41 @@
+package commenthandler
+
+import (
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/samber/do"
+)
+
+// commentServiceHandler combines all individual handlers to implement CommentServiceHandler
+type commentServiceHandler struct {
+	*addCommentHandler
+	*editCommentHandler
+	*deleteCommentHandler
+	*listCommentsHandler
+}
+
+// NewCommentServiceHandler creates a new CommentServiceHandler using composition
+func NewCommentServiceHandler(i *do.Injector) (v1connect.CommentServiceHandler, error) {
+	addHandler, err := newAddCommentHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	editHandler, err := newEditCommentHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	deleteHandler, err := newDeleteCommentHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	listHandler, err := newListCommentsHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentServiceHandler{
+		addCommentHandler:    addHandler,
+		editCommentHandler:   editHandler,
+		deleteCommentHandler: deleteHandler,
+		listCommentsHandler:  listHandler,
+	}, nil
+}
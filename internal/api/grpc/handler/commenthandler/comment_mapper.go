@@ -0,0 +1,57 @@
+package commenthandler
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+)
+
+// domainCommentToProto converts a domain Comment to a protobuf Comment
+func domainCommentToProto(domainComment *comment.Comment) *pb.Comment {
+	pbComment := &pb.Comment{
+		Id:        domainComment.ID().String(),
+		TodoId:    domainComment.TodoID().String(),
+		Author:    domainComment.Author(),
+		Body:      domainComment.Body(),
+		Edited:    domainComment.IsEdited(),
+		Deleted:   domainComment.IsDeleted(),
+		CreatedAt: domainComment.CreatedAt().Unix(),
+		UpdatedAt: domainComment.UpdatedAt().Unix(),
+	}
+
+	if editedAt := domainComment.EditedAt(); editedAt != nil {
+		timestamp := editedAt.Unix()
+		pbComment.EditedAt = &timestamp
+	}
+
+	if deletedAt := domainComment.DeletedAt(); deletedAt != nil {
+		timestamp := deletedAt.Unix()
+		pbComment.DeletedAt = &timestamp
+	}
+
+	return pbComment
+}
+
+// encodePageToken encodes the offset of the next page as an opaque page token
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodePageToken decodes a page token created by encodePageToken. An empty token is the first page.
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+	offset, err := strconv.Atoi(string(b))
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid page token")
+	}
+	return offset, nil
+}
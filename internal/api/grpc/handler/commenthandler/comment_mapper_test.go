@@ -0,0 +1,78 @@
+package commenthandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainCommentToProto(t *testing.T) {
+	t.Run("converts comment", func(t *testing.T) {
+		// Given
+		id := uuid.New()
+		todoID := todo.NewTodoID()
+		createdAt := time.Now().UTC()
+		updatedAt := createdAt.Add(time.Hour)
+		domainComment := comment.ReconstructComment(id, todoID, "alice", "**LGTM**", createdAt, updatedAt, nil, nil)
+
+		// When
+		result := domainCommentToProto(domainComment)
+
+		// Then
+		assert.Equal(t, id.String(), result.Id)
+		assert.Equal(t, todoID.String(), result.TodoId)
+		assert.Equal(t, "alice", result.Author)
+		assert.Equal(t, "**LGTM**", result.Body)
+		assert.False(t, result.Edited)
+		assert.False(t, result.Deleted)
+		assert.Equal(t, createdAt.Unix(), result.CreatedAt)
+		assert.Equal(t, updatedAt.Unix(), result.UpdatedAt)
+		assert.Nil(t, result.EditedAt)
+		assert.Nil(t, result.DeletedAt)
+	})
+
+	t.Run("converts edited and deleted comment", func(t *testing.T) {
+		// Given
+		createdAt := time.Now().UTC()
+		editedAt := createdAt.Add(time.Minute)
+		deletedAt := createdAt.Add(time.Hour)
+		domainComment := comment.ReconstructComment(
+			uuid.New(), todo.NewTodoID(), "alice", "", createdAt, deletedAt, &editedAt, &deletedAt,
+		)
+
+		// When
+		result := domainCommentToProto(domainComment)
+
+		// Then
+		assert.True(t, result.Edited)
+		assert.True(t, result.Deleted)
+		assert.Empty(t, result.Body)
+		require.NotNil(t, result.EditedAt)
+		assert.Equal(t, editedAt.Unix(), *result.EditedAt)
+		require.NotNil(t, result.DeletedAt)
+		assert.Equal(t, deletedAt.Unix(), *result.DeletedAt)
+	})
+}
+
+func TestPageToken(t *testing.T) {
+	t.Run("round trips the offset", func(t *testing.T) {
+		// When
+		offset, err := decodePageToken(encodePageToken(50))
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, 50, offset)
+	})
+
+	t.Run("returns error for invalid token", func(t *testing.T) {
+		for _, token := range []string{"!!!", encodePageToken(-1), "YWJj"} {
+			_, err := decodePageToken(token)
+			require.Error(t, err, token)
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package commenthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/samber/do"
+)
+
+// DeleteCommentHandler handles DeleteComment requests
+type deleteCommentHandler struct {
+	useCase commentapp.DeleteCommentUseCase
+}
+
+func newDeleteCommentHandler(i *do.Injector) (*deleteCommentHandler, error) {
+	deleteCommentUseCase, err := do.Invoke[commentapp.DeleteCommentUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delete comment use case: %w", err)
+	}
+	return &deleteCommentHandler{useCase: deleteCommentUseCase}, nil
+}
+
+func (h deleteCommentHandler) DeleteComment(
+	ctx context.Context,
+	req *connect.Request[v1.DeleteCommentRequest],
+) (*connect.Response[v1.DeleteCommentResponse], error) {
+	// Parse comment ID
+	commentID, err := comment.NewCommentIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := commentapp.DeleteCommentRequest{
+		ID: commentID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.DeleteCommentResponse{}), nil
+}
@@ -0,0 +1,54 @@
+package commenthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/samber/do"
+)
+
+// EditCommentHandler handles EditComment requests
+type editCommentHandler struct {
+	useCase commentapp.EditCommentUseCase
+}
+
+func newEditCommentHandler(i *do.Injector) (*editCommentHandler, error) {
+	editCommentUseCase, err := do.Invoke[commentapp.EditCommentUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke edit comment use case: %w", err)
+	}
+	return &editCommentHandler{useCase: editCommentUseCase}, nil
+}
+
+func (h editCommentHandler) EditComment(
+	ctx context.Context,
+	req *connect.Request[v1.EditCommentRequest],
+) (*connect.Response[v1.EditCommentResponse], error) {
+	// Parse comment ID
+	commentID, err := comment.NewCommentIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := commentapp.EditCommentRequest{
+		ID:   commentID,
+		Body: req.Msg.Body,
+	}
+
+	// Execute use case
+	domainComment, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.EditCommentResponse{
+		Comment: domainCommentToProto(domainComment),
+	}), nil
+}
@@ -0,0 +1,72 @@
+package commenthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// ListCommentsHandler handles ListComments requests
+type listCommentsHandler struct {
+	useCase commentapp.ListCommentsUseCase
+}
+
+func newListCommentsHandler(i *do.Injector) (*listCommentsHandler, error) {
+	listCommentsUseCase, err := do.Invoke[commentapp.ListCommentsUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke list comments use case: %w", err)
+	}
+	return &listCommentsHandler{useCase: listCommentsUseCase}, nil
+}
+
+func (h listCommentsHandler) ListComments(
+	ctx context.Context,
+	req *connect.Request[v1.ListCommentsRequest],
+) (*connect.Response[v1.ListCommentsResponse], error) {
+	// Parse todo ID
+	todoID, err := todo.NewTodoIDFromString(req.Msg.TodoId)
+	if err != nil {
+		return nil, connecterr.InvalidID("todo_id")
+	}
+
+	// Parse page token
+	offset, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connecterr.InvalidField("page_token", err.Error())
+	}
+
+	// Create use case request
+	useCaseReq := commentapp.ListCommentsRequest{
+		TodoID:   todoID,
+		PageSize: int(req.Msg.PageSize),
+		Offset:   offset,
+	}
+
+	// Execute use case
+	result, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	pbComments := make([]*v1.Comment, len(result.Comments))
+	for i, domainComment := range result.Comments {
+		pbComments[i] = domainCommentToProto(domainComment)
+	}
+
+	res := &v1.ListCommentsResponse{
+		Comments: pbComments,
+	}
+	if result.NextOffset != nil {
+		res.NextPageToken = encodePageToken(*result.NextOffset)
+	}
+
+	// Return response
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,67 @@
+package databasehandler
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/databaseapp"
+	"github.com/samber/do"
+)
+
+// backupChunkSize is the size of the content carried by each response message.
+const backupChunkSize = 64 * 1024
+
+// backupDatabaseHandler handles BackupDatabase requests
+type backupDatabaseHandler struct {
+	useCase databaseapp.BackupDatabaseUseCase
+}
+
+func newBackupDatabaseHandler(i *do.Injector) (*backupDatabaseHandler, error) {
+	backupDatabaseUseCase, err := do.Invoke[databaseapp.BackupDatabaseUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke backup database use case: %w", err)
+	}
+	return &backupDatabaseHandler{useCase: backupDatabaseUseCase}, nil
+}
+
+func (h backupDatabaseHandler) BackupDatabase(
+	ctx context.Context,
+	req *connect.Request[v1.BackupDatabaseRequest],
+	stream *connect.ServerStream[v1.BackupDatabaseResponse],
+) error {
+	output := &chunkWriter{stream: stream}
+	buffered := bufio.NewWriterSize(output, backupChunkSize)
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, databaseapp.BackupDatabaseRequest{Output: buffered}); err != nil {
+		// Errors of the stream itself take precedence over the write errors they cause
+		if output.err != nil {
+			return output.err
+		}
+		return connecterr.From(err)
+	}
+
+	// Send the rest of the content
+	return buffered.Flush()
+}
+
+// chunkWriter sends everything written to it as the chunks of a backup stream.
+type chunkWriter struct {
+	stream *connect.ServerStream[v1.BackupDatabaseResponse]
+	err    error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if err := w.stream.Send(&v1.BackupDatabaseResponse{Chunk: p}); err != nil {
+		w.err = err
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,23 @@
+package databasehandler
+
+import (
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/samber/do"
+)
+
+// databaseServiceHandler combines all individual handlers to implement DatabaseServiceHandler
+type databaseServiceHandler struct {
+	*backupDatabaseHandler
+}
+
+// NewDatabaseServiceHandler creates a new DatabaseServiceHandler using composition
+func NewDatabaseServiceHandler(i *do.Injector) (v1connect.DatabaseServiceHandler, error) {
+	backupHandler, err := newBackupDatabaseHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &databaseServiceHandler{
+		backupDatabaseHandler: backupHandler,
+	}, nil
+}
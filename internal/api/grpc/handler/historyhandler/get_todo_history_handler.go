@@ -0,0 +1,67 @@
+package historyhandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/historyapp"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// GetTodoHistoryHandler handles GetTodoHistory requests
+type getTodoHistoryHandler struct {
+	useCase historyapp.GetTodoHistoryUseCase
+}
+
+func newGetTodoHistoryHandler(i *do.Injector) (*getTodoHistoryHandler, error) {
+	getTodoHistoryUseCase, err := do.Invoke[historyapp.GetTodoHistoryUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke get todo history use case: %w", err)
+	}
+	return &getTodoHistoryHandler{useCase: getTodoHistoryUseCase}, nil
+}
+
+func (h getTodoHistoryHandler) GetTodoHistory(
+	ctx context.Context,
+	req *connect.Request[v1.GetTodoHistoryRequest],
+) (*connect.Response[v1.GetTodoHistoryResponse], error) {
+	// Parse todo ID
+	todoID, err := todo.NewTodoIDFromString(req.Msg.TodoId)
+	if err != nil {
+		return nil, connecterr.InvalidID("todo_id")
+	}
+
+	// Parse page token
+	offset, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connecterr.InvalidField("page_token", err.Error())
+	}
+
+	// Create use case request
+	useCaseReq := historyapp.GetTodoHistoryRequest{
+		TodoID:   todoID,
+		PageSize: int(req.Msg.PageSize),
+		Offset:   offset,
+	}
+
+	// Execute use case
+	result, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	res := &v1.GetTodoHistoryResponse{
+		Entries: domainHistoryEntriesToProto(result.Entries),
+	}
+	if result.NextOffset != nil {
+		res.NextPageToken = encodePageToken(*result.NextOffset)
+	}
+
+	// Return response
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,29 @@
+package historyhandler
+
+import (
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/samber/do"
+)
+
+// historyServiceHandler combines all individual handlers to implement HistoryServiceHandler
+type historyServiceHandler struct {
+	*getTodoHistoryHandler
+	*listActivityHandler
+}
+
+// NewHistoryServiceHandler creates a new HistoryServiceHandler using composition
+func NewHistoryServiceHandler(i *do.Injector) (v1connect.HistoryServiceHandler, error) {
+	getTodoHistoryHandler, err := newGetTodoHistoryHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	listActivityHandler, err := newListActivityHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &historyServiceHandler{
+		getTodoHistoryHandler: getTodoHistoryHandler,
+		listActivityHandler:   listActivityHandler,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package historyhandler
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+)
+
+// domainHistoryEntryToProto converts a domain HistoryEntry to a protobuf HistoryEntry
+func domainHistoryEntryToProto(entry *history.HistoryEntry) *pb.HistoryEntry {
+	changes := make([]*pb.FieldChange, len(entry.Changes()))
+	for i, change := range entry.Changes() {
+		changes[i] = &pb.FieldChange{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		}
+	}
+
+	pbEntry := &pb.HistoryEntry{
+		Id:         entry.ID().String(),
+		TodoId:     entry.TodoID().String(),
+		Actor:      entry.Actor(),
+		Operation:  domainOperationToProto(entry.Operation()),
+		Changes:    changes,
+		OccurredAt: entry.OccurredAt().Unix(),
+		Version:    int64(entry.Version()),
+	}
+
+	if entry.ProjectID() != nil {
+		projectID := entry.ProjectID().String()
+		pbEntry.ProjectId = &projectID
+	}
+
+	return pbEntry
+}
+
+// domainHistoryEntriesToProto converts domain HistoryEntries to protobuf HistoryEntries
+func domainHistoryEntriesToProto(entries []*history.HistoryEntry) []*pb.HistoryEntry {
+	pbEntries := make([]*pb.HistoryEntry, len(entries))
+	for i, entry := range entries {
+		pbEntries[i] = domainHistoryEntryToProto(entry)
+	}
+	return pbEntries
+}
+
+// domainOperationToProto converts a domain Operation to a protobuf HistoryOperation
+func domainOperationToProto(operation history.Operation) pb.HistoryOperation {
+	switch operation {
+	case history.OperationCreate:
+		return pb.HistoryOperation_HISTORY_OPERATION_CREATE
+	case history.OperationUpdate:
+		return pb.HistoryOperation_HISTORY_OPERATION_UPDATE
+	case history.OperationDelete:
+		return pb.HistoryOperation_HISTORY_OPERATION_DELETE
+	default:
+		return pb.HistoryOperation_HISTORY_OPERATION_UNSPECIFIED
+	}
+}
+
+// encodePageToken encodes the offset of the next page as an opaque page token
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodePageToken decodes a page token created by encodePageToken. An empty token is the first page.
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+	offset, err := strconv.Atoi(string(b))
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid page token")
+	}
+	return offset, nil
+}
@@ -0,0 +1,72 @@
+package historyhandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainHistoryEntryToProto(t *testing.T) {
+	t.Run("converts entry of a todo in a project", func(t *testing.T) {
+		// Given
+		id := uuid.New()
+		todoID := todo.NewTodoID()
+		projectID := project.NewProjectID()
+		occurredAt := time.Now().UTC()
+		entry := history.ReconstructHistoryEntry(id, todoID, 2, &projectID, "alice", history.OperationUpdate,
+			[]history.FieldChange{{Field: history.FieldTitle, Before: "Draft", After: "Final"}}, occurredAt)
+
+		// When
+		result := domainHistoryEntryToProto(entry)
+
+		// Then
+		assert.Equal(t, id.String(), result.Id)
+		assert.Equal(t, todoID.String(), result.TodoId)
+		require.NotNil(t, result.ProjectId)
+		assert.Equal(t, projectID.String(), *result.ProjectId)
+		assert.Equal(t, "alice", result.Actor)
+		assert.Equal(t, pb.HistoryOperation_HISTORY_OPERATION_UPDATE, result.Operation)
+		require.Len(t, result.Changes, 1)
+		assert.Equal(t, "title", result.Changes[0].Field)
+		assert.Equal(t, "Draft", result.Changes[0].Before)
+		assert.Equal(t, "Final", result.Changes[0].After)
+		assert.Equal(t, occurredAt.Unix(), result.OccurredAt)
+		assert.Equal(t, int64(2), result.Version)
+	})
+
+	t.Run("converts entry of a todo without a project", func(t *testing.T) {
+		// Given
+		entry := history.ReconstructHistoryEntry(uuid.New(), todo.NewTodoID(), 3, nil, "bob",
+			history.OperationDelete, nil, time.Now())
+
+		// When
+		result := domainHistoryEntryToProto(entry)
+
+		// Then
+		assert.Nil(t, result.ProjectId)
+		assert.Equal(t, pb.HistoryOperation_HISTORY_OPERATION_DELETE, result.Operation)
+		assert.Empty(t, result.Changes)
+	})
+}
+
+func TestPageToken(t *testing.T) {
+	// Given
+	token := encodePageToken(42)
+
+	// When
+	offset, err := decodePageToken(token)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 42, offset)
+
+	_, err = decodePageToken("not a token")
+	assert.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package historyhandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/historyapp"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/samber/do"
+)
+
+// ListActivityHandler handles ListActivity requests
+type listActivityHandler struct {
+	useCase historyapp.ListActivityUseCase
+}
+
+func newListActivityHandler(i *do.Injector) (*listActivityHandler, error) {
+	listActivityUseCase, err := do.Invoke[historyapp.ListActivityUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke list activity use case: %w", err)
+	}
+	return &listActivityHandler{useCase: listActivityUseCase}, nil
+}
+
+func (h listActivityHandler) ListActivity(
+	ctx context.Context,
+	req *connect.Request[v1.ListActivityRequest],
+) (*connect.Response[v1.ListActivityResponse], error) {
+	// Parse project ID
+	projectID, err := project.NewProjectIDFromString(req.Msg.ProjectId)
+	if err != nil {
+		return nil, connecterr.InvalidID("project_id")
+	}
+
+	// Parse page token
+	offset, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connecterr.InvalidField("page_token", err.Error())
+	}
+
+	// Create use case request
+	useCaseReq := historyapp.ListActivityRequest{
+		ProjectID: projectID,
+		PageSize:  int(req.Msg.PageSize),
+		Offset:    offset,
+	}
+
+	// Execute use case
+	result, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	res := &v1.ListActivityResponse{
+		Entries: domainHistoryEntriesToProto(result.Entries),
+	}
+	if result.NextOffset != nil {
+		res.NextPageToken = encodePageToken(*result.NextOffset)
+	}
+
+	// Return response
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,54 @@
+package projecthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/projectapp"
+	"github.com/samber/do"
+)
+
+// CreateProjectHandler handles CreateProject requests
+type createProjectHandler struct {
+	useCase projectapp.CreateProjectUseCase
+}
+
+func newCreateProjectHandler(i *do.Injector) (*createProjectHandler, error) {
+	createProjectUseCase, err := do.Invoke[projectapp.CreateProjectUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke create project use case: %w", err)
+	}
+	return &createProjectHandler{useCase: createProjectUseCase}, nil
+}
+
+func (h createProjectHandler) CreateProject(
+	ctx context.Context,
+	req *connect.Request[v1.CreateProjectRequest],
+) (*connect.Response[v1.CreateProjectResponse], error) {
+	// Convert workflow
+	statuses, err := protoStatusesToDomain(req.Msg.Statuses)
+	if err != nil {
+		return nil, connecterr.InvalidField("statuses", err.Error())
+	}
+
+	// Create use case request
+	useCaseReq := projectapp.CreateProjectRequest{
+		Name:        req.Msg.Name,
+		Statuses:    statuses,
+		Transitions: protoTransitionsToDomain(req.Msg.Transitions),
+	}
+
+	// Execute use case
+	domainProject, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.CreateProjectResponse{
+		Project: domainProjectToProto(domainProject),
+	}), nil
+}
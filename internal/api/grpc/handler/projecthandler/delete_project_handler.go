@@ -0,0 +1,50 @@
+package projecthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/projectapp"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/samber/do"
+)
+
+// DeleteProjectHandler handles DeleteProject requests
+type deleteProjectHandler struct {
+	useCase projectapp.DeleteProjectUseCase
+}
+
+func newDeleteProjectHandler(i *do.Injector) (*deleteProjectHandler, error) {
+	deleteProjectUseCase, err := do.Invoke[projectapp.DeleteProjectUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delete project use case: %w", err)
+	}
+	return &deleteProjectHandler{useCase: deleteProjectUseCase}, nil
+}
+
+func (h deleteProjectHandler) DeleteProject(
+	ctx context.Context,
+	req *connect.Request[v1.DeleteProjectRequest],
+) (*connect.Response[v1.DeleteProjectResponse], error) {
+	// Parse project ID
+	projectID, err := project.NewProjectIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := projectapp.DeleteProjectRequest{
+		ID: projectID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.DeleteProjectResponse{}), nil
+}
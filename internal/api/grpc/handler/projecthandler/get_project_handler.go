@@ -0,0 +1,48 @@
+package projecthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/projectapp"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/samber/do"
+)
+
+// GetProjectHandler handles GetProject requests
+type getProjectHandler struct {
+	useCase projectapp.GetProjectUseCase
+}
+
+func newGetProjectHandler(i *do.Injector) (*getProjectHandler, error) {
+	getProjectUseCase, err := do.Invoke[projectapp.GetProjectUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke get project use case: %w", err)
+	}
+	return &getProjectHandler{useCase: getProjectUseCase}, nil
+}
+
+func (h getProjectHandler) GetProject(
+	ctx context.Context,
+	req *connect.Request[v1.GetProjectRequest],
+) (*connect.Response[v1.GetProjectResponse], error) {
+	// Parse project ID
+	projectID, err := project.NewProjectIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Execute use case
+	domainProject, err := h.useCase.Execute(ctx, projectapp.GetProjectRequest{ID: projectID})
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.GetProjectResponse{
+		Project: domainProjectToProto(domainProject),
+	}), nil
+}
@@ -0,0 +1,47 @@
+package projecthandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/projectapp"
+	"github.com/samber/do"
+)
+
+// ListProjectsHandler handles ListProjects requests
+type listProjectsHandler struct {
+	useCase projectapp.ListProjectsUseCase
+}
+
+func newListProjectsHandler(i *do.Injector) (*listProjectsHandler, error) {
+	listProjectsUseCase, err := do.Invoke[projectapp.ListProjectsUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke list projects use case: %w", err)
+	}
+	return &listProjectsHandler{useCase: listProjectsUseCase}, nil
+}
+
+func (h listProjectsHandler) ListProjects(
+	ctx context.Context,
+	req *connect.Request[v1.ListProjectsRequest],
+) (*connect.Response[v1.ListProjectsResponse], error) {
+	// Execute use case
+	domainProjects, err := h.useCase.Execute(ctx, projectapp.ListProjectsRequest{})
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	pbProjects := make([]*v1.Project, len(domainProjects))
+	for i, domainProject := range domainProjects {
+		pbProjects[i] = domainProjectToProto(domainProject)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.ListProjectsResponse{
+		Projects: pbProjects,
+	}), nil
+}
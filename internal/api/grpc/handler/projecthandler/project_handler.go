@@ -0,0 +1,41 @@
+package projecthandler
+
+import (
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/samber/do"
+)
+
+// projectServiceHandler combines all individual handlers to implement ProjectServiceHandler
+type projectServiceHandler struct {
+	*createProjectHandler
+	*listProjectsHandler
+	*getProjectHandler
+	*deleteProjectHandler
+}
+
+// NewProjectServiceHandler creates a new ProjectServiceHandler using composition
+func NewProjectServiceHandler(i *do.Injector) (v1connect.ProjectServiceHandler, error) {
+	createHandler, err := newCreateProjectHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	listHandler, err := newListProjectsHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	getHandler, err := newGetProjectHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	deleteHandler, err := newDeleteProjectHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectServiceHandler{
+		createProjectHandler: createHandler,
+		listProjectsHandler:  listHandler,
+		getProjectHandler:    getHandler,
+		deleteProjectHandler: deleteHandler,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package projecthandler
+
+import (
+	"fmt"
+
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+)
+
+// domainProjectToProto converts a domain Project to a protobuf Project
+func domainProjectToProto(domainProject *project.Project) *pb.Project {
+	workflow := domainProject.Workflow()
+	pbProject := &pb.Project{
+		Id:          domainProject.ID().String(),
+		Name:        domainProject.Name(),
+		Statuses:    make([]*pb.WorkflowStatus, 0, len(workflow.Statuses())),
+		Transitions: make([]*pb.WorkflowTransition, 0, len(workflow.Transitions())),
+		CreatedAt:   domainProject.CreatedAt().Unix(),
+		UpdatedAt:   domainProject.UpdatedAt().Unix(),
+	}
+
+	for _, s := range workflow.Statuses() {
+		pbProject.Statuses = append(pbProject.Statuses, &pb.WorkflowStatus{
+			Id:       s.ID.String(),
+			Name:     s.Name,
+			Category: domainCategoryToProto(s.Category),
+		})
+	}
+
+	for _, tr := range workflow.Transitions() {
+		pbProject.Transitions = append(pbProject.Transitions, &pb.WorkflowTransition{
+			From: tr.From.String(),
+			To:   tr.To.String(),
+		})
+	}
+
+	return pbProject
+}
+
+// domainCategoryToProto converts domain StatusCategory to protobuf WorkflowStatusCategory
+func domainCategoryToProto(category project.StatusCategory) pb.WorkflowStatusCategory {
+	switch category {
+	case project.StatusCategoryTodo:
+		return pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_TODO
+	case project.StatusCategoryDoing:
+		return pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_DOING
+	case project.StatusCategoryDone:
+		return pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_DONE
+	default:
+		return pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_UNSPECIFIED
+	}
+}
+
+// protoCategoryToDomain converts protobuf WorkflowStatusCategory to domain StatusCategory
+func protoCategoryToDomain(category pb.WorkflowStatusCategory) (project.StatusCategory, error) {
+	switch category {
+	case pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_TODO:
+		return project.StatusCategoryTodo, nil
+	case pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_DOING:
+		return project.StatusCategoryDoing, nil
+	case pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_DONE:
+		return project.StatusCategoryDone, nil
+	default:
+		return project.StatusCategoryTodo, fmt.Errorf("invalid workflow status category: %s", category)
+	}
+}
+
+// protoStatusesToDomain converts protobuf WorkflowStatuses to domain WorkflowStatuses
+func protoStatusesToDomain(statuses []*pb.WorkflowStatus) ([]project.WorkflowStatus, error) {
+	result := make([]project.WorkflowStatus, len(statuses))
+	for i, s := range statuses {
+		category, err := protoCategoryToDomain(s.Category)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = project.WorkflowStatus{
+			ID:       project.StatusID(s.Id),
+			Name:     s.Name,
+			Category: category,
+		}
+	}
+	return result, nil
+}
+
+// protoTransitionsToDomain converts protobuf WorkflowTransitions to domain Transitions
+func protoTransitionsToDomain(transitions []*pb.WorkflowTransition) []project.Transition {
+	result := make([]project.Transition, len(transitions))
+	for i, tr := range transitions {
+		result[i] = project.Transition{
+			From: project.StatusID(tr.From),
+			To:   project.StatusID(tr.To),
+		}
+	}
+	return result
+}
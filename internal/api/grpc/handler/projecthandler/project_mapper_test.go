@@ -0,0 +1,83 @@
+package projecthandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainProjectToProto(t *testing.T) {
+	// Given
+	id := uuid.New()
+	createdAt := time.Now().UTC()
+	updatedAt := createdAt.Add(time.Hour)
+	workflow := project.ReconstructWorkflow(
+		[]project.WorkflowStatus{
+			{ID: "todo", Name: "To Do", Category: project.StatusCategoryTodo},
+			{ID: "review", Name: "Review", Category: project.StatusCategoryDoing},
+			{ID: "done", Name: "Done", Category: project.StatusCategoryDone},
+		},
+		[]project.Transition{{From: "todo", To: "review"}},
+	)
+	domainProject := project.ReconstructProject(id, "Platform", workflow, createdAt, updatedAt)
+
+	// When
+	result := domainProjectToProto(domainProject)
+
+	// Then
+	assert.Equal(t, id.String(), result.Id)
+	assert.Equal(t, "Platform", result.Name)
+	require.Len(t, result.Statuses, 3)
+	assert.Equal(t, "review", result.Statuses[1].Id)
+	assert.Equal(t, "Review", result.Statuses[1].Name)
+	assert.Equal(t, pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_DOING, result.Statuses[1].Category)
+	require.Len(t, result.Transitions, 1)
+	assert.Equal(t, "todo", result.Transitions[0].From)
+	assert.Equal(t, "review", result.Transitions[0].To)
+	assert.Equal(t, createdAt.Unix(), result.CreatedAt)
+	assert.Equal(t, updatedAt.Unix(), result.UpdatedAt)
+}
+
+func TestProtoStatusesToDomain(t *testing.T) {
+	t.Run("converts statuses", func(t *testing.T) {
+		// Given
+		statuses := []*pb.WorkflowStatus{
+			{Id: "todo", Name: "To Do", Category: pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_TODO},
+			{Id: "done", Name: "Done", Category: pb.WorkflowStatusCategory_WORKFLOW_STATUS_CATEGORY_DONE},
+		}
+
+		// When
+		result, err := protoStatusesToDomain(statuses)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, []project.WorkflowStatus{
+			{ID: "todo", Name: "To Do", Category: project.StatusCategoryTodo},
+			{ID: "done", Name: "Done", Category: project.StatusCategoryDone},
+		}, result)
+	})
+
+	t.Run("returns error for unspecified category", func(t *testing.T) {
+		// Given
+		statuses := []*pb.WorkflowStatus{{Id: "todo", Name: "To Do"}}
+
+		// When
+		_, err := protoStatusesToDomain(statuses)
+
+		// Then
+		require.Error(t, err)
+	})
+}
+
+func TestProtoTransitionsToDomain(t *testing.T) {
+	// When
+	result := protoTransitionsToDomain([]*pb.WorkflowTransition{{From: "todo", To: "done"}})
+
+	// Then
+	assert.Equal(t, []project.Transition{{From: "todo", To: "done"}}, result)
+}
@@ -0,0 +1,53 @@
+package taghandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/tagapp"
+	"github.com/samber/do"
+)
+
+// CreateTagHandler handles CreateTag requests
+type createTagHandler struct {
+	useCase tagapp.CreateTagUseCase
+}
+
+func newCreateTagHandler(i *do.Injector) (*createTagHandler, error) {
+	createTagUseCase, err := do.Invoke[tagapp.CreateTagUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke create tag use case: %w", err)
+	}
+	return &createTagHandler{useCase: createTagUseCase}, nil
+}
+
+func (h createTagHandler) CreateTag(
+	ctx context.Context,
+	req *connect.Request[v1.CreateTagRequest],
+) (*connect.Response[v1.CreateTagResponse], error) {
+	// Extract color value if present
+	color := ""
+	if req.Msg.Color != nil {
+		color = *req.Msg.Color
+	}
+
+	// Create use case request
+	useCaseReq := tagapp.CreateTagRequest{
+		Name:  req.Msg.Name,
+		Color: color,
+	}
+
+	// Execute use case
+	domainTag, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.CreateTagResponse{
+		Tag: domainTagToProto(domainTag),
+	}), nil
+}
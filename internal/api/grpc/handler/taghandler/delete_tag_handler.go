@@ -0,0 +1,50 @@
+package taghandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/tagapp"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/samber/do"
+)
+
+// DeleteTagHandler handles DeleteTag requests
+type deleteTagHandler struct {
+	useCase tagapp.DeleteTagUseCase
+}
+
+func newDeleteTagHandler(i *do.Injector) (*deleteTagHandler, error) {
+	deleteTagUseCase, err := do.Invoke[tagapp.DeleteTagUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delete tag use case: %w", err)
+	}
+	return &deleteTagHandler{useCase: deleteTagUseCase}, nil
+}
+
+func (h deleteTagHandler) DeleteTag(
+	ctx context.Context,
+	req *connect.Request[v1.DeleteTagRequest],
+) (*connect.Response[v1.DeleteTagResponse], error) {
+	// Parse tag ID
+	tagID, err := tag.NewTagIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := tagapp.DeleteTagRequest{
+		ID: tagID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.DeleteTagResponse{}), nil
+}
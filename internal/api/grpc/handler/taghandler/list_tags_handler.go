@@ -0,0 +1,47 @@
+package taghandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/tagapp"
+	"github.com/samber/do"
+)
+
+// ListTagsHandler handles ListTags requests
+type listTagsHandler struct {
+	useCase tagapp.ListTagsUseCase
+}
+
+func newListTagsHandler(i *do.Injector) (*listTagsHandler, error) {
+	listTagsUseCase, err := do.Invoke[tagapp.ListTagsUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke list tags use case: %w", err)
+	}
+	return &listTagsHandler{useCase: listTagsUseCase}, nil
+}
+
+func (h listTagsHandler) ListTags(
+	ctx context.Context,
+	req *connect.Request[v1.ListTagsRequest],
+) (*connect.Response[v1.ListTagsResponse], error) {
+	// Execute use case
+	domainTags, err := h.useCase.Execute(ctx, tagapp.ListTagsRequest{})
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	pbTags := make([]*v1.Tag, len(domainTags))
+	for i, domainTag := range domainTags {
+		pbTags[i] = domainTagToProto(domainTag)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.ListTagsResponse{
+		Tags: pbTags,
+	}), nil
+}
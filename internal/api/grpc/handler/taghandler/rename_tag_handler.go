@@ -0,0 +1,51 @@
+package taghandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/tagapp"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/samber/do"
+)
+
+// RenameTagHandler handles RenameTag requests
+type renameTagHandler struct {
+	useCase tagapp.RenameTagUseCase
+}
+
+func newRenameTagHandler(i *do.Injector) (*renameTagHandler, error) {
+	renameTagUseCase, err := do.Invoke[tagapp.RenameTagUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke rename tag use case: %w", err)
+	}
+	return &renameTagHandler{useCase: renameTagUseCase}, nil
+}
+
+func (h renameTagHandler) RenameTag(
+	ctx context.Context,
+	req *connect.Request[v1.RenameTagRequest],
+) (*connect.Response[v1.RenameTagResponse], error) {
+	// Parse tag ID
+	tagID, err := tag.NewTagIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := tagapp.RenameTagRequest{
+		ID:   tagID,
+		Name: req.Msg.Name,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.RenameTagResponse{}), nil
+}
@@ -0,0 +1,41 @@
+package taghandler
+
+import (
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/samber/do"
+)
+
+// tagServiceHandler combines all individual handlers to implement TagServiceHandler
+type tagServiceHandler struct {
+	*createTagHandler
+	*listTagsHandler
+	*renameTagHandler
+	*deleteTagHandler
+}
+
+// NewTagServiceHandler creates a new TagServiceHandler using composition
+func NewTagServiceHandler(i *do.Injector) (v1connect.TagServiceHandler, error) {
+	createHandler, err := newCreateTagHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	listHandler, err := newListTagsHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	renameHandler, err := newRenameTagHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	deleteHandler, err := newDeleteTagHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tagServiceHandler{
+		createTagHandler: createHandler,
+		listTagsHandler:  listHandler,
+		renameTagHandler: renameHandler,
+		deleteTagHandler: deleteHandler,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package taghandler
+
+import (
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+)
+
+// domainTagToProto converts a domain Tag to a protobuf Tag
+func domainTagToProto(domainTag *tag.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:        domainTag.ID().String(),
+		Name:      domainTag.Name(),
+		Color:     domainTag.Color(),
+		CreatedAt: domainTag.CreatedAt().Unix(),
+		UpdatedAt: domainTag.UpdatedAt().Unix(),
+	}
+}
@@ -0,0 +1,28 @@
+package taghandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainTagToProto(t *testing.T) {
+	// Given
+	id := uuid.New()
+	createdAt := time.Now().UTC()
+	updatedAt := createdAt.Add(time.Hour)
+	domainTag := tag.ReconstructTag(id, "backend", "#1e90ff", createdAt, updatedAt)
+
+	// When
+	result := domainTagToProto(domainTag)
+
+	// Then
+	assert.Equal(t, id.String(), result.Id)
+	assert.Equal(t, "backend", result.Name)
+	assert.Equal(t, "#1e90ff", result.Color)
+	assert.Equal(t, createdAt.Unix(), result.CreatedAt)
+	assert.Equal(t, updatedAt.Unix(), result.UpdatedAt)
+}
@@ -0,0 +1,56 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// AddDependencyHandler handles AddDependency requests
+type addDependencyHandler struct {
+	useCase todoapp.AddDependencyUseCase
+}
+
+func newAddDependencyHandler(i *do.Injector) (*addDependencyHandler, error) {
+	addDependencyUseCase, err := do.Invoke[todoapp.AddDependencyUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke add dependency use case: %w", err)
+	}
+	return &addDependencyHandler{useCase: addDependencyUseCase}, nil
+}
+
+func (h addDependencyHandler) AddDependency(
+	ctx context.Context,
+	req *connect.Request[v1.AddDependencyRequest],
+) (*connect.Response[v1.AddDependencyResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Parse blocker ID
+	blockerID, err := parseUUIDFromString(req.Msg.BlockerId)
+	if err != nil {
+		return nil, connecterr.InvalidID("blocker_id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.AddDependencyRequest{
+		ID:        todoID,
+		BlockerID: blockerID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.AddDependencyResponse{}), nil
+}
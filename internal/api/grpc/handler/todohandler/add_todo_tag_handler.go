@@ -0,0 +1,57 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/samber/do"
+)
+
+// AddTodoTagHandler handles AddTodoTag requests
+type addTodoTagHandler struct {
+	useCase todoapp.AddTodoTagUseCase
+}
+
+func newAddTodoTagHandler(i *do.Injector) (*addTodoTagHandler, error) {
+	addTodoTagUseCase, err := do.Invoke[todoapp.AddTodoTagUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke add todo tag use case: %w", err)
+	}
+	return &addTodoTagHandler{useCase: addTodoTagUseCase}, nil
+}
+
+func (h addTodoTagHandler) AddTodoTag(
+	ctx context.Context,
+	req *connect.Request[v1.AddTodoTagRequest],
+) (*connect.Response[v1.AddTodoTagResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Parse tag ID
+	tagID, err := tag.NewTagIDFromString(req.Msg.TagId)
+	if err != nil {
+		return nil, connecterr.InvalidID("tag_id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.AddTodoTagRequest{
+		ID:    todoID,
+		TagID: tagID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.AddTodoTagResponse{}), nil
+}
@@ -0,0 +1,49 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// CancelTodoHandler handles CancelTodo requests
+type cancelTodoHandler struct {
+	useCase todoapp.CancelTodoUseCase
+}
+
+func newCancelTodoHandler(i *do.Injector) (*cancelTodoHandler, error) {
+	cancelTodoUseCase, err := do.Invoke[todoapp.CancelTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke cancel todo use case: %w", err)
+	}
+	return &cancelTodoHandler{useCase: cancelTodoUseCase}, nil
+}
+
+func (h cancelTodoHandler) CancelTodo(
+	ctx context.Context,
+	req *connect.Request[v1.CancelTodoRequest],
+) (*connect.Response[v1.CancelTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.CancelTodoRequest{
+		ID: todoID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.CancelTodoResponse{}), nil
+}
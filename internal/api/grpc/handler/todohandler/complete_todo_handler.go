@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
 	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
 	"github.com/iktakahiro/oniongo/internal/application/todoapp"
 	"github.com/samber/do"
@@ -30,17 +31,18 @@ func (h completeTodoHandler) CompleteTodo(
 	// Parse todo ID
 	todoID, err := parseUUIDFromString(req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, connecterr.InvalidID("id")
 	}
 
 	// Create use case request
 	useCaseReq := todoapp.CompleteTodoRequest{
-		ID: todoID,
+		ID:      todoID,
+		Cascade: req.Msg.Cascade,
 	}
 
 	// Execute use case
 	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
-		return nil, toConnectError(err)
+		return nil, connecterr.From(err)
 	}
 
 	// Return response
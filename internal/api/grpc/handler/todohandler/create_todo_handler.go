@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
 	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
 	"github.com/iktakahiro/oniongo/internal/application/todoapp"
 	"github.com/samber/do"
@@ -33,17 +34,35 @@ func (h createTodoHandler) CreateTodo(
 		body = *req.Msg.Body
 	}
 
+	// Parse parent ID
+	parentID, err := parseOptionalUUIDFromString(req.Msg.ParentId)
+	if err != nil {
+		return nil, connecterr.InvalidID("parent_id")
+	}
+
+	// Parse project ID
+	projectID, err := parseOptionalProjectIDFromString(req.Msg.ProjectId)
+	if err != nil {
+		return nil, connecterr.InvalidID("project_id")
+	}
+
 	// Create use case request
 	useCaseReq := todoapp.CreateTodoRequest{
-		Title: req.Msg.Title,
-		Body:  body,
+		Title:     req.Msg.Title,
+		Body:      body,
+		ParentID:  parentID,
+		ProjectID: projectID,
 	}
 
 	// Execute use case
-	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
-		return nil, toConnectError(err)
+	result, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
 	}
 
+	// Convert to protobuf
+	pbTodo := domainTodoToProto(result)
+
 	// Return response
-	return connect.NewResponse(&v1.CreateTodoResponse{}), nil
+	return connect.NewResponse(&v1.CreateTodoResponse{Todo: pbTodo}), nil
 }
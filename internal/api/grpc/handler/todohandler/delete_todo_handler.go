@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
 	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
 	"github.com/iktakahiro/oniongo/internal/application/todoapp"
 	"github.com/samber/do"
@@ -30,7 +31,7 @@ func (h deleteTodoHandler) DeleteTodo(
 	// Parse todo ID
 	todoID, err := parseUUIDFromString(req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, connecterr.InvalidID("id")
 	}
 
 	// Create use case request
@@ -40,7 +41,7 @@ func (h deleteTodoHandler) DeleteTodo(
 
 	// Execute use case
 	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
-		return nil, toConnectError(err)
+		return nil, connecterr.From(err)
 	}
 
 	// Return response
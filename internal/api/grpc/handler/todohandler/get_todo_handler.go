@@ -3,16 +3,23 @@ package todohandler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
 	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
 	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/samber/do"
 )
 
 // GetTodoHandler handles GetTodo requests
 type getTodoHandler struct {
-	useCase todoapp.GetTodoUseCase
+	useCase        todoapp.GetTodoUseCase
+	treeUseCase    todoapp.GetTodoTreeUseCase
+	versionUseCase todoapp.GetTodoVersionUseCase
+	countUseCase   commentapp.CountCommentsUseCase
 }
 
 func newGetTodoHandler(i *do.Injector) (*getTodoHandler, error) {
@@ -20,7 +27,24 @@ func newGetTodoHandler(i *do.Injector) (*getTodoHandler, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to invoke get todo use case: %w", err)
 	}
-	return &getTodoHandler{useCase: getTodoUseCase}, nil
+	getTodoTreeUseCase, err := do.Invoke[todoapp.GetTodoTreeUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke get todo tree use case: %w", err)
+	}
+	getTodoVersionUseCase, err := do.Invoke[todoapp.GetTodoVersionUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke get todo version use case: %w", err)
+	}
+	countCommentsUseCase, err := do.Invoke[commentapp.CountCommentsUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke count comments use case: %w", err)
+	}
+	return &getTodoHandler{
+		useCase:        getTodoUseCase,
+		treeUseCase:    getTodoTreeUseCase,
+		versionUseCase: getTodoVersionUseCase,
+		countUseCase:   countCommentsUseCase,
+	}, nil
 }
 
 func (h getTodoHandler) GetTodo(
@@ -30,7 +54,30 @@ func (h getTodoHandler) GetTodo(
 	// Parse todo ID
 	todoID, err := parseUUIDFromString(req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, connecterr.InvalidID("id")
+	}
+
+	if req.Msg.PointInTime != nil {
+		if req.Msg.IncludeSubtree {
+			return nil, connecterr.InvalidField("include_subtree", "cannot be combined with as_of or version")
+		}
+		return h.getTodoVersion(ctx, todoID, req.Msg)
+	}
+
+	if req.Msg.IncludeSubtree {
+		tree, err := h.treeUseCase.Execute(ctx, todoapp.GetTodoTreeRequest{ID: todoID})
+		if err != nil {
+			return nil, connecterr.From(err)
+		}
+		commentCounts, err := h.countUseCase.Execute(ctx, commentapp.CountCommentsRequest{TodoIDs: treeTodoIDs(tree)})
+		if err != nil {
+			return nil, connecterr.From(err)
+		}
+		subtree := domainTreeToProto(tree, commentCounts)
+		return connect.NewResponse(&v1.GetTodoResponse{
+			Todo:    subtree.Todo,
+			Subtree: subtree,
+		}), nil
 	}
 
 	// Create use case request
@@ -41,12 +88,52 @@ func (h getTodoHandler) GetTodo(
 	// Execute use case
 	domainTodo, err := h.useCase.Execute(ctx, useCaseReq)
 	if err != nil {
-		return nil, toConnectError(err)
+		return nil, connecterr.From(err)
+	}
+
+	// Count comments
+	commentCounts, err := h.countUseCase.Execute(ctx, commentapp.CountCommentsRequest{
+		TodoIDs: []todo.TodoID{domainTodo.ID()},
+	})
+	if err != nil {
+		return nil, connecterr.From(err)
 	}
 
 	// Convert to protobuf and return response
 	pbTodo := domainTodoToProto(domainTodo)
+	pbTodo.CommentCount = int32(commentCounts[domainTodo.ID()])
 	return connect.NewResponse(&v1.GetTodoResponse{
 		Todo: pbTodo,
 	}), nil
 }
+
+// getTodoVersion returns the todo as it was at the point in time of the request.
+// Comments are not versioned, so the comment count is left unset.
+func (h getTodoHandler) getTodoVersion(
+	ctx context.Context,
+	todoID todo.TodoID,
+	msg *v1.GetTodoRequest,
+) (*connect.Response[v1.GetTodoResponse], error) {
+	// Create use case request
+	useCaseReq := todoapp.GetTodoVersionRequest{
+		ID:      todoID,
+		Version: int(msg.GetVersion()),
+	}
+	if _, ok := msg.PointInTime.(*v1.GetTodoRequest_AsOf); ok {
+		// Include the changes made during the requested second.
+		asOf := time.Unix(msg.GetAsOf()+1, 0).Add(-time.Nanosecond)
+		useCaseReq.AsOf = &asOf
+	}
+
+	// Execute use case
+	version, err := h.versionUseCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf and return response
+	return connect.NewResponse(&v1.GetTodoResponse{
+		Todo:    domainTodoToProto(version.Todo),
+		Version: int64(version.Version),
+	}), nil
+}
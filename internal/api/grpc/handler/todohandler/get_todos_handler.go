@@ -5,14 +5,18 @@ import (
 	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
 	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
 	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
 	"github.com/samber/do"
 )
 
 // GetTodosHandler handles GetTodos requests
 type getTodosHandler struct {
-	useCase todoapp.GetTodosUseCase
+	useCase      todoapp.GetTodosUseCase
+	countUseCase commentapp.CountCommentsUseCase
 }
 
 func newGetTodosHandler(i *do.Injector) (*getTodosHandler, error) {
@@ -20,26 +24,70 @@ func newGetTodosHandler(i *do.Injector) (*getTodosHandler, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to invoke get todos use case: %w", err)
 	}
-	return &getTodosHandler{useCase: getTodosUseCase}, nil
+	countCommentsUseCase, err := do.Invoke[commentapp.CountCommentsUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke count comments use case: %w", err)
+	}
+	return &getTodosHandler{
+		useCase:      getTodosUseCase,
+		countUseCase: countCommentsUseCase,
+	}, nil
 }
 
 func (h getTodosHandler) GetTodos(
 	ctx context.Context,
 	req *connect.Request[v1.GetTodosRequest],
 ) (*connect.Response[v1.GetTodosResponse], error) {
+	// Parse tag IDs
+	tagIDs := make([]tag.TagID, len(req.Msg.TagIds))
+	for i, tagIDStr := range req.Msg.TagIds {
+		tagID, err := tag.NewTagIDFromString(tagIDStr)
+		if err != nil {
+			return nil, connecterr.InvalidID(fmt.Sprintf("tag_ids[%d]", i))
+		}
+		tagIDs[i] = tagID
+	}
+
+	// Parse parent ID
+	parentID, err := parseOptionalUUIDFromString(req.Msg.ParentId)
+	if err != nil {
+		return nil, connecterr.InvalidID("parent_id")
+	}
+
+	// Parse project ID
+	projectID, err := parseOptionalProjectIDFromString(req.Msg.ProjectId)
+	if err != nil {
+		return nil, connecterr.InvalidID("project_id")
+	}
+
 	// Create use case request
-	useCaseReq := todoapp.GetTodosRequest{}
+	useCaseReq := todoapp.GetTodosRequest{
+		TagIDs:         tagIDs,
+		TagMatch:       protoTagMatchToDomain(req.Msg.TagMatch),
+		ParentID:       parentID,
+		ProjectID:      projectID,
+		ActionableOnly: req.Msg.ActionableOnly,
+	}
 
 	// Execute use case
 	domainTodos, err := h.useCase.Execute(ctx, useCaseReq)
 	if err != nil {
-		return nil, toConnectError(err)
+		return nil, connecterr.From(err)
+	}
+
+	// Count comments
+	commentCounts, err := h.countUseCase.Execute(ctx, commentapp.CountCommentsRequest{
+		TodoIDs: todoIDs(domainTodos),
+	})
+	if err != nil {
+		return nil, connecterr.From(err)
 	}
 
 	// Convert to protobuf
 	pbTodos := make([]*v1.Todo, len(domainTodos))
 	for i, domainTodo := range domainTodos {
 		pbTodos[i] = domainTodoToProto(domainTodo)
+		pbTodos[i].CommentCount = int32(commentCounts[domainTodo.ID()])
 	}
 
 	// Return response
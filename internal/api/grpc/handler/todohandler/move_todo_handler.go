@@ -0,0 +1,56 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// MoveTodoHandler handles MoveTodo requests
+type moveTodoHandler struct {
+	useCase todoapp.MoveTodoUseCase
+}
+
+func newMoveTodoHandler(i *do.Injector) (*moveTodoHandler, error) {
+	moveTodoUseCase, err := do.Invoke[todoapp.MoveTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke move todo use case: %w", err)
+	}
+	return &moveTodoHandler{useCase: moveTodoUseCase}, nil
+}
+
+func (h moveTodoHandler) MoveTodo(
+	ctx context.Context,
+	req *connect.Request[v1.MoveTodoRequest],
+) (*connect.Response[v1.MoveTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Parse parent ID
+	parentID, err := parseOptionalUUIDFromString(req.Msg.ParentId)
+	if err != nil {
+		return nil, connecterr.InvalidID("parent_id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.MoveTodoRequest{
+		ID:       todoID,
+		ParentID: parentID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.MoveTodoResponse{}), nil
+}
@@ -0,0 +1,49 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// PauseTodoHandler handles PauseTodo requests
+type pauseTodoHandler struct {
+	useCase todoapp.PauseTodoUseCase
+}
+
+func newPauseTodoHandler(i *do.Injector) (*pauseTodoHandler, error) {
+	pauseTodoUseCase, err := do.Invoke[todoapp.PauseTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke pause todo use case: %w", err)
+	}
+	return &pauseTodoHandler{useCase: pauseTodoUseCase}, nil
+}
+
+func (h pauseTodoHandler) PauseTodo(
+	ctx context.Context,
+	req *connect.Request[v1.PauseTodoRequest],
+) (*connect.Response[v1.PauseTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.PauseTodoRequest{
+		ID: todoID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.PauseTodoResponse{}), nil
+}
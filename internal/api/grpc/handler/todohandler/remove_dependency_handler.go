@@ -0,0 +1,56 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// RemoveDependencyHandler handles RemoveDependency requests
+type removeDependencyHandler struct {
+	useCase todoapp.RemoveDependencyUseCase
+}
+
+func newRemoveDependencyHandler(i *do.Injector) (*removeDependencyHandler, error) {
+	removeDependencyUseCase, err := do.Invoke[todoapp.RemoveDependencyUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke remove dependency use case: %w", err)
+	}
+	return &removeDependencyHandler{useCase: removeDependencyUseCase}, nil
+}
+
+func (h removeDependencyHandler) RemoveDependency(
+	ctx context.Context,
+	req *connect.Request[v1.RemoveDependencyRequest],
+) (*connect.Response[v1.RemoveDependencyResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Parse blocker ID
+	blockerID, err := parseUUIDFromString(req.Msg.BlockerId)
+	if err != nil {
+		return nil, connecterr.InvalidID("blocker_id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.RemoveDependencyRequest{
+		ID:        todoID,
+		BlockerID: blockerID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.RemoveDependencyResponse{}), nil
+}
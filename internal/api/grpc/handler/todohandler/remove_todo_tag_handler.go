@@ -0,0 +1,57 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/samber/do"
+)
+
+// RemoveTodoTagHandler handles RemoveTodoTag requests
+type removeTodoTagHandler struct {
+	useCase todoapp.RemoveTodoTagUseCase
+}
+
+func newRemoveTodoTagHandler(i *do.Injector) (*removeTodoTagHandler, error) {
+	removeTodoTagUseCase, err := do.Invoke[todoapp.RemoveTodoTagUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke remove todo tag use case: %w", err)
+	}
+	return &removeTodoTagHandler{useCase: removeTodoTagUseCase}, nil
+}
+
+func (h removeTodoTagHandler) RemoveTodoTag(
+	ctx context.Context,
+	req *connect.Request[v1.RemoveTodoTagRequest],
+) (*connect.Response[v1.RemoveTodoTagResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Parse tag ID
+	tagID, err := tag.NewTagIDFromString(req.Msg.TagId)
+	if err != nil {
+		return nil, connecterr.InvalidID("tag_id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.RemoveTodoTagRequest{
+		ID:    todoID,
+		TagID: tagID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.RemoveTodoTagResponse{}), nil
+}
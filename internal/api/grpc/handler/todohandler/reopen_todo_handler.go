@@ -0,0 +1,49 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// ReopenTodoHandler handles ReopenTodo requests
+type reopenTodoHandler struct {
+	useCase todoapp.ReopenTodoUseCase
+}
+
+func newReopenTodoHandler(i *do.Injector) (*reopenTodoHandler, error) {
+	reopenTodoUseCase, err := do.Invoke[todoapp.ReopenTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke reopen todo use case: %w", err)
+	}
+	return &reopenTodoHandler{useCase: reopenTodoUseCase}, nil
+}
+
+func (h reopenTodoHandler) ReopenTodo(
+	ctx context.Context,
+	req *connect.Request[v1.ReopenTodoRequest],
+) (*connect.Response[v1.ReopenTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.ReopenTodoRequest{
+		ID: todoID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.ReopenTodoResponse{}), nil
+}
@@ -0,0 +1,49 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// ResumeTodoHandler handles ResumeTodo requests
+type resumeTodoHandler struct {
+	useCase todoapp.ResumeTodoUseCase
+}
+
+func newResumeTodoHandler(i *do.Injector) (*resumeTodoHandler, error) {
+	resumeTodoUseCase, err := do.Invoke[todoapp.ResumeTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke resume todo use case: %w", err)
+	}
+	return &resumeTodoHandler{useCase: resumeTodoUseCase}, nil
+}
+
+func (h resumeTodoHandler) ResumeTodo(
+	ctx context.Context,
+	req *connect.Request[v1.ResumeTodoRequest],
+) (*connect.Response[v1.ResumeTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.ResumeTodoRequest{
+		ID: todoID,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.ResumeTodoResponse{}), nil
+}
@@ -0,0 +1,50 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// RevertTodoHandler handles RevertTodo requests
+type revertTodoHandler struct {
+	useCase todoapp.RevertTodoUseCase
+}
+
+func newRevertTodoHandler(i *do.Injector) (*revertTodoHandler, error) {
+	revertTodoUseCase, err := do.Invoke[todoapp.RevertTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke revert todo use case: %w", err)
+	}
+	return &revertTodoHandler{useCase: revertTodoUseCase}, nil
+}
+
+func (h revertTodoHandler) RevertTodo(
+	ctx context.Context,
+	req *connect.Request[v1.RevertTodoRequest],
+) (*connect.Response[v1.RevertTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.RevertTodoRequest{
+		ID:      todoID,
+		Version: int(req.Msg.Version),
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.RevertTodoResponse{}), nil
+}
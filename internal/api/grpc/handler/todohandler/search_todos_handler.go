@@ -0,0 +1,83 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// SearchTodosHandler handles SearchTodos requests
+type searchTodosHandler struct {
+	useCase      todoapp.SearchTodosUseCase
+	countUseCase commentapp.CountCommentsUseCase
+}
+
+func newSearchTodosHandler(i *do.Injector) (*searchTodosHandler, error) {
+	searchTodosUseCase, err := do.Invoke[todoapp.SearchTodosUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke search todos use case: %w", err)
+	}
+	countCommentsUseCase, err := do.Invoke[commentapp.CountCommentsUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke count comments use case: %w", err)
+	}
+	return &searchTodosHandler{
+		useCase:      searchTodosUseCase,
+		countUseCase: countCommentsUseCase,
+	}, nil
+}
+
+func (h searchTodosHandler) SearchTodos(
+	ctx context.Context,
+	req *connect.Request[v1.SearchTodosRequest],
+) (*connect.Response[v1.SearchTodosResponse], error) {
+	// Parse page token
+	offset, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connecterr.InvalidField("page_token", err.Error())
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.SearchTodosRequest{
+		Query:    req.Msg.Query,
+		PageSize: int(req.Msg.PageSize),
+		Offset:   offset,
+	}
+
+	// Execute use case
+	result, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Count comments
+	hitTodoIDs := make([]todo.TodoID, len(result.Hits))
+	for i, hit := range result.Hits {
+		hitTodoIDs[i] = hit.Todo.ID()
+	}
+	commentCounts, err := h.countUseCase.Execute(ctx, commentapp.CountCommentsRequest{TodoIDs: hitTodoIDs})
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	pbHits := make([]*v1.TodoSearchHit, len(result.Hits))
+	for i, hit := range result.Hits {
+		pbHits[i] = domainSearchHitToProto(hit)
+		pbHits[i].Todo.CommentCount = int32(commentCounts[hit.Todo.ID()])
+	}
+
+	// Return response
+	res := &v1.SearchTodosResponse{Hits: pbHits}
+	if result.NextOffset != nil {
+		res.NextPageToken = encodePageToken(*result.NextOffset)
+	}
+	return connect.NewResponse(res), nil
+}
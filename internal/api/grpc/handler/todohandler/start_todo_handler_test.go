@@ -0,0 +1,50 @@
+package todohandler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_todoapp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestStartTodoHandler_InvalidID(t *testing.T) {
+	// Given
+	useCase := mock_todoapp.NewMockStartTodoUseCase(t)
+	handler := startTodoHandler{useCase: useCase}
+
+	// When
+	_, err := handler.StartTodo(context.Background(), connect.NewRequest(&v1.StartTodoRequest{Id: "not-a-uuid"}))
+
+	// Then
+	var connectErr *connect.Error
+	require.True(t, errors.As(err, &connectErr))
+	assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+	assert.NotContains(t, connectErr.Message(), "invalid UUID length")
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		require.NoError(t, err)
+		switch v := value.(type) {
+		case *errdetails.ErrorInfo:
+			info = v
+		case *errdetails.BadRequest:
+			badRequest = v
+		}
+	}
+	require.NotNil(t, info)
+	assert.Equal(t, connecterr.ReasonInvalidArgument, info.GetReason())
+	assert.Equal(t, connecterr.Domain, info.GetDomain())
+	require.NotNil(t, badRequest)
+	require.Len(t, badRequest.GetFieldViolations(), 1)
+	assert.Equal(t, "id", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "must be a UUID", badRequest.GetFieldViolations()[0].GetDescription())
+}
@@ -10,10 +10,22 @@ type todoServiceHandler struct {
 	*createTodoHandler
 	*getTodoHandler
 	*getTodosHandler
+	*searchTodosHandler
 	*updateTodoHandler
 	*startTodoHandler
 	*completeTodoHandler
+	*reopenTodoHandler
+	*cancelTodoHandler
+	*pauseTodoHandler
+	*resumeTodoHandler
+	*transitionTodoHandler
+	*revertTodoHandler
 	*deleteTodoHandler
+	*addTodoTagHandler
+	*removeTodoTagHandler
+	*moveTodoHandler
+	*addDependencyHandler
+	*removeDependencyHandler
 }
 
 // NewTodoServiceHandler creates a new TodoServiceHandler using composition
@@ -30,6 +42,10 @@ func NewTodoServiceHandler(i *do.Injector) (v1connect.TodoServiceHandler, error)
 	if err != nil {
 		return nil, err
 	}
+	searchHandler, err := newSearchTodosHandler(i)
+	if err != nil {
+		return nil, err
+	}
 	updateHandler, err := newUpdateTodoHandler(i)
 	if err != nil {
 		return nil, err
@@ -42,18 +58,74 @@ func NewTodoServiceHandler(i *do.Injector) (v1connect.TodoServiceHandler, error)
 	if err != nil {
 		return nil, err
 	}
+	reopenHandler, err := newReopenTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	cancelHandler, err := newCancelTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	pauseHandler, err := newPauseTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	resumeHandler, err := newResumeTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	transitionHandler, err := newTransitionTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	revertHandler, err := newRevertTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
 	deleteHandler, err := newDeleteTodoHandler(i)
 	if err != nil {
 		return nil, err
 	}
+	addTagHandler, err := newAddTodoTagHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	removeTagHandler, err := newRemoveTodoTagHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	moveHandler, err := newMoveTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	addDependencyHandler, err := newAddDependencyHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	removeDependencyHandler, err := newRemoveDependencyHandler(i)
+	if err != nil {
+		return nil, err
+	}
 
 	return &todoServiceHandler{
-		createTodoHandler:   createHandler,
-		getTodoHandler:      getHandler,
-		getTodosHandler:     getTodosHandler,
-		updateTodoHandler:   updateHandler,
-		startTodoHandler:    startHandler,
-		completeTodoHandler: completeHandler,
-		deleteTodoHandler:   deleteHandler,
+		createTodoHandler:       createHandler,
+		getTodoHandler:          getHandler,
+		getTodosHandler:         getTodosHandler,
+		searchTodosHandler:      searchHandler,
+		updateTodoHandler:       updateHandler,
+		startTodoHandler:        startHandler,
+		completeTodoHandler:     completeHandler,
+		reopenTodoHandler:       reopenHandler,
+		cancelTodoHandler:       cancelHandler,
+		pauseTodoHandler:        pauseHandler,
+		resumeTodoHandler:       resumeHandler,
+		transitionTodoHandler:   transitionHandler,
+		revertTodoHandler:       revertHandler,
+		deleteTodoHandler:       deleteHandler,
+		addTodoTagHandler:       addTagHandler,
+		removeTodoTagHandler:    removeTagHandler,
+		moveTodoHandler:         moveHandler,
+		addDependencyHandler:    addDependencyHandler,
+		removeDependencyHandler: removeDependencyHandler,
 	}, nil
 }
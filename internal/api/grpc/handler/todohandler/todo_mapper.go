@@ -1,20 +1,30 @@
 package todohandler
 
 import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
 	"github.com/google/uuid"
 	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 )
 
 // domainTodoToProto converts a domain Todo to a protobuf Todo
 func domainTodoToProto(domainTodo *todo.Todo) *pb.Todo {
 	pbTodo := &pb.Todo{
-		Id:        domainTodo.ID().String(),
-		Title:     domainTodo.Title(),
-		Body:      domainTodo.Body(),
-		Status:    domainStatusToProtoStatus(domainTodo.Status()),
-		CreatedAt: domainTodo.CreatedAt().Unix(),
-		UpdatedAt: domainTodo.UpdatedAt().Unix(),
+		Id:         domainTodo.ID().String(),
+		Title:      domainTodo.Title(),
+		Body:       domainTodo.Body(),
+		Status:     domainStatusToProtoStatus(domainTodo.Status()),
+		CreatedAt:  domainTodo.CreatedAt().Unix(),
+		UpdatedAt:  domainTodo.UpdatedAt().Unix(),
+		TagIds:     make([]string, 0, len(domainTodo.TagIDs())),
+		BlockerIds: make([]string, 0, len(domainTodo.BlockerIDs())),
+		StatusId:   domainTodo.StatusID().String(),
 	}
 
 	if completedAt := domainTodo.CompletedAt(); completedAt != nil {
@@ -22,9 +32,75 @@ func domainTodoToProto(domainTodo *todo.Todo) *pb.Todo {
 		pbTodo.CompletedAt = &timestamp
 	}
 
+	for _, tagID := range domainTodo.TagIDs() {
+		pbTodo.TagIds = append(pbTodo.TagIds, tagID.String())
+	}
+
+	for _, blockerID := range domainTodo.BlockerIDs() {
+		pbTodo.BlockerIds = append(pbTodo.BlockerIds, blockerID.String())
+	}
+
+	if parentID := domainTodo.ParentID(); parentID != nil {
+		parentIDStr := parentID.String()
+		pbTodo.ParentId = &parentIDStr
+	}
+
+	if projectID := domainTodo.ProjectID(); projectID != nil {
+		projectIDStr := projectID.String()
+		pbTodo.ProjectId = &projectIDStr
+	}
+
 	return pbTodo
 }
 
+// domainSearchHitToProto converts a domain SearchHit to a protobuf TodoSearchHit
+func domainSearchHitToProto(hit *todo.SearchHit) *pb.TodoSearchHit {
+	return &pb.TodoSearchHit{
+		Todo:         domainTodoToProto(hit.Todo),
+		Score:        hit.Score,
+		TitleSnippet: hit.TitleSnippet,
+		BodySnippet:  hit.BodySnippet,
+	}
+}
+
+// domainTreeToProto converts a domain TodoTree to a protobuf TodoNode
+// with the comment counts of the todos in the tree
+func domainTreeToProto(tree *todo.TodoTree, commentCounts map[todo.TodoID]int) *pb.TodoNode {
+	progress := tree.Progress()
+	pbTodo := domainTodoToProto(tree.Todo)
+	pbTodo.CommentCount = int32(commentCounts[tree.Todo.ID()])
+	node := &pb.TodoNode{
+		Todo:     pbTodo,
+		Children: make([]*pb.TodoNode, len(tree.Children)),
+		Progress: &pb.TodoProgress{
+			Completed: int32(progress.Completed),
+			Total:     int32(progress.Total),
+		},
+	}
+	for i, child := range tree.Children {
+		node.Children[i] = domainTreeToProto(child, commentCounts)
+	}
+	return node
+}
+
+// todoIDs returns the IDs of the todos
+func todoIDs(todos []*todo.Todo) []todo.TodoID {
+	ids := make([]todo.TodoID, len(todos))
+	for i, t := range todos {
+		ids[i] = t.ID()
+	}
+	return ids
+}
+
+// treeTodoIDs returns the IDs of all todos in the tree
+func treeTodoIDs(tree *todo.TodoTree) []todo.TodoID {
+	ids := []todo.TodoID{tree.Todo.ID()}
+	for _, child := range tree.Children {
+		ids = append(ids, treeTodoIDs(child)...)
+	}
+	return ids
+}
+
 // domainStatusToProtoStatus converts a domain TodoStatus to a protobuf TodoStatus
 func domainStatusToProtoStatus(domainStatus todo.TodoStatus) pb.TodoStatus {
 	switch domainStatus {
@@ -34,11 +110,56 @@ func domainStatusToProtoStatus(domainStatus todo.TodoStatus) pb.TodoStatus {
 		return pb.TodoStatus_TODO_STATUS_IN_PROGRESS
 	case todo.TodoStatusCompleted:
 		return pb.TodoStatus_TODO_STATUS_COMPLETED
+	case todo.TodoStatusCancelled:
+		return pb.TodoStatus_TODO_STATUS_CANCELLED
+	case todo.TodoStatusOnHold:
+		return pb.TodoStatus_TODO_STATUS_ON_HOLD
 	default:
 		return pb.TodoStatus_TODO_STATUS_UNSPECIFIED
 	}
 }
 
+// protoTagMatchToDomain converts a protobuf TagMatchMode to a domain TagMatch
+func protoTagMatchToDomain(mode pb.TagMatchMode) todo.TagMatch {
+	if mode == pb.TagMatchMode_TAG_MATCH_MODE_ALL {
+		return todo.TagMatchAll
+	}
+	return todo.TagMatchAny
+}
+
+// updateMaskWildcard is the update mask path that names every field that can be updated
+const updateMaskWildcard = "*"
+
+// protoUpdateToDomain converts an UpdateTodoRequest to a use case request. Without an update mask,
+// the title and the body are replaced and a missing body clears it. With a mask, only the fields
+// in the mask are set, so the others are not changed.
+func protoUpdateToDomain(id todo.TodoID, msg *pb.UpdateTodoRequest) (todoapp.UpdateTodoRequest, error) {
+	req := todoapp.UpdateTodoRequest{ID: id}
+	title, body := msg.GetTitle(), msg.GetBody()
+	if msg.GetUpdateMask() == nil {
+		req.Title = &title
+		req.Body = &body
+		return req, nil
+	}
+	if len(msg.GetUpdateMask().GetPaths()) == 0 {
+		return req, errors.New("must name at least one field")
+	}
+	for _, path := range msg.GetUpdateMask().GetPaths() {
+		switch path {
+		case "title":
+			req.Title = &title
+		case "body":
+			req.Body = &body
+		case updateMaskWildcard:
+			req.Title = &title
+			req.Body = &body
+		default:
+			return req, fmt.Errorf("field %q cannot be updated", path)
+		}
+	}
+	return req, nil
+}
+
 // parseUUIDFromString parses a UUID string and returns a TodoID
 func parseUUIDFromString(idStr string) (todo.TodoID, error) {
 	id, err := uuid.Parse(idStr)
@@ -47,3 +168,48 @@ func parseUUIDFromString(idStr string) (todo.TodoID, error) {
 	}
 	return todo.TodoID(id), nil
 }
+
+// parseOptionalUUIDFromString parses an optional UUID string and returns an optional TodoID
+func parseOptionalUUIDFromString(idStr *string) (*todo.TodoID, error) {
+	if idStr == nil {
+		return nil, nil
+	}
+	id, err := parseUUIDFromString(*idStr)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// parseOptionalProjectIDFromString parses an optional UUID string and returns an optional ProjectID
+func parseOptionalProjectIDFromString(idStr *string) (*project.ProjectID, error) {
+	if idStr == nil {
+		return nil, nil
+	}
+	id, err := project.NewProjectIDFromString(*idStr)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// encodePageToken encodes the offset of the next page as an opaque page token
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodePageToken decodes a page token created by encodePageToken. An empty token is the first page.
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+	offset, err := strconv.Atoi(string(b))
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid page token")
+	}
+	return offset, nil
+}
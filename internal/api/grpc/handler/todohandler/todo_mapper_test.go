@@ -6,9 +6,13 @@ import (
 
 	"github.com/google/uuid"
 	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestDomainTodoToProto(t *testing.T) {
@@ -33,6 +37,12 @@ func TestDomainTodoToProto(t *testing.T) {
 					createdAt,
 					updatedAt,
 					&completedAt,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					"",
 				)
 				return todoItem
 			},
@@ -64,6 +74,12 @@ func TestDomainTodoToProto(t *testing.T) {
 					createdAt,
 					updatedAt,
 					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					"",
 				)
 				return todoItem
 			},
@@ -94,6 +110,12 @@ func TestDomainTodoToProto(t *testing.T) {
 					createdAt,
 					updatedAt,
 					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					"",
 				)
 				return todoItem
 			},
@@ -112,7 +134,7 @@ func TestDomainTodoToProto(t *testing.T) {
 		{
 			name: "converts todo created with NewTodo",
 			setupTodo: func() *todo.Todo {
-				todoItem, err := todo.NewTodo("Simple Todo", "Simple Body")
+				todoItem, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Simple Todo", "Simple Body")
 				require.NoError(t, err)
 				return todoItem
 			},
@@ -154,6 +176,175 @@ func TestDomainTodoToProto(t *testing.T) {
 	}
 }
 
+func TestDomainTodoToProto_TagIDs(t *testing.T) {
+	// Given
+	tagIDs := []tag.TagID{tag.NewTagID(), tag.NewTagID()}
+	domainTodo := todo.ReconstructTodoWithStatus(
+		uuid.New(),
+		"Tagged Todo",
+		"Body",
+		todo.TodoStatusNotStarted,
+		time.Now(),
+		time.Now(),
+		nil,
+		tagIDs,
+		nil,
+		nil,
+		nil,
+		nil,
+		"",
+	)
+
+	// When
+	result := domainTodoToProto(domainTodo)
+
+	// Then
+	require.Len(t, result.TagIds, 2)
+	assert.Equal(t, tagIDs[0].String(), result.TagIds[0])
+	assert.Equal(t, tagIDs[1].String(), result.TagIds[1])
+}
+
+func TestDomainTodoToProto_ParentID(t *testing.T) {
+	// Given
+	parentID := todo.NewTodoID()
+	domainTodo := todo.ReconstructTodoWithStatus(
+		uuid.New(),
+		"Subtask",
+		"Body",
+		todo.TodoStatusNotStarted,
+		time.Now(),
+		time.Now(),
+		nil,
+		nil,
+		&parentID,
+		nil,
+		nil,
+		nil,
+		"",
+	)
+
+	// When
+	result := domainTodoToProto(domainTodo)
+
+	// Then
+	require.NotNil(t, result.ParentId)
+	assert.Equal(t, parentID.String(), *result.ParentId)
+}
+
+func TestDomainTodoToProto_Project(t *testing.T) {
+	t.Run("converts project and workflow status", func(t *testing.T) {
+		// Given
+		projectID := project.NewProjectID()
+		domainTodo := todo.ReconstructTodoWithStatus(
+			uuid.New(),
+			"Project Todo",
+			"Body",
+			todo.TodoStatusInProgress,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			nil,
+			nil,
+			&projectID,
+			project.DefaultWorkflow(),
+			"doing",
+		)
+
+		// When
+		result := domainTodoToProto(domainTodo)
+
+		// Then
+		require.NotNil(t, result.ProjectId)
+		assert.Equal(t, projectID.String(), *result.ProjectId)
+		assert.Equal(t, "doing", result.StatusId)
+		assert.Equal(t, pb.TodoStatus_TODO_STATUS_IN_PROGRESS, result.Status)
+	})
+
+	t.Run("uses the status name for todo without project", func(t *testing.T) {
+		// Given
+		domainTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Todo", "")
+		require.NoError(t, err)
+
+		// When
+		result := domainTodoToProto(domainTodo)
+
+		// Then
+		assert.Nil(t, result.ProjectId)
+		assert.Equal(t, "NOT_STARTED", result.StatusId)
+	})
+}
+
+func TestDomainTreeToProto(t *testing.T) {
+	// Given
+	root, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Root", "")
+	require.NoError(t, err)
+	rootID := root.ID()
+	child := todo.ReconstructTodoWithStatus(
+		uuid.New(),
+		"Child",
+		"",
+		todo.TodoStatusCompleted,
+		time.Now(),
+		time.Now(),
+		nil,
+		nil,
+		&rootID,
+		nil,
+		nil,
+		nil,
+		"",
+	)
+	tree := todo.NewTodoTree(root, []*todo.Todo{child})
+	commentCounts := map[todo.TodoID]int{child.ID(): 2}
+
+	// When
+	result := domainTreeToProto(tree, commentCounts)
+
+	// Then
+	assert.Equal(t, root.ID().String(), result.Todo.Id)
+	assert.Nil(t, result.Todo.ParentId)
+	assert.Equal(t, int32(1), result.Progress.Completed)
+	assert.Equal(t, int32(1), result.Progress.Total)
+	require.Len(t, result.Children, 1)
+	assert.Equal(t, int32(0), result.Todo.CommentCount)
+	assert.Equal(t, child.ID().String(), result.Children[0].Todo.Id)
+	assert.Equal(t, int32(2), result.Children[0].Todo.CommentCount)
+	assert.Empty(t, result.Children[0].Children)
+	assert.Equal(t, int32(0), result.Children[0].Progress.Total)
+}
+
+func TestProtoTagMatchToDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     pb.TagMatchMode
+		expected todo.TagMatch
+	}{
+		{
+			name:     "unspecified defaults to any",
+			mode:     pb.TagMatchMode_TAG_MATCH_MODE_UNSPECIFIED,
+			expected: todo.TagMatchAny,
+		},
+		{
+			name:     "converts any",
+			mode:     pb.TagMatchMode_TAG_MATCH_MODE_ANY,
+			expected: todo.TagMatchAny,
+		},
+		{
+			name:     "converts all",
+			mode:     pb.TagMatchMode_TAG_MATCH_MODE_ALL,
+			expected: todo.TagMatchAll,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := protoTagMatchToDomain(tt.mode)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestDomainStatusToProtoStatus(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -175,6 +366,16 @@ func TestDomainStatusToProtoStatus(t *testing.T) {
 			domainStatus:   todo.TodoStatusCompleted,
 			expectedStatus: pb.TodoStatus_TODO_STATUS_COMPLETED,
 		},
+		{
+			name:           "converts cancelled status",
+			domainStatus:   todo.TodoStatusCancelled,
+			expectedStatus: pb.TodoStatus_TODO_STATUS_CANCELLED,
+		},
+		{
+			name:           "converts on hold status",
+			domainStatus:   todo.TodoStatusOnHold,
+			expectedStatus: pb.TodoStatus_TODO_STATUS_ON_HOLD,
+		},
 		{
 			name:           "converts unknown status to unspecified",
 			domainStatus:   todo.TodoStatus(999), // Invalid status
@@ -190,6 +391,75 @@ func TestDomainStatusToProtoStatus(t *testing.T) {
 	}
 }
 
+func TestProtoUpdateToDomain(t *testing.T) {
+	todoID := todo.NewTodoID()
+	title, body := "Renamed", "New body"
+
+	tests := []struct {
+		name      string
+		msg       *pb.UpdateTodoRequest
+		wantTitle *string
+		wantBody  *string
+		wantErr   bool
+	}{
+		{
+			name:      "replaces the title and the body without a mask",
+			msg:       &pb.UpdateTodoRequest{Title: title, Body: &body},
+			wantTitle: &title,
+			wantBody:  &body,
+		},
+		{
+			name:      "clears the body without a mask",
+			msg:       &pb.UpdateTodoRequest{Title: title},
+			wantTitle: &title,
+			wantBody:  new(string),
+		},
+		{
+			name:      "updates only the title",
+			msg:       &pb.UpdateTodoRequest{Title: title, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}},
+			wantTitle: &title,
+		},
+		{
+			name:     "updates only the body",
+			msg:      &pb.UpdateTodoRequest{Body: &body, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"body"}}},
+			wantBody: &body,
+		},
+		{
+			name:      "updates all fields with the wildcard",
+			msg:       &pb.UpdateTodoRequest{Title: title, Body: &body, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}}},
+			wantTitle: &title,
+			wantBody:  &body,
+		},
+		{
+			name:    "rejects an empty mask",
+			msg:     &pb.UpdateTodoRequest{Title: title, UpdateMask: &fieldmaskpb.FieldMask{}},
+			wantErr: true,
+		},
+		{
+			name:    "rejects a field that cannot be updated",
+			msg:     &pb.UpdateTodoRequest{Title: title, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			req, err := protoUpdateToDomain(todoID, tt.msg)
+
+			// Then
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, todoID, req.ID)
+			assert.Equal(t, tt.wantTitle, req.Title)
+			assert.Equal(t, tt.wantBody, req.Body)
+		})
+	}
+}
+
 func TestParseUUIDFromString(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -259,3 +529,77 @@ func TestParseUUIDFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseOptionalUUIDFromString(t *testing.T) {
+	t.Run("returns nil for unset value", func(t *testing.T) {
+		result, err := parseOptionalUUIDFromString(nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("parses valid UUID", func(t *testing.T) {
+		input := "550e8400-e29b-41d4-a716-446655440000"
+
+		result, err := parseOptionalUUIDFromString(&input)
+
+		require.NoError(t, err)
+		assert.Equal(t, input, result.String())
+	})
+
+	t.Run("returns error for invalid UUID", func(t *testing.T) {
+		input := "invalid-uuid"
+
+		result, err := parseOptionalUUIDFromString(&input)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestDomainSearchHitToProto(t *testing.T) {
+	// Given
+	domainTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Deploy the api", "")
+	require.NoError(t, err)
+	hit := &todo.SearchHit{
+		Todo:         domainTodo,
+		Score:        1.5,
+		TitleSnippet: "<mark>Deploy</mark> the api",
+	}
+
+	// When
+	result := domainSearchHitToProto(hit)
+
+	// Then
+	assert.Equal(t, domainTodo.ID().String(), result.Todo.Id)
+	assert.Equal(t, 1.5, result.Score)
+	assert.Equal(t, "<mark>Deploy</mark> the api", result.TitleSnippet)
+	assert.Empty(t, result.BodySnippet)
+}
+
+func TestPageToken(t *testing.T) {
+	t.Run("round trips the offset", func(t *testing.T) {
+		// When
+		offset, err := decodePageToken(encodePageToken(40))
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, 40, offset)
+	})
+
+	t.Run("empty token is the first page", func(t *testing.T) {
+		// When
+		offset, err := decodePageToken("")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, 0, offset)
+	})
+
+	t.Run("returns error for invalid token", func(t *testing.T) {
+		for _, token := range []string{"!!!", encodePageToken(-1), "YWJj"} {
+			_, err := decodePageToken(token)
+			require.Error(t, err, token)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package todohandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/samber/do"
+)
+
+// TransitionTodoHandler handles TransitionTodo requests
+type transitionTodoHandler struct {
+	useCase todoapp.TransitionTodoUseCase
+}
+
+func newTransitionTodoHandler(i *do.Injector) (*transitionTodoHandler, error) {
+	transitionTodoUseCase, err := do.Invoke[todoapp.TransitionTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transition todo use case: %w", err)
+	}
+	return &transitionTodoHandler{useCase: transitionTodoUseCase}, nil
+}
+
+func (h transitionTodoHandler) TransitionTodo(
+	ctx context.Context,
+	req *connect.Request[v1.TransitionTodoRequest],
+) (*connect.Response[v1.TransitionTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.TransitionTodoRequest{
+		ID:       todoID,
+		StatusID: project.StatusID(req.Msg.StatusId),
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.TransitionTodoResponse{}), nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
 	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
 	"github.com/iktakahiro/oniongo/internal/application/todoapp"
 	"github.com/samber/do"
@@ -30,25 +31,18 @@ func (h updateTodoHandler) UpdateTodo(
 	// Parse todo ID
 	todoID, err := parseUUIDFromString(req.Msg.Id)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, connecterr.InvalidID("id")
 	}
 
-	// Extract body value if present
-	body := ""
-	if req.Msg.Body != nil {
-		body = *req.Msg.Body
-	}
-
-	// Create use case request
-	useCaseReq := todoapp.UpdateTodoRequest{
-		ID:    todoID,
-		Title: req.Msg.Title,
-		Body:  body,
+	// Create use case request from the fields in the update mask
+	useCaseReq, err := protoUpdateToDomain(todoID, req.Msg)
+	if err != nil {
+		return nil, connecterr.InvalidField("update_mask", err.Error())
 	}
 
 	// Execute use case
 	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
-		return nil, toConnectError(err)
+		return nil, connecterr.From(err)
 	}
 
 	// Return response
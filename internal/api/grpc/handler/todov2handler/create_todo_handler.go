@@ -0,0 +1,61 @@
+package todov2handler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v2 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// CreateTodoHandler handles CreateTodo requests
+type createTodoHandler struct {
+	useCase todoapp.CreateTodoUseCase
+}
+
+func newCreateTodoHandler(i *do.Injector) (*createTodoHandler, error) {
+	createTodoUseCase, err := do.Invoke[todoapp.CreateTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke create todo use case: %w", err)
+	}
+	return &createTodoHandler{useCase: createTodoUseCase}, nil
+}
+
+func (h createTodoHandler) CreateTodo(
+	ctx context.Context,
+	req *connect.Request[v2.CreateTodoRequest],
+) (*connect.Response[v2.CreateTodoResponse], error) {
+	pbTodo := req.Msg.GetTodo()
+
+	// Parse parent ID
+	parentID, err := parseOptionalUUIDFromString(pbTodo.ParentId)
+	if err != nil {
+		return nil, connecterr.InvalidID("todo.parent_id")
+	}
+
+	// Parse project ID
+	projectID, err := parseOptionalProjectIDFromString(pbTodo.ProjectId)
+	if err != nil {
+		return nil, connecterr.InvalidID("todo.project_id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.CreateTodoRequest{
+		Title:     pbTodo.GetTitle(),
+		Body:      pbTodo.GetBody(),
+		ParentID:  parentID,
+		ProjectID: projectID,
+	}
+
+	// Execute use case
+	result, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v2.CreateTodoResponse{Todo: domainTodoToProto(result)}), nil
+}
@@ -0,0 +1,44 @@
+package todov2handler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v2 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// DeleteTodoHandler handles DeleteTodo requests
+type deleteTodoHandler struct {
+	useCase todoapp.DeleteTodoUseCase
+}
+
+func newDeleteTodoHandler(i *do.Injector) (*deleteTodoHandler, error) {
+	deleteTodoUseCase, err := do.Invoke[todoapp.DeleteTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delete todo use case: %w", err)
+	}
+	return &deleteTodoHandler{useCase: deleteTodoUseCase}, nil
+}
+
+func (h deleteTodoHandler) DeleteTodo(
+	ctx context.Context,
+	req *connect.Request[v2.DeleteTodoRequest],
+) (*connect.Response[v2.DeleteTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, todoapp.DeleteTodoRequest{ID: todoID}); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v2.DeleteTodoResponse{}), nil
+}
@@ -0,0 +1,76 @@
+package todov2handler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v2 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// GetTodoHandler handles GetTodo requests
+type getTodoHandler struct {
+	useCase      todoapp.GetTodoUseCase
+	countUseCase commentapp.CountCommentsUseCase
+}
+
+func newGetTodoHandler(i *do.Injector) (*getTodoHandler, error) {
+	getTodoUseCase, err := do.Invoke[todoapp.GetTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke get todo use case: %w", err)
+	}
+	countCommentsUseCase, err := do.Invoke[commentapp.CountCommentsUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke count comments use case: %w", err)
+	}
+	return &getTodoHandler{
+		useCase:      getTodoUseCase,
+		countUseCase: countCommentsUseCase,
+	}, nil
+}
+
+func (h getTodoHandler) GetTodo(
+	ctx context.Context,
+	req *connect.Request[v2.GetTodoRequest],
+) (*connect.Response[v2.GetTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	pbTodo, err := h.getTodo(ctx, todoID)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v2.GetTodoResponse{Todo: pbTodo}), nil
+}
+
+// getTodo returns the todo with its comment count
+func (h getTodoHandler) getTodo(ctx context.Context, todoID todo.TodoID) (*v2.Todo, error) {
+	// Execute use case
+	domainTodo, err := h.useCase.Execute(ctx, todoapp.GetTodoRequest{ID: todoID})
+	if err != nil {
+		return nil, err
+	}
+
+	// Count comments
+	commentCounts, err := h.countUseCase.Execute(ctx, commentapp.CountCommentsRequest{
+		TodoIDs: []todo.TodoID{domainTodo.ID()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to protobuf
+	pbTodo := domainTodoToProto(domainTodo)
+	pbTodo.CommentCount = int32(commentCounts[domainTodo.ID()])
+	return pbTodo, nil
+}
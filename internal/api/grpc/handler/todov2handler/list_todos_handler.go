@@ -0,0 +1,99 @@
+package todov2handler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v2 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// ListTodosHandler handles ListTodos requests
+type listTodosHandler struct {
+	useCase      todoapp.GetTodosUseCase
+	countUseCase commentapp.CountCommentsUseCase
+}
+
+func newListTodosHandler(i *do.Injector) (*listTodosHandler, error) {
+	getTodosUseCase, err := do.Invoke[todoapp.GetTodosUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke get todos use case: %w", err)
+	}
+	countCommentsUseCase, err := do.Invoke[commentapp.CountCommentsUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke count comments use case: %w", err)
+	}
+	return &listTodosHandler{
+		useCase:      getTodosUseCase,
+		countUseCase: countCommentsUseCase,
+	}, nil
+}
+
+func (h listTodosHandler) ListTodos(
+	ctx context.Context,
+	req *connect.Request[v2.ListTodosRequest],
+) (*connect.Response[v2.ListTodosResponse], error) {
+	// Parse tag IDs
+	tagIDs := make([]tag.TagID, len(req.Msg.TagIds))
+	for i, tagIDStr := range req.Msg.TagIds {
+		tagID, err := tag.NewTagIDFromString(tagIDStr)
+		if err != nil {
+			return nil, connecterr.InvalidID(fmt.Sprintf("tag_ids[%d]", i))
+		}
+		tagIDs[i] = tagID
+	}
+
+	// Parse parent ID
+	parentID, err := parseOptionalUUIDFromString(req.Msg.ParentId)
+	if err != nil {
+		return nil, connecterr.InvalidID("parent_id")
+	}
+
+	// Parse project ID
+	projectID, err := parseOptionalProjectIDFromString(req.Msg.ProjectId)
+	if err != nil {
+		return nil, connecterr.InvalidID("project_id")
+	}
+
+	// Create use case request
+	useCaseReq := todoapp.GetTodosRequest{
+		TagIDs:         tagIDs,
+		TagMatch:       todo.TagMatchAny,
+		ParentID:       parentID,
+		ProjectID:      projectID,
+		ActionableOnly: req.Msg.ActionableOnly,
+	}
+	if req.Msg.MatchAllTags {
+		useCaseReq.TagMatch = todo.TagMatchAll
+	}
+
+	// Execute use case
+	domainTodos, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Count comments
+	commentCounts, err := h.countUseCase.Execute(ctx, commentapp.CountCommentsRequest{
+		TodoIDs: todoIDs(domainTodos),
+	})
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	pbTodos := make([]*v2.Todo, len(domainTodos))
+	for i, domainTodo := range domainTodos {
+		pbTodos[i] = domainTodoToProto(domainTodo)
+		pbTodos[i].CommentCount = int32(commentCounts[domainTodo.ID()])
+	}
+
+	// Return response
+	return connect.NewResponse(&v2.ListTodosResponse{Todos: pbTodos}), nil
+}
@@ -0,0 +1,47 @@
+package todov2handler
+
+import (
+	v2connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2/oniongov2connect"
+	"github.com/samber/do"
+)
+
+// todoServiceHandler combines all individual handlers to implement TodoServiceHandler
+type todoServiceHandler struct {
+	*createTodoHandler
+	*getTodoHandler
+	*listTodosHandler
+	*updateTodoHandler
+	*deleteTodoHandler
+}
+
+// NewTodoServiceHandler creates a new TodoServiceHandler of API v2 using composition
+func NewTodoServiceHandler(i *do.Injector) (v2connect.TodoServiceHandler, error) {
+	createHandler, err := newCreateTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	getHandler, err := newGetTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	listHandler, err := newListTodosHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	updateHandler, err := newUpdateTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	deleteHandler, err := newDeleteTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todoServiceHandler{
+		createTodoHandler: createHandler,
+		getTodoHandler:    getHandler,
+		listTodosHandler:  listHandler,
+		updateTodoHandler: updateHandler,
+		deleteTodoHandler: deleteHandler,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package todov2handler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// updateMaskWildcard is the update_mask path that updates all updatable fields
+const updateMaskWildcard = "*"
+
+// domainTodoToProto converts a domain Todo to a protobuf Todo
+func domainTodoToProto(domainTodo *todo.Todo) *pb.Todo {
+	pbTodo := &pb.Todo{
+		Id:         domainTodo.ID().String(),
+		Title:      domainTodo.Title(),
+		Body:       domainTodo.Body(),
+		Status:     domainStatusToProtoStatus(domainTodo.Status()),
+		CreatedAt:  timestamppb.New(domainTodo.CreatedAt()),
+		UpdatedAt:  timestamppb.New(domainTodo.UpdatedAt()),
+		TagIds:     make([]string, 0, len(domainTodo.TagIDs())),
+		BlockerIds: make([]string, 0, len(domainTodo.BlockerIDs())),
+		StatusId:   domainTodo.StatusID().String(),
+	}
+
+	if completedAt := domainTodo.CompletedAt(); completedAt != nil {
+		pbTodo.CompletedAt = timestamppb.New(*completedAt)
+	}
+
+	for _, tagID := range domainTodo.TagIDs() {
+		pbTodo.TagIds = append(pbTodo.TagIds, tagID.String())
+	}
+
+	for _, blockerID := range domainTodo.BlockerIDs() {
+		pbTodo.BlockerIds = append(pbTodo.BlockerIds, blockerID.String())
+	}
+
+	if parentID := domainTodo.ParentID(); parentID != nil {
+		parentIDStr := parentID.String()
+		pbTodo.ParentId = &parentIDStr
+	}
+
+	if projectID := domainTodo.ProjectID(); projectID != nil {
+		projectIDStr := projectID.String()
+		pbTodo.ProjectId = &projectIDStr
+	}
+
+	return pbTodo
+}
+
+// domainStatusToProtoStatus converts a domain TodoStatus to a protobuf TodoStatus
+func domainStatusToProtoStatus(domainStatus todo.TodoStatus) pb.TodoStatus {
+	switch domainStatus {
+	case todo.TodoStatusNotStarted:
+		return pb.TodoStatus_TODO_STATUS_NOT_STARTED
+	case todo.TodoStatusInProgress:
+		return pb.TodoStatus_TODO_STATUS_IN_PROGRESS
+	case todo.TodoStatusCompleted:
+		return pb.TodoStatus_TODO_STATUS_COMPLETED
+	case todo.TodoStatusCancelled:
+		return pb.TodoStatus_TODO_STATUS_CANCELLED
+	case todo.TodoStatusOnHold:
+		return pb.TodoStatus_TODO_STATUS_ON_HOLD
+	default:
+		return pb.TodoStatus_TODO_STATUS_UNSPECIFIED
+	}
+}
+
+// protoUpdateToDomain converts the fields of a protobuf Todo named by the update mask
+// to a use case request. Fields outside the mask are left unset, so they are not changed.
+func protoUpdateToDomain(
+	id todo.TodoID,
+	pbTodo *pb.Todo,
+	mask *fieldmaskpb.FieldMask,
+) (todoapp.UpdateTodoRequest, error) {
+	req := todoapp.UpdateTodoRequest{ID: id}
+	title, body := pbTodo.GetTitle(), pbTodo.GetBody()
+	if len(mask.GetPaths()) == 0 {
+		return req, errors.New("must name at least one field")
+	}
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "title":
+			req.Title = &title
+		case "body":
+			req.Body = &body
+		case updateMaskWildcard:
+			req.Title = &title
+			req.Body = &body
+		default:
+			return req, fmt.Errorf("field %q cannot be updated", path)
+		}
+	}
+	return req, nil
+}
+
+// todoIDs returns the IDs of the todos
+func todoIDs(todos []*todo.Todo) []todo.TodoID {
+	ids := make([]todo.TodoID, len(todos))
+	for i, t := range todos {
+		ids[i] = t.ID()
+	}
+	return ids
+}
+
+// parseUUIDFromString parses a UUID string and returns a TodoID
+func parseUUIDFromString(idStr string) (todo.TodoID, error) {
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return todo.TodoID{}, err
+	}
+	return todo.TodoID(id), nil
+}
+
+// parseOptionalUUIDFromString parses an optional UUID string and returns an optional TodoID
+func parseOptionalUUIDFromString(idStr *string) (*todo.TodoID, error) {
+	if idStr == nil {
+		return nil, nil
+	}
+	id, err := parseUUIDFromString(*idStr)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// parseOptionalProjectIDFromString parses an optional UUID string and returns an optional ProjectID
+func parseOptionalProjectIDFromString(idStr *string) (*project.ProjectID, error) {
+	if idStr == nil {
+		return nil, nil
+	}
+	id, err := project.NewProjectIDFromString(*idStr)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
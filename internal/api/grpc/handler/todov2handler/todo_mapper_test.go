@@ -0,0 +1,136 @@
+package todov2handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestDomainTodoToProto(t *testing.T) {
+	t.Run("keeps the sub-second precision of the timestamps", func(t *testing.T) {
+		// Given
+		createdAt := time.Date(2025, 6, 1, 9, 30, 0, 123456789, time.UTC)
+		updatedAt := createdAt.Add(1500 * time.Millisecond)
+		completedAt := updatedAt.Add(time.Microsecond)
+		domainTodo := todo.ReconstructTodoWithStatus(
+			uuid.New(),
+			"Test Title",
+			"Test Body",
+			todo.TodoStatusCompleted,
+			createdAt,
+			updatedAt,
+			&completedAt,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+
+		// When
+		pbTodo := domainTodoToProto(domainTodo)
+
+		// Then
+		assert.True(t, createdAt.Equal(pbTodo.CreatedAt.AsTime()))
+		assert.True(t, updatedAt.Equal(pbTodo.UpdatedAt.AsTime()))
+		require.NotNil(t, pbTodo.CompletedAt)
+		assert.True(t, completedAt.Equal(pbTodo.CompletedAt.AsTime()))
+		assert.Equal(t, pb.TodoStatus_TODO_STATUS_COMPLETED, pbTodo.Status)
+	})
+
+	t.Run("leaves the unset fields of an open root todo unset", func(t *testing.T) {
+		// Given
+		domainTodo := todo.ReconstructTodo(
+			uuid.New(),
+			"Test Title",
+			"",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+		)
+
+		// When
+		pbTodo := domainTodoToProto(domainTodo)
+
+		// Then
+		assert.Nil(t, pbTodo.CompletedAt)
+		assert.Nil(t, pbTodo.ParentId)
+		assert.Nil(t, pbTodo.ProjectId)
+		assert.Empty(t, pbTodo.Body)
+	})
+}
+
+func TestProtoUpdateToDomain(t *testing.T) {
+	todoID := todo.NewTodoID()
+	pbTodo := &pb.Todo{Title: "New Title", Body: "New Body"}
+
+	tests := []struct {
+		name      string
+		todo      *pb.Todo
+		paths     []string
+		wantTitle *string
+		wantBody  *string
+		wantErr   bool
+	}{
+		{
+			name:      "updates only the title",
+			todo:      pbTodo,
+			paths:     []string{"title"},
+			wantTitle: &pbTodo.Title,
+		},
+		{
+			name:     "updates only the body",
+			todo:     pbTodo,
+			paths:    []string{"body"},
+			wantBody: &pbTodo.Body,
+		},
+		{
+			name:      "updates all fields with the wildcard",
+			todo:      pbTodo,
+			paths:     []string{"*"},
+			wantTitle: &pbTodo.Title,
+			wantBody:  &pbTodo.Body,
+		},
+		{
+			name:     "clears a field in the mask without a value",
+			todo:     nil,
+			paths:    []string{"body"},
+			wantBody: new(string),
+		},
+		{
+			name:    "rejects an empty mask",
+			todo:    pbTodo,
+			wantErr: true,
+		},
+		{
+			name:    "rejects a field that cannot be updated",
+			todo:    pbTodo,
+			paths:   []string{"title", "status"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			req, err := protoUpdateToDomain(todoID, tt.todo, &fieldmaskpb.FieldMask{Paths: tt.paths})
+
+			// Then
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, todoID, req.ID)
+			assert.Equal(t, tt.wantTitle, req.Title)
+			assert.Equal(t, tt.wantBody, req.Body)
+		})
+	}
+}
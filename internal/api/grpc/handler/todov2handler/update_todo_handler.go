@@ -0,0 +1,64 @@
+package todov2handler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v2 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v2"
+	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/samber/do"
+)
+
+// UpdateTodoHandler handles UpdateTodo requests
+type updateTodoHandler struct {
+	useCase    todoapp.UpdateTodoUseCase
+	getHandler *getTodoHandler
+}
+
+func newUpdateTodoHandler(i *do.Injector) (*updateTodoHandler, error) {
+	updateTodoUseCase, err := do.Invoke[todoapp.UpdateTodoUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke update todo use case: %w", err)
+	}
+	getHandler, err := newGetTodoHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	return &updateTodoHandler{
+		useCase:    updateTodoUseCase,
+		getHandler: getHandler,
+	}, nil
+}
+
+func (h updateTodoHandler) UpdateTodo(
+	ctx context.Context,
+	req *connect.Request[v2.UpdateTodoRequest],
+) (*connect.Response[v2.UpdateTodoResponse], error) {
+	// Parse todo ID
+	todoID, err := parseUUIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Create use case request from the fields in the update mask
+	useCaseReq, err := protoUpdateToDomain(todoID, req.Msg.GetTodo(), req.Msg.GetUpdateMask())
+	if err != nil {
+		return nil, connecterr.InvalidField("update_mask", err.Error())
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Read the updated todo
+	pbTodo, err := h.getHandler.getTodo(ctx, todoID)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v2.UpdateTodoResponse{Todo: pbTodo}), nil
+}
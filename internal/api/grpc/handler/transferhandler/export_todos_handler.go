@@ -0,0 +1,104 @@
+package transferhandler
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/transferapp"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// exportChunkSize is the size of the content carried by each response message.
+const exportChunkSize = 64 * 1024
+
+// ExportTodosHandler handles ExportTodos requests
+type exportTodosHandler struct {
+	useCase transferapp.ExportTodosUseCase
+}
+
+func newExportTodosHandler(i *do.Injector) (*exportTodosHandler, error) {
+	exportTodosUseCase, err := do.Invoke[transferapp.ExportTodosUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke export todos use case: %w", err)
+	}
+	return &exportTodosHandler{useCase: exportTodosUseCase}, nil
+}
+
+func (h exportTodosHandler) ExportTodos(
+	ctx context.Context,
+	req *connect.Request[v1.ExportTodosRequest],
+	stream *connect.ServerStream[v1.ExportTodosResponse],
+) error {
+	// Parse tag IDs
+	tagIDs := make([]tag.TagID, len(req.Msg.TagIds))
+	for i, tagIDStr := range req.Msg.TagIds {
+		tagID, err := tag.NewTagIDFromString(tagIDStr)
+		if err != nil {
+			return connecterr.InvalidID(fmt.Sprintf("tag_ids[%d]", i))
+		}
+		tagIDs[i] = tagID
+	}
+
+	// Parse project ID
+	var projectID *project.ProjectID
+	if req.Msg.ProjectId != nil {
+		id, err := project.NewProjectIDFromString(*req.Msg.ProjectId)
+		if err != nil {
+			return connecterr.InvalidID("project_id")
+		}
+		projectID = &id
+	}
+
+	// Create use case request
+	output := &chunkWriter{stream: stream}
+	buffered := bufio.NewWriterSize(output, exportChunkSize)
+	useCaseReq := transferapp.ExportTodosRequest{
+		Format: protoFormatToApp(req.Msg.Format),
+		Filter: todo.TodoFilter{
+			TagIDs:     tagIDs,
+			TagMatch:   protoTagMatchToDomain(req.Msg.TagMatch),
+			ProjectID:  projectID,
+			Actionable: req.Msg.ActionableOnly,
+		},
+		Output: buffered,
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, useCaseReq); err != nil {
+		// Errors of the stream itself take precedence over the write errors they cause
+		if output.err != nil {
+			return output.err
+		}
+		return connecterr.From(err)
+	}
+
+	// Send the rest of the content
+	if err := buffered.Flush(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// chunkWriter sends everything written to it as the chunks of an export stream.
+type chunkWriter struct {
+	stream *connect.ServerStream[v1.ExportTodosResponse]
+	err    error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if err := w.stream.Send(&v1.ExportTodosResponse{Chunk: p}); err != nil {
+		w.err = err
+		return 0, err
+	}
+	return len(p), nil
+}
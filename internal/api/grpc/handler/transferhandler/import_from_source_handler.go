@@ -0,0 +1,70 @@
+package transferhandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/transferapp"
+	"github.com/samber/do"
+)
+
+// ImportFromSourceHandler handles ImportFromSource requests
+type importFromSourceHandler struct {
+	useCase transferapp.ImportFromSourceUseCase
+}
+
+func newImportFromSourceHandler(i *do.Injector) (*importFromSourceHandler, error) {
+	importFromSourceUseCase, err := do.Invoke[transferapp.ImportFromSourceUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke import from source use case: %w", err)
+	}
+	return &importFromSourceHandler{useCase: importFromSourceUseCase}, nil
+}
+
+func (h importFromSourceHandler) ImportFromSource(
+	ctx context.Context,
+	stream *connect.ClientStream[v1.ImportFromSourceRequest],
+) (*connect.Response[v1.ImportFromSourceResponse], error) {
+	// The first message carries the metadata
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("metadata is required"))
+	}
+	metadata := stream.Msg().GetMetadata()
+	if metadata == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("the first message must carry the metadata"))
+	}
+
+	// Create use case request
+	content := &chunkReader[v1.ImportFromSourceRequest]{
+		stream: stream,
+		chunkOf: func(msg *v1.ImportFromSourceRequest) ([]byte, bool) {
+			_, ok := msg.Payload.(*v1.ImportFromSourceRequest_Chunk)
+			return msg.GetChunk(), ok
+		},
+	}
+	useCaseReq := transferapp.ImportFromSourceRequest{
+		Source:  protoSourceToDomain(metadata.Source),
+		Content: content,
+		DryRun:  metadata.DryRun,
+	}
+
+	// Execute use case
+	result, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		// Errors of the stream itself take precedence over the read errors they cause
+		if content.err != nil {
+			return nil, content.err
+		}
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf and return response
+	return connect.NewResponse(appSourceImportReportToProto(result)), nil
+}
@@ -0,0 +1,104 @@
+package transferhandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/transferapp"
+	"github.com/samber/do"
+)
+
+// ImportTodosHandler handles ImportTodos requests
+type importTodosHandler struct {
+	useCase transferapp.ImportTodosUseCase
+}
+
+func newImportTodosHandler(i *do.Injector) (*importTodosHandler, error) {
+	importTodosUseCase, err := do.Invoke[transferapp.ImportTodosUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke import todos use case: %w", err)
+	}
+	return &importTodosHandler{useCase: importTodosUseCase}, nil
+}
+
+func (h importTodosHandler) ImportTodos(
+	ctx context.Context,
+	stream *connect.ClientStream[v1.ImportTodosRequest],
+) (*connect.Response[v1.ImportTodosResponse], error) {
+	// The first message carries the metadata
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("metadata is required"))
+	}
+	metadata := stream.Msg().GetMetadata()
+	if metadata == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("the first message must carry the metadata"))
+	}
+
+	// Create use case request
+	content := &chunkReader[v1.ImportTodosRequest]{
+		stream: stream,
+		chunkOf: func(msg *v1.ImportTodosRequest) ([]byte, bool) {
+			_, ok := msg.Payload.(*v1.ImportTodosRequest_Chunk)
+			return msg.GetChunk(), ok
+		},
+	}
+	useCaseReq := transferapp.ImportTodosRequest{
+		Format:  protoFormatToApp(metadata.Format),
+		Content: content,
+		DryRun:  metadata.DryRun,
+	}
+
+	// Execute use case
+	result, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		// Errors of the stream itself take precedence over the read errors they cause
+		if content.err != nil {
+			return nil, content.err
+		}
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf and return response
+	return connect.NewResponse(appImportReportToProto(result)), nil
+}
+
+// chunkReader reads the content carried by the chunks of an import stream.
+type chunkReader[T any] struct {
+	stream *connect.ClientStream[T]
+	// chunkOf returns the chunk carried by a message, or false if the message carries none.
+	chunkOf func(msg *T) ([]byte, bool)
+	chunk   []byte
+	err     error
+}
+
+func (r *chunkReader[T]) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if !r.stream.Receive() {
+			if err := r.stream.Err(); err != nil {
+				r.err = err
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		chunk, ok := r.chunkOf(r.stream.Msg())
+		if !ok {
+			r.err = connect.NewError(connect.CodeInvalidArgument, errors.New("only the first message may carry the metadata"))
+			return 0, r.err
+		}
+		r.chunk = chunk
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
@@ -0,0 +1,36 @@
+package transferhandler
+
+import (
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/samber/do"
+)
+
+// transferServiceHandler combines all individual handlers to implement TransferServiceHandler
+type transferServiceHandler struct {
+	*exportTodosHandler
+	*importTodosHandler
+	*importFromSourceHandler
+}
+
+// NewTransferServiceHandler creates a new TransferServiceHandler using composition
+func NewTransferServiceHandler(i *do.Injector) (v1connect.TransferServiceHandler, error) {
+	exportHandler, err := newExportTodosHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	importHandler, err := newImportTodosHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	importFromSourceHandler, err := newImportFromSourceHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transferServiceHandler{
+		exportTodosHandler:      exportHandler,
+		importTodosHandler:      importHandler,
+		importFromSourceHandler: importFromSourceHandler,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package transferhandler
+
+import (
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/transferapp"
+	"github.com/iktakahiro/oniongo/internal/domain/externalref"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// protoFormatToApp converts a protobuf TodoFormat to a transferapp Format.
+// Unknown formats become an empty Format, which the use cases reject.
+func protoFormatToApp(format pb.TodoFormat) transferapp.Format {
+	switch format {
+	case pb.TodoFormat_TODO_FORMAT_JSONL:
+		return transferapp.FormatJSONL
+	case pb.TodoFormat_TODO_FORMAT_CSV:
+		return transferapp.FormatCSV
+	case pb.TodoFormat_TODO_FORMAT_MARKDOWN:
+		return transferapp.FormatMarkdown
+	case pb.TodoFormat_TODO_FORMAT_TODOTXT:
+		return transferapp.FormatTodoTxt
+	default:
+		return ""
+	}
+}
+
+// protoTagMatchToDomain converts a protobuf TagMatchMode to a domain TagMatch
+func protoTagMatchToDomain(mode pb.TagMatchMode) todo.TagMatch {
+	if mode == pb.TagMatchMode_TAG_MATCH_MODE_ALL {
+		return todo.TagMatchAll
+	}
+	return todo.TagMatchAny
+}
+
+// appImportReportToProto converts a transferapp ImportReport to a protobuf ImportTodosResponse
+func appImportReportToProto(report *transferapp.ImportReport) *pb.ImportTodosResponse {
+	duplicates := make([]*pb.ImportDuplicate, len(report.Duplicates))
+	for i, d := range report.Duplicates {
+		duplicates[i] = &pb.ImportDuplicate{Line: int32(d.Line), Id: d.ID.String()}
+	}
+	lineErrors := make([]*pb.ImportLineError, len(report.Errors))
+	for i, e := range report.Errors {
+		lineErrors[i] = &pb.ImportLineError{Line: int32(e.Line), Message: e.Message}
+	}
+	return &pb.ImportTodosResponse{
+		Imported:   int32(report.Imported),
+		Duplicates: duplicates,
+		Errors:     lineErrors,
+		DryRun:     report.DryRun,
+	}
+}
+
+// protoSourceToDomain converts a protobuf ImportSource to a domain Source.
+// Unknown sources become an empty Source, which the use case rejects.
+func protoSourceToDomain(source pb.ImportSource) externalref.Source {
+	switch source {
+	case pb.ImportSource_IMPORT_SOURCE_TODOIST:
+		return externalref.SourceTodoist
+	case pb.ImportSource_IMPORT_SOURCE_TRELLO:
+		return externalref.SourceTrello
+	case pb.ImportSource_IMPORT_SOURCE_GITHUB:
+		return externalref.SourceGitHub
+	default:
+		return ""
+	}
+}
+
+// domainKindToProto converts a domain Kind to a protobuf ImportItemKind
+func domainKindToProto(kind externalref.Kind) pb.ImportItemKind {
+	switch kind {
+	case externalref.KindProject:
+		return pb.ImportItemKind_IMPORT_ITEM_KIND_PROJECT
+	case externalref.KindTag:
+		return pb.ImportItemKind_IMPORT_ITEM_KIND_TAG
+	case externalref.KindTodo:
+		return pb.ImportItemKind_IMPORT_ITEM_KIND_TODO
+	default:
+		return pb.ImportItemKind_IMPORT_ITEM_KIND_UNSPECIFIED
+	}
+}
+
+// appSourceImportReportToProto converts a transferapp SourceImportReport to a protobuf ImportFromSourceResponse
+func appSourceImportReportToProto(report *transferapp.SourceImportReport) *pb.ImportFromSourceResponse {
+	itemErrors := make([]*pb.ImportItemError, len(report.Errors))
+	for i, e := range report.Errors {
+		itemErrors[i] = &pb.ImportItemError{
+			Kind:       domainKindToProto(e.Kind),
+			ExternalId: e.ExternalID,
+			Message:    e.Message,
+		}
+	}
+	return &pb.ImportFromSourceResponse{
+		Projects: int32(report.Projects),
+		Tags:     int32(report.Tags),
+		Todos:    int32(report.Todos),
+		Skipped:  int32(report.Skipped),
+		Errors:   itemErrors,
+		DryRun:   report.DryRun,
+	}
+}
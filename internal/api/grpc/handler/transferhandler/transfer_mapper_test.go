@@ -0,0 +1,85 @@
+package transferhandler
+
+import (
+	"testing"
+
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/transferapp"
+	"github.com/iktakahiro/oniongo/internal/domain/externalref"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoFormatToApp(t *testing.T) {
+	tests := []struct {
+		input    pb.TodoFormat
+		expected transferapp.Format
+	}{
+		{input: pb.TodoFormat_TODO_FORMAT_JSONL, expected: transferapp.FormatJSONL},
+		{input: pb.TodoFormat_TODO_FORMAT_CSV, expected: transferapp.FormatCSV},
+		{input: pb.TodoFormat_TODO_FORMAT_MARKDOWN, expected: transferapp.FormatMarkdown},
+		{input: pb.TodoFormat_TODO_FORMAT_TODOTXT, expected: transferapp.FormatTodoTxt},
+		{input: pb.TodoFormat_TODO_FORMAT_UNSPECIFIED, expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, protoFormatToApp(tt.input))
+		})
+	}
+}
+
+func TestAppImportReportToProto(t *testing.T) {
+	// Given
+	id := todo.NewTodoID()
+	report := &transferapp.ImportReport{
+		Imported:   3,
+		Duplicates: []transferapp.Duplicate{{Line: 2, ID: id}},
+		Errors:     []transferapp.LineError{{Line: 5, Message: "title: title is required"}},
+		DryRun:     true,
+	}
+
+	// When
+	result := appImportReportToProto(report)
+
+	// Then
+	assert.Equal(t, int32(3), result.Imported)
+	require.Len(t, result.Duplicates, 1)
+	assert.Equal(t, int32(2), result.Duplicates[0].Line)
+	assert.Equal(t, id.String(), result.Duplicates[0].Id)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, int32(5), result.Errors[0].Line)
+	assert.Equal(t, "title: title is required", result.Errors[0].Message)
+	assert.True(t, result.DryRun)
+}
+
+func TestProtoSourceToDomain(t *testing.T) {
+	assert.Equal(t, externalref.SourceTodoist, protoSourceToDomain(pb.ImportSource_IMPORT_SOURCE_TODOIST))
+	assert.Equal(t, externalref.SourceTrello, protoSourceToDomain(pb.ImportSource_IMPORT_SOURCE_TRELLO))
+	assert.Equal(t, externalref.SourceGitHub, protoSourceToDomain(pb.ImportSource_IMPORT_SOURCE_GITHUB))
+	assert.Equal(t, externalref.Source(""), protoSourceToDomain(pb.ImportSource_IMPORT_SOURCE_UNSPECIFIED))
+}
+
+func TestAppSourceImportReportToProto(t *testing.T) {
+	// Given
+	report := &transferapp.SourceImportReport{
+		Projects: 1,
+		Tags:     2,
+		Todos:    3,
+		Skipped:  4,
+		Errors:   []transferapp.ItemError{{Kind: externalref.KindTodo, ExternalID: "i3", Message: "title: title is required"}},
+	}
+
+	// When
+	result := appSourceImportReportToProto(report)
+
+	// Then
+	assert.Equal(t, int32(1), result.Projects)
+	assert.Equal(t, int32(2), result.Tags)
+	assert.Equal(t, int32(3), result.Todos)
+	assert.Equal(t, int32(4), result.Skipped)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, pb.ImportItemKind_IMPORT_ITEM_KIND_TODO, result.Errors[0].Kind)
+	assert.Equal(t, "i3", result.Errors[0].ExternalId)
+	assert.False(t, result.DryRun)
+}
@@ -0,0 +1,53 @@
+package webhookhandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/webhookapp"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+// CreateWebhookHandler handles CreateWebhook requests
+type createWebhookHandler struct {
+	useCase webhookapp.CreateWebhookUseCase
+}
+
+func newCreateWebhookHandler(i *do.Injector) (*createWebhookHandler, error) {
+	createWebhookUseCase, err := do.Invoke[webhookapp.CreateWebhookUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke create webhook use case: %w", err)
+	}
+	return &createWebhookHandler{useCase: createWebhookUseCase}, nil
+}
+
+func (h createWebhookHandler) CreateWebhook(
+	ctx context.Context,
+	req *connect.Request[v1.CreateWebhookRequest],
+) (*connect.Response[v1.CreateWebhookResponse], error) {
+	// Create use case request
+	events := make([]webhook.EventType, len(req.Msg.Events))
+	for i, event := range req.Msg.Events {
+		events[i] = webhook.EventType(event)
+	}
+	useCaseReq := webhookapp.CreateWebhookRequest{
+		URL:    req.Msg.Url,
+		Events: events,
+	}
+
+	// Execute use case
+	created, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.CreateWebhookResponse{
+		Webhook: domainWebhookToProto(created),
+		Secret:  created.Secret(),
+	}), nil
+}
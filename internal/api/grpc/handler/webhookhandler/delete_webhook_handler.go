@@ -0,0 +1,45 @@
+package webhookhandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/webhookapp"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+// DeleteWebhookHandler handles DeleteWebhook requests
+type deleteWebhookHandler struct {
+	useCase webhookapp.DeleteWebhookUseCase
+}
+
+func newDeleteWebhookHandler(i *do.Injector) (*deleteWebhookHandler, error) {
+	deleteWebhookUseCase, err := do.Invoke[webhookapp.DeleteWebhookUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delete webhook use case: %w", err)
+	}
+	return &deleteWebhookHandler{useCase: deleteWebhookUseCase}, nil
+}
+
+func (h deleteWebhookHandler) DeleteWebhook(
+	ctx context.Context,
+	req *connect.Request[v1.DeleteWebhookRequest],
+) (*connect.Response[v1.DeleteWebhookResponse], error) {
+	// Parse webhook ID
+	webhookID, err := webhook.NewWebhookIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Execute use case
+	if err := h.useCase.Execute(ctx, webhookapp.DeleteWebhookRequest{ID: webhookID}); err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.DeleteWebhookResponse{}), nil
+}
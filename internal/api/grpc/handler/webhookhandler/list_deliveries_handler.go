@@ -0,0 +1,71 @@
+package webhookhandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/webhookapp"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+// ListDeliveriesHandler handles ListDeliveries requests
+type listDeliveriesHandler struct {
+	useCase webhookapp.ListDeliveriesUseCase
+}
+
+func newListDeliveriesHandler(i *do.Injector) (*listDeliveriesHandler, error) {
+	listDeliveriesUseCase, err := do.Invoke[webhookapp.ListDeliveriesUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke list deliveries use case: %w", err)
+	}
+	return &listDeliveriesHandler{useCase: listDeliveriesUseCase}, nil
+}
+
+func (h listDeliveriesHandler) ListDeliveries(
+	ctx context.Context,
+	req *connect.Request[v1.ListDeliveriesRequest],
+) (*connect.Response[v1.ListDeliveriesResponse], error) {
+	// Parse webhook ID
+	webhookID, err := webhook.NewWebhookIDFromString(req.Msg.WebhookId)
+	if err != nil {
+		return nil, connecterr.InvalidID("webhook_id")
+	}
+
+	// Parse page token
+	offset, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connecterr.InvalidField("page_token", err.Error())
+	}
+
+	// Create use case request
+	useCaseReq := webhookapp.ListDeliveriesRequest{
+		WebhookID: webhookID,
+		PageSize:  int(req.Msg.PageSize),
+		Offset:    offset,
+	}
+
+	// Execute use case
+	page, err := h.useCase.Execute(ctx, useCaseReq)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	pbDeliveries := make([]*v1.WebhookDelivery, len(page.Deliveries))
+	for i, delivery := range page.Deliveries {
+		pbDeliveries[i] = domainDeliveryToProto(delivery)
+	}
+	res := &v1.ListDeliveriesResponse{
+		Deliveries: pbDeliveries,
+	}
+	if page.NextOffset != nil {
+		res.NextPageToken = encodePageToken(*page.NextOffset)
+	}
+
+	// Return response
+	return connect.NewResponse(res), nil
+}
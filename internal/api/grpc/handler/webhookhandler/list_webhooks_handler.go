@@ -0,0 +1,47 @@
+package webhookhandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/webhookapp"
+	"github.com/samber/do"
+)
+
+// ListWebhooksHandler handles ListWebhooks requests
+type listWebhooksHandler struct {
+	useCase webhookapp.ListWebhooksUseCase
+}
+
+func newListWebhooksHandler(i *do.Injector) (*listWebhooksHandler, error) {
+	listWebhooksUseCase, err := do.Invoke[webhookapp.ListWebhooksUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke list webhooks use case: %w", err)
+	}
+	return &listWebhooksHandler{useCase: listWebhooksUseCase}, nil
+}
+
+func (h listWebhooksHandler) ListWebhooks(
+	ctx context.Context,
+	req *connect.Request[v1.ListWebhooksRequest],
+) (*connect.Response[v1.ListWebhooksResponse], error) {
+	// Execute use case
+	webhooks, err := h.useCase.Execute(ctx)
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Convert to protobuf
+	pbWebhooks := make([]*v1.Webhook, len(webhooks))
+	for i, domainWebhook := range webhooks {
+		pbWebhooks[i] = domainWebhookToProto(domainWebhook)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.ListWebhooksResponse{
+		Webhooks: pbWebhooks,
+	}), nil
+}
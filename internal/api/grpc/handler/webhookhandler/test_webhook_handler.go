@@ -0,0 +1,48 @@
+package webhookhandler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/connecterr"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/application/webhookapp"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+// TestWebhookHandler handles TestWebhook requests
+type testWebhookHandler struct {
+	useCase webhookapp.TestWebhookUseCase
+}
+
+func newTestWebhookHandler(i *do.Injector) (*testWebhookHandler, error) {
+	testWebhookUseCase, err := do.Invoke[webhookapp.TestWebhookUseCase](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke test webhook use case: %w", err)
+	}
+	return &testWebhookHandler{useCase: testWebhookUseCase}, nil
+}
+
+func (h testWebhookHandler) TestWebhook(
+	ctx context.Context,
+	req *connect.Request[v1.TestWebhookRequest],
+) (*connect.Response[v1.TestWebhookResponse], error) {
+	// Parse webhook ID
+	webhookID, err := webhook.NewWebhookIDFromString(req.Msg.Id)
+	if err != nil {
+		return nil, connecterr.InvalidID("id")
+	}
+
+	// Execute use case
+	delivery, err := h.useCase.Execute(ctx, webhookapp.TestWebhookRequest{ID: webhookID})
+	if err != nil {
+		return nil, connecterr.From(err)
+	}
+
+	// Return response
+	return connect.NewResponse(&v1.TestWebhookResponse{
+		Delivery: domainDeliveryToProto(delivery),
+	}), nil
+}
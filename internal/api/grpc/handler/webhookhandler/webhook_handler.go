@@ -0,0 +1,47 @@
+package webhookhandler
+
+import (
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/samber/do"
+)
+
+// webhookServiceHandler combines all individual handlers to implement WebhookServiceHandler
+type webhookServiceHandler struct {
+	*createWebhookHandler
+	*listWebhooksHandler
+	*deleteWebhookHandler
+	*testWebhookHandler
+	*listDeliveriesHandler
+}
+
+// NewWebhookServiceHandler creates a new WebhookServiceHandler using composition
+func NewWebhookServiceHandler(i *do.Injector) (v1connect.WebhookServiceHandler, error) {
+	createHandler, err := newCreateWebhookHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	listHandler, err := newListWebhooksHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	deleteHandler, err := newDeleteWebhookHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	testHandler, err := newTestWebhookHandler(i)
+	if err != nil {
+		return nil, err
+	}
+	listDeliveriesHandler, err := newListDeliveriesHandler(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookServiceHandler{
+		createWebhookHandler:  createHandler,
+		listWebhooksHandler:   listHandler,
+		deleteWebhookHandler:  deleteHandler,
+		testWebhookHandler:    testHandler,
+		listDeliveriesHandler: listDeliveriesHandler,
+	}, nil
+}
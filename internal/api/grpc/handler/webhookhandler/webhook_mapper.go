@@ -0,0 +1,80 @@
+package webhookhandler
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+)
+
+// domainWebhookToProto converts a domain Webhook to a protobuf Webhook. The secret is left out.
+func domainWebhookToProto(domainWebhook *webhook.Webhook) *pb.Webhook {
+	events := make([]string, len(domainWebhook.Events()))
+	for i, event := range domainWebhook.Events() {
+		events[i] = event.String()
+	}
+	return &pb.Webhook{
+		Id:                  domainWebhook.ID().String(),
+		Url:                 domainWebhook.URL(),
+		Events:              events,
+		Enabled:             domainWebhook.Enabled(),
+		ConsecutiveFailures: int32(domainWebhook.ConsecutiveFailures()),
+		CreatedAt:           domainWebhook.CreatedAt().Unix(),
+		UpdatedAt:           domainWebhook.UpdatedAt().Unix(),
+	}
+}
+
+// domainDeliveryToProto converts a domain Delivery to a protobuf WebhookDelivery
+func domainDeliveryToProto(delivery *webhook.Delivery) *pb.WebhookDelivery {
+	return &pb.WebhookDelivery{
+		Id:             delivery.ID().String(),
+		WebhookId:      delivery.WebhookID().String(),
+		EventId:        delivery.EventID().String(),
+		EventType:      delivery.EventType().String(),
+		Payload:        string(delivery.Payload()),
+		Status:         domainDeliveryStatusToProto(delivery.Status()),
+		Attempts:       int32(delivery.Attempts()),
+		ResponseStatus: int32(delivery.ResponseStatus()),
+		Error:          delivery.LastError(),
+		NextAttemptAt:  delivery.NextAttemptAt().Unix(),
+		CreatedAt:      delivery.CreatedAt().Unix(),
+		UpdatedAt:      delivery.UpdatedAt().Unix(),
+	}
+}
+
+// domainDeliveryStatusToProto converts a domain DeliveryStatus to a protobuf WebhookDeliveryStatus
+func domainDeliveryStatusToProto(status webhook.DeliveryStatus) pb.WebhookDeliveryStatus {
+	switch status {
+	case webhook.DeliveryStatusPending:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case webhook.DeliveryStatusSucceeded:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case webhook.DeliveryStatusFailed:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
+// encodePageToken encodes the offset of the next page as an opaque page token
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodePageToken decodes a page token created by encodePageToken. An empty token is the first page.
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+	offset, err := strconv.Atoi(string(b))
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid page token")
+	}
+	return offset, nil
+}
@@ -0,0 +1,100 @@
+package webhookhandler
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainWebhookToProto(t *testing.T) {
+	// Given
+	id := uuid.New()
+	createdAt := time.Now().UTC()
+	updatedAt := createdAt.Add(time.Minute)
+	domainWebhook := webhook.ReconstructWebhook(
+		id,
+		"https://example.com/hooks",
+		"whsec_secret",
+		[]webhook.EventType{webhook.EventTodoCreated, webhook.EventTodoDeleted},
+		false,
+		10,
+		createdAt,
+		updatedAt,
+	)
+
+	// When
+	result := domainWebhookToProto(domainWebhook)
+
+	// Then
+	assert.Equal(t, id.String(), result.Id)
+	assert.Equal(t, "https://example.com/hooks", result.Url)
+	assert.Equal(t, []string{"todo.created", "todo.deleted"}, result.Events)
+	assert.False(t, result.Enabled)
+	assert.Equal(t, int32(10), result.ConsecutiveFailures)
+	assert.Equal(t, createdAt.Unix(), result.CreatedAt)
+	assert.Equal(t, updatedAt.Unix(), result.UpdatedAt)
+}
+
+func TestDomainDeliveryToProto(t *testing.T) {
+	// Given
+	target, err := webhook.NewWebhook(idgen.UUIDv7(), time.Now(), "https://example.com/hooks", []webhook.EventType{webhook.EventTodoCreated})
+	require.NoError(t, err)
+	event, err := webhook.NewPingEvent(idgen.UUIDv7(), time.Now(), target.ID())
+	require.NoError(t, err)
+	delivery := webhook.NewDelivery(idgen.UUIDv7(), time.Now(), target, event)
+	delivery.RecordAttempt(time.Now(), http.StatusBadGateway, nil)
+
+	// When
+	result := domainDeliveryToProto(delivery)
+
+	// Then
+	assert.Equal(t, delivery.ID().String(), result.Id)
+	assert.Equal(t, target.ID().String(), result.WebhookId)
+	assert.Equal(t, event.ID().String(), result.EventId)
+	assert.Equal(t, "ping", result.EventType)
+	assert.JSONEq(t, string(event.Payload()), result.Payload)
+	assert.Equal(t, pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED, result.Status)
+	assert.Equal(t, int32(1), result.Attempts)
+	assert.Equal(t, int32(http.StatusBadGateway), result.ResponseStatus)
+	assert.Equal(t, "unexpected response status", result.Error)
+}
+
+func TestDomainDeliveryToProto_Error(t *testing.T) {
+	// Given
+	target, err := webhook.NewWebhook(idgen.UUIDv7(), time.Now(), "https://example.com/hooks", []webhook.EventType{webhook.EventTodoCreated})
+	require.NoError(t, err)
+	event, err := webhook.NewPingEvent(idgen.UUIDv7(), time.Now(), target.ID())
+	require.NoError(t, err)
+	delivery := webhook.NewDelivery(idgen.UUIDv7(), time.Now(), target, event)
+	delivery.RecordAttempt(time.Now(), 0, errors.New("connection refused"))
+
+	// When
+	result := domainDeliveryToProto(delivery)
+
+	// Then
+	assert.Zero(t, result.ResponseStatus)
+	assert.Equal(t, "connection refused", result.Error)
+}
+
+func TestPageToken(t *testing.T) {
+	// Given
+	token := encodePageToken(42)
+
+	// When
+	offset, err := decodePageToken(token)
+
+	// Then
+	require.NoError(t, err)
+	assert.Equal(t, 42, offset)
+
+	_, err = decodePageToken("not a token")
+	assert.Error(t, err)
+}
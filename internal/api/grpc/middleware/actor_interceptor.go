@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+)
+
+// ActorHeader is the request header that names the actor of the mutations made by a request.
+const ActorHeader = "X-Actor"
+
+// maxActorLength bounds the actor recorded in the history.
+const maxActorLength = 100
+
+// actorInterceptor puts the actor named by ActorHeader into the context of every request,
+// so that the history records who made each mutation.
+type actorInterceptor struct{}
+
+func NewActorInterceptor() connect.Interceptor {
+	return actorInterceptor{}
+}
+
+func (actorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return next(withActor(ctx, req.Header().Get(ActorHeader)), req)
+	}
+}
+
+func (actorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (actorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(withActor(ctx, conn.RequestHeader().Get(ActorHeader)), conn)
+	}
+}
+
+func withActor(ctx context.Context, actor string) context.Context {
+	actor = strings.TrimSpace(actor)
+	if len(actor) > maxActorLength {
+		actor = actor[:maxActorLength]
+	}
+	if actor == "" {
+		return ctx
+	}
+	return history.WithActor(ctx, actor)
+}
+
+// NewActorHandler puts the actor named by ActorHeader into the context of the requests
+// served by next, for the APIs that are not served by Connect handlers.
+func NewActorHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(withActor(r.Context(), r.Header.Get(ActorHeader))))
+	})
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// adminTokenInterceptor rejects the requests that do not carry the admin token
+// as a bearer token in the Authorization header.
+type adminTokenInterceptor struct {
+	token string
+}
+
+// NewAdminTokenInterceptor creates an interceptor that only lets through the requests
+// authorized with token. token must not be empty.
+func NewAdminTokenInterceptor(token string) connect.Interceptor {
+	return adminTokenInterceptor{token: token}
+}
+
+func (i adminTokenInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.authorize(req.Header().Get("Authorization")); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (adminTokenInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i adminTokenInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.authorize(conn.RequestHeader().Get("Authorization")); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i adminTokenInterceptor) authorize(authorization string) error {
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || token == "" {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("admin token is required"))
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(i.token)) != 1 {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("invalid admin token"))
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestAdminTokenInterceptor(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		wantCode      connect.Code
+	}{
+		{name: "lets through the admin token", authorization: "Bearer secret"},
+		{name: "rejects a request without a token", authorization: "", wantCode: connect.CodeUnauthenticated},
+		{name: "rejects a token of another scheme", authorization: "Basic secret", wantCode: connect.CodeUnauthenticated},
+		{name: "rejects another token", authorization: "Bearer secret2", wantCode: connect.CodePermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			called := false
+			next := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				called = true
+				return connect.NewResponse(&emptypb.Empty{}), nil
+			}
+			req := connect.NewRequest(&emptypb.Empty{})
+			if tt.authorization != "" {
+				req.Header().Set("Authorization", tt.authorization)
+			}
+
+			// When
+			_, err := NewAdminTokenInterceptor("secret").WrapUnary(next)(context.Background(), req)
+
+			// Then
+			if tt.wantCode == 0 {
+				assert.NoError(t, err)
+				assert.True(t, called)
+				return
+			}
+			assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			assert.False(t, called)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package rest
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// openAPIDocument is generated from the google.api.http annotations with `make buf-generate`.
+//
+//go:embed openapi.yaml
+var openAPIDocument []byte
+
+// NewOpenAPIHandler returns a handler serving the OpenAPI 3 document of the REST routes.
+func NewOpenAPIHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write(openAPIDocument)
+	})
+}
@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// pathTemplate is the path of an HTTP rule, such as /v1/todos/{id}:start.
+// Variables match a single path segment.
+type pathTemplate struct {
+	segments []segment
+	verb     string
+}
+
+// segment is a literal segment of a path template, or a variable bound to a field.
+type segment struct {
+	literal  string
+	variable string
+}
+
+// parsePathTemplate parses the path of an HTTP rule. Variables with a pattern
+// other than a single segment, such as {name=shelves/*}, are not supported.
+func parsePathTemplate(path string) (*pathTemplate, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path template %q must start with /", path)
+	}
+	t := &pathTemplate{}
+	rest := path[1:]
+	// The verb follows the last colon outside the variables of the last segment
+	last := rest[strings.LastIndex(rest, "/")+1:]
+	if i := strings.LastIndex(last, ":"); i >= 0 && !strings.Contains(last[i:], "}") {
+		t.verb = last[i+1:]
+		rest = rest[:len(rest)-len(last)+i]
+	}
+
+	for _, s := range strings.Split(rest, "/") {
+		if !strings.HasPrefix(s, "{") {
+			if s == "" || strings.ContainsAny(s, "{}*") {
+				return nil, fmt.Errorf("path template %q has an invalid segment %q", path, s)
+			}
+			t.segments = append(t.segments, segment{literal: s})
+			continue
+		}
+		if !strings.HasSuffix(s, "}") {
+			return nil, fmt.Errorf("path template %q has an invalid variable %q", path, s)
+		}
+		name, pattern, _ := strings.Cut(s[1:len(s)-1], "=")
+		if name == "" || (pattern != "" && pattern != "*") {
+			return nil, fmt.Errorf("path template %q has an unsupported variable %q", path, s)
+		}
+		t.segments = append(t.segments, segment{variable: name})
+	}
+	return t, nil
+}
+
+// match returns the values of the variables when the escaped path matches the template.
+func (t *pathTemplate) match(escapedPath string) (map[string]string, bool) {
+	rest, ok := strings.CutPrefix(escapedPath, "/")
+	if !ok {
+		return nil, false
+	}
+	if t.verb != "" {
+		if rest, ok = strings.CutSuffix(rest, ":"+t.verb); !ok {
+			return nil, false
+		}
+	}
+
+	parts := strings.Split(rest, "/")
+	if len(parts) != len(t.segments) {
+		return nil, false
+	}
+	vars := make(map[string]string)
+	for i, s := range t.segments {
+		value, err := url.PathUnescape(parts[i])
+		if err != nil {
+			return nil, false
+		}
+		if s.variable == "" {
+			if value != s.literal {
+				return nil, false
+			}
+			continue
+		}
+		if value == "" {
+			return nil, false
+		}
+		vars[s.variable] = value
+	}
+	return vars, true
+}
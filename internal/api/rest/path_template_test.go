@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePathTemplate(t *testing.T) {
+	t.Run("parses literals, variables and the verb", func(t *testing.T) {
+		template, err := parsePathTemplate("/v1/todos/{id=*}:start")
+
+		require.NoError(t, err)
+		assert.Equal(t, []segment{{literal: "v1"}, {literal: "todos"}, {variable: "id"}}, template.segments)
+		assert.Equal(t, "start", template.verb)
+	})
+
+	t.Run("rejects unsupported templates", func(t *testing.T) {
+		for _, path := range []string{
+			"v1/todos",
+			"/v1//todos",
+			"/v1/todos/{name=shelves/*}",
+			"/v1/todos/{id}/**",
+			"/v1/todos/{}",
+			"/v1/todos/{id",
+		} {
+			_, err := parsePathTemplate(path)
+			assert.Error(t, err, path)
+		}
+	})
+}
+
+func TestPathTemplate_Match(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		path     string
+		want     map[string]string
+		matches  bool
+	}{
+		{name: "literal path", template: "/v1/todos", path: "/v1/todos", want: map[string]string{}, matches: true},
+		{name: "variable", template: "/v1/todos/{id}", path: "/v1/todos/abc", want: map[string]string{"id": "abc"}, matches: true},
+		{name: "escaped variable", template: "/v1/todos/{id}", path: "/v1/todos/a%2Fb", want: map[string]string{"id": "a/b"}, matches: true},
+		{name: "verb", template: "/v1/todos/{id}:start", path: "/v1/todos/abc:start", want: map[string]string{"id": "abc"}, matches: true},
+		{name: "other verb", template: "/v1/todos/{id}:start", path: "/v1/todos/abc:complete"},
+		{name: "missing verb", template: "/v1/todos/{id}:start", path: "/v1/todos/abc"},
+		{name: "other literal", template: "/v1/todos/{id}", path: "/v1/tags/abc"},
+		{name: "more segments", template: "/v1/todos/{id}", path: "/v1/todos/abc/def"},
+		{name: "empty variable", template: "/v1/todos/{id}", path: "/v1/todos/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := parsePathTemplate(tt.template)
+			require.NoError(t, err)
+
+			vars, ok := template.match(tt.path)
+
+			assert.Equal(t, tt.matches, ok)
+			if tt.matches {
+				assert.Equal(t, tt.want, vars)
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+// Package rest serves the methods of the Connect services that have google.api.http
+// annotations on resource-oriented HTTP/JSON routes, and the OpenAPI document of the routes.
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldMaskName is the name of the message of the update masks.
+const fieldMaskName protoreflect.FullName = "google.protobuf.FieldMask"
+
+// maxBodyBytes is the largest request body accepted, the same limit as the Connect handlers.
+const maxBodyBytes = 4 * 1024 * 1024
+
+// route is a REST route of a method.
+type route struct {
+	method    string
+	template  *pathTemplate
+	procedure string
+	body      string
+	input     protoreflect.MessageDescriptor
+	// updateMask is the update_mask field that a PATCH route fills with the fields of its body.
+	updateMask protoreflect.FieldDescriptor
+}
+
+// transcoder serves the REST routes by calling the Connect handlers.
+type transcoder struct {
+	routes []route
+	next   http.Handler
+}
+
+// NewHandler returns a handler serving the methods of the services that have google.api.http annotations.
+// Each request is transcoded to a Connect request in JSON and served by next, the handler of the Connect
+// routes, so that the REST routes share the handlers and the interceptors of the RPCs.
+// Errors are returned in the JSON format of Connect errors with the same HTTP status codes.
+func NewHandler(next http.Handler, services ...protoreflect.ServiceDescriptor) (http.Handler, error) {
+	t := &transcoder{next: next}
+	for _, service := range services {
+		methods := service.Methods()
+		for i := range methods.Len() {
+			method := methods.Get(i)
+			rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				return nil, fmt.Errorf("method %s: streaming methods cannot have HTTP rules", method.FullName())
+			}
+			r, err := newRoute(rule, method)
+			if err != nil {
+				return nil, fmt.Errorf("method %s: %w", method.FullName(), err)
+			}
+			t.routes = append(t.routes, r)
+		}
+	}
+	// Try the routes with a verb first, so that /v1/todos/{id}:start is not taken for /v1/todos/{id}
+	sort.SliceStable(t.routes, func(i, j int) bool {
+		return t.routes[i].template.verb != "" && t.routes[j].template.verb == ""
+	})
+	return t, nil
+}
+
+func newRoute(rule *annotations.HttpRule, method protoreflect.MethodDescriptor) (route, error) {
+	r := route{
+		procedure: "/" + string(method.Parent().FullName()) + "/" + string(method.Name()),
+		body:      rule.GetBody(),
+		input:     method.Input(),
+	}
+	var path string
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		r.method, path = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		r.method, path = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		r.method, path = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Patch:
+		r.method, path = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Delete:
+		r.method, path = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Custom:
+		r.method, path = pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	default:
+		return route{}, errors.New("HTTP rule has no pattern")
+	}
+	if len(rule.GetAdditionalBindings()) > 0 {
+		return route{}, errors.New("additional bindings are not supported")
+	}
+
+	template, err := parsePathTemplate(path)
+	if err != nil {
+		return route{}, err
+	}
+	for _, s := range template.segments {
+		if s.variable != "" && r.input.Fields().ByName(protoreflect.Name(s.variable)) == nil {
+			return route{}, fmt.Errorf("path variable %q is not a field of %s", s.variable, r.input.FullName())
+		}
+	}
+	if r.body != "" && r.body != "*" && r.input.Fields().ByName(protoreflect.Name(r.body)) == nil {
+		return route{}, fmt.Errorf("body %q is not a field of %s", r.body, r.input.FullName())
+	}
+	r.template = template
+	if r.method == http.MethodPatch && r.body == "*" {
+		if field := r.input.Fields().ByName("update_mask"); field != nil && field.Message() != nil &&
+			field.Message().FullName() == fieldMaskName {
+			r.updateMask = field
+		}
+	}
+	return r, nil
+}
+
+func (t *transcoder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.EscapedPath()
+	var allowed []string
+	for _, route := range t.routes {
+		vars, ok := route.template.match(path)
+		if !ok {
+			continue
+		}
+		if route.method != r.Method {
+			allowed = append(allowed, route.method)
+			continue
+		}
+		t.transcode(w, r, route, vars)
+		return
+	}
+
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeError(w, http.StatusMethodNotAllowed, connect.CodeUnimplemented, fmt.Sprintf("method %s is not allowed", r.Method))
+		return
+	}
+	writeError(w, http.StatusNotFound, connect.CodeNotFound, fmt.Sprintf("no route for %s", path))
+}
+
+// transcode builds the JSON of the request message from the body, the query and the path,
+// and passes it to the Connect handler of the method.
+func (t *transcoder) transcode(w http.ResponseWriter, r *http.Request, route route, vars map[string]string) {
+	if encoding := r.Header.Get("Content-Encoding"); encoding != "" && encoding != "identity" {
+		writeError(w, http.StatusUnsupportedMediaType, connect.CodeInvalidArgument, "compressed request bodies are not supported")
+		return
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if route.body != "" {
+		content, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, connect.CodeInvalidArgument, fmt.Sprintf("failed to read request body: %v", err))
+			return
+		}
+		content = bytes.TrimSpace(content)
+		switch {
+		case len(content) == 0:
+		case route.body == "*":
+			if err := json.Unmarshal(content, &fields); err != nil {
+				writeError(w, http.StatusBadRequest, connect.CodeInvalidArgument, "request body must be a JSON object")
+				return
+			}
+		default:
+			if !json.Valid(content) {
+				writeError(w, http.StatusBadRequest, connect.CodeInvalidArgument, "request body must be JSON")
+				return
+			}
+			fields[route.body] = content
+		}
+	}
+
+	// A PATCH updates the fields of its body only, unless the body names them
+	if route.updateMask != nil {
+		setUpdateMask(fields, route.updateMask, route.input, vars)
+	}
+
+	// The fields not in the body are read from the query
+	if route.body != "*" {
+		query := r.URL.Query()
+		names := make([]string, 0, len(query))
+		for name := range query {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		// A field can be named by its proto name and its JSON name at the same time
+		values := make(map[protoreflect.FieldDescriptor][]string)
+		var order []protoreflect.FieldDescriptor
+		for _, name := range names {
+			field := findField(route.input, name)
+			if field == nil {
+				writeError(w, http.StatusBadRequest, connect.CodeInvalidArgument, fmt.Sprintf("query parameter %s: unknown field", name))
+				return
+			}
+			if _, ok := values[field]; !ok {
+				order = append(order, field)
+			}
+			values[field] = append(values[field], query[name]...)
+		}
+		for _, field := range order {
+			if err := setField(fields, field, values[field]); err != nil {
+				writeError(w, http.StatusBadRequest, connect.CodeInvalidArgument, fmt.Sprintf("query parameter %s: %v", field.JSONName(), err))
+				return
+			}
+		}
+	}
+	// The path takes precedence over the body and the query
+	for name, value := range vars {
+		if err := setField(fields, findField(route.input, name), []string{value}); err != nil {
+			writeError(w, http.StatusBadRequest, connect.CodeInvalidArgument, fmt.Sprintf("path variable %s: %v", name, err))
+			return
+		}
+	}
+
+	payload, err := json.Marshal(fields)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, connect.CodeInternal, err.Error())
+		return
+	}
+	req := r.Clone(r.Context())
+	req.Method = http.MethodPost
+	req.URL = &url.URL{Path: route.procedure}
+	req.RequestURI = route.procedure
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Del("Content-Length")
+	req.Body = io.NopCloser(bytes.NewReader(payload))
+	req.ContentLength = int64(len(payload))
+	req.GetBody = nil
+	t.next.ServeHTTP(w, req)
+}
+
+// setUpdateMask sets the update mask to the fields in the body, unless the body has a mask.
+// The path variables identify the resource, so they are not in the mask.
+func setUpdateMask(
+	fields map[string]json.RawMessage,
+	updateMask protoreflect.FieldDescriptor,
+	input protoreflect.MessageDescriptor,
+	vars map[string]string,
+) {
+	if _, ok := fields[string(updateMask.Name())]; ok {
+		return
+	}
+	if _, ok := fields[updateMask.JSONName()]; ok {
+		return
+	}
+	paths := make([]string, 0, len(fields))
+	for name := range fields {
+		field := findField(input, name)
+		if field == nil {
+			// The handler rejects the unknown fields of the mask, while it would ignore those of the body
+			paths = append(paths, name)
+			continue
+		}
+		if _, ok := vars[string(field.Name())]; ok {
+			continue
+		}
+		paths = append(paths, field.JSONName())
+	}
+	sort.Strings(paths)
+	content, _ := json.Marshal(strings.Join(paths, ","))
+	fields[updateMask.JSONName()] = content
+}
+
+// findField returns the field named by its proto or JSON name, or nil when there is none.
+func findField(input protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if field := input.Fields().ByName(protoreflect.Name(name)); field != nil {
+		return field
+	}
+	return input.Fields().ByJSONName(name)
+}
+
+// setField sets the field to the values in their JSON form.
+// Integers and enums are left as strings, which the JSON mapping of protobuf accepts.
+func setField(fields map[string]json.RawMessage, field protoreflect.FieldDescriptor, values []string) error {
+	if field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind || field.IsMap() {
+		return errors.New("message fields cannot be set from the query or the path")
+	}
+	if !field.IsList() && len(values) > 1 {
+		return errors.New("field is not repeated")
+	}
+
+	encoded := make([]json.RawMessage, 0, len(values))
+	for _, value := range values {
+		v, err := scalarJSON(field, value)
+		if err != nil {
+			return err
+		}
+		encoded = append(encoded, v)
+	}
+
+	var content []byte
+	var err error
+	if field.IsList() {
+		content, err = json.Marshal(encoded)
+	} else {
+		content, err = json.Marshal(encoded[0])
+	}
+	if err != nil {
+		return err
+	}
+	delete(fields, string(field.Name()))
+	fields[field.JSONName()] = content
+	return nil
+}
+
+func scalarJSON(field protoreflect.FieldDescriptor, value string) (json.RawMessage, error) {
+	if field.Kind() == protoreflect.BoolKind {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean %q", value)
+		}
+		return json.Marshal(b)
+	}
+	return json.Marshal(value)
+}
+
+// writeError writes an error in the JSON format of Connect errors.
+func writeError(w http.ResponseWriter, status int, code connect.Code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{Code: code.String(), Message: message})
+}
@@ -0,0 +1,298 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	v1 "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1"
+	v1connect "github.com/iktakahiro/oniongo/internal/api/grpc/gen/oniongo/v1/oniongov1connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// recordingTodoService records the requests of the methods with REST routes.
+type recordingTodoService struct {
+	v1connect.UnimplementedTodoServiceHandler
+
+	request proto.Message
+	actor   string
+}
+
+func (s *recordingTodoService) record(msg proto.Message, header http.Header) {
+	s.request = msg
+	s.actor = header.Get("X-Actor")
+}
+
+func (s *recordingTodoService) CreateTodo(_ context.Context, req *connect.Request[v1.CreateTodoRequest]) (*connect.Response[v1.CreateTodoResponse], error) {
+	s.record(req.Msg, req.Header())
+	return connect.NewResponse(&v1.CreateTodoResponse{Todo: &v1.Todo{Id: "new", Title: req.Msg.Title}}), nil
+}
+
+func (s *recordingTodoService) GetTodo(_ context.Context, req *connect.Request[v1.GetTodoRequest]) (*connect.Response[v1.GetTodoResponse], error) {
+	s.record(req.Msg, req.Header())
+	if req.Msg.Id == "missing" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("todo not found: missing"))
+	}
+	return connect.NewResponse(&v1.GetTodoResponse{Todo: &v1.Todo{Id: req.Msg.Id}}), nil
+}
+
+func (s *recordingTodoService) GetTodos(_ context.Context, req *connect.Request[v1.GetTodosRequest]) (*connect.Response[v1.GetTodosResponse], error) {
+	s.record(req.Msg, req.Header())
+	return connect.NewResponse(&v1.GetTodosResponse{}), nil
+}
+
+func (s *recordingTodoService) UpdateTodo(_ context.Context, req *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error) {
+	s.record(req.Msg, req.Header())
+	return connect.NewResponse(&v1.UpdateTodoResponse{}), nil
+}
+
+func (s *recordingTodoService) StartTodo(_ context.Context, req *connect.Request[v1.StartTodoRequest]) (*connect.Response[v1.StartTodoResponse], error) {
+	s.record(req.Msg, req.Header())
+	return connect.NewResponse(&v1.StartTodoResponse{}), nil
+}
+
+func (s *recordingTodoService) CompleteTodo(_ context.Context, req *connect.Request[v1.CompleteTodoRequest]) (*connect.Response[v1.CompleteTodoResponse], error) {
+	s.record(req.Msg, req.Header())
+	return connect.NewResponse(&v1.CompleteTodoResponse{}), nil
+}
+
+func (s *recordingTodoService) DeleteTodo(_ context.Context, req *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error) {
+	s.record(req.Msg, req.Header())
+	return connect.NewResponse(&v1.DeleteTodoResponse{}), nil
+}
+
+func newTestServer(t *testing.T) (*httptest.Server, *recordingTodoService) {
+	service := &recordingTodoService{}
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewTodoServiceHandler(service))
+	handler, err := NewHandler(mux, v1.File_oniongo_v1_todo_proto.Services().ByName("TodoService"))
+	require.NoError(t, err)
+	mux.Handle("/v1/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, service
+}
+
+func doRequest(t *testing.T, server *httptest.Server, method, path, body string) (*http.Response, string) {
+	req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("X-Actor", "alice")
+	res, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	content, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res, string(content)
+}
+
+func TestTranscoder(t *testing.T) {
+	parentID := "0191e1a8-0000-7000-8000-000000000001"
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		want   proto.Message
+	}{
+		{
+			name:   "lists todo items with the filters in the query",
+			method: http.MethodGet,
+			path:   "/v1/todos?tagIds=a&tagIds=b&tag_match=TAG_MATCH_MODE_ALL&actionableOnly=true&parentId=" + parentID,
+			want: &v1.GetTodosRequest{
+				TagIds:         []string{"a", "b"},
+				TagMatch:       v1.TagMatchMode_TAG_MATCH_MODE_ALL,
+				ActionableOnly: true,
+				ParentId:       &parentID,
+			},
+		},
+		{
+			name:   "gets a todo item at a version",
+			method: http.MethodGet,
+			path:   "/v1/todos/abc?version=3",
+			want:   &v1.GetTodoRequest{Id: "abc", PointInTime: &v1.GetTodoRequest_Version{Version: 3}},
+		},
+		{
+			name:   "creates a todo item from the body",
+			method: http.MethodPost,
+			path:   "/v1/todos",
+			body:   `{"title": "Buy milk", "parent_id": "` + parentID + `"}`,
+			want:   &v1.CreateTodoRequest{Title: "Buy milk", ParentId: &parentID},
+		},
+		{
+			name:   "updates a todo item with the ID of the path",
+			method: http.MethodPatch,
+			path:   "/v1/todos/abc",
+			body:   `{"id": "other", "title": "Renamed"}`,
+			want: &v1.UpdateTodoRequest{
+				Id:         "abc",
+				Title:      "Renamed",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+			},
+		},
+		{
+			name:   "updates only the body of a todo item",
+			method: http.MethodPatch,
+			path:   "/v1/todos/abc",
+			body:   `{"body": "Only the body"}`,
+			want: &v1.UpdateTodoRequest{
+				Id:         "abc",
+				Body:       proto.String("Only the body"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"body"}},
+			},
+		},
+		{
+			name:   "clears the body of a todo item set to null",
+			method: http.MethodPatch,
+			path:   "/v1/todos/abc",
+			body:   `{"title": "Renamed", "body": null}`,
+			want: &v1.UpdateTodoRequest{
+				Id:         "abc",
+				Title:      "Renamed",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"body", "title"}},
+			},
+		},
+		{
+			name:   "names the unknown fields of the body in the update mask",
+			method: http.MethodPatch,
+			path:   "/v1/todos/abc",
+			body:   `{"status": "COMPLETED"}`,
+			want: &v1.UpdateTodoRequest{
+				Id:         "abc",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+			},
+		},
+		{
+			name:   "keeps the update mask of the body",
+			method: http.MethodPatch,
+			path:   "/v1/todos/abc",
+			body:   `{"title": "Renamed", "updateMask": "title,body"}`,
+			want: &v1.UpdateTodoRequest{
+				Id:         "abc",
+				Title:      "Renamed",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "body"}},
+			},
+		},
+		{
+			name:   "starts a todo item without a body",
+			method: http.MethodPost,
+			path:   "/v1/todos/abc:start",
+			want:   &v1.StartTodoRequest{Id: "abc"},
+		},
+		{
+			name:   "completes a todo item with the options in the body",
+			method: http.MethodPost,
+			path:   "/v1/todos/abc:complete",
+			body:   `{"cascade": true}`,
+			want:   &v1.CompleteTodoRequest{Id: "abc", Cascade: true},
+		},
+		{
+			name:   "deletes a todo item",
+			method: http.MethodDelete,
+			path:   "/v1/todos/abc",
+			want:   &v1.DeleteTodoRequest{Id: "abc"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			server, service := newTestServer(t)
+
+			// When
+			res, _ := doRequest(t, server, tt.method, tt.path, tt.body)
+
+			// Then
+			require.Equal(t, http.StatusOK, res.StatusCode)
+			assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+			assert.True(t, proto.Equal(tt.want, service.request), "got %v", service.request)
+			assert.Equal(t, "alice", service.actor)
+		})
+	}
+
+	t.Run("returns the response message as JSON", func(t *testing.T) {
+		// Given
+		server, _ := newTestServer(t)
+
+		// When
+		_, body := doRequest(t, server, http.MethodPost, "/v1/todos", `{"title": "Buy milk"}`)
+
+		// Then
+		assert.JSONEq(t, `{"todo": {"id": "new", "title": "Buy milk"}}`, body)
+	})
+}
+
+func TestTranscoder_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "error of the handler", method: http.MethodGet, path: "/v1/todos/missing", wantStatus: http.StatusNotFound, wantCode: "not_found"},
+		{name: "unknown route", method: http.MethodGet, path: "/v1/projects", wantStatus: http.StatusNotFound, wantCode: "not_found"},
+		{name: "method not allowed", method: http.MethodPut, path: "/v1/todos/abc", wantStatus: http.StatusMethodNotAllowed, wantCode: "unimplemented"},
+		{name: "unknown query parameter", method: http.MethodGet, path: "/v1/todos?color=red", wantStatus: http.StatusBadRequest, wantCode: "invalid_argument"},
+		{name: "invalid boolean", method: http.MethodGet, path: "/v1/todos?actionableOnly=maybe", wantStatus: http.StatusBadRequest, wantCode: "invalid_argument"},
+		{name: "repeated scalar", method: http.MethodGet, path: "/v1/todos/abc?version=1&version=2", wantStatus: http.StatusBadRequest, wantCode: "invalid_argument"},
+		{name: "body that is not an object", method: http.MethodPost, path: "/v1/todos", body: `["Buy milk"]`, wantStatus: http.StatusBadRequest, wantCode: "invalid_argument"},
+		{name: "invalid field in the body", method: http.MethodPost, path: "/v1/todos", body: `{"title": 42}`, wantStatus: http.StatusBadRequest, wantCode: "invalid_argument"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			server, _ := newTestServer(t)
+
+			// When
+			res, body := doRequest(t, server, tt.method, tt.path, tt.body)
+
+			// Then
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+			var connectErr struct {
+				Code string `json:"code"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(body), &connectErr))
+			assert.Equal(t, tt.wantCode, connectErr.Code)
+		})
+	}
+
+	t.Run("lists the allowed methods", func(t *testing.T) {
+		server, _ := newTestServer(t)
+
+		res, _ := doRequest(t, server, http.MethodPut, "/v1/todos/abc", "")
+
+		assert.Equal(t, "GET, PATCH, DELETE", res.Header.Get("Allow"))
+	})
+}
+
+func TestNewOpenAPIHandler(t *testing.T) {
+	// Given
+	server := httptest.NewServer(NewOpenAPIHandler())
+	defer server.Close()
+
+	// When
+	res, err := server.Client().Get(server.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	content, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, "application/yaml", res.Header.Get("Content-Type"))
+	for _, path := range []string{"/v1/todos:", "/v1/todos/{id}:", "/v1/todos/{id}:start:", "/v1/todos/{id}:complete:"} {
+		assert.Contains(t, string(content), "    "+path+"\n")
+	}
+}
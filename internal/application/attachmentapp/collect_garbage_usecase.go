@@ -0,0 +1,127 @@
+package attachmentapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/samber/do"
+)
+
+// DefaultGracePeriod is the age below which a blob is never collected,
+// because its attachment may not be saved yet.
+const DefaultGracePeriod = time.Hour
+
+type CollectGarbageRequest struct {
+	// GracePeriod defaults to DefaultGracePeriod.
+	GracePeriod time.Duration
+}
+
+type CollectGarbageResult struct {
+	// DeletedKeys are the keys of the orphaned blobs that were deleted.
+	DeletedKeys []string
+}
+
+// CollectGarbageUseCase is the interface that wraps the basic CollectGarbage operation.
+type CollectGarbageUseCase interface {
+	Execute(ctx context.Context, req CollectGarbageRequest) (*CollectGarbageResult, error)
+}
+
+// collectGarbageUseCase is the implementation of the CollectGarbageUseCase interface.
+type collectGarbageUseCase struct {
+	attachmentRepository attachment.AttachmentRepository
+	blobStore            attachment.BlobStore
+	txRunner             uow.TransactionRunner
+	clock                clock.Clock
+}
+
+// NewCollectGarbageUseCase creates a new CollectGarbageUseCase.
+func NewCollectGarbageUseCase(i *do.Injector) (CollectGarbageUseCase, error) {
+	attachmentRepository, err := do.Invoke[attachment.AttachmentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke attachment repository: %w", err)
+	}
+	blobStore, err := do.Invoke[attachment.BlobStore](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke blob store: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &collectGarbageUseCase{
+		attachmentRepository: attachmentRepository,
+		blobStore:            blobStore,
+		txRunner:             txRunner,
+		clock:                clk,
+	}, nil
+}
+
+// Execute deletes the blobs that no attachment refers to.
+// Such blobs are left behind when a todo is deleted, or when an upload or
+// a deletion fails half way. Blobs whose key is not an attachment ID are kept.
+func (u collectGarbageUseCase) Execute(ctx context.Context, req CollectGarbageRequest) (*CollectGarbageResult, error) {
+	gracePeriod := req.GracePeriod
+	if gracePeriod <= 0 {
+		gracePeriod = DefaultGracePeriod
+	}
+	cutoff := u.clock.Now().Add(-gracePeriod)
+
+	blobs, err := u.blobStore.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blobs: %w", err)
+	}
+	var candidates []attachment.AttachmentID
+	keys := map[attachment.AttachmentID]string{}
+	for _, blob := range blobs {
+		if !blob.ModifiedAt.Before(cutoff) {
+			continue
+		}
+		id, err := attachment.NewAttachmentIDFromString(blob.Key)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, id)
+		keys[id] = blob.Key
+	}
+	result := &CollectGarbageResult{DeletedKeys: []string{}}
+	if len(candidates) == 0 {
+		return result, nil
+	}
+
+	var referenced []*attachment.Attachment
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		referenced, err = u.attachmentRepository.FindByIDs(ctx, candidates)
+		if err != nil {
+			return fmt.Errorf("failed to find attachments: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+
+	keep := make(map[attachment.AttachmentID]bool, len(referenced))
+	for _, a := range referenced {
+		keep[a.ID()] = true
+	}
+	for _, id := range candidates {
+		if keep[id] {
+			continue
+		}
+		if err := u.blobStore.Delete(ctx, keys[id]); err != nil {
+			return result, fmt.Errorf("failed to delete blob: %w", err)
+		}
+		result.DeletedKeys = append(result.DeletedKeys, keys[id])
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package attachmentapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_attachment"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectGarbageUseCase_Execute(t *testing.T) {
+	t.Run("deletes old blobs that no attachment refers to", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+		referenced, err := attachment.NewAttachment(idgen.UUIDv7(), now, todo.NewTodoID(), "kept.txt", "")
+		require.NoError(t, err)
+		orphan := attachment.NewAttachmentID()
+		recent := attachment.NewAttachmentID()
+		old := now.Add(-2 * time.Hour)
+
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockBlobStore.EXPECT().List(ctx).Return([]attachment.BlobInfo{
+			{Key: referenced.BlobKey(), ModifiedAt: old},
+			{Key: orphan.String(), ModifiedAt: old},
+			{Key: recent.String(), ModifiedAt: now.Add(-time.Hour + time.Second)},
+			{Key: "not-an-attachment", ModifiedAt: old},
+		}, nil)
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockAttachmentRepo.EXPECT().FindByIDs(ctx, []attachment.AttachmentID{referenced.ID(), orphan}).
+					Return([]*attachment.Attachment{referenced}, nil)
+				return fn(ctx)
+			})
+		mockBlobStore.EXPECT().Delete(ctx, orphan.String()).Return(nil)
+
+		useCase := &collectGarbageUseCase{
+			attachmentRepository: mockAttachmentRepo,
+			blobStore:            mockBlobStore,
+			txRunner:             mockTxRunner,
+			clock:                clock.NewFake(now),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, CollectGarbageRequest{GracePeriod: time.Hour})
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, []string{orphan.String()}, result.DeletedKeys)
+	})
+
+	t.Run("does nothing when every blob is within the grace period", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+
+		mockBlobStore.EXPECT().List(ctx).Return([]attachment.BlobInfo{
+			{Key: attachment.NewAttachmentID().String(), ModifiedAt: time.Now().Add(-time.Minute)},
+		}, nil)
+
+		useCase := &collectGarbageUseCase{blobStore: mockBlobStore, clock: clock.System()}
+
+		// When
+		result, err := useCase.Execute(ctx, CollectGarbageRequest{})
+
+		// Then
+		require.NoError(t, err)
+		require.Empty(t, result.DeletedKeys)
+	})
+}
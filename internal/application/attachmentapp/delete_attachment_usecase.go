@@ -0,0 +1,71 @@
+package attachmentapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/samber/do"
+)
+
+type DeleteAttachmentRequest struct {
+	ID attachment.AttachmentID
+}
+
+// DeleteAttachmentUseCase is the interface that wraps the basic DeleteAttachment operation.
+type DeleteAttachmentUseCase interface {
+	Execute(ctx context.Context, req DeleteAttachmentRequest) error
+}
+
+// deleteAttachmentUseCase is the implementation of the DeleteAttachmentUseCase interface.
+type deleteAttachmentUseCase struct {
+	attachmentRepository attachment.AttachmentRepository
+	blobStore            attachment.BlobStore
+	txRunner             uow.TransactionRunner
+}
+
+// NewDeleteAttachmentUseCase creates a new DeleteAttachmentUseCase.
+func NewDeleteAttachmentUseCase(i *do.Injector) (DeleteAttachmentUseCase, error) {
+	attachmentRepository, err := do.Invoke[attachment.AttachmentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke attachment repository: %w", err)
+	}
+	blobStore, err := do.Invoke[attachment.BlobStore](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke blob store: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &deleteAttachmentUseCase{
+		attachmentRepository: attachmentRepository,
+		blobStore:            blobStore,
+		txRunner:             txRunner,
+	}, nil
+}
+
+// Execute deletes an Attachment and then its content.
+// A blob that cannot be deleted is left to the garbage collection.
+func (u deleteAttachmentUseCase) Execute(ctx context.Context, req DeleteAttachmentRequest) error {
+	var found *attachment.Attachment
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		found, err = u.attachmentRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find attachment: %w", err)
+		}
+		if err := u.attachmentRepository.Delete(ctx, req.ID); err != nil {
+			return fmt.Errorf("failed to delete attachment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return wrapTxError(err)
+	}
+
+	_ = u.blobStore.Delete(ctx, found.BlobKey())
+	return nil
+}
@@ -0,0 +1,109 @@
+package attachmentapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_attachment"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteAttachmentUseCase_Execute(t *testing.T) {
+	t.Run("deletes the attachment and its content", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existing, err := attachment.NewAttachment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "hello.txt", "")
+		require.NoError(t, err)
+
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockAttachmentRepo.EXPECT().FindByID(ctx, existing.ID()).Return(existing, nil)
+				mockAttachmentRepo.EXPECT().Delete(ctx, existing.ID()).Return(nil)
+				return fn(ctx)
+			})
+		mockBlobStore.EXPECT().Delete(ctx, existing.BlobKey()).Return(nil)
+
+		useCase := &deleteAttachmentUseCase{
+			attachmentRepository: mockAttachmentRepo,
+			blobStore:            mockBlobStore,
+			txRunner:             mockTxRunner,
+		}
+
+		// When
+		err = useCase.Execute(ctx, DeleteAttachmentRequest{ID: existing.ID()})
+
+		// Then
+		require.NoError(t, err)
+	})
+
+	t.Run("succeeds when the content cannot be deleted", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existing, err := attachment.NewAttachment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "hello.txt", "")
+		require.NoError(t, err)
+
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockAttachmentRepo.EXPECT().FindByID(ctx, existing.ID()).Return(existing, nil)
+				mockAttachmentRepo.EXPECT().Delete(ctx, existing.ID()).Return(nil)
+				return fn(ctx)
+			})
+		// The garbage collection removes the blob later
+		mockBlobStore.EXPECT().Delete(ctx, existing.BlobKey()).Return(errors.New("storage error"))
+
+		useCase := &deleteAttachmentUseCase{
+			attachmentRepository: mockAttachmentRepo,
+			blobStore:            mockBlobStore,
+			txRunner:             mockTxRunner,
+		}
+
+		// When
+		err = useCase.Execute(ctx, DeleteAttachmentRequest{ID: existing.ID()})
+
+		// Then
+		require.NoError(t, err)
+	})
+
+	t.Run("returns not found error", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		id := attachment.NewAttachmentID()
+
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockAttachmentRepo.EXPECT().FindByID(ctx, id).Return(nil, &attachment.NotFoundError{ID: id})
+				return fn(ctx)
+			})
+
+		useCase := &deleteAttachmentUseCase{
+			attachmentRepository: mockAttachmentRepo,
+			txRunner:             mockTxRunner,
+		}
+
+		// When
+		err := useCase.Execute(ctx, DeleteAttachmentRequest{ID: id})
+
+		// Then
+		var notFoundErr *attachment.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+}
@@ -0,0 +1,120 @@
+package attachmentapp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/samber/do"
+)
+
+type DownloadAttachmentRequest struct {
+	ID attachment.AttachmentID
+}
+
+type DownloadAttachmentResult struct {
+	Attachment *attachment.Attachment
+	// Content streams the stored file and must be closed by the caller.
+	// Reading it returns an IntegrityError at the end when the content does not
+	// match the size and checksum of the attachment.
+	Content io.ReadCloser
+}
+
+// DownloadAttachmentUseCase is the interface that wraps the basic DownloadAttachment operation.
+type DownloadAttachmentUseCase interface {
+	Execute(ctx context.Context, req DownloadAttachmentRequest) (*DownloadAttachmentResult, error)
+}
+
+// downloadAttachmentUseCase is the implementation of the DownloadAttachmentUseCase interface.
+type downloadAttachmentUseCase struct {
+	attachmentRepository attachment.AttachmentRepository
+	blobStore            attachment.BlobStore
+	txRunner             uow.TransactionRunner
+}
+
+// NewDownloadAttachmentUseCase creates a new DownloadAttachmentUseCase.
+func NewDownloadAttachmentUseCase(i *do.Injector) (DownloadAttachmentUseCase, error) {
+	attachmentRepository, err := do.Invoke[attachment.AttachmentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke attachment repository: %w", err)
+	}
+	blobStore, err := do.Invoke[attachment.BlobStore](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke blob store: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &downloadAttachmentUseCase{
+		attachmentRepository: attachmentRepository,
+		blobStore:            blobStore,
+		txRunner:             txRunner,
+	}, nil
+}
+
+// Execute opens the content of an Attachment.
+func (u downloadAttachmentUseCase) Execute(ctx context.Context, req DownloadAttachmentRequest) (*DownloadAttachmentResult, error) {
+	var found *attachment.Attachment
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		found, err = u.attachmentRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find attachment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapTxError(err)
+	}
+
+	content, err := u.blobStore.Get(ctx, found.BlobKey())
+	if err != nil {
+		var blobNotFoundErr *attachment.BlobNotFoundError
+		if errors.As(err, &blobNotFoundErr) {
+			return nil, &attachment.IntegrityError{ID: found.ID(), Message: "content is missing"}
+		}
+		return nil, fmt.Errorf("failed to open attachment content: %w", err)
+	}
+	return &DownloadAttachmentResult{
+		Attachment: found,
+		Content:    &verifyingReader{rc: content, attachment: found, hash: sha256.New()},
+	}, nil
+}
+
+// verifyingReader checks the size and checksum of the content once it is read to the end.
+type verifyingReader struct {
+	rc         io.ReadCloser
+	attachment *attachment.Attachment
+	hash       hash.Hash
+	size       int64
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.rc.Read(p)
+	v.hash.Write(p[:n])
+	v.size += int64(n)
+	if v.size > v.attachment.Size() {
+		return n, &attachment.IntegrityError{ID: v.attachment.ID(), Message: "content is larger than recorded"}
+	}
+	if err == io.EOF {
+		if v.size != v.attachment.Size() {
+			return n, &attachment.IntegrityError{ID: v.attachment.ID(), Message: "content is smaller than recorded"}
+		}
+		if hex.EncodeToString(v.hash.Sum(nil)) != v.attachment.Checksum() {
+			return n, &attachment.IntegrityError{ID: v.attachment.ID(), Message: "checksum mismatch"}
+		}
+	}
+	return n, err
+}
+
+func (v *verifyingReader) Close() error {
+	return v.rc.Close()
+}
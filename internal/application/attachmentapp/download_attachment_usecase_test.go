@@ -0,0 +1,133 @@
+package attachmentapp
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_attachment"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadAttachmentUseCase_Execute(t *testing.T) {
+	newUseCase := func(t *testing.T, found *attachment.Attachment, content string, contentErr error) *downloadAttachmentUseCase {
+		ctx := context.Background()
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockAttachmentRepo.EXPECT().FindByID(ctx, found.ID()).Return(found, nil)
+				return fn(ctx)
+			})
+		if contentErr != nil {
+			mockBlobStore.EXPECT().Get(ctx, found.BlobKey()).Return(nil, contentErr)
+		} else {
+			mockBlobStore.EXPECT().Get(ctx, found.BlobKey()).Return(io.NopCloser(strings.NewReader(content)), nil)
+		}
+
+		return &downloadAttachmentUseCase{
+			attachmentRepository: mockAttachmentRepo,
+			blobStore:            mockBlobStore,
+			txRunner:             mockTxRunner,
+		}
+	}
+	hello := attachment.ReconstructAttachment(
+		uuid.New(), todo.NewTodoID(), "hello.txt", "text/plain", 5, helloChecksum, time.Now(),
+	)
+
+	t.Run("streams the content of the attachment", func(t *testing.T) {
+		// Given
+		useCase := newUseCase(t, hello, "hello", nil)
+
+		// When
+		result, err := useCase.Execute(context.Background(), DownloadAttachmentRequest{ID: hello.ID()})
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, hello, result.Attachment)
+		content, err := io.ReadAll(result.Content)
+		require.NoError(t, err)
+		require.Equal(t, "hello", string(content))
+		require.NoError(t, result.Content.Close())
+	})
+
+	t.Run("returns integrity error when the content is corrupted", func(t *testing.T) {
+		// Given
+		useCase := newUseCase(t, hello, "jello", nil)
+
+		// When
+		result, err := useCase.Execute(context.Background(), DownloadAttachmentRequest{ID: hello.ID()})
+		require.NoError(t, err)
+		_, err = io.ReadAll(result.Content)
+
+		// Then
+		var integrityErr *attachment.IntegrityError
+		require.ErrorAs(t, err, &integrityErr)
+		require.Equal(t, "checksum mismatch", integrityErr.Message)
+	})
+
+	t.Run("returns integrity error when the content is truncated", func(t *testing.T) {
+		// Given
+		useCase := newUseCase(t, hello, "hell", nil)
+
+		// When
+		result, err := useCase.Execute(context.Background(), DownloadAttachmentRequest{ID: hello.ID()})
+		require.NoError(t, err)
+		_, err = io.ReadAll(result.Content)
+
+		// Then
+		var integrityErr *attachment.IntegrityError
+		require.ErrorAs(t, err, &integrityErr)
+		require.Equal(t, "content is smaller than recorded", integrityErr.Message)
+	})
+
+	t.Run("returns integrity error when the content is missing", func(t *testing.T) {
+		// Given
+		useCase := newUseCase(t, hello, "", &attachment.BlobNotFoundError{Key: hello.BlobKey()})
+
+		// When
+		result, err := useCase.Execute(context.Background(), DownloadAttachmentRequest{ID: hello.ID()})
+
+		// Then
+		var integrityErr *attachment.IntegrityError
+		require.ErrorAs(t, err, &integrityErr)
+		require.Nil(t, result)
+	})
+
+	t.Run("returns not found error", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		id := attachment.NewAttachmentID()
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockAttachmentRepo.EXPECT().FindByID(ctx, id).Return(nil, &attachment.NotFoundError{ID: id})
+				return fn(ctx)
+			})
+
+		useCase := &downloadAttachmentUseCase{
+			attachmentRepository: mockAttachmentRepo,
+			txRunner:             mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, DownloadAttachmentRequest{ID: id})
+
+		// Then
+		var notFoundErr *attachment.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		require.Nil(t, result)
+	})
+}
@@ -0,0 +1,69 @@
+package attachmentapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type ListAttachmentsRequest struct {
+	TodoID todo.TodoID
+}
+
+// ListAttachmentsUseCase is the interface that wraps the basic ListAttachments operation.
+type ListAttachmentsUseCase interface {
+	Execute(ctx context.Context, req ListAttachmentsRequest) ([]*attachment.Attachment, error)
+}
+
+// listAttachmentsUseCase is the implementation of the ListAttachmentsUseCase interface.
+type listAttachmentsUseCase struct {
+	attachmentRepository attachment.AttachmentRepository
+	todoRepository       todo.TodoRepository
+	txRunner             uow.TransactionRunner
+}
+
+// NewListAttachmentsUseCase creates a new ListAttachmentsUseCase.
+func NewListAttachmentsUseCase(i *do.Injector) (ListAttachmentsUseCase, error) {
+	attachmentRepository, err := do.Invoke[attachment.AttachmentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke attachment repository: %w", err)
+	}
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &listAttachmentsUseCase{
+		attachmentRepository: attachmentRepository,
+		todoRepository:       todoRepository,
+		txRunner:             txRunner,
+	}, nil
+}
+
+// Execute returns the Attachments of a Todo, oldest first.
+func (u listAttachmentsUseCase) Execute(ctx context.Context, req ListAttachmentsRequest) ([]*attachment.Attachment, error) {
+	var attachments []*attachment.Attachment
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.todoRepository.FindByID(ctx, req.TodoID); err != nil {
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+		var err error
+		attachments, err = u.attachmentRepository.FindByTodoIDs(ctx, []todo.TodoID{req.TodoID})
+		if err != nil {
+			return fmt.Errorf("failed to find attachments: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapTxError(err)
+	}
+	return attachments, nil
+}
@@ -0,0 +1,160 @@
+// Package attachmentapp provides the application layer for files attached to todos.
+package attachmentapp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type UploadAttachmentRequest struct {
+	TodoID      todo.TodoID
+	Filename    string
+	ContentType string
+	// Content is read until EOF and stored as the file.
+	Content io.Reader
+}
+
+// UploadAttachmentUseCase is the interface that wraps the basic UploadAttachment operation.
+type UploadAttachmentUseCase interface {
+	Execute(ctx context.Context, req UploadAttachmentRequest) (*attachment.Attachment, error)
+}
+
+// uploadAttachmentUseCase is the implementation of the UploadAttachmentUseCase interface.
+type uploadAttachmentUseCase struct {
+	attachmentRepository attachment.AttachmentRepository
+	todoRepository       todo.TodoRepository
+	blobStore            attachment.BlobStore
+	txRunner             uow.TransactionRunner
+	clock                clock.Clock
+	ids                  idgen.IDGenerator
+}
+
+// NewUploadAttachmentUseCase creates a new UploadAttachmentUseCase.
+func NewUploadAttachmentUseCase(i *do.Injector) (UploadAttachmentUseCase, error) {
+	attachmentRepository, err := do.Invoke[attachment.AttachmentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke attachment repository: %w", err)
+	}
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	blobStore, err := do.Invoke[attachment.BlobStore](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke blob store: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &uploadAttachmentUseCase{
+		attachmentRepository: attachmentRepository,
+		todoRepository:       todoRepository,
+		blobStore:            blobStore,
+		txRunner:             txRunner,
+		clock:                clk,
+		ids:                  ids,
+	}, nil
+}
+
+// Execute stores the content in the BlobStore and attaches it to a Todo.
+// The content is streamed, so no transaction is held open while it is uploaded.
+// The blob is removed again when the attachment cannot be saved.
+func (u uploadAttachmentUseCase) Execute(ctx context.Context, req UploadAttachmentRequest) (*attachment.Attachment, error) {
+	newAttachment, err := attachment.NewAttachment(u.ids, u.clock.Now(), req.TodoID, req.Filename, req.ContentType)
+	if err != nil {
+		// Return domain error directly for proper error handling
+		return nil, err
+	}
+
+	// Fail before receiving the content when the todo does not exist.
+	if err := u.txRunner.RunInTx(ctx, u.findTodo(req.TodoID)); err != nil {
+		return nil, wrapTxError(err)
+	}
+
+	content := &digestReader{r: io.LimitReader(req.Content, attachment.MaxSize+1), hash: sha256.New()}
+	if err := u.blobStore.Put(ctx, newAttachment.BlobKey(), content); err != nil {
+		return nil, fmt.Errorf("failed to store attachment content: %w", err)
+	}
+	if err := newAttachment.Uploaded(content.size, hex.EncodeToString(content.hash.Sum(nil))); err != nil {
+		u.discardBlob(newAttachment)
+		return nil, err
+	}
+
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		// The todo may have been deleted during the upload.
+		if err := u.findTodo(req.TodoID)(ctx); err != nil {
+			return err
+		}
+		if err := u.attachmentRepository.Create(ctx, newAttachment); err != nil {
+			return fmt.Errorf("failed to save attachment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		u.discardBlob(newAttachment)
+		return nil, wrapTxError(err)
+	}
+	return newAttachment, nil
+}
+
+func (u uploadAttachmentUseCase) findTodo(id todo.TodoID) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if _, err := u.todoRepository.FindByID(ctx, id); err != nil {
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+		return nil
+	}
+}
+
+// discardBlob removes the blob of an attachment that was not saved.
+// The request context may already be canceled, so a fresh one is used.
+// A blob left behind is removed by the garbage collection.
+func (u uploadAttachmentUseCase) discardBlob(a *attachment.Attachment) {
+	_ = u.blobStore.Delete(context.Background(), a.BlobKey())
+}
+
+// wrapTxError preserves the domain errors and wraps the others.
+func wrapTxError(err error) error {
+	var todoNotFoundErr *todo.NotFoundError
+	var notFoundErr *attachment.NotFoundError
+	if errors.As(err, &todoNotFoundErr) || errors.As(err, &notFoundErr) {
+		return err
+	}
+	return fmt.Errorf("failed to execute transaction: %w", err)
+}
+
+// digestReader counts and hashes the bytes read through it.
+type digestReader struct {
+	r    io.Reader
+	hash hash.Hash
+	size int64
+}
+
+func (d *digestReader) Read(p []byte) (int, error) {
+	n, err := d.r.Read(p)
+	d.hash.Write(p[:n])
+	d.size += int64(n)
+	return n, err
+}
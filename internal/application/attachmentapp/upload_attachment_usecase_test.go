@@ -0,0 +1,230 @@
+package attachmentapp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_attachment"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// helloChecksum is the SHA-256 digest of "hello".
+const helloChecksum = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+func TestUploadAttachmentUseCase_Execute(t *testing.T) {
+	t.Run("stores the content and saves the attachment", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existingTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		req := UploadAttachmentRequest{
+			TodoID:      existingTodo.ID(),
+			Filename:    "hello.txt",
+			ContentType: "text/plain",
+			Content:     strings.NewReader("hello"),
+		}
+
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				return fn(ctx)
+			}).Times(2)
+		mockTodoRepo.EXPECT().FindByID(ctx, existingTodo.ID()).Return(existingTodo, nil).Times(2)
+		var stored string
+		mockBlobStore.EXPECT().Put(ctx, mock.AnythingOfType("string"), mock.Anything).
+			RunAndReturn(func(ctx context.Context, key string, r io.Reader) error {
+				content, err := io.ReadAll(r)
+				stored = string(content)
+				return err
+			})
+		mockAttachmentRepo.EXPECT().Create(ctx, mock.AnythingOfType("*attachment.Attachment")).Return(nil)
+
+		useCase := &uploadAttachmentUseCase{
+			attachmentRepository: mockAttachmentRepo,
+			todoRepository:       mockTodoRepo,
+			blobStore:            mockBlobStore,
+			txRunner:             mockTxRunner,
+			clock:                clock.System(),
+			ids:                  idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "hello", stored)
+		require.Equal(t, existingTodo.ID(), result.TodoID())
+		require.Equal(t, "hello.txt", result.Filename())
+		require.Equal(t, "text/plain", result.ContentType())
+		require.Equal(t, int64(5), result.Size())
+		require.Equal(t, helloChecksum, result.Checksum())
+	})
+
+	t.Run("returns validation error for an invalid filename", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := UploadAttachmentRequest{
+			TodoID:   todo.NewTodoID(),
+			Filename: "../secret",
+			Content:  strings.NewReader("hello"),
+		}
+		useCase := &uploadAttachmentUseCase{clock: clock.System(), ids: idgen.UUIDv7()}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *attachment.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Nil(t, result)
+	})
+
+	t.Run("returns not found error before reading the content", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		req := UploadAttachmentRequest{
+			TodoID:   todoID,
+			Filename: "hello.txt",
+			Content:  strings.NewReader("hello"),
+		}
+
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(nil, &todo.NotFoundError{ID: todoID})
+				return fn(ctx)
+			})
+
+		useCase := &uploadAttachmentUseCase{
+			todoRepository: mockTodoRepo,
+			blobStore:      mockBlobStore,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		require.NotContains(t, err.Error(), "failed to execute transaction")
+		require.Nil(t, result)
+	})
+
+	t.Run("discards the blob when the attachment cannot be saved", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existingTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		req := UploadAttachmentRequest{
+			TodoID:   existingTodo.ID(),
+			Filename: "hello.txt",
+			Content:  strings.NewReader("hello"),
+		}
+
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				return fn(ctx)
+			}).Times(2)
+		mockTodoRepo.EXPECT().FindByID(ctx, existingTodo.ID()).Return(existingTodo, nil).Times(2)
+		var storedKey string
+		mockBlobStore.EXPECT().Put(ctx, mock.AnythingOfType("string"), mock.Anything).
+			RunAndReturn(func(ctx context.Context, key string, r io.Reader) error {
+				storedKey = key
+				_, err := io.Copy(io.Discard, r)
+				return err
+			})
+		mockAttachmentRepo.EXPECT().Create(ctx, mock.AnythingOfType("*attachment.Attachment")).
+			Return(errors.New("database error"))
+		mockBlobStore.EXPECT().Delete(mock.Anything, mock.AnythingOfType("string")).
+			RunAndReturn(func(ctx context.Context, key string) error {
+				require.Equal(t, storedKey, key)
+				return nil
+			})
+
+		useCase := &uploadAttachmentUseCase{
+			attachmentRepository: mockAttachmentRepo,
+			todoRepository:       mockTodoRepo,
+			blobStore:            mockBlobStore,
+			txRunner:             mockTxRunner,
+			clock:                clock.System(),
+			ids:                  idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+		require.Nil(t, result)
+	})
+
+	t.Run("returns error when the blob store fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existingTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		req := UploadAttachmentRequest{
+			TodoID:   existingTodo.ID(),
+			Filename: "hello.txt",
+			Content:  strings.NewReader("hello"),
+		}
+
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, existingTodo.ID()).Return(existingTodo, nil)
+				return fn(ctx)
+			})
+		mockBlobStore.EXPECT().Put(ctx, mock.AnythingOfType("string"), mock.Anything).
+			Return(errors.New("storage error"))
+
+		useCase := &uploadAttachmentUseCase{
+			todoRepository: mockTodoRepo,
+			blobStore:      mockBlobStore,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to store attachment content")
+		require.Nil(t, result)
+	})
+}
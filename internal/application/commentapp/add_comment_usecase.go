@@ -0,0 +1,94 @@
+// Package commentapp provides the application layer for comments on todos.
+package commentapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type AddCommentRequest struct {
+	TodoID todo.TodoID
+	Author string
+	Body   string
+}
+
+// AddCommentUseCase is the interface that wraps the basic AddComment operation.
+type AddCommentUseCase interface {
+	Execute(ctx context.Context, req AddCommentRequest) (*comment.Comment, error)
+}
+
+// addCommentUseCase is the implementation of the AddCommentUseCase interface.
+type addCommentUseCase struct {
+	commentRepository comment.CommentRepository
+	todoRepository    todo.TodoRepository
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+	ids               idgen.IDGenerator
+}
+
+// NewAddCommentUseCase creates a new AddCommentUseCase.
+func NewAddCommentUseCase(i *do.Injector) (AddCommentUseCase, error) {
+	commentRepository, err := do.Invoke[comment.CommentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke comment repository: %w", err)
+	}
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &addCommentUseCase{
+		commentRepository: commentRepository,
+		todoRepository:    todoRepository,
+		txRunner:          txRunner,
+		clock:             clk,
+		ids:               ids,
+	}, nil
+}
+
+// Execute adds a new Comment to a Todo.
+func (u addCommentUseCase) Execute(ctx context.Context, req AddCommentRequest) (*comment.Comment, error) {
+	newComment, err := comment.NewComment(u.ids, u.clock.Now(), req.TodoID, req.Author, req.Body)
+	if err != nil {
+		// Return domain error directly for proper error handling
+		return nil, err
+	}
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.todoRepository.FindByID(ctx, req.TodoID); err != nil {
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+		if err := u.commentRepository.Create(ctx, newComment); err != nil {
+			return fmt.Errorf("failed to save comment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var todoNotFoundErr *todo.NotFoundError
+		if errors.As(err, &todoNotFoundErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return newComment, nil
+}
@@ -0,0 +1,144 @@
+package commentapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_comment"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddCommentUseCase_Execute(t *testing.T) {
+	t.Run("successfully adds comment", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+		existingTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		todoID := existingTodo.ID()
+		req := AddCommentRequest{TodoID: todoID, Author: "alice", Body: "Looks good to me"}
+
+		mockCommentRepo := mock_comment.NewMockCommentRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect the todo to be checked before Create within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockCommentRepo.EXPECT().Create(ctx, mock.AnythingOfType("*comment.Comment")).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &addCommentUseCase{
+			commentRepository: mockCommentRepo,
+			todoRepository:    mockTodoRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.NewFake(now),
+			ids:               idgen.NewSequence(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "00000000-0000-7000-8000-000000000001", result.ID().String())
+		require.Equal(t, now, result.CreatedAt())
+		require.Equal(t, todoID, result.TodoID())
+		require.Equal(t, "alice", result.Author())
+		require.Equal(t, "Looks good to me", result.Body())
+	})
+
+	t.Run("returns validation error for empty body", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := AddCommentRequest{TodoID: todo.NewTodoID(), Author: "alice", Body: ""}
+
+		useCase := &addCommentUseCase{
+			commentRepository: mock_comment.NewMockCommentRepository(t),
+			todoRepository:    mock_todo.NewMockTodoRepository(t),
+			txRunner:          mock_uow.NewMockTransactionRunner(t),
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *comment.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "body", validationErr.Field)
+		require.Nil(t, result)
+	})
+
+	t.Run("returns not found error when todo does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		req := AddCommentRequest{TodoID: todoID, Author: "alice", Body: "Looks good to me"}
+
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(nil, &todo.NotFoundError{ID: todoID})
+				return fn(ctx)
+			})
+
+		useCase := &addCommentUseCase{
+			commentRepository: mock_comment.NewMockCommentRepository(t),
+			todoRepository:    mockTodoRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		require.Nil(t, result)
+	})
+
+	t.Run("returns error when transaction fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := AddCommentRequest{TodoID: todo.NewTodoID(), Author: "alice", Body: "Looks good to me"}
+		txError := errors.New("transaction error")
+
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			Return(txError)
+
+		useCase := &addCommentUseCase{
+			commentRepository: mock_comment.NewMockCommentRepository(t),
+			todoRepository:    mock_todo.NewMockTodoRepository(t),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+		require.Nil(t, result)
+	})
+}
@@ -0,0 +1,61 @@
+package commentapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type CountCommentsRequest struct {
+	TodoIDs []todo.TodoID
+}
+
+// CountCommentsUseCase is the interface that wraps the basic CountComments operation.
+type CountCommentsUseCase interface {
+	Execute(ctx context.Context, req CountCommentsRequest) (map[todo.TodoID]int, error)
+}
+
+// countCommentsUseCase is the implementation of the CountCommentsUseCase interface.
+type countCommentsUseCase struct {
+	commentRepository comment.CommentRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewCountCommentsUseCase creates a new CountCommentsUseCase.
+func NewCountCommentsUseCase(i *do.Injector) (CountCommentsUseCase, error) {
+	commentRepository, err := do.Invoke[comment.CommentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke comment repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &countCommentsUseCase{
+		commentRepository: commentRepository,
+		txRunner:          txRunner,
+	}, nil
+}
+
+// Execute counts the comments that are not deleted on each of the todos.
+// Todos without comments are left out of the result.
+func (u countCommentsUseCase) Execute(ctx context.Context, req CountCommentsRequest) (map[todo.TodoID]int, error) {
+	var counts map[todo.TodoID]int
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		counts, err = u.commentRepository.CountByTodoIDs(ctx, req.TodoIDs)
+		if err != nil {
+			return fmt.Errorf("failed to count comments: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return counts, nil
+}
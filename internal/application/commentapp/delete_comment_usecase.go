@@ -0,0 +1,77 @@
+package commentapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/samber/do"
+)
+
+type DeleteCommentRequest struct {
+	ID comment.CommentID
+}
+
+// DeleteCommentUseCase is the interface that wraps the basic DeleteComment operation.
+type DeleteCommentUseCase interface {
+	Execute(ctx context.Context, req DeleteCommentRequest) error
+}
+
+// deleteCommentUseCase is the implementation of the DeleteCommentUseCase interface.
+type deleteCommentUseCase struct {
+	commentRepository comment.CommentRepository
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+}
+
+// NewDeleteCommentUseCase creates a new DeleteCommentUseCase.
+func NewDeleteCommentUseCase(i *do.Injector) (DeleteCommentUseCase, error) {
+	commentRepository, err := do.Invoke[comment.CommentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke comment repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &deleteCommentUseCase{
+		commentRepository: commentRepository,
+		txRunner:          txRunner,
+		clock:             clk,
+	}, nil
+}
+
+// Execute deletes a Comment. The Comment stays in the discussion without its body.
+func (u deleteCommentUseCase) Execute(ctx context.Context, req DeleteCommentRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		c, err := u.commentRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find comment: %w", err)
+		}
+		if err := c.Delete(u.clock.Now()); err != nil {
+			return err
+		}
+		if err := u.commentRepository.Update(ctx, c); err != nil {
+			return fmt.Errorf("failed to update comment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var notFoundErr *comment.NotFoundError
+		var stateErr *comment.StateError
+		if errors.As(err, &notFoundErr) || errors.As(err, &stateErr) {
+			return err
+		}
+		return fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return nil
+}
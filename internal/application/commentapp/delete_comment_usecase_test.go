@@ -0,0 +1,107 @@
+package commentapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_comment"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteCommentUseCase_Execute(t *testing.T) {
+	t.Run("successfully deletes comment", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existing, err := comment.NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+		req := DeleteCommentRequest{ID: existing.ID()}
+
+		mockCommentRepo := mock_comment.NewMockCommentRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockCommentRepo.EXPECT().FindByID(ctx, existing.ID()).Return(existing, nil)
+				mockCommentRepo.EXPECT().Update(ctx, existing).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &deleteCommentUseCase{
+			commentRepository: mockCommentRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err = useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, existing.IsDeleted())
+		require.Empty(t, existing.Body())
+	})
+
+	t.Run("returns state error when comment is already deleted", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existing, err := comment.NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+		require.NoError(t, existing.Delete(time.Now()))
+		req := DeleteCommentRequest{ID: existing.ID()}
+
+		mockCommentRepo := mock_comment.NewMockCommentRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockCommentRepo.EXPECT().FindByID(ctx, existing.ID()).Return(existing, nil)
+				return fn(ctx)
+			})
+
+		useCase := &deleteCommentUseCase{
+			commentRepository: mockCommentRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err = useCase.Execute(ctx, req)
+
+		// Then
+		var stateErr *comment.StateError
+		require.ErrorAs(t, err, &stateErr)
+	})
+
+	t.Run("returns error when transaction fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := DeleteCommentRequest{ID: comment.NewCommentID()}
+		txError := errors.New("transaction error")
+
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			Return(txError)
+
+		useCase := &deleteCommentUseCase{
+			commentRepository: mock_comment.NewMockCommentRepository(t),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
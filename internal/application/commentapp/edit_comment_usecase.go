@@ -0,0 +1,81 @@
+package commentapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/samber/do"
+)
+
+type EditCommentRequest struct {
+	ID   comment.CommentID
+	Body string
+}
+
+// EditCommentUseCase is the interface that wraps the basic EditComment operation.
+type EditCommentUseCase interface {
+	Execute(ctx context.Context, req EditCommentRequest) (*comment.Comment, error)
+}
+
+// editCommentUseCase is the implementation of the EditCommentUseCase interface.
+type editCommentUseCase struct {
+	commentRepository comment.CommentRepository
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+}
+
+// NewEditCommentUseCase creates a new EditCommentUseCase.
+func NewEditCommentUseCase(i *do.Injector) (EditCommentUseCase, error) {
+	commentRepository, err := do.Invoke[comment.CommentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke comment repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &editCommentUseCase{
+		commentRepository: commentRepository,
+		txRunner:          txRunner,
+		clock:             clk,
+	}, nil
+}
+
+// Execute replaces the body of a Comment.
+func (u editCommentUseCase) Execute(ctx context.Context, req EditCommentRequest) (*comment.Comment, error) {
+	var edited *comment.Comment
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		c, err := u.commentRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find comment: %w", err)
+		}
+		if err := c.Edit(u.clock.Now(), req.Body); err != nil {
+			return err
+		}
+		if err := u.commentRepository.Update(ctx, c); err != nil {
+			return fmt.Errorf("failed to update comment: %w", err)
+		}
+		edited = c
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var notFoundErr *comment.NotFoundError
+		var validationErr *comment.ValidationError
+		var stateErr *comment.StateError
+		if errors.As(err, &notFoundErr) || errors.As(err, &validationErr) || errors.As(err, &stateErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return edited, nil
+}
@@ -0,0 +1,114 @@
+package commentapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_comment"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditCommentUseCase_Execute(t *testing.T) {
+	t.Run("successfully edits comment", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existing, err := comment.NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+		req := EditCommentRequest{ID: existing.ID(), Body: "second draft"}
+
+		mockCommentRepo := mock_comment.NewMockCommentRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockCommentRepo.EXPECT().FindByID(ctx, existing.ID()).Return(existing, nil)
+				mockCommentRepo.EXPECT().Update(ctx, existing).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &editCommentUseCase{
+			commentRepository: mockCommentRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "second draft", result.Body())
+		require.True(t, result.IsEdited())
+	})
+
+	t.Run("returns state error when comment is deleted", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existing, err := comment.NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+		require.NoError(t, existing.Delete(time.Now()))
+		req := EditCommentRequest{ID: existing.ID(), Body: "second draft"}
+
+		mockCommentRepo := mock_comment.NewMockCommentRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Update must not be called for a deleted comment
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockCommentRepo.EXPECT().FindByID(ctx, existing.ID()).Return(existing, nil)
+				return fn(ctx)
+			})
+
+		useCase := &editCommentUseCase{
+			commentRepository: mockCommentRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		var stateErr *comment.StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Nil(t, result)
+	})
+
+	t.Run("returns not found error when comment does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		commentID := comment.NewCommentID()
+		req := EditCommentRequest{ID: commentID, Body: "second draft"}
+
+		mockCommentRepo := mock_comment.NewMockCommentRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockCommentRepo.EXPECT().FindByID(ctx, commentID).Return(nil, &comment.NotFoundError{ID: commentID})
+				return fn(ctx)
+			})
+
+		useCase := &editCommentUseCase{
+			commentRepository: mockCommentRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *comment.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		require.Nil(t, result)
+	})
+}
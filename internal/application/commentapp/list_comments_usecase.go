@@ -0,0 +1,113 @@
+package commentapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+const (
+	// DefaultPageSize is the number of comments returned when PageSize is not set.
+	DefaultPageSize = 50
+	// MaxPageSize is the maximum number of comments returned at once.
+	MaxPageSize = 100
+)
+
+type ListCommentsRequest struct {
+	TodoID todo.TodoID
+	// PageSize defaults to DefaultPageSize.
+	PageSize int
+	// Offset is the number of comments to skip, usually the NextOffset of the previous page.
+	Offset int
+}
+
+type ListCommentsResult struct {
+	// Comments are ordered oldest first. Deleted comments are included without their body.
+	Comments []*comment.Comment
+	// NextOffset is the Offset of the next page, or nil on the last page.
+	NextOffset *int
+}
+
+// ListCommentsUseCase is the interface that wraps the basic ListComments operation.
+type ListCommentsUseCase interface {
+	Execute(ctx context.Context, req ListCommentsRequest) (*ListCommentsResult, error)
+}
+
+// listCommentsUseCase is the implementation of the ListCommentsUseCase interface.
+type listCommentsUseCase struct {
+	commentRepository comment.CommentRepository
+	todoRepository    todo.TodoRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewListCommentsUseCase creates a new ListCommentsUseCase.
+func NewListCommentsUseCase(i *do.Injector) (ListCommentsUseCase, error) {
+	commentRepository, err := do.Invoke[comment.CommentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke comment repository: %w", err)
+	}
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &listCommentsUseCase{
+		commentRepository: commentRepository,
+		todoRepository:    todoRepository,
+		txRunner:          txRunner,
+	}, nil
+}
+
+// Execute returns a page of the comments on a Todo.
+func (u listCommentsUseCase) Execute(ctx context.Context, req ListCommentsRequest) (*ListCommentsResult, error) {
+	if req.PageSize < 0 || req.PageSize > MaxPageSize {
+		return nil, &comment.ValidationError{
+			Field:   "page_size",
+			Message: fmt.Sprintf("page size must be between 0 and %d", MaxPageSize),
+		}
+	}
+	if req.Offset < 0 {
+		return nil, &comment.ValidationError{Field: "page_token", Message: "invalid page token"}
+	}
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	var result *ListCommentsResult
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.todoRepository.FindByID(ctx, req.TodoID); err != nil {
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+		// One extra comment tells whether there is a next page.
+		comments, err := u.commentRepository.FindByTodoID(ctx, req.TodoID, pageSize+1, req.Offset)
+		if err != nil {
+			return fmt.Errorf("failed to find comments: %w", err)
+		}
+		result = &ListCommentsResult{Comments: comments}
+		if len(comments) > pageSize {
+			result.Comments = comments[:pageSize]
+			nextOffset := req.Offset + pageSize
+			result.NextOffset = &nextOffset
+		}
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var todoNotFoundErr *todo.NotFoundError
+		if errors.As(err, &todoNotFoundErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package commentapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_comment"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newComments(t *testing.T, todoID todo.TodoID, n int) []*comment.Comment {
+	t.Helper()
+	comments := make([]*comment.Comment, n)
+	for i := range comments {
+		c, err := comment.NewComment(idgen.UUIDv7(), time.Now(), todoID, "alice", "comment")
+		require.NoError(t, err)
+		comments[i] = c
+	}
+	return comments
+}
+
+func TestListCommentsUseCase_Execute(t *testing.T) {
+	t.Run("returns the last page without next offset", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existingTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		todoID := existingTodo.ID()
+		comments := newComments(t, todoID, 2)
+		req := ListCommentsRequest{TodoID: todoID}
+
+		mockCommentRepo := mock_comment.NewMockCommentRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// One more comment than the page size is requested
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockCommentRepo.EXPECT().FindByTodoID(ctx, todoID, DefaultPageSize+1, 0).Return(comments, nil)
+				return fn(ctx)
+			})
+
+		useCase := &listCommentsUseCase{
+			commentRepository: mockCommentRepo,
+			todoRepository:    mockTodoRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, comments, result.Comments)
+		require.Nil(t, result.NextOffset)
+	})
+
+	t.Run("returns next offset when there are more comments", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existingTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		todoID := existingTodo.ID()
+		comments := newComments(t, todoID, 3)
+		req := ListCommentsRequest{TodoID: todoID, PageSize: 2, Offset: 4}
+
+		mockCommentRepo := mock_comment.NewMockCommentRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockCommentRepo.EXPECT().FindByTodoID(ctx, todoID, 3, 4).Return(comments, nil)
+				return fn(ctx)
+			})
+
+		useCase := &listCommentsUseCase{
+			commentRepository: mockCommentRepo,
+			todoRepository:    mockTodoRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, comments[:2], result.Comments)
+		require.NotNil(t, result.NextOffset)
+		require.Equal(t, 6, *result.NextOffset)
+	})
+
+	t.Run("returns validation error for too large page size", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := ListCommentsRequest{TodoID: todo.NewTodoID(), PageSize: MaxPageSize + 1}
+
+		useCase := &listCommentsUseCase{
+			commentRepository: mock_comment.NewMockCommentRepository(t),
+			todoRepository:    mock_todo.NewMockTodoRepository(t),
+			txRunner:          mock_uow.NewMockTransactionRunner(t),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *comment.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "page_size", validationErr.Field)
+		require.Nil(t, result)
+	})
+
+	t.Run("returns not found error when todo does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		req := ListCommentsRequest{TodoID: todoID}
+
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(nil, &todo.NotFoundError{ID: todoID})
+				return fn(ctx)
+			})
+
+		useCase := &listCommentsUseCase{
+			commentRepository: mock_comment.NewMockCommentRepository(t),
+			todoRepository:    mockTodoRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		require.Nil(t, result)
+	})
+}
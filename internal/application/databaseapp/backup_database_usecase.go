@@ -0,0 +1,47 @@
+package databaseapp
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/samber/do"
+)
+
+// Backuper writes consistent snapshots of the database while it is in use.
+type Backuper interface {
+	Backup(ctx context.Context, w io.Writer) error
+}
+
+type BackupDatabaseRequest struct {
+	// Output receives the snapshot.
+	Output io.Writer
+}
+
+// BackupDatabaseUseCase is the interface that wraps the basic BackupDatabase operation.
+type BackupDatabaseUseCase interface {
+	Execute(ctx context.Context, req BackupDatabaseRequest) error
+}
+
+// backupDatabaseUseCase is the implementation of the BackupDatabaseUseCase interface.
+type backupDatabaseUseCase struct {
+	backuper Backuper
+}
+
+// NewBackupDatabaseUseCase creates a new BackupDatabaseUseCase.
+func NewBackupDatabaseUseCase(i *do.Injector) (BackupDatabaseUseCase, error) {
+	backuper, err := do.Invoke[Backuper](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke backuper: %w", err)
+	}
+	return &backupDatabaseUseCase{backuper: backuper}, nil
+}
+
+// Execute writes a snapshot of the database to the output.
+// It does not run in a transaction, as the snapshot is consistent by itself.
+func (u backupDatabaseUseCase) Execute(ctx context.Context, req BackupDatabaseRequest) error {
+	if err := u.backuper.Backup(ctx, req.Output); err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+	return nil
+}
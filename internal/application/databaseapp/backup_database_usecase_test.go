@@ -0,0 +1,55 @@
+package databaseapp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBackuper writes its snapshot, or fails with its error.
+type fakeBackuper struct {
+	snapshot string
+	err      error
+}
+
+func (b fakeBackuper) Backup(ctx context.Context, w io.Writer) error {
+	if b.err != nil {
+		return b.err
+	}
+	_, err := io.WriteString(w, b.snapshot)
+	return err
+}
+
+func TestBackupDatabaseUseCase_Execute(t *testing.T) {
+	t.Run("writes the snapshot to the output", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		var output bytes.Buffer
+		useCase := &backupDatabaseUseCase{backuper: fakeBackuper{snapshot: "SQLite format 3\x00"}}
+
+		// When
+		err := useCase.Execute(ctx, BackupDatabaseRequest{Output: &output})
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "SQLite format 3\x00", output.String())
+	})
+
+	t.Run("returns the error of the backup", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		backupErr := errors.New("disk full")
+		useCase := &backupDatabaseUseCase{backuper: fakeBackuper{err: backupErr}}
+
+		// When
+		err := useCase.Execute(ctx, BackupDatabaseRequest{Output: io.Discard})
+
+		// Then
+		assert.ErrorIs(t, err, backupErr)
+	})
+}
@@ -0,0 +1,131 @@
+// Package historyapp provides the application layer for the change history of todos.
+package historyapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+const (
+	// DefaultPageSize is the number of history entries returned when PageSize is not set.
+	DefaultPageSize = 50
+	// MaxPageSize is the maximum number of history entries returned at once.
+	MaxPageSize = 100
+)
+
+type GetTodoHistoryRequest struct {
+	TodoID todo.TodoID
+	// PageSize defaults to DefaultPageSize.
+	PageSize int
+	// Offset is the number of entries to skip, usually the NextOffset of the previous page.
+	Offset int
+}
+
+type HistoryPage struct {
+	Entries []*history.HistoryEntry
+	// NextOffset is the Offset of the next page, or nil on the last page.
+	NextOffset *int
+}
+
+// GetTodoHistoryUseCase is the interface that wraps the basic GetTodoHistory operation.
+type GetTodoHistoryUseCase interface {
+	Execute(ctx context.Context, req GetTodoHistoryRequest) (*HistoryPage, error)
+}
+
+// getTodoHistoryUseCase is the implementation of the GetTodoHistoryUseCase interface.
+type getTodoHistoryUseCase struct {
+	historyRepository history.HistoryRepository
+	todoRepository    todo.TodoRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewGetTodoHistoryUseCase creates a new GetTodoHistoryUseCase.
+func NewGetTodoHistoryUseCase(i *do.Injector) (GetTodoHistoryUseCase, error) {
+	historyRepository, err := do.Invoke[history.HistoryRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke history repository: %w", err)
+	}
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &getTodoHistoryUseCase{
+		historyRepository: historyRepository,
+		todoRepository:    todoRepository,
+		txRunner:          txRunner,
+	}, nil
+}
+
+// Execute returns a page of the history of a Todo, oldest first.
+// The history of a deleted Todo is still returned.
+func (u getTodoHistoryUseCase) Execute(ctx context.Context, req GetTodoHistoryRequest) (*HistoryPage, error) {
+	pageSize, err := validatePage(req.PageSize, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *HistoryPage
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		// One extra entry tells whether there is a next page.
+		entries, err := u.historyRepository.FindByTodoID(ctx, req.TodoID, pageSize+1, req.Offset)
+		if err != nil {
+			return fmt.Errorf("failed to find history: %w", err)
+		}
+		// A todo without any history either never existed or predates the history.
+		if len(entries) == 0 && req.Offset == 0 {
+			if _, err := u.todoRepository.FindByID(ctx, req.TodoID); err != nil {
+				return fmt.Errorf("failed to find todo: %w", err)
+			}
+		}
+		result = newHistoryPage(entries, pageSize, req.Offset)
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var todoNotFoundErr *todo.NotFoundError
+		if errors.As(err, &todoNotFoundErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return result, nil
+}
+
+// validatePage checks the paging parameters and returns the page size to use.
+func validatePage(pageSize int, offset int) (int, error) {
+	if pageSize < 0 || pageSize > MaxPageSize {
+		return 0, &history.ValidationError{
+			Field:   "page_size",
+			Message: fmt.Sprintf("page size must be between 0 and %d", MaxPageSize),
+		}
+	}
+	if offset < 0 {
+		return 0, &history.ValidationError{Field: "page_token", Message: "invalid page token"}
+	}
+	if pageSize == 0 {
+		return DefaultPageSize, nil
+	}
+	return pageSize, nil
+}
+
+// newHistoryPage cuts the entries fetched with one extra entry down to a page.
+func newHistoryPage(entries []*history.HistoryEntry, pageSize int, offset int) *HistoryPage {
+	page := &HistoryPage{Entries: entries}
+	if len(entries) > pageSize {
+		page.Entries = entries[:pageSize]
+		nextOffset := offset + pageSize
+		page.NextOffset = &nextOffset
+	}
+	return page
+}
@@ -0,0 +1,172 @@
+package historyapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_history"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newEntries returns n history entries of title changes.
+func newEntries(t *testing.T, n int) []*history.HistoryEntry {
+	t.Helper()
+	entries := make([]*history.HistoryEntry, n)
+	for i := range entries {
+		before, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Before", "")
+		require.NoError(t, err)
+		after := *before
+		require.NoError(t, after.SetTitle(time.Now(), "After"))
+		entries[i] = history.TodoUpdated(idgen.UUIDv7(), time.Now(), "alice", before, &after)
+	}
+	return entries
+}
+
+func TestGetTodoHistoryUseCase_Execute(t *testing.T) {
+	t.Run("returns the first page with the next offset", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		entries := newEntries(t, 3)
+
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockHistoryRepo.EXPECT().FindByTodoID(ctx, todoID, 3, 0).Return(entries, nil)
+				return fn(ctx)
+			})
+
+		useCase := &getTodoHistoryUseCase{
+			historyRepository: mockHistoryRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, GetTodoHistoryRequest{TodoID: todoID, PageSize: 2})
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, entries[:2], result.Entries)
+		require.NotNil(t, result.NextOffset)
+		require.Equal(t, 2, *result.NextOffset)
+	})
+
+	t.Run("returns an empty history of a todo that predates the history", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existingTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Old todo", "")
+		require.NoError(t, err)
+
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockHistoryRepo.EXPECT().FindByTodoID(ctx, existingTodo.ID(), DefaultPageSize+1, 0).Return(nil, nil)
+				mockTodoRepo.EXPECT().FindByID(ctx, existingTodo.ID()).Return(existingTodo, nil)
+				return fn(ctx)
+			})
+
+		useCase := &getTodoHistoryUseCase{
+			historyRepository: mockHistoryRepo,
+			todoRepository:    mockTodoRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, GetTodoHistoryRequest{TodoID: existingTodo.ID()})
+
+		// Then
+		require.NoError(t, err)
+		require.Empty(t, result.Entries)
+		require.Nil(t, result.NextOffset)
+	})
+
+	t.Run("returns not found error for an unknown todo", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockHistoryRepo.EXPECT().FindByTodoID(ctx, todoID, DefaultPageSize+1, 0).Return(nil, nil)
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(nil, &todo.NotFoundError{ID: todoID})
+				return fn(ctx)
+			})
+
+		useCase := &getTodoHistoryUseCase{
+			historyRepository: mockHistoryRepo,
+			todoRepository:    mockTodoRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, GetTodoHistoryRequest{TodoID: todoID})
+
+		// Then
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		require.Nil(t, result)
+	})
+
+	t.Run("returns validation error for a too large page size", func(t *testing.T) {
+		// Given
+		useCase := &getTodoHistoryUseCase{}
+
+		// When
+		result, err := useCase.Execute(context.Background(), GetTodoHistoryRequest{
+			TodoID:   todo.NewTodoID(),
+			PageSize: MaxPageSize + 1,
+		})
+
+		// Then
+		var validationErr *history.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Nil(t, result)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockHistoryRepo.EXPECT().FindByTodoID(ctx, todoID, DefaultPageSize+1, 0).
+					Return(nil, errors.New("database error"))
+				return fn(ctx)
+			})
+
+		useCase := &getTodoHistoryUseCase{
+			historyRepository: mockHistoryRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, GetTodoHistoryRequest{TodoID: todoID})
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+		require.Nil(t, result)
+	})
+}
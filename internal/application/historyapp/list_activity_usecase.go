@@ -0,0 +1,85 @@
+package historyapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/samber/do"
+)
+
+type ListActivityRequest struct {
+	ProjectID project.ProjectID
+	// PageSize defaults to DefaultPageSize.
+	PageSize int
+	// Offset is the number of entries to skip, usually the NextOffset of the previous page.
+	Offset int
+}
+
+// ListActivityUseCase is the interface that wraps the basic ListActivity operation.
+type ListActivityUseCase interface {
+	Execute(ctx context.Context, req ListActivityRequest) (*HistoryPage, error)
+}
+
+// listActivityUseCase is the implementation of the ListActivityUseCase interface.
+type listActivityUseCase struct {
+	historyRepository history.HistoryRepository
+	projectRepository project.ProjectRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewListActivityUseCase creates a new ListActivityUseCase.
+func NewListActivityUseCase(i *do.Injector) (ListActivityUseCase, error) {
+	historyRepository, err := do.Invoke[history.HistoryRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke history repository: %w", err)
+	}
+	projectRepository, err := do.Invoke[project.ProjectRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke project repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &listActivityUseCase{
+		historyRepository: historyRepository,
+		projectRepository: projectRepository,
+		txRunner:          txRunner,
+	}, nil
+}
+
+// Execute returns a page of the history of the todos of a Project, newest first.
+func (u listActivityUseCase) Execute(ctx context.Context, req ListActivityRequest) (*HistoryPage, error) {
+	pageSize, err := validatePage(req.PageSize, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *HistoryPage
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.projectRepository.FindByID(ctx, req.ProjectID); err != nil {
+			return fmt.Errorf("failed to find project: %w", err)
+		}
+		// One extra entry tells whether there is a next page.
+		entries, err := u.historyRepository.FindByProjectID(ctx, req.ProjectID, pageSize+1, req.Offset)
+		if err != nil {
+			return fmt.Errorf("failed to find activity: %w", err)
+		}
+		result = newHistoryPage(entries, pageSize, req.Offset)
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var projectNotFoundErr *project.NotFoundError
+		if errors.As(err, &projectNotFoundErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package historyapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_history"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_project"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListActivityUseCase_Execute(t *testing.T) {
+	t.Run("returns the last page of the activity", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		existingProject, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Launch", nil)
+		require.NoError(t, err)
+		entries := newEntries(t, 2)
+
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockProjectRepo.EXPECT().FindByID(ctx, existingProject.ID()).Return(existingProject, nil)
+				mockHistoryRepo.EXPECT().FindByProjectID(ctx, existingProject.ID(), 3, 2).Return(entries, nil)
+				return fn(ctx)
+			})
+
+		useCase := &listActivityUseCase{
+			historyRepository: mockHistoryRepo,
+			projectRepository: mockProjectRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, ListActivityRequest{
+			ProjectID: existingProject.ID(),
+			PageSize:  2,
+			Offset:    2,
+		})
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, entries, result.Entries)
+		require.Nil(t, result.NextOffset)
+	})
+
+	t.Run("returns not found error for an unknown project", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		projectID := project.NewProjectID()
+
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockProjectRepo.EXPECT().FindByID(ctx, projectID).Return(nil, &project.NotFoundError{ID: projectID})
+				return fn(ctx)
+			})
+
+		useCase := &listActivityUseCase{
+			projectRepository: mockProjectRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, ListActivityRequest{ProjectID: projectID})
+
+		// Then
+		var notFoundErr *project.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		require.Nil(t, result)
+	})
+}
@@ -0,0 +1,100 @@
+// Package projectapp provides the application layer for projects.
+package projectapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/samber/do"
+)
+
+type CreateProjectRequest struct {
+	Name string
+	// Statuses and Transitions define the workflow of the project.
+	// When Statuses is empty the project gets the default workflow.
+	Statuses    []project.WorkflowStatus
+	Transitions []project.Transition
+}
+
+// CreateProjectUseCase is the interface that wraps the basic CreateProject operation.
+type CreateProjectUseCase interface {
+	Execute(ctx context.Context, req CreateProjectRequest) (*project.Project, error)
+}
+
+// createProjectUseCase is the implementation of the CreateProjectUseCase interface.
+type createProjectUseCase struct {
+	projectRepository project.ProjectRepository
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+	ids               idgen.IDGenerator
+}
+
+// NewCreateProjectUseCase creates a new CreateProjectUseCase.
+func NewCreateProjectUseCase(i *do.Injector) (CreateProjectUseCase, error) {
+	projectRepository, err := do.Invoke[project.ProjectRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke project repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &createProjectUseCase{
+		projectRepository: projectRepository,
+		txRunner:          txRunner,
+		clock:             clk,
+		ids:               ids,
+	}, nil
+}
+
+// Execute creates a new Project with its workflow.
+func (u createProjectUseCase) Execute(ctx context.Context, req CreateProjectRequest) (*project.Project, error) {
+	var workflow *project.Workflow
+	if len(req.Statuses) > 0 {
+		w, err := project.NewWorkflow(req.Statuses, req.Transitions)
+		if err != nil {
+			// Return domain error directly for proper error handling
+			return nil, err
+		}
+		workflow = w
+	} else if len(req.Transitions) > 0 {
+		return nil, &project.ValidationError{
+			Field:   "transitions",
+			Message: "transitions require statuses",
+		}
+	}
+	newProject, err := project.NewProject(u.ids, u.clock.Now(), req.Name, workflow)
+	if err != nil {
+		// Return domain error directly for proper error handling
+		return nil, err
+	}
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.projectRepository.Create(ctx, newProject); err != nil {
+			return fmt.Errorf("failed to save project: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var validationErr *project.ValidationError
+		if errors.As(err, &validationErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return newProject, nil
+}
@@ -0,0 +1,178 @@
+package projectapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_project"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateProjectUseCase_Execute(t *testing.T) {
+	t.Run("successfully creates project with a custom workflow", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+		req := CreateProjectRequest{
+			Name: "Platform",
+			Statuses: []project.WorkflowStatus{
+				{ID: "backlog", Name: "Backlog", Category: project.StatusCategoryTodo},
+				{ID: "review", Name: "Review", Category: project.StatusCategoryDoing},
+				{ID: "shipped", Name: "Shipped", Category: project.StatusCategoryDone},
+			},
+			Transitions: []project.Transition{
+				{From: "backlog", To: "review"},
+				{From: "review", To: "shipped"},
+			},
+		}
+
+		mockRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect Create to be called within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*project.Project")).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &createProjectUseCase{
+			projectRepository: mockRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.NewFake(now),
+			ids:               idgen.NewSequence(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "00000000-0000-7000-8000-000000000001", result.ID().String())
+		require.Equal(t, now, result.CreatedAt())
+		require.Equal(t, "Platform", result.Name())
+		require.Equal(t, req.Statuses, result.Workflow().Statuses())
+		require.Equal(t, req.Transitions, result.Workflow().Transitions())
+	})
+
+	t.Run("creates project with the default workflow when no statuses are given", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := CreateProjectRequest{Name: "Platform"}
+
+		mockRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*project.Project")).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &createProjectUseCase{
+			projectRepository: mockRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, project.DefaultWorkflow(), result.Workflow())
+	})
+
+	t.Run("returns validation error for an invalid workflow", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := CreateProjectRequest{
+			Name: "Platform",
+			Statuses: []project.WorkflowStatus{
+				{ID: "todo", Name: "To Do", Category: project.StatusCategoryTodo},
+			},
+		}
+
+		mockRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		useCase := &createProjectUseCase{
+			projectRepository: mockRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *project.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "statuses", validationErr.Field)
+		require.Nil(t, result)
+	})
+
+	t.Run("returns validation error for transitions without statuses", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := CreateProjectRequest{
+			Name:        "Platform",
+			Transitions: []project.Transition{{From: "todo", To: "done"}},
+		}
+
+		useCase := &createProjectUseCase{
+			projectRepository: mock_project.NewMockProjectRepository(t),
+			txRunner:          mock_uow.NewMockTransactionRunner(t),
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		_, err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *project.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "transitions", validationErr.Field)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := CreateProjectRequest{Name: "Platform"}
+		repoError := errors.New("repository error")
+
+		mockRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*project.Project")).Return(repoError)
+				return fn(ctx)
+			})
+
+		useCase := &createProjectUseCase{
+			projectRepository: mockRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		_, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
@@ -0,0 +1,83 @@
+package projectapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type DeleteProjectRequest struct {
+	ID project.ProjectID
+}
+
+// DeleteProjectUseCase is the interface that wraps the basic DeleteProject operation.
+type DeleteProjectUseCase interface {
+	Execute(ctx context.Context, req DeleteProjectRequest) error
+}
+
+// deleteProjectUseCase is the implementation of the DeleteProjectUseCase interface.
+type deleteProjectUseCase struct {
+	projectRepository project.ProjectRepository
+	todoRepository    todo.TodoRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewDeleteProjectUseCase creates a new DeleteProjectUseCase.
+func NewDeleteProjectUseCase(i *do.Injector) (DeleteProjectUseCase, error) {
+	projectRepository, err := do.Invoke[project.ProjectRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke project repository: %w", err)
+	}
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &deleteProjectUseCase{
+		projectRepository: projectRepository,
+		todoRepository:    todoRepository,
+		txRunner:          txRunner,
+	}, nil
+}
+
+// Execute deletes a Project by its ID. Projects that still have todos cannot be deleted.
+func (u deleteProjectUseCase) Execute(ctx context.Context, req DeleteProjectRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.projectRepository.FindByID(ctx, req.ID); err != nil {
+			return fmt.Errorf("failed to find project: %w", err)
+		}
+		todos, err := u.todoRepository.FindAll(ctx, todo.TodoFilter{ProjectID: &req.ID})
+		if err != nil {
+			return fmt.Errorf("failed to find todos: %w", err)
+		}
+		if len(todos) > 0 {
+			return errProjectHasTodos
+		}
+		if err := u.projectRepository.Delete(ctx, req.ID); err != nil {
+			return fmt.Errorf("failed to delete project: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var notFoundErr *project.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return err
+		}
+		var validationErr *project.ValidationError
+		if errors.As(err, &validationErr) {
+			return err
+		}
+		return fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package projectapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_project"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteProjectUseCase_Execute(t *testing.T) {
+	t.Run("successfully deletes project without todos", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		p, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Platform", nil)
+		require.NoError(t, err)
+		projectID := p.ID()
+		req := DeleteProjectRequest{ID: projectID}
+
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect todos to be checked before Delete within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockProjectRepo.EXPECT().FindByID(ctx, projectID).Return(p, nil)
+				mockTodoRepo.EXPECT().FindAll(ctx, todo.TodoFilter{ProjectID: &projectID}).Return(nil, nil)
+				mockProjectRepo.EXPECT().Delete(ctx, projectID).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &deleteProjectUseCase{
+			projectRepository: mockProjectRepo,
+			todoRepository:    mockTodoRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		err = useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+	})
+
+	t.Run("returns validation error when project still has todos", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		p, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Platform", nil)
+		require.NoError(t, err)
+		projectID := p.ID()
+		req := DeleteProjectRequest{ID: projectID}
+		existingTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Delete must not be called while todos remain
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockProjectRepo.EXPECT().FindByID(ctx, projectID).Return(p, nil)
+				mockTodoRepo.EXPECT().FindAll(ctx, todo.TodoFilter{ProjectID: &projectID}).Return([]*todo.Todo{existingTodo}, nil)
+				return fn(ctx)
+			})
+
+		useCase := &deleteProjectUseCase{
+			projectRepository: mockProjectRepo,
+			todoRepository:    mockTodoRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		err = useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *project.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "project still has todos", validationErr.Message)
+	})
+
+	t.Run("returns not found error when project does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		projectID := project.NewProjectID()
+		req := DeleteProjectRequest{ID: projectID}
+
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockProjectRepo.EXPECT().FindByID(ctx, projectID).Return(nil, &project.NotFoundError{ID: projectID})
+				return fn(ctx)
+			})
+
+		useCase := &deleteProjectUseCase{
+			projectRepository: mockProjectRepo,
+			todoRepository:    mock_todo.NewMockTodoRepository(t),
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *project.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("returns error when transaction fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := DeleteProjectRequest{ID: project.NewProjectID()}
+		txError := errors.New("transaction error")
+
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			Return(txError)
+
+		useCase := &deleteProjectUseCase{
+			projectRepository: mock_project.NewMockProjectRepository(t),
+			todoRepository:    mock_todo.NewMockTodoRepository(t),
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
@@ -0,0 +1,9 @@
+package projectapp
+
+import "github.com/iktakahiro/oniongo/internal/domain/project"
+
+// errProjectHasTodos is returned when a project that still has todos is deleted.
+var errProjectHasTodos = &project.ValidationError{
+	Field:   "id",
+	Message: "project still has todos",
+}
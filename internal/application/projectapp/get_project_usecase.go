@@ -0,0 +1,65 @@
+package projectapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/samber/do"
+)
+
+type GetProjectRequest struct {
+	ID project.ProjectID
+}
+
+// GetProjectUseCase is the interface that wraps the basic GetProject operation.
+type GetProjectUseCase interface {
+	Execute(ctx context.Context, req GetProjectRequest) (*project.Project, error)
+}
+
+// getProjectUseCase is the implementation of the GetProjectUseCase interface.
+type getProjectUseCase struct {
+	projectRepository project.ProjectRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewGetProjectUseCase creates a new GetProjectUseCase.
+func NewGetProjectUseCase(i *do.Injector) (GetProjectUseCase, error) {
+	projectRepository, err := do.Invoke[project.ProjectRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke project repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &getProjectUseCase{
+		projectRepository: projectRepository,
+		txRunner:          txRunner,
+	}, nil
+}
+
+// Execute finds a Project by its ID.
+func (u getProjectUseCase) Execute(ctx context.Context, req GetProjectRequest) (*project.Project, error) {
+	var result *project.Project
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		p, err := u.projectRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find project: %w", err)
+		}
+		result = p
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var notFoundErr *project.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return result, nil
+}
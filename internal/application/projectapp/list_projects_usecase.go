@@ -0,0 +1,57 @@
+package projectapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/samber/do"
+)
+
+type ListProjectsRequest struct{}
+
+// ListProjectsUseCase is the interface that wraps the basic ListProjects operation.
+type ListProjectsUseCase interface {
+	Execute(ctx context.Context, req ListProjectsRequest) ([]*project.Project, error)
+}
+
+// listProjectsUseCase is the implementation of the ListProjectsUseCase interface.
+type listProjectsUseCase struct {
+	projectRepository project.ProjectRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewListProjectsUseCase creates a new ListProjectsUseCase.
+func NewListProjectsUseCase(i *do.Injector) (ListProjectsUseCase, error) {
+	projectRepository, err := do.Invoke[project.ProjectRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke project repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &listProjectsUseCase{
+		projectRepository: projectRepository,
+		txRunner:          txRunner,
+	}, nil
+}
+
+// Execute finds all projects.
+func (u listProjectsUseCase) Execute(ctx context.Context, req ListProjectsRequest) ([]*project.Project, error) {
+	var result []*project.Project
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		projects, err := u.projectRepository.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to find projects: %w", err)
+		}
+		result = projects
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return result, nil
+}
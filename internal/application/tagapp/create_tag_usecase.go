@@ -0,0 +1,90 @@
+// Package tagapp provides the application layer for tags.
+package tagapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/samber/do"
+)
+
+type CreateTagRequest struct {
+	Name  string
+	Color string
+}
+
+// CreateTagUseCase is the interface that wraps the basic CreateTag operation.
+type CreateTagUseCase interface {
+	Execute(ctx context.Context, req CreateTagRequest) (*tag.Tag, error)
+}
+
+// createTagUseCase is the implementation of the CreateTagUseCase interface.
+type createTagUseCase struct {
+	tagRepository tag.TagRepository
+	txRunner      uow.TransactionRunner
+	clock         clock.Clock
+	ids           idgen.IDGenerator
+}
+
+// NewCreateTagUseCase creates a new CreateTagUseCase.
+func NewCreateTagUseCase(i *do.Injector) (CreateTagUseCase, error) {
+	tagRepository, err := do.Invoke[tag.TagRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke tag repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &createTagUseCase{
+		tagRepository: tagRepository,
+		txRunner:      txRunner,
+		clock:         clk,
+		ids:           ids,
+	}, nil
+}
+
+// Execute creates a new Tag. Tag names must be unique.
+func (u createTagUseCase) Execute(ctx context.Context, req CreateTagRequest) (*tag.Tag, error) {
+	newTag, err := tag.NewTag(u.ids, u.clock.Now(), req.Name, req.Color)
+	if err != nil {
+		// Return domain error directly for proper error handling
+		return nil, err
+	}
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		exists, err := u.tagRepository.ExistsByName(ctx, newTag.Name())
+		if err != nil {
+			return fmt.Errorf("failed to check tag name: %w", err)
+		}
+		if exists {
+			return errNameAlreadyExists
+		}
+		if err := u.tagRepository.Create(ctx, newTag); err != nil {
+			return fmt.Errorf("failed to save tag: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var validationErr *tag.ValidationError
+		if errors.As(err, &validationErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return newTag, nil
+}
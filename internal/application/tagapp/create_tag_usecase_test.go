@@ -0,0 +1,150 @@
+package tagapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_tag"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTagUseCase_Execute(t *testing.T) {
+	t.Run("successfully creates tag", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+		req := CreateTagRequest{
+			Name:  "backend",
+			Color: "#1e90ff",
+		}
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect name check and Create to be called within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().ExistsByName(ctx, "backend").Return(false, nil)
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*tag.Tag")).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &createTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+			clock:         clock.NewFake(now),
+			ids:           idgen.NewSequence(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "00000000-0000-7000-8000-000000000001", result.ID().String())
+		require.Equal(t, "backend", result.Name())
+		require.Equal(t, "#1e90ff", result.Color())
+		require.Equal(t, now, result.CreatedAt())
+		require.Equal(t, now, result.UpdatedAt())
+	})
+
+	t.Run("returns error when name already exists", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := CreateTagRequest{Name: "backend"}
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Name check finds a duplicate within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().ExistsByName(ctx, "backend").Return(true, nil)
+				return fn(ctx)
+			})
+
+		useCase := &createTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+			clock:         clock.System(),
+			ids:           idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Nil(t, result)
+		require.Contains(t, err.Error(), "tag name already exists")
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := CreateTagRequest{Name: "backend"}
+		repoError := errors.New("repository error")
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Repository error occurs within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().ExistsByName(ctx, "backend").Return(false, nil)
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*tag.Tag")).Return(repoError)
+				return fn(ctx)
+			})
+
+		useCase := &createTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+			clock:         clock.System(),
+			ids:           idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Nil(t, result)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+
+	t.Run("returns error when tag creation fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := CreateTagRequest{
+			Name:  "", // Empty name should cause domain error
+			Color: "#1e90ff",
+		}
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		useCase := &createTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+			clock:         clock.System(),
+			ids:           idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Nil(t, result)
+		require.Contains(t, err.Error(), "name is required")
+		// Transaction should not be called when tag creation fails
+		mockTxRunner.AssertNotCalled(t, "RunInTx")
+	})
+}
@@ -0,0 +1,62 @@
+package tagapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/samber/do"
+)
+
+type DeleteTagRequest struct {
+	ID tag.TagID
+}
+
+// DeleteTagUseCase is the interface that wraps the basic DeleteTag operation.
+type DeleteTagUseCase interface {
+	Execute(ctx context.Context, req DeleteTagRequest) error
+}
+
+// deleteTagUseCase is the implementation of the DeleteTagUseCase interface.
+type deleteTagUseCase struct {
+	tagRepository tag.TagRepository
+	txRunner      uow.TransactionRunner
+}
+
+// NewDeleteTagUseCase creates a new DeleteTagUseCase.
+func NewDeleteTagUseCase(i *do.Injector) (DeleteTagUseCase, error) {
+	tagRepository, err := do.Invoke[tag.TagRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke tag repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &deleteTagUseCase{
+		tagRepository: tagRepository,
+		txRunner:      txRunner,
+	}, nil
+}
+
+// Execute deletes a Tag by its ID and detaches it from all todos.
+func (u deleteTagUseCase) Execute(ctx context.Context, req DeleteTagRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.tagRepository.Delete(ctx, req.ID); err != nil {
+			return fmt.Errorf("failed to delete tag: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var notFoundErr *tag.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return err
+		}
+		return fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return nil
+}
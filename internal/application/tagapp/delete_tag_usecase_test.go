@@ -0,0 +1,101 @@
+package tagapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_tag"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteTagUseCase_Execute(t *testing.T) {
+	t.Run("successfully deletes tag", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		tagID := tag.TagID(uuid.New())
+		req := DeleteTagRequest{ID: tagID}
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect repository Delete to be called within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Delete(ctx, tagID).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &deleteTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+	})
+
+	t.Run("returns not found error when tag does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		tagID := tag.TagID(uuid.New())
+		req := DeleteTagRequest{ID: tagID}
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Repository reports a missing tag within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Delete(ctx, tagID).Return(&tag.NotFoundError{ID: tagID})
+				return fn(ctx)
+			})
+
+		useCase := &deleteTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *tag.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("returns error when transaction fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		tagID := tag.TagID(uuid.New())
+		req := DeleteTagRequest{ID: tagID}
+		txError := errors.New("transaction error")
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Transaction itself fails
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			Return(txError)
+
+		useCase := &deleteTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
@@ -0,0 +1,9 @@
+package tagapp
+
+import "github.com/iktakahiro/oniongo/internal/domain/tag"
+
+// errNameAlreadyExists is returned when another tag already uses the name.
+var errNameAlreadyExists = &tag.ValidationError{
+	Field:   "name",
+	Message: "tag name already exists",
+}
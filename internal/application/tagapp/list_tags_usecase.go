@@ -0,0 +1,57 @@
+package tagapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/samber/do"
+)
+
+type ListTagsRequest struct{}
+
+// ListTagsUseCase is the interface that wraps the basic ListTags operation.
+type ListTagsUseCase interface {
+	Execute(ctx context.Context, req ListTagsRequest) ([]*tag.Tag, error)
+}
+
+// listTagsUseCase is the implementation of the ListTagsUseCase interface.
+type listTagsUseCase struct {
+	tagRepository tag.TagRepository
+	txRunner      uow.TransactionRunner
+}
+
+// NewListTagsUseCase creates a new ListTagsUseCase.
+func NewListTagsUseCase(i *do.Injector) (ListTagsUseCase, error) {
+	tagRepository, err := do.Invoke[tag.TagRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke tag repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &listTagsUseCase{
+		tagRepository: tagRepository,
+		txRunner:      txRunner,
+	}, nil
+}
+
+// Execute finds all tags.
+func (u listTagsUseCase) Execute(ctx context.Context, req ListTagsRequest) ([]*tag.Tag, error) {
+	var result []*tag.Tag
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		tags, err := u.tagRepository.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to find tags: %w", err)
+		}
+		result = tags
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return result, nil
+}
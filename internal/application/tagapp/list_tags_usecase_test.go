@@ -0,0 +1,78 @@
+package tagapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_tag"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTagsUseCase_Execute(t *testing.T) {
+	t.Run("successfully retrieves tags", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		expectedTags := []*tag.Tag{
+			tag.ReconstructTag(uuid.New(), "backend", "#1e90ff", time.Now(), time.Now()),
+			tag.ReconstructTag(uuid.New(), "frontend", "#ff6347", time.Now(), time.Now()),
+		}
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect repository FindAll to be called within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindAll(ctx).Return(expectedTags, nil)
+				return fn(ctx)
+			})
+
+		useCase := &listTagsUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, ListTagsRequest{})
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, expectedTags, result)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		repoError := errors.New("repository error")
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Repository error occurs within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindAll(ctx).Return(nil, repoError)
+				return fn(ctx)
+			})
+
+		useCase := &listTagsUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, ListTagsRequest{})
+
+		// Then
+		require.Error(t, err)
+		require.Nil(t, result)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
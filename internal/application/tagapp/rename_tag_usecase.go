@@ -0,0 +1,91 @@
+package tagapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/samber/do"
+)
+
+type RenameTagRequest struct {
+	ID   tag.TagID
+	Name string
+}
+
+// RenameTagUseCase is the interface that wraps the basic RenameTag operation.
+type RenameTagUseCase interface {
+	Execute(ctx context.Context, req RenameTagRequest) error
+}
+
+// renameTagUseCase is the implementation of the RenameTagUseCase interface.
+type renameTagUseCase struct {
+	tagRepository tag.TagRepository
+	txRunner      uow.TransactionRunner
+	clock         clock.Clock
+}
+
+// NewRenameTagUseCase creates a new RenameTagUseCase.
+func NewRenameTagUseCase(i *do.Injector) (RenameTagUseCase, error) {
+	tagRepository, err := do.Invoke[tag.TagRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke tag repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &renameTagUseCase{
+		tagRepository: tagRepository,
+		txRunner:      txRunner,
+		clock:         clk,
+	}, nil
+}
+
+// Execute renames a Tag. The new name must not be used by another tag.
+func (u *renameTagUseCase) Execute(ctx context.Context, req RenameTagRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTag, err := u.tagRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+		if foundTag.Name() == req.Name {
+			return nil
+		}
+
+		exists, err := u.tagRepository.ExistsByName(ctx, req.Name)
+		if err != nil {
+			return fmt.Errorf("failed to check tag name: %w", err)
+		}
+		if exists {
+			return errNameAlreadyExists
+		}
+
+		if err := foundTag.Rename(u.clock.Now(), req.Name); err != nil {
+			return err
+		}
+
+		if err := u.tagRepository.Update(ctx, foundTag); err != nil {
+			return fmt.Errorf("failed to update tag: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var notFoundErr *tag.NotFoundError
+		var validationErr *tag.ValidationError
+		if errors.As(err, &notFoundErr) || errors.As(err, &validationErr) {
+			return err
+		}
+		return fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return nil
+}
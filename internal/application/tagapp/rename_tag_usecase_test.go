@@ -0,0 +1,179 @@
+package tagapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_tag"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameTagUseCase_Execute(t *testing.T) {
+	t.Run("successfully renames tag", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		tagID := tag.TagID(uuid.New())
+		req := RenameTagRequest{ID: tagID, Name: "server"}
+		existingTag := tag.ReconstructTag(tagID.UUID(), "backend", "#1e90ff", time.Now(), time.Now())
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect repository operations to be called within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, tagID).Return(existingTag, nil)
+				mockRepo.EXPECT().ExistsByName(ctx, "server").Return(false, nil)
+				mockRepo.EXPECT().Update(ctx, existingTag).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &renameTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+			clock:         clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "server", existingTag.Name())
+	})
+
+	t.Run("does nothing when name is unchanged", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		tagID := tag.TagID(uuid.New())
+		req := RenameTagRequest{ID: tagID, Name: "backend"}
+		existingTag := tag.ReconstructTag(tagID.UUID(), "backend", "#1e90ff", time.Now(), time.Now())
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Only FindByID is called within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, tagID).Return(existingTag, nil)
+				return fn(ctx)
+			})
+
+		useCase := &renameTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+			clock:         clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+	})
+
+	t.Run("returns error when name already exists", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		tagID := tag.TagID(uuid.New())
+		req := RenameTagRequest{ID: tagID, Name: "frontend"}
+		existingTag := tag.ReconstructTag(tagID.UUID(), "backend", "#1e90ff", time.Now(), time.Now())
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Name check finds a duplicate within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, tagID).Return(existingTag, nil)
+				mockRepo.EXPECT().ExistsByName(ctx, "frontend").Return(true, nil)
+				return fn(ctx)
+			})
+
+		useCase := &renameTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+			clock:         clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *tag.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "backend", existingTag.Name())
+	})
+
+	t.Run("returns not found error when tag does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		tagID := tag.TagID(uuid.New())
+		req := RenameTagRequest{ID: tagID, Name: "server"}
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// FindByID error occurs within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, tagID).Return(nil, &tag.NotFoundError{ID: tagID})
+				return fn(ctx)
+			})
+
+		useCase := &renameTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+			clock:         clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *tag.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("returns error when update repository fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		tagID := tag.TagID(uuid.New())
+		req := RenameTagRequest{ID: tagID, Name: "server"}
+		existingTag := tag.ReconstructTag(tagID.UUID(), "backend", "#1e90ff", time.Now(), time.Now())
+		updateError := errors.New("update failed")
+
+		mockRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Update error occurs within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, tagID).Return(existingTag, nil)
+				mockRepo.EXPECT().ExistsByName(ctx, "server").Return(false, nil)
+				mockRepo.EXPECT().Update(ctx, existingTag).Return(updateError)
+				return fn(ctx)
+			})
+
+		useCase := &renameTagUseCase{
+			tagRepository: mockRepo,
+			txRunner:      mockTxRunner,
+			clock:         clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
@@ -0,0 +1,94 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type AddDependencyRequest struct {
+	ID        todo.TodoID
+	BlockerID todo.TodoID
+}
+
+// AddDependencyUseCase is the interface that wraps the basic AddDependency operation.
+type AddDependencyUseCase interface {
+	Execute(ctx context.Context, req AddDependencyRequest) error
+}
+
+// addDependencyUseCase is the implementation of the AddDependencyUseCase interface.
+type addDependencyUseCase struct {
+	todoRepository    todo.TodoRepository
+	dependencyService *dependencyService
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+}
+
+// NewAddDependencyUseCase creates a new AddDependencyUseCase.
+func NewAddDependencyUseCase(i *do.Injector) (AddDependencyUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &addDependencyUseCase{
+		todoRepository:    todoRepository,
+		dependencyService: newDependencyService(todoRepository),
+		txRunner:          txRunner,
+		clock:             clk,
+	}, nil
+}
+
+// Execute makes a Todo blocked by another Todo.
+func (u *addDependencyUseCase) Execute(ctx context.Context, req AddDependencyRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+
+		blocker, err := u.todoRepository.FindByID(ctx, req.BlockerID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find blocker: %w", err)
+		}
+
+		if err := u.dependencyService.AddDependency(ctx, u.clock.Now(), foundTodo, blocker); err != nil {
+			// Preserve domain errors
+			var validationErr *todo.ValidationError
+			if errors.As(err, &validationErr) {
+				return err
+			}
+			return fmt.Errorf("failed to add dependency: %w", err)
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDependencyUseCase_Execute(t *testing.T) {
+	newTodo := func(id todo.TodoID, blockerIDs []todo.TodoID) *todo.Todo {
+		return todo.ReconstructTodoWithStatus(
+			id.UUID(),
+			"Test Todo",
+			"",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			nil,
+			blockerIDs,
+			nil,
+			nil,
+			"",
+		)
+	}
+
+	t.Run("successfully adds dependency", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		blockerID := todo.TodoID(uuid.New())
+		req := AddDependencyRequest{ID: todoID, BlockerID: blockerID}
+		existingTodo := newTodo(todoID, nil)
+		blocker := newTodo(blockerID, nil)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindByID(ctx, blockerID).Return(blocker, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &addDependencyUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, existingTodo.IsBlockedBy(blockerID))
+	})
+
+	t.Run("returns validation error when dependency creates a cycle", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		blockerID := todo.TodoID(uuid.New())
+		req := AddDependencyRequest{ID: todoID, BlockerID: blockerID}
+		existingTodo := newTodo(todoID, nil)
+		blocker := newTodo(blockerID, []todo.TodoID{todoID})
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindByID(ctx, blockerID).Return(blocker, nil)
+				return fn(ctx)
+			})
+
+		useCase := &addDependencyUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "blocker_id: dependency would create a cycle", err.Error())
+	})
+
+	t.Run("returns not found error when blocker does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		blockerID := todo.TodoID(uuid.New())
+		req := AddDependencyRequest{ID: todoID, BlockerID: blockerID}
+		existingTodo := newTodo(todoID, nil)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindByID(ctx, blockerID).Return(nil, &todo.NotFoundError{ID: blockerID})
+				return fn(ctx)
+			})
+
+		useCase := &addDependencyUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("returns error when transaction fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := AddDependencyRequest{ID: todo.NewTodoID(), BlockerID: todo.NewTodoID()}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			Return(errors.New("transaction error"))
+
+		useCase := &addDependencyUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
@@ -0,0 +1,83 @@
+package todoapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type AddTodoTagRequest struct {
+	ID    todo.TodoID
+	TagID tag.TagID
+}
+
+// AddTodoTagUseCase is the interface that wraps the basic AddTodoTag operation.
+type AddTodoTagUseCase interface {
+	Execute(ctx context.Context, req AddTodoTagRequest) error
+}
+
+// addTodoTagUseCase is the implementation of the AddTodoTagUseCase interface.
+type addTodoTagUseCase struct {
+	todoRepository todo.TodoRepository
+	tagRepository  tag.TagRepository
+	txRunner       uow.TransactionRunner
+	clock          clock.Clock
+}
+
+// NewAddTodoTagUseCase creates a new AddTodoTagUseCase.
+func NewAddTodoTagUseCase(i *do.Injector) (AddTodoTagUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	tagRepository, err := do.Invoke[tag.TagRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke tag repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &addTodoTagUseCase{
+		todoRepository: todoRepository,
+		tagRepository:  tagRepository,
+		txRunner:       txRunner,
+		clock:          clk,
+	}, nil
+}
+
+// Execute attaches an existing Tag to a Todo.
+func (u *addTodoTagUseCase) Execute(ctx context.Context, req AddTodoTagRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+		if _, err := u.tagRepository.FindByID(ctx, req.TagID); err != nil {
+			return err
+		}
+
+		if err := foundTodo.AddTag(u.clock.Now(), req.TagID); err != nil {
+			return err
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_tag"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddTodoTagUseCase_Execute(t *testing.T) {
+	t.Run("successfully attaches tag", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		tagID := tag.TagID(uuid.New())
+		req := AddTodoTagRequest{ID: todoID, TagID: tagID}
+
+		existingTodo := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Test Todo",
+			"Test Body",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+		)
+		existingTag := tag.ReconstructTag(tagID.UUID(), "backend", "#1e90ff", time.Now(), time.Now())
+
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTagRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect repository operations to be called within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockTagRepo.EXPECT().FindByID(ctx, tagID).Return(existingTag, nil)
+				mockTodoRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &addTodoTagUseCase{
+			todoRepository: mockTodoRepo,
+			tagRepository:  mockTagRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, existingTodo.HasTag(tagID))
+	})
+
+	t.Run("returns not found error when tag does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		tagID := tag.TagID(uuid.New())
+		req := AddTodoTagRequest{ID: todoID, TagID: tagID}
+
+		existingTodo := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Test Todo",
+			"Test Body",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+		)
+
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTagRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Tag lookup fails within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockTagRepo.EXPECT().FindByID(ctx, tagID).Return(nil, &tag.NotFoundError{ID: tagID})
+				return fn(ctx)
+			})
+
+		useCase := &addTodoTagUseCase{
+			todoRepository: mockTodoRepo,
+			tagRepository:  mockTagRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *tag.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("returns validation error when tag is already attached", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		tagID := tag.TagID(uuid.New())
+		req := AddTodoTagRequest{ID: todoID, TagID: tagID}
+
+		existingTodo := todo.ReconstructTodoWithStatus(
+			todoID.UUID(),
+			"Test Todo",
+			"Test Body",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+			nil,
+			[]tag.TagID{tagID},
+			nil,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+		existingTag := tag.ReconstructTag(tagID.UUID(), "backend", "#1e90ff", time.Now(), time.Now())
+
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTagRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// AddTag fails within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockTodoRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockTagRepo.EXPECT().FindByID(ctx, tagID).Return(existingTag, nil)
+				return fn(ctx)
+			})
+
+		useCase := &addTodoTagUseCase{
+			todoRepository: mockTodoRepo,
+			tagRepository:  mockTagRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Contains(t, err.Error(), "tag is already attached")
+	})
+
+	t.Run("returns error when transaction fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := AddTodoTagRequest{ID: todo.TodoID(uuid.New()), TagID: tag.TagID(uuid.New())}
+		txError := errors.New("transaction error")
+
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTagRepo := mock_tag.NewMockTagRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Transaction itself fails
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			Return(txError)
+
+		useCase := &addTodoTagUseCase{
+			todoRepository: mockTodoRepo,
+			tagRepository:  mockTagRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
@@ -0,0 +1,82 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type CancelTodoRequest struct {
+	ID todo.TodoID
+}
+
+// CancelTodoUseCase is the interface that wraps the basic CancelTodo operation.
+type CancelTodoUseCase interface {
+	Execute(ctx context.Context, req CancelTodoRequest) error
+}
+
+// cancelTodoUseCase is the implementation of the CancelTodoUseCase interface.
+type cancelTodoUseCase struct {
+	todoRepository todo.TodoRepository
+	txRunner       uow.TransactionRunner
+	clock          clock.Clock
+}
+
+// NewCancelTodoUseCase creates a new CancelTodoUseCase.
+func NewCancelTodoUseCase(i *do.Injector) (CancelTodoUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &cancelTodoUseCase{
+		todoRepository: todoRepository,
+		txRunner:       transactionManager,
+		clock:          clk,
+	}, nil
+}
+
+// Execute cancels an unfinished Todo.
+func (u *cancelTodoUseCase) Execute(ctx context.Context, req CancelTodoRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+
+		if err := foundTodo.Cancel(u.clock.Now()); err != nil {
+			// Preserve domain errors
+			var stateErr *todo.StateError
+			if errors.As(err, &stateErr) {
+				return err
+			}
+			return fmt.Errorf("failed to cancel todo: %w", err)
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
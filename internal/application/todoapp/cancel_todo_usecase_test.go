@@ -0,0 +1,127 @@
+package todoapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelTodoUseCase_Execute(t *testing.T) {
+	t.Run("successfully cancels todo", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := CancelTodoRequest{ID: todoID}
+
+		existingTodo := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Test Todo",
+			"Test Body",
+			todo.TodoStatusInProgress,
+			time.Now(),
+			time.Now(),
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &cancelTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, todo.TodoStatusCancelled, existingTodo.Status())
+	})
+
+	t.Run("returns state error when the transition is not allowed", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := CancelTodoRequest{ID: todoID}
+
+		existingTodo := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Test Todo",
+			"Test Body",
+			todo.TodoStatusCompleted,
+			time.Now(),
+			time.Now(),
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Update must not be called
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				return fn(ctx)
+			})
+
+		useCase := &cancelTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var stateErr *todo.StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, todo.TodoStatusCompleted, stateErr.Current)
+		require.Equal(t, todo.TodoStatusCancelled, stateErr.Attempted)
+	})
+
+	t.Run("returns not found error when todo does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := CancelTodoRequest{ID: todoID}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(nil, &todo.NotFoundError{ID: todoID})
+				return fn(ctx)
+			})
+
+		useCase := &cancelTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+}
@@ -6,12 +6,15 @@ import (
 	"fmt"
 
 	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/samber/do"
 )
 
 type CompleteTodoRequest struct {
 	ID todo.TodoID
+	// Cascade completes open subtasks too instead of failing.
+	Cascade bool
 }
 
 // CompleteTodoUseCase is the interface that wraps the basic CompleteTodo operation.
@@ -21,8 +24,10 @@ type CompleteTodoUseCase interface {
 
 // completeTodoUseCase is the implementation of the CompleteTodoUseCase interface.
 type completeTodoUseCase struct {
-	todoRepository todo.TodoRepository
-	txRunner       uow.TransactionRunner
+	todoRepository    todo.TodoRepository
+	dependencyService *dependencyService
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
 }
 
 // NewCompleteTodoUseCase creates a new CompleteTodoUseCase.
@@ -35,14 +40,21 @@ func NewCompleteTodoUseCase(i *do.Injector) (CompleteTodoUseCase, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
 	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
 
 	return &completeTodoUseCase{
-		todoRepository: todoRepository,
-		txRunner:       txRunner,
+		todoRepository:    todoRepository,
+		dependencyService: newDependencyService(todoRepository),
+		txRunner:          txRunner,
+		clock:             clk,
 	}, nil
 }
 
 // Execute completes a Todo by changing its status to completed.
+// Open subtasks are completed as well only when Cascade is set.
 func (u *completeTodoUseCase) Execute(ctx context.Context, req CompleteTodoRequest) error {
 	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
 		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
@@ -54,7 +66,18 @@ func (u *completeTodoUseCase) Execute(ctx context.Context, req CompleteTodoReque
 			return fmt.Errorf("failed to find todo: %w", err)
 		}
 
-		if err := foundTodo.Complete(); err != nil {
+		descendants, err := u.todoRepository.FindDescendants(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find descendants: %w", err)
+		}
+
+		blockers, err := u.dependencyService.LoadBlockers(ctx, append([]*todo.Todo{foundTodo}, descendants...)...)
+		if err != nil {
+			return fmt.Errorf("failed to load blockers: %w", err)
+		}
+
+		completed, err := foundTodo.CompleteWithDescendants(u.clock.Now(), descendants, req.Cascade, blockers...)
+		if err != nil {
 			// Preserve domain errors
 			var stateErr *todo.StateError
 			if errors.As(err, &stateErr) {
@@ -63,19 +86,18 @@ func (u *completeTodoUseCase) Execute(ctx context.Context, req CompleteTodoReque
 			return fmt.Errorf("failed to complete todo: %w", err)
 		}
 
+		for _, d := range completed {
+			if err := u.todoRepository.Update(ctx, d); err != nil {
+				return fmt.Errorf("failed to update subtask: %w", err)
+			}
+		}
 		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
 			return fmt.Errorf("failed to update todo: %w", err)
 		}
 		return nil
 	})
 	if err != nil {
-		// Preserve domain errors
-		var notFoundErr *todo.NotFoundError
-		var stateErr *todo.StateError
-		if errors.As(err, &notFoundErr) || errors.As(err, &stateErr) {
-			return err
-		}
-		return fmt.Errorf("failed to execute transaction: %w", err)
+		return transactionError(err)
 	}
 	return nil
 }
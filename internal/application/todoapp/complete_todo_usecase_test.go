@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
 	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
@@ -20,14 +22,16 @@ func TestCompleteTodoUseCase_Execute(t *testing.T) {
 		ctx := context.Background()
 		todoID := todo.TodoID(uuid.New())
 		req := CompleteTodoRequest{ID: todoID}
+		createdAt := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+		now := createdAt.Add(time.Hour)
 
 		existingTodo := todo.ReconstructTodo(
 			todoID.UUID(),
 			"Test Todo",
 			"Test Body",
 			todo.TodoStatusInProgress,
-			time.Now(),
-			time.Now(),
+			createdAt,
+			createdAt,
 		)
 
 		mockRepo := mock_todo.NewMockTodoRepository(t)
@@ -35,15 +39,18 @@ func TestCompleteTodoUseCase_Execute(t *testing.T) {
 
 		// Expect repository operations to be called within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return(nil, nil)
 				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
 				return fn(ctx)
 			})
 
 		useCase := &completeTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.NewFake(now),
 		}
 
 		// When
@@ -51,6 +58,9 @@ func TestCompleteTodoUseCase_Execute(t *testing.T) {
 
 		// Then
 		require.NoError(t, err)
+		require.Equal(t, now, existingTodo.UpdatedAt())
+		require.NotNil(t, existingTodo.CompletedAt())
+		require.Equal(t, now, *existingTodo.CompletedAt())
 	})
 
 	t.Run("returns error when todo not found", func(t *testing.T) {
@@ -65,14 +75,16 @@ func TestCompleteTodoUseCase_Execute(t *testing.T) {
 
 		// FindByID error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(nil, findError)
 				return fn(ctx)
 			})
 
 		useCase := &completeTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
 		}
 
 		// When
@@ -104,14 +116,17 @@ func TestCompleteTodoUseCase_Execute(t *testing.T) {
 
 		// IsCompleted check fails within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return(nil, nil)
 				return fn(ctx)
 			})
 
 		useCase := &completeTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
 		}
 
 		// When
@@ -122,6 +137,120 @@ func TestCompleteTodoUseCase_Execute(t *testing.T) {
 		require.Contains(t, err.Error(), "todo is already completed")
 	})
 
+	t.Run("returns state error when todo has open subtasks", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := CompleteTodoRequest{ID: todoID}
+
+		existingTodo := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Parent Todo",
+			"Test Body",
+			todo.TodoStatusInProgress,
+			time.Now(),
+			time.Now(),
+		)
+		subtask := todo.ReconstructTodoWithStatus(
+			uuid.New(),
+			"Subtask",
+			"",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			&todoID,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return([]*todo.Todo{subtask}, nil)
+				return fn(ctx)
+			})
+
+		useCase := &completeTodoUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var stateErr *todo.StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.False(t, existingTodo.IsCompleted())
+	})
+
+	t.Run("completes open subtasks when cascade is set", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := CompleteTodoRequest{ID: todoID, Cascade: true}
+
+		existingTodo := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Parent Todo",
+			"Test Body",
+			todo.TodoStatusInProgress,
+			time.Now(),
+			time.Now(),
+		)
+		subtask := todo.ReconstructTodoWithStatus(
+			uuid.New(),
+			"Subtask",
+			"",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			&todoID,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return([]*todo.Todo{subtask}, nil)
+				mockRepo.EXPECT().Update(ctx, subtask).Return(nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &completeTodoUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, existingTodo.IsCompleted())
+		require.True(t, subtask.IsCompleted())
+	})
+
 	t.Run("returns error when update repository fails", func(t *testing.T) {
 		// Given
 		ctx := context.Background()
@@ -143,15 +272,18 @@ func TestCompleteTodoUseCase_Execute(t *testing.T) {
 
 		// Update error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return(nil, nil)
 				mockRepo.EXPECT().Update(ctx, existingTodo).Return(updateError)
 				return fn(ctx)
 			})
 
 		useCase := &completeTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
 		}
 
 		// When
@@ -177,8 +309,10 @@ func TestCompleteTodoUseCase_Execute(t *testing.T) {
 			Return(txError)
 
 		useCase := &completeTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
 		}
 
 		// When
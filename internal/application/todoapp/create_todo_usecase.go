@@ -6,6 +6,9 @@ import (
 	"fmt"
 
 	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/samber/do"
 )
@@ -13,17 +16,25 @@ import (
 type CreateTodoRequest struct {
 	Title string
 	Body  string
+	// ParentID creates the Todo as a subtask of the given Todo.
+	ParentID *todo.TodoID
+	// ProjectID creates the Todo in the given project.
+	// Subtasks without it are created in the project of their parent.
+	ProjectID *project.ProjectID
 }
 
 // CreateTodoUseCase is the interface that wraps the basic CreateTodo operation.
 type CreateTodoUseCase interface {
-	Execute(ctx context.Context, req CreateTodoRequest) error
+	Execute(ctx context.Context, req CreateTodoRequest) (*todo.Todo, error)
 }
 
 // createTodoUseCase is the implementation of the CreateTodoUseCase interface.
 type createTodoUseCase struct {
-	todoRepository todo.TodoRepository
-	txRunner       uow.TransactionRunner
+	todoRepository    todo.TodoRepository
+	projectRepository project.ProjectRepository
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+	ids               idgen.IDGenerator
 }
 
 // NewCreateTodoUseCase creates a new CreateTodoUseCase.
@@ -32,32 +43,70 @@ func NewCreateTodoUseCase(i *do.Injector) (CreateTodoUseCase, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
 	}
+	projectRepository, err := do.Invoke[project.ProjectRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke project repository: %w", err)
+	}
 	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
 	if err != nil {
 		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
 	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
 
 	return &createTodoUseCase{
-		todoRepository: todoRepository,
-		txRunner:       transactionManager,
+		todoRepository:    todoRepository,
+		projectRepository: projectRepository,
+		txRunner:          transactionManager,
+		clock:             clk,
+		ids:               ids,
 	}, nil
 }
 
 // Execute creates a new Todo.
-func (u createTodoUseCase) Execute(ctx context.Context, req CreateTodoRequest) error {
-	newTodo, err := todo.NewTodo(req.Title, req.Body)
+func (u createTodoUseCase) Execute(ctx context.Context, req CreateTodoRequest) (*todo.Todo, error) {
+	now := u.clock.Now()
+	newTodo, err := todo.NewTodo(u.ids, now, req.Title, req.Body)
 	if err != nil {
 		// Return domain error directly for proper error handling
-		return err
+		return nil, err
 	}
 	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		projectID := req.ProjectID
+		if projectID == nil && req.ParentID != nil {
+			parent, err := u.todoRepository.FindByID(ctx, *req.ParentID)
+			if err != nil {
+				return fmt.Errorf("failed to find parent todo: %w", err)
+			}
+			projectID = parent.ProjectID()
+		}
+		if projectID != nil {
+			p, err := u.projectRepository.FindByID(ctx, *projectID)
+			if err != nil {
+				return fmt.Errorf("failed to find project: %w", err)
+			}
+			if err := newTodo.AssignProject(now, p); err != nil {
+				return err
+			}
+		}
+		if req.ParentID != nil {
+			if err := setParent(ctx, now, u.todoRepository, newTodo, *req.ParentID, 1); err != nil {
+				return err
+			}
+		}
 		if err := u.todoRepository.Create(ctx, newTodo); err != nil {
 			return fmt.Errorf("failed to save todo: %w", err)
 		}
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to execute transaction: %w", err)
+		return nil, transactionError(err)
 	}
-	return nil
+	return newTodo, nil
 }
@@ -4,8 +4,16 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_project"
 	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -15,6 +23,7 @@ func TestCreateTodoUseCase_Execute(t *testing.T) {
 	t.Run("successfully creates todo", func(t *testing.T) {
 		// Given
 		ctx := context.Background()
+		now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
 		req := CreateTodoRequest{
 			Title: "Test Todo",
 			Body:  "Test Body",
@@ -25,7 +34,7 @@ func TestCreateTodoUseCase_Execute(t *testing.T) {
 
 		// Expect repository Create to be called within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				// Repository is called within transaction
 				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*todo.Todo")).Return(nil)
 				return fn(ctx)
@@ -34,13 +43,244 @@ func TestCreateTodoUseCase_Execute(t *testing.T) {
 		useCase := &createTodoUseCase{
 			todoRepository: mockRepo,
 			txRunner:       mockTxRunner,
+			clock:          clock.NewFake(now),
+			ids:            idgen.NewSequence(),
 		}
 
 		// When
-		err := useCase.Execute(ctx, req)
+		result, err := useCase.Execute(ctx, req)
 
 		// Then
 		require.NoError(t, err)
+		require.Equal(t, "00000000-0000-7000-8000-000000000001", result.ID().String())
+		require.Equal(t, "Test Todo", result.Title())
+		require.Equal(t, "Test Body", result.Body())
+		require.Equal(t, now, result.CreatedAt())
+		require.Equal(t, now, result.UpdatedAt())
+	})
+
+	t.Run("creates todo as a subtask of the parent", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		parentID := todo.TodoID(uuid.New())
+		req := CreateTodoRequest{
+			Title:    "Subtask",
+			ParentID: &parentID,
+		}
+		parent := todo.ReconstructTodo(
+			parentID.UUID(),
+			"Parent",
+			"",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		var created *todo.Todo
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, parentID).Return(parent, nil)
+				mockRepo.EXPECT().FindAncestorIDs(ctx, parentID).Return(nil, nil)
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*todo.Todo")).
+					RunAndReturn(func(_ context.Context, t *todo.Todo) error {
+						created = t
+						return nil
+					})
+				return fn(ctx)
+			})
+
+		useCase := &createTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Same(t, created, result)
+		require.NotNil(t, created.ParentID())
+		require.Equal(t, parentID, *created.ParentID())
+	})
+
+	t.Run("creates todo in the initial status of the project workflow", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		p, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Platform", newReviewWorkflow(t))
+		require.NoError(t, err)
+		projectID := p.ID()
+		req := CreateTodoRequest{
+			Title:     "Test Todo",
+			ProjectID: &projectID,
+		}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		var created *todo.Todo
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockProjectRepo.EXPECT().FindByID(ctx, projectID).Return(p, nil)
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*todo.Todo")).
+					RunAndReturn(func(_ context.Context, t *todo.Todo) error {
+						created = t
+						return nil
+					})
+				return fn(ctx)
+			})
+
+		useCase := &createTodoUseCase{
+			todoRepository:    mockRepo,
+			projectRepository: mockProjectRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Same(t, created, result)
+		require.Equal(t, projectID, *created.ProjectID())
+		require.Equal(t, project.StatusID("todo"), created.StatusID())
+	})
+
+	t.Run("creates subtask in the project of the parent", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		p, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Platform", newReviewWorkflow(t))
+		require.NoError(t, err)
+		parentID := todo.TodoID(uuid.New())
+		parent := newProjectTodo(t, parentID, "todo")
+		projectID := *parent.ProjectID()
+		req := CreateTodoRequest{
+			Title:    "Subtask",
+			ParentID: &parentID,
+		}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		var created *todo.Todo
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, parentID).Return(parent, nil)
+				mockRepo.EXPECT().FindAncestorIDs(ctx, parentID).Return(nil, nil)
+				mockProjectRepo.EXPECT().FindByID(ctx, projectID).Return(
+					project.ReconstructProject(projectID.UUID(), p.Name(), p.Workflow(), p.CreatedAt(), p.UpdatedAt()), nil,
+				)
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*todo.Todo")).
+					RunAndReturn(func(_ context.Context, t *todo.Todo) error {
+						created = t
+						return nil
+					})
+				return fn(ctx)
+			})
+
+		useCase := &createTodoUseCase{
+			todoRepository:    mockRepo,
+			projectRepository: mockProjectRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Same(t, created, result)
+		require.Equal(t, projectID, *created.ProjectID())
+		require.Equal(t, parentID, *created.ParentID())
+	})
+
+	t.Run("returns validation error when parent is in another project", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		p, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Platform", nil)
+		require.NoError(t, err)
+		projectID := p.ID()
+		parentID := todo.TodoID(uuid.New())
+		parent := newProjectTodo(t, parentID, "todo")
+		req := CreateTodoRequest{
+			Title:     "Subtask",
+			ParentID:  &parentID,
+			ProjectID: &projectID,
+		}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockProjectRepo.EXPECT().FindByID(ctx, projectID).Return(p, nil)
+				mockRepo.EXPECT().FindByID(ctx, parentID).Return(parent, nil)
+				mockRepo.EXPECT().FindAncestorIDs(ctx, parentID).Return(nil, nil)
+				return fn(ctx)
+			})
+
+		useCase := &createTodoUseCase{
+			todoRepository:    mockRepo,
+			projectRepository: mockProjectRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Nil(t, result)
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "parent_id", validationErr.Field)
+	})
+
+	t.Run("returns not found error when parent does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		parentID := todo.TodoID(uuid.New())
+		req := CreateTodoRequest{
+			Title:    "Subtask",
+			ParentID: &parentID,
+		}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, parentID).Return(nil, &todo.NotFoundError{ID: parentID})
+				return fn(ctx)
+			})
+
+		useCase := &createTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Nil(t, result)
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
 	})
 
 	t.Run("returns error when repository fails", func(t *testing.T) {
@@ -57,7 +297,7 @@ func TestCreateTodoUseCase_Execute(t *testing.T) {
 
 		// Repository error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*todo.Todo")).Return(repoError)
 				return fn(ctx)
 			})
@@ -65,12 +305,15 @@ func TestCreateTodoUseCase_Execute(t *testing.T) {
 		useCase := &createTodoUseCase{
 			todoRepository: mockRepo,
 			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
 		}
 
 		// When
-		err := useCase.Execute(ctx, req)
+		result, err := useCase.Execute(ctx, req)
 
 		// Then
+		require.Nil(t, result)
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "failed to execute transaction")
 	})
@@ -94,12 +337,15 @@ func TestCreateTodoUseCase_Execute(t *testing.T) {
 		useCase := &createTodoUseCase{
 			todoRepository: mockRepo,
 			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
 		}
 
 		// When
-		err := useCase.Execute(ctx, req)
+		result, err := useCase.Execute(ctx, req)
 
 		// Then
+		require.Nil(t, result)
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "failed to execute transaction")
 	})
@@ -118,12 +364,15 @@ func TestCreateTodoUseCase_Execute(t *testing.T) {
 		useCase := &createTodoUseCase{
 			todoRepository: mockRepo,
 			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
 		}
 
 		// When
-		err := useCase.Execute(ctx, req)
+		result, err := useCase.Execute(ctx, req)
 
 		// Then
+		require.Nil(t, result)
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "title is required")
 		// Transaction should not be called when todo creation fails
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/samber/do"
 )
@@ -20,8 +21,10 @@ type DeleteTodoUseCase interface {
 
 // deleteTodoUseCase is the implementation of the DeleteTodoUseCase interface.
 type deleteTodoUseCase struct {
-	todoRepository todo.TodoRepository
-	txRunner       uow.TransactionRunner
+	todoRepository       todo.TodoRepository
+	attachmentRepository attachment.AttachmentRepository
+	blobStore            attachment.BlobStore
+	txRunner             uow.TransactionRunner
 }
 
 // NewDeleteTodoUseCase creates a new DeleteTodoUseCase.
@@ -30,27 +33,57 @@ func NewDeleteTodoUseCase(i *do.Injector) (DeleteTodoUseCase, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
 	}
+	attachmentRepository, err := do.Invoke[attachment.AttachmentRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke attachment repository: %w", err)
+	}
+	blobStore, err := do.Invoke[attachment.BlobStore](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke blob store: %w", err)
+	}
 	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
 	if err != nil {
 		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
 	}
 
 	return &deleteTodoUseCase{
-		todoRepository: todoRepository,
-		txRunner:       transactionManager,
+		todoRepository:       todoRepository,
+		attachmentRepository: attachmentRepository,
+		blobStore:            blobStore,
+		txRunner:             transactionManager,
 	}, nil
 }
 
 // Execute deletes a Todo by its ID.
+// The subtree and the attachments go with it, and the content of the attachments
+// is removed from the BlobStore once the deletion is committed.
 func (u deleteTodoUseCase) Execute(ctx context.Context, req DeleteTodoRequest) error {
+	var attachments []*attachment.Attachment
 	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		descendants, err := u.todoRepository.FindDescendants(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find descendants: %w", err)
+		}
+		ids := []todo.TodoID{req.ID}
+		for _, descendant := range descendants {
+			ids = append(ids, descendant.ID())
+		}
+		attachments, err = u.attachmentRepository.FindByTodoIDs(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("failed to find attachments: %w", err)
+		}
 		if err := u.todoRepository.Delete(ctx, req.ID); err != nil {
 			return fmt.Errorf("failed to delete todo: %w", err)
 		}
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to execute transaction: %w", err)
+		return transactionError(err)
+	}
+
+	// Blobs that cannot be deleted now are left to the garbage collection.
+	for _, a := range attachments {
+		_ = u.blobStore.Delete(ctx, a.BlobKey())
 	}
 	return nil
 }
@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_attachment"
 	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -21,18 +26,24 @@ func TestDeleteTodoUseCase_Execute(t *testing.T) {
 		req := DeleteTodoRequest{ID: todoID}
 
 		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
 		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
 
 		// Expect repository Delete to be called within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return(nil, nil)
+				mockAttachmentRepo.EXPECT().FindByTodoIDs(ctx, []todo.TodoID{todoID}).Return(nil, nil)
 				mockRepo.EXPECT().Delete(ctx, todoID).Return(nil)
 				return fn(ctx)
 			})
 
 		useCase := &deleteTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:       mockRepo,
+			attachmentRepository: mockAttachmentRepo,
+			blobStore:            mockBlobStore,
+			txRunner:             mockTxRunner,
 		}
 
 		// When
@@ -42,6 +53,49 @@ func TestDeleteTodoUseCase_Execute(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("deletes the content of the attachments of the subtree", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := DeleteTodoRequest{ID: todoID}
+		subtask, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Subtask", "")
+		require.NoError(t, err)
+		parentFile, err := attachment.NewAttachment(idgen.UUIDv7(), time.Now(), todoID, "parent.txt", "text/plain")
+		require.NoError(t, err)
+		subtaskFile, err := attachment.NewAttachment(idgen.UUIDv7(), time.Now(), subtask.ID(), "subtask.txt", "text/plain")
+		require.NoError(t, err)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return([]*todo.Todo{subtask}, nil)
+				mockAttachmentRepo.EXPECT().FindByTodoIDs(ctx, []todo.TodoID{todoID, subtask.ID()}).
+					Return([]*attachment.Attachment{parentFile, subtaskFile}, nil)
+				mockRepo.EXPECT().Delete(ctx, todoID).Return(nil)
+				return fn(ctx)
+			})
+		mockBlobStore.EXPECT().Delete(ctx, parentFile.BlobKey()).Return(nil)
+		// A failed blob deletion is left to the garbage collection
+		mockBlobStore.EXPECT().Delete(ctx, subtaskFile.BlobKey()).Return(errors.New("storage error"))
+
+		useCase := &deleteTodoUseCase{
+			todoRepository:       mockRepo,
+			attachmentRepository: mockAttachmentRepo,
+			blobStore:            mockBlobStore,
+			txRunner:             mockTxRunner,
+		}
+
+		// When
+		err = useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+	})
+
 	t.Run("returns error when repository fails", func(t *testing.T) {
 		// Given
 		ctx := context.Background()
@@ -50,18 +104,24 @@ func TestDeleteTodoUseCase_Execute(t *testing.T) {
 		repoError := errors.New("repository error")
 
 		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(t)
+		mockBlobStore := mock_attachment.NewMockBlobStore(t)
 		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
 
 		// Repository error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return(nil, nil)
+				mockAttachmentRepo.EXPECT().FindByTodoIDs(ctx, []todo.TodoID{todoID}).Return(nil, nil)
 				mockRepo.EXPECT().Delete(ctx, todoID).Return(repoError)
 				return fn(ctx)
 			})
 
 		useCase := &deleteTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:       mockRepo,
+			attachmentRepository: mockAttachmentRepo,
+			blobStore:            mockBlobStore,
+			txRunner:             mockTxRunner,
 		}
 
 		// When
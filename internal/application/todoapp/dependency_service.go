@@ -0,0 +1,83 @@
+package todoapp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// dependencyService applies the rules that span several todos through "blocked by" dependencies,
+// which need the todos to be loaded from the repository.
+type dependencyService struct {
+	todoRepository todo.TodoRepository
+}
+
+// newDependencyService creates a new dependencyService.
+func newDependencyService(todoRepository todo.TodoRepository) *dependencyService {
+	return &dependencyService{todoRepository: todoRepository}
+}
+
+// AddDependency makes t blocked by blocker at now.
+// It rejects dependencies that would create a cycle.
+func (s *dependencyService) AddDependency(ctx context.Context, now time.Time, t *todo.Todo, blocker *todo.Todo) error {
+	if blocker.ID() != t.ID() {
+		reachable, err := s.isBlockedTransitivelyBy(ctx, blocker, t.ID())
+		if err != nil {
+			return err
+		}
+		if reachable {
+			return &todo.ValidationError{Field: "blocker_id", Message: "dependency would create a cycle"}
+		}
+	}
+	return t.AddBlocker(now, blocker.ID())
+}
+
+// LoadBlockers returns the blockers of the given todos.
+// Blockers that are part of todos are not loaded again.
+func (s *dependencyService) LoadBlockers(ctx context.Context, todos ...*todo.Todo) ([]*todo.Todo, error) {
+	loaded := make(map[todo.TodoID]bool, len(todos))
+	for _, t := range todos {
+		loaded[t.ID()] = true
+	}
+	var blockers []*todo.Todo
+	for _, t := range todos {
+		for _, blockerID := range t.BlockerIDs() {
+			if loaded[blockerID] {
+				continue
+			}
+			blocker, err := s.todoRepository.FindByID(ctx, blockerID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find blocker %v: %w", blockerID, err)
+			}
+			loaded[blockerID] = true
+			blockers = append(blockers, blocker)
+		}
+	}
+	return blockers, nil
+}
+
+// isBlockedTransitivelyBy walks the blockers of from and reports whether target is among them.
+func (s *dependencyService) isBlockedTransitivelyBy(ctx context.Context, from *todo.Todo, target todo.TodoID) (bool, error) {
+	visited := map[todo.TodoID]bool{from.ID(): true}
+	queue := slices.Clone(from.BlockerIDs())
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == target {
+			return true, nil
+		}
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		next, err := s.todoRepository.FindByID(ctx, id)
+		if err != nil {
+			return false, fmt.Errorf("failed to find blocker %v: %w", id, err)
+		}
+		queue = append(queue, next.BlockerIDs()...)
+	}
+	return false, nil
+}
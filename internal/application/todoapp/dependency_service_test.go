@@ -0,0 +1,133 @@
+package todoapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTodoRepository serves FindByID from memory for dependencyService tests.
+type stubTodoRepository struct {
+	todo.TodoRepository
+	todos map[todo.TodoID]*todo.Todo
+}
+
+func (r stubTodoRepository) FindByID(_ context.Context, id todo.TodoID) (*todo.Todo, error) {
+	t, ok := r.todos[id]
+	if !ok {
+		return nil, &todo.NotFoundError{ID: id}
+	}
+	return t, nil
+}
+
+func newStubTodoRepository(todos ...*todo.Todo) stubTodoRepository {
+	r := stubTodoRepository{todos: map[todo.TodoID]*todo.Todo{}}
+	for _, t := range todos {
+		r.todos[t.ID()] = t
+	}
+	return r
+}
+
+func TestDependencyService_AddDependency(t *testing.T) {
+	newTodo := func(title string) *todo.Todo {
+		newTodo, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), title, "")
+		require.NoError(t, err)
+		return newTodo
+	}
+
+	t.Run("adds a dependency", func(t *testing.T) {
+		// Given
+		a, b := newTodo("A"), newTodo("B")
+		service := newDependencyService(newStubTodoRepository(a, b))
+
+		// When
+		err := service.AddDependency(context.Background(), time.Now(), a, b)
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, a.IsBlockedBy(b.ID()))
+	})
+
+	t.Run("returns error on a direct cycle", func(t *testing.T) {
+		// Given
+		a, b := newTodo("A"), newTodo("B")
+		require.NoError(t, b.AddBlocker(time.Now(), a.ID()))
+		service := newDependencyService(newStubTodoRepository(a, b))
+
+		// When
+		err := service.AddDependency(context.Background(), time.Now(), a, b)
+
+		// Then
+		require.Error(t, err)
+		require.Equal(t, "blocker_id: dependency would create a cycle", err.Error())
+		require.Empty(t, a.BlockerIDs())
+	})
+
+	t.Run("returns error on a transitive cycle", func(t *testing.T) {
+		// Given
+		a, b, c := newTodo("A"), newTodo("B"), newTodo("C")
+		require.NoError(t, b.AddBlocker(time.Now(), c.ID()))
+		require.NoError(t, c.AddBlocker(time.Now(), a.ID()))
+		service := newDependencyService(newStubTodoRepository(a, b, c))
+
+		// When
+		err := service.AddDependency(context.Background(), time.Now(), a, b)
+
+		// Then
+		require.Error(t, err)
+		require.Equal(t, "blocker_id: dependency would create a cycle", err.Error())
+	})
+
+	t.Run("allows diamonds", func(t *testing.T) {
+		// Given
+		a, b, c, d := newTodo("A"), newTodo("B"), newTodo("C"), newTodo("D")
+		require.NoError(t, b.AddBlocker(time.Now(), d.ID()))
+		require.NoError(t, c.AddBlocker(time.Now(), d.ID()))
+		require.NoError(t, a.AddBlocker(time.Now(), c.ID()))
+		service := newDependencyService(newStubTodoRepository(a, b, c, d))
+
+		// When
+		err := service.AddDependency(context.Background(), time.Now(), a, b)
+
+		// Then
+		require.NoError(t, err)
+	})
+
+	t.Run("returns error when todo blocks itself", func(t *testing.T) {
+		// Given
+		a := newTodo("A")
+		service := newDependencyService(newStubTodoRepository(a))
+
+		// When
+		err := service.AddDependency(context.Background(), time.Now(), a, a)
+
+		// Then
+		require.Error(t, err)
+		require.Equal(t, "blocker_id: todo cannot block itself", err.Error())
+	})
+}
+
+func TestDependencyService_LoadBlockers(t *testing.T) {
+	// Given
+	a, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "A", "")
+	require.NoError(t, err)
+	b, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "B", "")
+	require.NoError(t, err)
+	c, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "C", "")
+	require.NoError(t, err)
+	require.NoError(t, a.AddBlocker(time.Now(), b.ID()))
+	require.NoError(t, a.AddBlocker(time.Now(), c.ID()))
+	require.NoError(t, b.AddBlocker(time.Now(), c.ID()))
+	service := newDependencyService(newStubTodoRepository(a, b, c))
+
+	// When
+	blockers, err := service.LoadBlockers(context.Background(), a, b)
+
+	// Then
+	require.NoError(t, err)
+	require.Equal(t, []*todo.Todo{c}, blockers)
+}
@@ -0,0 +1,17 @@
+package todoapp
+
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+)
+
+// transactionError returns the domain error in err unchanged, so that callers see the error
+// of the domain or the repository rather than the steps of the use case that led to it.
+// Other errors are wrapped as a failure of the transaction.
+func transactionError(err error) error {
+	if domainErr, ok := domainerr.As(err); ok {
+		return domainErr
+	}
+	return fmt.Errorf("failed to execute transaction: %w", err)
+}
@@ -0,0 +1,69 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type GetTodoTreeRequest struct {
+	ID todo.TodoID
+}
+
+// GetTodoTreeUseCase is the interface that wraps the basic GetTodoTree operation.
+type GetTodoTreeUseCase interface {
+	Execute(ctx context.Context, req GetTodoTreeRequest) (*todo.TodoTree, error)
+}
+
+// getTodoTreeUseCase is the implementation of the GetTodoTreeUseCase interface.
+type getTodoTreeUseCase struct {
+	todoRepository todo.TodoRepository
+	txRunner       uow.TransactionRunner
+}
+
+// NewGetTodoTreeUseCase creates a new GetTodoTreeUseCase.
+func NewGetTodoTreeUseCase(i *do.Injector) (GetTodoTreeUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &getTodoTreeUseCase{
+		todoRepository: todoRepository,
+		txRunner:       transactionManager,
+	}, nil
+}
+
+// Execute gets a Todo with its whole subtree.
+func (u getTodoTreeUseCase) Execute(ctx context.Context, req GetTodoTreeRequest) (*todo.TodoTree, error) {
+	var result *todo.TodoTree
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			// Preserve domain errors
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+		descendants, err := u.todoRepository.FindDescendants(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find descendants: %w", err)
+		}
+		result = todo.NewTodoTree(foundTodo, descendants)
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,138 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTodoTreeUseCase_Execute(t *testing.T) {
+	t.Run("successfully gets todo with its subtree", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := GetTodoTreeRequest{ID: todoID}
+		root := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Root",
+			"",
+			todo.TodoStatusInProgress,
+			time.Now(),
+			time.Now(),
+		)
+		child := todo.ReconstructTodoWithStatus(
+			uuid.New(),
+			"Child",
+			"",
+			todo.TodoStatusCompleted,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			&todoID,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(root, nil)
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return([]*todo.Todo{child}, nil)
+				return fn(ctx)
+			})
+
+		useCase := &getTodoTreeUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+		}
+
+		// When
+		tree, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, root, tree.Todo)
+		require.Len(t, tree.Children, 1)
+		require.Equal(t, todo.Progress{Completed: 1, Total: 1}, tree.Progress())
+	})
+
+	t.Run("returns not found error when todo does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := GetTodoTreeRequest{ID: todoID}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(nil, &todo.NotFoundError{ID: todoID})
+				return fn(ctx)
+			})
+
+		useCase := &getTodoTreeUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+		}
+
+		// When
+		tree, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Nil(t, tree)
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("returns error when finding descendants fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := GetTodoTreeRequest{ID: todoID}
+		root := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Root",
+			"",
+			todo.TodoStatusInProgress,
+			time.Now(),
+			time.Now(),
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(root, nil)
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return(nil, errors.New("db error"))
+				return fn(ctx)
+			})
+
+		useCase := &getTodoTreeUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+		}
+
+		// When
+		tree, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Nil(t, tree)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
@@ -45,7 +45,7 @@ func NewGetTodoUseCase(i *do.Injector) (GetTodoUseCase, error) {
 // Execute gets a Todo by its ID.
 func (u getTodoUseCase) Execute(ctx context.Context, req GetTodoRequest) (*todo.Todo, error) {
 	var result *todo.Todo
-	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+	err := u.txRunner.RunInTx(uow.WithReadOnly(ctx), func(ctx context.Context) error {
 		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
 		if err != nil {
 			// Preserve domain errors
@@ -59,12 +59,7 @@ func (u getTodoUseCase) Execute(ctx context.Context, req GetTodoRequest) (*todo.
 		return nil
 	})
 	if err != nil {
-		// Preserve domain errors
-		var notFoundErr *todo.NotFoundError
-		if errors.As(err, &notFoundErr) {
-			return nil, err
-		}
-		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+		return nil, transactionError(err)
 	}
 	return result, nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
 	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
@@ -32,8 +33,8 @@ func TestGetTodoUseCase_Execute(t *testing.T) {
 		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
 
 		// Expect repository FindByID to be called within transaction
-		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		mockTxRunner.EXPECT().RunInTx(uow.WithReadOnly(ctx), mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				// Repository is called within transaction
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(expectedTodo, nil)
 				return fn(ctx)
@@ -64,8 +65,8 @@ func TestGetTodoUseCase_Execute(t *testing.T) {
 		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
 
 		// Repository error occurs within transaction
-		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		mockTxRunner.EXPECT().RunInTx(uow.WithReadOnly(ctx), mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(nil, repoError)
 				return fn(ctx)
 			})
@@ -96,7 +97,7 @@ func TestGetTodoUseCase_Execute(t *testing.T) {
 		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
 
 		// Transaction itself fails
-		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+		mockTxRunner.EXPECT().RunInTx(uow.WithReadOnly(ctx), mock.AnythingOfType("func(context.Context) error")).
 			Return(txError)
 
 		useCase := &getTodoUseCase{
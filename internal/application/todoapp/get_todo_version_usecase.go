@@ -0,0 +1,117 @@
+package todoapp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// GetTodoVersionRequest selects a version of a Todo either by AsOf or by Version.
+type GetTodoVersionRequest struct {
+	ID todo.TodoID
+	// AsOf selects the version that was current at that time.
+	AsOf *time.Time
+	// Version selects the version with that number, counting from 1. It is used when AsOf is nil.
+	Version int
+}
+
+// TodoVersion is a Todo as it was at a point in its history.
+type TodoVersion struct {
+	Todo    *todo.Todo
+	Version int
+}
+
+// GetTodoVersionUseCase is the interface that wraps the basic GetTodoVersion operation.
+type GetTodoVersionUseCase interface {
+	Execute(ctx context.Context, req GetTodoVersionRequest) (*TodoVersion, error)
+}
+
+// getTodoVersionUseCase is the implementation of the GetTodoVersionUseCase interface.
+type getTodoVersionUseCase struct {
+	historyRepository history.HistoryRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewGetTodoVersionUseCase creates a new GetTodoVersionUseCase.
+func NewGetTodoVersionUseCase(i *do.Injector) (GetTodoVersionUseCase, error) {
+	historyRepository, err := do.Invoke[history.HistoryRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke history repository: %w", err)
+	}
+	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &getTodoVersionUseCase{
+		historyRepository: historyRepository,
+		txRunner:          transactionManager,
+	}, nil
+}
+
+// Execute rebuilds a Todo from its history as it was at the requested version.
+// Versions of deleted Todos before their deletion can be read as well.
+func (u getTodoVersionUseCase) Execute(ctx context.Context, req GetTodoVersionRequest) (*TodoVersion, error) {
+	if req.AsOf == nil && req.Version < 1 {
+		return nil, &todo.ValidationError{Field: "version", Message: "version must be 1 or greater"}
+	}
+
+	var result *TodoVersion
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		entries, err := findVersionEntries(ctx, u.historyRepository, req.ID, req.AsOf, req.Version)
+		if err != nil {
+			return err
+		}
+		found, err := history.ReplayTodo(req.ID, entries)
+		if err != nil {
+			return err
+		}
+		result = &TodoVersion{Todo: found, Version: entries[len(entries)-1].Version()}
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(err)
+	}
+	return result, nil
+}
+
+// findVersionEntries returns the history of the Todo up to the version that was current at asOf,
+// or up to the given version when asOf is nil.
+func findVersionEntries(
+	ctx context.Context,
+	historyRepository history.HistoryRepository,
+	id todo.TodoID,
+	asOf *time.Time,
+	version int,
+) ([]*history.HistoryEntry, error) {
+	if asOf != nil {
+		entries, err := historyRepository.FindByTodoIDUntil(ctx, id, *asOf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find history: %w", err)
+		}
+		if len(entries) == 0 {
+			return nil, &history.VersionNotFoundError{
+				TodoID:  id,
+				Message: fmt.Sprintf("no version was recorded at or before %s", asOf.UTC().Format(time.RFC3339)),
+			}
+		}
+		return entries, nil
+	}
+
+	entries, err := historyRepository.FindByTodoID(ctx, id, version, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find history: %w", err)
+	}
+	if len(entries) < version {
+		return nil, &history.VersionNotFoundError{
+			TodoID:  id,
+			Message: fmt.Sprintf("version %d does not exist", version),
+		}
+	}
+	return entries, nil
+}
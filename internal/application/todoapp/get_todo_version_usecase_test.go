@@ -0,0 +1,174 @@
+package todoapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_history"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTodoHistory returns the history of a todo that was created, renamed and started, oldest first.
+func newTodoHistory(t *testing.T, todoID todo.TodoID) []*history.HistoryEntry {
+	t.Helper()
+	changes := [][]history.FieldChange{
+		{
+			{Field: history.FieldTitle, After: "Write report"},
+			{Field: history.FieldStatus, After: "NOT_STARTED"},
+		},
+		{{Field: history.FieldTitle, Before: "Write report", After: "Write the annual report"}},
+		{{Field: history.FieldStatus, Before: "NOT_STARTED", After: "IN_PROGRESS"}},
+	}
+	operations := []history.Operation{history.OperationCreate, history.OperationUpdate, history.OperationUpdate}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := make([]*history.HistoryEntry, len(changes))
+	for i := range changes {
+		entries[i] = history.ReconstructHistoryEntry(uuid.New(), todoID, i+1, nil, "alice",
+			operations[i], changes[i], start.Add(time.Duration(i)*time.Hour))
+	}
+	return entries
+}
+
+func TestGetTodoVersionUseCase_Execute(t *testing.T) {
+	t.Run("successfully gets todo at a version", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		entries := newTodoHistory(t, todoID)
+		req := GetTodoVersionRequest{ID: todoID, Version: 2}
+
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockHistoryRepo.EXPECT().FindByTodoID(ctx, todoID, 2, 0).Return(entries[:2], nil)
+				return fn(ctx)
+			})
+
+		useCase := &getTodoVersionUseCase{
+			historyRepository: mockHistoryRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, 2, result.Version)
+		require.Equal(t, "Write the annual report", result.Todo.Title())
+		require.Equal(t, todo.TodoStatusNotStarted, result.Todo.Status())
+	})
+
+	t.Run("successfully gets todo as of a time", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		entries := newTodoHistory(t, todoID)
+		asOf := entries[2].OccurredAt().Add(time.Minute)
+		req := GetTodoVersionRequest{ID: todoID, AsOf: &asOf}
+
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockHistoryRepo.EXPECT().FindByTodoIDUntil(ctx, todoID, asOf).Return(entries, nil)
+				return fn(ctx)
+			})
+
+		useCase := &getTodoVersionUseCase{
+			historyRepository: mockHistoryRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, 3, result.Version)
+		require.Equal(t, todo.TodoStatusInProgress, result.Todo.Status())
+	})
+
+	t.Run("returns version not found error when the version does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		entries := newTodoHistory(t, todoID)
+		req := GetTodoVersionRequest{ID: todoID, Version: 4}
+
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockHistoryRepo.EXPECT().FindByTodoID(ctx, todoID, 4, 0).Return(entries, nil)
+				return fn(ctx)
+			})
+
+		useCase := &getTodoVersionUseCase{
+			historyRepository: mockHistoryRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Nil(t, result)
+		var versionNotFoundErr *history.VersionNotFoundError
+		require.ErrorAs(t, err, &versionNotFoundErr)
+	})
+
+	t.Run("returns version not found error before the todo was created", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		asOf := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		req := GetTodoVersionRequest{ID: todoID, AsOf: &asOf}
+
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockHistoryRepo.EXPECT().FindByTodoIDUntil(ctx, todoID, asOf).Return(nil, nil)
+				return fn(ctx)
+			})
+
+		useCase := &getTodoVersionUseCase{
+			historyRepository: mockHistoryRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Nil(t, result)
+		var versionNotFoundErr *history.VersionNotFoundError
+		require.ErrorAs(t, err, &versionNotFoundErr)
+	})
+
+	t.Run("returns validation error without a point in time", func(t *testing.T) {
+		// Given
+		useCase := &getTodoVersionUseCase{}
+
+		// When
+		result, err := useCase.Execute(context.Background(), GetTodoVersionRequest{ID: todo.NewTodoID()})
+
+		// Then
+		require.Nil(t, result)
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
@@ -5,11 +5,22 @@ import (
 	"fmt"
 
 	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/samber/do"
 )
 
-type GetTodosRequest struct{}
+type GetTodosRequest struct {
+	TagIDs   []tag.TagID
+	TagMatch todo.TagMatch
+	// ParentID lists only the direct children of the given Todo.
+	ParentID *todo.TodoID
+	// ProjectID lists only the todos in the given project.
+	ProjectID *project.ProjectID
+	// ActionableOnly lists only unfinished todos that are not blocked.
+	ActionableOnly bool
+}
 
 // GetTodosUseCase is the interface that wraps the basic GetTodos operation.
 type GetTodosUseCase interface {
@@ -39,11 +50,19 @@ func NewGetTodosUseCase(i *do.Injector) (GetTodosUseCase, error) {
 	}, nil
 }
 
-// Execute finds all todos.
+// Execute finds all todos, optionally narrowed down by tags, parent, project or actionability.
 func (u getTodosUseCase) Execute(ctx context.Context, req GetTodosRequest) ([]*todo.Todo, error) {
+	filter := todo.TodoFilter{
+		TagIDs:     req.TagIDs,
+		TagMatch:   req.TagMatch,
+		ParentID:   req.ParentID,
+		ProjectID:  req.ProjectID,
+		Actionable: req.ActionableOnly,
+	}
+
 	var result []*todo.Todo
-	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
-		todos, err := u.todoRepository.FindAll(ctx)
+	err := u.txRunner.RunInTx(uow.WithReadOnly(ctx), func(ctx context.Context) error {
+		todos, err := u.todoRepository.FindAll(ctx, filter)
 		if err != nil {
 			return fmt.Errorf("failed to find todos: %w", err)
 		}
@@ -51,7 +70,7 @@ func (u getTodosUseCase) Execute(ctx context.Context, req GetTodosRequest) ([]*t
 		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+		return nil, transactionError(err)
 	}
 	return result, nil
 }
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
 	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
@@ -43,9 +45,9 @@ func TestGetTodosUseCase_Execute(t *testing.T) {
 		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
 
 		// Expect repository FindAll to be called within transaction
-		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-				mockRepo.EXPECT().FindAll(ctx).Return(expectedTodos, nil)
+		mockTxRunner.EXPECT().RunInTx(uow.WithReadOnly(ctx), mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindAll(ctx, todo.TodoFilter{}).Return(expectedTodos, nil)
 				return fn(ctx)
 			})
 
@@ -72,9 +74,9 @@ func TestGetTodosUseCase_Execute(t *testing.T) {
 		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
 
 		// Expect repository FindAll to be called within transaction
-		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-				mockRepo.EXPECT().FindAll(ctx).Return(expectedTodos, nil)
+		mockTxRunner.EXPECT().RunInTx(uow.WithReadOnly(ctx), mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindAll(ctx, todo.TodoFilter{}).Return(expectedTodos, nil)
 				return fn(ctx)
 			})
 
@@ -92,6 +94,43 @@ func TestGetTodosUseCase_Execute(t *testing.T) {
 		require.Len(t, result, 0)
 	})
 
+	t.Run("passes tag filter to repository", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		tagIDs := []tag.TagID{tag.NewTagID(), tag.NewTagID()}
+		req := GetTodosRequest{
+			TagIDs:   tagIDs,
+			TagMatch: todo.TagMatchAll,
+		}
+		expectedFilter := todo.TodoFilter{
+			TagIDs:   tagIDs,
+			TagMatch: todo.TagMatchAll,
+		}
+		expectedTodos := []*todo.Todo{}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect repository FindAll to be called with the filter
+		mockTxRunner.EXPECT().RunInTx(uow.WithReadOnly(ctx), mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindAll(ctx, expectedFilter).Return(expectedTodos, nil)
+				return fn(ctx)
+			})
+
+		useCase := &getTodosUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, expectedTodos, result)
+	})
+
 	t.Run("returns error when repository fails", func(t *testing.T) {
 		// Given
 		ctx := context.Background()
@@ -102,9 +141,9 @@ func TestGetTodosUseCase_Execute(t *testing.T) {
 		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
 
 		// Repository error occurs within transaction
-		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-				mockRepo.EXPECT().FindAll(ctx).Return(nil, repoError)
+		mockTxRunner.EXPECT().RunInTx(uow.WithReadOnly(ctx), mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindAll(ctx, todo.TodoFilter{}).Return(nil, repoError)
 				return fn(ctx)
 			})
 
@@ -132,7 +171,7 @@ func TestGetTodosUseCase_Execute(t *testing.T) {
 		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
 
 		// Transaction itself fails
-		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+		mockTxRunner.EXPECT().RunInTx(uow.WithReadOnly(ctx), mock.AnythingOfType("func(context.Context) error")).
 			Return(txError)
 
 		useCase := &getTodosUseCase{
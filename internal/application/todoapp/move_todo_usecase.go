@@ -0,0 +1,89 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type MoveTodoRequest struct {
+	ID todo.TodoID
+	// ParentID is the new parent. Nil moves the Todo to the root.
+	ParentID *todo.TodoID
+}
+
+// MoveTodoUseCase is the interface that wraps the basic MoveTodo operation.
+type MoveTodoUseCase interface {
+	Execute(ctx context.Context, req MoveTodoRequest) error
+}
+
+// moveTodoUseCase is the implementation of the MoveTodoUseCase interface.
+type moveTodoUseCase struct {
+	todoRepository todo.TodoRepository
+	txRunner       uow.TransactionRunner
+	clock          clock.Clock
+}
+
+// NewMoveTodoUseCase creates a new MoveTodoUseCase.
+func NewMoveTodoUseCase(i *do.Injector) (MoveTodoUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &moveTodoUseCase{
+		todoRepository: todoRepository,
+		txRunner:       txRunner,
+		clock:          clk,
+	}, nil
+}
+
+// Execute re-parents a Todo together with its subtree.
+func (u *moveTodoUseCase) Execute(ctx context.Context, req MoveTodoRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+
+		now := u.clock.Now()
+		if req.ParentID == nil {
+			foundTodo.ClearParent(now)
+		} else {
+			descendants, err := u.todoRepository.FindDescendants(ctx, req.ID)
+			if err != nil {
+				return fmt.Errorf("failed to find descendants: %w", err)
+			}
+			height := todo.NewTodoTree(foundTodo, descendants).Height()
+			if err := setParent(ctx, now, u.todoRepository, foundTodo, *req.ParentID, height); err != nil {
+				return err
+			}
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
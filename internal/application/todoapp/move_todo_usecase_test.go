@@ -0,0 +1,172 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveTodoUseCase_Execute(t *testing.T) {
+	newTodo := func(id todo.TodoID, parentID *todo.TodoID) *todo.Todo {
+		return todo.ReconstructTodoWithStatus(
+			id.UUID(),
+			"Test Todo",
+			"",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			parentID,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+	}
+
+	t.Run("successfully moves todo under a new parent", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		parentID := todo.TodoID(uuid.New())
+		req := MoveTodoRequest{ID: todoID, ParentID: &parentID}
+		existingTodo := newTodo(todoID, nil)
+		parent := newTodo(parentID, nil)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return(nil, nil)
+				mockRepo.EXPECT().FindByID(ctx, parentID).Return(parent, nil)
+				mockRepo.EXPECT().FindAncestorIDs(ctx, parentID).Return(nil, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &moveTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, parentID, *existingTodo.ParentID())
+	})
+
+	t.Run("moves todo to the root when parent is nil", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		parentID := todo.TodoID(uuid.New())
+		req := MoveTodoRequest{ID: todoID}
+		existingTodo := newTodo(todoID, &parentID)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &moveTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, existingTodo.IsRoot())
+	})
+
+	t.Run("returns validation error when moving under its own descendant", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		childID := todo.TodoID(uuid.New())
+		req := MoveTodoRequest{ID: todoID, ParentID: &childID}
+		existingTodo := newTodo(todoID, nil)
+		child := newTodo(childID, &todoID)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindDescendants(ctx, todoID).Return([]*todo.Todo{child}, nil)
+				mockRepo.EXPECT().FindByID(ctx, childID).Return(child, nil)
+				mockRepo.EXPECT().FindAncestorIDs(ctx, childID).Return([]todo.TodoID{todoID}, nil)
+				return fn(ctx)
+			})
+
+		useCase := &moveTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("returns error when update repository fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		parentID := todo.TodoID(uuid.New())
+		req := MoveTodoRequest{ID: todoID}
+		existingTodo := newTodo(todoID, &parentID)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(errors.New("update failed"))
+				return fn(ctx)
+			})
+
+		useCase := &moveTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
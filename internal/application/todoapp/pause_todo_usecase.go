@@ -0,0 +1,82 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type PauseTodoRequest struct {
+	ID todo.TodoID
+}
+
+// PauseTodoUseCase is the interface that wraps the basic PauseTodo operation.
+type PauseTodoUseCase interface {
+	Execute(ctx context.Context, req PauseTodoRequest) error
+}
+
+// pauseTodoUseCase is the implementation of the PauseTodoUseCase interface.
+type pauseTodoUseCase struct {
+	todoRepository todo.TodoRepository
+	txRunner       uow.TransactionRunner
+	clock          clock.Clock
+}
+
+// NewPauseTodoUseCase creates a new PauseTodoUseCase.
+func NewPauseTodoUseCase(i *do.Injector) (PauseTodoUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &pauseTodoUseCase{
+		todoRepository: todoRepository,
+		txRunner:       transactionManager,
+		clock:          clk,
+	}, nil
+}
+
+// Execute pauses a Todo by changing its status from in progress to on hold.
+func (u *pauseTodoUseCase) Execute(ctx context.Context, req PauseTodoRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+
+		if err := foundTodo.Pause(u.clock.Now()); err != nil {
+			// Preserve domain errors
+			var stateErr *todo.StateError
+			if errors.As(err, &stateErr) {
+				return err
+			}
+			return fmt.Errorf("failed to pause todo: %w", err)
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
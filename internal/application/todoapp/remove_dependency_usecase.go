@@ -0,0 +1,78 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type RemoveDependencyRequest struct {
+	ID        todo.TodoID
+	BlockerID todo.TodoID
+}
+
+// RemoveDependencyUseCase is the interface that wraps the basic RemoveDependency operation.
+type RemoveDependencyUseCase interface {
+	Execute(ctx context.Context, req RemoveDependencyRequest) error
+}
+
+// removeDependencyUseCase is the implementation of the RemoveDependencyUseCase interface.
+type removeDependencyUseCase struct {
+	todoRepository todo.TodoRepository
+	txRunner       uow.TransactionRunner
+	clock          clock.Clock
+}
+
+// NewRemoveDependencyUseCase creates a new RemoveDependencyUseCase.
+func NewRemoveDependencyUseCase(i *do.Injector) (RemoveDependencyUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &removeDependencyUseCase{
+		todoRepository: todoRepository,
+		txRunner:       txRunner,
+		clock:          clk,
+	}, nil
+}
+
+// Execute removes the dependency of a Todo on another Todo.
+func (u *removeDependencyUseCase) Execute(ctx context.Context, req RemoveDependencyRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+
+		if err := foundTodo.RemoveBlocker(u.clock.Now(), req.BlockerID); err != nil {
+			return err
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveDependencyUseCase_Execute(t *testing.T) {
+	newTodo := func(id todo.TodoID, blockerIDs []todo.TodoID) *todo.Todo {
+		return todo.ReconstructTodoWithStatus(
+			id.UUID(),
+			"Test Todo",
+			"",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			nil,
+			blockerIDs,
+			nil,
+			nil,
+			"",
+		)
+	}
+
+	t.Run("successfully removes dependency", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		blockerID := todo.TodoID(uuid.New())
+		req := RemoveDependencyRequest{ID: todoID, BlockerID: blockerID}
+		existingTodo := newTodo(todoID, []todo.TodoID{blockerID})
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &removeDependencyUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Empty(t, existingTodo.BlockerIDs())
+	})
+
+	t.Run("returns validation error when dependency does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := RemoveDependencyRequest{ID: todoID, BlockerID: todo.NewTodoID()}
+		existingTodo := newTodo(todoID, nil)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				return fn(ctx)
+			})
+
+		useCase := &removeDependencyUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("returns error when update repository fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		blockerID := todo.TodoID(uuid.New())
+		req := RemoveDependencyRequest{ID: todoID, BlockerID: blockerID}
+		existingTodo := newTodo(todoID, []todo.TodoID{blockerID})
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(errors.New("update failed"))
+				return fn(ctx)
+			})
+
+		useCase := &removeDependencyUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
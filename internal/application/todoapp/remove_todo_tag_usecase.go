@@ -0,0 +1,74 @@
+package todoapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type RemoveTodoTagRequest struct {
+	ID    todo.TodoID
+	TagID tag.TagID
+}
+
+// RemoveTodoTagUseCase is the interface that wraps the basic RemoveTodoTag operation.
+type RemoveTodoTagUseCase interface {
+	Execute(ctx context.Context, req RemoveTodoTagRequest) error
+}
+
+// removeTodoTagUseCase is the implementation of the RemoveTodoTagUseCase interface.
+type removeTodoTagUseCase struct {
+	todoRepository todo.TodoRepository
+	txRunner       uow.TransactionRunner
+	clock          clock.Clock
+}
+
+// NewRemoveTodoTagUseCase creates a new RemoveTodoTagUseCase.
+func NewRemoveTodoTagUseCase(i *do.Injector) (RemoveTodoTagUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &removeTodoTagUseCase{
+		todoRepository: todoRepository,
+		txRunner:       txRunner,
+		clock:          clk,
+	}, nil
+}
+
+// Execute detaches a Tag from a Todo.
+func (u *removeTodoTagUseCase) Execute(ctx context.Context, req RemoveTodoTagRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := foundTodo.RemoveTag(u.clock.Now(), req.TagID); err != nil {
+			return err
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
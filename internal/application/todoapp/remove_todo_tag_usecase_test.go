@@ -0,0 +1,156 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveTodoTagUseCase_Execute(t *testing.T) {
+	t.Run("successfully detaches tag", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		tagID := tag.TagID(uuid.New())
+		req := RemoveTodoTagRequest{ID: todoID, TagID: tagID}
+
+		existingTodo := todo.ReconstructTodoWithStatus(
+			todoID.UUID(),
+			"Test Todo",
+			"Test Body",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+			nil,
+			[]tag.TagID{tagID},
+			nil,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect repository operations to be called within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &removeTodoTagUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.False(t, existingTodo.HasTag(tagID))
+	})
+
+	t.Run("returns validation error when tag is not attached", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := RemoveTodoTagRequest{ID: todoID, TagID: tag.TagID(uuid.New())}
+
+		existingTodo := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Test Todo",
+			"Test Body",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// RemoveTag fails within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				return fn(ctx)
+			})
+
+		useCase := &removeTodoTagUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("returns error when update repository fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		tagID := tag.TagID(uuid.New())
+		req := RemoveTodoTagRequest{ID: todoID, TagID: tagID}
+
+		existingTodo := todo.ReconstructTodoWithStatus(
+			todoID.UUID(),
+			"Test Todo",
+			"Test Body",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+			nil,
+			[]tag.TagID{tagID},
+			nil,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+		updateError := errors.New("update failed")
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Update error occurs within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(updateError)
+				return fn(ctx)
+			})
+
+		useCase := &removeTodoTagUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
@@ -0,0 +1,82 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type ReopenTodoRequest struct {
+	ID todo.TodoID
+}
+
+// ReopenTodoUseCase is the interface that wraps the basic ReopenTodo operation.
+type ReopenTodoUseCase interface {
+	Execute(ctx context.Context, req ReopenTodoRequest) error
+}
+
+// reopenTodoUseCase is the implementation of the ReopenTodoUseCase interface.
+type reopenTodoUseCase struct {
+	todoRepository todo.TodoRepository
+	txRunner       uow.TransactionRunner
+	clock          clock.Clock
+}
+
+// NewReopenTodoUseCase creates a new ReopenTodoUseCase.
+func NewReopenTodoUseCase(i *do.Injector) (ReopenTodoUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &reopenTodoUseCase{
+		todoRepository: todoRepository,
+		txRunner:       transactionManager,
+		clock:          clk,
+	}, nil
+}
+
+// Execute reopens a completed or cancelled Todo by changing its status back to not started.
+func (u *reopenTodoUseCase) Execute(ctx context.Context, req ReopenTodoRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+
+		if err := foundTodo.Reopen(u.clock.Now()); err != nil {
+			// Preserve domain errors
+			var stateErr *todo.StateError
+			if errors.As(err, &stateErr) {
+				return err
+			}
+			return fmt.Errorf("failed to reopen todo: %w", err)
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type ResumeTodoRequest struct {
+	ID todo.TodoID
+}
+
+// ResumeTodoUseCase is the interface that wraps the basic ResumeTodo operation.
+type ResumeTodoUseCase interface {
+	Execute(ctx context.Context, req ResumeTodoRequest) error
+}
+
+// resumeTodoUseCase is the implementation of the ResumeTodoUseCase interface.
+type resumeTodoUseCase struct {
+	todoRepository    todo.TodoRepository
+	dependencyService *dependencyService
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+}
+
+// NewResumeTodoUseCase creates a new ResumeTodoUseCase.
+func NewResumeTodoUseCase(i *do.Injector) (ResumeTodoUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &resumeTodoUseCase{
+		todoRepository:    todoRepository,
+		dependencyService: newDependencyService(todoRepository),
+		txRunner:          transactionManager,
+		clock:             clk,
+	}, nil
+}
+
+// Execute resumes a Todo by changing its status from on hold back to in progress.
+func (u *resumeTodoUseCase) Execute(ctx context.Context, req ResumeTodoRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+
+		blockers, err := u.dependencyService.LoadBlockers(ctx, foundTodo)
+		if err != nil {
+			return fmt.Errorf("failed to load blockers: %w", err)
+		}
+
+		if err := foundTodo.Resume(u.clock.Now(), blockers...); err != nil {
+			// Preserve domain errors
+			var stateErr *todo.StateError
+			if errors.As(err, &stateErr) {
+				return err
+			}
+			return fmt.Errorf("failed to resume todo: %w", err)
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type RevertTodoRequest struct {
+	ID todo.TodoID
+	// Version is the version to restore, counting from 1.
+	Version int
+}
+
+// RevertTodoUseCase is the interface that wraps the basic RevertTodo operation.
+type RevertTodoUseCase interface {
+	Execute(ctx context.Context, req RevertTodoRequest) error
+}
+
+// revertTodoUseCase is the implementation of the RevertTodoUseCase interface.
+type revertTodoUseCase struct {
+	todoRepository    todo.TodoRepository
+	historyRepository history.HistoryRepository
+	dependencyService *dependencyService
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+}
+
+// NewRevertTodoUseCase creates a new RevertTodoUseCase.
+func NewRevertTodoUseCase(i *do.Injector) (RevertTodoUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	historyRepository, err := do.Invoke[history.HistoryRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke history repository: %w", err)
+	}
+	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &revertTodoUseCase{
+		todoRepository:    todoRepository,
+		historyRepository: historyRepository,
+		dependencyService: newDependencyService(todoRepository),
+		txRunner:          transactionManager,
+		clock:             clk,
+	}, nil
+}
+
+// Execute restores the title, body and status of a Todo from a version in its history.
+// The restore is saved as a new version. The status is changed through the same
+// transition rules as TransitionTodo, so it fails when the old status cannot be reached.
+func (u *revertTodoUseCase) Execute(ctx context.Context, req RevertTodoRequest) error {
+	if req.Version < 1 {
+		return &todo.ValidationError{Field: "version", Message: "version must be 1 or greater"}
+	}
+
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+
+		entries, err := findVersionEntries(ctx, u.historyRepository, req.ID, nil, req.Version)
+		if err != nil {
+			return err
+		}
+		version, err := history.ReplayTodo(req.ID, entries)
+		if err != nil {
+			return err
+		}
+
+		now := u.clock.Now()
+		if err := foundTodo.SetTitle(now, version.Title()); err != nil {
+			return err
+		}
+		if err := foundTodo.SetBody(now, version.Body()); err != nil {
+			return err
+		}
+		if version.StatusID() != foundTodo.StatusID() {
+			blockers, err := u.dependencyService.LoadBlockers(ctx, foundTodo)
+			if err != nil {
+				return fmt.Errorf("failed to load blockers: %w", err)
+			}
+			if err := foundTodo.TransitionTo(now, version.StatusID(), blockers...); err != nil {
+				// Preserve domain errors
+				var stateErr *todo.StateError
+				var validationErr *todo.ValidationError
+				if errors.As(err, &stateErr) || errors.As(err, &validationErr) {
+					return err
+				}
+				return fmt.Errorf("failed to transition todo: %w", err)
+			}
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package todoapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_history"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevertTodoUseCase_Execute(t *testing.T) {
+	t.Run("successfully restores title, body and status from a version", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		entries := newTodoHistory(t, todoID)
+		// The todo was completed after the last recorded version.
+		existingTodo := todo.ReconstructTodoWithStatus(todoID.UUID(), "Oops", "overwritten",
+			todo.TodoStatusCompleted, time.Now(), time.Now(), nil, nil, nil, nil, nil, nil, "")
+		req := RevertTodoRequest{ID: todoID, Version: 1}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockHistoryRepo.EXPECT().FindByTodoID(ctx, todoID, 1, 0).Return(entries[:1], nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &revertTodoUseCase{
+			todoRepository:    mockRepo,
+			historyRepository: mockHistoryRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "Write report", existingTodo.Title())
+		require.Equal(t, "", existingTodo.Body())
+		require.Equal(t, todo.TodoStatusNotStarted, existingTodo.Status())
+	})
+
+	t.Run("returns state error when the old status cannot be reached", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		entries := newTodoHistory(t, todoID)
+		// The todo is on hold, which cannot go back to not started.
+		existingTodo := todo.ReconstructTodoWithStatus(todoID.UUID(), "Write the annual report", "",
+			todo.TodoStatusOnHold, time.Now(), time.Now(), nil, nil, nil, nil, nil, nil, "")
+		req := RevertTodoRequest{ID: todoID, Version: 2}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockHistoryRepo.EXPECT().FindByTodoID(ctx, todoID, 2, 0).Return(entries[:2], nil)
+				return fn(ctx)
+			})
+
+		useCase := &revertTodoUseCase{
+			todoRepository:    mockRepo,
+			historyRepository: mockHistoryRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var stateErr *todo.StateError
+		require.ErrorAs(t, err, &stateErr)
+	})
+
+	t.Run("returns version not found error when the version does not exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.NewTodoID()
+		entries := newTodoHistory(t, todoID)
+		existingTodo := todo.ReconstructTodo(todoID.UUID(), "Write the annual report", "",
+			todo.TodoStatusInProgress, time.Now(), time.Now())
+		req := RevertTodoRequest{ID: todoID, Version: 9}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockHistoryRepo := mock_history.NewMockHistoryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockHistoryRepo.EXPECT().FindByTodoID(ctx, todoID, 9, 0).Return(entries, nil)
+				return fn(ctx)
+			})
+
+		useCase := &revertTodoUseCase{
+			todoRepository:    mockRepo,
+			historyRepository: mockHistoryRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var versionNotFoundErr *history.VersionNotFoundError
+		require.ErrorAs(t, err, &versionNotFoundErr)
+	})
+}
@@ -0,0 +1,102 @@
+package todoapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+const (
+	// DefaultSearchPageSize is the number of hits returned when PageSize is not set.
+	DefaultSearchPageSize = 20
+	// MaxSearchPageSize is the maximum number of hits returned at once.
+	MaxSearchPageSize = 100
+)
+
+type SearchTodosRequest struct {
+	// Query is parsed with todo.ParseSearchQuery.
+	Query string
+	// PageSize defaults to DefaultSearchPageSize.
+	PageSize int
+	// Offset is the number of hits to skip, usually the NextOffset of the previous page.
+	Offset int
+}
+
+type SearchTodosResult struct {
+	Hits []*todo.SearchHit
+	// NextOffset is the Offset of the next page, or nil on the last page.
+	NextOffset *int
+}
+
+// SearchTodosUseCase is the interface that wraps the basic SearchTodos operation.
+type SearchTodosUseCase interface {
+	Execute(ctx context.Context, req SearchTodosRequest) (*SearchTodosResult, error)
+}
+
+// searchTodosUseCase is the implementation of the SearchTodosUseCase interface.
+type searchTodosUseCase struct {
+	todoSearchRepository todo.TodoSearchRepository
+	txRunner             uow.TransactionRunner
+}
+
+// NewSearchTodosUseCase creates a new SearchTodosUseCase.
+func NewSearchTodosUseCase(i *do.Injector) (SearchTodosUseCase, error) {
+	todoSearchRepository, err := do.Invoke[todo.TodoSearchRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo search repository: %w", err)
+	}
+	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &searchTodosUseCase{
+		todoSearchRepository: todoSearchRepository,
+		txRunner:             transactionManager,
+	}, nil
+}
+
+// Execute finds the todos whose title or body match the query, best match first.
+func (u searchTodosUseCase) Execute(ctx context.Context, req SearchTodosRequest) (*SearchTodosResult, error) {
+	query, err := todo.ParseSearchQuery(req.Query)
+	if err != nil {
+		// Return domain error directly for proper error handling
+		return nil, err
+	}
+	if req.PageSize < 0 || req.PageSize > MaxSearchPageSize {
+		return nil, &todo.ValidationError{
+			Field:   "page_size",
+			Message: fmt.Sprintf("page size must be between 0 and %d", MaxSearchPageSize),
+		}
+	}
+	if req.Offset < 0 {
+		return nil, &todo.ValidationError{Field: "page_token", Message: "invalid page token"}
+	}
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultSearchPageSize
+	}
+
+	var result *SearchTodosResult
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		// One extra hit tells whether there is a next page.
+		hits, err := u.todoSearchRepository.Search(ctx, query, pageSize+1, req.Offset)
+		if err != nil {
+			return fmt.Errorf("failed to search todos: %w", err)
+		}
+		result = &SearchTodosResult{Hits: hits}
+		if len(hits) > pageSize {
+			result.Hits = hits[:pageSize]
+			nextOffset := req.Offset + pageSize
+			result.NextOffset = &nextOffset
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,182 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newSearchHits(t *testing.T, n int) []*todo.SearchHit {
+	t.Helper()
+	hits := make([]*todo.SearchHit, n)
+	for i := range hits {
+		found, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Deploy the api", "")
+		require.NoError(t, err)
+		hits[i] = &todo.SearchHit{
+			Todo:         found,
+			Score:        float64(n - i),
+			TitleSnippet: todo.HighlightStart + "Deploy" + todo.HighlightEnd + " the api",
+		}
+	}
+	return hits
+}
+
+func TestSearchTodosUseCase_Execute(t *testing.T) {
+	t.Run("returns the last page without next offset", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := SearchTodosRequest{Query: "deploy*", PageSize: 2}
+		expectedQuery, err := todo.ParseSearchQuery("deploy*")
+		require.NoError(t, err)
+		hits := newSearchHits(t, 2)
+
+		mockRepo := mock_todo.NewMockTodoSearchRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// One extra hit is requested to detect the next page
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Search(ctx, expectedQuery, 3, 0).Return(hits, nil)
+				return fn(ctx)
+			})
+
+		useCase := &searchTodosUseCase{
+			todoSearchRepository: mockRepo,
+			txRunner:             mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, hits, result.Hits)
+		require.Nil(t, result.NextOffset)
+	})
+
+	t.Run("returns next offset when more hits exist", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := SearchTodosRequest{Query: "deploy", PageSize: 2, Offset: 4}
+		hits := newSearchHits(t, 3)
+
+		mockRepo := mock_todo.NewMockTodoSearchRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Search(ctx, mock.AnythingOfType("todo.SearchQuery"), 3, 4).Return(hits, nil)
+				return fn(ctx)
+			})
+
+		useCase := &searchTodosUseCase{
+			todoSearchRepository: mockRepo,
+			txRunner:             mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, hits[:2], result.Hits)
+		require.NotNil(t, result.NextOffset)
+		require.Equal(t, 6, *result.NextOffset)
+	})
+
+	t.Run("uses the default page size", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := SearchTodosRequest{Query: "deploy"}
+
+		mockRepo := mock_todo.NewMockTodoSearchRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Search(ctx, mock.AnythingOfType("todo.SearchQuery"), DefaultSearchPageSize+1, 0).Return(nil, nil)
+				return fn(ctx)
+			})
+
+		useCase := &searchTodosUseCase{
+			todoSearchRepository: mockRepo,
+			txRunner:             mockTxRunner,
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Empty(t, result.Hits)
+	})
+
+	t.Run("returns validation error for an empty query", func(t *testing.T) {
+		// Given
+		useCase := &searchTodosUseCase{
+			todoSearchRepository: mock_todo.NewMockTodoSearchRepository(t),
+			txRunner:             mock_uow.NewMockTransactionRunner(t),
+		}
+
+		// When
+		_, err := useCase.Execute(context.Background(), SearchTodosRequest{Query: `""`})
+
+		// Then
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "query", validationErr.Field)
+	})
+
+	t.Run("returns validation error for a too large page size", func(t *testing.T) {
+		// Given
+		useCase := &searchTodosUseCase{
+			todoSearchRepository: mock_todo.NewMockTodoSearchRepository(t),
+			txRunner:             mock_uow.NewMockTransactionRunner(t),
+		}
+
+		// When
+		_, err := useCase.Execute(context.Background(), SearchTodosRequest{Query: "deploy", PageSize: MaxSearchPageSize + 1})
+
+		// Then
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "page_size", validationErr.Field)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := SearchTodosRequest{Query: "deploy"}
+		repoError := errors.New("repository error")
+
+		mockRepo := mock_todo.NewMockTodoSearchRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Search(ctx, mock.AnythingOfType("todo.SearchQuery"), DefaultSearchPageSize+1, 0).Return(nil, repoError)
+				return fn(ctx)
+			})
+
+		useCase := &searchTodosUseCase{
+			todoSearchRepository: mockRepo,
+			txRunner:             mockTxRunner,
+		}
+
+		// When
+		_, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
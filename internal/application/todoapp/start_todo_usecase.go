@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/samber/do"
 )
@@ -21,8 +22,10 @@ type StartTodoUseCase interface {
 
 // startTodoUseCase is the implementation of the StartTodoUseCase interface.
 type startTodoUseCase struct {
-	todoRepository todo.TodoRepository
-	txRunner       uow.TransactionRunner
+	todoRepository    todo.TodoRepository
+	dependencyService *dependencyService
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
 }
 
 // NewStartTodoUseCase creates a new StartTodoUseCase.
@@ -35,10 +38,16 @@ func NewStartTodoUseCase(i *do.Injector) (StartTodoUseCase, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
 	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
 
 	return &startTodoUseCase{
-		todoRepository: todoRepository,
-		txRunner:       transactionManager,
+		todoRepository:    todoRepository,
+		dependencyService: newDependencyService(todoRepository),
+		txRunner:          transactionManager,
+		clock:             clk,
 	}, nil
 }
 
@@ -54,7 +63,12 @@ func (u *startTodoUseCase) Execute(ctx context.Context, req StartTodoRequest) er
 			return fmt.Errorf("failed to find todo: %w", err)
 		}
 
-		if err := foundTodo.Start(); err != nil {
+		blockers, err := u.dependencyService.LoadBlockers(ctx, foundTodo)
+		if err != nil {
+			return fmt.Errorf("failed to load blockers: %w", err)
+		}
+
+		if err := foundTodo.Start(u.clock.Now(), blockers...); err != nil {
 			// Preserve domain errors
 			var stateErr *todo.StateError
 			if errors.As(err, &stateErr) {
@@ -69,13 +83,7 @@ func (u *startTodoUseCase) Execute(ctx context.Context, req StartTodoRequest) er
 		return nil
 	})
 	if err != nil {
-		// Preserve domain errors
-		var notFoundErr *todo.NotFoundError
-		var stateErr *todo.StateError
-		if errors.As(err, &notFoundErr) || errors.As(err, &stateErr) {
-			return err
-		}
-		return fmt.Errorf("failed to execute transaction: %w", err)
+		return transactionError(err)
 	}
 	return nil
 }
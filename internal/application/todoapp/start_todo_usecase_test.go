@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
 	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
@@ -35,15 +37,17 @@ func TestStartTodoUseCase_Execute(t *testing.T) {
 
 		// Expect repository operations to be called within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
 				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
 				return fn(ctx)
 			})
 
 		useCase := &startTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
 		}
 
 		// When
@@ -53,6 +57,64 @@ func TestStartTodoUseCase_Execute(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("returns state error when a blocker is unfinished", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		blockerID := todo.TodoID(uuid.New())
+		req := StartTodoRequest{ID: todoID}
+
+		existingTodo := todo.ReconstructTodoWithStatus(
+			todoID.UUID(),
+			"Test Todo",
+			"Test Body",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			nil,
+			[]todo.TodoID{blockerID},
+			nil,
+			nil,
+			"",
+		)
+		blocker := todo.ReconstructTodo(
+			blockerID.UUID(),
+			"Blocker",
+			"",
+			todo.TodoStatusInProgress,
+			time.Now(),
+			time.Now(),
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().FindByID(ctx, blockerID).Return(blocker, nil)
+				return fn(ctx)
+			})
+
+		useCase := &startTodoUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var stateErr *todo.StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, "todo is blocked by unfinished todos", err.Error())
+		require.Equal(t, todo.TodoStatusNotStarted, existingTodo.Status())
+	})
+
 	t.Run("returns error when todo not found", func(t *testing.T) {
 		// Given
 		ctx := context.Background()
@@ -65,14 +127,16 @@ func TestStartTodoUseCase_Execute(t *testing.T) {
 
 		// FindByID error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(nil, findError)
 				return fn(ctx)
 			})
 
 		useCase := &startTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
 		}
 
 		// When
@@ -104,15 +168,17 @@ func TestStartTodoUseCase_Execute(t *testing.T) {
 
 		// Update error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
 				mockRepo.EXPECT().Update(ctx, existingTodo).Return(updateError)
 				return fn(ctx)
 			})
 
 		useCase := &startTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
 		}
 
 		// When
@@ -138,8 +204,10 @@ func TestStartTodoUseCase_Execute(t *testing.T) {
 			Return(txError)
 
 		useCase := &startTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
 		}
 
 		// When
@@ -171,14 +239,16 @@ func TestStartTodoUseCase_Execute(t *testing.T) {
 
 		// Start error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
 				return fn(ctx)
 			})
 
 		useCase := &startTodoUseCase{
-			todoRepository: mockRepo,
-			txRunner:       mockTxRunner,
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
 		}
 
 		// When
@@ -0,0 +1,35 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// setParent loads the parent and its ancestors and places target under it at now.
+// height is the number of levels of target's own subtree including itself.
+func setParent(
+	ctx context.Context,
+	now time.Time,
+	todoRepository todo.TodoRepository,
+	target *todo.Todo,
+	parentID todo.TodoID,
+	height int,
+) error {
+	parent, err := todoRepository.FindByID(ctx, parentID)
+	if err != nil {
+		var notFoundErr *todo.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return err
+		}
+		return fmt.Errorf("failed to find parent todo: %w", err)
+	}
+	ancestorIDs, err := todoRepository.FindAncestorIDs(ctx, parentID)
+	if err != nil {
+		return fmt.Errorf("failed to find ancestors of parent todo: %w", err)
+	}
+	return target.SetParent(now, parent, ancestorIDs, height)
+}
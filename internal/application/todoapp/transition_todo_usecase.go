@@ -0,0 +1,94 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type TransitionTodoRequest struct {
+	ID todo.TodoID
+	// StatusID is a status of the project workflow,
+	// or a built-in status such as "IN_PROGRESS" for todos without a project.
+	StatusID project.StatusID
+}
+
+// TransitionTodoUseCase is the interface that wraps the basic TransitionTodo operation.
+type TransitionTodoUseCase interface {
+	Execute(ctx context.Context, req TransitionTodoRequest) error
+}
+
+// transitionTodoUseCase is the implementation of the TransitionTodoUseCase interface.
+type transitionTodoUseCase struct {
+	todoRepository    todo.TodoRepository
+	dependencyService *dependencyService
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+}
+
+// NewTransitionTodoUseCase creates a new TransitionTodoUseCase.
+func NewTransitionTodoUseCase(i *do.Injector) (TransitionTodoUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	transactionManager, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+
+	return &transitionTodoUseCase{
+		todoRepository:    todoRepository,
+		dependencyService: newDependencyService(todoRepository),
+		txRunner:          transactionManager,
+		clock:             clk,
+	}, nil
+}
+
+// Execute moves a Todo to the given status if its workflow allows it.
+func (u *transitionTodoUseCase) Execute(ctx context.Context, req TransitionTodoRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		foundTodo, err := u.todoRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			var notFoundErr *todo.NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return err
+			}
+			return fmt.Errorf("failed to find todo: %w", err)
+		}
+
+		blockers, err := u.dependencyService.LoadBlockers(ctx, foundTodo)
+		if err != nil {
+			return fmt.Errorf("failed to load blockers: %w", err)
+		}
+
+		if err := foundTodo.TransitionTo(u.clock.Now(), req.StatusID, blockers...); err != nil {
+			// Preserve domain errors
+			var stateErr *todo.StateError
+			var validationErr *todo.ValidationError
+			if errors.As(err, &stateErr) || errors.As(err, &validationErr) {
+				return err
+			}
+			return fmt.Errorf("failed to transition todo: %w", err)
+		}
+
+		if err := u.todoRepository.Update(ctx, foundTodo); err != nil {
+			return fmt.Errorf("failed to update todo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return transactionError(err)
+	}
+	return nil
+}
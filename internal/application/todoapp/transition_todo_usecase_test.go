@@ -0,0 +1,220 @@
+package todoapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newReviewWorkflow(t *testing.T) *project.Workflow {
+	t.Helper()
+	workflow, err := project.NewWorkflow(
+		[]project.WorkflowStatus{
+			{ID: "todo", Name: "To Do", Category: project.StatusCategoryTodo},
+			{ID: "review", Name: "Review", Category: project.StatusCategoryDoing},
+			{ID: "done", Name: "Done", Category: project.StatusCategoryDone},
+		},
+		[]project.Transition{
+			{From: "todo", To: "review"},
+			{From: "review", To: "done"},
+		},
+	)
+	require.NoError(t, err)
+	return workflow
+}
+
+func newProjectTodo(t *testing.T, id todo.TodoID, statusID project.StatusID) *todo.Todo {
+	t.Helper()
+	projectID := project.NewProjectID()
+	workflow := newReviewWorkflow(t)
+	status, ok := workflow.Status(statusID)
+	require.True(t, ok)
+	lifecycle := map[project.StatusCategory]todo.TodoStatus{
+		project.StatusCategoryTodo:  todo.TodoStatusNotStarted,
+		project.StatusCategoryDoing: todo.TodoStatusInProgress,
+		project.StatusCategoryDone:  todo.TodoStatusCompleted,
+	}
+	return todo.ReconstructTodoWithStatus(
+		id.UUID(),
+		"Test Todo",
+		"Test Body",
+		lifecycle[status.Category],
+		time.Now(),
+		time.Now(),
+		nil,
+		nil,
+		nil,
+		nil,
+		&projectID,
+		workflow,
+		statusID,
+	)
+}
+
+func TestTransitionTodoUseCase_Execute(t *testing.T) {
+	t.Run("successfully moves todo along the project workflow", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := TransitionTodoRequest{ID: todoID, StatusID: "review"}
+		existingTodo := newProjectTodo(t, todoID, "todo")
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Expect repository operations to be called within transaction
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &transitionTodoUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, project.StatusID("review"), existingTodo.StatusID())
+		require.Equal(t, todo.TodoStatusInProgress, existingTodo.Status())
+	})
+
+	t.Run("returns state error when the workflow does not allow the transition", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := TransitionTodoRequest{ID: todoID, StatusID: "done"}
+		existingTodo := newProjectTodo(t, todoID, "todo")
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		// Update must not be called for a rejected transition
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				return fn(ctx)
+			})
+
+		useCase := &transitionTodoUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var stateErr *todo.StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, "todo cannot change from To Do to Done", stateErr.Message)
+	})
+
+	t.Run("returns validation error for an unknown status", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := TransitionTodoRequest{ID: todoID, StatusID: "archived"}
+		existingTodo := newProjectTodo(t, todoID, "todo")
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				return fn(ctx)
+			})
+
+		useCase := &transitionTodoUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("returns error when todo not found", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		req := TransitionTodoRequest{ID: todoID, StatusID: "review"}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(nil, &todo.NotFoundError{ID: todoID})
+				return fn(ctx)
+			})
+
+		useCase := &transitionTodoUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("returns error when transaction fails", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := TransitionTodoRequest{ID: todo.TodoID(uuid.New()), StatusID: "review"}
+		txError := errors.New("transaction error")
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			Return(txError)
+
+		useCase := &transitionTodoUseCase{
+			todoRepository:    mockRepo,
+			dependencyService: newDependencyService(mockRepo),
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to execute transaction")
+	})
+}
@@ -5,14 +5,16 @@ import (
 	"fmt"
 
 	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/samber/do"
 )
 
+// UpdateTodoRequest changes the fields of a Todo that are set and leaves the nil ones unchanged.
 type UpdateTodoRequest struct {
 	ID    todo.TodoID
-	Title string
-	Body  string
+	Title *string
+	Body  *string
 }
 
 // UpdateTodoUseCase is the interface that wraps the basic UpdateTodo operation.
@@ -24,6 +26,7 @@ type UpdateTodoUseCase interface {
 type updateTodoUseCase struct {
 	todoRepository todo.TodoRepository
 	txRunner       uow.TransactionRunner
+	clock          clock.Clock
 }
 
 // NewUpdateTodoUseCase creates a new UpdateTodoUseCase.
@@ -36,10 +39,15 @@ func NewUpdateTodoUseCase(i *do.Injector) (UpdateTodoUseCase, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
 	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
 
 	return &updateTodoUseCase{
 		todoRepository: todoRepository,
 		txRunner:       transactionManager,
+		clock:          clk,
 	}, nil
 }
 
@@ -50,11 +58,16 @@ func (u *updateTodoUseCase) Execute(ctx context.Context, req UpdateTodoRequest)
 		if err != nil {
 			return fmt.Errorf("failed to find todo: %w", err)
 		}
-		if err := todo.SetTitle(req.Title); err != nil {
-			return fmt.Errorf("failed to set title: %w", err)
+		now := u.clock.Now()
+		if req.Title != nil {
+			if err := todo.SetTitle(now, *req.Title); err != nil {
+				return fmt.Errorf("failed to set title: %w", err)
+			}
 		}
-		if err := todo.SetBody(req.Body); err != nil {
-			return fmt.Errorf("failed to set body: %w", err)
+		if req.Body != nil {
+			if err := todo.SetBody(now, *req.Body); err != nil {
+				return fmt.Errorf("failed to set body: %w", err)
+			}
 		}
 
 		if err := u.todoRepository.Update(ctx, todo); err != nil {
@@ -63,7 +76,7 @@ func (u *updateTodoUseCase) Execute(ctx context.Context, req UpdateTodoRequest)
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to execute transaction: %w", err)
+		return transactionError(err)
 	}
 	return nil
 }
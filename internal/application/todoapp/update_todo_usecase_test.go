@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
 	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
@@ -19,10 +21,11 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 		// Given
 		ctx := context.Background()
 		todoID := todo.TodoID(uuid.New())
+		title, body := "Updated Title", "Updated Body"
 		req := UpdateTodoRequest{
 			ID:    todoID,
-			Title: "Updated Title",
-			Body:  "Updated Body",
+			Title: &title,
+			Body:  &body,
 		}
 
 		existingTodo := todo.ReconstructTodo(
@@ -39,7 +42,7 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 
 		// Expect repository operations to be called within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
 				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
 				return fn(ctx)
@@ -48,6 +51,7 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 		useCase := &updateTodoUseCase{
 			todoRepository: mockRepo,
 			txRunner:       mockTxRunner,
+			clock:          clock.System(),
 		}
 
 		// When
@@ -55,16 +59,63 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 
 		// Then
 		require.NoError(t, err)
+		require.Equal(t, "Updated Title", existingTodo.Title())
+		require.Equal(t, "Updated Body", existingTodo.Body())
+	})
+
+	t.Run("leaves the fields without a value unchanged", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		todoID := todo.TodoID(uuid.New())
+		body := ""
+		req := UpdateTodoRequest{
+			ID:   todoID,
+			Body: &body,
+		}
+
+		existingTodo := todo.ReconstructTodo(
+			todoID.UUID(),
+			"Original Title",
+			"Original Body",
+			todo.TodoStatusNotStarted,
+			time.Now(),
+			time.Now(),
+		)
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
+				mockRepo.EXPECT().Update(ctx, existingTodo).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &updateTodoUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "Original Title", existingTodo.Title())
+		require.Empty(t, existingTodo.Body())
 	})
 
 	t.Run("returns error when todo not found", func(t *testing.T) {
 		// Given
 		ctx := context.Background()
 		todoID := todo.TodoID(uuid.New())
+		title, body := "Updated Title", "Updated Body"
 		req := UpdateTodoRequest{
 			ID:    todoID,
-			Title: "Updated Title",
-			Body:  "Updated Body",
+			Title: &title,
+			Body:  &body,
 		}
 		findError := errors.New("todo not found")
 
@@ -73,7 +124,7 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 
 		// FindByID error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(nil, findError)
 				return fn(ctx)
 			})
@@ -81,6 +132,7 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 		useCase := &updateTodoUseCase{
 			todoRepository: mockRepo,
 			txRunner:       mockTxRunner,
+			clock:          clock.System(),
 		}
 
 		// When
@@ -95,10 +147,11 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 		// Given
 		ctx := context.Background()
 		todoID := todo.TodoID(uuid.New())
+		title, body := "Updated Title", "Updated Body"
 		req := UpdateTodoRequest{
 			ID:    todoID,
-			Title: "Updated Title",
-			Body:  "Updated Body",
+			Title: &title,
+			Body:  &body,
 		}
 
 		existingTodo := todo.ReconstructTodo(
@@ -116,7 +169,7 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 
 		// Update error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
 				mockRepo.EXPECT().Update(ctx, existingTodo).Return(updateError)
 				return fn(ctx)
@@ -125,6 +178,7 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 		useCase := &updateTodoUseCase{
 			todoRepository: mockRepo,
 			txRunner:       mockTxRunner,
+			clock:          clock.System(),
 		}
 
 		// When
@@ -139,10 +193,11 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 		// Given
 		ctx := context.Background()
 		todoID := todo.TodoID(uuid.New())
+		title, body := "Updated Title", "Updated Body"
 		req := UpdateTodoRequest{
 			ID:    todoID,
-			Title: "Updated Title",
-			Body:  "Updated Body",
+			Title: &title,
+			Body:  &body,
 		}
 		txError := errors.New("transaction error")
 
@@ -156,6 +211,7 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 		useCase := &updateTodoUseCase{
 			todoRepository: mockRepo,
 			txRunner:       mockTxRunner,
+			clock:          clock.System(),
 		}
 
 		// When
@@ -170,10 +226,11 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 		// Given
 		ctx := context.Background()
 		todoID := todo.TodoID(uuid.New())
+		title, body := "", "Updated Body" // Empty title should cause domain error
 		req := UpdateTodoRequest{
 			ID:    todoID,
-			Title: "", // Empty title should cause domain error
-			Body:  "Updated Body",
+			Title: &title,
+			Body:  &body,
 		}
 
 		existingTodo := todo.ReconstructTodo(
@@ -190,7 +247,7 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 
 		// SetTitle error occurs within transaction
 		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
-			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
 				mockRepo.EXPECT().FindByID(ctx, todoID).Return(existingTodo, nil)
 				return fn(ctx)
 			})
@@ -198,13 +255,16 @@ func TestUpdateTodoUseCase_Execute(t *testing.T) {
 		useCase := &updateTodoUseCase{
 			todoRepository: mockRepo,
 			txRunner:       mockTxRunner,
+			clock:          clock.System(),
 		}
 
 		// When
 		err := useCase.Execute(ctx, req)
 
 		// Then
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "failed to execute transaction")
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Same(t, validationErr, err, "the domain error is returned unchanged")
+		require.Equal(t, "title", validationErr.Field)
 	})
 }
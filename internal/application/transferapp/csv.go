@@ -0,0 +1,157 @@
+package transferapp
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// csvColumns are the columns written by the CSV encoder, in order.
+// The decoder finds the columns by the header row, so they may be in any order and only title is required.
+var csvColumns = []string{
+	"id", "title", "body", "status", "created_at", "updated_at", "completed_at", "tag_ids", "parent_id", "project_id",
+}
+
+// csvEncoder writes a header row followed by one row per record.
+// Times are in RFC 3339 and tag IDs are separated by spaces.
+type csvEncoder struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func newCSVEncoder(w io.Writer) *csvEncoder {
+	return &csvEncoder{w: csv.NewWriter(w)}
+}
+
+func (e *csvEncoder) Encode(r record) error {
+	if !e.headerWritten {
+		if err := e.w.Write(csvColumns); err != nil {
+			return err
+		}
+		e.headerWritten = true
+	}
+	return e.w.Write([]string{
+		r.ID,
+		r.Title,
+		r.Body,
+		r.Status,
+		formatTime(r.CreatedAt),
+		formatTime(r.UpdatedAt),
+		formatTime(r.CompletedAt),
+		strings.Join(r.TagIDs, " "),
+		r.ParentID,
+		r.ProjectID,
+	})
+}
+
+func (e *csvEncoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// csvDecoder reads rows by the columns of the header row. Unknown columns are ignored.
+type csvDecoder struct {
+	r *csv.Reader
+	// columns maps column names to their index, and is nil until the header row is read.
+	columns map[string]int
+	done    bool
+}
+
+func newCSVDecoder(r io.Reader) *csvDecoder {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	return &csvDecoder{r: reader}
+}
+
+func (d *csvDecoder) Decode() (record, error) {
+	if d.done {
+		return record{}, io.EOF
+	}
+	if d.columns == nil {
+		header, err := d.r.Read()
+		if err != nil {
+			d.done = true
+			return record{}, d.readError(err)
+		}
+		d.columns = make(map[string]int, len(header))
+		for i, name := range header {
+			// Spreadsheets may start the file with a byte order mark.
+			d.columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+		}
+		if _, ok := d.columns["title"]; !ok {
+			d.done = true
+			return record{}, &LineError{Line: 1, Message: "header row has no title column"}
+		}
+	}
+
+	row, err := d.r.Read()
+	if err != nil {
+		return record{}, d.readError(err)
+	}
+	line, _ := d.r.FieldPos(0)
+	field := func(name string) string {
+		i, ok := d.columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	r := record{
+		line:      line,
+		ID:        field("id"),
+		Title:     field("title"),
+		Body:      field("body"),
+		Status:    field("status"),
+		TagIDs:    strings.Fields(field("tag_ids")),
+		ParentID:  field("parent_id"),
+		ProjectID: field("project_id"),
+	}
+	for name, dst := range map[string]**time.Time{
+		"created_at":   &r.CreatedAt,
+		"updated_at":   &r.UpdatedAt,
+		"completed_at": &r.CompletedAt,
+	} {
+		t, err := parseTime(field(name))
+		if err != nil {
+			return record{}, &LineError{Line: line, Message: fmt.Sprintf("%s: %v", name, err)}
+		}
+		*dst = t
+	}
+	return r, nil
+}
+
+// readError converts a malformed row to a LineError so that the following rows are still read.
+func (d *csvDecoder) readError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &LineError{Line: parseErr.StartLine, Message: parseErr.Err.Error()}
+	}
+	if errors.Is(err, io.EOF) {
+		return io.EOF
+	}
+	return fmt.Errorf("failed to read CSV: %w", err)
+}
+
+// formatTime formats t in RFC 3339, or returns an empty string for nil.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// parseTime parses a time in RFC 3339, or returns nil for an empty string.
+func parseTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, errors.New("time must be in RFC 3339")
+	}
+	return &t, nil
+}
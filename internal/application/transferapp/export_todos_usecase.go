@@ -0,0 +1,79 @@
+package transferapp
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+type ExportTodosRequest struct {
+	Format Format
+	// Filter narrows down the exported todos. The zero value exports every todo.
+	Filter todo.TodoFilter
+	// Output receives the file.
+	Output io.Writer
+}
+
+// ExportTodosUseCase is the interface that wraps the basic ExportTodos operation.
+type ExportTodosUseCase interface {
+	Execute(ctx context.Context, req ExportTodosRequest) error
+}
+
+// exportTodosUseCase is the implementation of the ExportTodosUseCase interface.
+type exportTodosUseCase struct {
+	todoRepository todo.TodoRepository
+	txRunner       uow.TransactionRunner
+}
+
+// NewExportTodosUseCase creates a new ExportTodosUseCase.
+func NewExportTodosUseCase(i *do.Injector) (ExportTodosUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &exportTodosUseCase{
+		todoRepository: todoRepository,
+		txRunner:       txRunner,
+	}, nil
+}
+
+// Execute writes the todos that match the filter to the output in the format.
+// The todos are read in a transaction first, so no transaction is held open while they are written.
+func (u exportTodosUseCase) Execute(ctx context.Context, req ExportTodosRequest) error {
+	enc, err := newEncoder(req.Format, req.Output)
+	if err != nil {
+		return err
+	}
+
+	var todos []*todo.Todo
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		found, err := u.todoRepository.FindAll(ctx, req.Filter)
+		if err != nil {
+			return fmt.Errorf("failed to find todos: %w", err)
+		}
+		todos = found
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to execute transaction: %w", err)
+	}
+
+	for _, t := range todos {
+		if err := enc.Encode(newRecord(t)); err != nil {
+			return fmt.Errorf("failed to write todo %v: %w", t.ID(), err)
+		}
+	}
+	if err := enc.Flush(); err != nil {
+		return fmt.Errorf("failed to write todos: %w", err)
+	}
+	return nil
+}
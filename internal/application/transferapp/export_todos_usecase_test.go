@@ -0,0 +1,67 @@
+package transferapp
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportTodosUseCase_Execute(t *testing.T) {
+	t.Run("successfully writes the todos that match the filter", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		completedAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		first := todo.ReconstructTodo(todo.NewTodoID().UUID(), "Write report", "", todo.TodoStatusNotStarted,
+			createdAt, createdAt)
+		second := todo.ReconstructTodoWithStatus(todo.NewTodoID().UUID(), "Pay rent", "", todo.TodoStatusCompleted,
+			createdAt, completedAt, &completedAt, nil, nil, nil, nil, nil, "")
+		filter := todo.TodoFilter{Actionable: true}
+		var output bytes.Buffer
+		req := ExportTodosRequest{Format: FormatMarkdown, Filter: filter, Output: &output}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindAll(ctx, filter).Return([]*todo.Todo{first, second}, nil)
+				return fn(ctx)
+			})
+
+		useCase := &exportTodosUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+		}
+
+		// When
+		err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t,
+			"- [ ] Write report <!-- id:"+first.ID().String()+" -->\n"+
+				"- [x] Pay rent <!-- id:"+second.ID().String()+" -->\n",
+			output.String())
+	})
+
+	t.Run("returns validation error for an unknown format", func(t *testing.T) {
+		// Given
+		useCase := &exportTodosUseCase{}
+
+		// When
+		err := useCase.Execute(context.Background(), ExportTodosRequest{Format: Format("xml"), Output: &bytes.Buffer{}})
+
+		// Then
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
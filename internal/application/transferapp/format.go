@@ -0,0 +1,99 @@
+// Package transferapp provides the application layer for importing and exporting todos as files.
+package transferapp
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// Format is a file format todos are imported from and exported to.
+type Format string
+
+const (
+	// FormatJSONL is one JSON object per line with every field of a todo.
+	FormatJSONL Format = "jsonl"
+	// FormatCSV is comma separated values with a header row.
+	FormatCSV Format = "csv"
+	// FormatMarkdown is a Markdown task list, "- [ ]" for open and "- [x]" for completed todos.
+	FormatMarkdown Format = "markdown"
+	// FormatTodoTxt is the todo.txt format, one todo per line.
+	FormatTodoTxt Format = "todotxt"
+)
+
+// Formats returns all supported formats.
+func Formats() []Format {
+	return []Format{FormatJSONL, FormatCSV, FormatMarkdown, FormatTodoTxt}
+}
+
+// String returns the string representation of the Format.
+func (f Format) String() string {
+	return string(f)
+}
+
+// NewFormatFromString creates a Format from its name, ignoring case.
+func NewFormatFromString(s string) (Format, error) {
+	for _, f := range Formats() {
+		if strings.EqualFold(s, f.String()) {
+			return f, nil
+		}
+	}
+	return "", &todo.ValidationError{Field: "format", Message: fmt.Sprintf("unknown format %q", s)}
+}
+
+// LineError is a line of an imported file that could not be imported.
+type LineError struct {
+	// Line is the number of the line in the file, counting from 1.
+	Line    int
+	Message string
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// encoder writes records in a format.
+type encoder interface {
+	Encode(r record) error
+	// Flush writes any buffered data.
+	Flush() error
+}
+
+// decoder reads records in a format.
+// Decode returns io.EOF at the end of the file and a *LineError for a line
+// that cannot be read; decoding may continue after a *LineError.
+type decoder interface {
+	Decode() (record, error)
+}
+
+func newEncoder(format Format, w io.Writer) (encoder, error) {
+	switch format {
+	case FormatJSONL:
+		return newJSONLEncoder(w), nil
+	case FormatCSV:
+		return newCSVEncoder(w), nil
+	case FormatMarkdown:
+		return newMarkdownEncoder(w), nil
+	case FormatTodoTxt:
+		return newTodoTxtEncoder(w), nil
+	default:
+		return nil, &todo.ValidationError{Field: "format", Message: fmt.Sprintf("unknown format %q", format)}
+	}
+}
+
+func newDecoder(format Format, r io.Reader) (decoder, error) {
+	switch format {
+	case FormatJSONL:
+		return newJSONLDecoder(r), nil
+	case FormatCSV:
+		return newCSVDecoder(r), nil
+	case FormatMarkdown:
+		return newMarkdownDecoder(r), nil
+	case FormatTodoTxt:
+		return newTodoTxtDecoder(r), nil
+	default:
+		return nil, &todo.ValidationError{Field: "format", Message: fmt.Sprintf("unknown format %q", format)}
+	}
+}
@@ -0,0 +1,222 @@
+package transferapp
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeAll reads every record and line error of the file.
+func decodeAll(t *testing.T, format Format, content string) ([]record, []LineError) {
+	t.Helper()
+	dec, err := newDecoder(format, strings.NewReader(content))
+	require.NoError(t, err)
+	var records []record
+	var lineErrs []LineError
+	for {
+		r, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return records, lineErrs
+		}
+		var lineErr *LineError
+		if errors.As(err, &lineErr) {
+			lineErrs = append(lineErrs, *lineErr)
+			continue
+		}
+		require.NoError(t, err)
+		records = append(records, r)
+	}
+}
+
+func TestFormats_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	completedAt := time.Date(2024, 1, 2, 18, 0, 0, 0, time.UTC)
+	completed := todo.ReconstructTodoWithStatus(todo.NewTodoID().UUID(), "Write report", "First line\n\nThird line",
+		todo.TodoStatusCompleted, createdAt, completedAt, &completedAt, nil, nil, nil, nil, nil, "")
+	open := todo.ReconstructTodoWithStatus(todo.NewTodoID().UUID(), "Review report", "",
+		todo.TodoStatusInProgress, createdAt, createdAt, nil, nil, nil, nil, nil, nil, "")
+
+	tests := []struct {
+		format Format
+		// keepsBody tells whether the format has room for the body.
+		keepsBody bool
+		// keepsStatus tells whether the format keeps statuses other than completed.
+		keepsStatus bool
+	}{
+		{format: FormatJSONL, keepsBody: true, keepsStatus: true},
+		{format: FormatCSV, keepsBody: true, keepsStatus: true},
+		{format: FormatMarkdown, keepsBody: true, keepsStatus: false},
+		{format: FormatTodoTxt, keepsBody: false, keepsStatus: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format.String(), func(t *testing.T) {
+			// Given
+			var buf bytes.Buffer
+			enc, err := newEncoder(tt.format, &buf)
+			require.NoError(t, err)
+			require.NoError(t, enc.Encode(newRecord(completed)))
+			require.NoError(t, enc.Encode(newRecord(open)))
+			require.NoError(t, enc.Flush())
+
+			// When
+			records, lineErrs := decodeAll(t, tt.format, buf.String())
+
+			// Then
+			require.Empty(t, lineErrs)
+			require.Len(t, records, 2)
+			first, err := records[0].toTodo(idgen.UUIDv7(), time.Now())
+			require.NoError(t, err)
+			second, err := records[1].toTodo(idgen.UUIDv7(), time.Now())
+			require.NoError(t, err)
+
+			require.Equal(t, completed.ID(), first.ID())
+			require.Equal(t, "Write report", first.Title())
+			require.Equal(t, todo.TodoStatusCompleted, first.Status())
+			require.NotNil(t, first.CompletedAt())
+			require.Equal(t, open.ID(), second.ID())
+			require.Equal(t, "Review report", second.Title())
+			if tt.keepsBody {
+				require.Equal(t, completed.Body(), first.Body())
+			}
+			if tt.keepsStatus {
+				require.Equal(t, todo.TodoStatusInProgress, second.Status())
+			} else {
+				require.Equal(t, todo.TodoStatusNotStarted, second.Status())
+			}
+		})
+	}
+}
+
+func TestDecoders_LineErrors(t *testing.T) {
+	t.Run("jsonl reports malformed lines and keeps reading", func(t *testing.T) {
+		content := "{\"title\":\"One\"}\n\n{not json}\n{\"title\":\"Two\"}\n"
+
+		records, lineErrs := decodeAll(t, FormatJSONL, content)
+
+		require.Len(t, records, 2)
+		require.Equal(t, 4, records[1].line)
+		require.Len(t, lineErrs, 1)
+		require.Equal(t, 3, lineErrs[0].Line)
+	})
+
+	t.Run("csv finds columns by the header row", func(t *testing.T) {
+		content := "Status,Title,Extra\nCOMPLETED,One,x\nNOT_STARTED,\"Two\n\n"
+
+		records, lineErrs := decodeAll(t, FormatCSV, content)
+
+		require.Len(t, records, 1)
+		require.Equal(t, "One", records[0].Title)
+		require.Equal(t, "COMPLETED", records[0].Status)
+		require.Equal(t, 2, records[0].line)
+		require.Len(t, lineErrs, 1)
+		require.Equal(t, 3, lineErrs[0].Line)
+	})
+
+	t.Run("csv requires a title column", func(t *testing.T) {
+		records, lineErrs := decodeAll(t, FormatCSV, "id,body\n1,two\n")
+
+		require.Empty(t, records)
+		require.Equal(t, []LineError{{Line: 1, Message: "header row has no title column"}}, lineErrs)
+	})
+}
+
+func TestMarkdownDecoder(t *testing.T) {
+	// Given
+	content := strings.Join([]string{
+		"# Release",
+		"",
+		"- [x] Tag the release",
+		"- [ ] Write notes",
+		"  Mention the migration",
+		"  * [X] Nested task",
+		"Closing paragraph",
+	}, "\n")
+
+	// When
+	records, lineErrs := decodeAll(t, FormatMarkdown, content)
+
+	// Then
+	require.Empty(t, lineErrs)
+	require.Len(t, records, 3)
+	require.Equal(t, "Tag the release", records[0].Title)
+	require.Equal(t, todo.TodoStatusCompleted.String(), records[0].Status)
+	require.Equal(t, 3, records[0].line)
+	require.Equal(t, "Write notes", records[1].Title)
+	require.Equal(t, "Mention the migration", records[1].Body)
+	require.Empty(t, records[1].Status)
+	require.Equal(t, "Nested task", records[2].Title)
+	require.Equal(t, todo.TodoStatusCompleted.String(), records[2].Status)
+}
+
+func TestTodoTxtDecoder(t *testing.T) {
+	// Given
+	content := strings.Join([]string{
+		"x 2024-01-05 2024-01-01 Pay rent +home @bank",
+		"(A) 2024-01-03 Call mom due:2024-01-10",
+		"2024-01-03 2024-01-04 Dates after the creation date are text",
+		"Plan trip status:on_hold",
+	}, "\n")
+
+	// When
+	records, lineErrs := decodeAll(t, FormatTodoTxt, content)
+
+	// Then
+	require.Empty(t, lineErrs)
+	require.Len(t, records, 4)
+
+	require.Equal(t, "Pay rent +home @bank", records[0].Title)
+	require.Equal(t, todo.TodoStatusCompleted.String(), records[0].Status)
+	require.Equal(t, time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), *records[0].CompletedAt)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *records[0].CreatedAt)
+
+	require.Equal(t, "Call mom due:2024-01-10", records[1].Title)
+	require.Empty(t, records[1].Status)
+	require.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), *records[1].CreatedAt)
+
+	require.Equal(t, "2024-01-04 Dates after the creation date are text", records[2].Title)
+
+	imported, err := records[3].toTodo(idgen.UUIDv7(), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, "Plan trip", imported.Title())
+	require.Equal(t, todo.TodoStatusOnHold, imported.Status())
+}
+
+func TestRecord_ToTodo(t *testing.T) {
+	t.Run("validates the title like a new todo", func(t *testing.T) {
+		_, err := record{Title: ""}.toTodo(idgen.UUIDv7(), time.Now())
+
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "title", validationErr.Field)
+	})
+
+	t.Run("rejects unknown statuses and malformed IDs", func(t *testing.T) {
+		_, err := record{Title: "One", Status: "DONE"}.toTodo(idgen.UUIDv7(), time.Now())
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "status", validationErr.Field)
+
+		_, err = record{Title: "One", ID: "42"}.toTodo(idgen.UUIDv7(), time.Now())
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "id", validationErr.Field)
+	})
+
+	t.Run("creates a new todo without optional fields", func(t *testing.T) {
+		now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+
+		imported, err := record{Title: "One"}.toTodo(idgen.NewSequence(), now)
+
+		require.NoError(t, err)
+		require.Equal(t, "00000000-0000-7000-8000-000000000001", imported.ID().String())
+		require.Equal(t, now, imported.CreatedAt())
+		require.Equal(t, todo.TodoStatusNotStarted, imported.Status())
+		require.Nil(t, imported.CompletedAt())
+	})
+}
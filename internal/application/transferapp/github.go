@@ -0,0 +1,149 @@
+package transferapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+)
+
+// Statuses of the workflow given to the projects of GitHub repositories.
+const (
+	githubStatusOpen       project.StatusID = "open"
+	githubStatusClosed     project.StatusID = "closed"
+	githubStatusNotPlanned project.StatusID = "not_planned"
+)
+
+// githubIssue is an issue as returned by the REST API (`gh api repos/{owner}/{repo}/issues`)
+// or by `gh issue list --json`, which names the same fields in camel case.
+type githubIssue struct {
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	Body        string `json:"body"`
+	State       string `json:"state"`
+	StateReason string `json:"state_reason"`
+	Labels      []struct {
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	} `json:"labels"`
+	CreatedAt   *time.Time      `json:"created_at"`
+	UpdatedAt   *time.Time      `json:"updated_at"`
+	ClosedAt    *time.Time      `json:"closed_at"`
+	URL         string          `json:"url"`
+	HTMLURL     string          `json:"html_url"`
+	PullRequest json.RawMessage `json:"pull_request"`
+
+	// Fields of `gh issue list --json`
+	StateReasonCamel string     `json:"stateReason"`
+	CreatedAtCamel   *time.Time `json:"createdAt"`
+	UpdatedAtCamel   *time.Time `json:"updatedAt"`
+	ClosedAtCamel    *time.Time `json:"closedAt"`
+}
+
+// parseGitHub maps the repositories of GitHub issues to projects, the issues to todos
+// and their labels to tags. Closed issues are done, in a separate status when they were not planned.
+// Issues are identified as "owner/repo#number" and labels by their names.
+// Pull requests are left out.
+func parseGitHub(content []byte) (*sourceData, error) {
+	var issues []githubIssue
+	if err := json.Unmarshal(content, &issues); err != nil {
+		return nil, invalidExport("GitHub", err)
+	}
+
+	statuses := []project.WorkflowStatus{
+		{ID: githubStatusOpen, Name: "Open", Category: project.StatusCategoryTodo},
+		{ID: githubStatusClosed, Name: "Closed", Category: project.StatusCategoryDone},
+		{ID: githubStatusNotPlanned, Name: "Not planned", Category: project.StatusCategoryDone},
+	}
+	workflow, err := project.NewWorkflow(statuses, anyToAny(statuses))
+	if err != nil {
+		return nil, err
+	}
+
+	data := &sourceData{}
+	repositories := make(map[string]bool)
+	labels := make(map[string]bool)
+	for i, issue := range issues {
+		if len(issue.PullRequest) > 0 && string(issue.PullRequest) != "null" {
+			continue
+		}
+		repository, err := githubRepository(issue.HTMLURL, issue.URL)
+		if err != nil {
+			return nil, invalidExport("GitHub", fmt.Errorf("issue %d: %w", i+1, err))
+		}
+		if !repositories[repository] {
+			repositories[repository] = true
+			data.projects = append(data.projects, sourceProject{externalID: repository, name: repository, workflow: workflow})
+		}
+
+		t := sourceTodo{
+			externalID:  fmt.Sprintf("%s#%d", repository, issue.Number),
+			projectID:   repository,
+			statusID:    githubStatusOpen,
+			title:       issue.Title,
+			body:        issue.Body,
+			createdAt:   firstTime(issue.CreatedAt, issue.CreatedAtCamel),
+			updatedAt:   firstTime(issue.UpdatedAt, issue.UpdatedAtCamel),
+			completedAt: firstTime(issue.ClosedAt, issue.ClosedAtCamel),
+		}
+		if strings.EqualFold(issue.State, "closed") {
+			t.statusID = githubStatusClosed
+			reason := issue.StateReason
+			if reason == "" {
+				reason = issue.StateReasonCamel
+			}
+			if strings.EqualFold(reason, "not_planned") {
+				t.statusID = githubStatusNotPlanned
+			}
+		}
+		for _, l := range issue.Labels {
+			if !labels[l.Name] {
+				labels[l.Name] = true
+				tag := sourceTag{externalID: l.Name, name: l.Name}
+				if l.Color != "" {
+					tag.color = "#" + l.Color
+				}
+				data.tags = append(data.tags, tag)
+			}
+			t.tagIDs = append(t.tagIDs, l.Name)
+		}
+		data.todos = append(data.todos, t)
+	}
+	return data, nil
+}
+
+// githubRepository returns the "owner/repo" of an issue from its web or API URL.
+func githubRepository(urls ...string) (string, error) {
+	for _, raw := range urls {
+		if raw == "" {
+			continue
+		}
+		u, err := url.Parse(raw)
+		if err != nil {
+			return "", fmt.Errorf("invalid url %q: %w", raw, err)
+		}
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		// API URLs look like /repos/{owner}/{repo}/issues/{number}
+		if len(segments) > 0 && segments[0] == "repos" {
+			segments = segments[1:]
+		}
+		if len(segments) < 4 || (segments[2] != "issues" && segments[2] != "pull") {
+			return "", fmt.Errorf("url %q is not the url of an issue", raw)
+		}
+		return segments[0] + "/" + segments[1], nil
+	}
+	return "", fmt.Errorf("issue has no url")
+}
+
+// firstTime returns the first of the times that is set.
+func firstTime(times ...*time.Time) *time.Time {
+	for _, t := range times {
+		if t != nil {
+			return t
+		}
+	}
+	return nil
+}
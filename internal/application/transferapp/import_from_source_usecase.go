@@ -0,0 +1,487 @@
+package transferapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/externalref"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// MaxSourceSize is the maximum size of an export file of another tool in bytes.
+const MaxSourceSize = 32 << 20
+
+type ImportFromSourceRequest struct {
+	Source externalref.Source
+	// Content is read until EOF as the export file.
+	Content io.Reader
+	// DryRun reports what would be imported without saving anything.
+	DryRun bool
+}
+
+// ItemError is an item of an export file that could not be imported.
+type ItemError struct {
+	Kind       externalref.Kind
+	ExternalID string
+	Message    string
+}
+
+// SourceImportReport describes the outcome of an import from another tool.
+// The counts are of the entities created, or that would be created in a dry run.
+type SourceImportReport struct {
+	Projects int
+	Tags     int
+	Todos    int
+	// Skipped is the number of items imported by an earlier run.
+	Skipped int
+	// Errors are the items that failed validation, together with the items under them.
+	Errors []ItemError
+	DryRun bool
+}
+
+// ImportFromSourceUseCase is the interface that wraps the basic ImportFromSource operation.
+type ImportFromSourceUseCase interface {
+	Execute(ctx context.Context, req ImportFromSourceRequest) (*SourceImportReport, error)
+}
+
+// importFromSourceUseCase is the implementation of the ImportFromSourceUseCase interface.
+type importFromSourceUseCase struct {
+	externalRefRepository externalref.ExternalRefRepository
+	projectRepository     project.ProjectRepository
+	tagRepository         tag.TagRepository
+	todoRepository        todo.TodoRepository
+	txRunner              uow.TransactionRunner
+	clock                 clock.Clock
+	ids                   idgen.IDGenerator
+}
+
+// NewImportFromSourceUseCase creates a new ImportFromSourceUseCase.
+func NewImportFromSourceUseCase(i *do.Injector) (ImportFromSourceUseCase, error) {
+	externalRefRepository, err := do.Invoke[externalref.ExternalRefRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke external ref repository: %w", err)
+	}
+	projectRepository, err := do.Invoke[project.ProjectRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke project repository: %w", err)
+	}
+	tagRepository, err := do.Invoke[tag.TagRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke tag repository: %w", err)
+	}
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &importFromSourceUseCase{
+		externalRefRepository: externalRefRepository,
+		projectRepository:     projectRepository,
+		tagRepository:         tagRepository,
+		todoRepository:        todoRepository,
+		txRunner:              txRunner,
+		clock:                 clk,
+		ids:                   ids,
+	}, nil
+}
+
+// Execute reads the export file of another tool and creates the projects, tags and todos in it.
+// Every item is recorded with its external ID, and items recorded by an earlier run are skipped,
+// so the same file can be imported again. Labels are matched to existing tags of the same name.
+// The file is read before the transaction starts, so no transaction is held open while it is received.
+func (u importFromSourceUseCase) Execute(ctx context.Context, req ImportFromSourceRequest) (*SourceImportReport, error) {
+	if !req.Source.IsValid() {
+		return nil, &externalref.ValidationError{Field: "source", Message: fmt.Sprintf("unknown source %q", req.Source)}
+	}
+	content, err := io.ReadAll(io.LimitReader(req.Content, MaxSourceSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read export file: %w", err)
+	}
+	if len(content) > MaxSourceSize {
+		return nil, &externalref.ValidationError{
+			Field:   "content",
+			Message: fmt.Sprintf("file is larger than %d bytes", MaxSourceSize),
+		}
+	}
+	data, err := parseSource(req.Source, content)
+	if err != nil {
+		return nil, err
+	}
+
+	var report *SourceImportReport
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		// The report is started again when the transaction is retried
+		report = &SourceImportReport{DryRun: req.DryRun}
+		im := &sourceImport{
+			importFromSourceUseCase: u,
+			source:                  req.Source,
+			dryRun:                  req.DryRun,
+			report:                  report,
+			projects:                make(map[string]*project.Project),
+			tags:                    make(map[string]tag.TagID),
+			todos:                   make(map[string]*importedNode),
+			pending:                 make(map[string]*sourceTodo, len(data.todos)),
+		}
+		return im.run(ctx, data)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return report, nil
+}
+
+// importedNode is a todo of the import, with what is needed to place children under it.
+type importedNode struct {
+	todo *todo.Todo
+	// ancestorIDs are the IDs of the ancestors of the todo, nearest first.
+	ancestorIDs []todo.TodoID
+}
+
+// sourceImport is the state of a running import. The maps are keyed by external ID.
+type sourceImport struct {
+	importFromSourceUseCase
+	source   externalref.Source
+	dryRun   bool
+	report   *SourceImportReport
+	projects map[string]*project.Project
+	tags     map[string]tag.TagID
+	// tagsByName are the stored tags by name, loaded when the first new label is met.
+	tagsByName map[string]tag.TagID
+	// todos are the todos that have been imported, or nil for those that could not be.
+	todos map[string]*importedNode
+	// pending are the todos of the file that have not been imported yet.
+	pending map[string]*sourceTodo
+}
+
+func (im *sourceImport) run(ctx context.Context, data *sourceData) error {
+	for _, p := range data.projects {
+		if err := im.importProject(ctx, p); err != nil {
+			return err
+		}
+	}
+	for _, t := range data.tags {
+		if err := im.importTag(ctx, t); err != nil {
+			return err
+		}
+	}
+	for i := range data.todos {
+		im.pending[data.todos[i].externalID] = &data.todos[i]
+	}
+	// Todos are imported in the order of the file, except that parents are imported before their children.
+	for _, t := range data.todos {
+		if _, err := im.importTodo(ctx, t.externalID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (im *sourceImport) importProject(ctx context.Context, sp sourceProject) error {
+	localID, err := im.findRef(ctx, externalref.KindProject, sp.externalID)
+	if err != nil {
+		return err
+	}
+	if localID != nil {
+		im.report.Skipped++
+		p, err := im.projectRepository.FindByID(ctx, project.ProjectID(*localID))
+		var notFoundErr *project.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			im.fail(externalref.KindProject, sp.externalID, errors.New("project was imported before and has been deleted since"))
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find project: %w", err)
+		}
+		im.projects[sp.externalID] = p
+		return nil
+	}
+
+	p, err := project.NewProject(im.ids, im.clock.Now(), truncate(sp.name, project.MaxNameLength), sp.workflow)
+	if err != nil {
+		im.fail(externalref.KindProject, sp.externalID, err)
+		return nil
+	}
+	if !im.dryRun {
+		if err := im.projectRepository.Create(ctx, p); err != nil {
+			return fmt.Errorf("failed to create project: %w", err)
+		}
+	}
+	if err := im.createRef(ctx, externalref.KindProject, sp.externalID, p.ID().UUID()); err != nil {
+		return err
+	}
+	im.projects[sp.externalID] = p
+	im.report.Projects++
+	return nil
+}
+
+func (im *sourceImport) importTag(ctx context.Context, st sourceTag) error {
+	localID, err := im.findRef(ctx, externalref.KindTag, st.externalID)
+	if err != nil {
+		return err
+	}
+	if localID != nil {
+		im.report.Skipped++
+		im.tags[st.externalID] = tag.TagID(*localID)
+		return nil
+	}
+
+	name := truncate(st.name, tag.MaxNameLength)
+	tagID, ok, err := im.findTagByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// Colors of other tools that are not hex codes fall back to the default color
+		now := im.clock.Now()
+		t, err := tag.NewTag(im.ids, now, name, st.color)
+		if err != nil {
+			t, err = tag.NewTag(im.ids, now, name, "")
+		}
+		if err != nil {
+			im.fail(externalref.KindTag, st.externalID, err)
+			return nil
+		}
+		if !im.dryRun {
+			if err := im.tagRepository.Create(ctx, t); err != nil {
+				return fmt.Errorf("failed to create tag: %w", err)
+			}
+		}
+		tagID = t.ID()
+		im.tagsByName[name] = tagID
+		im.report.Tags++
+	}
+	if err := im.createRef(ctx, externalref.KindTag, st.externalID, tagID.UUID()); err != nil {
+		return err
+	}
+	im.tags[st.externalID] = tagID
+	return nil
+}
+
+// importTodo imports the todo with the external ID after its parent, and returns it,
+// or nil when it could not be imported or was imported by an earlier run.
+func (im *sourceImport) importTodo(ctx context.Context, externalID string) (*importedNode, error) {
+	if node, ok := im.todos[externalID]; ok {
+		return node, nil
+	}
+	st, ok := im.pending[externalID]
+	if !ok {
+		return nil, nil
+	}
+	// Removing the todo from pending also stops a cycle of parents
+	delete(im.pending, externalID)
+
+	localID, err := im.findRef(ctx, externalref.KindTodo, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if localID != nil {
+		im.report.Skipped++
+		return im.loadTodo(ctx, externalID, todo.TodoID(*localID))
+	}
+
+	p, ok := im.projects[st.projectID]
+	if !ok {
+		im.fail(externalref.KindTodo, externalID, fmt.Errorf("project %s was not imported", st.projectID))
+		return nil, nil
+	}
+	var parent *importedNode
+	if st.parentID != "" {
+		parent, err = im.importTodo(ctx, st.parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			im.fail(externalref.KindTodo, externalID, fmt.Errorf("parent %s was not imported", st.parentID))
+			return nil, nil
+		}
+	}
+
+	t, err := im.newTodo(st, p, parent)
+	if err != nil {
+		im.fail(externalref.KindTodo, externalID, err)
+		return nil, nil
+	}
+	if !im.dryRun {
+		if err := im.todoRepository.Create(ctx, t); err != nil {
+			return nil, fmt.Errorf("failed to create todo: %w", err)
+		}
+	}
+	if err := im.createRef(ctx, externalref.KindTodo, externalID, t.ID().UUID()); err != nil {
+		return nil, err
+	}
+	im.report.Todos++
+
+	node := &importedNode{todo: t}
+	if parent != nil {
+		node.ancestorIDs = append([]todo.TodoID{parent.todo.ID()}, parent.ancestorIDs...)
+	}
+	im.todos[externalID] = node
+	return node, nil
+}
+
+// newTodo builds the todo in the status of the source with the timestamps of the source.
+func (im *sourceImport) newTodo(st *sourceTodo, p *project.Project, parent *importedNode) (*todo.Todo, error) {
+	// Validate the todo like a new one
+	now := im.clock.Now()
+	t, err := todo.NewTodo(im.ids, now, st.title, st.body)
+	if err != nil {
+		return nil, err
+	}
+	status, ok := p.Workflow().Status(st.statusID)
+	if !ok {
+		return nil, &todo.ValidationError{
+			Field:   "status_id",
+			Message: fmt.Sprintf("unknown status %q in the project workflow", st.statusID),
+		}
+	}
+
+	createdAt := t.CreatedAt()
+	if st.createdAt != nil {
+		createdAt = *st.createdAt
+	}
+	updatedAt := createdAt
+	if st.updatedAt != nil && st.updatedAt.After(createdAt) {
+		updatedAt = *st.updatedAt
+	}
+	lifecycle := lifecycleOf(status.Category)
+	var completedAt *time.Time
+	if lifecycle == todo.TodoStatusCompleted {
+		completedAt = &updatedAt
+		if st.completedAt != nil {
+			completedAt = st.completedAt
+		}
+	}
+
+	var tagIDs []tag.TagID
+	for _, externalID := range st.tagIDs {
+		tagID, ok := im.tags[externalID]
+		if ok && !slices.Contains(tagIDs, tagID) {
+			tagIDs = append(tagIDs, tagID)
+		}
+	}
+
+	projectID := p.ID()
+	build := func(parentID *todo.TodoID) *todo.Todo {
+		return todo.ReconstructTodoWithStatus(
+			t.ID().UUID(), t.Title(), t.Body(), lifecycle, createdAt, updatedAt, completedAt,
+			tagIDs, parentID, nil, &projectID, p.Workflow(), status.ID,
+		)
+	}
+	t = build(nil)
+	if parent == nil {
+		return t, nil
+	}
+	if err := t.SetParent(now, parent.todo, parent.ancestorIDs, 1); err != nil {
+		return nil, err
+	}
+	// SetParent touches the todo, so it is built again to keep the timestamps of the source
+	return build(t.ParentID()), nil
+}
+
+// loadTodo returns a todo imported by an earlier run, so that new children can be placed under it.
+func (im *sourceImport) loadTodo(ctx context.Context, externalID string, id todo.TodoID) (*importedNode, error) {
+	t, err := im.todoRepository.FindByID(ctx, id)
+	var notFoundErr *todo.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		im.fail(externalref.KindTodo, externalID, errors.New("todo was imported before and has been deleted since"))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find todo: %w", err)
+	}
+	ancestorIDs, err := im.todoRepository.FindAncestorIDs(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ancestors of todo: %w", err)
+	}
+	node := &importedNode{todo: t, ancestorIDs: ancestorIDs}
+	im.todos[externalID] = node
+	return node, nil
+}
+
+// findRef returns the local ID of the item if an earlier run imported it.
+func (im *sourceImport) findRef(ctx context.Context, kind externalref.Kind, externalID string) (*uuid.UUID, error) {
+	ref, err := im.externalRefRepository.Find(ctx, im.source, kind, externalID)
+	var notFoundErr *externalref.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find external ref: %w", err)
+	}
+	localID := ref.LocalID()
+	return &localID, nil
+}
+
+// createRef records the entity the item was imported as.
+func (im *sourceImport) createRef(ctx context.Context, kind externalref.Kind, externalID string, localID uuid.UUID) error {
+	ref, err := externalref.NewExternalRef(im.clock.Now(), im.source, kind, externalID, localID)
+	if err != nil {
+		return err
+	}
+	if im.dryRun {
+		return nil
+	}
+	if err := im.externalRefRepository.Create(ctx, ref); err != nil {
+		return fmt.Errorf("failed to create external ref: %w", err)
+	}
+	return nil
+}
+
+// findTagByName returns the ID of the stored tag with the name.
+func (im *sourceImport) findTagByName(ctx context.Context, name string) (tag.TagID, bool, error) {
+	if im.tagsByName == nil {
+		tags, err := im.tagRepository.FindAll(ctx)
+		if err != nil {
+			return tag.TagID{}, false, fmt.Errorf("failed to find all tags: %w", err)
+		}
+		im.tagsByName = make(map[string]tag.TagID, len(tags))
+		for _, t := range tags {
+			im.tagsByName[t.Name()] = t.ID()
+		}
+	}
+	tagID, ok := im.tagsByName[name]
+	return tagID, ok, nil
+}
+
+// fail reports the item as an error. A todo that fails is not imported again.
+func (im *sourceImport) fail(kind externalref.Kind, externalID string, err error) {
+	if kind == externalref.KindTodo {
+		im.todos[externalID] = nil
+	}
+	im.report.Errors = append(im.report.Errors, ItemError{Kind: kind, ExternalID: externalID, Message: err.Error()})
+}
+
+// lifecycleOf returns the built-in status of todos in a status of the category.
+func lifecycleOf(category project.StatusCategory) todo.TodoStatus {
+	switch category {
+	case project.StatusCategoryDoing:
+		return todo.TodoStatusInProgress
+	case project.StatusCategoryDone:
+		return todo.TodoStatusCompleted
+	default:
+		return todo.TodoStatusNotStarted
+	}
+}
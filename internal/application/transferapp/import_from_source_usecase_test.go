@@ -0,0 +1,325 @@
+package transferapp
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/externalref"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_externalref"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_project"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_tag"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// todoistContent has a sub-task listed before its parent, a label that matches
+// an existing tag, a new label and a task without content.
+const todoistContent = `{
+	"projects": [{"id": "p1", "name": "Work"}],
+	"labels": [{"name": "urgent", "color": "red"}, {"name": "later", "color": "grey"}],
+	"items": [
+		{"id": "i2", "project_id": "p1", "parent_id": "i1", "content": "Outline", "checked": true},
+		{"id": "i1", "project_id": "p1", "content": "Write report", "labels": ["urgent", "later"]},
+		{"id": "i3", "project_id": "p1", "content": ""},
+		{"id": "i4", "project_id": "p1", "parent_id": "i3", "content": "Under an invalid task"}
+	]
+}`
+
+// refStore is an in-memory ExternalRefRepository for the mock.
+type refStore map[string]*externalref.ExternalRef
+
+func (s refStore) key(kind externalref.Kind, externalID string) string {
+	return kind.String() + "/" + externalID
+}
+
+func (s refStore) add(t *testing.T, kind externalref.Kind, externalID string, localID uuid.UUID) {
+	ref, err := externalref.NewExternalRef(time.Now(), externalref.SourceTodoist, kind, externalID, localID)
+	require.NoError(t, err)
+	s[s.key(kind, externalID)] = ref
+}
+
+func (s refStore) localID(kind externalref.Kind, externalID string) uuid.UUID {
+	return s[s.key(kind, externalID)].LocalID()
+}
+
+func (s refStore) expect(m *mock_externalref.MockExternalRefRepository, ctx context.Context) {
+	m.EXPECT().Find(ctx, externalref.SourceTodoist, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, source externalref.Source, kind externalref.Kind, externalID string) (*externalref.ExternalRef, error) {
+			if ref, ok := s[s.key(kind, externalID)]; ok {
+				return ref, nil
+			}
+			return nil, &externalref.NotFoundError{Source: source, Kind: kind, ExternalID: externalID}
+		}).Maybe()
+	m.EXPECT().Create(ctx, mock.AnythingOfType("*externalref.ExternalRef")).
+		RunAndReturn(func(_ context.Context, ref *externalref.ExternalRef) error {
+			s[s.key(ref.Kind(), ref.ExternalID())] = ref
+			return nil
+		}).Maybe()
+}
+
+func TestImportFromSourceUseCase_Execute(t *testing.T) {
+	existingTag, err := tag.NewTag(idgen.UUIDv7(), time.Now(), "urgent", "")
+	require.NoError(t, err)
+
+	t.Run("successfully imports the items and records their external IDs", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := ImportFromSourceRequest{Source: externalref.SourceTodoist, Content: strings.NewReader(todoistContent)}
+
+		refs := refStore{}
+		mockRefRepo := mock_externalref.NewMockExternalRefRepository(t)
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTagRepo := mock_tag.NewMockTagRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		var createdTags []*tag.Tag
+		var createdTodos []*todo.Todo
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				refs.expect(mockRefRepo, ctx)
+				mockProjectRepo.EXPECT().Create(ctx, mock.AnythingOfType("*project.Project")).Return(nil)
+				mockTagRepo.EXPECT().FindAll(ctx).Return([]*tag.Tag{existingTag}, nil)
+				mockTagRepo.EXPECT().Create(ctx, mock.AnythingOfType("*tag.Tag")).
+					RunAndReturn(func(_ context.Context, t *tag.Tag) error {
+						createdTags = append(createdTags, t)
+						return nil
+					})
+				mockTodoRepo.EXPECT().Create(ctx, mock.AnythingOfType("*todo.Todo")).
+					RunAndReturn(func(_ context.Context, t *todo.Todo) error {
+						createdTodos = append(createdTodos, t)
+						return nil
+					})
+				return fn(ctx)
+			})
+
+		useCase := &importFromSourceUseCase{
+			externalRefRepository: mockRefRepo,
+			projectRepository:     mockProjectRepo,
+			tagRepository:         mockTagRepo,
+			todoRepository:        mockTodoRepo,
+			txRunner:              mockTxRunner,
+			clock:                 clock.System(),
+			ids:                   idgen.UUIDv7(),
+		}
+
+		// When
+		report, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, 1, report.Projects)
+		require.Equal(t, 1, report.Tags)
+		require.Equal(t, 2, report.Todos)
+		require.Equal(t, 0, report.Skipped)
+		require.Equal(t, []ItemError{
+			{Kind: externalref.KindTodo, ExternalID: "i3", Message: "title: title is required"},
+			{Kind: externalref.KindTodo, ExternalID: "i4", Message: "parent i3 was not imported"},
+		}, report.Errors)
+
+		require.Len(t, createdTags, 1)
+		require.Equal(t, "later", createdTags[0].Name())
+		require.Equal(t, "#b8b8b8", createdTags[0].Color())
+
+		require.Len(t, createdTodos, 2)
+		parent, child := createdTodos[0], createdTodos[1]
+		require.Equal(t, "Write report", parent.Title())
+		require.Equal(t, project.StatusID("todo"), parent.StatusID())
+		require.Equal(t, []tag.TagID{existingTag.ID(), createdTags[0].ID()}, parent.TagIDs())
+		require.Equal(t, "Outline", child.Title())
+		require.Equal(t, parent.ID(), *child.ParentID())
+		require.Equal(t, project.StatusID("done"), child.StatusID())
+		require.True(t, child.IsCompleted())
+		require.NotNil(t, child.CompletedAt())
+
+		require.Len(t, refs, 5)
+		require.Equal(t, parent.ID().UUID(), refs.localID(externalref.KindTodo, "i1"))
+		require.Equal(t, existingTag.ID().UUID(), refs.localID(externalref.KindTag, "urgent"))
+	})
+
+	t.Run("skips the items imported by an earlier run", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		content := `{
+			"projects": [{"id": "p1", "name": "Work"}],
+			"items": [
+				{"id": "i1", "project_id": "p1", "content": "Write report"},
+				{"id": "i5", "project_id": "p1", "parent_id": "i1", "content": "Proofread"}
+			]
+		}`
+		req := ImportFromSourceRequest{Source: externalref.SourceTodoist, Content: strings.NewReader(content)}
+
+		p, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Work", nil)
+		require.NoError(t, err)
+		parent, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Write report", "")
+		require.NoError(t, err)
+		require.NoError(t, parent.AssignProject(time.Now(), p))
+
+		refs := refStore{}
+		refs.add(t, externalref.KindProject, "p1", p.ID().UUID())
+		refs.add(t, externalref.KindTodo, "i1", parent.ID().UUID())
+
+		mockRefRepo := mock_externalref.NewMockExternalRefRepository(t)
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTagRepo := mock_tag.NewMockTagRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		var created *todo.Todo
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				refs.expect(mockRefRepo, ctx)
+				mockProjectRepo.EXPECT().FindByID(ctx, p.ID()).Return(p, nil)
+				mockTodoRepo.EXPECT().FindByID(ctx, parent.ID()).Return(parent, nil)
+				mockTodoRepo.EXPECT().FindAncestorIDs(ctx, parent.ID()).Return(nil, nil)
+				mockTodoRepo.EXPECT().Create(ctx, mock.AnythingOfType("*todo.Todo")).
+					RunAndReturn(func(_ context.Context, t *todo.Todo) error {
+						created = t
+						return nil
+					})
+				return fn(ctx)
+			})
+
+		useCase := &importFromSourceUseCase{
+			externalRefRepository: mockRefRepo,
+			projectRepository:     mockProjectRepo,
+			tagRepository:         mockTagRepo,
+			todoRepository:        mockTodoRepo,
+			txRunner:              mockTxRunner,
+			clock:                 clock.System(),
+			ids:                   idgen.UUIDv7(),
+		}
+
+		// When
+		report, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, 0, report.Projects)
+		require.Equal(t, 1, report.Todos)
+		require.Equal(t, 2, report.Skipped)
+		require.Empty(t, report.Errors)
+		require.Equal(t, "Proofread", created.Title())
+		require.Equal(t, parent.ID(), *created.ParentID())
+	})
+
+	t.Run("saves nothing in a dry run", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := ImportFromSourceRequest{
+			Source:  externalref.SourceTodoist,
+			Content: strings.NewReader(todoistContent),
+			DryRun:  true,
+		}
+
+		mockRefRepo := mock_externalref.NewMockExternalRefRepository(t)
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTagRepo := mock_tag.NewMockTagRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRefRepo.EXPECT().Find(ctx, externalref.SourceTodoist, mock.Anything, mock.Anything).
+					Return(nil, &externalref.NotFoundError{})
+				mockTagRepo.EXPECT().FindAll(ctx).Return(nil, nil)
+				return fn(ctx)
+			})
+
+		useCase := &importFromSourceUseCase{
+			externalRefRepository: mockRefRepo,
+			projectRepository:     mockProjectRepo,
+			tagRepository:         mockTagRepo,
+			todoRepository:        mockTodoRepo,
+			txRunner:              mockTxRunner,
+			clock:                 clock.System(),
+			ids:                   idgen.UUIDv7(),
+		}
+
+		// When
+		report, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, report.DryRun)
+		require.Equal(t, 1, report.Projects)
+		require.Equal(t, 2, report.Tags)
+		require.Equal(t, 2, report.Todos)
+		require.Len(t, report.Errors, 2)
+	})
+
+	t.Run("reports a retried transaction once", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := ImportFromSourceRequest{
+			Source:  externalref.SourceTodoist,
+			Content: strings.NewReader(todoistContent),
+			DryRun:  true,
+		}
+
+		mockRefRepo := mock_externalref.NewMockExternalRefRepository(t)
+		mockProjectRepo := mock_project.NewMockProjectRepository(t)
+		mockTagRepo := mock_tag.NewMockTagRepository(t)
+		mockTodoRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRefRepo.EXPECT().Find(ctx, externalref.SourceTodoist, mock.Anything, mock.Anything).
+					Return(nil, &externalref.NotFoundError{})
+				mockTagRepo.EXPECT().FindAll(ctx).Return(nil, nil)
+				// The first attempt is run in full and then rolled back
+				if err := fn(ctx); err != nil {
+					return err
+				}
+				return fn(ctx)
+			})
+
+		useCase := &importFromSourceUseCase{
+			externalRefRepository: mockRefRepo,
+			projectRepository:     mockProjectRepo,
+			tagRepository:         mockTagRepo,
+			todoRepository:        mockTodoRepo,
+			txRunner:              mockTxRunner,
+			clock:                 clock.System(),
+			ids:                   idgen.UUIDv7(),
+		}
+
+		// When
+		report, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, 1, report.Projects)
+		require.Equal(t, 2, report.Tags)
+		require.Equal(t, 2, report.Todos)
+		require.Len(t, report.Errors, 2)
+	})
+
+	t.Run("rejects an unknown source", func(t *testing.T) {
+		// Given
+		useCase := &importFromSourceUseCase{}
+
+		// When
+		report, err := useCase.Execute(context.Background(), ImportFromSourceRequest{
+			Source:  "asana",
+			Content: strings.NewReader("{}"),
+		})
+
+		// Then
+		require.Nil(t, report)
+		var validationErr *externalref.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
@@ -0,0 +1,179 @@
+package transferapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/samber/do"
+)
+
+// MaxImportTodos is the maximum number of todos in an imported file.
+const MaxImportTodos = 10000
+
+type ImportTodosRequest struct {
+	Format Format
+	// Content is read until EOF as the file.
+	Content io.Reader
+	// DryRun reports what would be imported without saving anything.
+	DryRun bool
+}
+
+// Duplicate is a line of an imported file whose todo ID already exists,
+// either in the store or on an earlier line.
+type Duplicate struct {
+	Line int
+	ID   todo.TodoID
+}
+
+// ImportReport describes the outcome of an import.
+type ImportReport struct {
+	// Imported is the number of todos created, or that would be created in a dry run.
+	Imported int
+	// Duplicates are the skipped lines whose todo already exists.
+	Duplicates []Duplicate
+	// Errors are the skipped lines that could not be read or failed validation.
+	Errors []LineError
+	DryRun bool
+}
+
+// ImportTodosUseCase is the interface that wraps the basic ImportTodos operation.
+type ImportTodosUseCase interface {
+	Execute(ctx context.Context, req ImportTodosRequest) (*ImportReport, error)
+}
+
+// importTodosUseCase is the implementation of the ImportTodosUseCase interface.
+type importTodosUseCase struct {
+	todoRepository todo.TodoRepository
+	txRunner       uow.TransactionRunner
+	clock          clock.Clock
+	ids            idgen.IDGenerator
+}
+
+// NewImportTodosUseCase creates a new ImportTodosUseCase.
+func NewImportTodosUseCase(i *do.Injector) (ImportTodosUseCase, error) {
+	todoRepository, err := do.Invoke[todo.TodoRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke todo repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &importTodosUseCase{
+		todoRepository: todoRepository,
+		txRunner:       txRunner,
+		clock:          clk,
+		ids:            ids,
+	}, nil
+}
+
+// importedTodo is a Todo read from a line of the file.
+type importedTodo struct {
+	line int
+	todo *todo.Todo
+}
+
+// Execute reads the todos in the file and creates them.
+// Every line is validated like a new Todo, and the lines that fail validation or
+// whose ID already exists are skipped and reported, while the other lines are imported.
+// The file is read before the transaction starts, so no transaction is held open while it is received.
+func (u importTodosUseCase) Execute(ctx context.Context, req ImportTodosRequest) (*ImportReport, error) {
+	dec, err := newDecoder(req.Format, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.clock.Now()
+	var lineErrors []LineError
+	var imported []importedTodo
+	for {
+		r, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var lineErr *LineError
+		if errors.As(err, &lineErr) {
+			lineErrors = append(lineErrors, *lineErr)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read todos: %w", err)
+		}
+
+		t, err := r.toTodo(u.ids, now)
+		if err != nil {
+			lineErrors = append(lineErrors, LineError{Line: r.line, Message: err.Error()})
+			continue
+		}
+		if len(imported) == MaxImportTodos {
+			return nil, &todo.ValidationError{
+				Field:   "content",
+				Message: fmt.Sprintf("file has more than %d todos", MaxImportTodos),
+			}
+		}
+		imported = append(imported, importedTodo{line: r.line, todo: t})
+	}
+
+	var report *ImportReport
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		// The report is started again when the transaction is retried
+		report = &ImportReport{DryRun: req.DryRun, Errors: lineErrors}
+		seen := make(map[todo.TodoID]bool, len(imported))
+		for _, it := range imported {
+			if seen[it.todo.ID()] {
+				report.Duplicates = append(report.Duplicates, Duplicate{Line: it.line, ID: it.todo.ID()})
+				continue
+			}
+			seen[it.todo.ID()] = true
+
+			exists, err := u.exists(ctx, it.todo.ID())
+			if err != nil {
+				return err
+			}
+			if exists {
+				report.Duplicates = append(report.Duplicates, Duplicate{Line: it.line, ID: it.todo.ID()})
+				continue
+			}
+
+			if !req.DryRun {
+				if err := u.todoRepository.Create(ctx, it.todo); err != nil {
+					return fmt.Errorf("failed to create todo of line %d: %w", it.line, err)
+				}
+			}
+			report.Imported++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return report, nil
+}
+
+// exists checks if a Todo with the ID is already stored.
+func (u importTodosUseCase) exists(ctx context.Context, id todo.TodoID) (bool, error) {
+	_, err := u.todoRepository.FindByID(ctx, id)
+	if err == nil {
+		return true, nil
+	}
+	var notFoundErr *todo.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return false, nil
+	}
+	return false, fmt.Errorf("failed to find todo: %w", err)
+}
@@ -0,0 +1,161 @@
+package transferapp
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportTodosUseCase_Execute(t *testing.T) {
+	existingID := todo.NewTodoID()
+	newID := todo.NewTodoID()
+	content := strings.Join([]string{
+		`{"id":"` + existingID.String() + `","title":"Already there"}`,
+		`{"id":"` + newID.String() + `","title":"New","status":"IN_PROGRESS"}`,
+		`{"id":"` + newID.String() + `","title":"New again"}`,
+		`{"title":""}`,
+		`{"title":"Without ID"}`,
+	}, "\n")
+
+	t.Run("successfully imports the new todos and reports the others", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := ImportTodosRequest{Format: FormatJSONL, Content: strings.NewReader(content)}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		var created []*todo.Todo
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, existingID).Return(&todo.Todo{}, nil)
+				mockRepo.EXPECT().FindByID(ctx, mock.AnythingOfType("todo.TodoID")).
+					Return(nil, &todo.NotFoundError{})
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*todo.Todo")).
+					RunAndReturn(func(_ context.Context, t *todo.Todo) error {
+						created = append(created, t)
+						return nil
+					})
+				return fn(ctx)
+			})
+
+		useCase := &importTodosUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
+		}
+
+		// When
+		report, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, 2, report.Imported)
+		require.Equal(t, []Duplicate{{Line: 1, ID: existingID}, {Line: 3, ID: newID}}, report.Duplicates)
+		require.Len(t, report.Errors, 1)
+		require.Equal(t, 4, report.Errors[0].Line)
+		require.False(t, report.DryRun)
+
+		require.Len(t, created, 2)
+		require.Equal(t, newID, created[0].ID())
+		require.Equal(t, todo.TodoStatusInProgress, created[0].Status())
+		require.Equal(t, "Without ID", created[1].Title())
+	})
+
+	t.Run("creates nothing in a dry run", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := ImportTodosRequest{Format: FormatJSONL, Content: strings.NewReader(content), DryRun: true}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, existingID).Return(&todo.Todo{}, nil)
+				mockRepo.EXPECT().FindByID(ctx, mock.AnythingOfType("todo.TodoID")).
+					Return(nil, &todo.NotFoundError{})
+				return fn(ctx)
+			})
+
+		useCase := &importTodosUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
+		}
+
+		// When
+		report, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, 2, report.Imported)
+		require.Len(t, report.Duplicates, 2)
+		require.True(t, report.DryRun)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reports a retried transaction once", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := ImportTodosRequest{Format: FormatJSONL, Content: strings.NewReader(content), DryRun: true}
+
+		mockRepo := mock_todo.NewMockTodoRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().FindByID(ctx, existingID).Return(&todo.Todo{}, nil)
+				mockRepo.EXPECT().FindByID(ctx, mock.AnythingOfType("todo.TodoID")).
+					Return(nil, &todo.NotFoundError{})
+				// The first attempt is run in full and then rolled back
+				if err := fn(ctx); err != nil {
+					return err
+				}
+				return fn(ctx)
+			})
+
+		useCase := &importTodosUseCase{
+			todoRepository: mockRepo,
+			txRunner:       mockTxRunner,
+			clock:          clock.System(),
+			ids:            idgen.UUIDv7(),
+		}
+
+		// When
+		report, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, 2, report.Imported)
+		require.Len(t, report.Duplicates, 2)
+		require.Len(t, report.Errors, 1)
+	})
+
+	t.Run("returns validation error for an unknown format", func(t *testing.T) {
+		// Given
+		useCase := &importTodosUseCase{}
+
+		// When
+		report, err := useCase.Execute(context.Background(), ImportTodosRequest{
+			Format:  Format("xml"),
+			Content: strings.NewReader(""),
+		})
+
+		// Then
+		require.Nil(t, report)
+		var validationErr *todo.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
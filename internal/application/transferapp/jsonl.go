@@ -0,0 +1,109 @@
+package transferapp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// maxLineSize is the maximum size of a line of the formats that are read line by line.
+const maxLineSize = 1024 * 1024
+
+// jsonRecord is the JSON representation of a record.
+type jsonRecord struct {
+	ID          string     `json:"id,omitempty"`
+	Title       string     `json:"title"`
+	Body        string     `json:"body,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	TagIDs      []string   `json:"tag_ids,omitempty"`
+	ParentID    string     `json:"parent_id,omitempty"`
+	ProjectID   string     `json:"project_id,omitempty"`
+}
+
+// jsonlEncoder writes one JSON object per line.
+type jsonlEncoder struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONLEncoder(w io.Writer) *jsonlEncoder {
+	bw := bufio.NewWriter(w)
+	return &jsonlEncoder{w: bw, enc: json.NewEncoder(bw)}
+}
+
+func (e *jsonlEncoder) Encode(r record) error {
+	return e.enc.Encode(jsonRecord{
+		ID:          r.ID,
+		Title:       r.Title,
+		Body:        r.Body,
+		Status:      r.Status,
+		CreatedAt:   utc(r.CreatedAt),
+		UpdatedAt:   utc(r.UpdatedAt),
+		CompletedAt: utc(r.CompletedAt),
+		TagIDs:      r.TagIDs,
+		ParentID:    r.ParentID,
+		ProjectID:   r.ProjectID,
+	})
+}
+
+func (e *jsonlEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+// jsonlDecoder reads one JSON object per line. Blank lines are skipped.
+type jsonlDecoder struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newJSONLDecoder(r io.Reader) *jsonlDecoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return &jsonlDecoder{scanner: scanner}
+}
+
+func (d *jsonlDecoder) Decode() (record, error) {
+	for d.scanner.Scan() {
+		d.line++
+		text := strings.TrimSpace(d.scanner.Text())
+		if text == "" {
+			continue
+		}
+		var v jsonRecord
+		if err := json.Unmarshal([]byte(text), &v); err != nil {
+			return record{}, &LineError{Line: d.line, Message: fmt.Sprintf("invalid JSON: %v", err)}
+		}
+		return record{
+			line:        d.line,
+			ID:          v.ID,
+			Title:       v.Title,
+			Body:        v.Body,
+			Status:      v.Status,
+			CreatedAt:   v.CreatedAt,
+			UpdatedAt:   v.UpdatedAt,
+			CompletedAt: v.CompletedAt,
+			TagIDs:      v.TagIDs,
+			ParentID:    v.ParentID,
+			ProjectID:   v.ProjectID,
+		}, nil
+	}
+	if err := d.scanner.Err(); err != nil {
+		return record{}, fmt.Errorf("failed to read line %d: %w", d.line+1, err)
+	}
+	return record{}, io.EOF
+}
+
+// utc returns t in UTC, or nil.
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
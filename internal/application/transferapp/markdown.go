@@ -0,0 +1,148 @@
+package transferapp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+var (
+	// markdownTaskPattern matches a task list item such as "- [x] Title".
+	markdownTaskPattern = regexp.MustCompile(`^(\s*)[-*+] \[([ xX])\] (.*)$`)
+	// markdownIDPattern matches the HTML comment carrying the ID at the end of a task.
+	markdownIDPattern = regexp.MustCompile(`\s*<!--\s*id:\s*(\S+)\s*-->\s*$`)
+)
+
+// markdownEncoder writes a task list item per record, "- [x]" for completed and "- [ ]" for other todos.
+// The ID is kept in an HTML comment at the end of the item, and the body in the indented lines below it.
+type markdownEncoder struct {
+	w *bufio.Writer
+}
+
+func newMarkdownEncoder(w io.Writer) *markdownEncoder {
+	return &markdownEncoder{w: bufio.NewWriter(w)}
+}
+
+func (e *markdownEncoder) Encode(r record) error {
+	check := " "
+	if r.Status == todo.TodoStatusCompleted.String() {
+		check = "x"
+	}
+	if _, err := fmt.Fprintf(e.w, "- [%s] %s <!-- id:%s -->\n", check, singleLine(r.Title), r.ID); err != nil {
+		return err
+	}
+	if r.Body == "" {
+		return nil
+	}
+	for _, line := range strings.Split(r.Body, "\n") {
+		if _, err := fmt.Fprintf(e.w, "  %s\n", strings.TrimRight(line, " \t\r")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *markdownEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+// markdownDecoder reads the task list items of a Markdown document, including nested ones.
+// Lines indented below an item are its body; other lines such as headings are skipped.
+type markdownDecoder struct {
+	scanner *bufio.Scanner
+	line    int
+	// next is the task read ahead while collecting the body of the previous one.
+	next *markdownTask
+}
+
+type markdownTask struct {
+	line   int
+	indent int
+	check  string
+	text   string
+}
+
+func newMarkdownDecoder(r io.Reader) *markdownDecoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return &markdownDecoder{scanner: scanner}
+}
+
+func (d *markdownDecoder) Decode() (record, error) {
+	task := d.next
+	d.next = nil
+	for task == nil {
+		text, ok := d.scan()
+		if !ok {
+			return record{}, d.eof()
+		}
+		task = parseMarkdownTask(d.line, text)
+	}
+
+	var body []string
+	blank := 0
+	for {
+		text, ok := d.scan()
+		if !ok {
+			break
+		}
+		if next := parseMarkdownTask(d.line, text); next != nil {
+			d.next = next
+			break
+		}
+		if strings.TrimSpace(text) == "" {
+			blank++
+			continue
+		}
+		indent := len(text) - len(strings.TrimLeft(text, " \t"))
+		if indent <= task.indent {
+			// The list ended
+			break
+		}
+		if len(body) > 0 {
+			for ; blank > 0; blank-- {
+				body = append(body, "")
+			}
+		}
+		blank = 0
+		body = append(body, text[min(indent, task.indent+2):])
+	}
+
+	r := record{line: task.line, Title: task.text, Body: strings.Join(body, "\n")}
+	if m := markdownIDPattern.FindStringSubmatch(task.text); m != nil {
+		r.ID = m[1]
+		r.Title = strings.TrimSpace(task.text[:len(task.text)-len(m[0])])
+	}
+	if task.check != " " {
+		r.Status = todo.TodoStatusCompleted.String()
+	}
+	return r, nil
+}
+
+func (d *markdownDecoder) scan() (string, bool) {
+	if !d.scanner.Scan() {
+		return "", false
+	}
+	d.line++
+	return strings.TrimRight(d.scanner.Text(), "\r"), true
+}
+
+func (d *markdownDecoder) eof() error {
+	if err := d.scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read line %d: %w", d.line+1, err)
+	}
+	return io.EOF
+}
+
+// parseMarkdownTask returns the task list item on the line, or nil.
+func parseMarkdownTask(line int, text string) *markdownTask {
+	m := markdownTaskPattern.FindStringSubmatch(text)
+	if m == nil {
+		return nil
+	}
+	return &markdownTask{line: line, indent: len(m[1]), check: m[2], text: strings.TrimSpace(m[3])}
+}
@@ -0,0 +1,117 @@
+package transferapp
+
+import (
+	"strings"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// record is a todo as it is written to and read from a file.
+// Empty fields were not present in the file.
+type record struct {
+	// line is the number of the line the record starts at, set by decoders.
+	line        int
+	ID          string
+	Title       string
+	Body        string
+	Status      string
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	CompletedAt *time.Time
+	// TagIDs, ParentID and ProjectID are exported for reference only.
+	// They point to other records, so they are not imported.
+	TagIDs    []string
+	ParentID  string
+	ProjectID string
+}
+
+// newRecord converts a Todo to a record.
+func newRecord(t *todo.Todo) record {
+	createdAt := t.CreatedAt()
+	updatedAt := t.UpdatedAt()
+	r := record{
+		ID:          t.ID().String(),
+		Title:       t.Title(),
+		Body:        t.Body(),
+		Status:      t.Status().String(),
+		CreatedAt:   &createdAt,
+		UpdatedAt:   &updatedAt,
+		CompletedAt: t.CompletedAt(),
+	}
+	for _, tagID := range t.TagIDs() {
+		r.TagIDs = append(r.TagIDs, tagID.String())
+	}
+	if t.ParentID() != nil {
+		r.ParentID = t.ParentID().String()
+	}
+	if t.ProjectID() != nil {
+		r.ProjectID = t.ProjectID().String()
+	}
+	return r
+}
+
+// toTodo converts the record to a new Todo. The title and body are validated by todo.NewTodo,
+// and the fields present in the record replace the defaults it sets.
+func (r record) toTodo(ids idgen.IDGenerator, now time.Time) (*todo.Todo, error) {
+	validated, err := todo.NewTodo(ids, now, r.Title, r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	id := validated.ID()
+	if r.ID != "" {
+		id, err = todo.NewTodoIDFromString(r.ID)
+		if err != nil {
+			return nil, &todo.ValidationError{Field: "id", Message: "id must be a UUID"}
+		}
+	}
+	status := validated.Status()
+	if r.Status != "" {
+		status, err = todo.NewTodoStatusFromString(strings.ToUpper(r.Status))
+		if err != nil {
+			return nil, &todo.ValidationError{Field: "status", Message: "unknown status " + r.Status}
+		}
+	}
+	createdAt := validated.CreatedAt()
+	if r.CreatedAt != nil {
+		createdAt = *r.CreatedAt
+	}
+	updatedAt := createdAt
+	if r.UpdatedAt != nil {
+		updatedAt = *r.UpdatedAt
+	}
+	if updatedAt.Before(createdAt) {
+		return nil, &todo.ValidationError{Field: "updated_at", Message: "updated_at is before created_at"}
+	}
+	// Only completed todos have a completion time, which defaults to the last update.
+	var completedAt *time.Time
+	if status == todo.TodoStatusCompleted {
+		completedAt = &updatedAt
+		if r.CompletedAt != nil {
+			completedAt = r.CompletedAt
+		}
+	}
+
+	return todo.ReconstructTodoWithStatus(
+		id.UUID(),
+		validated.Title(),
+		validated.Body(),
+		status,
+		createdAt,
+		updatedAt,
+		completedAt,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		"",
+	), nil
+}
+
+// singleLine replaces the line breaks of s with spaces for formats with one todo per line.
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,144 @@
+package transferapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/iktakahiro/oniongo/internal/domain/externalref"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+)
+
+// sourceProject is a project, board or repository of another tool.
+type sourceProject struct {
+	externalID string
+	name       string
+	// workflow is given to the project when it is created.
+	workflow *project.Workflow
+}
+
+// sourceTag is a label of another tool.
+type sourceTag struct {
+	externalID string
+	name       string
+	// color is a hex color code, or empty for the default color.
+	color string
+}
+
+// sourceTodo is a task, card, checklist item or issue of another tool.
+// Its project, parent and tags are referred to by their external IDs.
+type sourceTodo struct {
+	externalID string
+	projectID  string
+	// parentID is empty for todos at the top of their project.
+	parentID string
+	// statusID is a status of the workflow of the project.
+	statusID    project.StatusID
+	title       string
+	body        string
+	tagIDs      []string
+	createdAt   *time.Time
+	updatedAt   *time.Time
+	completedAt *time.Time
+}
+
+// sourceData is the content of an export file of another tool.
+type sourceData struct {
+	projects []sourceProject
+	tags     []sourceTag
+	todos    []sourceTodo
+}
+
+// parseSource reads the export file of the source.
+func parseSource(source externalref.Source, content []byte) (*sourceData, error) {
+	switch source {
+	case externalref.SourceTodoist:
+		return parseTodoist(content)
+	case externalref.SourceTrello:
+		return parseTrello(content)
+	case externalref.SourceGitHub:
+		return parseGitHub(content)
+	default:
+		return nil, &externalref.ValidationError{Field: "source", Message: fmt.Sprintf("unknown source %q", source)}
+	}
+}
+
+// invalidExport returns the error for an export file that cannot be read.
+func invalidExport(tool string, err error) error {
+	return &externalref.ValidationError{Field: "content", Message: fmt.Sprintf("invalid %s export: %v", tool, err)}
+}
+
+// flexibleID is an identifier that is a string in some versions of an export and a number in others.
+type flexibleID string
+
+func (id *flexibleID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*id = ""
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = flexibleID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("id must be a string or a number: %s", data)
+	}
+	*id = flexibleID(n.String())
+	return nil
+}
+
+// anyToAny returns the transitions between every pair of the statuses.
+func anyToAny(statuses []project.WorkflowStatus) []project.Transition {
+	var transitions []project.Transition
+	for _, from := range statuses {
+		for _, to := range statuses {
+			if from.ID != to.ID {
+				transitions = append(transitions, project.Transition{From: from.ID, To: to.ID})
+			}
+		}
+	}
+	return transitions
+}
+
+// statusIDFromName derives a status ID such as "in_review" from a status name
+// that is not in used yet, and adds it to used.
+func statusIDFromName(name string, used map[project.StatusID]bool) project.StatusID {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	base := strings.TrimSuffix(b.String(), "_")
+	if base == "" || base[0] < 'a' || base[0] > 'z' {
+		base = "status_" + base
+	}
+	base = strings.TrimSuffix(truncate(base, 28), "_")
+
+	id := project.StatusID(base)
+	for n := 2; used[id]; n++ {
+		id = project.StatusID(base + "_" + strconv.Itoa(n))
+	}
+	used[id] = true
+	return id
+}
+
+// truncate shortens s to at most max characters.
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
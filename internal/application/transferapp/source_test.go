@@ -0,0 +1,182 @@
+package transferapp
+
+import (
+	"testing"
+
+	"github.com/iktakahiro/oniongo/internal/domain/externalref"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTodoist(t *testing.T) {
+	// Given
+	content := `{
+		"projects": [
+			{"id": "2203306141", "name": "Work"},
+			{"id": "2203306142", "name": "Trash", "is_deleted": true}
+		],
+		"labels": [{"id": "790748", "name": "urgent", "color": "berry_red"}],
+		"items": [
+			{"id": "2995104339", "project_id": "2203306141", "content": "Write report", "description": "Q3",
+			 "labels": ["urgent", "shared"], "checked": false, "added_at": "2023-01-02T03:04:05.000000Z"},
+			{"id": "2995104340", "project_id": "2203306141", "parent_id": "2995104339", "content": "Outline",
+			 "checked": true, "completed_at": "2023-01-03T00:00:00Z"},
+			{"id": 2995104341, "project_id": "2203306142", "content": "In a deleted project"},
+			{"id": "2995104342", "project_id": "2203306141", "content": "Deleted", "is_deleted": true}
+		]
+	}`
+
+	// When
+	data, err := parseSource(externalref.SourceTodoist, []byte(content))
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, data.projects, 1)
+	assert.Equal(t, "2203306141", data.projects[0].externalID)
+	assert.Equal(t, "Work", data.projects[0].name)
+	assert.Equal(t, project.DefaultWorkflow(), data.projects[0].workflow)
+
+	assert.Equal(t, []sourceTag{
+		{externalID: "urgent", name: "urgent", color: "#b8256f"},
+		{externalID: "shared", name: "shared"},
+	}, data.tags)
+
+	require.Len(t, data.todos, 2)
+	assert.Equal(t, "Write report", data.todos[0].title)
+	assert.Equal(t, "Q3", data.todos[0].body)
+	assert.Equal(t, project.StatusID("todo"), data.todos[0].statusID)
+	assert.Equal(t, []string{"urgent", "shared"}, data.todos[0].tagIDs)
+	require.NotNil(t, data.todos[0].createdAt)
+	assert.Equal(t, 2023, data.todos[0].createdAt.Year())
+	assert.Equal(t, "2995104339", data.todos[1].parentID)
+	assert.Equal(t, project.StatusID("done"), data.todos[1].statusID)
+	require.NotNil(t, data.todos[1].completedAt)
+}
+
+func TestParseTrello(t *testing.T) {
+	// Given
+	content := `{
+		"id": "5e6f7a8b9c0d1e2f3a4b5c6d",
+		"name": "Launch",
+		"labels": [
+			{"id": "lbl1", "name": "Design", "color": "purple"},
+			{"id": "lbl2", "name": "", "color": "red"},
+			{"id": "lbl3", "name": "", "color": null}
+		],
+		"lists": [
+			{"id": "l3", "name": "In Review", "pos": 3},
+			{"id": "l1", "name": "Backlog", "pos": 1},
+			{"id": "l2", "name": "Backlog", "pos": 2},
+			{"id": "l4", "name": "Old", "closed": true, "pos": 4}
+		],
+		"cards": [
+			{"id": "5e6f7a8b00000000000000a1", "name": "Logo", "desc": "SVG", "idList": "l3", "idLabels": ["lbl1"],
+			 "dateLastActivity": "2023-05-01T00:00:00.000Z"},
+			{"id": "5e6f7a8b00000000000000a2", "name": "Archived", "idList": "l1", "closed": true},
+			{"id": "5e6f7a8b00000000000000a3", "name": "In an archived list", "idList": "l4"}
+		],
+		"checklists": [
+			{"idCard": "5e6f7a8b00000000000000a1", "checkItems": [
+				{"id": "ci2", "name": "Export PNG", "state": "incomplete", "pos": 2},
+				{"id": "ci1", "name": "Draft", "state": "complete", "pos": 1}
+			]},
+			{"idCard": "5e6f7a8b00000000000000a2", "checkItems": [{"id": "ci3", "name": "Lost", "state": "complete"}]}
+		]
+	}`
+
+	// When
+	data, err := parseSource(externalref.SourceTrello, []byte(content))
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, data.projects, 1)
+	assert.Equal(t, "Launch", data.projects[0].name)
+	assert.Equal(t, []project.WorkflowStatus{
+		{ID: "backlog", Name: "Backlog", Category: project.StatusCategoryTodo},
+		{ID: "backlog_2", Name: "Backlog", Category: project.StatusCategoryTodo},
+		{ID: "in_review", Name: "In Review", Category: project.StatusCategoryDoing},
+		{ID: "done", Name: "Done", Category: project.StatusCategoryDone},
+	}, data.projects[0].workflow.Statuses())
+
+	assert.Equal(t, []sourceTag{
+		{externalID: "lbl1", name: "Design", color: "#c377e0"},
+		{externalID: "lbl2", name: "red", color: "#eb5a46"},
+	}, data.tags)
+
+	require.Len(t, data.todos, 3)
+	card := data.todos[0]
+	assert.Equal(t, "Logo", card.title)
+	assert.Equal(t, "SVG", card.body)
+	assert.Equal(t, project.StatusID("in_review"), card.statusID)
+	assert.Equal(t, []string{"lbl1"}, card.tagIDs)
+	require.NotNil(t, card.createdAt)
+	assert.Equal(t, int64(0x5e6f7a8b), card.createdAt.Unix())
+	assert.Equal(t, "Draft", data.todos[1].title)
+	assert.Equal(t, card.externalID, data.todos[1].parentID)
+	assert.Equal(t, project.StatusID("done"), data.todos[1].statusID)
+	assert.Equal(t, "Export PNG", data.todos[2].title)
+	assert.Equal(t, project.StatusID("backlog"), data.todos[2].statusID)
+}
+
+func TestParseGitHub(t *testing.T) {
+	// Given
+	content := `[
+		{"number": 1, "title": "Crash on start", "body": "Stack trace", "state": "open",
+		 "labels": [{"name": "bug", "color": "d73a4a"}],
+		 "created_at": "2023-01-01T00:00:00Z",
+		 "url": "https://api.github.com/repos/octo/app/issues/1", "html_url": "https://github.com/octo/app/issues/1"},
+		{"number": 2, "title": "Add a PR", "state": "open", "pull_request": {"url": "x"},
+		 "html_url": "https://github.com/octo/app/pull/2"},
+		{"number": 3, "title": "Wontfix", "body": null, "state": "closed", "state_reason": "not_planned",
+		 "closed_at": "2023-02-01T00:00:00Z", "html_url": "https://github.com/octo/app/issues/3"},
+		{"number": 7, "title": "Docs", "state": "CLOSED", "stateReason": "COMPLETED",
+		 "labels": [{"name": "bug", "color": "d73a4a"}],
+		 "createdAt": "2023-03-01T00:00:00Z", "url": "https://github.com/octo/site/issues/7"}
+	]`
+
+	// When
+	data, err := parseSource(externalref.SourceGitHub, []byte(content))
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, data.projects, 2)
+	assert.Equal(t, "octo/app", data.projects[0].externalID)
+	assert.Equal(t, "octo/site", data.projects[1].name)
+
+	assert.Equal(t, []sourceTag{{externalID: "bug", name: "bug", color: "#d73a4a"}}, data.tags)
+
+	require.Len(t, data.todos, 3)
+	assert.Equal(t, "octo/app#1", data.todos[0].externalID)
+	assert.Equal(t, githubStatusOpen, data.todos[0].statusID)
+	assert.Equal(t, []string{"bug"}, data.todos[0].tagIDs)
+	assert.Equal(t, "octo/app#3", data.todos[1].externalID)
+	assert.Equal(t, githubStatusNotPlanned, data.todos[1].statusID)
+	require.NotNil(t, data.todos[1].completedAt)
+	assert.Equal(t, "octo/site#7", data.todos[2].externalID)
+	assert.Equal(t, "octo/site", data.todos[2].projectID)
+	assert.Equal(t, githubStatusClosed, data.todos[2].statusID)
+	require.NotNil(t, data.todos[2].createdAt)
+}
+
+func TestParseSource_InvalidContent(t *testing.T) {
+	for _, source := range externalref.Sources() {
+		t.Run(source.String(), func(t *testing.T) {
+			_, err := parseSource(source, []byte(`{"broken"`))
+
+			var validationErr *externalref.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, "content", validationErr.Field)
+		})
+	}
+}
+
+func TestStatusIDFromName(t *testing.T) {
+	used := make(map[project.StatusID]bool)
+
+	assert.Equal(t, project.StatusID("in_review"), statusIDFromName("In Review!", used))
+	assert.Equal(t, project.StatusID("in_review_2"), statusIDFromName("in-review", used))
+	assert.Equal(t, project.StatusID("status_2024"), statusIDFromName("2024", used))
+	assert.Equal(t, project.StatusID("status"), statusIDFromName("やること", used))
+	assert.Len(t, string(statusIDFromName("a very long list name that goes on and on", used)), 28)
+}
@@ -0,0 +1,125 @@
+package transferapp
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+)
+
+// todoistColors are the hex codes of the named colors of Todoist.
+var todoistColors = map[string]string{
+	"berry_red":   "#b8256f",
+	"red":         "#db4035",
+	"orange":      "#ff9933",
+	"yellow":      "#fad000",
+	"olive_green": "#afb83b",
+	"lime_green":  "#7ecc49",
+	"green":       "#299438",
+	"mint_green":  "#6accbc",
+	"teal":        "#158fad",
+	"sky_blue":    "#14aaf5",
+	"light_blue":  "#96c3eb",
+	"blue":        "#4073ff",
+	"grape":       "#884dff",
+	"violet":      "#af38eb",
+	"lavender":    "#eb96eb",
+	"magenta":     "#e05194",
+	"salmon":      "#ff8d85",
+	"charcoal":    "#808080",
+	"grey":        "#b8b8b8",
+	"taupe":       "#ccac93",
+}
+
+// todoistExport is the response of the Todoist Sync API for all resources,
+// as saved by `curl https://api.todoist.com/sync/v9/sync -d sync_token='*' -d resource_types='["all"]'`.
+type todoistExport struct {
+	Projects []struct {
+		ID        flexibleID `json:"id"`
+		Name      string     `json:"name"`
+		IsDeleted bool       `json:"is_deleted"`
+	} `json:"projects"`
+	Items []struct {
+		ID          flexibleID `json:"id"`
+		ProjectID   flexibleID `json:"project_id"`
+		ParentID    flexibleID `json:"parent_id"`
+		Content     string     `json:"content"`
+		Description string     `json:"description"`
+		// Labels are the names of the labels of the task.
+		Labels      []string   `json:"labels"`
+		Checked     bool       `json:"checked"`
+		IsDeleted   bool       `json:"is_deleted"`
+		AddedAt     *time.Time `json:"added_at"`
+		UpdatedAt   *time.Time `json:"updated_at"`
+		CompletedAt *time.Time `json:"completed_at"`
+	} `json:"items"`
+	Labels []struct {
+		Name      string `json:"name"`
+		Color     string `json:"color"`
+		IsDeleted bool   `json:"is_deleted"`
+	} `json:"labels"`
+}
+
+// parseTodoist maps Todoist projects to projects with the default workflow,
+// tasks and their sub-tasks to todos, and labels to tags.
+// Completed tasks are done. Labels are identified by their names, as tasks refer to them by name.
+// Deleted projects and tasks are left out.
+func parseTodoist(content []byte) (*sourceData, error) {
+	var export todoistExport
+	if err := json.Unmarshal(content, &export); err != nil {
+		return nil, invalidExport("Todoist", err)
+	}
+
+	data := &sourceData{}
+	deletedProjects := make(map[flexibleID]bool)
+	for _, p := range export.Projects {
+		if p.IsDeleted {
+			deletedProjects[p.ID] = true
+			continue
+		}
+		data.projects = append(data.projects, sourceProject{
+			externalID: string(p.ID),
+			name:       p.Name,
+			workflow:   project.DefaultWorkflow(),
+		})
+	}
+
+	labels := make(map[string]bool)
+	for _, l := range export.Labels {
+		if l.IsDeleted || labels[l.Name] {
+			continue
+		}
+		labels[l.Name] = true
+		data.tags = append(data.tags, sourceTag{externalID: l.Name, name: l.Name, color: todoistColors[l.Color]})
+	}
+
+	for _, item := range export.Items {
+		if item.IsDeleted || deletedProjects[item.ProjectID] {
+			continue
+		}
+		t := sourceTodo{
+			externalID:  string(item.ID),
+			projectID:   string(item.ProjectID),
+			parentID:    string(item.ParentID),
+			statusID:    "todo",
+			title:       item.Content,
+			body:        item.Description,
+			createdAt:   item.AddedAt,
+			updatedAt:   item.UpdatedAt,
+			completedAt: item.CompletedAt,
+		}
+		if item.Checked {
+			t.statusID = "done"
+		}
+		for _, name := range item.Labels {
+			// Personal labels of tasks shared by others are not in the list of labels
+			if !labels[name] {
+				labels[name] = true
+				data.tags = append(data.tags, sourceTag{externalID: name, name: name})
+			}
+			t.tagIDs = append(t.tagIDs, name)
+		}
+		data.todos = append(data.todos, t)
+	}
+	return data, nil
+}
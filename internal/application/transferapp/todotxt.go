@@ -0,0 +1,141 @@
+package transferapp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// todoTxtDate is the layout of the dates in todo.txt.
+const todoTxtDate = "2006-01-02"
+
+// todoTxtPriorityPattern matches a priority such as "(A)".
+var todoTxtPriorityPattern = regexp.MustCompile(`^\([A-Z]\)$`)
+
+// todoTxtEncoder writes a line per record in the todo.txt format:
+// "x <completion date> <creation date> <title> id:<id>" for completed todos and
+// "<creation date> <title> id:<id>" for others, with a status:<status> tag for the statuses
+// todo.txt has no notation for. The body does not fit on the line and is not written.
+type todoTxtEncoder struct {
+	w *bufio.Writer
+}
+
+func newTodoTxtEncoder(w io.Writer) *todoTxtEncoder {
+	return &todoTxtEncoder{w: bufio.NewWriter(w)}
+}
+
+func (e *todoTxtEncoder) Encode(r record) error {
+	var fields []string
+	if r.Status == todo.TodoStatusCompleted.String() {
+		fields = append(fields, "x")
+		if r.CompletedAt != nil {
+			fields = append(fields, r.CompletedAt.UTC().Format(todoTxtDate))
+		}
+	}
+	if r.CreatedAt != nil {
+		fields = append(fields, r.CreatedAt.UTC().Format(todoTxtDate))
+	}
+	fields = append(fields, singleLine(r.Title), "id:"+r.ID)
+	if r.Status != todo.TodoStatusCompleted.String() && r.Status != todo.TodoStatusNotStarted.String() {
+		fields = append(fields, "status:"+r.Status)
+	}
+	_, err := fmt.Fprintln(e.w, strings.Join(fields, " "))
+	return err
+}
+
+func (e *todoTxtEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+// todoTxtDecoder reads a todo per line in the todo.txt format. Blank lines are skipped.
+// The priority is ignored, and the id: and status: tags are read into the record; other
+// tags, +projects and @contexts stay in the title.
+type todoTxtDecoder struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newTodoTxtDecoder(r io.Reader) *todoTxtDecoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return &todoTxtDecoder{scanner: scanner}
+}
+
+func (d *todoTxtDecoder) Decode() (record, error) {
+	for d.scanner.Scan() {
+		d.line++
+		fields := strings.Fields(d.scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		return parseTodoTxtLine(d.line, fields), nil
+	}
+	if err := d.scanner.Err(); err != nil {
+		return record{}, fmt.Errorf("failed to read line %d: %w", d.line+1, err)
+	}
+	return record{}, io.EOF
+}
+
+// parseTodoTxtLine parses the fields of a todo.txt line.
+func parseTodoTxtLine(line int, fields []string) record {
+	r := record{line: line}
+	completed := fields[0] == "x"
+	if completed {
+		r.Status = todo.TodoStatusCompleted.String()
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && todoTxtPriorityPattern.MatchString(fields[0]) {
+		fields = fields[1:]
+	}
+	// Completed todos may have a completion date followed by a creation date,
+	// others only a creation date.
+	maxDates := 1
+	if completed {
+		maxDates = 2
+	}
+	var dates []time.Time
+	for len(fields) > 0 && len(dates) < maxDates {
+		t, err := time.Parse(todoTxtDate, fields[0])
+		if err != nil {
+			break
+		}
+		dates = append(dates, t)
+		fields = fields[1:]
+	}
+	switch {
+	case completed && len(dates) == 2:
+		r.CompletedAt, r.CreatedAt = &dates[0], &dates[1]
+	case completed && len(dates) == 1:
+		r.CompletedAt = &dates[0]
+	case len(dates) > 0:
+		r.CreatedAt = &dates[0]
+	}
+
+	var title []string
+	for _, field := range fields {
+		key, value, ok := strings.Cut(field, ":")
+		switch {
+		case ok && key == "id" && value != "":
+			r.ID = value
+		case ok && key == "status" && value != "":
+			r.Status = value
+		default:
+			title = append(title, field)
+		}
+	}
+	r.Title = strings.Join(title, " ")
+
+	// The last change of the line is the completion, or the creation.
+	if r.CompletedAt != nil {
+		r.UpdatedAt = r.CompletedAt
+	}
+	if r.CreatedAt == nil && r.CompletedAt != nil {
+		r.CreatedAt = r.CompletedAt
+	}
+	return r
+}
@@ -0,0 +1,205 @@
+package transferapp
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+)
+
+// trelloColors are the hex codes of the label colors of Trello.
+var trelloColors = map[string]string{
+	"green":  "#61bd4f",
+	"yellow": "#f2d600",
+	"orange": "#ff9f1a",
+	"red":    "#eb5a46",
+	"purple": "#c377e0",
+	"blue":   "#0079bf",
+	"sky":    "#00c2e0",
+	"lime":   "#51e898",
+	"pink":   "#ff78cb",
+	"black":  "#344563",
+}
+
+// Words in the names of lists that tell which category of status they are.
+var (
+	trelloDoneWords  = []string{"done", "complete", "finished", "closed", "shipped", "released"}
+	trelloDoingWords = []string{"doing", "progress", "review", "testing", "wip", "active"}
+)
+
+// trelloBoard is a board exported with "Print and export" > "Export as JSON".
+type trelloBoard struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Labels []struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	} `json:"labels"`
+	Lists []struct {
+		ID     string  `json:"id"`
+		Name   string  `json:"name"`
+		Closed bool    `json:"closed"`
+		Pos    float64 `json:"pos"`
+	} `json:"lists"`
+	Cards []struct {
+		ID               string     `json:"id"`
+		Name             string     `json:"name"`
+		Desc             string     `json:"desc"`
+		IDList           string     `json:"idList"`
+		IDLabels         []string   `json:"idLabels"`
+		Closed           bool       `json:"closed"`
+		DateLastActivity *time.Time `json:"dateLastActivity"`
+	} `json:"cards"`
+	Checklists []struct {
+		IDCard     string `json:"idCard"`
+		CheckItems []struct {
+			ID    string  `json:"id"`
+			Name  string  `json:"name"`
+			State string  `json:"state"`
+			Pos   float64 `json:"pos"`
+		} `json:"checkItems"`
+	} `json:"checklists"`
+}
+
+// parseTrello maps a Trello board to a project whose workflow has a status for each open list,
+// cards to todos in the status of their list, checklist items to the sub-todos of their card,
+// and labels to tags. Archived lists and cards are left out.
+// The category of a status is guessed from the name of its list; a "Done" status is added
+// when no list looks done.
+func parseTrello(content []byte) (*sourceData, error) {
+	var board trelloBoard
+	if err := json.Unmarshal(content, &board); err != nil {
+		return nil, invalidExport("Trello", err)
+	}
+	if board.ID == "" {
+		return nil, invalidExport("Trello", fmt.Errorf("board has no id"))
+	}
+
+	lists := board.Lists[:0:0]
+	for _, l := range board.Lists {
+		if !l.Closed {
+			lists = append(lists, l)
+		}
+	}
+	sort.SliceStable(lists, func(i, j int) bool { return lists[i].Pos < lists[j].Pos })
+
+	used := make(map[project.StatusID]bool)
+	statuses := make([]project.WorkflowStatus, 0, len(lists)+1)
+	listStatuses := make(map[string]project.StatusID, len(lists))
+	var doneStatus project.StatusID
+	for i, l := range lists {
+		status := project.WorkflowStatus{
+			ID:       statusIDFromName(l.Name, used),
+			Name:     truncate(l.Name, project.MaxNameLength),
+			Category: trelloCategory(l.Name),
+		}
+		// New todos start in the first status
+		if i == 0 {
+			status.Category = project.StatusCategoryTodo
+		}
+		if status.Category == project.StatusCategoryDone && doneStatus == "" {
+			doneStatus = status.ID
+		}
+		statuses = append(statuses, status)
+		listStatuses[l.ID] = status.ID
+	}
+	if doneStatus == "" {
+		doneStatus = statusIDFromName("Done", used)
+		statuses = append(statuses, project.WorkflowStatus{ID: doneStatus, Name: "Done", Category: project.StatusCategoryDone})
+	}
+	data := &sourceData{}
+	workflow, err := project.NewWorkflow(statuses, anyToAny(statuses))
+	if err != nil {
+		return nil, invalidExport("Trello", fmt.Errorf("board %q: %w", board.Name, err))
+	}
+	data.projects = append(data.projects, sourceProject{externalID: board.ID, name: board.Name, workflow: workflow})
+
+	for _, l := range board.Labels {
+		name := l.Name
+		if name == "" {
+			name = l.Color
+		}
+		if name == "" {
+			continue
+		}
+		data.tags = append(data.tags, sourceTag{externalID: l.ID, name: name, color: trelloColors[l.Color]})
+	}
+
+	initialStatus := statuses[0].ID
+	cards := make(map[string]bool, len(board.Cards))
+	for _, c := range board.Cards {
+		statusID, ok := listStatuses[c.IDList]
+		if c.Closed || !ok {
+			continue
+		}
+		cards[c.ID] = true
+		data.todos = append(data.todos, sourceTodo{
+			externalID: c.ID,
+			projectID:  board.ID,
+			statusID:   statusID,
+			title:      c.Name,
+			body:       c.Desc,
+			tagIDs:     c.IDLabels,
+			createdAt:  trelloCreatedAt(c.ID),
+			updatedAt:  c.DateLastActivity,
+		})
+	}
+
+	for _, cl := range board.Checklists {
+		if !cards[cl.IDCard] {
+			continue
+		}
+		items := cl.CheckItems
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Pos < items[j].Pos })
+		for _, item := range items {
+			statusID := initialStatus
+			if item.State == "complete" {
+				statusID = doneStatus
+			}
+			data.todos = append(data.todos, sourceTodo{
+				externalID: item.ID,
+				projectID:  board.ID,
+				parentID:   cl.IDCard,
+				statusID:   statusID,
+				title:      item.Name,
+				createdAt:  trelloCreatedAt(item.ID),
+			})
+		}
+	}
+	return data, nil
+}
+
+// trelloCategory guesses the category of the status of a list from its name.
+func trelloCategory(name string) project.StatusCategory {
+	name = strings.ToLower(name)
+	for _, word := range trelloDoneWords {
+		if strings.Contains(name, word) {
+			return project.StatusCategoryDone
+		}
+	}
+	for _, word := range trelloDoingWords {
+		if strings.Contains(name, word) {
+			return project.StatusCategoryDoing
+		}
+	}
+	return project.StatusCategoryTodo
+}
+
+// trelloCreatedAt returns the creation time encoded in the first 4 bytes of a Trello ID, or nil.
+func trelloCreatedAt(id string) *time.Time {
+	if len(id) < 8 {
+		return nil
+	}
+	b, err := hex.DecodeString(id[:8])
+	if err != nil {
+		return nil
+	}
+	seconds := int64(b[0])<<24 | int64(b[1])<<16 | int64(b[2])<<8 | int64(b[3])
+	createdAt := time.Unix(seconds, 0).UTC()
+	return &createdAt
+}
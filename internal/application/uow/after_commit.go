@@ -0,0 +1,49 @@
+package uow
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoTransaction is returned by AfterCommit when the context has no transaction.
+var ErrNoTransaction = errors.New("no transaction in context")
+
+type hooksKey struct{}
+
+// Hooks collects the callbacks registered with AfterCommit during a transaction.
+// TransactionRunner implementations put the Hooks of each transaction and savepoint
+// in its context with WithHooks, and call them once the outermost transaction has committed.
+type Hooks struct {
+	afterCommit []func(ctx context.Context)
+}
+
+// WithHooks returns a copy of ctx in which AfterCommit registers the callbacks in h.
+func WithHooks(ctx context.Context, h *Hooks) context.Context {
+	return context.WithValue(ctx, hooksKey{}, h)
+}
+
+// AfterCommit registers fn to be called once the transaction of ctx has committed.
+// fn is not called when the transaction, or the savepoint fn was registered in, is rolled back.
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) error {
+	h, ok := ctx.Value(hooksKey{}).(*Hooks)
+	if !ok {
+		return ErrNoTransaction
+	}
+	h.afterCommit = append(h.afterCommit, fn)
+	return nil
+}
+
+// Merge moves the callbacks of a released savepoint to the Hooks of the transaction it joined.
+func (h *Hooks) Merge(savepoint *Hooks) {
+	h.afterCommit = append(h.afterCommit, savepoint.afterCommit...)
+	savepoint.afterCommit = nil
+}
+
+// RunAfterCommit calls the callbacks in the order they were registered.
+// The context passed to them is not canceled with ctx.
+func (h *Hooks) RunAfterCommit(ctx context.Context) {
+	ctx = context.WithoutCancel(ctx)
+	for _, fn := range h.afterCommit {
+		fn(ctx)
+	}
+}
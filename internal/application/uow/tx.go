@@ -4,6 +4,27 @@ package uow
 import "context"
 
 // TransactionRunner is the interface for running operations within a transaction.
+//
+// A RunInTx called with the context of a running transaction joins it: fn runs in
+// a savepoint, so that its error rolls back only its own writes, and its options are ignored.
+// The outermost RunInTx may run fn again when the transaction fails with an error that
+// is safe to retry, so fn must not have effects outside of the transaction; use AfterCommit for them.
 type TransactionRunner interface {
-	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error, opts ...TxOption) error
+}
+
+type readOnlyKey struct{}
+
+// WithReadOnly returns a copy of ctx that declares the work run with it as read-only.
+// Repositories may serve the reads of read-only work from a cache that lags behind
+// the database for a short time, so it must not be used for data that is written back.
+// A transaction started with the returned context is read-only, as with the ReadOnly option.
+func WithReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+// IsReadOnly reports whether ctx was returned by WithReadOnly.
+func IsReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyKey{}).(bool)
+	return readOnly
 }
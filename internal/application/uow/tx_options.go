@@ -0,0 +1,65 @@
+package uow
+
+import "context"
+
+// IsolationLevel is the isolation level of a transaction.
+type IsolationLevel int
+
+const (
+	// IsolationDefault uses the default isolation level of the database.
+	IsolationDefault IsolationLevel = iota
+	IsolationReadUncommitted
+	IsolationReadCommitted
+	IsolationRepeatableRead
+	IsolationSerializable
+)
+
+// DefaultMaxAttempts is the number of times a transaction is run at most
+// when it keeps failing with an error that is safe to retry.
+const DefaultMaxAttempts = 3
+
+// TxOptions are the options of a transaction, built from the TxOption values passed to RunInTx.
+type TxOptions struct {
+	Isolation IsolationLevel
+	ReadOnly  bool
+	// MaxAttempts bounds the number of times the transaction is run. 1 disables the retries.
+	MaxAttempts int
+}
+
+// TxOption sets an option of a transaction.
+type TxOption func(*TxOptions)
+
+// Isolation runs the transaction at the isolation level.
+func Isolation(level IsolationLevel) TxOption {
+	return func(o *TxOptions) {
+		o.Isolation = level
+	}
+}
+
+// ReadOnly runs a transaction that does not write.
+// Databases that support it reject the writes, others treat it as a hint.
+func ReadOnly() TxOption {
+	return func(o *TxOptions) {
+		o.ReadOnly = true
+	}
+}
+
+// MaxAttempts bounds the number of times the transaction is run when it fails with
+// an error that is safe to retry. Values below 1 are treated as 1.
+func MaxAttempts(n int) TxOption {
+	return func(o *TxOptions) {
+		o.MaxAttempts = max(n, 1)
+	}
+}
+
+// NewTxOptions returns the options of a transaction run with ctx and opts.
+func NewTxOptions(ctx context.Context, opts ...TxOption) TxOptions {
+	o := TxOptions{
+		ReadOnly:    IsReadOnly(ctx),
+		MaxAttempts: DefaultMaxAttempts,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
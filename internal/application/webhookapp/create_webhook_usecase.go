@@ -0,0 +1,77 @@
+// Package webhookapp provides the application layer for webhooks.
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+type CreateWebhookRequest struct {
+	URL    string
+	Events []webhook.EventType
+}
+
+// CreateWebhookUseCase is the interface that wraps the basic CreateWebhook operation.
+type CreateWebhookUseCase interface {
+	Execute(ctx context.Context, req CreateWebhookRequest) (*webhook.Webhook, error)
+}
+
+// createWebhookUseCase is the implementation of the CreateWebhookUseCase interface.
+type createWebhookUseCase struct {
+	webhookRepository webhook.WebhookRepository
+	txRunner          uow.TransactionRunner
+	clock             clock.Clock
+	ids               idgen.IDGenerator
+}
+
+// NewCreateWebhookUseCase creates a new CreateWebhookUseCase.
+func NewCreateWebhookUseCase(i *do.Injector) (CreateWebhookUseCase, error) {
+	webhookRepository, err := do.Invoke[webhook.WebhookRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke webhook repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &createWebhookUseCase{
+		webhookRepository: webhookRepository,
+		txRunner:          txRunner,
+		clock:             clk,
+		ids:               ids,
+	}, nil
+}
+
+// Execute creates a new Webhook with a generated secret.
+func (u createWebhookUseCase) Execute(ctx context.Context, req CreateWebhookRequest) (*webhook.Webhook, error) {
+	newWebhook, err := webhook.NewWebhook(u.ids, u.clock.Now(), req.URL, req.Events)
+	if err != nil {
+		// Return domain error directly for proper error handling
+		return nil, err
+	}
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.webhookRepository.Create(ctx, newWebhook); err != nil {
+			return fmt.Errorf("failed to save webhook: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return newWebhook, nil
+}
@@ -0,0 +1,69 @@
+package webhookapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhookUseCase_Execute(t *testing.T) {
+	t.Run("successfully creates webhook", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := CreateWebhookRequest{
+			URL:    "https://example.com/hooks",
+			Events: []webhook.EventType{webhook.EventTodoCompleted, webhook.EventTodoCreated},
+		}
+
+		mockRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Create(ctx, mock.AnythingOfType("*webhook.Webhook")).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &createWebhookUseCase{
+			webhookRepository: mockRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "https://example.com/hooks", result.URL())
+		require.Equal(t, []webhook.EventType{webhook.EventTodoCreated, webhook.EventTodoCompleted}, result.Events())
+		require.NotEmpty(t, result.Secret())
+	})
+
+	t.Run("returns validation error for unknown event type", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		req := CreateWebhookRequest{
+			URL:    "https://example.com/hooks",
+			Events: []webhook.EventType{"todo.archived"},
+		}
+		useCase := &createWebhookUseCase{clock: clock.System(), ids: idgen.UUIDv7()}
+
+		// When
+		result, err := useCase.Execute(ctx, req)
+
+		// Then
+		require.Nil(t, result)
+		var validationErr *webhook.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
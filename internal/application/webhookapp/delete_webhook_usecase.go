@@ -0,0 +1,62 @@
+package webhookapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+type DeleteWebhookRequest struct {
+	ID webhook.WebhookID
+}
+
+// DeleteWebhookUseCase is the interface that wraps the basic DeleteWebhook operation.
+type DeleteWebhookUseCase interface {
+	Execute(ctx context.Context, req DeleteWebhookRequest) error
+}
+
+// deleteWebhookUseCase is the implementation of the DeleteWebhookUseCase interface.
+type deleteWebhookUseCase struct {
+	webhookRepository webhook.WebhookRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewDeleteWebhookUseCase creates a new DeleteWebhookUseCase.
+func NewDeleteWebhookUseCase(i *do.Injector) (DeleteWebhookUseCase, error) {
+	webhookRepository, err := do.Invoke[webhook.WebhookRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke webhook repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &deleteWebhookUseCase{
+		webhookRepository: webhookRepository,
+		txRunner:          txRunner,
+	}, nil
+}
+
+// Execute deletes a Webhook with its deliveries. Pending deliveries are not sent.
+func (u deleteWebhookUseCase) Execute(ctx context.Context, req DeleteWebhookRequest) error {
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.webhookRepository.Delete(ctx, req.ID); err != nil {
+			return fmt.Errorf("failed to delete webhook: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Preserve domain errors
+		var notFoundErr *webhook.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return err
+		}
+		return fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return nil
+}
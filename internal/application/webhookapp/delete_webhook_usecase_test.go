@@ -0,0 +1,68 @@
+package webhookapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteWebhookUseCase_Execute(t *testing.T) {
+	t.Run("successfully deletes webhook", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		id := webhook.NewWebhookID()
+
+		mockRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Delete(ctx, id).Return(nil)
+				return fn(ctx)
+			})
+
+		useCase := &deleteWebhookUseCase{
+			webhookRepository: mockRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		err := useCase.Execute(ctx, DeleteWebhookRequest{ID: id})
+
+		// Then
+		require.NoError(t, err)
+	})
+
+	t.Run("returns not found error", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		id := webhook.NewWebhookID()
+
+		mockRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockRepo.EXPECT().Delete(ctx, id).Return(&webhook.NotFoundError{ID: id})
+				return fn(ctx)
+			})
+
+		useCase := &deleteWebhookUseCase{
+			webhookRepository: mockRepo,
+			txRunner:          mockTxRunner,
+		}
+
+		// When
+		err := useCase.Execute(ctx, DeleteWebhookRequest{ID: id})
+
+		// Then
+		var notFoundErr *webhook.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+}
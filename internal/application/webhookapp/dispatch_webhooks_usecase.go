@@ -0,0 +1,199 @@
+package webhookapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+// BatchSize is the maximum number of events fanned out, and of deliveries attempted, per dispatch.
+const BatchSize = 100
+
+type DispatchWebhooksResult struct {
+	// Queued is the number of deliveries created for the queued events.
+	Queued int
+	// Succeeded is the number of attempts the endpoints accepted.
+	Succeeded int
+	// Failed is the number of attempts that failed, and of deliveries abandoned.
+	Failed int
+}
+
+// DispatchWebhooksUseCase is the interface that wraps the basic DispatchWebhooks operation.
+type DispatchWebhooksUseCase interface {
+	Execute(ctx context.Context) (*DispatchWebhooksResult, error)
+}
+
+// dispatchWebhooksUseCase is the implementation of the DispatchWebhooksUseCase interface.
+type dispatchWebhooksUseCase struct {
+	webhookRepository  webhook.WebhookRepository
+	eventRepository    webhook.EventRepository
+	deliveryRepository webhook.DeliveryRepository
+	sender             webhook.Sender
+	txRunner           uow.TransactionRunner
+	clock              clock.Clock
+	ids                idgen.IDGenerator
+}
+
+// NewDispatchWebhooksUseCase creates a new DispatchWebhooksUseCase.
+func NewDispatchWebhooksUseCase(i *do.Injector) (DispatchWebhooksUseCase, error) {
+	webhookRepository, err := do.Invoke[webhook.WebhookRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke webhook repository: %w", err)
+	}
+	eventRepository, err := do.Invoke[webhook.EventRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke event repository: %w", err)
+	}
+	deliveryRepository, err := do.Invoke[webhook.DeliveryRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delivery repository: %w", err)
+	}
+	sender, err := do.Invoke[webhook.Sender](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke webhook sender: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &dispatchWebhooksUseCase{
+		webhookRepository:  webhookRepository,
+		eventRepository:    eventRepository,
+		deliveryRepository: deliveryRepository,
+		sender:             sender,
+		txRunner:           txRunner,
+		clock:              clk,
+		ids:                ids,
+	}, nil
+}
+
+// Execute creates the deliveries of the queued events for the webhooks subscribing to them,
+// then attempts the deliveries that are due. Each attempt is saved in its own transaction,
+// so a failure part way keeps the outcome of the attempts made before it.
+func (u dispatchWebhooksUseCase) Execute(ctx context.Context) (*DispatchWebhooksResult, error) {
+	result := &DispatchWebhooksResult{}
+
+	var due []*webhook.Delivery
+	webhooks := map[webhook.WebhookID]*webhook.Webhook{}
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		all, err := u.webhookRepository.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to find webhooks: %w", err)
+		}
+		for _, w := range all {
+			webhooks[w.ID()] = w
+		}
+		if result.Queued, err = u.fanOut(ctx, all); err != nil {
+			return err
+		}
+		due, err = u.deliveryRepository.FindDue(ctx, u.clock.Now(), BatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to find due deliveries: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+
+	for _, delivery := range due {
+		target, ok := webhooks[delivery.WebhookID()]
+		if !ok {
+			continue
+		}
+		if err := u.attempt(ctx, target, delivery); err != nil {
+			return result, err
+		}
+		if delivery.Succeeded() {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+	return result, nil
+}
+
+// fanOut creates a delivery of every queued event for each webhook subscribing to it,
+// and removes the events from the queue.
+func (u dispatchWebhooksUseCase) fanOut(ctx context.Context, webhooks []*webhook.Webhook) (int, error) {
+	events, err := u.eventRepository.FindPending(ctx, BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find pending events: %w", err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+	queued, now := 0, u.clock.Now()
+	ids := make([]webhook.EventID, len(events))
+	for i, event := range events {
+		ids[i] = event.ID()
+		for _, w := range webhooks {
+			if !w.Subscribes(event.Type()) {
+				continue
+			}
+			if err := u.deliveryRepository.Create(ctx, webhook.NewDelivery(u.ids, now, w, event)); err != nil {
+				return 0, fmt.Errorf("failed to save delivery: %w", err)
+			}
+			queued++
+		}
+	}
+	if err := u.eventRepository.Delete(ctx, ids); err != nil {
+		return 0, fmt.Errorf("failed to delete events: %w", err)
+	}
+	return queued, nil
+}
+
+// attempt sends a due delivery, or abandons it when its webhook was disabled, and saves
+// the outcome with the failure count of the webhook.
+func (u dispatchWebhooksUseCase) attempt(ctx context.Context, target *webhook.Webhook, delivery *webhook.Delivery) error {
+	if target.Enabled() {
+		status, sendErr := u.sender.Send(ctx, target, delivery)
+		if err := ctx.Err(); err != nil {
+			// The attempt was cut short by the shutdown, not by the endpoint.
+			return err
+		}
+		now := u.clock.Now()
+		delivery.RecordAttempt(now, status, sendErr)
+		if delivery.Succeeded() {
+			target.RecordSuccess(now)
+		} else {
+			target.RecordFailure(now)
+		}
+	} else {
+		delivery.Abandon(u.clock.Now(), "webhook is disabled")
+	}
+
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.webhookRepository.Update(ctx, target); err != nil {
+			return fmt.Errorf("failed to save webhook: %w", err)
+		}
+		if err := u.deliveryRepository.Update(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to save delivery: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// The webhook was deleted with its deliveries during the attempt.
+		var notFoundErr *webhook.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package webhookapp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/webhooksender"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchWebhooksUseCase_Execute(t *testing.T) {
+	runInTx := func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+		return fn(ctx)
+	}
+
+	t.Run("delivers queued events to the subscribing webhooks", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		var mu sync.Mutex
+		gotBodies := map[string][]byte{}
+		gotSignatures := map[string]string{}
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			gotBodies[r.URL.Path] = body
+			gotSignatures[r.URL.Path] = r.Header.Get(webhook.SignatureHeader)
+			mu.Unlock()
+			if r.URL.Path == "/failing" {
+				rw.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		ok, err := webhook.NewWebhook(idgen.UUIDv7(), time.Now(), server.URL+"/ok", []webhook.EventType{webhook.EventTodoCreated})
+		require.NoError(t, err)
+		failing, err := webhook.NewWebhook(idgen.UUIDv7(), time.Now(), server.URL+"/failing", []webhook.EventType{webhook.EventTodoCreated})
+		require.NoError(t, err)
+		for range webhook.MaxConsecutiveFailures - 1 {
+			failing.RecordFailure(time.Now())
+		}
+		other, err := webhook.NewWebhook(idgen.UUIDv7(), time.Now(), server.URL+"/other", []webhook.EventType{webhook.EventTodoDeleted})
+		require.NoError(t, err)
+
+		created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Buy milk", "")
+		require.NoError(t, err)
+		event, err := webhook.NewTodoEvent(idgen.UUIDv7(), history.TodoCreated(idgen.UUIDv7(), time.Now(), "alice", created))
+		require.NoError(t, err)
+
+		mockWebhookRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockEventRepo := mock_webhook.NewMockEventRepository(t)
+		mockDeliveryRepo := mock_webhook.NewMockDeliveryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		var deliveries []*webhook.Delivery
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).RunAndReturn(runInTx)
+		mockWebhookRepo.EXPECT().FindAll(ctx).Return([]*webhook.Webhook{ok, failing, other}, nil)
+		mockEventRepo.EXPECT().FindPending(ctx, BatchSize).Return([]*webhook.Event{event}, nil)
+		mockDeliveryRepo.EXPECT().Create(ctx, mock.AnythingOfType("*webhook.Delivery")).
+			RunAndReturn(func(ctx context.Context, d *webhook.Delivery) error {
+				deliveries = append(deliveries, d)
+				return nil
+			}).Times(2)
+		mockEventRepo.EXPECT().Delete(ctx, []webhook.EventID{event.ID()}).Return(nil)
+		mockDeliveryRepo.EXPECT().FindDue(ctx, mock.AnythingOfType("time.Time"), BatchSize).
+			RunAndReturn(func(context.Context, time.Time, int) ([]*webhook.Delivery, error) {
+				return deliveries, nil
+			})
+		mockWebhookRepo.EXPECT().Update(ctx, ok).Return(nil)
+		mockWebhookRepo.EXPECT().Update(ctx, failing).Return(nil)
+		mockDeliveryRepo.EXPECT().Update(ctx, mock.AnythingOfType("*webhook.Delivery")).Return(nil).Times(2)
+
+		useCase := &dispatchWebhooksUseCase{
+			webhookRepository:  mockWebhookRepo,
+			eventRepository:    mockEventRepo,
+			deliveryRepository: mockDeliveryRepo,
+			sender:             webhooksender.NewHTTPSender(server.Client(), clock.System()),
+			txRunner:           mockTxRunner,
+			clock:              clock.System(),
+			ids:                idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, &DispatchWebhooksResult{Queued: 2, Succeeded: 1, Failed: 1}, result)
+
+		require.Len(t, deliveries, 2)
+		require.Equal(t, webhook.DeliveryStatusSucceeded, deliveries[0].Status())
+		require.Equal(t, http.StatusOK, deliveries[0].ResponseStatus())
+		require.Equal(t, webhook.DeliveryStatusPending, deliveries[1].Status())
+		require.Equal(t, http.StatusInternalServerError, deliveries[1].ResponseStatus())
+		require.Equal(t, 1, deliveries[1].Attempts())
+
+		require.True(t, ok.Enabled())
+		require.False(t, failing.Enabled())
+
+		require.Equal(t, event.Payload(), gotBodies["/ok"])
+		require.NoError(t, webhook.Verify(ok.Secret(), gotSignatures["/ok"], gotBodies["/ok"], time.Minute))
+		require.NoError(t, webhook.Verify(failing.Secret(), gotSignatures["/failing"], gotBodies["/failing"], time.Minute))
+		require.NotContains(t, gotBodies, "/other")
+	})
+
+	t.Run("abandons the deliveries of a disabled webhook", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		clk := clock.NewFake(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+		disabled, err := webhook.NewWebhook(idgen.UUIDv7(), time.Now(), "https://example.com/hooks", []webhook.EventType{webhook.EventTodoCreated})
+		require.NoError(t, err)
+		event, err := webhook.NewPingEvent(idgen.UUIDv7(), time.Now(), disabled.ID())
+		require.NoError(t, err)
+		delivery := webhook.NewDelivery(idgen.UUIDv7(), time.Now(), disabled, event)
+		for range webhook.MaxConsecutiveFailures {
+			disabled.RecordFailure(time.Now())
+		}
+
+		mockWebhookRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockEventRepo := mock_webhook.NewMockEventRepository(t)
+		mockDeliveryRepo := mock_webhook.NewMockDeliveryRepository(t)
+		mockSender := mock_webhook.NewMockSender(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).RunAndReturn(runInTx)
+		mockWebhookRepo.EXPECT().FindAll(ctx).Return([]*webhook.Webhook{disabled}, nil)
+		mockEventRepo.EXPECT().FindPending(ctx, BatchSize).Return(nil, nil)
+		mockDeliveryRepo.EXPECT().FindDue(ctx, clk.Now(), BatchSize).
+			Return([]*webhook.Delivery{delivery}, nil)
+		mockWebhookRepo.EXPECT().Update(ctx, disabled).Return(nil)
+		mockDeliveryRepo.EXPECT().Update(ctx, delivery).Return(nil)
+
+		useCase := &dispatchWebhooksUseCase{
+			webhookRepository:  mockWebhookRepo,
+			eventRepository:    mockEventRepo,
+			deliveryRepository: mockDeliveryRepo,
+			sender:             mockSender,
+			txRunner:           mockTxRunner,
+			clock:              clk,
+			ids:                idgen.UUIDv7(),
+		}
+
+		// When
+		result, err := useCase.Execute(ctx)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, &DispatchWebhooksResult{Failed: 1}, result)
+		require.Equal(t, webhook.DeliveryStatusFailed, delivery.Status())
+		require.Equal(t, "webhook is disabled", delivery.LastError())
+		require.Equal(t, clk.Now(), delivery.UpdatedAt())
+	})
+}
@@ -0,0 +1,105 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+const (
+	// DefaultPageSize is the number of deliveries returned when PageSize is not set.
+	DefaultPageSize = 50
+	// MaxPageSize is the maximum number of deliveries returned at once.
+	MaxPageSize = 100
+)
+
+type ListDeliveriesRequest struct {
+	WebhookID webhook.WebhookID
+	// PageSize defaults to DefaultPageSize.
+	PageSize int
+	// Offset is the number of deliveries to skip, usually the NextOffset of the previous page.
+	Offset int
+}
+
+type DeliveryPage struct {
+	Deliveries []*webhook.Delivery
+	// NextOffset is the Offset of the next page, or nil on the last page.
+	NextOffset *int
+}
+
+// ListDeliveriesUseCase is the interface that wraps the basic ListDeliveries operation.
+type ListDeliveriesUseCase interface {
+	Execute(ctx context.Context, req ListDeliveriesRequest) (*DeliveryPage, error)
+}
+
+// listDeliveriesUseCase is the implementation of the ListDeliveriesUseCase interface.
+type listDeliveriesUseCase struct {
+	webhookRepository  webhook.WebhookRepository
+	deliveryRepository webhook.DeliveryRepository
+	txRunner           uow.TransactionRunner
+}
+
+// NewListDeliveriesUseCase creates a new ListDeliveriesUseCase.
+func NewListDeliveriesUseCase(i *do.Injector) (ListDeliveriesUseCase, error) {
+	webhookRepository, err := do.Invoke[webhook.WebhookRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke webhook repository: %w", err)
+	}
+	deliveryRepository, err := do.Invoke[webhook.DeliveryRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delivery repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &listDeliveriesUseCase{
+		webhookRepository:  webhookRepository,
+		deliveryRepository: deliveryRepository,
+		txRunner:           txRunner,
+	}, nil
+}
+
+// Execute returns a page of the deliveries of a Webhook, newest first.
+func (u listDeliveriesUseCase) Execute(ctx context.Context, req ListDeliveriesRequest) (*DeliveryPage, error) {
+	if req.PageSize < 0 || req.PageSize > MaxPageSize {
+		return nil, &webhook.ValidationError{
+			Field:   "page_size",
+			Message: fmt.Sprintf("page size must be between 0 and %d", MaxPageSize),
+		}
+	}
+	if req.Offset < 0 {
+		return nil, &webhook.ValidationError{Field: "page_token", Message: "invalid page token"}
+	}
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	var page *DeliveryPage
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := u.webhookRepository.FindByID(ctx, req.WebhookID); err != nil {
+			return fmt.Errorf("failed to find webhook: %w", err)
+		}
+		// One extra delivery tells whether there is a next page.
+		deliveries, err := u.deliveryRepository.FindByWebhookID(ctx, req.WebhookID, pageSize+1, req.Offset)
+		if err != nil {
+			return fmt.Errorf("failed to find deliveries: %w", err)
+		}
+		page = &DeliveryPage{Deliveries: deliveries}
+		if len(deliveries) > pageSize {
+			page.Deliveries = deliveries[:pageSize]
+			nextOffset := req.Offset + pageSize
+			page.NextOffset = &nextOffset
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, preserveNotFound(err)
+	}
+	return page, nil
+}
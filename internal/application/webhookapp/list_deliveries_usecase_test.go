@@ -0,0 +1,69 @@
+package webhookapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDeliveriesUseCase_Execute(t *testing.T) {
+	t.Run("returns a page with the offset of the next page", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		target, err := webhook.NewWebhook(idgen.UUIDv7(), time.Now(), "https://example.com/hooks", []webhook.EventType{webhook.EventTodoCreated})
+		require.NoError(t, err)
+		event, err := webhook.NewPingEvent(idgen.UUIDv7(), time.Now(), target.ID())
+		require.NoError(t, err)
+		deliveries := []*webhook.Delivery{
+			webhook.NewDelivery(idgen.UUIDv7(), time.Now(), target, event),
+			webhook.NewDelivery(idgen.UUIDv7(), time.Now(), target, event),
+			webhook.NewDelivery(idgen.UUIDv7(), time.Now(), target, event),
+		}
+
+		mockWebhookRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockDeliveryRepo := mock_webhook.NewMockDeliveryRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+				mockWebhookRepo.EXPECT().FindByID(ctx, target.ID()).Return(target, nil)
+				mockDeliveryRepo.EXPECT().FindByWebhookID(ctx, target.ID(), 3, 4).Return(deliveries, nil)
+				return fn(ctx)
+			})
+
+		useCase := &listDeliveriesUseCase{
+			webhookRepository:  mockWebhookRepo,
+			deliveryRepository: mockDeliveryRepo,
+			txRunner:           mockTxRunner,
+		}
+
+		// When
+		page, err := useCase.Execute(ctx, ListDeliveriesRequest{WebhookID: target.ID(), PageSize: 2, Offset: 4})
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, deliveries[:2], page.Deliveries)
+		require.NotNil(t, page.NextOffset)
+		require.Equal(t, 6, *page.NextOffset)
+	})
+
+	t.Run("returns validation error for a too large page size", func(t *testing.T) {
+		// Given
+		useCase := &listDeliveriesUseCase{}
+
+		// When
+		_, err := useCase.Execute(context.Background(), ListDeliveriesRequest{PageSize: MaxPageSize + 1})
+
+		// Then
+		var validationErr *webhook.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
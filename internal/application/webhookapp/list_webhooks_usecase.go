@@ -0,0 +1,55 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+// ListWebhooksUseCase is the interface that wraps the basic ListWebhooks operation.
+type ListWebhooksUseCase interface {
+	Execute(ctx context.Context) ([]*webhook.Webhook, error)
+}
+
+// listWebhooksUseCase is the implementation of the ListWebhooksUseCase interface.
+type listWebhooksUseCase struct {
+	webhookRepository webhook.WebhookRepository
+	txRunner          uow.TransactionRunner
+}
+
+// NewListWebhooksUseCase creates a new ListWebhooksUseCase.
+func NewListWebhooksUseCase(i *do.Injector) (ListWebhooksUseCase, error) {
+	webhookRepository, err := do.Invoke[webhook.WebhookRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke webhook repository: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+
+	return &listWebhooksUseCase{
+		webhookRepository: webhookRepository,
+		txRunner:          txRunner,
+	}, nil
+}
+
+// Execute returns every Webhook, oldest first.
+func (u listWebhooksUseCase) Execute(ctx context.Context) ([]*webhook.Webhook, error) {
+	var webhooks []*webhook.Webhook
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		webhooks, err = u.webhookRepository.FindAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to find webhooks: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction: %w", err)
+	}
+	return webhooks, nil
+}
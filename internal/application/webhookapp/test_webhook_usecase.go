@@ -0,0 +1,122 @@
+package webhookapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+type TestWebhookRequest struct {
+	ID webhook.WebhookID
+}
+
+// TestWebhookUseCase is the interface that wraps the basic TestWebhook operation.
+type TestWebhookUseCase interface {
+	Execute(ctx context.Context, req TestWebhookRequest) (*webhook.Delivery, error)
+}
+
+// testWebhookUseCase is the implementation of the TestWebhookUseCase interface.
+type testWebhookUseCase struct {
+	webhookRepository  webhook.WebhookRepository
+	deliveryRepository webhook.DeliveryRepository
+	sender             webhook.Sender
+	txRunner           uow.TransactionRunner
+	clock              clock.Clock
+	ids                idgen.IDGenerator
+}
+
+// NewTestWebhookUseCase creates a new TestWebhookUseCase.
+func NewTestWebhookUseCase(i *do.Injector) (TestWebhookUseCase, error) {
+	webhookRepository, err := do.Invoke[webhook.WebhookRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke webhook repository: %w", err)
+	}
+	deliveryRepository, err := do.Invoke[webhook.DeliveryRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke delivery repository: %w", err)
+	}
+	sender, err := do.Invoke[webhook.Sender](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke webhook sender: %w", err)
+	}
+	txRunner, err := do.Invoke[uow.TransactionRunner](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke transaction manager: %w", err)
+	}
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &testWebhookUseCase{
+		webhookRepository:  webhookRepository,
+		deliveryRepository: deliveryRepository,
+		sender:             sender,
+		txRunner:           txRunner,
+		clock:              clk,
+		ids:                ids,
+	}, nil
+}
+
+// Execute sends a ping to the Webhook once and records the delivery, even when the
+// Webhook is disabled. A failed ping does not count towards disabling the Webhook,
+// and a successful one enables it again.
+func (u testWebhookUseCase) Execute(ctx context.Context, req TestWebhookRequest) (*webhook.Delivery, error) {
+	var target *webhook.Webhook
+	err := u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		target, err = u.webhookRepository.FindByID(ctx, req.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find webhook: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, preserveNotFound(err)
+	}
+
+	event, err := webhook.NewPingEvent(u.ids, u.clock.Now(), target.ID())
+	if err != nil {
+		return nil, err
+	}
+	delivery := webhook.NewDelivery(u.ids, u.clock.Now(), target, event)
+	status, sendErr := u.sender.Send(ctx, target, delivery)
+	delivery.RecordAttempt(u.clock.Now(), status, sendErr)
+
+	err = u.txRunner.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.deliveryRepository.Create(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to save delivery: %w", err)
+		}
+		if !delivery.Succeeded() {
+			return nil
+		}
+		target.RecordSuccess(u.clock.Now())
+		if err := u.webhookRepository.Update(ctx, target); err != nil {
+			return fmt.Errorf("failed to save webhook: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, preserveNotFound(err)
+	}
+	return delivery, nil
+}
+
+// preserveNotFound returns a NotFoundError as is, and wraps other transaction errors.
+func preserveNotFound(err error) error {
+	var notFoundErr *webhook.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return err
+	}
+	return fmt.Errorf("failed to execute transaction: %w", err)
+}
@@ -0,0 +1,127 @@
+package webhookapp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/iktakahiro/oniongo/internal/mocks/application/mock_uow"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_webhook"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestWebhookUseCase_Execute(t *testing.T) {
+	runInTx := func(ctx context.Context, fn func(context.Context) error, _ ...uow.TxOption) error {
+		return fn(ctx)
+	}
+
+	t.Run("enables a disabled webhook when the ping succeeds", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		clk := clock.NewFake(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+		target, err := webhook.NewWebhook(idgen.UUIDv7(), time.Now(), "https://example.com/hooks", []webhook.EventType{webhook.EventTodoCreated})
+		require.NoError(t, err)
+		for range webhook.MaxConsecutiveFailures {
+			target.RecordFailure(time.Now())
+		}
+
+		mockWebhookRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockDeliveryRepo := mock_webhook.NewMockDeliveryRepository(t)
+		mockSender := mock_webhook.NewMockSender(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).RunAndReturn(runInTx)
+		mockWebhookRepo.EXPECT().FindByID(ctx, target.ID()).Return(target, nil)
+		mockSender.EXPECT().Send(ctx, target, mock.AnythingOfType("*webhook.Delivery")).Return(http.StatusOK, nil)
+		mockDeliveryRepo.EXPECT().Create(ctx, mock.AnythingOfType("*webhook.Delivery")).Return(nil)
+		mockWebhookRepo.EXPECT().Update(ctx, target).Return(nil)
+
+		useCase := &testWebhookUseCase{
+			webhookRepository:  mockWebhookRepo,
+			deliveryRepository: mockDeliveryRepo,
+			sender:             mockSender,
+			txRunner:           mockTxRunner,
+			clock:              clk,
+			ids:                idgen.UUIDv7(),
+		}
+
+		// When
+		delivery, err := useCase.Execute(ctx, TestWebhookRequest{ID: target.ID()})
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, webhook.EventPing, delivery.EventType())
+		require.Equal(t, webhook.DeliveryStatusSucceeded, delivery.Status())
+		require.True(t, target.Enabled())
+		require.Zero(t, target.ConsecutiveFailures())
+		require.Equal(t, clk.Now(), delivery.CreatedAt())
+		require.Equal(t, clk.Now(), target.UpdatedAt())
+	})
+
+	t.Run("records a failed ping without counting it", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		target, err := webhook.NewWebhook(idgen.UUIDv7(), time.Now(), "https://example.com/hooks", []webhook.EventType{webhook.EventTodoCreated})
+		require.NoError(t, err)
+
+		mockWebhookRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockDeliveryRepo := mock_webhook.NewMockDeliveryRepository(t)
+		mockSender := mock_webhook.NewMockSender(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).RunAndReturn(runInTx)
+		mockWebhookRepo.EXPECT().FindByID(ctx, target.ID()).Return(target, nil)
+		mockSender.EXPECT().Send(ctx, target, mock.AnythingOfType("*webhook.Delivery")).Return(http.StatusNotFound, nil)
+		mockDeliveryRepo.EXPECT().Create(ctx, mock.AnythingOfType("*webhook.Delivery")).Return(nil)
+
+		useCase := &testWebhookUseCase{
+			webhookRepository:  mockWebhookRepo,
+			deliveryRepository: mockDeliveryRepo,
+			sender:             mockSender,
+			txRunner:           mockTxRunner,
+			clock:              clock.System(),
+			ids:                idgen.UUIDv7(),
+		}
+
+		// When
+		delivery, err := useCase.Execute(ctx, TestWebhookRequest{ID: target.ID()})
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, webhook.DeliveryStatusFailed, delivery.Status())
+		require.Equal(t, http.StatusNotFound, delivery.ResponseStatus())
+		require.Zero(t, target.ConsecutiveFailures())
+	})
+
+	t.Run("returns not found error", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		id := webhook.NewWebhookID()
+
+		mockWebhookRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockTxRunner := mock_uow.NewMockTransactionRunner(t)
+
+		mockTxRunner.EXPECT().RunInTx(ctx, mock.AnythingOfType("func(context.Context) error")).RunAndReturn(runInTx)
+		mockWebhookRepo.EXPECT().FindByID(ctx, id).Return(nil, &webhook.NotFoundError{ID: id})
+
+		useCase := &testWebhookUseCase{
+			webhookRepository: mockWebhookRepo,
+			txRunner:          mockTxRunner,
+			clock:             clock.System(),
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		_, err := useCase.Execute(ctx, TestWebhookRequest{ID: id})
+
+		// Then
+		var notFoundErr *webhook.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+}
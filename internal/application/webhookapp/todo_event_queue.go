@@ -0,0 +1,62 @@
+package webhookapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+// todoEventQueue is the EntryListener that queues the webhook event of every history entry.
+type todoEventQueue struct {
+	webhookRepository webhook.WebhookRepository
+	eventRepository   webhook.EventRepository
+	ids               idgen.IDGenerator
+}
+
+// NewTodoEventQueue creates the EntryListener that queues the events of the todo changes
+// for DispatchWebhooks. The events are queued in the transaction of the change they are about,
+// and only while a webhook is enabled.
+func NewTodoEventQueue(i *do.Injector) (history.EntryListener, error) {
+	webhookRepository, err := do.Invoke[webhook.WebhookRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke webhook repository: %w", err)
+	}
+	eventRepository, err := do.Invoke[webhook.EventRepository](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke event repository: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+
+	return &todoEventQueue{
+		webhookRepository: webhookRepository,
+		eventRepository:   eventRepository,
+		ids:               ids,
+	}, nil
+}
+
+// EntryRecorded queues the event of the entry when any webhook is enabled.
+func (q todoEventQueue) EntryRecorded(ctx context.Context, entry *history.HistoryEntry) error {
+	enabled, err := q.webhookRepository.HasEnabled(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find enabled webhooks: %w", err)
+	}
+	if !enabled {
+		return nil
+	}
+
+	event, err := webhook.NewTodoEvent(q.ids, entry)
+	if err != nil {
+		return fmt.Errorf("failed to create event: %w", err)
+	}
+	if err := q.eventRepository.Create(ctx, event); err != nil {
+		return fmt.Errorf("failed to queue event: %w", err)
+	}
+	return nil
+}
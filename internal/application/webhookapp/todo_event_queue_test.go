@@ -0,0 +1,102 @@
+package webhookapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoEventQueue_EntryRecorded(t *testing.T) {
+	newEntry := func(t *testing.T) *history.HistoryEntry {
+		now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		created, err := todo.NewTodo(idgen.NewSequence(), now, "Buy milk", "")
+		require.NoError(t, err)
+		return history.TodoCreated(idgen.NewSequence(), now, "alice", created)
+	}
+
+	t.Run("queues the event of the entry while a webhook is enabled", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		entry := newEntry(t)
+
+		mockWebhookRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockEventRepo := mock_webhook.NewMockEventRepository(t)
+		mockWebhookRepo.EXPECT().HasEnabled(ctx).Return(true, nil)
+		var queued *webhook.Event
+		mockEventRepo.EXPECT().Create(ctx, mock.AnythingOfType("*webhook.Event")).
+			RunAndReturn(func(_ context.Context, event *webhook.Event) error {
+				queued = event
+				return nil
+			})
+
+		queue := &todoEventQueue{
+			webhookRepository: mockWebhookRepo,
+			eventRepository:   mockEventRepo,
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		err := queue.EntryRecorded(ctx, entry)
+
+		// Then
+		require.NoError(t, err)
+		require.NotNil(t, queued)
+		assert.Equal(t, webhook.EventTodoCreated, queued.Type())
+		assert.Equal(t, entry.TodoID(), queued.TodoID())
+		assert.Equal(t, entry.OccurredAt(), queued.OccurredAt())
+	})
+
+	t.Run("queues nothing while no webhook is enabled", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+
+		mockWebhookRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockEventRepo := mock_webhook.NewMockEventRepository(t)
+		mockWebhookRepo.EXPECT().HasEnabled(ctx).Return(false, nil)
+
+		queue := &todoEventQueue{
+			webhookRepository: mockWebhookRepo,
+			eventRepository:   mockEventRepo,
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		err := queue.EntryRecorded(ctx, newEntry(t))
+
+		// Then
+		require.NoError(t, err)
+	})
+
+	t.Run("returns the error of the event repository", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		failure := errors.New("failure")
+
+		mockWebhookRepo := mock_webhook.NewMockWebhookRepository(t)
+		mockEventRepo := mock_webhook.NewMockEventRepository(t)
+		mockWebhookRepo.EXPECT().HasEnabled(ctx).Return(true, nil)
+		mockEventRepo.EXPECT().Create(ctx, mock.AnythingOfType("*webhook.Event")).Return(failure)
+
+		queue := &todoEventQueue{
+			webhookRepository: mockWebhookRepo,
+			eventRepository:   mockEventRepo,
+			ids:               idgen.UUIDv7(),
+		}
+
+		// When
+		err := queue.EntryRecorded(ctx, newEntry(t))
+
+		// Then
+		assert.ErrorIs(t, err, failure)
+	})
+}
@@ -0,0 +1,158 @@
+// Package attachment provides the domain layer for files attached to todos.
+package attachment
+
+import (
+	"encoding/hex"
+	"mime"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+const (
+	// MaxFilenameLength is the maximum number of characters in a filename.
+	MaxFilenameLength = 255
+	// MaxSize is the maximum size of an attached file in bytes.
+	MaxSize int64 = 1 << 30
+	// DefaultContentType is the content type assigned to a file when none is given.
+	DefaultContentType = "application/octet-stream"
+)
+
+// Attachment is the entity that represents a file attached to a todo.
+// The content of the file lives in a BlobStore under BlobKey.
+type Attachment struct {
+	id          AttachmentID
+	todoID      todo.TodoID
+	filename    string
+	contentType string
+	size        int64
+	checksum    string
+	createdAt   time.Time
+}
+
+// NewAttachment creates a new Attachment with an ID from ids, created at now,
+// for a file that is about to be uploaded.
+// An empty content type falls back to DefaultContentType.
+// The size and checksum are set by Uploaded once the content is stored.
+func NewAttachment(ids idgen.IDGenerator, now time.Time, todoID todo.TodoID, filename string, contentType string) (*Attachment, error) {
+	if err := validateFilename(filename); err != nil {
+		return nil, err
+	}
+	if contentType == "" {
+		contentType = DefaultContentType
+	}
+	contentType, err := normalizeContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+	return &Attachment{
+		id:          AttachmentID(ids.NewID()),
+		todoID:      todoID,
+		filename:    filename,
+		contentType: contentType,
+		createdAt:   now,
+	}, nil
+}
+
+// ID returns the ID of the Attachment.
+func (a Attachment) ID() AttachmentID {
+	return a.id
+}
+
+// TodoID returns the ID of the Todo the Attachment belongs to.
+func (a Attachment) TodoID() todo.TodoID {
+	return a.todoID
+}
+
+// Filename returns the filename of the Attachment.
+func (a Attachment) Filename() string {
+	return a.filename
+}
+
+// ContentType returns the media type of the Attachment.
+func (a Attachment) ContentType() string {
+	return a.contentType
+}
+
+// Size returns the size of the Attachment in bytes.
+func (a Attachment) Size() int64 {
+	return a.size
+}
+
+// Checksum returns the hex encoded SHA-256 digest of the content of the Attachment.
+func (a Attachment) Checksum() string {
+	return a.checksum
+}
+
+// CreatedAt returns the created at of the Attachment.
+func (a Attachment) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// BlobKey returns the key of the content of the Attachment in the BlobStore.
+func (a Attachment) BlobKey() string {
+	return a.id.String()
+}
+
+// Uploaded records the size and the SHA-256 checksum of the stored content.
+func (a *Attachment) Uploaded(size int64, checksum string) error {
+	if size < 0 {
+		return &ValidationError{Field: "size", Message: "size must not be negative"}
+	}
+	if size > MaxSize {
+		return &ValidationError{Field: "content", Message: "file is too large"}
+	}
+	if b, err := hex.DecodeString(checksum); err != nil || len(b) != 32 {
+		return &ValidationError{Field: "checksum", Message: "checksum must be a hex encoded SHA-256 digest"}
+	}
+	a.size = size
+	a.checksum = checksum
+	return nil
+}
+
+// ReconstructAttachment reconstructs an Attachment from the given values.
+func ReconstructAttachment(
+	id uuid.UUID,
+	todoID todo.TodoID,
+	filename string,
+	contentType string,
+	size int64,
+	checksum string,
+	createdAt time.Time,
+) *Attachment {
+	return &Attachment{
+		id:          AttachmentID(id),
+		todoID:      todoID,
+		filename:    filename,
+		contentType: contentType,
+		size:        size,
+		checksum:    checksum,
+		createdAt:   createdAt,
+	}
+}
+
+func validateFilename(filename string) error {
+	if filename == "" {
+		return &ValidationError{Field: "filename", Message: "filename is required"}
+	}
+	if utf8.RuneCountInString(filename) > MaxFilenameLength {
+		return &ValidationError{Field: "filename", Message: "filename is too long"}
+	}
+	if filename == "." || filename == ".." || strings.ContainsAny(filename, "/\\\x00") {
+		return &ValidationError{Field: "filename", Message: "filename must not contain a path"}
+	}
+	return nil
+}
+
+// normalizeContentType validates the media type and formats it in canonical form.
+func normalizeContentType(contentType string) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.Contains(mediaType, "/") {
+		return "", &ValidationError{Field: "content_type", Message: "content type must be a media type like image/png"}
+	}
+	return mime.FormatMediaType(mediaType, params), nil
+}
@@ -0,0 +1,36 @@
+package attachment
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+// AttachmentID is the identifier for an Attachment.
+type AttachmentID uuid.UUID
+
+// NewAttachmentID creates a new AttachmentID with the default IDGenerator.
+// NewAttachment takes the IDs of new Attachments from the IDGenerator it is given instead.
+func NewAttachmentID() AttachmentID {
+	return AttachmentID(idgen.UUIDv7().NewID())
+}
+
+// String returns the string representation of the AttachmentID.
+func (id AttachmentID) String() string {
+	return id.UUID().String()
+}
+
+// UUID returns the UUID representation of the AttachmentID.
+func (id AttachmentID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// NewAttachmentIDFromString creates a new AttachmentID from a string.
+func NewAttachmentIDFromString(s string) (AttachmentID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return AttachmentID{}, fmt.Errorf("failed to parse uuid %s: %w", s, err)
+	}
+	return AttachmentID(id), nil
+}
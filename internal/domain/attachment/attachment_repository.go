@@ -0,0 +1,18 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// AttachmentRepository is the interface that wraps the basic CRUD operations for Attachment.
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *Attachment) error
+	FindByID(ctx context.Context, id AttachmentID) (*Attachment, error)
+	// FindByIDs returns the attachments that exist among the given IDs.
+	FindByIDs(ctx context.Context, ids []AttachmentID) ([]*Attachment, error)
+	// FindByTodoIDs returns the attachments of the todos, oldest first.
+	FindByTodoIDs(ctx context.Context, todoIDs []todo.TodoID) ([]*Attachment, error)
+	Delete(ctx context.Context, id AttachmentID) error
+}
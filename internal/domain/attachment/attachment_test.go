@@ -0,0 +1,183 @@
+package attachment
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttachment(t *testing.T) {
+	tests := []struct {
+		name                string
+		filename            string
+		contentType         string
+		expectedContentType string
+		expectError         bool
+		errorMsg            string
+	}{
+		{
+			name:                "valid attachment",
+			filename:            "screenshot.png",
+			contentType:         "image/png",
+			expectedContentType: "image/png",
+			expectError:         false,
+		},
+		{
+			name:                "attachment with empty content type uses default",
+			filename:            "notes.bin",
+			contentType:         "",
+			expectedContentType: DefaultContentType,
+			expectError:         false,
+		},
+		{
+			name:                "content type is normalized",
+			filename:            "notes.txt",
+			contentType:         "Text/Plain; Charset=utf-8",
+			expectedContentType: "text/plain; charset=utf-8",
+			expectError:         false,
+		},
+		{
+			name:        "attachment with empty filename",
+			filename:    "",
+			contentType: "image/png",
+			expectError: true,
+			errorMsg:    "filename: filename is required",
+		},
+		{
+			name:        "attachment with too long filename",
+			filename:    strings.Repeat("a", MaxFilenameLength+1),
+			contentType: "image/png",
+			expectError: true,
+			errorMsg:    "filename: filename is too long",
+		},
+		{
+			name:        "attachment with path in filename",
+			filename:    "../etc/passwd",
+			contentType: "text/plain",
+			expectError: true,
+			errorMsg:    "filename: filename must not contain a path",
+		},
+		{
+			name:        "attachment with invalid content type",
+			filename:    "screenshot.png",
+			contentType: "png",
+			expectError: true,
+			errorMsg:    "content_type: content type must be a media type like image/png",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			todoID := todo.NewTodoID()
+			now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+
+			// When
+			attachment, err := NewAttachment(idgen.NewSequence(), now, todoID, tt.filename, tt.contentType)
+
+			// Then
+			if tt.expectError {
+				require.Error(t, err)
+				require.Equal(t, tt.errorMsg, err.Error())
+				require.Nil(t, attachment)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, attachment)
+				require.Equal(t, "00000000-0000-7000-8000-000000000001", attachment.ID().String())
+				require.Equal(t, now, attachment.CreatedAt())
+				require.Equal(t, todoID, attachment.TodoID())
+				require.Equal(t, tt.filename, attachment.Filename())
+				require.Equal(t, tt.expectedContentType, attachment.ContentType())
+				require.Equal(t, attachment.ID().String(), attachment.BlobKey())
+				require.Zero(t, attachment.Size())
+			}
+		})
+	}
+}
+
+func TestAttachment_Uploaded(t *testing.T) {
+	const checksum = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	tests := []struct {
+		name        string
+		size        int64
+		checksum    string
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid size and checksum",
+			size:        1024,
+			checksum:    checksum,
+			expectError: false,
+		},
+		{
+			name:        "file at the size limit",
+			size:        MaxSize,
+			checksum:    checksum,
+			expectError: false,
+		},
+		{
+			name:        "file over the size limit",
+			size:        MaxSize + 1,
+			checksum:    checksum,
+			expectError: true,
+			errorMsg:    "content: file is too large",
+		},
+		{
+			name:        "checksum that is not SHA-256",
+			size:        1024,
+			checksum:    "abc",
+			expectError: true,
+			errorMsg:    "checksum: checksum must be a hex encoded SHA-256 digest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			attachment, err := NewAttachment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "notes.txt", "text/plain")
+			require.NoError(t, err)
+
+			// When
+			err = attachment.Uploaded(tt.size, tt.checksum)
+
+			// Then
+			if tt.expectError {
+				require.Error(t, err)
+				require.Equal(t, tt.errorMsg, err.Error())
+				require.Zero(t, attachment.Size())
+				require.Empty(t, attachment.Checksum())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.size, attachment.Size())
+				require.Equal(t, tt.checksum, attachment.Checksum())
+			}
+		})
+	}
+}
+
+func TestReconstructAttachment(t *testing.T) {
+	// Given
+	id := uuid.New()
+	todoID := todo.NewTodoID()
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// When
+	attachment := ReconstructAttachment(id, todoID, "notes.txt", "text/plain", 3, "abc", createdAt)
+
+	// Then
+	require.NotNil(t, attachment)
+	require.Equal(t, AttachmentID(id), attachment.ID())
+	require.Equal(t, todoID, attachment.TodoID())
+	require.Equal(t, "notes.txt", attachment.Filename())
+	require.Equal(t, "text/plain", attachment.ContentType())
+	require.Equal(t, int64(3), attachment.Size())
+	require.Equal(t, "abc", attachment.Checksum())
+	require.Equal(t, createdAt, attachment.CreatedAt())
+}
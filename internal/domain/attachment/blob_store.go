@@ -0,0 +1,28 @@
+package attachment
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// BlobInfo describes a blob in a BlobStore.
+type BlobInfo struct {
+	Key        string
+	ModifiedAt time.Time
+}
+
+// BlobStore stores the content of attachments.
+// Blobs are not transactional, so they are written before and deleted after
+// the attachments that refer to them are saved.
+type BlobStore interface {
+	// Put stores the content read from r under the key, replacing any existing blob.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get opens the blob stored under the key.
+	// It returns a BlobNotFoundError when there is no such blob.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob stored under the key. Deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+	// List returns every blob in the store.
+	List(ctx context.Context) ([]BlobInfo, error)
+}
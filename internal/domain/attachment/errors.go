@@ -0,0 +1,82 @@
+package attachment
+
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+)
+
+// Reasons of the attachment errors, reported to clients as stable error codes
+const (
+	ReasonNotFound        = "ATTACHMENT_NOT_FOUND"
+	ReasonInvalidArgument = "ATTACHMENT_INVALID_ARGUMENT"
+	ReasonCorrupted       = "ATTACHMENT_CORRUPTED"
+)
+
+// NotFoundError represents an error when an attachment is not found
+type NotFoundError struct {
+	ID AttachmentID
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("attachment not found: %s", e.ID.String())
+}
+
+func (e *NotFoundError) Kind() domainerr.Kind { return domainerr.KindNotFound }
+
+func (e *NotFoundError) Reason() string { return ReasonNotFound }
+
+func (e *NotFoundError) Metadata() map[string]string {
+	return map[string]string{"attachment_id": e.ID.String()}
+}
+
+// ValidationError represents a validation error
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return e.Message
+}
+
+func (e *ValidationError) Kind() domainerr.Kind { return domainerr.KindInvalidArgument }
+
+func (e *ValidationError) Reason() string { return ReasonInvalidArgument }
+
+func (e *ValidationError) FieldViolations() []domainerr.FieldViolation {
+	if e.Field == "" {
+		return nil
+	}
+	return []domainerr.FieldViolation{{Field: e.Field, Description: e.Message}}
+}
+
+// IntegrityError represents an attachment whose stored content is missing or corrupted
+type IntegrityError struct {
+	ID      AttachmentID
+	Message string
+}
+
+func (e *IntegrityError) Error() string {
+	return fmt.Sprintf("attachment %s: %s", e.ID.String(), e.Message)
+}
+
+func (e *IntegrityError) Kind() domainerr.Kind { return domainerr.KindDataLoss }
+
+func (e *IntegrityError) Reason() string { return ReasonCorrupted }
+
+func (e *IntegrityError) Metadata() map[string]string {
+	return map[string]string{"attachment_id": e.ID.String()}
+}
+
+// BlobNotFoundError represents an error when a blob is not found in a BlobStore
+type BlobNotFoundError struct {
+	Key string
+}
+
+func (e *BlobNotFoundError) Error() string {
+	return fmt.Sprintf("blob not found: %s", e.Key)
+}
@@ -0,0 +1,37 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystem(t *testing.T) {
+	// Given
+	before := time.Now()
+
+	// When
+	now := System().Now()
+
+	// Then
+	require.False(t, now.Before(before))
+}
+
+func TestFake(t *testing.T) {
+	// Given
+	start := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+	fake := NewFake(start)
+
+	// When
+	first := fake.Now()
+	fake.Advance(time.Hour)
+	advanced := fake.Now()
+	fake.Set(start.Add(-time.Hour))
+	set := fake.Now()
+
+	// Then
+	require.Equal(t, start, first)
+	require.Equal(t, start.Add(time.Hour), advanced)
+	require.Equal(t, start.Add(-time.Hour), set)
+}
@@ -0,0 +1,171 @@
+// Package comment provides the domain layer for comments on todos.
+package comment
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+const (
+	// MaxAuthorLength is the maximum number of characters in an author name.
+	MaxAuthorLength = 100
+	// MaxBodyLength is the maximum number of characters in a comment body.
+	MaxBodyLength = 10000
+)
+
+// Comment is the entity that represents a markdown comment on a todo.
+// Deleted comments keep their place in the discussion but lose their body.
+type Comment struct {
+	id        CommentID
+	todoID    todo.TodoID
+	author    string
+	body      string
+	createdAt time.Time
+	updatedAt time.Time
+	editedAt  *time.Time
+	deletedAt *time.Time
+}
+
+// NewComment creates a new Comment on the Todo with an ID from ids, created at now.
+func NewComment(ids idgen.IDGenerator, now time.Time, todoID todo.TodoID, author string, body string) (*Comment, error) {
+	if err := validateAuthor(author); err != nil {
+		return nil, err
+	}
+	if err := validateBody(body); err != nil {
+		return nil, err
+	}
+	return &Comment{
+		id:        CommentID(ids.NewID()),
+		todoID:    todoID,
+		author:    author,
+		body:      body,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ID returns the ID of the Comment.
+func (c Comment) ID() CommentID {
+	return c.id
+}
+
+// TodoID returns the ID of the Todo the Comment belongs to.
+func (c Comment) TodoID() todo.TodoID {
+	return c.todoID
+}
+
+// Author returns the author of the Comment.
+func (c Comment) Author() string {
+	return c.author
+}
+
+// Body returns the markdown body of the Comment. It is empty once the Comment is deleted.
+func (c Comment) Body() string {
+	return c.body
+}
+
+// CreatedAt returns the created at of the Comment.
+func (c Comment) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt returns the updated at of the Comment.
+func (c Comment) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// EditedAt returns when the body of the Comment was last edited, or nil if it never was.
+func (c Comment) EditedAt() *time.Time {
+	return c.editedAt
+}
+
+// DeletedAt returns when the Comment was deleted, or nil if it is not deleted.
+func (c Comment) DeletedAt() *time.Time {
+	return c.deletedAt
+}
+
+// IsEdited checks if the body of the Comment has been edited.
+func (c Comment) IsEdited() bool {
+	return c.editedAt != nil
+}
+
+// IsDeleted checks if the Comment has been deleted.
+func (c Comment) IsDeleted() bool {
+	return c.deletedAt != nil
+}
+
+// Edit replaces the body of the Comment.
+func (c *Comment) Edit(now time.Time, body string) error {
+	if c.IsDeleted() {
+		return &StateError{Message: "cannot edit a deleted comment"}
+	}
+	if err := validateBody(body); err != nil {
+		return err
+	}
+	if body == c.body {
+		return nil
+	}
+	c.body = body
+	c.editedAt = &now
+	c.updatedAt = now
+	return nil
+}
+
+// Delete marks the Comment as deleted and discards its body.
+func (c *Comment) Delete(now time.Time) error {
+	if c.IsDeleted() {
+		return &StateError{Message: "comment is already deleted"}
+	}
+	c.body = ""
+	c.deletedAt = &now
+	c.updatedAt = now
+	return nil
+}
+
+// ReconstructComment reconstructs a Comment from the given values.
+func ReconstructComment(
+	id uuid.UUID,
+	todoID todo.TodoID,
+	author string,
+	body string,
+	createdAt time.Time,
+	updatedAt time.Time,
+	editedAt *time.Time,
+	deletedAt *time.Time,
+) *Comment {
+	return &Comment{
+		id:        CommentID(id),
+		todoID:    todoID,
+		author:    author,
+		body:      body,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+		editedAt:  editedAt,
+		deletedAt: deletedAt,
+	}
+}
+
+func validateAuthor(author string) error {
+	if strings.TrimSpace(author) == "" {
+		return &ValidationError{Field: "author", Message: "author is required"}
+	}
+	if utf8.RuneCountInString(author) > MaxAuthorLength {
+		return &ValidationError{Field: "author", Message: "author is too long"}
+	}
+	return nil
+}
+
+func validateBody(body string) error {
+	if strings.TrimSpace(body) == "" {
+		return &ValidationError{Field: "body", Message: "body is required"}
+	}
+	if utf8.RuneCountInString(body) > MaxBodyLength {
+		return &ValidationError{Field: "body", Message: "body is too long"}
+	}
+	return nil
+}
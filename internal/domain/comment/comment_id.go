@@ -0,0 +1,36 @@
+package comment
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+// CommentID is the identifier for a Comment.
+type CommentID uuid.UUID
+
+// NewCommentID creates a new CommentID with the default IDGenerator.
+// NewComment takes the IDs of new Comments from the IDGenerator it is given instead.
+func NewCommentID() CommentID {
+	return CommentID(idgen.UUIDv7().NewID())
+}
+
+// String returns the string representation of the CommentID.
+func (id CommentID) String() string {
+	return id.UUID().String()
+}
+
+// UUID returns the UUID representation of the CommentID.
+func (id CommentID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// NewCommentIDFromString creates a new CommentID from a string.
+func NewCommentIDFromString(s string) (CommentID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return CommentID{}, fmt.Errorf("failed to parse uuid %s: %w", s, err)
+	}
+	return CommentID(id), nil
+}
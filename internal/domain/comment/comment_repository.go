@@ -0,0 +1,19 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// CommentRepository is the interface that wraps the basic CRUD operations for Comment.
+type CommentRepository interface {
+	Create(ctx context.Context, comment *Comment) error
+	Update(ctx context.Context, comment *Comment) error
+	FindByID(ctx context.Context, id CommentID) (*Comment, error)
+	// FindByTodoID returns the comments on the Todo, oldest first, deleted ones included.
+	FindByTodoID(ctx context.Context, todoID todo.TodoID, limit int, offset int) ([]*Comment, error)
+	// CountByTodoIDs counts the comments that are not deleted on each of the todos.
+	// Todos without comments are left out of the result.
+	CountByTodoIDs(ctx context.Context, todoIDs []todo.TodoID) (map[todo.TodoID]int, error)
+}
@@ -0,0 +1,205 @@
+package comment
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComment(t *testing.T) {
+	tests := []struct {
+		name        string
+		author      string
+		body        string
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid comment",
+			author:      "alice",
+			body:        "Looks good to me",
+			expectError: false,
+		},
+		{
+			name:        "comment with empty author",
+			author:      "",
+			body:        "Looks good to me",
+			expectError: true,
+			errorMsg:    "author: author is required",
+		},
+		{
+			name:        "comment with too long author",
+			author:      strings.Repeat("a", MaxAuthorLength+1),
+			body:        "Looks good to me",
+			expectError: true,
+			errorMsg:    "author: author is too long",
+		},
+		{
+			name:        "comment with blank body",
+			author:      "alice",
+			body:        " \n ",
+			expectError: true,
+			errorMsg:    "body: body is required",
+		},
+		{
+			name:        "comment with too long body",
+			author:      "alice",
+			body:        strings.Repeat("a", MaxBodyLength+1),
+			expectError: true,
+			errorMsg:    "body: body is too long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			todoID := todo.NewTodoID()
+			now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+			ids := idgen.NewSequence()
+
+			// When
+			comment, err := NewComment(ids, now, todoID, tt.author, tt.body)
+
+			// Then
+			if tt.expectError {
+				require.Error(t, err)
+				require.Equal(t, tt.errorMsg, err.Error())
+				require.Nil(t, comment)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, comment)
+				require.Equal(t, "00000000-0000-7000-8000-000000000001", comment.ID().String())
+				require.Equal(t, todoID, comment.TodoID())
+				require.Equal(t, tt.author, comment.Author())
+				require.Equal(t, tt.body, comment.Body())
+				require.False(t, comment.IsEdited())
+				require.False(t, comment.IsDeleted())
+				require.Equal(t, now, comment.CreatedAt())
+				require.Equal(t, now, comment.UpdatedAt())
+			}
+		})
+	}
+}
+
+func TestComment_Edit(t *testing.T) {
+	t.Run("replaces the body and marks the comment as edited", func(t *testing.T) {
+		// Given
+		comment, err := NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+		originalUpdatedAt := comment.UpdatedAt()
+		time.Sleep(1 * time.Millisecond) // Ensure time difference
+
+		// When
+		err = comment.Edit(time.Now(), "second draft")
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "second draft", comment.Body())
+		require.True(t, comment.IsEdited())
+		require.Equal(t, comment.UpdatedAt(), *comment.EditedAt())
+		require.True(t, comment.UpdatedAt().After(originalUpdatedAt))
+	})
+
+	t.Run("does not mark the comment as edited when the body is unchanged", func(t *testing.T) {
+		// Given
+		comment, err := NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+
+		// When
+		err = comment.Edit(time.Now(), "first draft")
+
+		// Then
+		require.NoError(t, err)
+		require.False(t, comment.IsEdited())
+	})
+
+	t.Run("rejects an empty body", func(t *testing.T) {
+		// Given
+		comment, err := NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+
+		// When
+		err = comment.Edit(time.Now(), "")
+
+		// Then
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t, "first draft", comment.Body())
+		require.False(t, comment.IsEdited())
+	})
+
+	t.Run("rejects a deleted comment", func(t *testing.T) {
+		// Given
+		comment, err := NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+		require.NoError(t, comment.Delete(time.Now()))
+
+		// When
+		err = comment.Edit(time.Now(), "second draft")
+
+		// Then
+		var stateErr *StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Empty(t, comment.Body())
+	})
+}
+
+func TestComment_Delete(t *testing.T) {
+	t.Run("marks the comment as deleted and discards the body", func(t *testing.T) {
+		// Given
+		comment, err := NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+
+		// When
+		err = comment.Delete(time.Now())
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, comment.IsDeleted())
+		require.Empty(t, comment.Body())
+		require.Equal(t, "alice", comment.Author())
+		require.Equal(t, comment.UpdatedAt(), *comment.DeletedAt())
+	})
+
+	t.Run("rejects a deleted comment", func(t *testing.T) {
+		// Given
+		comment, err := NewComment(idgen.UUIDv7(), time.Now(), todo.NewTodoID(), "alice", "first draft")
+		require.NoError(t, err)
+		require.NoError(t, comment.Delete(time.Now()))
+
+		// When
+		err = comment.Delete(time.Now())
+
+		// Then
+		var stateErr *StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, "comment is already deleted", stateErr.Message)
+	})
+}
+
+func TestReconstructComment(t *testing.T) {
+	// Given
+	id := uuid.New()
+	todoID := todo.NewTodoID()
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	// When
+	comment := ReconstructComment(id, todoID, "alice", "hello", createdAt, updatedAt, &updatedAt, nil)
+
+	// Then
+	require.NotNil(t, comment)
+	require.Equal(t, CommentID(id), comment.ID())
+	require.Equal(t, todoID, comment.TodoID())
+	require.Equal(t, "alice", comment.Author())
+	require.Equal(t, "hello", comment.Body())
+	require.Equal(t, createdAt, comment.CreatedAt())
+	require.Equal(t, updatedAt, comment.UpdatedAt())
+	require.True(t, comment.IsEdited())
+	require.False(t, comment.IsDeleted())
+}
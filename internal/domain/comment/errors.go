@@ -0,0 +1,77 @@
+package comment
+
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+)
+
+// Reasons of the comment errors, reported to clients as stable error codes
+const (
+	ReasonNotFound        = "COMMENT_NOT_FOUND"
+	ReasonInvalidArgument = "COMMENT_INVALID_ARGUMENT"
+	ReasonDeleted         = "COMMENT_DELETED"
+)
+
+// NotFoundError represents an error when a comment is not found
+type NotFoundError struct {
+	ID CommentID
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("comment not found: %s", e.ID.String())
+}
+
+func (e *NotFoundError) Kind() domainerr.Kind { return domainerr.KindNotFound }
+
+func (e *NotFoundError) Reason() string { return ReasonNotFound }
+
+func (e *NotFoundError) Metadata() map[string]string {
+	return map[string]string{"comment_id": e.ID.String()}
+}
+
+// ValidationError represents a validation error
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return e.Message
+}
+
+func (e *ValidationError) Kind() domainerr.Kind { return domainerr.KindInvalidArgument }
+
+func (e *ValidationError) Reason() string { return ReasonInvalidArgument }
+
+func (e *ValidationError) FieldViolations() []domainerr.FieldViolation {
+	if e.Field == "" {
+		return nil
+	}
+	return []domainerr.FieldViolation{{Field: e.Field, Description: e.Message}}
+}
+
+// StateError represents an operation that is not allowed in the current state of a comment
+type StateError struct {
+	Message string
+}
+
+func (e *StateError) Error() string {
+	return e.Message
+}
+
+func (e *StateError) Kind() domainerr.Kind { return domainerr.KindFailedPrecondition }
+
+// Reason returns ReasonDeleted, as a deleted comment is the only state that refuses operations.
+func (e *StateError) Reason() string { return ReasonDeleted }
+
+func (e *StateError) PreconditionViolations() []domainerr.PreconditionViolation {
+	return []domainerr.PreconditionViolation{{
+		Type:        "COMMENT_STATE",
+		Subject:     "deleted",
+		Description: e.Message,
+	}}
+}
@@ -0,0 +1,83 @@
+// Package domainerr defines the taxonomy shared by the errors of the domain packages,
+// so that the presentation layer can report them to clients without knowing every error type.
+package domainerr
+
+import "errors"
+
+// Kind is the category of a domain error.
+type Kind int
+
+const (
+	// KindUnknown is the kind of the errors outside the taxonomy, such as infrastructure failures.
+	KindUnknown Kind = iota
+	// KindNotFound is the kind of the errors of entities that do not exist.
+	KindNotFound
+	// KindAlreadyExists is the kind of the errors of entities that conflict with existing ones.
+	KindAlreadyExists
+	// KindInvalidArgument is the kind of the errors of invalid input.
+	KindInvalidArgument
+	// KindFailedPrecondition is the kind of the errors of operations not allowed in the current state.
+	KindFailedPrecondition
+	// KindDataLoss is the kind of the errors of stored data that is missing or corrupted.
+	KindDataLoss
+)
+
+// Error is a domain error with a stable reason.
+type Error interface {
+	error
+	// Kind returns the category of the error.
+	Kind() Kind
+	// Reason returns the reason code of the error in UPPER_SNAKE_CASE, such as TODO_NOT_FOUND.
+	// Clients rely on the reasons, so a published reason is never changed.
+	Reason() string
+}
+
+// MetadataError is an Error with structured context, such as the ID of the missing entity.
+type MetadataError interface {
+	Error
+	Metadata() map[string]string
+}
+
+// FieldViolation describes an invalid field of the input.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// FieldViolationError is an Error caused by invalid fields of the input.
+type FieldViolationError interface {
+	Error
+	FieldViolations() []FieldViolation
+}
+
+// PreconditionViolation describes a condition that the current state does not meet.
+type PreconditionViolation struct {
+	// Type is the type of the condition, such as TODO_STATUS.
+	Type string
+	// Subject is what the condition is about, such as the current status.
+	Subject     string
+	Description string
+}
+
+// PreconditionViolationError is an Error caused by the current state of an entity.
+type PreconditionViolationError interface {
+	Error
+	PreconditionViolations() []PreconditionViolation
+}
+
+// As returns the first domain Error in the chain of err.
+func As(err error) (Error, bool) {
+	var domainErr Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
+
+// KindOf returns the kind of the first domain Error in the chain of err, or KindUnknown.
+func KindOf(err error) Kind {
+	if domainErr, ok := As(err); ok {
+		return domainErr.Kind()
+	}
+	return KindUnknown
+}
@@ -0,0 +1,46 @@
+package externalref
+
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+)
+
+// ReasonInvalidArgument is the reason of ValidationError, reported to clients as a stable error code.
+// NotFoundError never reaches clients, as the transfers treat it as an item not imported yet.
+const ReasonInvalidArgument = "EXTERNAL_REF_INVALID_ARGUMENT"
+
+// NotFoundError represents an error when no item with the external ID has been imported
+type NotFoundError struct {
+	Source     Source
+	Kind       Kind
+	ExternalID string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("external ref not found: %s %s %s", e.Source, e.Kind, e.ExternalID)
+}
+
+// ValidationError represents a validation error
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return e.Message
+}
+
+func (e *ValidationError) Kind() domainerr.Kind { return domainerr.KindInvalidArgument }
+
+func (e *ValidationError) Reason() string { return ReasonInvalidArgument }
+
+func (e *ValidationError) FieldViolations() []domainerr.FieldViolation {
+	if e.Field == "" {
+		return nil
+	}
+	return []domainerr.FieldViolation{{Field: e.Field, Description: e.Message}}
+}
@@ -0,0 +1,147 @@
+// Package externalref provides the domain layer for the identifiers that items
+// imported from other tools had in them.
+package externalref
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// MaxExternalIDLength is the maximum number of characters in an external ID.
+const MaxExternalIDLength = 255
+
+// Source is the tool an item was imported from.
+type Source string
+
+const (
+	SourceTodoist Source = "todoist"
+	SourceTrello  Source = "trello"
+	SourceGitHub  Source = "github"
+)
+
+// Sources returns all supported sources.
+func Sources() []Source {
+	return []Source{SourceTodoist, SourceTrello, SourceGitHub}
+}
+
+// String returns the string representation of the Source.
+func (s Source) String() string {
+	return string(s)
+}
+
+// IsValid checks if the Source is supported.
+func (s Source) IsValid() bool {
+	for _, source := range Sources() {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+// NewSourceFromString creates a Source from a string.
+func NewSourceFromString(s string) (Source, error) {
+	source := Source(s)
+	if !source.IsValid() {
+		return "", &ValidationError{Field: "source", Message: fmt.Sprintf("unknown source %q", s)}
+	}
+	return source, nil
+}
+
+// Kind is the kind of oniongo entity an item was imported as.
+type Kind string
+
+const (
+	KindProject Kind = "PROJECT"
+	KindTag     Kind = "TAG"
+	KindTodo    Kind = "TODO"
+)
+
+// String returns the string representation of the Kind.
+func (k Kind) String() string {
+	return string(k)
+}
+
+// IsValid checks if the Kind is known.
+func (k Kind) IsValid() bool {
+	return k == KindProject || k == KindTag || k == KindTodo
+}
+
+// ExternalRef links an item of another tool to the entity it was imported as,
+// so that importing the same export again does not duplicate it.
+// It is keyed by the source, the kind and the external ID.
+type ExternalRef struct {
+	source     Source
+	kind       Kind
+	externalID string
+	localID    uuid.UUID
+	createdAt  time.Time
+}
+
+// NewExternalRef creates a new ExternalRef to the entity with localID, created at now.
+func NewExternalRef(now time.Time, source Source, kind Kind, externalID string, localID uuid.UUID) (*ExternalRef, error) {
+	if !source.IsValid() {
+		return nil, &ValidationError{Field: "source", Message: fmt.Sprintf("unknown source %q", source)}
+	}
+	if !kind.IsValid() {
+		return nil, &ValidationError{Field: "kind", Message: fmt.Sprintf("unknown kind %q", kind)}
+	}
+	if externalID == "" {
+		return nil, &ValidationError{Field: "external_id", Message: "external id is required"}
+	}
+	if utf8.RuneCountInString(externalID) > MaxExternalIDLength {
+		return nil, &ValidationError{Field: "external_id", Message: "external id is too long"}
+	}
+	return &ExternalRef{
+		source:     source,
+		kind:       kind,
+		externalID: externalID,
+		localID:    localID,
+		createdAt:  now,
+	}, nil
+}
+
+// Source returns the tool the item was imported from.
+func (r ExternalRef) Source() Source {
+	return r.source
+}
+
+// Kind returns the kind of entity the item was imported as.
+func (r ExternalRef) Kind() Kind {
+	return r.kind
+}
+
+// ExternalID returns the identifier of the item in its source.
+func (r ExternalRef) ExternalID() string {
+	return r.externalID
+}
+
+// LocalID returns the ID of the project, tag or todo the item was imported as.
+func (r ExternalRef) LocalID() uuid.UUID {
+	return r.localID
+}
+
+// CreatedAt returns when the item was imported.
+func (r ExternalRef) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// ReconstructExternalRef reconstructs an ExternalRef from the given values.
+func ReconstructExternalRef(
+	source Source,
+	kind Kind,
+	externalID string,
+	localID uuid.UUID,
+	createdAt time.Time,
+) *ExternalRef {
+	return &ExternalRef{
+		source:     source,
+		kind:       kind,
+		externalID: externalID,
+		localID:    localID,
+		createdAt:  createdAt,
+	}
+}
@@ -0,0 +1,13 @@
+package externalref
+
+import (
+	"context"
+)
+
+// ExternalRefRepository is the interface that wraps the operations for ExternalRef.
+// References are never updated; they outlive the deletion of the entities they refer to.
+type ExternalRefRepository interface {
+	Create(ctx context.Context, ref *ExternalRef) error
+	// Find returns the reference to the item, or a NotFoundError if it has never been imported.
+	Find(ctx context.Context, source Source, kind Kind, externalID string) (*ExternalRef, error)
+}
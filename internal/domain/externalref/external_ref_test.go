@@ -0,0 +1,64 @@
+package externalref
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExternalRef(t *testing.T) {
+	t.Run("creates a reference", func(t *testing.T) {
+		// Given
+		localID := uuid.New()
+		now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+
+		// When
+		ref, err := NewExternalRef(now, SourceTrello, KindTodo, "5f1a", localID)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, SourceTrello, ref.Source())
+		require.Equal(t, KindTodo, ref.Kind())
+		require.Equal(t, "5f1a", ref.ExternalID())
+		require.Equal(t, localID, ref.LocalID())
+		require.Equal(t, now, ref.CreatedAt())
+	})
+
+	tests := []struct {
+		name       string
+		source     Source
+		kind       Kind
+		externalID string
+		field      string
+	}{
+		{name: "unknown source", source: "asana", kind: KindTodo, externalID: "1", field: "source"},
+		{name: "unknown kind", source: SourceGitHub, kind: "BOARD", externalID: "1", field: "kind"},
+		{name: "empty external id", source: SourceGitHub, kind: KindTodo, externalID: "", field: "external_id"},
+		{name: "too long external id", source: SourceGitHub, kind: KindTodo, externalID: strings.Repeat("a", MaxExternalIDLength+1), field: "external_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			ref, err := NewExternalRef(time.Now(), tt.source, tt.kind, tt.externalID, uuid.New())
+
+			// Then
+			require.Nil(t, ref)
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			require.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestNewSourceFromString(t *testing.T) {
+	source, err := NewSourceFromString("todoist")
+	require.NoError(t, err)
+	require.Equal(t, SourceTodoist, source)
+
+	_, err = NewSourceFromString("asana")
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
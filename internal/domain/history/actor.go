@@ -0,0 +1,21 @@
+package history
+
+import "context"
+
+// AnonymousActor is the actor of mutations made without an identified actor.
+const AnonymousActor = "anonymous"
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx that carries the actor of the mutations made with it.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx, or AnonymousActor.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return AnonymousActor
+}
@@ -0,0 +1,56 @@
+package history
+
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// Reasons of the history errors, reported to clients as stable error codes
+const (
+	ReasonInvalidArgument = "HISTORY_INVALID_ARGUMENT"
+	ReasonVersionNotFound = "TODO_VERSION_NOT_FOUND"
+)
+
+// ValidationError represents a validation error
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return e.Message
+}
+
+func (e *ValidationError) Kind() domainerr.Kind { return domainerr.KindInvalidArgument }
+
+func (e *ValidationError) Reason() string { return ReasonInvalidArgument }
+
+func (e *ValidationError) FieldViolations() []domainerr.FieldViolation {
+	if e.Field == "" {
+		return nil
+	}
+	return []domainerr.FieldViolation{{Field: e.Field, Description: e.Message}}
+}
+
+// VersionNotFoundError represents an error when the history has no version of a todo at the requested point
+type VersionNotFoundError struct {
+	TodoID  todo.TodoID
+	Message string
+}
+
+func (e *VersionNotFoundError) Error() string {
+	return fmt.Sprintf("version of todo %s not found: %s", e.TodoID.String(), e.Message)
+}
+
+func (e *VersionNotFoundError) Kind() domainerr.Kind { return domainerr.KindNotFound }
+
+func (e *VersionNotFoundError) Reason() string { return ReasonVersionNotFound }
+
+func (e *VersionNotFoundError) Metadata() map[string]string {
+	return map[string]string{"todo_id": e.TodoID.String()}
+}
@@ -0,0 +1,233 @@
+// Package history provides the domain layer for the change history of todos.
+package history
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// Operation is the kind of mutation recorded by a HistoryEntry.
+type Operation string
+
+const (
+	OperationCreate Operation = "CREATE"
+	OperationUpdate Operation = "UPDATE"
+	OperationDelete Operation = "DELETE"
+)
+
+// String returns the string representation of the Operation.
+func (o Operation) String() string {
+	return string(o)
+}
+
+// Names of the recorded fields of a todo.
+const (
+	FieldTitle       = "title"
+	FieldBody        = "body"
+	FieldStatus      = "status"
+	FieldStatusID    = "status_id"
+	FieldParentID    = "parent_id"
+	FieldProjectID   = "project_id"
+	FieldTagIDs      = "tag_ids"
+	FieldBlockerIDs  = "blocker_ids"
+	FieldCompletedAt = "completed_at"
+)
+
+// FieldChange is the value of a field before and after a mutation.
+// An empty value means the field was not set. Lists of IDs are sorted and
+// separated by commas, and times are formatted in RFC 3339.
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// HistoryEntry is the immutable record of a mutation of a todo.
+type HistoryEntry struct {
+	id         HistoryEntryID
+	todoID     todo.TodoID
+	version    int
+	projectID  *project.ProjectID
+	actor      string
+	operation  Operation
+	changes    []FieldChange
+	occurredAt time.Time
+}
+
+// TodoCreated records the creation of a Todo with all of its fields,
+// as an entry with an ID from ids that occurred at now.
+func TodoCreated(ids idgen.IDGenerator, now time.Time, actor string, created *todo.Todo) *HistoryEntry {
+	return newHistoryEntry(ids, now, created.ID(), created.ProjectID(), actor, OperationCreate, diff(nil, created))
+}
+
+// TodoUpdated records the fields that differ between two states of a Todo,
+// as an entry with an ID from ids that occurred at now. It returns nil when nothing changed.
+func TodoUpdated(ids idgen.IDGenerator, now time.Time, actor string, before *todo.Todo, after *todo.Todo) *HistoryEntry {
+	changes := diff(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+	return newHistoryEntry(ids, now, after.ID(), after.ProjectID(), actor, OperationUpdate, changes)
+}
+
+// TodoDeleted records the deletion of a Todo with the fields it had,
+// as an entry with an ID from ids that occurred at now.
+func TodoDeleted(ids idgen.IDGenerator, now time.Time, actor string, deleted *todo.Todo) *HistoryEntry {
+	return newHistoryEntry(ids, now, deleted.ID(), deleted.ProjectID(), actor, OperationDelete, diff(deleted, nil))
+}
+
+func newHistoryEntry(
+	ids idgen.IDGenerator,
+	now time.Time,
+	todoID todo.TodoID,
+	projectID *project.ProjectID,
+	actor string,
+	operation Operation,
+	changes []FieldChange,
+) *HistoryEntry {
+	return &HistoryEntry{
+		id:         HistoryEntryID(ids.NewID()),
+		todoID:     todoID,
+		projectID:  projectID,
+		actor:      actor,
+		operation:  operation,
+		changes:    changes,
+		occurredAt: now,
+	}
+}
+
+// ID returns the ID of the HistoryEntry.
+func (e HistoryEntry) ID() HistoryEntryID {
+	return e.id
+}
+
+// TodoID returns the ID of the Todo that was mutated.
+func (e HistoryEntry) TodoID() todo.TodoID {
+	return e.todoID
+}
+
+// Version returns the version of the Todo the entry created, counting from 1.
+// It is assigned when the entry is saved and is 0 until then.
+func (e HistoryEntry) Version() int {
+	return e.version
+}
+
+// ProjectID returns the ID of the Project the Todo belonged to, or nil.
+func (e HistoryEntry) ProjectID() *project.ProjectID {
+	return e.projectID
+}
+
+// Actor returns who made the mutation.
+func (e HistoryEntry) Actor() string {
+	return e.actor
+}
+
+// Operation returns the kind of the mutation.
+func (e HistoryEntry) Operation() Operation {
+	return e.operation
+}
+
+// Changes returns the changed fields in a fixed order.
+func (e HistoryEntry) Changes() []FieldChange {
+	return e.changes
+}
+
+// OccurredAt returns when the mutation was made.
+func (e HistoryEntry) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// ReconstructHistoryEntry reconstructs a HistoryEntry from the given values.
+func ReconstructHistoryEntry(
+	id uuid.UUID,
+	todoID todo.TodoID,
+	version int,
+	projectID *project.ProjectID,
+	actor string,
+	operation Operation,
+	changes []FieldChange,
+	occurredAt time.Time,
+) *HistoryEntry {
+	return &HistoryEntry{
+		id:         HistoryEntryID(id),
+		todoID:     todoID,
+		version:    version,
+		projectID:  projectID,
+		actor:      actor,
+		operation:  operation,
+		changes:    changes,
+		occurredAt: occurredAt,
+	}
+}
+
+// diff compares the recorded fields of two states of a Todo. A nil state has no fields.
+func diff(before *todo.Todo, after *todo.Todo) []FieldChange {
+	beforeFields := snapshot(before)
+	afterFields := snapshot(after)
+
+	var changes []FieldChange
+	for i, field := range recordedFields {
+		if beforeFields[i] != afterFields[i] {
+			changes = append(changes, FieldChange{Field: field, Before: beforeFields[i], After: afterFields[i]})
+		}
+	}
+	return changes
+}
+
+// recordedFields are the fields of a todo in the order of snapshot.
+var recordedFields = []string{
+	FieldTitle,
+	FieldBody,
+	FieldStatus,
+	FieldStatusID,
+	FieldParentID,
+	FieldProjectID,
+	FieldTagIDs,
+	FieldBlockerIDs,
+	FieldCompletedAt,
+}
+
+// snapshot returns the values of the recorded fields of a Todo.
+func snapshot(t *todo.Todo) []string {
+	values := make([]string, len(recordedFields))
+	if t == nil {
+		return values
+	}
+
+	values[0] = t.Title()
+	values[1] = t.Body()
+	values[2] = t.Status().String()
+	if t.ParentID() != nil {
+		values[4] = t.ParentID().String()
+	}
+	// Only the todos of a project have a workflow status.
+	if t.ProjectID() != nil {
+		values[3] = t.StatusID().String()
+		values[5] = t.ProjectID().String()
+	}
+	tagIDs := make([]string, len(t.TagIDs()))
+	for i, id := range t.TagIDs() {
+		tagIDs[i] = id.String()
+	}
+	values[6] = joinSorted(tagIDs)
+	blockerIDs := make([]string, len(t.BlockerIDs()))
+	for i, id := range t.BlockerIDs() {
+		blockerIDs[i] = id.String()
+	}
+	values[7] = joinSorted(blockerIDs)
+	if t.CompletedAt() != nil {
+		values[8] = t.CompletedAt().UTC().Format(time.RFC3339)
+	}
+	return values
+}
+
+func joinSorted(values []string) string {
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,36 @@
+package history
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+// HistoryEntryID is the identifier for a HistoryEntry.
+type HistoryEntryID uuid.UUID
+
+// NewHistoryEntryID creates a new HistoryEntryID with the default IDGenerator.
+// TodoCreated, TodoUpdated and TodoDeleted take the IDs of new HistoryEntries from the IDGenerator they are given instead.
+func NewHistoryEntryID() HistoryEntryID {
+	return HistoryEntryID(idgen.UUIDv7().NewID())
+}
+
+// String returns the string representation of the HistoryEntryID.
+func (id HistoryEntryID) String() string {
+	return id.UUID().String()
+}
+
+// UUID returns the UUID representation of the HistoryEntryID.
+func (id HistoryEntryID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// NewHistoryEntryIDFromString creates a new HistoryEntryID from a string.
+func NewHistoryEntryIDFromString(s string) (HistoryEntryID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return HistoryEntryID{}, fmt.Errorf("failed to parse uuid %s: %w", s, err)
+	}
+	return HistoryEntryID(id), nil
+}
@@ -0,0 +1,58 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHistoryEntryID(t *testing.T) {
+	// When
+	id1 := NewHistoryEntryID()
+	id2 := NewHistoryEntryID()
+
+	// Then
+	require.NotEqual(t, HistoryEntryID{}, id1)
+	require.NotEqual(t, id1, id2)
+	require.NotEmpty(t, id1.String())
+}
+
+func TestNewHistoryEntryIDFromString(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectError bool
+	}{
+		{
+			name:        "valid uuid string",
+			input:       "550e8400-e29b-41d4-a716-446655440000",
+			expectError: false,
+		},
+		{
+			name:        "invalid uuid string",
+			input:       "invalid-uuid",
+			expectError: true,
+		},
+		{
+			name:        "empty string",
+			input:       "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			result, err := NewHistoryEntryIDFromString(tt.input)
+
+			// Then
+			if tt.expectError {
+				require.Error(t, err)
+				require.Equal(t, HistoryEntryID{}, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.input, result.String())
+			}
+		})
+	}
+}
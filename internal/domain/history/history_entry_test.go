@@ -0,0 +1,120 @@
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoCreated(t *testing.T) {
+	// Given
+	created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Write report", "")
+	require.NoError(t, err)
+	ids := idgen.NewSequence()
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// When
+	entry := TodoCreated(ids, now, "alice", created)
+
+	// Then
+	require.Equal(t, HistoryEntryID(idgen.NewSequence().NewID()), entry.ID())
+	require.Equal(t, now, entry.OccurredAt())
+	require.Equal(t, created.ID(), entry.TodoID())
+	require.Nil(t, entry.ProjectID())
+	require.Equal(t, "alice", entry.Actor())
+	require.Equal(t, OperationCreate, entry.Operation())
+	require.Equal(t, []FieldChange{
+		{Field: FieldTitle, After: "Write report"},
+		{Field: FieldStatus, After: created.Status().String()},
+	}, entry.Changes())
+}
+
+func TestTodoUpdated(t *testing.T) {
+	t.Run("records the changed fields", func(t *testing.T) {
+		// Given
+		before, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Write report", "draft")
+		require.NoError(t, err)
+		after := *before
+		require.NoError(t, after.SetTitle(time.Now(), "Write the annual report"))
+		tagID := tag.NewTagID()
+		require.NoError(t, after.AddTag(time.Now(), tagID))
+		require.NoError(t, after.Start(time.Now()))
+
+		// When
+		entry := TodoUpdated(idgen.UUIDv7(), time.Now(), "bob", before, &after)
+
+		// Then
+		require.NotNil(t, entry)
+		require.Equal(t, OperationUpdate, entry.Operation())
+		require.Equal(t, []FieldChange{
+			{Field: FieldTitle, Before: "Write report", After: "Write the annual report"},
+			{Field: FieldStatus, Before: before.Status().String(), After: after.Status().String()},
+			{Field: FieldTagIDs, After: tagID.String()},
+		}, entry.Changes())
+	})
+
+	t.Run("returns nil when nothing changed", func(t *testing.T) {
+		// Given
+		before, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Write report", "draft")
+		require.NoError(t, err)
+		after := *before
+
+		// When
+		entry := TodoUpdated(idgen.UUIDv7(), time.Now(), "bob", before, &after)
+
+		// Then
+		require.Nil(t, entry)
+	})
+}
+
+func TestTodoDeleted(t *testing.T) {
+	// Given
+	deleted, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Write report", "draft")
+	require.NoError(t, err)
+
+	// When
+	entry := TodoDeleted(idgen.UUIDv7(), time.Now(), "carol", deleted)
+
+	// Then
+	require.Equal(t, OperationDelete, entry.Operation())
+	require.Equal(t, []FieldChange{
+		{Field: FieldTitle, Before: "Write report"},
+		{Field: FieldBody, Before: "draft"},
+		{Field: FieldStatus, Before: deleted.Status().String()},
+	}, entry.Changes())
+}
+
+func TestReconstructHistoryEntry(t *testing.T) {
+	// Given
+	id := uuid.New()
+	todoID := todo.NewTodoID()
+	projectID := project.NewProjectID()
+	occurredAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	changes := []FieldChange{{Field: FieldTitle, Before: "a", After: "b"}}
+
+	// When
+	entry := ReconstructHistoryEntry(id, todoID, 3, &projectID, "alice", OperationUpdate, changes, occurredAt)
+
+	// Then
+	require.Equal(t, HistoryEntryID(id), entry.ID())
+	require.Equal(t, todoID, entry.TodoID())
+	require.Equal(t, 3, entry.Version())
+	require.Equal(t, &projectID, entry.ProjectID())
+	require.Equal(t, "alice", entry.Actor())
+	require.Equal(t, OperationUpdate, entry.Operation())
+	require.Equal(t, changes, entry.Changes())
+	require.Equal(t, occurredAt, entry.OccurredAt())
+}
+
+func TestActorFromContext(t *testing.T) {
+	require.Equal(t, AnonymousActor, ActorFromContext(context.Background()))
+	require.Equal(t, AnonymousActor, ActorFromContext(WithActor(context.Background(), "")))
+	require.Equal(t, "alice", ActorFromContext(WithActor(context.Background(), "alice")))
+}
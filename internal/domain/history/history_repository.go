@@ -0,0 +1,29 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// HistoryRepository reads the history entries of todos.
+// Entries are written by the TodoRepository in the transaction of the mutation they record,
+// and are never updated or deleted.
+type HistoryRepository interface {
+	// FindByTodoID returns the history of the todo, oldest first.
+	// The history of a deleted todo is kept.
+	FindByTodoID(ctx context.Context, todoID todo.TodoID, limit int, offset int) ([]*HistoryEntry, error)
+	// FindByTodoIDUntil returns the history of the todo recorded at or before until, oldest first.
+	FindByTodoIDUntil(ctx context.Context, todoID todo.TodoID, until time.Time) ([]*HistoryEntry, error)
+	// FindByProjectID returns the history of the todos of the project, newest first.
+	FindByProjectID(ctx context.Context, projectID project.ProjectID, limit int, offset int) ([]*HistoryEntry, error)
+}
+
+// EntryListener is told about every entry the TodoRepository records, in the transaction of
+// the mutation the entry records, so that what it writes is committed or rolled back with it.
+type EntryListener interface {
+	// EntryRecorded is called with the saved entry, which carries its version.
+	EntryRecorded(ctx context.Context, entry *HistoryEntry) error
+}
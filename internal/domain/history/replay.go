@@ -0,0 +1,105 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// ReplayTodo rebuilds the Todo with the given ID as it was after the last of the entries,
+// which must be its history from the first entry on, oldest first.
+// It returns a VersionNotFoundError when the entries are empty, do not start with the creation
+// of the Todo, as is the case for todos created before the history was recorded, or end with its deletion.
+// The rebuilt Todo carries no project workflow, so it can be read but not transitioned.
+func ReplayTodo(todoID todo.TodoID, entries []*HistoryEntry) (*todo.Todo, error) {
+	if len(entries) == 0 {
+		return nil, &VersionNotFoundError{TodoID: todoID, Message: "no version was recorded"}
+	}
+	if entries[0].Operation() != OperationCreate {
+		return nil, &VersionNotFoundError{TodoID: todoID, Message: "history does not start with the creation of the todo"}
+	}
+	last := entries[len(entries)-1]
+	if last.Operation() == OperationDelete {
+		return nil, &VersionNotFoundError{TodoID: todoID, Message: "todo was deleted"}
+	}
+
+	values := make(map[string]string, len(recordedFields))
+	for _, entry := range entries {
+		for _, change := range entry.Changes() {
+			values[change.Field] = change.After
+		}
+	}
+
+	status, err := todo.NewTodoStatusFromString(values[FieldStatus])
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay status: %w", err)
+	}
+	var completedAt *time.Time
+	if v := values[FieldCompletedAt]; v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay completed at: %w", err)
+		}
+		completedAt = &t
+	}
+	var parentID *todo.TodoID
+	if v := values[FieldParentID]; v != "" {
+		id, err := todo.NewTodoIDFromString(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay parent ID: %w", err)
+		}
+		parentID = &id
+	}
+	var projectID *project.ProjectID
+	if v := values[FieldProjectID]; v != "" {
+		id, err := project.NewProjectIDFromString(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay project ID: %w", err)
+		}
+		projectID = &id
+	}
+	var tagIDs []tag.TagID
+	for _, v := range splitIDs(values[FieldTagIDs]) {
+		id, err := tag.NewTagIDFromString(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay tag IDs: %w", err)
+		}
+		tagIDs = append(tagIDs, id)
+	}
+	var blockerIDs []todo.TodoID
+	for _, v := range splitIDs(values[FieldBlockerIDs]) {
+		id, err := todo.NewTodoIDFromString(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay blocker IDs: %w", err)
+		}
+		blockerIDs = append(blockerIDs, id)
+	}
+
+	return todo.ReconstructTodoWithStatus(
+		todoID.UUID(),
+		values[FieldTitle],
+		values[FieldBody],
+		status,
+		entries[0].OccurredAt(),
+		last.OccurredAt(),
+		completedAt,
+		tagIDs,
+		parentID,
+		blockerIDs,
+		projectID,
+		nil,
+		project.StatusID(values[FieldStatusID]),
+	), nil
+}
+
+// splitIDs splits a list of IDs joined by joinSorted.
+func splitIDs(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
@@ -0,0 +1,90 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayTodo(t *testing.T) {
+	t.Run("rebuilds every version of the todo", func(t *testing.T) {
+		// Given
+		created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Write report", "draft")
+		require.NoError(t, err)
+		renamed := *created
+		require.NoError(t, renamed.SetTitle(time.Now(), "Write the annual report"))
+		tagID := tag.NewTagID()
+		require.NoError(t, renamed.AddTag(time.Now(), tagID))
+		started := renamed
+		require.NoError(t, started.Start(time.Now()))
+		entries := []*HistoryEntry{
+			TodoCreated(idgen.UUIDv7(), time.Now(), "alice", created),
+			TodoUpdated(idgen.UUIDv7(), time.Now(), "bob", created, &renamed),
+			TodoUpdated(idgen.UUIDv7(), time.Now(), "bob", &renamed, &started),
+		}
+
+		// When
+		first, err := ReplayTodo(created.ID(), entries[:1])
+		require.NoError(t, err)
+		last, err := ReplayTodo(created.ID(), entries)
+		require.NoError(t, err)
+
+		// Then
+		require.Equal(t, created.ID(), first.ID())
+		require.Equal(t, "Write report", first.Title())
+		require.Equal(t, "draft", first.Body())
+		require.Equal(t, todo.TodoStatusNotStarted, first.Status())
+		require.Empty(t, first.TagIDs())
+		require.Equal(t, "Write the annual report", last.Title())
+		require.Equal(t, "draft", last.Body())
+		require.Equal(t, todo.TodoStatusInProgress, last.Status())
+		require.Equal(t, []tag.TagID{tagID}, last.TagIDs())
+		require.Equal(t, entries[0].OccurredAt(), last.CreatedAt())
+		require.Equal(t, entries[2].OccurredAt(), last.UpdatedAt())
+	})
+
+	t.Run("returns an error when the history is empty", func(t *testing.T) {
+		// When
+		_, err := ReplayTodo(todo.NewTodoID(), nil)
+
+		// Then
+		var versionNotFoundErr *VersionNotFoundError
+		require.ErrorAs(t, err, &versionNotFoundErr)
+	})
+
+	t.Run("returns an error when the history does not start with the creation", func(t *testing.T) {
+		// Given
+		before, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Write report", "")
+		require.NoError(t, err)
+		after := *before
+		require.NoError(t, after.SetTitle(time.Now(), "Write the annual report"))
+
+		// When
+		_, err = ReplayTodo(before.ID(), []*HistoryEntry{TodoUpdated(idgen.UUIDv7(), time.Now(), "bob", before, &after)})
+
+		// Then
+		var versionNotFoundErr *VersionNotFoundError
+		require.ErrorAs(t, err, &versionNotFoundErr)
+	})
+
+	t.Run("returns an error when the todo was deleted", func(t *testing.T) {
+		// Given
+		created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Write report", "")
+		require.NoError(t, err)
+		entries := []*HistoryEntry{
+			TodoCreated(idgen.UUIDv7(), time.Now(), "alice", created),
+			TodoDeleted(idgen.UUIDv7(), time.Now(), "carol", created),
+		}
+
+		// When
+		_, err = ReplayTodo(created.ID(), entries)
+
+		// Then
+		var versionNotFoundErr *VersionNotFoundError
+		require.ErrorAs(t, err, &versionNotFoundErr)
+	})
+}
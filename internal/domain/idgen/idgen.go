@@ -0,0 +1,52 @@
+// Package idgen generates the identifiers of the domain entities,
+// so that tests can predict them instead of reading random ones.
+package idgen
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// IDGenerator generates unique identifiers.
+type IDGenerator interface {
+	NewID() uuid.UUID
+}
+
+// UUIDv7 returns the IDGenerator of time-ordered UUIDv7s.
+func UUIDv7() IDGenerator {
+	return uuidV7Generator{}
+}
+
+type uuidV7Generator struct{}
+
+func (uuidV7Generator) NewID() uuid.UUID {
+	id, _ := uuid.NewV7()
+	return id
+}
+
+// Sequence is an IDGenerator of predictable UUIDs numbered from 1,
+// such as 00000000-0000-7000-8000-000000000001. It is safe for concurrent use.
+type Sequence struct {
+	mu   sync.Mutex
+	next uint64
+}
+
+// NewSequence creates a Sequence that starts at 1.
+func NewSequence() *Sequence {
+	return &Sequence{next: 1}
+}
+
+// NewID returns the next UUID of the Sequence.
+func (s *Sequence) NewID() uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var id uuid.UUID
+	binary.BigEndian.PutUint64(id[8:], s.next)
+	// Version 7 and the RFC 9562 variant keep the IDs valid UUIDs
+	id[6] = 0x70
+	id[8] = id[8]&0x3f | 0x80
+	s.next++
+	return id
+}
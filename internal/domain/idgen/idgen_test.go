@@ -0,0 +1,33 @@
+package idgen
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDv7(t *testing.T) {
+	// When
+	first := UUIDv7().NewID()
+	second := UUIDv7().NewID()
+
+	// Then
+	require.Equal(t, uuid.Version(7), first.Version())
+	require.NotEqual(t, first, second)
+}
+
+func TestSequence(t *testing.T) {
+	// Given
+	sequence := NewSequence()
+
+	// When
+	first := sequence.NewID()
+	second := sequence.NewID()
+
+	// Then
+	require.Equal(t, "00000000-0000-7000-8000-000000000001", first.String())
+	require.Equal(t, "00000000-0000-7000-8000-000000000002", second.String())
+	require.Equal(t, uuid.Version(7), first.Version())
+	require.Equal(t, uuid.RFC4122, first.Variant())
+}
@@ -0,0 +1,54 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+)
+
+// Reasons of the project errors, reported to clients as stable error codes
+const (
+	ReasonNotFound        = "PROJECT_NOT_FOUND"
+	ReasonInvalidArgument = "PROJECT_INVALID_ARGUMENT"
+)
+
+// NotFoundError represents an error when a project is not found
+type NotFoundError struct {
+	ID ProjectID
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("project not found: %s", e.ID.String())
+}
+
+func (e *NotFoundError) Kind() domainerr.Kind { return domainerr.KindNotFound }
+
+func (e *NotFoundError) Reason() string { return ReasonNotFound }
+
+func (e *NotFoundError) Metadata() map[string]string {
+	return map[string]string{"project_id": e.ID.String()}
+}
+
+// ValidationError represents a validation error
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return e.Message
+}
+
+func (e *ValidationError) Kind() domainerr.Kind { return domainerr.KindInvalidArgument }
+
+func (e *ValidationError) Reason() string { return ReasonInvalidArgument }
+
+func (e *ValidationError) FieldViolations() []domainerr.FieldViolation {
+	if e.Field == "" {
+		return nil
+	}
+	return []domainerr.FieldViolation{{Field: e.Field, Description: e.Message}}
+}
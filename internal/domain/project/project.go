@@ -0,0 +1,92 @@
+// Package project provides the domain layer for projects and their workflows.
+package project
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+// MaxNameLength is the maximum number of characters in a project or status name.
+const MaxNameLength = 50
+
+// Project is the entity that groups todos that share a workflow.
+type Project struct {
+	id        ProjectID
+	name      string
+	workflow  *Workflow
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewProject creates a new Project with an ID from ids, created at now.
+// A nil workflow falls back to DefaultWorkflow.
+func NewProject(ids idgen.IDGenerator, now time.Time, name string, workflow *Workflow) (*Project, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	if workflow == nil {
+		workflow = DefaultWorkflow()
+	}
+	return &Project{
+		id:        ProjectID(ids.NewID()),
+		name:      name,
+		workflow:  workflow,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ID returns the ID of the Project.
+func (p Project) ID() ProjectID {
+	return p.id
+}
+
+// Name returns the name of the Project.
+func (p Project) Name() string {
+	return p.name
+}
+
+// Workflow returns the workflow todos in the Project follow.
+func (p Project) Workflow() *Workflow {
+	return p.workflow
+}
+
+// CreatedAt returns the created at of the Project.
+func (p Project) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// UpdatedAt returns the updated at of the Project.
+func (p Project) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// ReconstructProject reconstructs a Project from the given values.
+func ReconstructProject(
+	id uuid.UUID,
+	name string,
+	workflow *Workflow,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Project {
+	return &Project{
+		id:        ProjectID(id),
+		name:      name,
+		workflow:  workflow,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return &ValidationError{Field: "name", Message: "name is too long"}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+// ProjectID is the identifier for a Project.
+type ProjectID uuid.UUID
+
+// NewProjectID creates a new ProjectID with the default IDGenerator.
+// NewProject takes the IDs of new Projects from the IDGenerator it is given instead.
+func NewProjectID() ProjectID {
+	return ProjectID(idgen.UUIDv7().NewID())
+}
+
+// String returns the string representation of the ProjectID.
+func (id ProjectID) String() string {
+	return id.UUID().String()
+}
+
+// UUID returns the UUID representation of the ProjectID.
+func (id ProjectID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// NewProjectIDFromString creates a new ProjectID from a string.
+func NewProjectIDFromString(s string) (ProjectID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return ProjectID{}, fmt.Errorf("failed to parse uuid %s: %w", s, err)
+	}
+	return ProjectID(id), nil
+}
@@ -0,0 +1,58 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProjectID(t *testing.T) {
+	// When
+	id1 := NewProjectID()
+	id2 := NewProjectID()
+
+	// Then
+	require.NotEqual(t, ProjectID{}, id1)
+	require.NotEqual(t, id1, id2)
+	require.NotEmpty(t, id1.String())
+}
+
+func TestNewProjectIDFromString(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectError bool
+	}{
+		{
+			name:        "valid uuid string",
+			input:       "550e8400-e29b-41d4-a716-446655440000",
+			expectError: false,
+		},
+		{
+			name:        "invalid uuid string",
+			input:       "invalid-uuid",
+			expectError: true,
+		},
+		{
+			name:        "empty string",
+			input:       "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			result, err := NewProjectIDFromString(tt.input)
+
+			// Then
+			if tt.expectError {
+				require.Error(t, err)
+				require.Equal(t, ProjectID{}, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.input, result.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package project
+
+import (
+	"context"
+)
+
+// ProjectRepository is the interface that wraps the basic CRUD operations for Project.
+type ProjectRepository interface {
+	Create(ctx context.Context, project *Project) error
+	FindAll(ctx context.Context) ([]*Project, error)
+	FindByID(ctx context.Context, id ProjectID) (*Project, error)
+	Delete(ctx context.Context, id ProjectID) error
+}
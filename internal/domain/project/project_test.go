@@ -0,0 +1,55 @@
+package project
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProject(t *testing.T) {
+	t.Run("creates a project with the given workflow", func(t *testing.T) {
+		// Given
+		workflow, err := NewWorkflow(newKanbanStatuses(), nil)
+		require.NoError(t, err)
+		now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+
+		// When
+		project, err := NewProject(idgen.NewSequence(), now, "Platform", workflow)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, "Platform", project.Name())
+		require.Equal(t, workflow, project.Workflow())
+		require.Equal(t, "00000000-0000-7000-8000-000000000001", project.ID().String())
+		require.Equal(t, now, project.CreatedAt())
+		require.Equal(t, now, project.UpdatedAt())
+	})
+
+	t.Run("falls back to the default workflow", func(t *testing.T) {
+		// When
+		project, err := NewProject(idgen.UUIDv7(), time.Now(), "Platform", nil)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, DefaultWorkflow(), project.Workflow())
+	})
+
+	t.Run("returns validation error for an empty name", func(t *testing.T) {
+		// When
+		_, err := NewProject(idgen.UUIDv7(), time.Now(), "", nil)
+
+		// Then
+		require.EqualError(t, err, "name: name is required")
+	})
+
+	t.Run("returns validation error for a too long name", func(t *testing.T) {
+		// When
+		_, err := NewProject(idgen.UUIDv7(), time.Now(), strings.Repeat("a", MaxNameLength+1), nil)
+
+		// Then
+		require.EqualError(t, err, "name: name is too long")
+	})
+}
@@ -0,0 +1,209 @@
+package project
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+)
+
+// MaxStatuses is the maximum number of statuses in a workflow.
+const MaxStatuses = 20
+
+// statusIDPattern matches a status ID such as "in_review".
+var statusIDPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// StatusCategory groups workflow statuses by how far the work has gone.
+type StatusCategory int
+
+const (
+	// StatusCategoryTodo represents statuses where work has not started yet.
+	StatusCategoryTodo StatusCategory = iota
+	// StatusCategoryDoing represents statuses where work is ongoing.
+	StatusCategoryDoing
+	// StatusCategoryDone represents statuses where work is finished.
+	StatusCategoryDone
+)
+
+// categoryStrings maps StatusCategory values to their string representations.
+var categoryStrings = map[StatusCategory]string{
+	StatusCategoryTodo:  "TODO",
+	StatusCategoryDoing: "DOING",
+	StatusCategoryDone:  "DONE",
+}
+
+// String returns the string representation of the StatusCategory.
+func (c StatusCategory) String() string {
+	if str, ok := categoryStrings[c]; ok {
+		return str
+	}
+	return fmt.Sprintf("StatusCategory(%d)", int(c))
+}
+
+// IsValid checks if the StatusCategory is valid.
+func (c StatusCategory) IsValid() bool {
+	_, ok := categoryStrings[c]
+	return ok
+}
+
+// NewStatusCategoryFromString creates a StatusCategory from a string.
+func NewStatusCategoryFromString(s string) (StatusCategory, error) {
+	for c, str := range categoryStrings {
+		if str == s {
+			return c, nil
+		}
+	}
+	return StatusCategoryTodo, fmt.Errorf("invalid status category: %s", s)
+}
+
+// StatusID identifies a status within a workflow, e.g. "in_review".
+type StatusID string
+
+// String returns the string representation of the StatusID.
+func (id StatusID) String() string {
+	return string(id)
+}
+
+// WorkflowStatus is a single status, or board column, of a workflow.
+type WorkflowStatus struct {
+	ID       StatusID
+	Name     string
+	Category StatusCategory
+}
+
+// Transition is an allowed move from one status of a workflow to another.
+type Transition struct {
+	From StatusID
+	To   StatusID
+}
+
+// Workflow is the set of statuses todos in a project move through
+// together with the transitions allowed between them.
+// The first status is the one new todos start in.
+type Workflow struct {
+	statuses    []WorkflowStatus
+	transitions []Transition
+}
+
+// NewWorkflow creates a new Workflow.
+// The first status must be in the todo category and at least one status must be in the done category.
+func NewWorkflow(statuses []WorkflowStatus, transitions []Transition) (*Workflow, error) {
+	if len(statuses) == 0 {
+		return nil, &ValidationError{Field: "statuses", Message: "at least one status is required"}
+	}
+	if len(statuses) > MaxStatuses {
+		return nil, &ValidationError{Field: "statuses", Message: "too many statuses"}
+	}
+	seen := make(map[StatusID]bool, len(statuses))
+	for _, s := range statuses {
+		if !statusIDPattern.MatchString(string(s.ID)) {
+			return nil, &ValidationError{Field: "statuses", Message: fmt.Sprintf("status id %q must be lower snake case", s.ID)}
+		}
+		if seen[s.ID] {
+			return nil, &ValidationError{Field: "statuses", Message: fmt.Sprintf("status id %q is duplicated", s.ID)}
+		}
+		seen[s.ID] = true
+		if s.Name == "" || utf8.RuneCountInString(s.Name) > MaxNameLength {
+			return nil, &ValidationError{Field: "statuses", Message: fmt.Sprintf("status %q must have a name of 1 to %d characters", s.ID, MaxNameLength)}
+		}
+		if !s.Category.IsValid() {
+			return nil, &ValidationError{Field: "statuses", Message: fmt.Sprintf("status %q has an invalid category", s.ID)}
+		}
+	}
+	if statuses[0].Category != StatusCategoryTodo {
+		return nil, &ValidationError{Field: "statuses", Message: "the first status must be in the todo category"}
+	}
+	if !slices.ContainsFunc(statuses, func(s WorkflowStatus) bool { return s.Category == StatusCategoryDone }) {
+		return nil, &ValidationError{Field: "statuses", Message: "at least one status must be in the done category"}
+	}
+
+	for i, tr := range transitions {
+		if !seen[tr.From] || !seen[tr.To] {
+			return nil, &ValidationError{Field: "transitions", Message: fmt.Sprintf("transition %s -> %s refers to an unknown status", tr.From, tr.To)}
+		}
+		if tr.From == tr.To {
+			return nil, &ValidationError{Field: "transitions", Message: fmt.Sprintf("transition %s -> %s does not change the status", tr.From, tr.To)}
+		}
+		if slices.Contains(transitions[:i], tr) {
+			return nil, &ValidationError{Field: "transitions", Message: fmt.Sprintf("transition %s -> %s is duplicated", tr.From, tr.To)}
+		}
+	}
+
+	return ReconstructWorkflow(statuses, transitions), nil
+}
+
+// DefaultWorkflow returns the workflow given to projects created without one:
+// "To Do", "Doing" and "Done", where any status can move to any other.
+func DefaultWorkflow() *Workflow {
+	statuses := []WorkflowStatus{
+		{ID: "todo", Name: "To Do", Category: StatusCategoryTodo},
+		{ID: "doing", Name: "Doing", Category: StatusCategoryDoing},
+		{ID: "done", Name: "Done", Category: StatusCategoryDone},
+	}
+	var transitions []Transition
+	for _, from := range statuses {
+		for _, to := range statuses {
+			if from.ID != to.ID {
+				transitions = append(transitions, Transition{From: from.ID, To: to.ID})
+			}
+		}
+	}
+	return ReconstructWorkflow(statuses, transitions)
+}
+
+// Statuses returns the statuses of the Workflow in order.
+func (w Workflow) Statuses() []WorkflowStatus {
+	return slices.Clone(w.statuses)
+}
+
+// Transitions returns the allowed transitions of the Workflow.
+func (w Workflow) Transitions() []Transition {
+	return slices.Clone(w.transitions)
+}
+
+// InitialStatus returns the status new todos start in.
+func (w Workflow) InitialStatus() WorkflowStatus {
+	return w.statuses[0]
+}
+
+// Status returns the status with the given ID.
+func (w Workflow) Status(id StatusID) (WorkflowStatus, bool) {
+	i := slices.IndexFunc(w.statuses, func(s WorkflowStatus) bool { return s.ID == id })
+	if i < 0 {
+		return WorkflowStatus{}, false
+	}
+	return w.statuses[i], true
+}
+
+// CanTransition checks if the Workflow allows moving from one status to another.
+func (w Workflow) CanTransition(from, to StatusID) bool {
+	return slices.Contains(w.transitions, Transition{From: from, To: to})
+}
+
+// NextStatusInCategory returns the first status in the given category,
+// in workflow order, that the Workflow allows moving to from the given status.
+func (w Workflow) NextStatusInCategory(from StatusID, category StatusCategory) (WorkflowStatus, bool) {
+	for _, s := range w.statuses {
+		if s.Category == category && w.CanTransition(from, s.ID) {
+			return s, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+// FirstStatusInCategory returns the first status in the given category in workflow order.
+func (w Workflow) FirstStatusInCategory(category StatusCategory) (WorkflowStatus, bool) {
+	i := slices.IndexFunc(w.statuses, func(s WorkflowStatus) bool { return s.Category == category })
+	if i < 0 {
+		return WorkflowStatus{}, false
+	}
+	return w.statuses[i], true
+}
+
+// ReconstructWorkflow reconstructs a Workflow from the given values.
+func ReconstructWorkflow(statuses []WorkflowStatus, transitions []Transition) *Workflow {
+	return &Workflow{
+		statuses:    slices.Clone(statuses),
+		transitions: slices.Clone(transitions),
+	}
+}
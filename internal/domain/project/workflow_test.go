@@ -0,0 +1,186 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newKanbanStatuses() []WorkflowStatus {
+	return []WorkflowStatus{
+		{ID: "backlog", Name: "Backlog", Category: StatusCategoryTodo},
+		{ID: "ready", Name: "Ready", Category: StatusCategoryTodo},
+		{ID: "review", Name: "Review", Category: StatusCategoryDoing},
+		{ID: "done", Name: "Done", Category: StatusCategoryDone},
+	}
+}
+
+func TestNewWorkflow(t *testing.T) {
+	tests := []struct {
+		name        string
+		statuses    []WorkflowStatus
+		transitions []Transition
+		errorMsg    string
+	}{
+		{
+			name:        "valid workflow",
+			statuses:    newKanbanStatuses(),
+			transitions: []Transition{{From: "backlog", To: "ready"}, {From: "ready", To: "review"}, {From: "review", To: "done"}},
+		},
+		{
+			name:     "no statuses",
+			statuses: nil,
+			errorMsg: "statuses: at least one status is required",
+		},
+		{
+			name: "invalid status id",
+			statuses: []WorkflowStatus{
+				{ID: "In Review", Name: "In Review", Category: StatusCategoryTodo},
+			},
+			errorMsg: `statuses: status id "In Review" must be lower snake case`,
+		},
+		{
+			name: "duplicated status id",
+			statuses: []WorkflowStatus{
+				{ID: "todo", Name: "To Do", Category: StatusCategoryTodo},
+				{ID: "todo", Name: "Done", Category: StatusCategoryDone},
+			},
+			errorMsg: `statuses: status id "todo" is duplicated`,
+		},
+		{
+			name: "first status is not in the todo category",
+			statuses: []WorkflowStatus{
+				{ID: "doing", Name: "Doing", Category: StatusCategoryDoing},
+				{ID: "done", Name: "Done", Category: StatusCategoryDone},
+			},
+			errorMsg: "statuses: the first status must be in the todo category",
+		},
+		{
+			name: "no done status",
+			statuses: []WorkflowStatus{
+				{ID: "todo", Name: "To Do", Category: StatusCategoryTodo},
+			},
+			errorMsg: "statuses: at least one status must be in the done category",
+		},
+		{
+			name:        "transition to an unknown status",
+			statuses:    newKanbanStatuses(),
+			transitions: []Transition{{From: "backlog", To: "archived"}},
+			errorMsg:    "transitions: transition backlog -> archived refers to an unknown status",
+		},
+		{
+			name:        "transition to the same status",
+			statuses:    newKanbanStatuses(),
+			transitions: []Transition{{From: "backlog", To: "backlog"}},
+			errorMsg:    "transitions: transition backlog -> backlog does not change the status",
+		},
+		{
+			name:        "duplicated transition",
+			statuses:    newKanbanStatuses(),
+			transitions: []Transition{{From: "backlog", To: "ready"}, {From: "backlog", To: "ready"}},
+			errorMsg:    "transitions: transition backlog -> ready is duplicated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			workflow, err := NewWorkflow(tt.statuses, tt.transitions)
+
+			// Then
+			if tt.errorMsg != "" {
+				var validationErr *ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				require.Equal(t, tt.errorMsg, err.Error())
+				require.Nil(t, workflow)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.statuses, workflow.Statuses())
+			require.Equal(t, tt.transitions, workflow.Transitions())
+		})
+	}
+}
+
+func TestWorkflow_Transitions(t *testing.T) {
+	// Given
+	workflow, err := NewWorkflow(newKanbanStatuses(), []Transition{
+		{From: "backlog", To: "ready"},
+		{From: "backlog", To: "review"},
+		{From: "ready", To: "review"},
+		{From: "review", To: "done"},
+		{From: "done", To: "ready"},
+	})
+	require.NoError(t, err)
+
+	t.Run("initial status is the first status", func(t *testing.T) {
+		require.Equal(t, StatusID("backlog"), workflow.InitialStatus().ID)
+	})
+
+	t.Run("can transition only along the defined transitions", func(t *testing.T) {
+		require.True(t, workflow.CanTransition("backlog", "ready"))
+		require.False(t, workflow.CanTransition("ready", "backlog"))
+		require.False(t, workflow.CanTransition("backlog", "done"))
+	})
+
+	t.Run("next status in category follows the workflow order", func(t *testing.T) {
+		// When
+		next, ok := workflow.NextStatusInCategory("done", StatusCategoryTodo)
+
+		// Then
+		require.True(t, ok)
+		require.Equal(t, StatusID("ready"), next.ID)
+	})
+
+	t.Run("next status in category is missing without a transition", func(t *testing.T) {
+		// When
+		_, ok := workflow.NextStatusInCategory("backlog", StatusCategoryDone)
+
+		// Then
+		require.False(t, ok)
+	})
+
+	t.Run("status returns false for an unknown id", func(t *testing.T) {
+		// When
+		_, ok := workflow.Status("archived")
+
+		// Then
+		require.False(t, ok)
+	})
+}
+
+func TestDefaultWorkflow(t *testing.T) {
+	// When
+	workflow := DefaultWorkflow()
+
+	// Then
+	statuses := workflow.Statuses()
+	_, err := NewWorkflow(statuses, workflow.Transitions())
+	require.NoError(t, err)
+	for _, from := range statuses {
+		for _, to := range statuses {
+			require.Equal(t, from.ID != to.ID, workflow.CanTransition(from.ID, to.ID))
+		}
+	}
+}
+
+func TestNewStatusCategoryFromString(t *testing.T) {
+	for _, category := range []StatusCategory{StatusCategoryTodo, StatusCategoryDoing, StatusCategoryDone} {
+		t.Run(category.String(), func(t *testing.T) {
+			// When
+			result, err := NewStatusCategoryFromString(category.String())
+
+			// Then
+			require.NoError(t, err)
+			require.Equal(t, category, result)
+		})
+	}
+
+	t.Run("invalid category", func(t *testing.T) {
+		// When
+		_, err := NewStatusCategoryFromString("ARCHIVED")
+
+		// Then
+		require.Error(t, err)
+	})
+}
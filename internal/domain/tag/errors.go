@@ -0,0 +1,72 @@
+package tag
+
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+)
+
+// Reasons of the tag errors, reported to clients as stable error codes
+const (
+	ReasonNotFound        = "TAG_NOT_FOUND"
+	ReasonAlreadyExists   = "TAG_ALREADY_EXISTS"
+	ReasonInvalidArgument = "TAG_INVALID_ARGUMENT"
+)
+
+// NotFoundError represents an error when a tag is not found
+type NotFoundError struct {
+	ID TagID
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("tag not found: %s", e.ID.String())
+}
+
+func (e *NotFoundError) Kind() domainerr.Kind { return domainerr.KindNotFound }
+
+func (e *NotFoundError) Reason() string { return ReasonNotFound }
+
+func (e *NotFoundError) Metadata() map[string]string {
+	return map[string]string{"tag_id": e.ID.String()}
+}
+
+// AlreadyExistsError represents an error when another tag already uses the name
+type AlreadyExistsError struct {
+	Name string
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("tag already exists: %s", e.Name)
+}
+
+func (e *AlreadyExistsError) Kind() domainerr.Kind { return domainerr.KindAlreadyExists }
+
+func (e *AlreadyExistsError) Reason() string { return ReasonAlreadyExists }
+
+func (e *AlreadyExistsError) Metadata() map[string]string {
+	return map[string]string{"name": e.Name}
+}
+
+// ValidationError represents a validation error
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return e.Message
+}
+
+func (e *ValidationError) Kind() domainerr.Kind { return domainerr.KindInvalidArgument }
+
+func (e *ValidationError) Reason() string { return ReasonInvalidArgument }
+
+func (e *ValidationError) FieldViolations() []domainerr.FieldViolation {
+	if e.Field == "" {
+		return nil
+	}
+	return []domainerr.FieldViolation{{Field: e.Field, Description: e.Message}}
+}
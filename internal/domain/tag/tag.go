@@ -0,0 +1,129 @@
+// Package tag provides the domain layer for tags attached to todos.
+package tag
+
+import (
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+const (
+	// MaxNameLength is the maximum number of characters in a tag name.
+	MaxNameLength = 50
+	// DefaultColor is the color assigned to a tag when none is given.
+	DefaultColor = "#808080"
+)
+
+// colorPattern matches a hex color code such as "#1e90ff".
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Tag is the entity that represents a label attached to todos.
+type Tag struct {
+	id        TagID
+	name      string
+	color     string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewTag creates a new Tag with an ID from ids, created at now. An empty color falls back to DefaultColor.
+func NewTag(ids idgen.IDGenerator, now time.Time, name string, color string) (*Tag, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	if color == "" {
+		color = DefaultColor
+	}
+	if err := validateColor(color); err != nil {
+		return nil, err
+	}
+	return &Tag{
+		id:        TagID(ids.NewID()),
+		name:      name,
+		color:     color,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ID returns the ID of the Tag.
+func (t Tag) ID() TagID {
+	return t.id
+}
+
+// Name returns the name of the Tag.
+func (t Tag) Name() string {
+	return t.name
+}
+
+// Color returns the color of the Tag.
+func (t Tag) Color() string {
+	return t.color
+}
+
+// CreatedAt returns the created at of the Tag.
+func (t Tag) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt returns the updated at of the Tag.
+func (t Tag) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// Rename changes the name of the Tag.
+func (t *Tag) Rename(now time.Time, name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	t.name = name
+	t.updatedAt = now
+	return nil
+}
+
+// SetColor changes the color of the Tag.
+func (t *Tag) SetColor(now time.Time, color string) error {
+	if err := validateColor(color); err != nil {
+		return err
+	}
+	t.color = color
+	t.updatedAt = now
+	return nil
+}
+
+// ReconstructTag reconstructs a Tag from the given values.
+func ReconstructTag(
+	id uuid.UUID,
+	name string,
+	color string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Tag {
+	return &Tag{
+		id:        TagID(id),
+		name:      name,
+		color:     color,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return &ValidationError{Field: "name", Message: "name is too long"}
+	}
+	return nil
+}
+
+func validateColor(color string) error {
+	if !colorPattern.MatchString(color) {
+		return &ValidationError{Field: "color", Message: "color must be a hex code like #1e90ff"}
+	}
+	return nil
+}
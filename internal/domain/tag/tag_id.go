@@ -0,0 +1,36 @@
+package tag
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+// TagID is the identifier for a Tag.
+type TagID uuid.UUID
+
+// NewTagID creates a new TagID with the default IDGenerator.
+// NewTag takes the IDs of new Tags from the IDGenerator it is given instead.
+func NewTagID() TagID {
+	return TagID(idgen.UUIDv7().NewID())
+}
+
+// String returns the string representation of the TagID.
+func (id TagID) String() string {
+	return id.UUID().String()
+}
+
+// UUID returns the UUID representation of the TagID.
+func (id TagID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// NewTagIDFromString creates a new TagID from a string.
+func NewTagIDFromString(s string) (TagID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return TagID{}, fmt.Errorf("failed to parse uuid %s: %w", s, err)
+	}
+	return TagID(id), nil
+}
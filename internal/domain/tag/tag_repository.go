@@ -0,0 +1,15 @@
+package tag
+
+import (
+	"context"
+)
+
+// TagRepository is the interface that wraps the basic CRUD operations for Tag.
+type TagRepository interface {
+	Create(ctx context.Context, tag *Tag) error
+	Update(ctx context.Context, tag *Tag) error
+	FindAll(ctx context.Context) ([]*Tag, error)
+	FindByID(ctx context.Context, id TagID) (*Tag, error)
+	ExistsByName(ctx context.Context, name string) (bool, error)
+	Delete(ctx context.Context, id TagID) error
+}
@@ -0,0 +1,197 @@
+package tag
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTag(t *testing.T) {
+	tests := []struct {
+		name          string
+		tagName       string
+		color         string
+		expectedColor string
+		expectError   bool
+		errorMsg      string
+	}{
+		{
+			name:          "valid tag with name and color",
+			tagName:       "backend",
+			color:         "#1e90ff",
+			expectedColor: "#1e90ff",
+			expectError:   false,
+		},
+		{
+			name:          "tag with empty color uses default",
+			tagName:       "frontend",
+			color:         "",
+			expectedColor: DefaultColor,
+			expectError:   false,
+		},
+		{
+			name:        "tag with empty name",
+			tagName:     "",
+			color:       "#1e90ff",
+			expectError: true,
+			errorMsg:    "name: name is required",
+		},
+		{
+			name:        "tag with too long name",
+			tagName:     strings.Repeat("a", MaxNameLength+1),
+			color:       "#1e90ff",
+			expectError: true,
+			errorMsg:    "name: name is too long",
+		},
+		{
+			name:        "tag with invalid color",
+			tagName:     "backend",
+			color:       "blue",
+			expectError: true,
+			errorMsg:    "color: color must be a hex code like #1e90ff",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+			ids := idgen.NewSequence()
+
+			// When
+			tag, err := NewTag(ids, now, tt.tagName, tt.color)
+
+			// Then
+			if tt.expectError {
+				require.Error(t, err)
+				require.Equal(t, tt.errorMsg, err.Error())
+				require.Nil(t, tag)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, tag)
+				require.Equal(t, "00000000-0000-7000-8000-000000000001", tag.ID().String())
+				require.Equal(t, tt.tagName, tag.Name())
+				require.Equal(t, tt.expectedColor, tag.Color())
+				require.Equal(t, now, tag.CreatedAt())
+				require.Equal(t, now, tag.UpdatedAt())
+			}
+		})
+	}
+}
+
+func TestTag_Rename(t *testing.T) {
+	tests := []struct {
+		name        string
+		newName     string
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid name",
+			newName:     "renamed",
+			expectError: false,
+		},
+		{
+			name:        "empty name",
+			newName:     "",
+			expectError: true,
+			errorMsg:    "name: name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			tag, err := NewTag(idgen.UUIDv7(), time.Now(), "original", "#1e90ff")
+			require.NoError(t, err)
+			originalUpdatedAt := tag.UpdatedAt()
+			time.Sleep(1 * time.Millisecond) // Ensure time difference
+
+			// When
+			err = tag.Rename(time.Now(), tt.newName)
+
+			// Then
+			if tt.expectError {
+				require.Error(t, err)
+				require.Equal(t, tt.errorMsg, err.Error())
+				require.Equal(t, "original", tag.Name())
+				require.Equal(t, originalUpdatedAt, tag.UpdatedAt())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.newName, tag.Name())
+				require.True(t, tag.UpdatedAt().After(originalUpdatedAt))
+			}
+		})
+	}
+}
+
+func TestTag_SetColor(t *testing.T) {
+	tests := []struct {
+		name        string
+		color       string
+		expectError bool
+	}{
+		{
+			name:        "valid lowercase color",
+			color:       "#ff0000",
+			expectError: false,
+		},
+		{
+			name:        "valid uppercase color",
+			color:       "#00FF00",
+			expectError: false,
+		},
+		{
+			name:        "short color",
+			color:       "#fff",
+			expectError: true,
+		},
+		{
+			name:        "empty color",
+			color:       "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			tag, err := NewTag(idgen.UUIDv7(), time.Now(), "backend", "#1e90ff")
+			require.NoError(t, err)
+
+			// When
+			err = tag.SetColor(time.Now(), tt.color)
+
+			// Then
+			if tt.expectError {
+				require.Error(t, err)
+				require.Equal(t, "#1e90ff", tag.Color())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.color, tag.Color())
+			}
+		})
+	}
+}
+
+func TestReconstructTag(t *testing.T) {
+	// Given
+	id := uuid.New()
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	// When
+	tag := ReconstructTag(id, "backend", "#1e90ff", createdAt, updatedAt)
+
+	// Then
+	require.NotNil(t, tag)
+	require.Equal(t, TagID(id), tag.ID())
+	require.Equal(t, "backend", tag.Name())
+	require.Equal(t, "#1e90ff", tag.Color())
+	require.Equal(t, createdAt, tag.CreatedAt())
+	require.Equal(t, updatedAt, tag.UpdatedAt())
+}
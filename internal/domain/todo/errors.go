@@ -1,6 +1,18 @@
 package todo
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+)
+
+// Reasons of the todo errors, reported to clients as stable error codes
+const (
+	ReasonNotFound          = "TODO_NOT_FOUND"
+	ReasonAlreadyExists     = "TODO_ALREADY_EXISTS"
+	ReasonInvalidArgument   = "TODO_INVALID_ARGUMENT"
+	ReasonInvalidTransition = "TODO_INVALID_TRANSITION"
+)
 
 // NotFoundError represents an error when a todo is not found
 type NotFoundError struct {
@@ -11,6 +23,31 @@ func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("todo not found: %s", e.ID.String())
 }
 
+func (e *NotFoundError) Kind() domainerr.Kind { return domainerr.KindNotFound }
+
+func (e *NotFoundError) Reason() string { return ReasonNotFound }
+
+func (e *NotFoundError) Metadata() map[string]string {
+	return map[string]string{"todo_id": e.ID.String()}
+}
+
+// AlreadyExistsError represents an error when a todo with the same ID already exists
+type AlreadyExistsError struct {
+	ID TodoID
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("todo already exists: %s", e.ID.String())
+}
+
+func (e *AlreadyExistsError) Kind() domainerr.Kind { return domainerr.KindAlreadyExists }
+
+func (e *AlreadyExistsError) Reason() string { return ReasonAlreadyExists }
+
+func (e *AlreadyExistsError) Metadata() map[string]string {
+	return map[string]string{"todo_id": e.ID.String()}
+}
+
 // ValidationError represents a validation error
 type ValidationError struct {
 	Field   string
@@ -24,12 +61,45 @@ func (e *ValidationError) Error() string {
 	return e.Message
 }
 
+func (e *ValidationError) Kind() domainerr.Kind { return domainerr.KindInvalidArgument }
+
+func (e *ValidationError) Reason() string { return ReasonInvalidArgument }
+
+// FieldViolations returns the invalid field, or nothing when the error does not name one.
+func (e *ValidationError) FieldViolations() []domainerr.FieldViolation {
+	if e.Field == "" {
+		return nil
+	}
+	return []domainerr.FieldViolation{{Field: e.Field, Description: e.Message}}
+}
+
 // StateError represents an invalid state transition error
 type StateError struct {
-	Current TodoStatus
-	Message string
+	Current   TodoStatus
+	Attempted TodoStatus
+	Message   string
 }
 
 func (e *StateError) Error() string {
 	return e.Message
 }
+
+func (e *StateError) Kind() domainerr.Kind { return domainerr.KindFailedPrecondition }
+
+func (e *StateError) Reason() string { return ReasonInvalidTransition }
+
+func (e *StateError) Metadata() map[string]string {
+	return map[string]string{
+		"current_status":   e.Current.String(),
+		"attempted_status": e.Attempted.String(),
+	}
+}
+
+// PreconditionViolations reports the current status as the violated condition.
+func (e *StateError) PreconditionViolations() []domainerr.PreconditionViolation {
+	return []domainerr.PreconditionViolation{{
+		Type:        "TODO_STATUS",
+		Subject:     e.Current.String(),
+		Description: e.Message,
+	}}
+}
@@ -2,9 +2,14 @@
 package todo
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
 )
 
 // Todo is the entity that represents a todo item.
@@ -16,16 +21,27 @@ type Todo struct {
 	createdAt   time.Time
 	updatedAt   time.Time
 	completedAt *time.Time
+	tagIDs      []tag.TagID
+	parentID    *TodoID
+	blockerIDs  []TodoID
+	projectID   *project.ProjectID
+	// workflow is the workflow of the todo's project, or nil when the todo
+	// follows the built-in status transition table.
+	workflow *project.Workflow
+	// statusID is the current workflow status. It is empty for todos without a project.
+	statusID project.StatusID
 }
 
-// NewTodo creates a new Todo.
-func NewTodo(title string, body string) (*Todo, error) {
+// MaxDepth is the maximum number of levels in a todo hierarchy, counting the root.
+const MaxDepth = 5
+
+// NewTodo creates a new Todo with an ID from ids, created at now.
+func NewTodo(ids idgen.IDGenerator, now time.Time, title string, body string) (*Todo, error) {
 	if title == "" {
 		return nil, &ValidationError{Field: "title", Message: "title is required"}
 	}
-	now := time.Now()
 	return &Todo{
-		id:          NewTodoID(),
+		id:          TodoID(ids.NewID()),
 		title:       title,
 		body:        body,
 		status:      TodoStatusNotStarted,
@@ -70,49 +86,367 @@ func (t Todo) CompletedAt() *time.Time {
 	return t.completedAt
 }
 
-func (t *Todo) SetTitle(title string) error {
+// TagIDs returns the IDs of the tags attached to the Todo.
+func (t Todo) TagIDs() []tag.TagID {
+	return slices.Clone(t.tagIDs)
+}
+
+// HasTag checks if the tag is attached to the Todo.
+func (t Todo) HasTag(tagID tag.TagID) bool {
+	return slices.Contains(t.tagIDs, tagID)
+}
+
+// AddTag attaches the tag to the Todo. A tag can be attached only once.
+func (t *Todo) AddTag(now time.Time, tagID tag.TagID) error {
+	if t.HasTag(tagID) {
+		return &ValidationError{Field: "tag_id", Message: "tag is already attached"}
+	}
+	t.tagIDs = append(t.tagIDs, tagID)
+	t.updatedAt = now
+	return nil
+}
+
+// RemoveTag detaches the tag from the Todo.
+func (t *Todo) RemoveTag(now time.Time, tagID tag.TagID) error {
+	i := slices.Index(t.tagIDs, tagID)
+	if i < 0 {
+		return &ValidationError{Field: "tag_id", Message: "tag is not attached"}
+	}
+	t.tagIDs = slices.Delete(t.tagIDs, i, i+1)
+	t.updatedAt = now
+	return nil
+}
+
+// ParentID returns the ID of the parent Todo, or nil if the Todo is a root.
+func (t Todo) ParentID() *TodoID {
+	if t.parentID == nil {
+		return nil
+	}
+	parentID := *t.parentID
+	return &parentID
+}
+
+// IsRoot checks if the Todo has no parent.
+func (t Todo) IsRoot() bool {
+	return t.parentID == nil
+}
+
+// ProjectID returns the ID of the project the Todo belongs to, or nil if it belongs to none.
+func (t Todo) ProjectID() *project.ProjectID {
+	if t.projectID == nil {
+		return nil
+	}
+	projectID := *t.projectID
+	return &projectID
+}
+
+// StatusID returns the ID of the Todo's current status.
+// Todos without a project use the built-in status names such as "IN_PROGRESS".
+func (t Todo) StatusID() project.StatusID {
+	if t.statusID == "" {
+		return project.StatusID(t.status.String())
+	}
+	return t.statusID
+}
+
+// Workflow returns the workflow of the todo's project, or nil when the todo
+// follows the built-in status transition table.
+func (t Todo) Workflow() *project.Workflow {
+	return t.workflow
+}
+
+// AssignProject makes the Todo follow the project's workflow, starting at its initial status.
+// Only todos that belong to no project and have not been started can be assigned.
+func (t *Todo) AssignProject(now time.Time, p *project.Project) error {
+	if t.projectID != nil {
+		return &ValidationError{Field: "project_id", Message: "todo already belongs to a project"}
+	}
+	if t.status != TodoStatusNotStarted {
+		return &ValidationError{Field: "project_id", Message: "only todos that have not been started can be assigned to a project"}
+	}
+	projectID := p.ID()
+	t.projectID = &projectID
+	t.workflow = p.Workflow()
+	t.setWorkflowStatus(now, t.workflow.InitialStatus())
+	return nil
+}
+
+// SetParent places the Todo under the parent. Both must belong to the same project.
+// parentAncestorIDs are the IDs of the parent's ancestors, nearest first,
+// and height is the number of levels of the Todo's own subtree including itself.
+// It rejects moves that would create a cycle or exceed MaxDepth.
+func (t *Todo) SetParent(now time.Time, parent *Todo, parentAncestorIDs []TodoID, height int) error {
+	if parent.id == t.id || slices.Contains(parentAncestorIDs, t.id) {
+		return &ValidationError{Field: "parent_id", Message: "todo cannot be placed under itself or its descendants"}
+	}
+	if !sameProject(t.projectID, parent.projectID) {
+		return &ValidationError{Field: "parent_id", Message: "todo and parent must belong to the same project"}
+	}
+	parentDepth := len(parentAncestorIDs) + 1
+	if parentDepth+height > MaxDepth {
+		return &ValidationError{Field: "parent_id", Message: "todo hierarchy is too deep"}
+	}
+	parentID := parent.id
+	t.parentID = &parentID
+	t.updatedAt = now
+	return nil
+}
+
+// ClearParent makes the Todo a root.
+func (t *Todo) ClearParent(now time.Time) {
+	if t.parentID == nil {
+		return
+	}
+	t.parentID = nil
+	t.updatedAt = now
+}
+
+func (t *Todo) SetTitle(now time.Time, title string) error {
 	if title == "" {
 		return &ValidationError{Field: "title", Message: "title is required"}
 	}
 	t.title = title
-	t.updatedAt = time.Now()
+	t.updatedAt = now
 	return nil
 }
 
-func (t *Todo) SetBody(body string) error {
+func (t *Todo) SetBody(now time.Time, body string) error {
 	t.body = body
-	t.updatedAt = time.Now()
+	t.updatedAt = now
 	return nil
 }
 
-// Start changes the Todo's status to in progress.
-func (t *Todo) Start() error {
-	if t.status == TodoStatusCompleted {
-		return &StateError{
-			Current: t.status,
-			Message: "todo is already completed",
+// Start changes the Todo's status from not started to in progress.
+// Todos with a project workflow move to the first allowed status in the doing category.
+// blockers must contain every Todo the Todo is blocked by.
+func (t *Todo) Start(now time.Time, blockers ...*Todo) error {
+	return t.apply(now, startMove, blockers)
+}
+
+// Complete changes the Todo's status from in progress to completed.
+// Todos with a project workflow move to the first allowed status in the done category.
+// blockers must contain every Todo the Todo is blocked by.
+func (t *Todo) Complete(now time.Time, blockers ...*Todo) error {
+	return t.apply(now, completeMove, blockers)
+}
+
+// CompleteWithDescendants completes the Todo together with its subtree.
+// Open descendants make it fail with a StateError unless cascade is set,
+// in which case they are completed as well and returned so they can be saved.
+// Open descendants move straight to a completed status regardless of the transitions in between.
+// blockers must contain every Todo outside of the subtree that blocks the Todo or a completed descendant.
+func (t *Todo) CompleteWithDescendants(now time.Time, descendants []*Todo, cascade bool, blockers ...*Todo) ([]*Todo, error) {
+	next, err := t.resolve(completeMove)
+	if err != nil {
+		return nil, err
+	}
+	var open []*Todo
+	for _, d := range descendants {
+		if !d.IsFinished() {
+			open = append(open, d)
 		}
 	}
-	t.status = TodoStatusInProgress
-	t.updatedAt = time.Now()
-	return nil
+	if len(open) > 0 && !cascade {
+		return nil, &StateError{
+			Current:   t.status,
+			Attempted: TodoStatusCompleted,
+			Message:   "todo has open subtasks",
+		}
+	}
+	// Todos completed in the same operation count as finished blockers.
+	finishing := map[TodoID]bool{t.id: true}
+	for _, d := range open {
+		finishing[d.id] = true
+	}
+	for _, d := range open {
+		if err := d.checkBlockers(TodoStatusCompleted, blockers, finishing); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.checkBlockers(TodoStatusCompleted, blockers, finishing); err != nil {
+		return nil, err
+	}
+	for _, d := range open {
+		d.forceComplete(now)
+	}
+	t.moveTo(now, completeMove, next)
+	return open, nil
+}
+
+// Reopen changes the Todo's status from completed or cancelled back to not started.
+// Todos with a project workflow move to the first allowed status in the todo category.
+func (t *Todo) Reopen(now time.Time) error {
+	return t.apply(now, reopenMove, nil)
 }
 
-// Complete changes the Todo's status to completed.
-func (t *Todo) Complete() error {
-	if t.status == TodoStatusCompleted {
+// Cancel changes the Todo's status to cancelled. A finished Todo cannot be cancelled.
+// Project workflows have no cancelled status.
+func (t *Todo) Cancel(now time.Time) error {
+	return t.apply(now, cancelMove, nil)
+}
+
+// Pause changes the Todo's status from in progress to on hold.
+// Project workflows have no on hold status.
+func (t *Todo) Pause(now time.Time) error {
+	return t.apply(now, pauseMove, nil)
+}
+
+// Resume changes the Todo's status from on hold back to in progress.
+// blockers must contain every Todo the Todo is blocked by.
+func (t *Todo) Resume(now time.Time, blockers ...*Todo) error {
+	return t.apply(now, resumeMove, blockers)
+}
+
+// TransitionTo changes the Todo's status to the status with the given ID.
+// Todos with a project workflow are validated against its transitions,
+// others against the built-in table using IDs such as "IN_PROGRESS".
+// blockers must contain every Todo the Todo is blocked by; they are checked
+// unless the Todo moves back to a status that has not been started.
+func (t *Todo) TransitionTo(now time.Time, statusID project.StatusID, blockers ...*Todo) error {
+	if t.workflow == nil {
+		next, err := NewTodoStatusFromString(statusID.String())
+		if err != nil {
+			return &ValidationError{Field: "status_id", Message: fmt.Sprintf("unknown status %q", statusID)}
+		}
+		if err := t.checkTransition(next); err != nil {
+			return err
+		}
+		if next == TodoStatusInProgress || next == TodoStatusCompleted {
+			if err := t.checkBlockers(next, blockers, nil); err != nil {
+				return err
+			}
+		}
+		t.setStatus(now, next)
+		return nil
+	}
+
+	next, ok := t.workflow.Status(statusID)
+	if !ok {
+		return &ValidationError{Field: "status_id", Message: fmt.Sprintf("unknown status %q in the project workflow", statusID)}
+	}
+	current, _ := t.workflow.Status(t.statusID)
+	attempted := lifecycleStatus(next.Category)
+	if next.ID == current.ID {
 		return &StateError{
-			Current: t.status,
-			Message: "todo is already completed",
+			Current:   t.status,
+			Attempted: attempted,
+			Message:   fmt.Sprintf("todo is already in %s", current.Name),
 		}
 	}
-	now := time.Now()
-	t.status = TodoStatusCompleted
-	t.completedAt = &now
+	if !t.workflow.CanTransition(current.ID, next.ID) {
+		return &StateError{
+			Current:   t.status,
+			Attempted: attempted,
+			Message:   fmt.Sprintf("todo cannot change from %s to %s", current.Name, next.Name),
+		}
+	}
+	if next.Category != project.StatusCategoryTodo {
+		if err := t.checkBlockers(attempted, blockers, nil); err != nil {
+			return err
+		}
+	}
+	t.setWorkflowStatus(now, next)
+	return nil
+}
+
+// checkTransition returns a StateError unless the transition table allows the Todo
+// to move to next. When from is given, the current status must also be one of them.
+func (t Todo) checkTransition(next TodoStatus, from ...TodoStatus) error {
+	if len(from) > 0 && !slices.Contains(from, t.status) {
+		return newTransitionError(t.status, next)
+	}
+	if !t.status.CanTransitionTo(next) {
+		return newTransitionError(t.status, next)
+	}
+	return nil
+}
+
+// setStatus changes the status and keeps completedAt in sync with it.
+func (t *Todo) setStatus(now time.Time, status TodoStatus) {
+	t.status = status
+	t.updatedAt = now
+	if status == TodoStatusCompleted {
+		t.completedAt = &now
+	} else {
+		t.completedAt = nil
+	}
+}
+
+// setWorkflowStatus moves the Todo to the workflow status and derives the built-in status from its category.
+func (t *Todo) setWorkflowStatus(now time.Time, status project.WorkflowStatus) {
+	t.statusID = status.ID
+	t.setStatus(now, lifecycleStatus(status.Category))
+}
+
+// BlockerIDs returns the IDs of the Todos that block the Todo.
+func (t Todo) BlockerIDs() []TodoID {
+	return slices.Clone(t.blockerIDs)
+}
+
+// IsBlockedBy checks if the Todo is blocked by the given Todo.
+func (t Todo) IsBlockedBy(id TodoID) bool {
+	return slices.Contains(t.blockerIDs, id)
+}
+
+// AddBlocker makes the Todo blocked by the given Todo.
+// Cycles spanning several Todos are detected by the application layer, which loads them.
+func (t *Todo) AddBlocker(now time.Time, blockerID TodoID) error {
+	if blockerID == t.id {
+		return &ValidationError{Field: "blocker_id", Message: "todo cannot block itself"}
+	}
+	if t.IsBlockedBy(blockerID) {
+		return &ValidationError{Field: "blocker_id", Message: "dependency already exists"}
+	}
+	t.blockerIDs = append(t.blockerIDs, blockerID)
+	t.updatedAt = now
+	return nil
+}
+
+// RemoveBlocker removes the dependency on the given Todo.
+func (t *Todo) RemoveBlocker(now time.Time, blockerID TodoID) error {
+	i := slices.Index(t.blockerIDs, blockerID)
+	if i < 0 {
+		return &ValidationError{Field: "blocker_id", Message: "dependency does not exist"}
+	}
+	t.blockerIDs = slices.Delete(t.blockerIDs, i, i+1)
 	t.updatedAt = now
 	return nil
 }
 
+// IsActionable checks if the Todo is unfinished and every blocker is finished.
+// blockers must contain every Todo the Todo is blocked by.
+func (t Todo) IsActionable(blockers ...*Todo) bool {
+	return !t.IsFinished() && t.checkBlockers(TodoStatusInProgress, blockers, nil) == nil
+}
+
+// checkBlockers returns a StateError unless every blocker of the Todo is finished.
+// attempted is the status the Todo is about to move to. Todos in finishing count as finished.
+// A blocker missing from blockers is a mistake of the caller, so it is not reported as a StateError.
+func (t Todo) checkBlockers(attempted TodoStatus, blockers []*Todo, finishing map[TodoID]bool) error {
+	for _, blockerID := range t.blockerIDs {
+		if finishing[blockerID] {
+			continue
+		}
+		i := slices.IndexFunc(blockers, func(b *Todo) bool { return b.id == blockerID })
+		if i < 0 {
+			return fmt.Errorf("blocker %s is not loaded", blockerID)
+		}
+		if !blockers[i].IsFinished() {
+			return &StateError{
+				Current:   t.status,
+				Attempted: attempted,
+				Message:   "todo is blocked by unfinished todos",
+			}
+		}
+	}
+	return nil
+}
+
+// IsFinished checks if the Todo no longer needs work, i.e. it is completed or cancelled.
+func (t Todo) IsFinished() bool {
+	return t.status.IsFinished()
+}
+
 // IsInProgress checks if the Todo is in progress.
 func (t Todo) IsInProgress() bool {
 	return t.status == TodoStatusInProgress
@@ -123,6 +457,16 @@ func (t Todo) IsCompleted() bool {
 	return t.status == TodoStatusCompleted
 }
 
+// IsCancelled checks if the Todo is cancelled.
+func (t Todo) IsCancelled() bool {
+	return t.status == TodoStatusCancelled
+}
+
+// IsOnHold checks if the Todo is on hold.
+func (t Todo) IsOnHold() bool {
+	return t.status == TodoStatusOnHold
+}
+
 // ReconstructTodo reconstructs a Todo from the given values.
 func ReconstructTodo(
 	id uuid.UUID,
@@ -143,7 +487,8 @@ func ReconstructTodo(
 	}
 }
 
-// ReconstructTodoWithStatus reconstructs a Todo from the given values including status and completedAt.
+// ReconstructTodoWithStatus reconstructs a Todo from the given values including status, completedAt, tags, parent, blockers and project.
+// workflow and statusID are the project's workflow and the todo's status in it, and are ignored when projectID is nil.
 func ReconstructTodoWithStatus(
 	id uuid.UUID,
 	title string,
@@ -152,7 +497,24 @@ func ReconstructTodoWithStatus(
 	createdAt time.Time,
 	updatedAt time.Time,
 	completedAt *time.Time,
+	tagIDs []tag.TagID,
+	parentID *TodoID,
+	blockerIDs []TodoID,
+	projectID *project.ProjectID,
+	workflow *project.Workflow,
+	statusID project.StatusID,
 ) *Todo {
+	if parentID != nil {
+		id := *parentID
+		parentID = &id
+	}
+	if projectID == nil {
+		workflow = nil
+		statusID = ""
+	} else {
+		id := *projectID
+		projectID = &id
+	}
 	return &Todo{
 		id:          TodoID(id),
 		title:       title,
@@ -161,5 +523,19 @@ func ReconstructTodoWithStatus(
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
 		completedAt: completedAt,
+		tagIDs:      slices.Clone(tagIDs),
+		parentID:    parentID,
+		blockerIDs:  slices.Clone(blockerIDs),
+		projectID:   projectID,
+		workflow:    workflow,
+		statusID:    statusID,
+	}
+}
+
+// sameProject checks if two todos belong to the same project or both to none.
+func sameProject(a, b *project.ProjectID) bool {
+	if a == nil || b == nil {
+		return a == b
 	}
+	return *a == *b
 }
@@ -0,0 +1,57 @@
+package todo
+
+import (
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+)
+
+// TagMatch represents how the tags of a TodoFilter are matched.
+type TagMatch int
+
+const (
+	// TagMatchAny matches todos that have at least one of the tags.
+	TagMatchAny TagMatch = iota
+	// TagMatchAll matches todos that have every one of the tags.
+	TagMatchAll
+)
+
+// TodoFilter narrows down the todos returned by TodoRepository.FindAll.
+// The zero value matches every todo.
+type TodoFilter struct {
+	TagIDs   []tag.TagID
+	TagMatch TagMatch
+	// ParentID limits the result to the direct children of the Todo.
+	ParentID *TodoID
+	// ProjectID limits the result to the todos in the project.
+	ProjectID *project.ProjectID
+	// Actionable limits the result to unfinished todos whose blockers are all finished.
+	Actionable bool
+}
+
+// Matches checks if the Todo satisfies the filter.
+// The state of blockers lives in other todos, so for Actionable
+// only the Todo's own state is checked here.
+func (f TodoFilter) Matches(t *Todo) bool {
+	if f.Actionable && t.IsFinished() {
+		return false
+	}
+	if f.ParentID != nil && (t.parentID == nil || *t.parentID != *f.ParentID) {
+		return false
+	}
+	if f.ProjectID != nil && (t.projectID == nil || *t.projectID != *f.ProjectID) {
+		return false
+	}
+	if len(f.TagIDs) == 0 {
+		return true
+	}
+	for _, tagID := range f.TagIDs {
+		has := t.HasTag(tagID)
+		if f.TagMatch == TagMatchAny && has {
+			return true
+		}
+		if f.TagMatch == TagMatchAll && !has {
+			return false
+		}
+	}
+	return f.TagMatch == TagMatchAll
+}
@@ -0,0 +1,95 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoFilter_Matches(t *testing.T) {
+	backend := tag.NewTagID()
+	frontend := tag.NewTagID()
+	urgent := tag.NewTagID()
+	parentID := NewTodoID()
+	otherParentID := NewTodoID()
+	projectID := project.NewProjectID()
+	otherProjectID := project.NewProjectID()
+
+	todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "Test Body")
+	require.NoError(t, err)
+	require.NoError(t, todo.AddTag(time.Now(), backend))
+	require.NoError(t, todo.AddTag(time.Now(), urgent))
+	todo.parentID = &parentID
+	todo.projectID = &projectID
+
+	tests := []struct {
+		name     string
+		filter   TodoFilter
+		expected bool
+	}{
+		{
+			name:     "empty filter matches everything",
+			filter:   TodoFilter{},
+			expected: true,
+		},
+		{
+			name:     "any matches when one tag is attached",
+			filter:   TodoFilter{TagIDs: []tag.TagID{frontend, backend}, TagMatch: TagMatchAny},
+			expected: true,
+		},
+		{
+			name:     "any does not match when no tag is attached",
+			filter:   TodoFilter{TagIDs: []tag.TagID{frontend}, TagMatch: TagMatchAny},
+			expected: false,
+		},
+		{
+			name:     "all matches when every tag is attached",
+			filter:   TodoFilter{TagIDs: []tag.TagID{backend, urgent}, TagMatch: TagMatchAll},
+			expected: true,
+		},
+		{
+			name:     "all does not match when a tag is missing",
+			filter:   TodoFilter{TagIDs: []tag.TagID{backend, frontend}, TagMatch: TagMatchAll},
+			expected: false,
+		},
+		{
+			name:     "actionable matches an unfinished todo",
+			filter:   TodoFilter{Actionable: true},
+			expected: true,
+		},
+		{
+			name:     "parent matches a direct child",
+			filter:   TodoFilter{ParentID: &parentID},
+			expected: true,
+		},
+		{
+			name:     "parent does not match a child of another todo",
+			filter:   TodoFilter{ParentID: &otherParentID},
+			expected: false,
+		},
+		{
+			name:     "project matches a todo in the project",
+			filter:   TodoFilter{ProjectID: &projectID},
+			expected: true,
+		},
+		{
+			name:     "project does not match a todo in another project",
+			filter:   TodoFilter{ProjectID: &otherProjectID},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			result := tt.filter.Matches(todo)
+
+			// Then
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
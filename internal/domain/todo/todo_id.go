@@ -4,15 +4,16 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
 )
 
 // TodoID is the identifier for a Todo.
 type TodoID uuid.UUID
 
-// NewTodoID creates a new TodoID.
+// NewTodoID creates a new TodoID with the default IDGenerator.
+// NewTodo takes the IDs of new Todos from the IDGenerator it is given instead.
 func NewTodoID() TodoID {
-	id, _ := uuid.NewV7()
-	return TodoID(id)
+	return TodoID(idgen.UUIDv7().NewID())
 }
 
 // String returns the string representation of the TodoID.
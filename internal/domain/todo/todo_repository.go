@@ -8,7 +8,18 @@ import (
 type TodoRepository interface {
 	Create(ctx context.Context, todo *Todo) error
 	Update(ctx context.Context, todo *Todo) error
-	FindAll(ctx context.Context) ([]*Todo, error)
+	FindAll(ctx context.Context, filter TodoFilter) ([]*Todo, error)
 	FindByID(ctx context.Context, id TodoID) (*Todo, error)
+	// FindAncestorIDs returns the IDs of the ancestors of the Todo, nearest first.
+	FindAncestorIDs(ctx context.Context, id TodoID) ([]TodoID, error)
+	// FindDescendants returns every Todo in the subtree below the Todo.
+	FindDescendants(ctx context.Context, id TodoID) ([]*Todo, error)
 	Delete(ctx context.Context, id TodoID) error
 }
+
+// TodoSearchRepository finds todos by full-text search over their titles and bodies.
+type TodoSearchRepository interface {
+	// Search returns the todos that match the query, best match first.
+	// Soft-deleted todos are never returned.
+	Search(ctx context.Context, query SearchQuery, limit int, offset int) ([]*SearchHit, error)
+}
@@ -0,0 +1,108 @@
+package todo
+
+import (
+	"strings"
+	"unicode"
+)
+
+// HighlightStart and HighlightEnd enclose the matched words in the snippets of a SearchHit.
+const (
+	HighlightStart = "<mark>"
+	HighlightEnd   = "</mark>"
+)
+
+// SearchTerm is a single term of a SearchQuery.
+type SearchTerm struct {
+	// Words must appear next to each other in this order. Plain terms have a single word.
+	Words []string
+	// Prefix matches the last word as a prefix, e.g. "deploy*" matches "deployment".
+	Prefix bool
+}
+
+// IsPhrase checks if the term is a quoted phrase of several words.
+func (t SearchTerm) IsPhrase() bool {
+	return len(t.Words) > 1
+}
+
+// SearchQuery is a parsed full-text search query.
+// A todo matches when its title or body contains every term.
+type SearchQuery struct {
+	terms []SearchTerm
+}
+
+// ParseSearchQuery parses the query syntax accepted by SearchTodos.
+// Words separated by spaces are all required, "double quotes" match a phrase
+// and a trailing * matches a prefix, e.g. `"release notes" deploy*`.
+// Other punctuation only separates words.
+func ParseSearchQuery(s string) (SearchQuery, error) {
+	var terms []SearchTerm
+	rest := []rune(s)
+	for len(rest) > 0 {
+		if unicode.IsSpace(rest[0]) {
+			rest = rest[1:]
+			continue
+		}
+
+		var text []rune
+		if rest[0] == '"' {
+			// An unterminated phrase runs to the end of the query.
+			end := 1
+			for end < len(rest) && rest[end] != '"' {
+				end++
+			}
+			text = rest[1:end]
+			rest = rest[min(end+1, len(rest)):]
+		} else {
+			end := 0
+			for end < len(rest) && !unicode.IsSpace(rest[end]) && rest[end] != '"' {
+				end++
+			}
+			text = rest[:end]
+			rest = rest[end:]
+		}
+
+		prefix := false
+		if len(rest) > 0 && rest[0] == '*' {
+			prefix = true
+			rest = rest[1:]
+		}
+		if n := len(text); n > 0 && text[n-1] == '*' {
+			prefix = true
+			text = text[:n-1]
+		}
+
+		words := strings.FieldsFunc(string(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		if len(words) == 0 {
+			continue
+		}
+		terms = append(terms, SearchTerm{Words: words, Prefix: prefix})
+	}
+
+	if len(terms) == 0 {
+		return SearchQuery{}, &ValidationError{Field: "query", Message: "query must contain at least one word"}
+	}
+	return SearchQuery{terms: terms}, nil
+}
+
+// Terms returns the terms of the SearchQuery.
+func (q SearchQuery) Terms() []SearchTerm {
+	terms := make([]SearchTerm, len(q.terms))
+	for i, t := range q.terms {
+		terms[i] = SearchTerm{Words: append([]string(nil), t.Words...), Prefix: t.Prefix}
+	}
+	return terms
+}
+
+// SearchHit is a Todo found by TodoSearchRepository.Search.
+type SearchHit struct {
+	Todo *Todo
+	// Score tells how well the Todo matches. Higher is better.
+	Score float64
+	// TitleSnippet is the title with the matched words highlighted.
+	TitleSnippet string
+	// BodySnippet is the part of the body around the matched words, highlighted.
+	// It is empty when only the title matches.
+	BodySnippet string
+}
@@ -0,0 +1,97 @@
+package todo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []SearchTerm
+		errorMsg string
+	}{
+		{
+			name:  "splits words",
+			query: "release  notes",
+			expected: []SearchTerm{
+				{Words: []string{"release"}},
+				{Words: []string{"notes"}},
+			},
+		},
+		{
+			name:  "parses phrase",
+			query: `"release notes" draft`,
+			expected: []SearchTerm{
+				{Words: []string{"release", "notes"}},
+				{Words: []string{"draft"}},
+			},
+		},
+		{
+			name:  "parses prefix",
+			query: `deploy* "release no"*`,
+			expected: []SearchTerm{
+				{Words: []string{"deploy"}, Prefix: true},
+				{Words: []string{"release", "no"}, Prefix: true},
+			},
+		},
+		{
+			name:  "unterminated phrase runs to the end",
+			query: `"release notes`,
+			expected: []SearchTerm{
+				{Words: []string{"release", "notes"}},
+			},
+		},
+		{
+			name:  "punctuation separates words",
+			query: "api-v2 OR NOT",
+			expected: []SearchTerm{
+				{Words: []string{"api", "v2"}},
+				{Words: []string{"OR"}},
+				{Words: []string{"NOT"}},
+			},
+		},
+		{
+			name:  "keeps non ascii words",
+			query: "買い物 café",
+			expected: []SearchTerm{
+				{Words: []string{"買い物"}},
+				{Words: []string{"café"}},
+			},
+		},
+		{
+			name:     "returns error for empty query",
+			query:    "   ",
+			errorMsg: "query: query must contain at least one word",
+		},
+		{
+			name:     "returns error for punctuation only",
+			query:    `* "" -`,
+			errorMsg: "query: query must contain at least one word",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			query, err := ParseSearchQuery(tt.query)
+
+			// Then
+			if tt.errorMsg != "" {
+				var validationErr *ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				require.Equal(t, tt.errorMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, query.Terms())
+		})
+	}
+}
+
+func TestSearchTerm_IsPhrase(t *testing.T) {
+	require.False(t, SearchTerm{Words: []string{"release"}}.IsPhrase())
+	require.True(t, SearchTerm{Words: []string{"release", "notes"}}.IsPhrase())
+}
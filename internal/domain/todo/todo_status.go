@@ -12,6 +12,10 @@ const (
 	TodoStatusInProgress
 	// TodoStatusCompleted represents a todo that has been completed.
 	TodoStatusCompleted
+	// TodoStatusCancelled represents a todo that has been cancelled.
+	TodoStatusCancelled
+	// TodoStatusOnHold represents a todo whose work has been paused.
+	TodoStatusOnHold
 )
 
 // statusStrings maps TodoStatus values to their string representations.
@@ -19,6 +23,8 @@ var statusStrings = map[TodoStatus]string{
 	TodoStatusNotStarted: "NOT_STARTED",
 	TodoStatusInProgress: "IN_PROGRESS",
 	TodoStatusCompleted:  "COMPLETED",
+	TodoStatusCancelled:  "CANCELLED",
+	TodoStatusOnHold:     "ON_HOLD",
 }
 
 // stringToStatus maps string representations to TodoStatus values.
@@ -26,6 +32,8 @@ var stringToStatus = map[string]TodoStatus{
 	"NOT_STARTED": TodoStatusNotStarted,
 	"IN_PROGRESS": TodoStatusInProgress,
 	"COMPLETED":   TodoStatusCompleted,
+	"CANCELLED":   TodoStatusCancelled,
+	"ON_HOLD":     TodoStatusOnHold,
 }
 
 // String returns the string representation of the TodoStatus.
@@ -56,5 +64,7 @@ func AllStatuses() []TodoStatus {
 		TodoStatusNotStarted,
 		TodoStatusInProgress,
 		TodoStatusCompleted,
+		TodoStatusCancelled,
+		TodoStatusOnHold,
 	}
 }
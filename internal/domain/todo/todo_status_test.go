@@ -1,6 +1,7 @@
 package todo
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -27,6 +28,16 @@ func TestTodoStatus_String(t *testing.T) {
 			status:   TodoStatusCompleted,
 			expected: "COMPLETED",
 		},
+		{
+			name:     "cancelled status",
+			status:   TodoStatusCancelled,
+			expected: "CANCELLED",
+		},
+		{
+			name:     "on hold status",
+			status:   TodoStatusOnHold,
+			expected: "ON_HOLD",
+		},
 	}
 
 	for _, tt := range tests {
@@ -109,6 +120,18 @@ func TestNewTodoStatusFromString(t *testing.T) {
 			expected:    TodoStatusCompleted,
 			expectError: false,
 		},
+		{
+			name:        "valid cancelled status",
+			input:       "CANCELLED",
+			expected:    TodoStatusCancelled,
+			expectError: false,
+		},
+		{
+			name:        "valid on hold status",
+			input:       "ON_HOLD",
+			expected:    TodoStatusOnHold,
+			expectError: false,
+		},
 		{
 			name:        "invalid status",
 			input:       "INVALID",
@@ -145,3 +168,25 @@ func TestNewTodoStatusFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestTodoStatus_CanTransitionTo(t *testing.T) {
+	allowed := map[TodoStatus][]TodoStatus{
+		TodoStatusNotStarted: {TodoStatusInProgress, TodoStatusCancelled},
+		TodoStatusInProgress: {TodoStatusCompleted, TodoStatusOnHold, TodoStatusCancelled},
+		TodoStatusOnHold:     {TodoStatusInProgress, TodoStatusCancelled},
+		TodoStatusCompleted:  {TodoStatusNotStarted},
+		TodoStatusCancelled:  {TodoStatusNotStarted},
+	}
+
+	for _, from := range AllStatuses() {
+		for _, to := range AllStatuses() {
+			t.Run(from.String()+" to "+to.String(), func(t *testing.T) {
+				// When
+				result := from.CanTransitionTo(to)
+
+				// Then
+				require.Equal(t, slices.Contains(allowed[from], to), result)
+			})
+		}
+	}
+}
@@ -1,10 +1,13 @@
 package todo
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,10 +50,11 @@ func TestNewTodo(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
-			before := time.Now()
+			now := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+			ids := idgen.NewSequence()
 
 			// When
-			todo, err := NewTodo(tt.title, tt.body)
+			todo, err := NewTodo(ids, now, tt.title, tt.body)
 
 			// Then
 			if tt.expectError {
@@ -62,12 +66,9 @@ func TestNewTodo(t *testing.T) {
 				require.NotNil(t, todo)
 				require.Equal(t, tt.title, todo.Title())
 				require.Equal(t, tt.body, todo.Body())
-				require.NotEqual(t, TodoID{}, todo.ID())
-				require.False(t, todo.CreatedAt().IsZero())
-				require.False(t, todo.UpdatedAt().IsZero())
-				require.True(t, todo.CreatedAt().After(before) || todo.CreatedAt().Equal(before))
-				require.True(t, todo.UpdatedAt().After(before) || todo.UpdatedAt().Equal(before))
-				require.Equal(t, todo.CreatedAt(), todo.UpdatedAt())
+				require.Equal(t, "00000000-0000-7000-8000-000000000001", todo.ID().String())
+				require.Equal(t, now, todo.CreatedAt())
+				require.Equal(t, now, todo.UpdatedAt())
 			}
 		})
 	}
@@ -77,7 +78,7 @@ func TestTodo_Getters(t *testing.T) {
 	// Given
 	title := "Test Todo"
 	body := "This is a test todo"
-	todo, err := NewTodo(title, body)
+	todo, err := NewTodo(idgen.UUIDv7(), time.Now(), title, body)
 	require.NoError(t, err)
 
 	// When & Then
@@ -145,6 +146,7 @@ func TestReconstructTodoWithStatus(t *testing.T) {
 		createdAt   time.Time
 		updatedAt   time.Time
 		completedAt *time.Time
+		tagIDs      []tag.TagID
 	}{
 		{
 			name:        "reconstruction with completed status",
@@ -155,6 +157,7 @@ func TestReconstructTodoWithStatus(t *testing.T) {
 			createdAt:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
 			updatedAt:   time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
 			completedAt: func() *time.Time { t := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC); return &t }(),
+			tagIDs:      []tag.TagID{tag.NewTagID(), tag.NewTagID()},
 		},
 		{
 			name:        "reconstruction with not started status",
@@ -179,6 +182,12 @@ func TestReconstructTodoWithStatus(t *testing.T) {
 				tt.createdAt,
 				tt.updatedAt,
 				tt.completedAt,
+				tt.tagIDs,
+				nil,
+				nil,
+				nil,
+				nil,
+				"",
 			)
 
 			// Then
@@ -190,6 +199,10 @@ func TestReconstructTodoWithStatus(t *testing.T) {
 			require.Equal(t, tt.createdAt, todo.CreatedAt())
 			require.Equal(t, tt.updatedAt, todo.UpdatedAt())
 			require.Equal(t, tt.completedAt, todo.CompletedAt())
+			require.Len(t, todo.TagIDs(), len(tt.tagIDs))
+			for _, tagID := range tt.tagIDs {
+				require.True(t, todo.HasTag(tagID))
+			}
 		})
 	}
 }
@@ -217,13 +230,14 @@ func TestTodo_SetTitle(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
-			todo, err := NewTodo("Original Title", "Original Body")
+			createdAt := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+			todo, err := NewTodo(idgen.UUIDv7(), createdAt, "Original Title", "Original Body")
 			require.NoError(t, err)
 			originalUpdatedAt := todo.UpdatedAt()
-			time.Sleep(1 * time.Millisecond) // Ensure time difference
+			now := createdAt.Add(time.Minute)
 
 			// When
-			err = todo.SetTitle(tt.title)
+			err = todo.SetTitle(now, tt.title)
 
 			// Then
 			if tt.expectError {
@@ -234,7 +248,7 @@ func TestTodo_SetTitle(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tt.title, todo.Title())
-				require.True(t, todo.UpdatedAt().After(originalUpdatedAt))
+				require.Equal(t, now, todo.UpdatedAt())
 			}
 		})
 	}
@@ -258,13 +272,13 @@ func TestTodo_SetBody(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
-			todo, err := NewTodo("Original Title", "Original Body")
+			todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Original Title", "Original Body")
 			require.NoError(t, err)
 			originalUpdatedAt := todo.UpdatedAt()
 			time.Sleep(1 * time.Millisecond) // Ensure time difference
 
 			// When
-			err = todo.SetBody(tt.body)
+			err = todo.SetBody(time.Now(), tt.body)
 
 			// Then
 			require.NoError(t, err)
@@ -289,7 +303,14 @@ func TestTodo_Start(t *testing.T) {
 		{
 			name:          "start in progress todo",
 			initialStatus: TodoStatusInProgress,
-			expectError:   false,
+			expectError:   true,
+			errorMsg:      "todo is already in progress",
+		},
+		{
+			name:          "start on hold todo",
+			initialStatus: TodoStatusOnHold,
+			expectError:   true,
+			errorMsg:      "todo cannot change from on hold to in progress",
 		},
 		{
 			name:          "start completed todo",
@@ -297,39 +318,35 @@ func TestTodo_Start(t *testing.T) {
 			expectError:   true,
 			errorMsg:      "todo is already completed",
 		},
+		{
+			name:          "start cancelled todo",
+			initialStatus: TodoStatusCancelled,
+			expectError:   true,
+			errorMsg:      "todo is already cancelled",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
-			todo, err := NewTodo("Test Todo", "Test Body")
-			require.NoError(t, err)
-
-			// Set initial status
-			switch tt.initialStatus {
-			case TodoStatusCompleted:
-				err = todo.Complete()
-				require.NoError(t, err)
-			case TodoStatusInProgress:
-				err = todo.Start()
-				require.NoError(t, err)
-			}
-
-			originalUpdatedAt := todo.UpdatedAt()
-			time.Sleep(1 * time.Millisecond) // Ensure time difference
+			createdAt := time.Now().Add(-time.Hour)
+			todo := ReconstructTodo(uuid.New(), "Test Todo", "Test Body", tt.initialStatus, createdAt, createdAt)
 
 			// When
-			err = todo.Start()
+			err := todo.Start(time.Now())
 
 			// Then
 			if tt.expectError {
-				require.Error(t, err)
+				var stateErr *StateError
+				require.ErrorAs(t, err, &stateErr)
 				require.Equal(t, tt.errorMsg, err.Error())
+				require.Equal(t, tt.initialStatus, stateErr.Current)
+				require.Equal(t, TodoStatusInProgress, stateErr.Attempted)
 				require.Equal(t, tt.initialStatus, todo.Status())
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, TodoStatusInProgress, todo.Status())
-				require.True(t, todo.UpdatedAt().After(originalUpdatedAt))
+				require.True(t, todo.UpdatedAt().After(createdAt))
 			}
 		})
 	}
@@ -345,13 +362,20 @@ func TestTodo_Complete(t *testing.T) {
 		{
 			name:          "complete not started todo",
 			initialStatus: TodoStatusNotStarted,
-			expectError:   false,
+			expectError:   true,
+			errorMsg:      "todo cannot change from not started to completed",
 		},
 		{
 			name:          "complete in progress todo",
 			initialStatus: TodoStatusInProgress,
 			expectError:   false,
 		},
+		{
+			name:          "complete on hold todo",
+			initialStatus: TodoStatusOnHold,
+			expectError:   true,
+			errorMsg:      "todo cannot change from on hold to completed",
+		},
 		{
 			name:          "complete already completed todo",
 			initialStatus: TodoStatusCompleted,
@@ -363,43 +387,192 @@ func TestTodo_Complete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
-			todo, err := NewTodo("Test Todo", "Test Body")
-			require.NoError(t, err)
-
-			// Set initial status
-			switch tt.initialStatus {
-			case TodoStatusInProgress:
-				err = todo.Start()
-				require.NoError(t, err)
-			case TodoStatusCompleted:
-				err = todo.Complete()
-				require.NoError(t, err)
-			}
-
-			originalUpdatedAt := todo.UpdatedAt()
-			originalCompletedAt := todo.CompletedAt()
-			time.Sleep(1 * time.Millisecond) // Ensure time difference
+			createdAt := time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC)
+			todo := ReconstructTodo(uuid.New(), "Test Todo", "Test Body", tt.initialStatus, createdAt, createdAt)
+			now := createdAt.Add(time.Hour)
 
 			// When
-			err = todo.Complete()
+			err := todo.Complete(now)
 
 			// Then
 			if tt.expectError {
-				require.Error(t, err)
+				var stateErr *StateError
+				require.ErrorAs(t, err, &stateErr)
 				require.Equal(t, tt.errorMsg, err.Error())
+				require.Equal(t, tt.initialStatus, stateErr.Current)
+				require.Equal(t, TodoStatusCompleted, stateErr.Attempted)
 				require.Equal(t, tt.initialStatus, todo.Status())
-				require.Equal(t, originalCompletedAt, todo.CompletedAt())
+				require.Nil(t, todo.CompletedAt())
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, TodoStatusCompleted, todo.Status())
-				require.True(t, todo.UpdatedAt().After(originalUpdatedAt))
+				require.Equal(t, now, todo.UpdatedAt())
 				require.NotNil(t, todo.CompletedAt())
-				require.True(t, todo.CompletedAt().After(originalUpdatedAt))
+				require.Equal(t, now, *todo.CompletedAt())
 			}
 		})
 	}
 }
 
+func TestTodo_Reopen(t *testing.T) {
+	tests := []struct {
+		name          string
+		initialStatus TodoStatus
+		expectError   string
+	}{
+		{
+			name:          "reopen completed todo",
+			initialStatus: TodoStatusCompleted,
+		},
+		{
+			name:          "reopen cancelled todo",
+			initialStatus: TodoStatusCancelled,
+		},
+		{
+			name:          "reopen in progress todo",
+			initialStatus: TodoStatusInProgress,
+			expectError:   "todo cannot change from in progress to not started",
+		},
+		{
+			name:          "reopen not started todo",
+			initialStatus: TodoStatusNotStarted,
+			expectError:   "todo is already not started",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			completedAt := time.Now().Add(-time.Hour)
+			todo := ReconstructTodoWithStatus(
+				uuid.New(), "Test Todo", "", tt.initialStatus, completedAt, completedAt, &completedAt, nil, nil, nil,
+				nil,
+				nil,
+				"",
+			)
+
+			// When
+			err := todo.Reopen(time.Now())
+
+			// Then
+			if tt.expectError != "" {
+				var stateErr *StateError
+				require.ErrorAs(t, err, &stateErr)
+				require.Equal(t, tt.expectError, err.Error())
+				require.Equal(t, TodoStatusNotStarted, stateErr.Attempted)
+				require.Equal(t, tt.initialStatus, todo.Status())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, TodoStatusNotStarted, todo.Status())
+			require.Nil(t, todo.CompletedAt())
+			require.True(t, todo.UpdatedAt().After(completedAt))
+		})
+	}
+}
+
+func TestTodo_Cancel(t *testing.T) {
+	tests := []struct {
+		name          string
+		initialStatus TodoStatus
+		expectError   string
+	}{
+		{
+			name:          "cancel not started todo",
+			initialStatus: TodoStatusNotStarted,
+		},
+		{
+			name:          "cancel in progress todo",
+			initialStatus: TodoStatusInProgress,
+		},
+		{
+			name:          "cancel on hold todo",
+			initialStatus: TodoStatusOnHold,
+		},
+		{
+			name:          "cancel completed todo",
+			initialStatus: TodoStatusCompleted,
+			expectError:   "todo is already completed",
+		},
+		{
+			name:          "cancel cancelled todo",
+			initialStatus: TodoStatusCancelled,
+			expectError:   "todo is already cancelled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			todo := ReconstructTodo(uuid.New(), "Test Todo", "", tt.initialStatus, time.Now(), time.Now())
+
+			// When
+			err := todo.Cancel(time.Now())
+
+			// Then
+			if tt.expectError != "" {
+				var stateErr *StateError
+				require.ErrorAs(t, err, &stateErr)
+				require.Equal(t, tt.expectError, err.Error())
+				require.Equal(t, TodoStatusCancelled, stateErr.Attempted)
+				require.Equal(t, tt.initialStatus, todo.Status())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, todo.IsCancelled())
+			require.True(t, todo.IsFinished())
+			require.Nil(t, todo.CompletedAt())
+		})
+	}
+}
+
+func TestTodo_PauseAndResume(t *testing.T) {
+	t.Run("pauses and resumes an in progress todo", func(t *testing.T) {
+		// Given
+		todo := ReconstructTodo(uuid.New(), "Test Todo", "", TodoStatusInProgress, time.Now(), time.Now())
+
+		// When
+		pauseErr := todo.Pause(time.Now())
+		isOnHold := todo.IsOnHold()
+		resumeErr := todo.Resume(time.Now())
+
+		// Then
+		require.NoError(t, pauseErr)
+		require.True(t, isOnHold)
+		require.NoError(t, resumeErr)
+		require.True(t, todo.IsInProgress())
+	})
+
+	t.Run("returns state error when pausing a todo that is not in progress", func(t *testing.T) {
+		// Given
+		todo := ReconstructTodo(uuid.New(), "Test Todo", "", TodoStatusNotStarted, time.Now(), time.Now())
+
+		// When
+		err := todo.Pause(time.Now())
+
+		// Then
+		var stateErr *StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, TodoStatusNotStarted, stateErr.Current)
+		require.Equal(t, TodoStatusOnHold, stateErr.Attempted)
+		require.Equal(t, "todo cannot change from not started to on hold", err.Error())
+	})
+
+	t.Run("returns state error when resuming a todo that is not on hold", func(t *testing.T) {
+		// Given
+		todo := ReconstructTodo(uuid.New(), "Test Todo", "", TodoStatusNotStarted, time.Now(), time.Now())
+
+		// When
+		err := todo.Resume(time.Now())
+
+		// Then
+		var stateErr *StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, "todo cannot change from not started to in progress", err.Error())
+		require.Equal(t, TodoStatusNotStarted, todo.Status())
+	})
+}
+
 func TestTodo_IsInProgress(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -426,16 +599,17 @@ func TestTodo_IsInProgress(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
-			todo, err := NewTodo("Test Todo", "Test Body")
+			todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "Test Body")
 			require.NoError(t, err)
 
 			// Set status
 			switch tt.status {
 			case TodoStatusInProgress:
-				err = todo.Start()
+				err = todo.Start(time.Now())
 				require.NoError(t, err)
 			case TodoStatusCompleted:
-				err = todo.Complete()
+				require.NoError(t, todo.Start(time.Now()))
+				err = todo.Complete(time.Now())
 				require.NoError(t, err)
 			}
 
@@ -471,16 +645,17 @@ func TestTodo_IsCompleted(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Given
-			todo, err := NewTodo("Test Todo", "Test Body")
+			todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "Test Body")
 			require.NoError(t, err)
 
 			// Set status
 			switch tt.status {
 			case TodoStatusInProgress:
-				err = todo.Start()
+				err = todo.Start(time.Now())
 				require.NoError(t, err)
 			case TodoStatusCompleted:
-				err = todo.Complete()
+				require.NoError(t, todo.Start(time.Now()))
+				err = todo.Complete(time.Now())
 				require.NoError(t, err)
 			}
 
@@ -489,3 +664,493 @@ func TestTodo_IsCompleted(t *testing.T) {
 		})
 	}
 }
+
+func TestTodo_AddTag(t *testing.T) {
+	t.Run("attaches a new tag", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "Test Body")
+		require.NoError(t, err)
+		tagID := tag.NewTagID()
+		originalUpdatedAt := todo.UpdatedAt()
+		time.Sleep(1 * time.Millisecond) // Ensure time difference
+
+		// When
+		err = todo.AddTag(time.Now(), tagID)
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, todo.HasTag(tagID))
+		require.Equal(t, []tag.TagID{tagID}, todo.TagIDs())
+		require.True(t, todo.UpdatedAt().After(originalUpdatedAt))
+	})
+
+	t.Run("returns error when tag is already attached", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "Test Body")
+		require.NoError(t, err)
+		tagID := tag.NewTagID()
+		require.NoError(t, todo.AddTag(time.Now(), tagID))
+
+		// When
+		err = todo.AddTag(time.Now(), tagID)
+
+		// Then
+		require.Error(t, err)
+		require.Equal(t, "tag_id: tag is already attached", err.Error())
+		require.Len(t, todo.TagIDs(), 1)
+	})
+}
+
+func TestTodo_RemoveTag(t *testing.T) {
+	t.Run("detaches an attached tag", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "Test Body")
+		require.NoError(t, err)
+		keep := tag.NewTagID()
+		remove := tag.NewTagID()
+		require.NoError(t, todo.AddTag(time.Now(), keep))
+		require.NoError(t, todo.AddTag(time.Now(), remove))
+
+		// When
+		err = todo.RemoveTag(time.Now(), remove)
+
+		// Then
+		require.NoError(t, err)
+		require.False(t, todo.HasTag(remove))
+		require.Equal(t, []tag.TagID{keep}, todo.TagIDs())
+	})
+
+	t.Run("returns error when tag is not attached", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "Test Body")
+		require.NoError(t, err)
+
+		// When
+		err = todo.RemoveTag(time.Now(), tag.NewTagID())
+
+		// Then
+		require.Error(t, err)
+		require.Equal(t, "tag_id: tag is not attached", err.Error())
+	})
+}
+
+func TestTodo_TagIDs_ReturnsCopy(t *testing.T) {
+	// Given
+	todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "Test Body")
+	require.NoError(t, err)
+	tagID := tag.NewTagID()
+	require.NoError(t, todo.AddTag(time.Now(), tagID))
+
+	// When
+	tagIDs := todo.TagIDs()
+	tagIDs[0] = tag.NewTagID()
+
+	// Then
+	require.True(t, todo.HasTag(tagID))
+}
+
+func TestTodo_SetParent(t *testing.T) {
+	tests := []struct {
+		name              string
+		parentAncestors   func(child *Todo) []TodoID
+		height            int
+		useSelfAsParent   bool
+		expectError       bool
+		expectedErrorText string
+	}{
+		{
+			name:            "places todo under a root parent",
+			parentAncestors: func(*Todo) []TodoID { return nil },
+			height:          1,
+		},
+		{
+			name:              "returns error when todo is its own parent",
+			parentAncestors:   func(*Todo) []TodoID { return nil },
+			height:            1,
+			useSelfAsParent:   true,
+			expectError:       true,
+			expectedErrorText: "parent_id: todo cannot be placed under itself or its descendants",
+		},
+		{
+			name: "returns error when parent is a descendant",
+			parentAncestors: func(child *Todo) []TodoID {
+				return []TodoID{NewTodoID(), child.ID()}
+			},
+			height:            2,
+			expectError:       true,
+			expectedErrorText: "parent_id: todo cannot be placed under itself or its descendants",
+		},
+		{
+			name: "allows the deepest level",
+			parentAncestors: func(*Todo) []TodoID {
+				return []TodoID{NewTodoID(), NewTodoID(), NewTodoID()}
+			},
+			height: 1,
+		},
+		{
+			name: "returns error when hierarchy becomes too deep",
+			parentAncestors: func(*Todo) []TodoID {
+				return []TodoID{NewTodoID(), NewTodoID(), NewTodoID()}
+			},
+			height:            2,
+			expectError:       true,
+			expectedErrorText: "parent_id: todo hierarchy is too deep",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			child, err := NewTodo(idgen.UUIDv7(), time.Now(), "Child", "")
+			require.NoError(t, err)
+			parent, err := NewTodo(idgen.UUIDv7(), time.Now(), "Parent", "")
+			require.NoError(t, err)
+			if tt.useSelfAsParent {
+				parent = child
+			}
+
+			// When
+			err = child.SetParent(time.Now(), parent, tt.parentAncestors(child), tt.height)
+
+			// Then
+			if tt.expectError {
+				require.Error(t, err)
+				require.Equal(t, tt.expectedErrorText, err.Error())
+				require.True(t, child.IsRoot())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, parent.ID(), *child.ParentID())
+		})
+	}
+}
+
+func TestTodo_ClearParent(t *testing.T) {
+	// Given
+	parentID := NewTodoID()
+	todo := ReconstructTodoWithStatus(
+		uuid.New(),
+		"Child",
+		"",
+		TodoStatusNotStarted,
+		time.Now(),
+		time.Now(),
+		nil,
+		nil,
+		&parentID,
+		nil,
+		nil,
+		nil,
+		"",
+	)
+
+	// When
+	todo.ClearParent(time.Now())
+
+	// Then
+	require.True(t, todo.IsRoot())
+	require.Nil(t, todo.ParentID())
+}
+
+func TestTodo_CompleteWithDescendants(t *testing.T) {
+	newSubtask := func(status TodoStatus) *Todo {
+		return ReconstructTodoWithStatus(
+			uuid.New(),
+			"Subtask",
+			"",
+			status,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+	}
+
+	t.Run("completes todo when every subtask is completed", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Parent", "")
+		require.NoError(t, err)
+		require.NoError(t, todo.Start(time.Now()))
+		descendants := []*Todo{newSubtask(TodoStatusCompleted)}
+
+		// When
+		completed, err := todo.CompleteWithDescendants(time.Now(), descendants, false)
+
+		// Then
+		require.NoError(t, err)
+		require.Empty(t, completed)
+		require.True(t, todo.IsCompleted())
+	})
+
+	t.Run("returns state error when a subtask is open", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Parent", "")
+		require.NoError(t, err)
+		require.NoError(t, todo.Start(time.Now()))
+		open := newSubtask(TodoStatusInProgress)
+
+		// When
+		completed, err := todo.CompleteWithDescendants(time.Now(), []*Todo{open}, false)
+
+		// Then
+		var stateErr *StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, "todo has open subtasks", err.Error())
+		require.Nil(t, completed)
+		require.False(t, todo.IsCompleted())
+		require.False(t, open.IsCompleted())
+	})
+
+	t.Run("completes open subtasks when cascading", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Parent", "")
+		require.NoError(t, err)
+		require.NoError(t, todo.Start(time.Now()))
+		open := newSubtask(TodoStatusNotStarted)
+		onHold := newSubtask(TodoStatusOnHold)
+		done := newSubtask(TodoStatusCompleted)
+		cancelled := newSubtask(TodoStatusCancelled)
+
+		// When
+		completed, err := todo.CompleteWithDescendants(time.Now(), []*Todo{open, onHold, done, cancelled}, true)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, []*Todo{open, onHold}, completed)
+		require.True(t, todo.IsCompleted())
+		require.True(t, open.IsCompleted())
+		require.True(t, onHold.IsCompleted())
+		require.True(t, cancelled.IsCancelled())
+	})
+
+	t.Run("returns state error when todo is already completed", func(t *testing.T) {
+		// Given
+		todo := newSubtask(TodoStatusCompleted)
+
+		// When
+		_, err := todo.CompleteWithDescendants(time.Now(), nil, true)
+
+		// Then
+		require.Error(t, err)
+		require.Equal(t, "todo is already completed", err.Error())
+	})
+}
+
+func TestTodo_AddBlocker(t *testing.T) {
+	t.Run("adds a blocker", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		blockerID := NewTodoID()
+
+		// When
+		err = todo.AddBlocker(time.Now(), blockerID)
+
+		// Then
+		require.NoError(t, err)
+		require.True(t, todo.IsBlockedBy(blockerID))
+		require.Equal(t, []TodoID{blockerID}, todo.BlockerIDs())
+	})
+
+	t.Run("returns error when todo blocks itself", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+
+		// When
+		err = todo.AddBlocker(time.Now(), todo.ID())
+
+		// Then
+		require.Error(t, err)
+		require.Equal(t, "blocker_id: todo cannot block itself", err.Error())
+	})
+
+	t.Run("returns error when dependency already exists", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		blockerID := NewTodoID()
+		require.NoError(t, todo.AddBlocker(time.Now(), blockerID))
+
+		// When
+		err = todo.AddBlocker(time.Now(), blockerID)
+
+		// Then
+		require.Error(t, err)
+		require.Equal(t, "blocker_id: dependency already exists", err.Error())
+	})
+}
+
+func TestTodo_RemoveBlocker(t *testing.T) {
+	t.Run("removes a blocker", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		blockerID := NewTodoID()
+		require.NoError(t, todo.AddBlocker(time.Now(), blockerID))
+
+		// When
+		err = todo.RemoveBlocker(time.Now(), blockerID)
+
+		// Then
+		require.NoError(t, err)
+		require.Empty(t, todo.BlockerIDs())
+	})
+
+	t.Run("returns error when dependency does not exist", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+
+		// When
+		err = todo.RemoveBlocker(time.Now(), NewTodoID())
+
+		// Then
+		require.Error(t, err)
+		require.Equal(t, "blocker_id: dependency does not exist", err.Error())
+	})
+}
+
+func TestTodo_Blockers(t *testing.T) {
+	newBlocker := func(status TodoStatus) *Todo {
+		return ReconstructTodo(uuid.New(), "Blocker", "", status, time.Now(), time.Now())
+	}
+
+	tests := []struct {
+		name          string
+		blockerStatus TodoStatus
+		loadBlocker   bool
+		expectError   string
+	}{
+		{
+			name:          "allows transition when blocker is completed",
+			blockerStatus: TodoStatusCompleted,
+			loadBlocker:   true,
+		},
+		{
+			name:          "allows transition when blocker is cancelled",
+			blockerStatus: TodoStatusCancelled,
+			loadBlocker:   true,
+		},
+		{
+			name:          "returns error when blocker is unfinished",
+			blockerStatus: TodoStatusInProgress,
+			loadBlocker:   true,
+			expectError:   "todo is blocked by unfinished todos",
+		},
+		{
+			name:          "returns error when blocker is not loaded",
+			blockerStatus: TodoStatusCompleted,
+			loadBlocker:   false,
+			expectError:   "is not loaded",
+		},
+	}
+
+	initialStatuses := map[string]TodoStatus{
+		"start":    TodoStatusNotStarted,
+		"resume":   TodoStatusOnHold,
+		"complete": TodoStatusInProgress,
+	}
+
+	for _, tt := range tests {
+		for _, transition := range []string{"start", "resume", "complete"} {
+			t.Run(transition+" "+tt.name, func(t *testing.T) {
+				// Given
+				todo := ReconstructTodo(uuid.New(), "Test Todo", "", initialStatuses[transition], time.Now(), time.Now())
+				blocker := newBlocker(tt.blockerStatus)
+				require.NoError(t, todo.AddBlocker(time.Now(), blocker.ID()))
+				var blockers []*Todo
+				if tt.loadBlocker {
+					blockers = []*Todo{blocker}
+				}
+
+				// When
+				var err error
+				switch transition {
+				case "start":
+					err = todo.Start(time.Now(), blockers...)
+				case "resume":
+					err = todo.Resume(time.Now(), blockers...)
+				default:
+					err = todo.Complete(time.Now(), blockers...)
+				}
+
+				// Then
+				if tt.expectError == "" {
+					require.NoError(t, err)
+					return
+				}
+				var stateErr *StateError
+				require.Equal(t, tt.loadBlocker, errors.As(err, &stateErr))
+				require.Contains(t, err.Error(), tt.expectError)
+				require.Equal(t, initialStatuses[transition], todo.Status())
+			})
+		}
+	}
+}
+
+func TestTodo_CompleteWithDescendants_Blockers(t *testing.T) {
+	t.Run("treats subtasks completed together as finished blockers", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Parent", "")
+		require.NoError(t, err)
+		require.NoError(t, todo.Start(time.Now()))
+		first, err := NewTodo(idgen.UUIDv7(), time.Now(), "First", "")
+		require.NoError(t, err)
+		second, err := NewTodo(idgen.UUIDv7(), time.Now(), "Second", "")
+		require.NoError(t, err)
+		require.NoError(t, second.AddBlocker(time.Now(), first.ID()))
+
+		// When
+		completed, err := todo.CompleteWithDescendants(time.Now(), []*Todo{second, first}, true)
+
+		// Then
+		require.NoError(t, err)
+		require.Len(t, completed, 2)
+		require.True(t, second.IsCompleted())
+	})
+
+	t.Run("returns error and changes nothing when a subtask has an unfinished blocker", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Parent", "")
+		require.NoError(t, err)
+		require.NoError(t, todo.Start(time.Now()))
+		subtask, err := NewTodo(idgen.UUIDv7(), time.Now(), "Subtask", "")
+		require.NoError(t, err)
+		external, err := NewTodo(idgen.UUIDv7(), time.Now(), "External", "")
+		require.NoError(t, err)
+		require.NoError(t, subtask.AddBlocker(time.Now(), external.ID()))
+
+		// When
+		_, err = todo.CompleteWithDescendants(time.Now(), []*Todo{subtask}, true, external)
+
+		// Then
+		var stateErr *StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.False(t, todo.IsCompleted())
+		require.False(t, subtask.IsCompleted())
+	})
+}
+
+func TestTodo_IsActionable(t *testing.T) {
+	// Given
+	done := ReconstructTodo(uuid.New(), "Done", "", TodoStatusCompleted, time.Now(), time.Now())
+	open := ReconstructTodo(uuid.New(), "Open", "", TodoStatusInProgress, time.Now(), time.Now())
+	blockedByDone, err := NewTodo(idgen.UUIDv7(), time.Now(), "Blocked by done", "")
+	require.NoError(t, err)
+	require.NoError(t, blockedByDone.AddBlocker(time.Now(), done.ID()))
+	blockedByOpen, err := NewTodo(idgen.UUIDv7(), time.Now(), "Blocked by open", "")
+	require.NoError(t, err)
+	require.NoError(t, blockedByOpen.AddBlocker(time.Now(), open.ID()))
+
+	// Then
+	require.True(t, open.IsActionable())
+	require.False(t, done.IsActionable())
+	require.True(t, blockedByDone.IsActionable(done))
+	require.False(t, blockedByOpen.IsActionable(open))
+}
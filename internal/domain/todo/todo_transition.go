@@ -0,0 +1,180 @@
+package todo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+)
+
+// transitions is the table of allowed status changes, keyed by the current status.
+//
+//	NOT_STARTED -> IN_PROGRESS (Start), CANCELLED (Cancel)
+//	IN_PROGRESS -> COMPLETED (Complete), ON_HOLD (Pause), CANCELLED (Cancel)
+//	ON_HOLD     -> IN_PROGRESS (Resume), CANCELLED (Cancel)
+//	COMPLETED   -> NOT_STARTED (Reopen)
+//	CANCELLED   -> NOT_STARTED (Reopen)
+var transitions = map[TodoStatus][]TodoStatus{
+	TodoStatusNotStarted: {TodoStatusInProgress, TodoStatusCancelled},
+	TodoStatusInProgress: {TodoStatusCompleted, TodoStatusOnHold, TodoStatusCancelled},
+	TodoStatusOnHold:     {TodoStatusInProgress, TodoStatusCancelled},
+	TodoStatusCompleted:  {TodoStatusNotStarted},
+	TodoStatusCancelled:  {TodoStatusNotStarted},
+}
+
+// CanTransitionTo checks if the status is allowed to change to next.
+func (s TodoStatus) CanTransitionTo(next TodoStatus) bool {
+	return slices.Contains(transitions[s], next)
+}
+
+// NextStatuses returns the statuses the status is allowed to change to.
+func (s TodoStatus) NextStatuses() []TodoStatus {
+	return slices.Clone(transitions[s])
+}
+
+// IsFinished checks if the status is terminal until the todo is reopened.
+func (s TodoStatus) IsFinished() bool {
+	return s == TodoStatusCompleted || s == TodoStatusCancelled
+}
+
+// label returns the status in lower case words, e.g. "in progress".
+func (s TodoStatus) label() string {
+	return strings.ToLower(strings.ReplaceAll(s.String(), "_", " "))
+}
+
+// newTransitionError returns a StateError for a status change that is not allowed.
+func newTransitionError(current, attempted TodoStatus) *StateError {
+	message := fmt.Sprintf("todo cannot change from %s to %s", current.label(), attempted.label())
+	if current == attempted || current.IsFinished() {
+		message = fmt.Sprintf("todo is already %s", current.label())
+	}
+	return &StateError{
+		Current:   current,
+		Attempted: attempted,
+		Message:   message,
+	}
+}
+
+// move describes one of the built-in transition methods such as Start or Complete.
+type move struct {
+	// to is the status the Todo moves to.
+	to TodoStatus
+	// from restricts the current status on top of the transition table. Empty means any.
+	from []TodoStatus
+	// inWorkflow reports whether the move exists for todos with a project workflow,
+	// where it goes from a status in the fromCategory to the next allowed status in the toCategory.
+	inWorkflow   bool
+	fromCategory project.StatusCategory
+	toCategory   project.StatusCategory
+	// checksBlockers reports whether every blocker must be finished first.
+	checksBlockers bool
+}
+
+var (
+	startMove = move{
+		to:             TodoStatusInProgress,
+		from:           []TodoStatus{TodoStatusNotStarted},
+		inWorkflow:     true,
+		fromCategory:   project.StatusCategoryTodo,
+		toCategory:     project.StatusCategoryDoing,
+		checksBlockers: true,
+	}
+	completeMove = move{
+		to:             TodoStatusCompleted,
+		inWorkflow:     true,
+		fromCategory:   project.StatusCategoryDoing,
+		toCategory:     project.StatusCategoryDone,
+		checksBlockers: true,
+	}
+	reopenMove = move{
+		to:           TodoStatusNotStarted,
+		inWorkflow:   true,
+		fromCategory: project.StatusCategoryDone,
+		toCategory:   project.StatusCategoryTodo,
+	}
+	cancelMove = move{to: TodoStatusCancelled}
+	pauseMove  = move{to: TodoStatusOnHold}
+	resumeMove = move{
+		to:             TodoStatusInProgress,
+		from:           []TodoStatus{TodoStatusOnHold},
+		checksBlockers: true,
+	}
+)
+
+// lifecycleStatus returns the built-in status that a workflow status category stands for.
+func lifecycleStatus(category project.StatusCategory) TodoStatus {
+	switch category {
+	case project.StatusCategoryDoing:
+		return TodoStatusInProgress
+	case project.StatusCategoryDone:
+		return TodoStatusCompleted
+	default:
+		return TodoStatusNotStarted
+	}
+}
+
+// resolve returns the workflow status the move takes the Todo to, or a StateError
+// if the move is not allowed. The status is empty for todos without a workflow.
+func (t Todo) resolve(m move) (project.WorkflowStatus, error) {
+	if t.workflow == nil {
+		return project.WorkflowStatus{}, t.checkTransition(m.to, m.from...)
+	}
+	if !m.inWorkflow {
+		return project.WorkflowStatus{}, &StateError{
+			Current:   t.status,
+			Attempted: m.to,
+			Message:   fmt.Sprintf("%s is not part of the project workflow", m.to.label()),
+		}
+	}
+	current, _ := t.workflow.Status(t.statusID)
+	if current.Category != m.fromCategory {
+		return project.WorkflowStatus{}, newTransitionError(t.status, m.to)
+	}
+	next, ok := t.workflow.NextStatusInCategory(current.ID, m.toCategory)
+	if !ok {
+		return project.WorkflowStatus{}, &StateError{
+			Current:   t.status,
+			Attempted: m.to,
+			Message:   fmt.Sprintf("project workflow has no transition from %s to a %s status", current.Name, strings.ToLower(m.toCategory.String())),
+		}
+	}
+	return next, nil
+}
+
+// apply performs the move after checking the transition and, if needed, the blockers.
+func (t *Todo) apply(now time.Time, m move, blockers []*Todo) error {
+	next, err := t.resolve(m)
+	if err != nil {
+		return err
+	}
+	if m.checksBlockers {
+		if err := t.checkBlockers(m.to, blockers, nil); err != nil {
+			return err
+		}
+	}
+	t.moveTo(now, m, next)
+	return nil
+}
+
+// moveTo changes the status to the result of resolve without further checks.
+func (t *Todo) moveTo(now time.Time, m move, next project.WorkflowStatus) {
+	if t.workflow == nil {
+		t.setStatus(now, m.to)
+		return
+	}
+	t.setWorkflowStatus(now, next)
+}
+
+// forceComplete completes the Todo without checking the transitions in between.
+// It is used when a parent completes its open subtasks.
+func (t *Todo) forceComplete(now time.Time) {
+	if t.workflow == nil {
+		t.setStatus(now, TodoStatusCompleted)
+		return
+	}
+	// A workflow always has a status in the done category.
+	done, _ := t.workflow.FirstStatusInCategory(project.StatusCategoryDone)
+	t.setWorkflowStatus(now, done)
+}
@@ -0,0 +1,290 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/stretchr/testify/require"
+)
+
+// newKanbanProject returns a project whose workflow is
+// backlog -> ready -> review -> done, with done -> ready for rework.
+func newKanbanProject(t *testing.T) *project.Project {
+	t.Helper()
+	workflow, err := project.NewWorkflow(
+		[]project.WorkflowStatus{
+			{ID: "backlog", Name: "Backlog", Category: project.StatusCategoryTodo},
+			{ID: "ready", Name: "Ready", Category: project.StatusCategoryTodo},
+			{ID: "review", Name: "Review", Category: project.StatusCategoryDoing},
+			{ID: "done", Name: "Done", Category: project.StatusCategoryDone},
+		},
+		[]project.Transition{
+			{From: "backlog", To: "ready"},
+			{From: "ready", To: "review"},
+			{From: "review", To: "done"},
+			{From: "done", To: "ready"},
+		},
+	)
+	require.NoError(t, err)
+	p, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Platform", workflow)
+	require.NoError(t, err)
+	return p
+}
+
+func newTodoInProject(t *testing.T, p *project.Project) *Todo {
+	t.Helper()
+	todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+	require.NoError(t, err)
+	require.NoError(t, todo.AssignProject(time.Now(), p))
+	return todo
+}
+
+func TestTodo_AssignProject(t *testing.T) {
+	t.Run("starts the todo in the initial status of the workflow", func(t *testing.T) {
+		// Given
+		p := newKanbanProject(t)
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+
+		// When
+		err = todo.AssignProject(time.Now(), p)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, p.ID(), *todo.ProjectID())
+		require.Equal(t, project.StatusID("backlog"), todo.StatusID())
+		require.Equal(t, TodoStatusNotStarted, todo.Status())
+	})
+
+	t.Run("returns error when todo already belongs to a project", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+
+		// When
+		err := todo.AssignProject(time.Now(), newKanbanProject(t))
+
+		// Then
+		require.EqualError(t, err, "project_id: todo already belongs to a project")
+	})
+
+	t.Run("returns error when todo has been started", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+		require.NoError(t, todo.Start(time.Now()))
+
+		// When
+		err = todo.AssignProject(time.Now(), newKanbanProject(t))
+
+		// Then
+		require.EqualError(t, err, "project_id: only todos that have not been started can be assigned to a project")
+	})
+}
+
+func TestTodo_TransitionTo(t *testing.T) {
+	t.Run("moves a todo without project along the built-in statuses", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+
+		// When
+		err = todo.TransitionTo(time.Now(), "IN_PROGRESS")
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, TodoStatusInProgress, todo.Status())
+		require.Equal(t, project.StatusID("IN_PROGRESS"), todo.StatusID())
+	})
+
+	t.Run("returns validation error for an unknown built-in status", func(t *testing.T) {
+		// Given
+		todo, err := NewTodo(idgen.UUIDv7(), time.Now(), "Test Todo", "")
+		require.NoError(t, err)
+
+		// When
+		err = todo.TransitionTo(time.Now(), "review")
+
+		// Then
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("moves a project todo along the workflow", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+
+		// When
+		require.NoError(t, todo.TransitionTo(time.Now(), "ready"))
+		require.Equal(t, TodoStatusNotStarted, todo.Status())
+		require.NoError(t, todo.TransitionTo(time.Now(), "review"))
+		require.Equal(t, TodoStatusInProgress, todo.Status())
+		err := todo.TransitionTo(time.Now(), "done")
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, project.StatusID("done"), todo.StatusID())
+		require.Equal(t, TodoStatusCompleted, todo.Status())
+		require.NotNil(t, todo.CompletedAt())
+	})
+
+	t.Run("returns state error when the workflow does not allow the transition", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+
+		// When
+		err := todo.TransitionTo(time.Now(), "review")
+
+		// Then
+		var stateErr *StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, "todo cannot change from Backlog to Review", stateErr.Message)
+		require.Equal(t, project.StatusID("backlog"), todo.StatusID())
+	})
+
+	t.Run("returns state error when the todo is already in the status", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+
+		// When
+		err := todo.TransitionTo(time.Now(), "backlog")
+
+		// Then
+		require.EqualError(t, err, "todo is already in Backlog")
+	})
+
+	t.Run("returns validation error for a status outside the workflow", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+
+		// When
+		err := todo.TransitionTo(time.Now(), "IN_PROGRESS")
+
+		// Then
+		require.EqualError(t, err, `status_id: unknown status "IN_PROGRESS" in the project workflow`)
+	})
+
+	t.Run("returns state error when a blocker is unfinished", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+		require.NoError(t, todo.TransitionTo(time.Now(), "ready"))
+		blocker, err := NewTodo(idgen.UUIDv7(), time.Now(), "Blocker", "")
+		require.NoError(t, err)
+		require.NoError(t, todo.AddBlocker(time.Now(), blocker.ID()))
+
+		// When
+		err = todo.TransitionTo(time.Now(), "review", blocker)
+
+		// Then
+		var stateErr *StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, project.StatusID("ready"), todo.StatusID())
+	})
+}
+
+func TestTodo_BuiltInMovesInWorkflow(t *testing.T) {
+	t.Run("start and complete move to the next status in the category", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+		require.NoError(t, todo.TransitionTo(time.Now(), "ready"))
+
+		// When
+		require.NoError(t, todo.Start(time.Now()))
+		require.Equal(t, project.StatusID("review"), todo.StatusID())
+		err := todo.Complete(time.Now())
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, project.StatusID("done"), todo.StatusID())
+		require.True(t, todo.IsCompleted())
+	})
+
+	t.Run("reopen moves back to the todo category", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+		for _, s := range []project.StatusID{"ready", "review", "done"} {
+			require.NoError(t, todo.TransitionTo(time.Now(), s))
+		}
+
+		// When
+		err := todo.Reopen(time.Now())
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, project.StatusID("ready"), todo.StatusID())
+		require.Nil(t, todo.CompletedAt())
+	})
+
+	t.Run("start fails without a transition to the doing category", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+
+		// When
+		err := todo.Start(time.Now())
+
+		// Then
+		require.EqualError(t, err, "project workflow has no transition from Backlog to a doing status")
+	})
+
+	t.Run("cancel is not part of the project workflow", func(t *testing.T) {
+		// Given
+		todo := newTodoInProject(t, newKanbanProject(t))
+
+		// When
+		err := todo.Cancel(time.Now())
+
+		// Then
+		var stateErr *StateError
+		require.ErrorAs(t, err, &stateErr)
+		require.Equal(t, "cancelled is not part of the project workflow", stateErr.Message)
+	})
+
+	t.Run("completing a parent moves open subtasks to the first done status", func(t *testing.T) {
+		// Given
+		p := newKanbanProject(t)
+		parent := newTodoInProject(t, p)
+		for _, s := range []project.StatusID{"ready", "review"} {
+			require.NoError(t, parent.TransitionTo(time.Now(), s))
+		}
+		child := newTodoInProject(t, p)
+		require.NoError(t, child.SetParent(time.Now(), parent, nil, 1))
+
+		// When
+		completed, err := parent.CompleteWithDescendants(time.Now(), []*Todo{child}, true)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, []*Todo{child}, completed)
+		require.True(t, parent.IsCompleted())
+		require.Equal(t, project.StatusID("done"), child.StatusID())
+		require.True(t, child.IsCompleted())
+	})
+}
+
+func TestTodo_SetParent_Project(t *testing.T) {
+	t.Run("returns error when parent belongs to another project", func(t *testing.T) {
+		// Given
+		child := newTodoInProject(t, newKanbanProject(t))
+		parent := newTodoInProject(t, newKanbanProject(t))
+
+		// When
+		err := child.SetParent(time.Now(), parent, nil, 1)
+
+		// Then
+		require.EqualError(t, err, "parent_id: todo and parent must belong to the same project")
+	})
+
+	t.Run("returns error when only the parent belongs to a project", func(t *testing.T) {
+		// Given
+		child, err := NewTodo(idgen.UUIDv7(), time.Now(), "Child", "")
+		require.NoError(t, err)
+		parent := newTodoInProject(t, newKanbanProject(t))
+
+		// When
+		err = child.SetParent(time.Now(), parent, nil, 1)
+
+		// Then
+		require.EqualError(t, err, "parent_id: todo and parent must belong to the same project")
+	})
+}
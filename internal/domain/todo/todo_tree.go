@@ -0,0 +1,70 @@
+package todo
+
+// TodoTree is a Todo together with its subtasks.
+type TodoTree struct {
+	Todo     *Todo
+	Children []*TodoTree
+}
+
+// Progress counts the descendants of a TodoTree.
+type Progress struct {
+	Completed int
+	Total     int
+}
+
+// NewTodoTree builds the tree rooted at root from its descendants.
+// Descendants whose parent is not part of the tree are ignored.
+func NewTodoTree(root *Todo, descendants []*Todo) *TodoTree {
+	tree := &TodoTree{Todo: root}
+	nodes := map[TodoID]*TodoTree{root.id: tree}
+	pending := descendants
+	for len(pending) > 0 {
+		var rest []*Todo
+		for _, d := range pending {
+			if d.parentID == nil {
+				continue
+			}
+			parent, ok := nodes[*d.parentID]
+			if !ok {
+				rest = append(rest, d)
+				continue
+			}
+			node := &TodoTree{Todo: d}
+			parent.Children = append(parent.Children, node)
+			nodes[d.id] = node
+		}
+		if len(rest) == len(pending) {
+			break
+		}
+		pending = rest
+	}
+	return tree
+}
+
+// Progress returns how many of the descendants are completed.
+// Cancelled descendants are left out of the total.
+func (t *TodoTree) Progress() Progress {
+	var p Progress
+	for _, child := range t.Children {
+		childProgress := child.Progress()
+		p.Total += childProgress.Total
+		p.Completed += childProgress.Completed
+		switch {
+		case child.Todo.IsCompleted():
+			p.Total++
+			p.Completed++
+		case !child.Todo.IsCancelled():
+			p.Total++
+		}
+	}
+	return p
+}
+
+// Height returns the number of levels in the tree including the root.
+func (t *TodoTree) Height() int {
+	height := 0
+	for _, child := range t.Children {
+		height = max(height, child.Height())
+	}
+	return height + 1
+}
@@ -0,0 +1,82 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTodoTree(t *testing.T) {
+	newNode := func(status TodoStatus, parent *Todo) *Todo {
+		var parentID *TodoID
+		if parent != nil {
+			id := parent.ID()
+			parentID = &id
+		}
+		return ReconstructTodoWithStatus(
+			uuid.New(),
+			"Todo",
+			"",
+			status,
+			time.Now(),
+			time.Now(),
+			nil,
+			nil,
+			parentID,
+			nil,
+			nil,
+			nil,
+			"",
+		)
+	}
+
+	t.Run("builds a tree with progress counts", func(t *testing.T) {
+		// Given
+		root := newNode(TodoStatusInProgress, nil)
+		child := newNode(TodoStatusInProgress, root)
+		done := newNode(TodoStatusCompleted, root)
+		grandchild := newNode(TodoStatusCompleted, child)
+
+		// When
+		// Descendants are passed out of order on purpose.
+		tree := NewTodoTree(root, []*Todo{grandchild, child, done})
+
+		// Then
+		require.Len(t, tree.Children, 2)
+		require.Equal(t, child, tree.Children[0].Todo)
+		require.Equal(t, grandchild, tree.Children[0].Children[0].Todo)
+		require.Equal(t, Progress{Completed: 2, Total: 3}, tree.Progress())
+		require.Equal(t, Progress{Completed: 1, Total: 1}, tree.Children[0].Progress())
+		require.Equal(t, 3, tree.Height())
+	})
+
+	t.Run("leaves cancelled todos out of the progress total", func(t *testing.T) {
+		// Given
+		root := newNode(TodoStatusInProgress, nil)
+		done := newNode(TodoStatusCompleted, root)
+		cancelled := newNode(TodoStatusCancelled, root)
+
+		// When
+		tree := NewTodoTree(root, []*Todo{done, cancelled})
+
+		// Then
+		require.Len(t, tree.Children, 2)
+		require.Equal(t, Progress{Completed: 1, Total: 1}, tree.Progress())
+	})
+
+	t.Run("ignores todos outside of the tree", func(t *testing.T) {
+		// Given
+		root := newNode(TodoStatusNotStarted, nil)
+		stranger := newNode(TodoStatusNotStarted, newNode(TodoStatusNotStarted, nil))
+
+		// When
+		tree := NewTodoTree(root, []*Todo{stranger})
+
+		// Then
+		require.Empty(t, tree.Children)
+		require.Equal(t, Progress{}, tree.Progress())
+		require.Equal(t, 1, tree.Height())
+	})
+}
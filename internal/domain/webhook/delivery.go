@@ -0,0 +1,218 @@
+package webhook
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+const (
+	// MaxAttempts is the number of times an event is sent to a webhook before the delivery fails.
+	MaxAttempts = 5
+	// InitialBackoff is the wait before the first retry. It doubles with every retry.
+	InitialBackoff = 30 * time.Second
+	// MaxBackoff caps the wait between retries.
+	MaxBackoff = time.Hour
+	// maxErrorLength is the maximum number of bytes kept of the error of an attempt.
+	maxErrorLength = 1024
+)
+
+// DeliveryStatus represents the status of a Delivery.
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending is a delivery waiting for its next attempt.
+	DeliveryStatusPending DeliveryStatus = "PENDING"
+	// DeliveryStatusSucceeded is a delivery the endpoint answered with a 2xx status.
+	DeliveryStatusSucceeded DeliveryStatus = "SUCCEEDED"
+	// DeliveryStatusFailed is a delivery that will not be attempted again.
+	DeliveryStatusFailed DeliveryStatus = "FAILED"
+)
+
+// String returns the string representation of the DeliveryStatus.
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
+
+// Delivery is the entity that represents sending an Event to a Webhook, with the outcome
+// of its last attempt. Failed attempts are retried with an exponential backoff.
+type Delivery struct {
+	id             DeliveryID
+	webhookID      WebhookID
+	eventID        EventID
+	eventType      EventType
+	payload        []byte
+	status         DeliveryStatus
+	attempts       int
+	responseStatus int
+	lastError      string
+	nextAttemptAt  time.Time
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// NewDelivery creates a new pending Delivery of the Event to the Webhook with an ID from ids,
+// created at now and due immediately.
+func NewDelivery(ids idgen.IDGenerator, now time.Time, webhook *Webhook, event *Event) *Delivery {
+	return &Delivery{
+		id:            DeliveryID(ids.NewID()),
+		webhookID:     webhook.ID(),
+		eventID:       event.ID(),
+		eventType:     event.Type(),
+		payload:       event.Payload(),
+		status:        DeliveryStatusPending,
+		nextAttemptAt: now,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+}
+
+// ID returns the ID of the Delivery.
+func (d Delivery) ID() DeliveryID {
+	return d.id
+}
+
+// WebhookID returns the ID of the Webhook the Delivery is sent to.
+func (d Delivery) WebhookID() WebhookID {
+	return d.webhookID
+}
+
+// EventID returns the ID of the delivered Event, which receivers can use to drop duplicates.
+func (d Delivery) EventID() EventID {
+	return d.eventID
+}
+
+// EventType returns the type of the delivered Event.
+func (d Delivery) EventType() EventType {
+	return d.eventType
+}
+
+// Payload returns the JSON body sent to the endpoint.
+func (d Delivery) Payload() []byte {
+	return d.payload
+}
+
+// Status returns the status of the Delivery.
+func (d Delivery) Status() DeliveryStatus {
+	return d.status
+}
+
+// Attempts returns the number of times the Delivery was sent.
+func (d Delivery) Attempts() int {
+	return d.attempts
+}
+
+// ResponseStatus returns the HTTP status of the last response, or 0 when there was none.
+func (d Delivery) ResponseStatus() int {
+	return d.responseStatus
+}
+
+// LastError returns why the last attempt failed, or an empty string.
+func (d Delivery) LastError() string {
+	return d.lastError
+}
+
+// NextAttemptAt returns when a pending Delivery is due.
+func (d Delivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+// CreatedAt returns the created at of the Delivery.
+func (d Delivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// UpdatedAt returns the updated at of the Delivery.
+func (d Delivery) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// Succeeded reports whether the endpoint accepted the Delivery.
+func (d Delivery) Succeeded() bool {
+	return d.status == DeliveryStatusSucceeded
+}
+
+// RecordAttempt records the outcome of sending the Delivery. err is the error of a request
+// that got no response. Only 2xx responses succeed. A failed attempt is retried after
+// Backoff, until MaxAttempts attempts have been made. Pings are never retried.
+// now is when the attempt was made.
+func (d *Delivery) RecordAttempt(now time.Time, responseStatus int, err error) {
+	d.attempts++
+	d.responseStatus = responseStatus
+	d.updatedAt = now
+	switch {
+	case err != nil:
+		d.lastError = truncate(err.Error())
+	case responseStatus < 200 || responseStatus > 299:
+		d.lastError = "unexpected response status"
+	default:
+		d.lastError = ""
+		d.status = DeliveryStatusSucceeded
+		return
+	}
+	if d.eventType == EventPing || d.attempts >= MaxAttempts {
+		d.status = DeliveryStatusFailed
+		return
+	}
+	d.nextAttemptAt = now.Add(Backoff(d.attempts))
+}
+
+// Abandon fails a pending Delivery without sending it, for instance because its Webhook was disabled.
+func (d *Delivery) Abandon(now time.Time, reason string) {
+	d.status = DeliveryStatusFailed
+	d.lastError = truncate(reason)
+	d.updatedAt = now
+}
+
+// Backoff returns the wait after the given number of failed attempts.
+func Backoff(attempts int) time.Duration {
+	backoff := InitialBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= MaxBackoff {
+			return MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// ReconstructDelivery reconstructs a Delivery from the given values.
+func ReconstructDelivery(
+	id uuid.UUID,
+	webhookID WebhookID,
+	eventID EventID,
+	eventType EventType,
+	payload []byte,
+	status DeliveryStatus,
+	attempts int,
+	responseStatus int,
+	lastError string,
+	nextAttemptAt time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Delivery {
+	return &Delivery{
+		id:             DeliveryID(id),
+		webhookID:      webhookID,
+		eventID:        eventID,
+		eventType:      eventType,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		responseStatus: responseStatus,
+		lastError:      lastError,
+		nextAttemptAt:  nextAttemptAt,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+// truncate cuts an error message down to maxErrorLength bytes.
+func truncate(message string) string {
+	if len(message) <= maxErrorLength {
+		return message
+	}
+	return strings.ToValidUTF8(message[:maxErrorLength], "")
+}
@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+// DeliveryID is the identifier for a Delivery.
+type DeliveryID uuid.UUID
+
+// NewDeliveryID creates a new DeliveryID with the default IDGenerator.
+// NewDelivery takes the IDs of new Deliveries from the IDGenerator it is given instead.
+func NewDeliveryID() DeliveryID {
+	return DeliveryID(idgen.UUIDv7().NewID())
+}
+
+// String returns the string representation of the DeliveryID.
+func (id DeliveryID) String() string {
+	return id.UUID().String()
+}
+
+// UUID returns the UUID representation of the DeliveryID.
+func (id DeliveryID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// NewDeliveryIDFromString creates a new DeliveryID from a string.
+func NewDeliveryIDFromString(s string) (DeliveryID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return DeliveryID{}, fmt.Errorf("failed to parse uuid %s: %w", s, err)
+	}
+	return DeliveryID(id), nil
+}
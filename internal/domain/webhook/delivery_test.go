@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDelivery(t *testing.T) *Delivery {
+	t.Helper()
+	webhook, err := NewWebhook(idgen.UUIDv7(), time.Now(), "https://example.com/hooks", []EventType{EventTodoCreated})
+	require.NoError(t, err)
+	created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Buy milk", "")
+	require.NoError(t, err)
+	event, err := NewTodoEvent(idgen.UUIDv7(), history.TodoCreated(idgen.UUIDv7(), time.Now(), "alice", created))
+	require.NoError(t, err)
+	return NewDelivery(idgen.UUIDv7(), time.Now(), webhook, event)
+}
+
+func TestDelivery_RecordAttempt(t *testing.T) {
+	t.Run("succeeds on a 2xx response", func(t *testing.T) {
+		// Given
+		delivery := newTestDelivery(t)
+
+		// When
+		delivery.RecordAttempt(time.Now(), http.StatusNoContent, nil)
+
+		// Then
+		assert.Equal(t, DeliveryStatusSucceeded, delivery.Status())
+		assert.Equal(t, 1, delivery.Attempts())
+		assert.Equal(t, http.StatusNoContent, delivery.ResponseStatus())
+		assert.Empty(t, delivery.LastError())
+	})
+
+	t.Run("schedules a retry after a failed attempt", func(t *testing.T) {
+		// Given
+		delivery := newTestDelivery(t)
+		now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		// When
+		delivery.RecordAttempt(now, http.StatusServiceUnavailable, nil)
+
+		// Then
+		assert.Equal(t, DeliveryStatusPending, delivery.Status())
+		assert.Equal(t, http.StatusServiceUnavailable, delivery.ResponseStatus())
+		assert.Equal(t, "unexpected response status", delivery.LastError())
+		assert.Equal(t, now.Add(InitialBackoff), delivery.NextAttemptAt())
+		assert.Equal(t, now, delivery.UpdatedAt())
+	})
+
+	t.Run("fails after the last attempt", func(t *testing.T) {
+		// Given
+		delivery := newTestDelivery(t)
+
+		// When
+		for range MaxAttempts {
+			delivery.RecordAttempt(time.Now(), 0, errors.New("connection refused"))
+		}
+
+		// Then
+		assert.Equal(t, DeliveryStatusFailed, delivery.Status())
+		assert.Equal(t, MaxAttempts, delivery.Attempts())
+		assert.Zero(t, delivery.ResponseStatus())
+		assert.Equal(t, "connection refused", delivery.LastError())
+	})
+
+	t.Run("does not retry a ping", func(t *testing.T) {
+		// Given
+		webhook, err := NewWebhook(idgen.UUIDv7(), time.Now(), "https://example.com/hooks", []EventType{EventTodoCreated})
+		require.NoError(t, err)
+		event, err := NewPingEvent(idgen.UUIDv7(), time.Now(), webhook.ID())
+		require.NoError(t, err)
+		delivery := NewDelivery(idgen.UUIDv7(), time.Now(), webhook, event)
+
+		// When
+		delivery.RecordAttempt(time.Now(), http.StatusInternalServerError, nil)
+
+		// Then
+		assert.Equal(t, DeliveryStatusFailed, delivery.Status())
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, InitialBackoff, Backoff(1))
+	assert.Equal(t, 2*InitialBackoff, Backoff(2))
+	assert.Equal(t, 8*InitialBackoff, Backoff(4))
+	assert.Equal(t, MaxBackoff, Backoff(100))
+}
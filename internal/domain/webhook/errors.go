@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/domainerr"
+)
+
+// Reasons of the webhook errors, reported to clients as stable error codes
+const (
+	ReasonNotFound        = "WEBHOOK_NOT_FOUND"
+	ReasonInvalidArgument = "WEBHOOK_INVALID_ARGUMENT"
+)
+
+// NotFoundError represents an error when a webhook is not found
+type NotFoundError struct {
+	ID WebhookID
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("webhook not found: %s", e.ID.String())
+}
+
+func (e *NotFoundError) Kind() domainerr.Kind { return domainerr.KindNotFound }
+
+func (e *NotFoundError) Reason() string { return ReasonNotFound }
+
+func (e *NotFoundError) Metadata() map[string]string {
+	return map[string]string{"webhook_id": e.ID.String()}
+}
+
+// ValidationError represents a validation error
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return e.Message
+}
+
+func (e *ValidationError) Kind() domainerr.Kind { return domainerr.KindInvalidArgument }
+
+func (e *ValidationError) Reason() string { return ReasonInvalidArgument }
+
+func (e *ValidationError) FieldViolations() []domainerr.FieldViolation {
+	if e.Field == "" {
+		return nil
+	}
+	return []domainerr.FieldViolation{{Field: e.Field, Description: e.Message}}
+}
+
+// SignatureError represents a signature that does not match the payload
+type SignatureError struct {
+	Message string
+}
+
+func (e *SignatureError) Error() string {
+	return fmt.Sprintf("invalid signature: %s", e.Message)
+}
@@ -0,0 +1,201 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// EventType is the kind of an Event a Webhook subscribes to.
+type EventType string
+
+const (
+	// EventTodoCreated is sent when a todo is created.
+	EventTodoCreated EventType = "todo.created"
+	// EventTodoStarted is sent when a todo moves to IN_PROGRESS.
+	EventTodoStarted EventType = "todo.started"
+	// EventTodoCompleted is sent when a todo moves to COMPLETED.
+	EventTodoCompleted EventType = "todo.completed"
+	// EventTodoUpdated is sent for any other change of a todo.
+	EventTodoUpdated EventType = "todo.updated"
+	// EventTodoDeleted is sent when a todo is deleted.
+	EventTodoDeleted EventType = "todo.deleted"
+	// EventPing is sent by TestWebhook. Webhooks cannot subscribe to it.
+	EventPing EventType = "ping"
+)
+
+// EventTypes are the event types a Webhook can subscribe to.
+var EventTypes = []EventType{
+	EventTodoCreated,
+	EventTodoStarted,
+	EventTodoCompleted,
+	EventTodoUpdated,
+	EventTodoDeleted,
+}
+
+// String returns the string representation of the EventType.
+func (t EventType) String() string {
+	return string(t)
+}
+
+// ParseEventType parses an event type a Webhook can subscribe to.
+func ParseEventType(s string) (EventType, error) {
+	for _, t := range EventTypes {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", &ValidationError{Field: "events", Message: fmt.Sprintf("unknown event type %q", s)}
+}
+
+// Event is something that happened to a todo, waiting to be delivered to the webhooks.
+// The payload is the JSON body sent to the endpoints.
+type Event struct {
+	id         EventID
+	eventType  EventType
+	todoID     todo.TodoID
+	payload    []byte
+	occurredAt time.Time
+}
+
+// eventPayload is the JSON body of an Event.
+type eventPayload struct {
+	ID         string    `json:"id"`
+	Type       EventType `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// todoData is the data of the payload of a todo Event.
+type todoData struct {
+	TodoID    string       `json:"todo_id"`
+	ProjectID *string      `json:"project_id,omitempty"`
+	Version   int          `json:"version"`
+	Actor     string       `json:"actor"`
+	Changes   []changeData `json:"changes"`
+}
+
+// changeData is a changed field in the payload of a todo Event.
+type changeData struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// NewTodoEvent creates the Event of a saved HistoryEntry with an ID from ids.
+// Each entry is one event: a change of the status to IN_PROGRESS or COMPLETED is
+// todo.started or todo.completed, and every other update is todo.updated.
+func NewTodoEvent(ids idgen.IDGenerator, entry *history.HistoryEntry) (*Event, error) {
+	changes := make([]changeData, len(entry.Changes()))
+	for i, change := range entry.Changes() {
+		changes[i] = changeData{Field: change.Field, Before: change.Before, After: change.After}
+	}
+	data := todoData{
+		TodoID:  entry.TodoID().String(),
+		Version: entry.Version(),
+		Actor:   entry.Actor(),
+		Changes: changes,
+	}
+	if entry.ProjectID() != nil {
+		projectID := entry.ProjectID().String()
+		data.ProjectID = &projectID
+	}
+	return newEvent(ids, eventTypeOf(entry), entry.TodoID(), data, entry.OccurredAt())
+}
+
+// NewPingEvent creates the Event sent by TestWebhook with an ID from ids, occurred at now.
+func NewPingEvent(ids idgen.IDGenerator, now time.Time, webhookID WebhookID) (*Event, error) {
+	data := struct {
+		WebhookID string `json:"webhook_id"`
+	}{WebhookID: webhookID.String()}
+	return newEvent(ids, EventPing, todo.TodoID{}, data, now)
+}
+
+func newEvent(ids idgen.IDGenerator, eventType EventType, todoID todo.TodoID, data any, occurredAt time.Time) (*Event, error) {
+	id := EventID(ids.NewID())
+	body, err := json.Marshal(eventPayload{
+		ID:         id.String(),
+		Type:       eventType,
+		OccurredAt: occurredAt.UTC(),
+		Data:       data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload of %s event: %w", eventType, err)
+	}
+	return &Event{
+		id:         id,
+		eventType:  eventType,
+		todoID:     todoID,
+		payload:    body,
+		occurredAt: occurredAt,
+	}, nil
+}
+
+// eventTypeOf returns the event type of a HistoryEntry.
+func eventTypeOf(entry *history.HistoryEntry) EventType {
+	switch entry.Operation() {
+	case history.OperationCreate:
+		return EventTodoCreated
+	case history.OperationDelete:
+		return EventTodoDeleted
+	}
+	for _, change := range entry.Changes() {
+		if change.Field != history.FieldStatus {
+			continue
+		}
+		switch change.After {
+		case todo.TodoStatusInProgress.String():
+			return EventTodoStarted
+		case todo.TodoStatusCompleted.String():
+			return EventTodoCompleted
+		}
+	}
+	return EventTodoUpdated
+}
+
+// ID returns the ID of the Event.
+func (e Event) ID() EventID {
+	return e.id
+}
+
+// Type returns the type of the Event.
+func (e Event) Type() EventType {
+	return e.eventType
+}
+
+// TodoID returns the ID of the Todo the Event is about. It is zero for pings.
+func (e Event) TodoID() todo.TodoID {
+	return e.todoID
+}
+
+// Payload returns the JSON body of the Event.
+func (e Event) Payload() []byte {
+	return e.payload
+}
+
+// OccurredAt returns when the Event happened.
+func (e Event) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// ReconstructEvent reconstructs an Event from the given values.
+func ReconstructEvent(
+	id uuid.UUID,
+	eventType EventType,
+	todoID todo.TodoID,
+	payload []byte,
+	occurredAt time.Time,
+) *Event {
+	return &Event{
+		id:         EventID(id),
+		eventType:  eventType,
+		todoID:     todoID,
+		payload:    payload,
+		occurredAt: occurredAt,
+	}
+}
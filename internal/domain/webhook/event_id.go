@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+// EventID is the identifier for an Event.
+type EventID uuid.UUID
+
+// NewEventID creates a new EventID with the default IDGenerator.
+// NewTodoEvent and NewPingEvent take the IDs of new Events from the IDGenerator they are given instead.
+func NewEventID() EventID {
+	return EventID(idgen.UUIDv7().NewID())
+}
+
+// String returns the string representation of the EventID.
+func (id EventID) String() string {
+	return id.UUID().String()
+}
+
+// UUID returns the UUID representation of the EventID.
+func (id EventID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// NewEventIDFromString creates a new EventID from a string.
+func NewEventIDFromString(s string) (EventID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return EventID{}, fmt.Errorf("failed to parse uuid %s: %w", s, err)
+	}
+	return EventID(id), nil
+}
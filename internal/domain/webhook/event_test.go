@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTodoEvent(t *testing.T) {
+	created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Buy milk", "")
+	require.NoError(t, err)
+	started := *created
+	require.NoError(t, started.Start(time.Now()))
+	completed := started
+	require.NoError(t, completed.Complete(time.Now()))
+	renamed := *created
+	require.NoError(t, renamed.SetTitle(time.Now(), "Buy bread"))
+
+	tests := []struct {
+		name     string
+		entry    *history.HistoryEntry
+		expected EventType
+	}{
+		{name: "created", entry: history.TodoCreated(idgen.UUIDv7(), time.Now(), "alice", created), expected: EventTodoCreated},
+		{name: "started", entry: history.TodoUpdated(idgen.UUIDv7(), time.Now(), "alice", created, &started), expected: EventTodoStarted},
+		{name: "completed", entry: history.TodoUpdated(idgen.UUIDv7(), time.Now(), "alice", &started, &completed), expected: EventTodoCompleted},
+		{name: "updated", entry: history.TodoUpdated(idgen.UUIDv7(), time.Now(), "alice", created, &renamed), expected: EventTodoUpdated},
+		{name: "deleted", entry: history.TodoDeleted(idgen.UUIDv7(), time.Now(), "alice", created), expected: EventTodoDeleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			event, err := NewTodoEvent(idgen.UUIDv7(), tt.entry)
+
+			// Then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, event.Type())
+			assert.Equal(t, created.ID(), event.TodoID())
+
+			var body struct {
+				ID   string `json:"id"`
+				Type string `json:"type"`
+				Data struct {
+					TodoID  string `json:"todo_id"`
+					Actor   string `json:"actor"`
+					Changes []struct {
+						Field string `json:"field"`
+					} `json:"changes"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(event.Payload(), &body))
+			assert.Equal(t, event.ID().String(), body.ID)
+			assert.Equal(t, string(tt.expected), body.Type)
+			assert.Equal(t, created.ID().String(), body.Data.TodoID)
+			assert.Equal(t, "alice", body.Data.Actor)
+			assert.Len(t, body.Data.Changes, len(tt.entry.Changes()))
+		})
+	}
+}
+
+func TestParseEventType(t *testing.T) {
+	eventType, err := ParseEventType("todo.completed")
+	require.NoError(t, err)
+	assert.Equal(t, EventTodoCompleted, eventType)
+
+	_, err = ParseEventType("todo.archived")
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
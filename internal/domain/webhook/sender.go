@@ -0,0 +1,10 @@
+package webhook
+
+import "context"
+
+// Sender posts deliveries to the endpoints of webhooks.
+type Sender interface {
+	// Send posts the payload of the delivery to the URL of the webhook, signed with its secret.
+	// It returns the HTTP status of the response, or an error when no response was received.
+	Send(ctx context.Context, webhook *Webhook, delivery *Delivery) (int, error)
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader is the HTTP header that carries the signature of a delivery.
+const SignatureHeader = "X-Oniongo-Signature"
+
+// Sign returns the value of the SignatureHeader of a payload sent at timestamp:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<payload>" keyed with the secret>".
+// Signing the timestamp lets receivers reject replayed deliveries.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac(secret, t, payload))
+}
+
+// Verify checks a SignatureHeader value against the payload. A positive tolerance also
+// rejects signatures whose timestamp is further than tolerance from now.
+func Verify(secret string, header string, payload []byte, tolerance time.Duration) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil {
+		return &SignatureError{Message: "missing or malformed timestamp"}
+	}
+	signature, err := hex.DecodeString(v1)
+	if err != nil || len(signature) == 0 {
+		return &SignatureError{Message: "missing or malformed v1 signature"}
+	}
+	if !hmac.Equal(signature, mac(secret, t, payload)) {
+		return &SignatureError{Message: "signature does not match the payload"}
+	}
+	if tolerance > 0 {
+		if age := time.Since(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+			return &SignatureError{Message: fmt.Sprintf("timestamp is outside the tolerance of %v", tolerance)}
+		}
+	}
+	return nil
+}
+
+// mac returns the HMAC-SHA256 of the timestamp and the payload.
+func mac(secret string, timestamp string, payload []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(payload)
+	return h.Sum(nil)
+}
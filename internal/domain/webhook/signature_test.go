@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	// Given
+	timestamp := time.Unix(1760000000, 0)
+
+	// When
+	signature := Sign("whsec_test", timestamp, []byte(`{"type":"ping"}`))
+
+	// Then
+	assert.Equal(t, "t=1760000000,v1=5aa19891e0607e571335e9a7a00b5dfa2d6fb7d302b46f94ce8ea85f8d26d7b3", signature)
+}
+
+func TestVerify(t *testing.T) {
+	payload := []byte(`{"type":"ping"}`)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		secret    string
+		header    string
+		tolerance time.Duration
+		valid     bool
+	}{
+		{name: "valid signature", secret: "whsec_test", header: Sign("whsec_test", now, payload), tolerance: time.Minute, valid: true},
+		{name: "wrong secret", secret: "whsec_other", header: Sign("whsec_test", now, payload)},
+		{name: "old timestamp", secret: "whsec_test", header: Sign("whsec_test", now.Add(-time.Hour), payload), tolerance: time.Minute},
+		{name: "old timestamp without tolerance", secret: "whsec_test", header: Sign("whsec_test", now.Add(-time.Hour), payload), valid: true},
+		{name: "missing signature", secret: "whsec_test", header: "t=1760000000"},
+		{name: "empty header", secret: "whsec_test", header: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			err := Verify(tt.secret, tt.header, payload, tt.tolerance)
+
+			// Then
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			var signatureErr *SignatureError
+			require.ErrorAs(t, err, &signatureErr)
+		})
+	}
+}
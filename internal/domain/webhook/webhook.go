@@ -0,0 +1,195 @@
+// Package webhook provides the domain layer for the HTTP endpoints notified of todo events.
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+const (
+	// MaxURLLength is the maximum number of characters in the URL of a webhook.
+	MaxURLLength = 2048
+	// MaxConsecutiveFailures is the number of failed attempts in a row after which a webhook is disabled.
+	MaxConsecutiveFailures = 10
+	// secretPrefix is the prefix of the generated secrets, which tells them apart from other credentials.
+	secretPrefix = "whsec_"
+)
+
+// Webhook is the entity that represents an HTTP endpoint notified of the events it subscribes to.
+// The deliveries are signed with the secret, so that the endpoint can verify them.
+type Webhook struct {
+	id                  WebhookID
+	url                 string
+	secret              string
+	events              []EventType
+	enabled             bool
+	consecutiveFailures int
+	createdAt           time.Time
+	updatedAt           time.Time
+}
+
+// NewWebhook creates a new enabled Webhook with an ID from ids and a random secret, created at now.
+// The events are deduplicated and sorted in the order of EventTypes.
+func NewWebhook(ids idgen.IDGenerator, now time.Time, rawURL string, events []EventType) (*Webhook, error) {
+	if err := validateURL(rawURL); err != nil {
+		return nil, err
+	}
+	events, err := normalizeEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, err
+	}
+	return &Webhook{
+		id:        WebhookID(ids.NewID()),
+		url:       rawURL,
+		secret:    secret,
+		events:    events,
+		enabled:   true,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ID returns the ID of the Webhook.
+func (w Webhook) ID() WebhookID {
+	return w.id
+}
+
+// URL returns the URL the deliveries are posted to.
+func (w Webhook) URL() string {
+	return w.url
+}
+
+// Secret returns the key the deliveries are signed with.
+func (w Webhook) Secret() string {
+	return w.secret
+}
+
+// Events returns the event types the Webhook subscribes to.
+func (w Webhook) Events() []EventType {
+	return w.events
+}
+
+// Enabled reports whether events are delivered to the Webhook.
+func (w Webhook) Enabled() bool {
+	return w.enabled
+}
+
+// ConsecutiveFailures returns the number of failed attempts since the last successful one.
+func (w Webhook) ConsecutiveFailures() int {
+	return w.consecutiveFailures
+}
+
+// CreatedAt returns the created at of the Webhook.
+func (w Webhook) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+// UpdatedAt returns the updated at of the Webhook.
+func (w Webhook) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// Subscribes reports whether events of the type are delivered to the Webhook.
+func (w Webhook) Subscribes(eventType EventType) bool {
+	return w.enabled && slices.Contains(w.events, eventType)
+}
+
+// RecordSuccess resets the failure count after a successful attempt,
+// and enables the Webhook again if it was disabled.
+func (w *Webhook) RecordSuccess(now time.Time) {
+	if w.enabled && w.consecutiveFailures == 0 {
+		return
+	}
+	w.enabled = true
+	w.consecutiveFailures = 0
+	w.updatedAt = now
+}
+
+// RecordFailure counts a failed attempt, and disables the Webhook
+// once MaxConsecutiveFailures attempts in a row have failed.
+func (w *Webhook) RecordFailure(now time.Time) {
+	w.consecutiveFailures++
+	if w.consecutiveFailures >= MaxConsecutiveFailures {
+		w.enabled = false
+	}
+	w.updatedAt = now
+}
+
+// ReconstructWebhook reconstructs a Webhook from the given values.
+func ReconstructWebhook(
+	id uuid.UUID,
+	url string,
+	secret string,
+	events []EventType,
+	enabled bool,
+	consecutiveFailures int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Webhook {
+	return &Webhook{
+		id:                  WebhookID(id),
+		url:                 url,
+		secret:              secret,
+		events:              events,
+		enabled:             enabled,
+		consecutiveFailures: consecutiveFailures,
+		createdAt:           createdAt,
+		updatedAt:           updatedAt,
+	}
+}
+
+// validateURL validates the URL of a webhook.
+func validateURL(rawURL string) error {
+	if rawURL == "" {
+		return &ValidationError{Field: "url", Message: "url must not be empty"}
+	}
+	if len(rawURL) > MaxURLLength {
+		return &ValidationError{Field: "url", Message: "url is too long"}
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &ValidationError{Field: "url", Message: "url must be an absolute http or https URL"}
+	}
+	if u.User != nil {
+		return &ValidationError{Field: "url", Message: "url must not contain credentials"}
+	}
+	return nil
+}
+
+// normalizeEvents validates the event types and sorts them in the order of EventTypes.
+func normalizeEvents(events []EventType) ([]EventType, error) {
+	if len(events) == 0 {
+		return nil, &ValidationError{Field: "events", Message: "at least one event type is required"}
+	}
+	for _, e := range events {
+		if _, err := ParseEventType(string(e)); err != nil {
+			return nil, err
+		}
+	}
+	normalized := make([]EventType, 0, len(events))
+	for _, t := range EventTypes {
+		if slices.Contains(events, t) {
+			normalized = append(normalized, t)
+		}
+	}
+	return normalized, nil
+}
+
+// generateSecret returns a random secret with 256 bits of entropy.
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
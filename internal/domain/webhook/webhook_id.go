@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+)
+
+// WebhookID is the identifier for a Webhook.
+type WebhookID uuid.UUID
+
+// NewWebhookID creates a new WebhookID with the default IDGenerator.
+// NewWebhook takes the IDs of new Webhooks from the IDGenerator it is given instead.
+func NewWebhookID() WebhookID {
+	return WebhookID(idgen.UUIDv7().NewID())
+}
+
+// String returns the string representation of the WebhookID.
+func (id WebhookID) String() string {
+	return id.UUID().String()
+}
+
+// UUID returns the UUID representation of the WebhookID.
+func (id WebhookID) UUID() uuid.UUID {
+	return uuid.UUID(id)
+}
+
+// NewWebhookIDFromString creates a new WebhookID from a string.
+func NewWebhookIDFromString(s string) (WebhookID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return WebhookID{}, fmt.Errorf("failed to parse uuid %s: %w", s, err)
+	}
+	return WebhookID(id), nil
+}
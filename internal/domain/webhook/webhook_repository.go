@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"context"
+	"time"
+)
+
+// WebhookRepository is the interface that wraps the basic CRUD operations for Webhook.
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *Webhook) error
+	FindByID(ctx context.Context, id WebhookID) (*Webhook, error)
+	// FindAll returns every webhook, oldest first.
+	FindAll(ctx context.Context) ([]*Webhook, error)
+	// HasEnabled reports whether any webhook is enabled.
+	HasEnabled(ctx context.Context) (bool, error)
+	Update(ctx context.Context, webhook *Webhook) error
+	// Delete deletes the webhook with its deliveries.
+	Delete(ctx context.Context, id WebhookID) error
+}
+
+// EventRepository is the interface of the queue of events waiting to be delivered.
+// The events are queued in the transaction of the change they are about.
+type EventRepository interface {
+	// Create queues the event.
+	Create(ctx context.Context, event *Event) error
+	// FindPending returns up to limit queued events, oldest first.
+	FindPending(ctx context.Context, limit int) ([]*Event, error)
+	// Delete removes the events from the queue.
+	Delete(ctx context.Context, ids []EventID) error
+}
+
+// DeliveryRepository is the interface that wraps the basic CRUD operations for Delivery.
+type DeliveryRepository interface {
+	Create(ctx context.Context, delivery *Delivery) error
+	Update(ctx context.Context, delivery *Delivery) error
+	// FindDue returns up to limit pending deliveries whose next attempt is due at now, earliest first.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*Delivery, error)
+	// FindByWebhookID returns the deliveries of the webhook, newest first.
+	FindByWebhookID(ctx context.Context, webhookID WebhookID, limit int, offset int) ([]*Delivery, error)
+}
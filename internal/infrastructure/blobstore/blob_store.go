@@ -0,0 +1,39 @@
+// Package blobstore provides the implementations of the attachment BlobStore.
+package blobstore
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/samber/do"
+)
+
+// DefaultDir is the directory of the local blob store when BLOB_DIR is not set.
+const DefaultDir = "db/attachments"
+
+// NewBlobStore creates the BlobStore selected by the BLOB_STORE environment variable.
+//
+//   - local (default): files in BLOB_DIR
+//   - s3: objects in S3_BUCKET of the S3 compatible storage at S3_ENDPOINT,
+//     signed with S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY for S3_REGION
+func NewBlobStore(i *do.Injector) (attachment.BlobStore, error) {
+	switch kind := os.Getenv("BLOB_STORE"); kind {
+	case "", "local":
+		dir := os.Getenv("BLOB_DIR")
+		if dir == "" {
+			dir = DefaultDir
+		}
+		return NewLocalBlobStore(dir)
+	case "s3":
+		return NewS3BlobStore(S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		}, nil)
+	default:
+		return nil, fmt.Errorf("unknown blob store %q", kind)
+	}
+}
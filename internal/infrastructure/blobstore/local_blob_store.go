@@ -0,0 +1,130 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+)
+
+// localBlobStore stores blobs as files in a directory of the local filesystem.
+type localBlobStore struct {
+	dir string
+}
+
+// NewLocalBlobStore creates a BlobStore that keeps blobs in dir.
+// The directory is created when it does not exist.
+func NewLocalBlobStore(dir string) (attachment.BlobStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	return &localBlobStore{dir: dir}, nil
+}
+
+// Put writes the content to a temporary file and renames it to the key,
+// so a failed upload never leaves a partial blob behind.
+func (s *localBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create blob file: %w", err)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	if _, err := io.Copy(f, contextReader{ctx: ctx, r: r}); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(s.dir, key)); err != nil {
+		return fmt.Errorf("failed to store blob: %w", err)
+	}
+	return nil
+}
+
+// Get opens the blob stored under the key.
+func (s *localBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filepath.Join(s.dir, key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, &attachment.BlobNotFoundError{Key: key}
+		}
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+	return f, nil
+}
+
+// Delete removes the blob stored under the key.
+func (s *localBlobStore) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	err := os.Remove(filepath.Join(s.dir, key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+// List returns every blob in the directory.
+// Temporary files of uploads in progress are skipped.
+func (s *localBlobStore) List(ctx context.Context) ([]attachment.BlobInfo, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blobs: %w", err)
+	}
+
+	blobs := make([]attachment.BlobInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list blobs: %w", err)
+		}
+		blobs = append(blobs, attachment.BlobInfo{Key: entry.Name(), ModifiedAt: info.ModTime()})
+	}
+	return blobs, nil
+}
+
+// validateKey rejects keys that would escape the directory of the store.
+func validateKey(key string) error {
+	if key == "" || key == "." || key == ".." || strings.HasPrefix(key, ".") || strings.ContainsAny(key, "/\\\x00") {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	return nil
+}
+
+// contextReader stops reading once the context is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
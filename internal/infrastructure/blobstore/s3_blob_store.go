@@ -0,0 +1,233 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+)
+
+// S3Config is the configuration of an S3 compatible object storage.
+type S3Config struct {
+	// Endpoint is the base URL of the storage, e.g. https://s3.us-east-1.amazonaws.com.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// s3BlobStore stores blobs as objects in a bucket of an S3 compatible object storage.
+// Objects are addressed with path-style URLs, which every S3 compatible storage supports.
+type s3BlobStore struct {
+	endpoint *url.URL
+	bucket   string
+	signer   sigV4Signer
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3BlobStore creates a BlobStore backed by the bucket described by cfg.
+// A nil client falls back to http.DefaultClient.
+func NewS3BlobStore(cfg S3Config, client *http.Client) (attachment.BlobStore, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 endpoint and bucket are required")
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &s3BlobStore{
+		endpoint: endpoint,
+		bucket:   cfg.Bucket,
+		signer: sigV4Signer{
+			accessKeyID:     cfg.AccessKeyID,
+			secretAccessKey: cfg.SecretAccessKey,
+			region:          cfg.Region,
+		},
+		client: client,
+		now:    time.Now,
+	}, nil
+}
+
+// Put uploads the content as an object.
+// The content is spooled to a temporary file first, because the request must be
+// signed with the digest of the payload and sent with its length.
+func (s *s3BlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp("", "oniongo-upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to spool blob: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, hash), contextReader{ctx: ctx, r: r})
+	if err != nil {
+		return fmt.Errorf("failed to spool blob: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to spool blob: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), io.NopCloser(f))
+	if err != nil {
+		return fmt.Errorf("failed to create s3 request: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	res, err := s.do(req, hex.EncodeToString(hash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return s3Error("put", key, res)
+	}
+	return nil
+}
+
+// Get downloads the object stored under the key.
+func (s *s3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 request: %w", err)
+	}
+	res, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res.Body, nil
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, &attachment.BlobNotFoundError{Key: key}
+	default:
+		defer res.Body.Close()
+		return nil, s3Error("get", key, res)
+	}
+}
+
+// Delete removes the object stored under the key.
+func (s *s3BlobStore) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create s3 request: %w", err)
+	}
+	res, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return s3Error("delete", key, res)
+	}
+}
+
+// listBucketResult is the response body of ListObjectsV2.
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List returns every object in the bucket, following the continuation tokens of ListObjectsV2.
+func (s *s3BlobStore) List(ctx context.Context) ([]attachment.BlobInfo, error) {
+	var blobs []attachment.BlobInfo
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		u := *s.endpoint
+		u.Path = s.endpoint.Path + "/" + s.bucket
+		u.RawQuery = query.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create s3 request: %w", err)
+		}
+		res, err := s.do(req, emptyPayloadHash)
+		if err != nil {
+			return nil, err
+		}
+
+		var result listBucketResult
+		if res.StatusCode != http.StatusOK {
+			err = s3Error("list", s.bucket, res)
+		} else if decodeErr := xml.NewDecoder(res.Body).Decode(&result); decodeErr != nil {
+			err = fmt.Errorf("failed to decode s3 list response: %w", decodeErr)
+		}
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range result.Contents {
+			blobs = append(blobs, attachment.BlobInfo{Key: object.Key, ModifiedAt: object.LastModified})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return blobs, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *s3BlobStore) objectURL(key string) string {
+	u := *s.endpoint
+	u.Path = s.endpoint.Path + "/" + s.bucket + "/" + key
+	return u.String()
+}
+
+func (s *s3BlobStore) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	s.signer.sign(req, payloadHash, s.now())
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send s3 request: %w", err)
+	}
+	return res, nil
+}
+
+// s3Error builds an error from an unexpected response, including the start of its body.
+func s3Error(op string, key string, res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+	return fmt.Errorf("s3 %s %s failed with status %d: %s", op, key, res.StatusCode, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,98 @@
+// Package cache provides the stores behind the read-through caches of repositories.
+package cache
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/samber/do"
+)
+
+const (
+	// DefaultSize is the number of entries of the memory store when CACHE_SIZE is not set.
+	DefaultSize = 10000
+	// DefaultTTL is the lifetime of the entries when CACHE_TTL is not set.
+	DefaultTTL = time.Minute
+	// DefaultRedisAddr is the address of the Redis server when REDIS_ADDR is not set.
+	DefaultRedisAddr = "localhost:6379"
+)
+
+// Store is a key-value store whose entries expire after a TTL set when it is created.
+type Store interface {
+	// Get returns the value of the key, or false when the key is missing or expired.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores the value of the key. The store keeps the value, so it must not be modified afterwards.
+	Set(ctx context.Context, key string, value []byte) error
+	// Delete removes the keys. Missing keys are ignored.
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// NewStore creates the Store selected by the CACHE_STORE environment variable.
+// It returns a nil Store when caching is disabled.
+//
+//   - memory (default): an LRU of CACHE_SIZE entries in the process
+//   - redis: the Redis compatible server at REDIS_ADDR, authenticated with REDIS_PASSWORD,
+//     using the database REDIS_DB
+//   - none: no caching
+//
+// The entries of both stores expire after CACHE_TTL.
+func NewStore(i *do.Injector) (Store, error) {
+	ttl, err := durationEnv("CACHE_TTL", DefaultTTL)
+	if err != nil {
+		return nil, err
+	}
+	switch kind := os.Getenv("CACHE_STORE"); kind {
+	case "", "memory":
+		size, err := intEnv("CACHE_SIZE", DefaultSize)
+		if err != nil {
+			return nil, err
+		}
+		return NewMemoryStore(size, ttl), nil
+	case "redis":
+		addr := os.Getenv("REDIS_ADDR")
+		if addr == "" {
+			addr = DefaultRedisAddr
+		}
+		db, err := intEnv("REDIS_DB", 0)
+		if err != nil {
+			return nil, err
+		}
+		return NewRedisStore(RedisConfig{
+			Addr:     addr,
+			Password: os.Getenv("REDIS_PASSWORD"),
+			DB:       db,
+			TTL:      ttl,
+		}), nil
+	case "none":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown cache store %q", kind)
+	}
+}
+
+func durationEnv(name string, fallback time.Duration) (time.Duration, error) {
+	s := os.Getenv(name)
+	if s == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration: %q", name, s)
+	}
+	return d, nil
+}
+
+func intEnv(name string, fallback int) (int, error) {
+	s := os.Getenv(name)
+	if s == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer: %q", name, s)
+	}
+	return n, nil
+}
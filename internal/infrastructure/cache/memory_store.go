@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// MemoryStore is a Store that keeps the most recently used entries in the process.
+type MemoryStore struct {
+	lru *expirable.LRU[string, []byte]
+}
+
+// NewMemoryStore creates a MemoryStore of up to size entries that expire after ttl.
+func NewMemoryStore(size int, ttl time.Duration) *MemoryStore {
+	return &MemoryStore{lru: expirable.NewLRU[string, []byte](size, nil, ttl)}
+}
+
+// Get returns the value of the key, or false when the key is missing or expired.
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	value, ok := s.lru.Get(key)
+	return value, ok, nil
+}
+
+// Set stores the value of the key, evicting the least recently used entry when the store is full.
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte) error {
+	s.lru.Add(key, value)
+	return nil
+}
+
+// Delete removes the keys.
+func (s *MemoryStore) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		s.lru.Remove(key)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("evicts the least recently used entry", func(t *testing.T) {
+		// Given
+		store := NewMemoryStore(2, time.Minute)
+		require.NoError(t, store.Set(ctx, "a", []byte("1")))
+		require.NoError(t, store.Set(ctx, "b", []byte("2")))
+		_, _, _ = store.Get(ctx, "a")
+
+		// When
+		require.NoError(t, store.Set(ctx, "c", []byte("3")))
+
+		// Then
+		_, okA, _ := store.Get(ctx, "a")
+		_, okB, _ := store.Get(ctx, "b")
+		_, okC, _ := store.Get(ctx, "c")
+		assert.True(t, okA)
+		assert.False(t, okB)
+		assert.True(t, okC)
+	})
+
+	t.Run("entries expire after the TTL", func(t *testing.T) {
+		// Given
+		store := NewMemoryStore(10, 20*time.Millisecond)
+		require.NoError(t, store.Set(ctx, "a", []byte("1")))
+
+		// When
+		time.Sleep(50 * time.Millisecond)
+		_, ok, _ := store.Get(ctx, "a")
+
+		// Then
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRedisTimeout bounds each command sent to Redis when the context has no earlier deadline.
+	DefaultRedisTimeout = time.Second
+	// maxIdleConns is the number of connections kept open between commands.
+	maxIdleConns = 8
+)
+
+// RedisConfig is the configuration of a RedisStore.
+type RedisConfig struct {
+	// Addr is the host:port of the server.
+	Addr     string
+	Password string
+	DB       int
+	// TTL is the lifetime of the entries.
+	TTL time.Duration
+	// Timeout bounds each command. DefaultRedisTimeout is used when it is zero.
+	Timeout time.Duration
+}
+
+// RedisStore is a Store on a Redis compatible server, shared by every process that uses it.
+// It speaks the RESP protocol with the GET, SET and DEL commands only.
+type RedisStore struct {
+	cfg  RedisConfig
+	mu   sync.Mutex
+	idle []*redisConn
+}
+
+type redisConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	w    *bufio.Writer
+}
+
+// NewRedisStore creates a RedisStore. Connections are opened on first use.
+func NewRedisStore(cfg RedisConfig) *RedisStore {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultRedisTimeout
+	}
+	return &RedisStore{cfg: cfg}
+}
+
+// Get returns the value of the key, or false when the key is missing or expired.
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := s.do(ctx, "GET", key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected reply to GET: %v", reply)
+	}
+	return value, true, nil
+}
+
+// Set stores the value of the key with the TTL of the store.
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte) error {
+	_, err := s.do(ctx, "SET", key, string(value), "PX", strconv.FormatInt(s.cfg.TTL.Milliseconds(), 10))
+	return err
+}
+
+// Delete removes the keys.
+func (s *RedisStore) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := s.do(ctx, "DEL", keys...)
+	return err
+}
+
+// Shutdown closes the idle connections. It is called by the injector on shutdown.
+func (s *RedisStore) Shutdown() error {
+	s.mu.Lock()
+	idle := s.idle
+	s.idle = nil
+	s.mu.Unlock()
+	for _, c := range idle {
+		_ = c.conn.Close()
+	}
+	return nil
+}
+
+// do sends a command and returns its reply. An error reply is returned as a *RedisError.
+func (s *RedisStore) do(ctx context.Context, name string, args ...string) (any, error) {
+	c, err := s.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := c.do(ctx, s.cfg.Timeout, append([]string{name}, args...)...)
+	if err != nil {
+		// The connection may be in the middle of a reply, so it is not reused.
+		_ = c.conn.Close()
+		return nil, fmt.Errorf("redis: %s failed: %w", name, err)
+	}
+	s.release(c)
+	if redisErr, ok := reply.(*RedisError); ok {
+		return nil, redisErr
+	}
+	return reply, nil
+}
+
+// conn returns an idle connection, or dials a new one.
+func (s *RedisStore) conn(ctx context.Context) (*redisConn, error) {
+	s.mu.Lock()
+	if n := len(s.idle); n > 0 {
+		c := s.idle[n-1]
+		s.idle = s.idle[:n-1]
+		s.mu.Unlock()
+		return c, nil
+	}
+	s.mu.Unlock()
+
+	dialer := net.Dialer{Timeout: s.cfg.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("redis: failed to connect to %s: %w", s.cfg.Addr, err)
+	}
+	c := &redisConn{conn: conn, r: bufio.NewReader(conn), w: bufio.NewWriter(conn)}
+	if s.cfg.Password != "" {
+		if err := c.expectOK(ctx, s.cfg.Timeout, "AUTH", s.cfg.Password); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	if s.cfg.DB != 0 {
+		if err := c.expectOK(ctx, s.cfg.Timeout, "SELECT", strconv.Itoa(s.cfg.DB)); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (s *RedisStore) release(c *redisConn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.idle) >= maxIdleConns {
+		_ = c.conn.Close()
+		return
+	}
+	s.idle = append(s.idle, c)
+}
+
+func (c *redisConn) do(ctx context.Context, timeout time.Duration, args ...string) (any, error) {
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := c.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	if err := writeCommand(c.w, args...); err != nil {
+		return nil, err
+	}
+	return readReply(c.r)
+}
+
+func (c *redisConn) expectOK(ctx context.Context, timeout time.Duration, args ...string) error {
+	reply, err := c.do(ctx, timeout, args...)
+	if err != nil {
+		return fmt.Errorf("redis: %s failed: %w", args[0], err)
+	}
+	if redisErr, ok := reply.(*RedisError); ok {
+		return redisErr
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis is an embedded server that understands the commands used by RedisStore.
+type fakeRedis struct {
+	password string
+
+	mu      sync.Mutex
+	values  map[string][]byte
+	expires map[string]time.Time
+	conns   int
+}
+
+func startFakeRedis(t *testing.T, password string) (*fakeRedis, string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	f := &fakeRedis{password: password, values: map[string][]byte{}, expires: map[string]time.Time{}}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			f.mu.Lock()
+			f.conns++
+			f.mu.Unlock()
+			go f.serve(conn)
+		}
+	}()
+	return f, listener.Addr().String()
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r, w := bufio.NewReader(conn), bufio.NewWriter(conn)
+	authenticated := f.password == ""
+	for {
+		reply, err := readReply(r)
+		if err != nil {
+			return
+		}
+		items, _ := reply.([]any)
+		args := make([]string, len(items))
+		for i, item := range items {
+			b, _ := item.([]byte)
+			args[i] = string(b)
+		}
+		switch cmd := strings.ToUpper(args[0]); {
+		case cmd == "AUTH":
+			authenticated = args[1] == f.password
+			if !authenticated {
+				w.WriteString("-WRONGPASS invalid password\r\n")
+			} else {
+				w.WriteString("+OK\r\n")
+			}
+		case !authenticated:
+			w.WriteString("-NOAUTH Authentication required.\r\n")
+		case cmd == "SELECT":
+			w.WriteString("+OK\r\n")
+		case cmd == "GET":
+			if value, ok := f.get(args[1]); ok {
+				w.WriteString("$" + strconv.Itoa(len(value)) + "\r\n" + string(value) + "\r\n")
+			} else {
+				w.WriteString("$-1\r\n")
+			}
+		case cmd == "SET":
+			ttl, _ := strconv.Atoi(args[4])
+			f.mu.Lock()
+			f.values[args[1]] = []byte(args[2])
+			f.expires[args[1]] = time.Now().Add(time.Duration(ttl) * time.Millisecond)
+			f.mu.Unlock()
+			w.WriteString("+OK\r\n")
+		case cmd == "DEL":
+			f.mu.Lock()
+			n := 0
+			for _, key := range args[1:] {
+				if _, ok := f.values[key]; ok {
+					n++
+				}
+				delete(f.values, key)
+			}
+			f.mu.Unlock()
+			w.WriteString(":" + strconv.Itoa(n) + "\r\n")
+		default:
+			w.WriteString("-ERR unknown command '" + args[0] + "'\r\n")
+		}
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeRedis) get(key string) ([]byte, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	value, ok := f.values[key]
+	if ok && time.Now().After(f.expires[key]) {
+		delete(f.values, key)
+		return nil, false
+	}
+	return value, ok
+}
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores, reads and deletes values", func(t *testing.T) {
+		// Given
+		fake, addr := startFakeRedis(t, "")
+		store := NewRedisStore(RedisConfig{Addr: addr, TTL: time.Minute})
+		t.Cleanup(func() { _ = store.Shutdown() })
+
+		// When
+		require.NoError(t, store.Set(ctx, "a", []byte("value\r\nwith a line break")))
+		require.NoError(t, store.Set(ctx, "b", []byte("")))
+		a, okA, errA := store.Get(ctx, "a")
+		b, okB, errB := store.Get(ctx, "b")
+		_, okMissing, errMissing := store.Get(ctx, "missing")
+
+		// Then
+		require.NoError(t, errA)
+		require.NoError(t, errB)
+		require.NoError(t, errMissing)
+		assert.True(t, okA)
+		assert.Equal(t, "value\r\nwith a line break", string(a))
+		assert.True(t, okB)
+		assert.Empty(t, b)
+		assert.False(t, okMissing)
+
+		// When
+		require.NoError(t, store.Delete(ctx, "a", "b"))
+		_, okA, _ = store.Get(ctx, "a")
+
+		// Then
+		assert.False(t, okA)
+		fake.mu.Lock()
+		assert.Equal(t, 1, fake.conns, "the connection is reused")
+		fake.mu.Unlock()
+	})
+
+	t.Run("values expire after the TTL", func(t *testing.T) {
+		// Given
+		_, addr := startFakeRedis(t, "")
+		store := NewRedisStore(RedisConfig{Addr: addr, TTL: 20 * time.Millisecond})
+		require.NoError(t, store.Set(ctx, "a", []byte("1")))
+
+		// When
+		time.Sleep(50 * time.Millisecond)
+		_, ok, err := store.Get(ctx, "a")
+
+		// Then
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("authenticates with the password", func(t *testing.T) {
+		// Given
+		_, addr := startFakeRedis(t, "secret")
+
+		// When
+		_, _, errWrong := NewRedisStore(RedisConfig{Addr: addr, Password: "wrong", TTL: time.Minute}).Get(ctx, "a")
+		_, _, errMissing := NewRedisStore(RedisConfig{Addr: addr, TTL: time.Minute}).Get(ctx, "a")
+		_, _, errRight := NewRedisStore(RedisConfig{Addr: addr, Password: "secret", DB: 1, TTL: time.Minute}).Get(ctx, "a")
+
+		// Then
+		var redisErr *RedisError
+		require.ErrorAs(t, errWrong, &redisErr)
+		assert.Contains(t, redisErr.Message, "WRONGPASS")
+		require.ErrorAs(t, errMissing, &redisErr)
+		assert.Contains(t, redisErr.Message, "NOAUTH")
+		assert.NoError(t, errRight)
+	})
+
+	t.Run("fails when the server is unreachable", func(t *testing.T) {
+		// Given
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := listener.Addr().String()
+		require.NoError(t, listener.Close())
+		store := NewRedisStore(RedisConfig{Addr: addr, TTL: time.Minute, Timeout: 100 * time.Millisecond})
+
+		// When
+		_, _, err = store.Get(ctx, "a")
+
+		// Then
+		assert.Error(t, err)
+	})
+}
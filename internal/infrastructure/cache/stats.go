@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"sync/atomic"
+
+	"github.com/samber/do"
+)
+
+// Stats counts the lookups of a cache. It is safe for concurrent use.
+type Stats struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+	errors atomic.Uint64
+}
+
+// NewStats creates the Stats shared by the caches of the injector.
+func NewStats(i *do.Injector) (*Stats, error) {
+	return &Stats{}, nil
+}
+
+// Hit counts a lookup answered by the cache.
+func (s *Stats) Hit() {
+	s.hits.Add(1)
+}
+
+// Miss counts a lookup that had to be answered by the database.
+func (s *Stats) Miss() {
+	s.misses.Add(1)
+}
+
+// Error counts a failed operation of the store.
+func (s *Stats) Error() {
+	s.errors.Add(1)
+}
+
+// Hits returns the number of lookups answered by the cache.
+func (s *Stats) Hits() uint64 {
+	return s.hits.Load()
+}
+
+// Misses returns the number of lookups answered by the database.
+func (s *Stats) Misses() uint64 {
+	return s.misses.Load()
+}
+
+// Errors returns the number of failed operations of the store.
+func (s *Stats) Errors() uint64 {
+	return s.errors.Load()
+}
+
+// Map returns the counters and the hit ratio, in a form that can be published with expvar.
+func (s *Stats) Map() map[string]any {
+	hits, misses := s.Hits(), s.Misses()
+	ratio := 0.0
+	if hits+misses > 0 {
+		ratio = float64(hits) / float64(hits+misses)
+	}
+	return map[string]any{
+		"hits":      hits,
+		"misses":    misses,
+		"errors":    s.Errors(),
+		"hit_ratio": ratio,
+	}
+}
@@ -1,10 +1,38 @@
 package di
 
 import (
+	"github.com/iktakahiro/oniongo/internal/api/graphql"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/attachmenthandler"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/commenthandler"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/databasehandler"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/historyhandler"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/projecthandler"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/taghandler"
 	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/todohandler"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/todov2handler"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/transferhandler"
+	"github.com/iktakahiro/oniongo/internal/api/grpc/handler/webhookhandler"
+	"github.com/iktakahiro/oniongo/internal/application/attachmentapp"
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
+	"github.com/iktakahiro/oniongo/internal/application/databaseapp"
+	"github.com/iktakahiro/oniongo/internal/application/historyapp"
+	"github.com/iktakahiro/oniongo/internal/application/projectapp"
+	"github.com/iktakahiro/oniongo/internal/application/tagapp"
 	"github.com/iktakahiro/oniongo/internal/application/todoapp"
+	"github.com/iktakahiro/oniongo/internal/application/transferapp"
+	"github.com/iktakahiro/oniongo/internal/application/webhookapp"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/blobstore"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/cache"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/attachmentrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/commentrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/externalrefrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/historyrepo"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/todorepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/webhookrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/timetravel"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/webhooksender"
 	"github.com/samber/do"
 )
 
@@ -13,20 +41,104 @@ func DependencyInjection() *do.Injector {
 
 	do.Provide(injector, db.NewEntTransactionRunner)
 
+	// Clock and ID generator of the domain
+	do.Provide(injector, timetravel.NewClock)
+	do.ProvideValue(injector, idgen.UUIDv7())
+
 	// Repositories
-	do.Provide(injector, todorepo.NewTodoRepository)
+	do.Provide(injector, todorepo.NewCachedTodoRepository)
+	do.Provide(injector, todorepo.NewTodoSearchRepository)
+	do.Provide(injector, todorepo.NewTagRepositoryWithTodoCache)
+	do.Provide(injector, todorepo.NewProjectRepositoryWithTodoCache)
+	do.Provide(injector, commentrepo.NewCommentRepository)
+	do.Provide(injector, attachmentrepo.NewAttachmentRepository)
+	do.Provide(injector, historyrepo.NewHistoryRepository)
+	do.Provide(injector, externalrefrepo.NewExternalRefRepository)
+	do.Provide(injector, webhookrepo.NewWebhookRepository)
+	do.Provide(injector, webhookrepo.NewEventRepository)
+	do.Provide(injector, webhookrepo.NewDeliveryRepository)
+
+	// Caches
+	do.Provide(injector, cache.NewStore)
+	do.Provide(injector, cache.NewStats)
+
+	// Blob stores
+	do.Provide(injector, blobstore.NewBlobStore)
+
+	// Webhook senders
+	do.Provide(injector, webhooksender.NewSender)
+
+	// Webhook events of the todo history
+	do.Provide(injector, webhookapp.NewTodoEventQueue)
+
+	// Database backups
+	do.Provide(injector, db.NewBackuper)
 
 	// UseCases
 	do.Provide(injector, todoapp.NewCreateTodoUseCase)
 	do.Provide(injector, todoapp.NewGetTodoUseCase)
+	do.Provide(injector, todoapp.NewGetTodoVersionUseCase)
 	do.Provide(injector, todoapp.NewGetTodosUseCase)
+	do.Provide(injector, todoapp.NewSearchTodosUseCase)
 	do.Provide(injector, todoapp.NewUpdateTodoUseCase)
 	do.Provide(injector, todoapp.NewStartTodoUseCase)
 	do.Provide(injector, todoapp.NewCompleteTodoUseCase)
+	do.Provide(injector, todoapp.NewReopenTodoUseCase)
+	do.Provide(injector, todoapp.NewCancelTodoUseCase)
+	do.Provide(injector, todoapp.NewPauseTodoUseCase)
+	do.Provide(injector, todoapp.NewResumeTodoUseCase)
+	do.Provide(injector, todoapp.NewTransitionTodoUseCase)
+	do.Provide(injector, todoapp.NewRevertTodoUseCase)
 	do.Provide(injector, todoapp.NewDeleteTodoUseCase)
+	do.Provide(injector, todoapp.NewAddTodoTagUseCase)
+	do.Provide(injector, todoapp.NewRemoveTodoTagUseCase)
+	do.Provide(injector, todoapp.NewMoveTodoUseCase)
+	do.Provide(injector, todoapp.NewGetTodoTreeUseCase)
+	do.Provide(injector, todoapp.NewAddDependencyUseCase)
+	do.Provide(injector, todoapp.NewRemoveDependencyUseCase)
+	do.Provide(injector, tagapp.NewCreateTagUseCase)
+	do.Provide(injector, tagapp.NewListTagsUseCase)
+	do.Provide(injector, tagapp.NewRenameTagUseCase)
+	do.Provide(injector, tagapp.NewDeleteTagUseCase)
+	do.Provide(injector, projectapp.NewCreateProjectUseCase)
+	do.Provide(injector, projectapp.NewListProjectsUseCase)
+	do.Provide(injector, projectapp.NewGetProjectUseCase)
+	do.Provide(injector, projectapp.NewDeleteProjectUseCase)
+	do.Provide(injector, commentapp.NewAddCommentUseCase)
+	do.Provide(injector, commentapp.NewEditCommentUseCase)
+	do.Provide(injector, commentapp.NewDeleteCommentUseCase)
+	do.Provide(injector, commentapp.NewListCommentsUseCase)
+	do.Provide(injector, commentapp.NewCountCommentsUseCase)
+	do.Provide(injector, attachmentapp.NewUploadAttachmentUseCase)
+	do.Provide(injector, attachmentapp.NewDownloadAttachmentUseCase)
+	do.Provide(injector, attachmentapp.NewListAttachmentsUseCase)
+	do.Provide(injector, attachmentapp.NewDeleteAttachmentUseCase)
+	do.Provide(injector, attachmentapp.NewCollectGarbageUseCase)
+	do.Provide(injector, historyapp.NewGetTodoHistoryUseCase)
+	do.Provide(injector, historyapp.NewListActivityUseCase)
+	do.Provide(injector, transferapp.NewExportTodosUseCase)
+	do.Provide(injector, transferapp.NewImportTodosUseCase)
+	do.Provide(injector, transferapp.NewImportFromSourceUseCase)
+	do.Provide(injector, webhookapp.NewCreateWebhookUseCase)
+	do.Provide(injector, webhookapp.NewListWebhooksUseCase)
+	do.Provide(injector, webhookapp.NewDeleteWebhookUseCase)
+	do.Provide(injector, webhookapp.NewTestWebhookUseCase)
+	do.Provide(injector, webhookapp.NewListDeliveriesUseCase)
+	do.Provide(injector, webhookapp.NewDispatchWebhooksUseCase)
+	do.Provide(injector, databaseapp.NewBackupDatabaseUseCase)
 
 	// Handlers
 	do.Provide(injector, todohandler.NewTodoServiceHandler)
+	do.Provide(injector, todov2handler.NewTodoServiceHandler)
+	do.Provide(injector, taghandler.NewTagServiceHandler)
+	do.Provide(injector, projecthandler.NewProjectServiceHandler)
+	do.Provide(injector, commenthandler.NewCommentServiceHandler)
+	do.Provide(injector, attachmenthandler.NewAttachmentServiceHandler)
+	do.Provide(injector, historyhandler.NewHistoryServiceHandler)
+	do.Provide(injector, transferhandler.NewTransferServiceHandler)
+	do.Provide(injector, webhookhandler.NewWebhookServiceHandler)
+	do.Provide(injector, databasehandler.NewDatabaseServiceHandler)
+	do.Provide(injector, graphql.NewResolver)
 
 	return injector
 }
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/iktakahiro/oniongo/internal/application/databaseapp"
+	"github.com/mattn/go-sqlite3"
+	"github.com/samber/do"
+)
+
+// backuper is the implementation of the Backuper interface with Backup.
+type backuper struct{}
+
+// NewBackuper creates a new Backuper of the database of GetClient.
+func NewBackuper(i *do.Injector) (databaseapp.Backuper, error) {
+	return &backuper{}, nil
+}
+
+// Backup writes a snapshot of the database to w.
+func (backuper) Backup(ctx context.Context, w io.Writer) error {
+	return Backup(ctx, w)
+}
+
+// Backup writes a consistent snapshot of the database to w while it is in use.
+//
+// The snapshot is copied with the online backup API of SQLite into a temporary file,
+// in a single step that holds a read transaction, so writers are not blocked in WAL mode.
+// The temporary file is then copied to w and removed.
+func Backup(ctx context.Context, w io.Writer) (err error) {
+	if _, err := GetClient(); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp("", "oniongo-backup-*.db")
+	if err != nil {
+		return fmt.Errorf("failed to create backup file: %w", err)
+	}
+	path := f.Name()
+	defer func() {
+		err = errors.Join(err, f.Close(), os.Remove(path))
+	}()
+
+	if err := backupTo(ctx, backupSource, path); err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return nil
+}
+
+// backupTo copies the database of src to the SQLite database at path.
+func backupTo(ctx context.Context, src *stdsql.DB, path string) error {
+	dest, err := stdsql.Open("sqlite3", path)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+	destConn, err := dest.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer destConn.Close()
+	srcConn, err := src.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer srcConn.Close()
+
+	return destConn.Raw(func(destDriverConn any) error {
+		return srcConn.Raw(func(srcDriverConn any) error {
+			destSQLite, ok := destDriverConn.(*sqlite3.SQLiteConn)
+			if !ok {
+				return errors.New("backup destination is not a SQLite connection")
+			}
+			srcSQLite, ok := srcDriverConn.(*sqlite3.SQLiteConn)
+			if !ok {
+				return errors.New("database is not a SQLite connection")
+			}
+			b, err := destSQLite.Backup("main", srcSQLite, "main")
+			if err != nil {
+				return err
+			}
+			// A step of -1 copies every page at once, so the snapshot is consistent.
+			if _, err := b.Step(-1); err != nil {
+				return errors.Join(err, b.Finish())
+			}
+			return b.Finish()
+		})
+	})
+}
@@ -0,0 +1,121 @@
+package db
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Defaults of the settings of the SQLite database.
+const (
+	DefaultPath            = "db/dev.db"
+	DefaultJournalMode     = "WAL"
+	DefaultSynchronous     = "NORMAL"
+	DefaultBusyTimeout     = 5 * time.Second
+	DefaultMaxReadConns    = 4
+	DefaultConnMaxIdleTime = 5 * time.Minute
+)
+
+var (
+	journalModes     = []string{"DELETE", "TRUNCATE", "PERSIST", "MEMORY", "WAL", "OFF"}
+	synchronousModes = []string{"OFF", "NORMAL", "FULL", "EXTRA"}
+)
+
+// Config is the configuration of the SQLite database.
+type Config struct {
+	// Path is the file of the database.
+	Path string
+	// JournalMode is the journal_mode pragma. WAL lets the readers run during a write.
+	JournalMode string
+	// Synchronous is the synchronous pragma. NORMAL is durable enough with WAL,
+	// where a power loss may only roll back the last transactions.
+	Synchronous string
+	// BusyTimeout is how long a connection waits for a lock held by another process.
+	BusyTimeout time.Duration
+	// MaxReadConns is the size of the pool of read-only connections.
+	// Writes always go through a single connection.
+	MaxReadConns int
+	// ConnMaxIdleTime is how long an unused read-only connection is kept open.
+	ConnMaxIdleTime time.Duration
+}
+
+// LoadConfig reads the configuration from the SQLITE_PATH, SQLITE_JOURNAL_MODE,
+// SQLITE_SYNCHRONOUS, SQLITE_BUSY_TIMEOUT, SQLITE_MAX_READ_CONNS and
+// SQLITE_CONN_MAX_IDLE_TIME environment variables, using the defaults for the missing ones.
+func LoadConfig() (Config, error) {
+	c := Config{
+		Path:        os.Getenv("SQLITE_PATH"),
+		JournalMode: strings.ToUpper(os.Getenv("SQLITE_JOURNAL_MODE")),
+		Synchronous: strings.ToUpper(os.Getenv("SQLITE_SYNCHRONOUS")),
+	}
+	if c.Path == "" {
+		c.Path = DefaultPath
+	}
+	if c.JournalMode == "" {
+		c.JournalMode = DefaultJournalMode
+	}
+	if !slices.Contains(journalModes, c.JournalMode) {
+		return Config{}, fmt.Errorf("SQLITE_JOURNAL_MODE must be one of %s: %q", strings.Join(journalModes, ", "), c.JournalMode)
+	}
+	if c.Synchronous == "" {
+		c.Synchronous = DefaultSynchronous
+	}
+	if !slices.Contains(synchronousModes, c.Synchronous) {
+		return Config{}, fmt.Errorf("SQLITE_SYNCHRONOUS must be one of %s: %q", strings.Join(synchronousModes, ", "), c.Synchronous)
+	}
+
+	var err error
+	if c.BusyTimeout, err = durationEnv("SQLITE_BUSY_TIMEOUT", DefaultBusyTimeout); err != nil {
+		return Config{}, err
+	}
+	if c.ConnMaxIdleTime, err = durationEnv("SQLITE_CONN_MAX_IDLE_TIME", DefaultConnMaxIdleTime); err != nil {
+		return Config{}, err
+	}
+	if s := os.Getenv("SQLITE_MAX_READ_CONNS"); s != "" {
+		if c.MaxReadConns, err = strconv.Atoi(s); err != nil || c.MaxReadConns < 1 {
+			return Config{}, fmt.Errorf("SQLITE_MAX_READ_CONNS must be a positive integer: %q", s)
+		}
+	} else {
+		c.MaxReadConns = DefaultMaxReadConns
+	}
+	return c, nil
+}
+
+// writeDSN is the data source of the write connection. Its transactions take the write
+// lock when they begin, so that they wait for each other with the busy timeout instead
+// of failing when a read turns into a write.
+func (c Config) writeDSN() string {
+	params := url.Values{}
+	params.Set("_fk", "1")
+	params.Set("_journal_mode", c.JournalMode)
+	params.Set("_synchronous", c.Synchronous)
+	params.Set("_busy_timeout", strconv.FormatInt(c.BusyTimeout.Milliseconds(), 10))
+	params.Set("_txlock", "immediate")
+	return "file:" + c.Path + "?" + params.Encode()
+}
+
+// readDSN is the data source of the read-only connections.
+// The journal mode is kept in the database file, so it is set by the write connection only.
+func (c Config) readDSN() string {
+	params := url.Values{}
+	params.Set("_fk", "1")
+	params.Set("_busy_timeout", strconv.FormatInt(c.BusyTimeout.Milliseconds(), 10))
+	params.Set("_query_only", "1")
+	return "file:" + c.Path + "?" + params.Encode()
+}
+
+func durationEnv(name string, fallback time.Duration) (time.Duration, error) {
+	s := os.Getenv(name)
+	if s == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative duration: %q", name, s)
+	}
+	return d, nil
+}
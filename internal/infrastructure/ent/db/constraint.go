@@ -0,0 +1,37 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// IsUniqueViolation reports whether err is the violation of a primary key or a unique index,
+// from SQLite or reported with a SQLSTATE by a driver such as pgx.
+func IsUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique ||
+			sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+	}
+	return sqlState(err) == "23505"
+}
+
+// IsForeignKeyViolation reports whether err is the violation of a foreign key,
+// from SQLite or reported with a SQLSTATE by a driver such as pgx.
+func IsForeignKeyViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintForeignKey
+	}
+	return sqlState(err) == "23503"
+}
+
+// sqlState returns the SQLSTATE of err, or an empty string when the driver does not report one.
+func sqlState(err error) string {
+	var stateErr interface{ SQLState() string }
+	if errors.As(err, &stateErr) {
+		return stateErr.SQLState()
+	}
+	return ""
+}
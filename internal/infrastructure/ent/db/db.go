@@ -2,42 +2,136 @@ package db
 
 import (
 	"context"
+	stdsql "database/sql"
+	"strings"
 	"sync"
 
+	atlasmigrate "ariga.io/atlas/sql/migrate"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	entschema "entgo.io/ent/dialect/sql/schema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/hook"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/migrate"
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// inMemory makes GetClient open a database that lives in memory.
+var inMemory bool
+
 var (
 	clientInstance *entgen.Client
 	clientOnce     sync.Once
 	clientErr      error
+	// backupSource is the pool the backups read the database from.
+	backupSource *stdsql.DB
 )
 
-// GetClient returns a singleton instance of the database client
+// GetClient returns a singleton instance of the database client,
+// configured by LoadConfig unless UseInMemoryDatabase was called.
 func GetClient() (*entgen.Client, error) {
 	if clientInstance != nil {
 		return clientInstance, nil
 	}
 
 	clientOnce.Do(func() {
-		clientInstance, clientErr = entgen.Open(
-			"sqlite3",
-			"file:db/dev.db?_fk=1",
-		)
+		if inMemory {
+			clientInstance, backupSource, clientErr = openInMemory()
+		} else {
+			var config Config
+			if config, clientErr = LoadConfig(); clientErr == nil {
+				clientInstance, backupSource, clientErr = open(config)
+			}
+		}
+		if clientErr == nil {
+			// History entries are an audit trail, so they are never changed once written.
+			clientInstance.TodoHistorySchema.Use(
+				hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne),
+			)
+		}
 	})
 	return clientInstance, clientErr
 }
 
+// open opens the database file with a write connection and a pool of read-only connections,
+// which it also returns to take the backups from.
+func open(config Config) (*entgen.Client, *stdsql.DB, error) {
+	write, err := entsql.Open(dialect.SQLite, config.writeDSN())
+	if err != nil {
+		return nil, nil, err
+	}
+	write.DB().SetMaxOpenConns(1)
+
+	read, err := entsql.Open(dialect.SQLite, config.readDSN())
+	if err != nil {
+		_ = write.Close()
+		return nil, nil, err
+	}
+	read.DB().SetMaxOpenConns(config.MaxReadConns)
+	read.DB().SetMaxIdleConns(config.MaxReadConns)
+	read.DB().SetConnMaxIdleTime(config.ConnMaxIdleTime)
+
+	return entgen.NewClient(entgen.Driver(&splitDriver{write: write, read: read})), read.DB(), nil
+}
+
+// UseInMemoryDatabase makes GetClient open a database that lives in memory.
+// It must be called before the first GetClient.
+func UseInMemoryDatabase() {
+	inMemory = true
+}
+
+// openInMemory opens the in-memory database on a single connection,
+// which the database lives and dies with.
+func openInMemory() (*entgen.Client, *stdsql.DB, error) {
+	drv, err := entsql.Open(dialect.SQLite, "file:oniongo?mode=memory&_fk=1")
+	if err != nil {
+		return nil, nil, err
+	}
+	drv.DB().SetMaxOpenConns(1)
+	drv.DB().SetConnMaxLifetime(0)
+	drv.DB().SetConnMaxIdleTime(0)
+	return entgen.NewClient(entgen.Driver(drv)), drv.DB(), nil
+}
+
+// Migrate applies the ent schema and the todo search index to the database.
 func Migrate() error {
 	db, err := GetClient()
 	if err != nil {
 		return err
 	}
 
-	return db.Schema.Create(context.Background(),
+	ctx := context.Background()
+	err = db.Schema.Create(ctx, MigrateOptions()...)
+	if err != nil {
+		return err
+	}
+	return migrateSearchIndex(ctx)
+}
+
+// MigrateOptions returns the options of the ent schema migration,
+// for the tests that create the schema in an in-memory database.
+func MigrateOptions() []entschema.MigrateOption {
+	return []entschema.MigrateOption{
 		migrate.WithDropIndex(true),
 		migrate.WithDropColumn(true),
-	)
+		entschema.WithApplyHook(skipSequenceChanges),
+	}
+}
+
+// skipSequenceChanges drops the sqlite_sequence inserts that the global ID
+// feature plans for new tables. Every table uses a UUID primary key, so no
+// sequence exists, and the insert fails on databases without AUTOINCREMENT.
+func skipSequenceChanges(next entschema.Applier) entschema.Applier {
+	return entschema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *atlasmigrate.Plan) error {
+		changes := plan.Changes[:0]
+		for _, c := range plan.Changes {
+			if strings.HasPrefix(c.Cmd, "INSERT INTO sqlite_sequence") {
+				continue
+			}
+			changes = append(changes, c)
+		}
+		plan.Changes = changes
+		return next.Apply(ctx, conn, plan)
+	})
 }
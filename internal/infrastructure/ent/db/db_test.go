@@ -0,0 +1,111 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfig(t *testing.T) Config {
+	t.Helper()
+	return Config{
+		Path:            filepath.Join(t.TempDir(), "oniongo.db"),
+		JournalMode:     DefaultJournalMode,
+		Synchronous:     DefaultSynchronous,
+		BusyTimeout:     DefaultBusyTimeout,
+		MaxReadConns:    2,
+		ConnMaxIdleTime: DefaultConnMaxIdleTime,
+	}
+}
+
+// openTestDatabase opens a migrated database file with the config.
+func openTestDatabase(t *testing.T, config Config) (uow.TransactionRunner, *entgen.Client, func(ctx context.Context, path string) error) {
+	t.Helper()
+	client, src, err := open(config)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	require.NoError(t, client.Schema.Create(context.Background(), MigrateOptions()...))
+	return NewClientTransactionRunner(client), client, func(ctx context.Context, path string) error {
+		return backupTo(ctx, src, path)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("uses the journal mode of the config", func(t *testing.T) {
+		// Given
+		_, client, _ := openTestDatabase(t, newTestConfig(t))
+
+		// When
+		rows, err := client.QueryContext(ctx, "PRAGMA journal_mode")
+		require.NoError(t, err)
+		defer rows.Close()
+		var mode string
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&mode))
+
+		// Then
+		assert.Equal(t, "wal", mode)
+	})
+
+	t.Run("runs read-only transactions on read-only connections", func(t *testing.T) {
+		// Given
+		runner, _, _ := openTestDatabase(t, newTestConfig(t))
+
+		// When
+		writeErr := runner.RunInTx(ctx, func(ctx context.Context) error {
+			tx, err := GetTx(ctx)
+			require.NoError(t, err)
+			return tx.TagSchema.Create().SetName("home").SetColor("#808080").Exec(ctx)
+		}, uow.ReadOnly())
+		var count int
+		readErr := runner.RunInTx(ctx, func(ctx context.Context) error {
+			tx, err := GetTx(ctx)
+			require.NoError(t, err)
+			count, err = tx.TagSchema.Query().Count(ctx)
+			return err
+		}, uow.ReadOnly())
+
+		// Then
+		assert.ErrorContains(t, writeErr, "readonly")
+		require.NoError(t, readErr)
+		assert.Zero(t, count)
+	})
+}
+
+func TestBackup(t *testing.T) {
+	// Given
+	ctx := context.Background()
+	runner, _, backup := openTestDatabase(t, newTestConfig(t))
+	require.NoError(t, runner.RunInTx(ctx, func(ctx context.Context) error {
+		tx, err := GetTx(ctx)
+		require.NoError(t, err)
+		return tx.TagSchema.Create().SetName("home").SetColor("#808080").Exec(ctx)
+	}))
+
+	// When
+	path := filepath.Join(t.TempDir(), "backup.db")
+	err := backup(ctx, path)
+
+	// Then
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(content, []byte("SQLite format 3\x00")))
+
+	restored, _, err := open(Config{Path: path, JournalMode: "DELETE", Synchronous: "FULL", MaxReadConns: 1})
+	require.NoError(t, err)
+	defer restored.Close()
+	tags, err := restored.TagSchema.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "home", tags[0].Name)
+}
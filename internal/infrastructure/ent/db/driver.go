@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// splitDriver is a dialect.Driver that sends the writes to a single connection,
+// which queues the writers of the process, and the reads to a pool of read-only connections.
+//
+// Transactions are writes unless they are started with the read-only option.
+// Outside of a transaction, only SELECT statements are reads.
+type splitDriver struct {
+	write *entsql.Driver
+	read  *entsql.Driver
+}
+
+func (d *splitDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.write.Exec(ctx, query, args, v)
+}
+
+func (d *splitDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.route(query).Query(ctx, query, args, v)
+}
+
+func (d *splitDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	return d.write.ExecContext(ctx, query, args...)
+}
+
+func (d *splitDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	return d.route(query).QueryContext(ctx, query, args...)
+}
+
+func (d *splitDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.write.Tx(ctx)
+}
+
+func (d *splitDriver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	if opts != nil && opts.ReadOnly {
+		return d.read.BeginTx(ctx, opts)
+	}
+	return d.write.BeginTx(ctx, opts)
+}
+
+func (d *splitDriver) Close() error {
+	return errors.Join(d.write.Close(), d.read.Close())
+}
+
+func (d *splitDriver) Dialect() string {
+	return dialect.SQLite
+}
+
+// route returns the driver of a statement run outside of a transaction.
+// Inserts may return rows, so only SELECT statements are sent to the read-only connections.
+func (d *splitDriver) route(query string) *entsql.Driver {
+	if q := strings.TrimSpace(query); len(q) >= 6 && strings.EqualFold(q[:6], "SELECT") {
+		return d.read
+	}
+	return d.write
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// retryBaseDelay is the backoff before the second attempt of a transaction.
+// It doubles with each attempt.
+const retryBaseDelay = 20 * time.Millisecond
+
+// IsRetryable reports whether err is a transient failure after which the whole
+// transaction can be run again: a busy or locked SQLite database, or a serialization
+// failure or a deadlock reported with a SQLSTATE by a driver such as pgx.
+func IsRetryable(err error) bool {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+	var stateErr interface{ SQLState() string }
+	if errors.As(err, &stateErr) {
+		switch stateErr.SQLState() {
+		case "40001", "40P01":
+			return true
+		}
+	}
+	return false
+}
+
+// retryBackoff returns a random delay of up to the exponential backoff of the attempt,
+// so that the transactions that failed together do not run again together.
+func retryBackoff(attempt int) time.Duration {
+	return rand.N(retryBaseDelay << (attempt - 1))
+}
+
+// sleep waits for d, or returns the error of ctx when it is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
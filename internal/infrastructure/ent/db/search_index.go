@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+)
+
+// searchIndexStatements create the FTS5 index used by the todo search repository.
+// The index lives outside of the ent schema, so triggers keep it in sync with the todo table.
+// The add_todo_search migration creates the same index for databases managed with atlas.
+//
+// Rebuilding the todo table drops its triggers. Every statement is idempotent,
+// so running them after the ent migration restores the triggers.
+var searchIndexStatements = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS todo_fts USING fts5(
+		todo_id UNINDEXED,
+		title,
+		body,
+		tokenize = 'unicode61 remove_diacritics 2'
+	)`,
+	`CREATE TRIGGER IF NOT EXISTS todo_fts_after_insert AFTER INSERT ON todo BEGIN
+		INSERT INTO todo_fts (todo_id, title, body) VALUES (new.id, new.title, coalesce(new.body, ''));
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS todo_fts_after_update AFTER UPDATE OF title, body ON todo
+	WHEN old.title IS NOT new.title OR old.body IS NOT new.body BEGIN
+		DELETE FROM todo_fts WHERE todo_id = old.id;
+		INSERT INTO todo_fts (todo_id, title, body) VALUES (new.id, new.title, coalesce(new.body, ''));
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS todo_fts_after_delete AFTER DELETE ON todo BEGIN
+		DELETE FROM todo_fts WHERE todo_id = old.id;
+	END`,
+	// Index the todos that were written while the triggers were missing.
+	`INSERT INTO todo_fts (todo_id, title, body)
+	SELECT id, title, coalesce(body, '') FROM todo
+	WHERE id NOT IN (SELECT todo_id FROM todo_fts)`,
+}
+
+// migrateSearchIndex creates the full-text search index for todos.
+func migrateSearchIndex(ctx context.Context) error {
+	client, err := GetClient()
+	if err != nil {
+		return err
+	}
+	return CreateSearchIndex(ctx, client)
+}
+
+// CreateSearchIndex creates the full-text search index for todos in the database of client.
+// It needs SQLite built with FTS5, i.e. the sqlite_fts5 build tag.
+func CreateSearchIndex(ctx context.Context, client *entgen.Client) error {
+	for _, stmt := range searchIndexStatements {
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			if strings.Contains(err.Error(), "no such module: fts5") {
+				return fmt.Errorf("failed to create search index: SQLite lacks FTS5, build with -tags sqlite_fts5: %w", err)
+			}
+			return fmt.Errorf("failed to create search index: %w", err)
+		}
+	}
+	return nil
+}
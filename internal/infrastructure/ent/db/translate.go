@@ -0,0 +1,53 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+)
+
+// DomainErrors builds the domain errors of an entity for TranslateError.
+// The errors with no builder are wrapped like any other error.
+type DomainErrors struct {
+	NotFound      func() error
+	AlreadyExists func() error
+	Invalid       func(field, message string) error
+}
+
+// TranslateError converts an error returned by ent for an entity into the domain error of the entity:
+//   - a NotFoundError into NotFound,
+//   - a ConstraintError of a primary key or a unique index into AlreadyExists,
+//   - any other ConstraintError, such as a reference to a missing entity, into Invalid,
+//   - a ValidationError of a field into Invalid with the name of the field.
+//
+// Other errors are wrapped with the description of the failed operation.
+func TranslateError(err error, domainErrs DomainErrors, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case entgen.IsNotFound(err) && domainErrs.NotFound != nil:
+		return domainErrs.NotFound()
+	case entgen.IsConstraintError(err) && IsUniqueViolation(err) && domainErrs.AlreadyExists != nil:
+		return domainErrs.AlreadyExists()
+	case entgen.IsConstraintError(err) && domainErrs.Invalid != nil:
+		if IsForeignKeyViolation(err) {
+			return domainErrs.Invalid("", "refers to an entity that does not exist")
+		}
+		return domainErrs.Invalid("", "conflicts with the stored data")
+	}
+
+	var validationErr *entgen.ValidationError
+	if errors.As(err, &validationErr) && domainErrs.Invalid != nil {
+		// ent wraps the error of a failed validator, and reports a missing field without a cause.
+		message := "is required"
+		if cause := errors.Unwrap(errors.Unwrap(validationErr)); cause != nil {
+			message = cause.Error()
+		}
+		return domainErrs.Invalid(validationErr.Name, message)
+	}
+
+	return fmt.Errorf(format+": %w", append(args, err)...)
+}
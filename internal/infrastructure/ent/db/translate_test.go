@@ -0,0 +1,154 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/enttest"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errTestNotFound      = errors.New("not found")
+	errTestAlreadyExists = errors.New("already exists")
+)
+
+// testInvalidError is the error built by testDomainErrors for invalid input.
+type testInvalidError struct {
+	field   string
+	message string
+}
+
+func (e *testInvalidError) Error() string { return e.field + ": " + e.message }
+
+var testDomainErrors = DomainErrors{
+	NotFound:      func() error { return errTestNotFound },
+	AlreadyExists: func() error { return errTestAlreadyExists },
+	Invalid: func(field, message string) error {
+		return &testInvalidError{field: field, message: message}
+	},
+}
+
+func TestTranslateError(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(MigrateOptions()...),
+	)
+	t.Cleanup(func() { _ = client.Close() })
+
+	createTag := func(name string) error {
+		return client.TagSchema.Create().
+			SetID(uuid.New()).
+			SetName(name).
+			SetColor("#ff0000").
+			SetCreatedAt(time.Now()).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx)
+	}
+
+	t.Run("translates a missing entity", func(t *testing.T) {
+		// Given
+		_, err := client.TagSchema.Get(ctx, uuid.New())
+
+		// When
+		translated := TranslateError(err, testDomainErrors, "failed to find tag")
+
+		// Then
+		assert.Same(t, errTestNotFound, translated)
+	})
+
+	t.Run("translates a unique violation", func(t *testing.T) {
+		// Given
+		require.NoError(t, createTag("home"))
+		err := createTag("home")
+
+		// When
+		translated := TranslateError(err, testDomainErrors, "failed to create tag")
+
+		// Then
+		assert.Same(t, errTestAlreadyExists, translated)
+	})
+
+	t.Run("translates a reference to a missing entity", func(t *testing.T) {
+		// Given
+		err := client.TodoSchema.Create().
+			SetID(uuid.New()).
+			SetTitle("Buy milk").
+			SetProjectID(uuid.New()).
+			Exec(ctx)
+
+		// When
+		translated := TranslateError(err, testDomainErrors, "failed to create todo")
+
+		// Then
+		var invalidErr *testInvalidError
+		require.ErrorAs(t, translated, &invalidErr)
+		assert.Empty(t, invalidErr.field)
+	})
+
+	t.Run("translates an invalid field", func(t *testing.T) {
+		// Given
+		err := createTag("")
+
+		// When
+		translated := TranslateError(err, testDomainErrors, "failed to create tag")
+
+		// Then
+		var invalidErr *testInvalidError
+		require.ErrorAs(t, translated, &invalidErr)
+		assert.Equal(t, "name", invalidErr.field)
+		assert.NotContains(t, invalidErr.message, "entgen")
+	})
+
+	t.Run("wraps the errors without a domain error", func(t *testing.T) {
+		// Given
+		_, err := client.TagSchema.Get(ctx, uuid.New())
+
+		// When
+		translated := TranslateError(err, DomainErrors{}, "failed to find tag %v", "home")
+
+		// Then
+		assert.True(t, entgen.IsNotFound(translated))
+		assert.ErrorContains(t, translated, "failed to find tag home: ")
+	})
+
+	t.Run("wraps other errors", func(t *testing.T) {
+		// Given
+		err := sqlite3.Error{Code: sqlite3.ErrBusy}
+
+		// When
+		translated := TranslateError(err, testDomainErrors, "failed to update tag")
+
+		// Then
+		assert.ErrorIs(t, translated, err)
+	})
+
+	t.Run("returns nil for no error", func(t *testing.T) {
+		assert.NoError(t, TranslateError(nil, testDomainErrors, "failed to update tag"))
+	})
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "unique index", err: sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintUnique}, want: true},
+		{name: "primary key", err: sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintPrimaryKey}, want: true},
+		{name: "foreign key", err: sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintForeignKey}, want: false},
+		{name: "unique violation with a SQLSTATE", err: sqlStateError("23505"), want: true},
+		{name: "other error", err: errors.New("failure"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsUniqueViolation(tt.err))
+		})
+	}
+}
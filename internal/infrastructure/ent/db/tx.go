@@ -2,7 +2,9 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/iktakahiro/oniongo/internal/application/uow"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
@@ -13,29 +15,83 @@ type key int
 
 const (
 	TxKey key = iota
+	txStateKey
 )
 
 // entTransactionRunner is the implementation of the TransactionRunner interface.
-type entTransactionRunner struct{}
+// It uses the client returned by GetClient when client is nil.
+type entTransactionRunner struct {
+	client *entgen.Client
+}
 
 // NewEntTransactionRunner creates a new ent transaction runner.
 func NewEntTransactionRunner(i *do.Injector) (uow.TransactionRunner, error) {
 	return &entTransactionRunner{}, nil
 }
 
-// RunInTx runs a function in a transaction.
+// NewClientTransactionRunner creates an ent transaction runner on the given client,
+// for the tests that run the repositories on their own database.
+func NewClientTransactionRunner(client *entgen.Client) uow.TransactionRunner {
+	return &entTransactionRunner{client: client}
+}
+
+// RunInTx runs a function in a transaction, or in a savepoint of the transaction in ctx.
+// The transaction is run again, up to the MaxAttempts option, when it fails with an error
+// that IsRetryable reports, after a backoff with jitter.
 func (r entTransactionRunner) RunInTx(
 	ctx context.Context,
 	fn func(ctx context.Context) error,
+	opts ...uow.TxOption,
 ) error {
-	client, err := GetClient()
-	if err != nil {
-		return err
+	if state, ok := ctx.Value(txStateKey).(*txState); ok {
+		return runInSavepoint(ctx, state, fn)
 	}
 
-	tx, err := client.BeginTx(ctx, nil)
+	client := r.client
+	if client == nil {
+		var err error
+		if client, err = GetClient(); err != nil {
+			return err
+		}
+	}
+
+	o := uow.NewTxOptions(ctx, opts...)
+	for attempt := 1; ; attempt++ {
+		hooks, err := runInNewTx(ctx, client, o, fn)
+		if err == nil {
+			hooks.RunAfterCommit(ctx)
+			return nil
+		}
+		if attempt >= o.MaxAttempts || !IsRetryable(err) {
+			return err
+		}
+		if sleepErr := sleep(ctx, retryBackoff(attempt)); sleepErr != nil {
+			return err
+		}
+	}
+}
+
+// txState is the state of a transaction started by the runner.
+type txState struct {
+	tx *entgen.Tx
+	// hooks collect the callbacks of the innermost savepoint.
+	hooks *uow.Hooks
+	// depth is the number of savepoints the innermost one is nested in.
+	depth int
+}
+
+func runInNewTx(
+	ctx context.Context,
+	client *entgen.Client,
+	o uow.TxOptions,
+	fn func(ctx context.Context) error,
+) (*uow.Hooks, error) {
+	tx, err := client.BeginTx(ctx, &sql.TxOptions{
+		Isolation: isolationLevels[o.Isolation],
+		ReadOnly:  o.ReadOnly,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var done bool
@@ -54,14 +110,58 @@ func (r entTransactionRunner) RunInTx(
 	// 	  Title: "test",
 	// 	  Body:  "test",
 	//   })
+	state := &txState{tx: tx, hooks: &uow.Hooks{}}
 	ctx = context.WithValue(ctx, TxKey, tx)
+	ctx = context.WithValue(ctx, txStateKey, state)
 
-	if err := fn(ctx); err != nil {
-		return err
+	if err := fn(uow.WithHooks(ctx, state.hooks)); err != nil {
+		return nil, err
 	}
 	done = true
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return state.hooks, nil
+}
+
+// runInSavepoint runs fn in a savepoint of the transaction, which is rolled back when fn fails.
+func runInSavepoint(ctx context.Context, state *txState, fn func(ctx context.Context) error) error {
+	parent := state.hooks
+	state.depth++
+	state.hooks = &uow.Hooks{}
+	savepoint, name := state.hooks, fmt.Sprintf("oniongo_sp%d", state.depth)
+	defer func() {
+		state.depth--
+		state.hooks = parent
+	}()
+
+	client := state.tx.Client()
+	if _, err := client.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+	if err := fn(uow.WithHooks(ctx, savepoint)); err != nil {
+		if _, rbErr := client.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to roll back savepoint: %w", rbErr))
+		}
+		if _, relErr := client.ExecContext(ctx, "RELEASE SAVEPOINT "+name); relErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to release savepoint: %w", relErr))
+		}
+		return err
+	}
+	if _, err := client.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	parent.Merge(savepoint)
+	return nil
+}
+
+var isolationLevels = map[uow.IsolationLevel]sql.IsolationLevel{
+	uow.IsolationDefault:         sql.LevelDefault,
+	uow.IsolationReadUncommitted: sql.LevelReadUncommitted,
+	uow.IsolationReadCommitted:   sql.LevelReadCommitted,
+	uow.IsolationRepeatableRead:  sql.LevelRepeatableRead,
+	uow.IsolationSerializable:    sql.LevelSerializable,
 }
 
 // GetTx returns the transaction from the context.
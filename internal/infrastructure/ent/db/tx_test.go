@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/enttest"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRunner(t *testing.T) uow.TransactionRunner {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(MigrateOptions()...),
+	)
+	t.Cleanup(func() { _ = client.Close() })
+	return NewClientTransactionRunner(client)
+}
+
+func TestEntTransactionRunner_Retry(t *testing.T) {
+	ctx := context.Background()
+	busy := fmt.Errorf("failed to update todo: %w", sqlite3.Error{Code: sqlite3.ErrBusy})
+
+	t.Run("runs the transaction again after a retryable error", func(t *testing.T) {
+		// Given
+		runner := newTestRunner(t)
+		attempts, committed := 0, 0
+
+		// When
+		err := runner.RunInTx(ctx, func(ctx context.Context) error {
+			attempts++
+			require.NoError(t, uow.AfterCommit(ctx, func(context.Context) { committed++ }))
+			if attempts < 3 {
+				return busy
+			}
+			return nil
+		})
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, 1, committed, "only the callbacks of the committed attempt are called")
+	})
+
+	t.Run("gives up after the maximum number of attempts", func(t *testing.T) {
+		// Given
+		runner := newTestRunner(t)
+		attempts := 0
+
+		// When
+		err := runner.RunInTx(ctx, func(ctx context.Context) error {
+			attempts++
+			return busy
+		}, uow.MaxAttempts(2))
+
+		// Then
+		assert.ErrorIs(t, err, busy)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		// Given
+		runner := newTestRunner(t)
+		failure := errors.New("failure")
+		attempts := 0
+
+		// When
+		err := runner.RunInTx(ctx, func(ctx context.Context) error {
+			attempts++
+			return failure
+		})
+
+		// Then
+		assert.ErrorIs(t, err, failure)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("retries the outermost transaction only", func(t *testing.T) {
+		// Given
+		runner := newTestRunner(t)
+		outer, inner := 0, 0
+
+		// When
+		err := runner.RunInTx(ctx, func(ctx context.Context) error {
+			outer++
+			return runner.RunInTx(ctx, func(ctx context.Context) error {
+				inner++
+				return busy
+			})
+		}, uow.MaxAttempts(2))
+
+		// Then
+		assert.ErrorIs(t, err, busy)
+		assert.Equal(t, 2, outer)
+		assert.Equal(t, 2, inner)
+	})
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "busy database", err: sqlite3.Error{Code: sqlite3.ErrBusy}, want: true},
+		{name: "locked table", err: fmt.Errorf("wrapped: %w", sqlite3.Error{Code: sqlite3.ErrLocked}), want: true},
+		{name: "constraint violation", err: sqlite3.Error{Code: sqlite3.ErrConstraint}, want: false},
+		{name: "serialization failure", err: sqlStateError("40001"), want: true},
+		{name: "deadlock", err: sqlStateError("40P01"), want: true},
+		{name: "unique violation", err: sqlStateError("23505"), want: false},
+		{name: "other error", err: errors.New("failure"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryable(tt.err))
+		})
+	}
+}
+
+// sqlStateError is an error of a driver that reports a SQLSTATE, such as pgx.
+type sqlStateError string
+
+func (e sqlStateError) Error() string    { return "SQLSTATE " + string(e) }
+func (e sqlStateError) SQLState() string { return string(e) }
@@ -6,16 +6,29 @@ package main
 import (
 	"log"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 )
 
 func main() {
-	err := entc.Generate("./schema", &gen.Config{
+	// The GraphQL schema of the ent types is generated next to the GraphQL API,
+	// and its types are registered in the gqlgen config there.
+	ex, err := entgql.NewExtension(
+		entgql.WithSchemaGenerator(),
+		entgql.WithWhereInputs(true),
+		entgql.WithSchemaPath("../../api/graphql/ent.graphql"),
+		entgql.WithConfigPath("../../api/graphql/gqlgen.yml"),
+	)
+	if err != nil {
+		log.Fatalf("creating entgql extension: %v", err)
+	}
+
+	err = entc.Generate("./schema", &gen.Config{
 		Target:   "./entgen",
 		Package:  "github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen",
 		Features: gen.AllFeatures,
-	})
+	}, entc.Extensions(ex), entc.TemplateFiles("template/import.tmpl"))
 	if err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// AttachmentSchema is the model entity for the AttachmentSchema schema.
+type AttachmentSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID uuid.UUID `json:"todo_id,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttachmentSchemaQuery when eager-loading is set.
+	Edges        AttachmentSchemaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AttachmentSchemaEdges holds the relations/edges for other nodes in the graph.
+type AttachmentSchemaEdges struct {
+	// Todo holds the value of the todo edge.
+	Todo *TodoSchema `json:"todo,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttachmentSchemaEdges) TodoOrErr() (*TodoSchema, error) {
+	if e.Todo != nil {
+		return e.Todo, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: todoschema.Label}
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttachmentSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attachmentschema.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case attachmentschema.FieldFilename, attachmentschema.FieldContentType, attachmentschema.FieldChecksum:
+			values[i] = new(sql.NullString)
+		case attachmentschema.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case attachmentschema.FieldID, attachmentschema.FieldTodoID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttachmentSchema fields.
+func (as *AttachmentSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attachmentschema.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				as.ID = *value
+			}
+		case attachmentschema.FieldTodoID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value != nil {
+				as.TodoID = *value
+			}
+		case attachmentschema.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				as.Filename = value.String
+			}
+		case attachmentschema.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				as.ContentType = value.String
+			}
+		case attachmentschema.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				as.Size = value.Int64
+			}
+		case attachmentschema.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				as.Checksum = value.String
+			}
+		case attachmentschema.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				as.CreatedAt = value.Time
+			}
+		default:
+			as.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttachmentSchema.
+// This includes values selected through modifiers, order, etc.
+func (as *AttachmentSchema) Value(name string) (ent.Value, error) {
+	return as.selectValues.Get(name)
+}
+
+// QueryTodo queries the "todo" edge of the AttachmentSchema entity.
+func (as *AttachmentSchema) QueryTodo() *TodoSchemaQuery {
+	return NewAttachmentSchemaClient(as.config).QueryTodo(as)
+}
+
+// Update returns a builder for updating this AttachmentSchema.
+// Note that you need to call AttachmentSchema.Unwrap() before calling this method if this AttachmentSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (as *AttachmentSchema) Update() *AttachmentSchemaUpdateOne {
+	return NewAttachmentSchemaClient(as.config).UpdateOne(as)
+}
+
+// Unwrap unwraps the AttachmentSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (as *AttachmentSchema) Unwrap() *AttachmentSchema {
+	_tx, ok := as.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: AttachmentSchema is not a transactional entity")
+	}
+	as.config.driver = _tx.drv
+	return as
+}
+
+// String implements the fmt.Stringer.
+func (as *AttachmentSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttachmentSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", as.ID))
+	builder.WriteString("todo_id=")
+	builder.WriteString(fmt.Sprintf("%v", as.TodoID))
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(as.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(as.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", as.Size))
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(as.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(as.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttachmentSchemas is a parsable slice of AttachmentSchema.
+type AttachmentSchemas []*AttachmentSchema
@@ -0,0 +1,777 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// AttachmentSchemaCreate is the builder for creating a AttachmentSchema entity.
+type AttachmentSchemaCreate struct {
+	config
+	mutation *AttachmentSchemaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTodoID sets the "todo_id" field.
+func (asc *AttachmentSchemaCreate) SetTodoID(u uuid.UUID) *AttachmentSchemaCreate {
+	asc.mutation.SetTodoID(u)
+	return asc
+}
+
+// SetFilename sets the "filename" field.
+func (asc *AttachmentSchemaCreate) SetFilename(s string) *AttachmentSchemaCreate {
+	asc.mutation.SetFilename(s)
+	return asc
+}
+
+// SetContentType sets the "content_type" field.
+func (asc *AttachmentSchemaCreate) SetContentType(s string) *AttachmentSchemaCreate {
+	asc.mutation.SetContentType(s)
+	return asc
+}
+
+// SetSize sets the "size" field.
+func (asc *AttachmentSchemaCreate) SetSize(i int64) *AttachmentSchemaCreate {
+	asc.mutation.SetSize(i)
+	return asc
+}
+
+// SetChecksum sets the "checksum" field.
+func (asc *AttachmentSchemaCreate) SetChecksum(s string) *AttachmentSchemaCreate {
+	asc.mutation.SetChecksum(s)
+	return asc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (asc *AttachmentSchemaCreate) SetCreatedAt(t time.Time) *AttachmentSchemaCreate {
+	asc.mutation.SetCreatedAt(t)
+	return asc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (asc *AttachmentSchemaCreate) SetNillableCreatedAt(t *time.Time) *AttachmentSchemaCreate {
+	if t != nil {
+		asc.SetCreatedAt(*t)
+	}
+	return asc
+}
+
+// SetID sets the "id" field.
+func (asc *AttachmentSchemaCreate) SetID(u uuid.UUID) *AttachmentSchemaCreate {
+	asc.mutation.SetID(u)
+	return asc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (asc *AttachmentSchemaCreate) SetNillableID(u *uuid.UUID) *AttachmentSchemaCreate {
+	if u != nil {
+		asc.SetID(*u)
+	}
+	return asc
+}
+
+// SetTodo sets the "todo" edge to the TodoSchema entity.
+func (asc *AttachmentSchemaCreate) SetTodo(t *TodoSchema) *AttachmentSchemaCreate {
+	return asc.SetTodoID(t.ID)
+}
+
+// Mutation returns the AttachmentSchemaMutation object of the builder.
+func (asc *AttachmentSchemaCreate) Mutation() *AttachmentSchemaMutation {
+	return asc.mutation
+}
+
+// Save creates the AttachmentSchema in the database.
+func (asc *AttachmentSchemaCreate) Save(ctx context.Context) (*AttachmentSchema, error) {
+	asc.defaults()
+	return withHooks(ctx, asc.sqlSave, asc.mutation, asc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (asc *AttachmentSchemaCreate) SaveX(ctx context.Context) *AttachmentSchema {
+	v, err := asc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (asc *AttachmentSchemaCreate) Exec(ctx context.Context) error {
+	_, err := asc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asc *AttachmentSchemaCreate) ExecX(ctx context.Context) {
+	if err := asc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (asc *AttachmentSchemaCreate) defaults() {
+	if _, ok := asc.mutation.CreatedAt(); !ok {
+		v := attachmentschema.DefaultCreatedAt()
+		asc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := asc.mutation.ID(); !ok {
+		v := attachmentschema.DefaultID()
+		asc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (asc *AttachmentSchemaCreate) check() error {
+	if _, ok := asc.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`entgen: missing required field "AttachmentSchema.todo_id"`)}
+	}
+	if _, ok := asc.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`entgen: missing required field "AttachmentSchema.filename"`)}
+	}
+	if v, ok := asc.mutation.Filename(); ok {
+		if err := attachmentschema.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`entgen: validator failed for field "AttachmentSchema.filename": %w`, err)}
+		}
+	}
+	if _, ok := asc.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`entgen: missing required field "AttachmentSchema.content_type"`)}
+	}
+	if v, ok := asc.mutation.ContentType(); ok {
+		if err := attachmentschema.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`entgen: validator failed for field "AttachmentSchema.content_type": %w`, err)}
+		}
+	}
+	if _, ok := asc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`entgen: missing required field "AttachmentSchema.size"`)}
+	}
+	if v, ok := asc.mutation.Size(); ok {
+		if err := attachmentschema.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`entgen: validator failed for field "AttachmentSchema.size": %w`, err)}
+		}
+	}
+	if _, ok := asc.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`entgen: missing required field "AttachmentSchema.checksum"`)}
+	}
+	if _, ok := asc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entgen: missing required field "AttachmentSchema.created_at"`)}
+	}
+	if len(asc.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`entgen: missing required edge "AttachmentSchema.todo"`)}
+	}
+	return nil
+}
+
+func (asc *AttachmentSchemaCreate) sqlSave(ctx context.Context) (*AttachmentSchema, error) {
+	if err := asc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := asc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, asc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	asc.mutation.id = &_node.ID
+	asc.mutation.done = true
+	return _node, nil
+}
+
+func (asc *AttachmentSchemaCreate) createSpec() (*AttachmentSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttachmentSchema{config: asc.config}
+		_spec = sqlgraph.NewCreateSpec(attachmentschema.Table, sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = asc.schemaConfig.AttachmentSchema
+	_spec.OnConflict = asc.conflict
+	if id, ok := asc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := asc.mutation.Filename(); ok {
+		_spec.SetField(attachmentschema.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := asc.mutation.ContentType(); ok {
+		_spec.SetField(attachmentschema.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := asc.mutation.Size(); ok {
+		_spec.SetField(attachmentschema.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := asc.mutation.Checksum(); ok {
+		_spec.SetField(attachmentschema.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := asc.mutation.CreatedAt(); ok {
+		_spec.SetField(attachmentschema.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := asc.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachmentschema.TodoTable,
+			Columns: []string{attachmentschema.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = asc.schemaConfig.AttachmentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttachmentSchema.Create().
+//		SetTodoID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttachmentSchemaUpsert) {
+//			SetTodoID(v+v).
+//		}).
+//		Exec(ctx)
+func (asc *AttachmentSchemaCreate) OnConflict(opts ...sql.ConflictOption) *AttachmentSchemaUpsertOne {
+	asc.conflict = opts
+	return &AttachmentSchemaUpsertOne{
+		create: asc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttachmentSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (asc *AttachmentSchemaCreate) OnConflictColumns(columns ...string) *AttachmentSchemaUpsertOne {
+	asc.conflict = append(asc.conflict, sql.ConflictColumns(columns...))
+	return &AttachmentSchemaUpsertOne{
+		create: asc,
+	}
+}
+
+type (
+	// AttachmentSchemaUpsertOne is the builder for "upsert"-ing
+	//  one AttachmentSchema node.
+	AttachmentSchemaUpsertOne struct {
+		create *AttachmentSchemaCreate
+	}
+
+	// AttachmentSchemaUpsert is the "OnConflict" setter.
+	AttachmentSchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetFilename sets the "filename" field.
+func (u *AttachmentSchemaUpsert) SetFilename(v string) *AttachmentSchemaUpsert {
+	u.Set(attachmentschema.FieldFilename, v)
+	return u
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsert) UpdateFilename() *AttachmentSchemaUpsert {
+	u.SetExcluded(attachmentschema.FieldFilename)
+	return u
+}
+
+// SetContentType sets the "content_type" field.
+func (u *AttachmentSchemaUpsert) SetContentType(v string) *AttachmentSchemaUpsert {
+	u.Set(attachmentschema.FieldContentType, v)
+	return u
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsert) UpdateContentType() *AttachmentSchemaUpsert {
+	u.SetExcluded(attachmentschema.FieldContentType)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *AttachmentSchemaUpsert) SetSize(v int64) *AttachmentSchemaUpsert {
+	u.Set(attachmentschema.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsert) UpdateSize() *AttachmentSchemaUpsert {
+	u.SetExcluded(attachmentschema.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *AttachmentSchemaUpsert) AddSize(v int64) *AttachmentSchemaUpsert {
+	u.Add(attachmentschema.FieldSize, v)
+	return u
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *AttachmentSchemaUpsert) SetChecksum(v string) *AttachmentSchemaUpsert {
+	u.Set(attachmentschema.FieldChecksum, v)
+	return u
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsert) UpdateChecksum() *AttachmentSchemaUpsert {
+	u.SetExcluded(attachmentschema.FieldChecksum)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AttachmentSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(attachmentschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AttachmentSchemaUpsertOne) UpdateNewValues() *AttachmentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(attachmentschema.FieldID)
+		}
+		if _, exists := u.create.mutation.TodoID(); exists {
+			s.SetIgnore(attachmentschema.FieldTodoID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(attachmentschema.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttachmentSchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AttachmentSchemaUpsertOne) Ignore() *AttachmentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttachmentSchemaUpsertOne) DoNothing() *AttachmentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttachmentSchemaCreate.OnConflict
+// documentation for more info.
+func (u *AttachmentSchemaUpsertOne) Update(set func(*AttachmentSchemaUpsert)) *AttachmentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttachmentSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFilename sets the "filename" field.
+func (u *AttachmentSchemaUpsertOne) SetFilename(v string) *AttachmentSchemaUpsertOne {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsertOne) UpdateFilename() *AttachmentSchemaUpsertOne {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *AttachmentSchemaUpsertOne) SetContentType(v string) *AttachmentSchemaUpsertOne {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsertOne) UpdateContentType() *AttachmentSchemaUpsertOne {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AttachmentSchemaUpsertOne) SetSize(v int64) *AttachmentSchemaUpsertOne {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AttachmentSchemaUpsertOne) AddSize(v int64) *AttachmentSchemaUpsertOne {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsertOne) UpdateSize() *AttachmentSchemaUpsertOne {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *AttachmentSchemaUpsertOne) SetChecksum(v string) *AttachmentSchemaUpsertOne {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsertOne) UpdateChecksum() *AttachmentSchemaUpsertOne {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// Exec executes the query.
+func (u *AttachmentSchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for AttachmentSchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttachmentSchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AttachmentSchemaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entgen: AttachmentSchemaUpsertOne.ID is not supported by MySQL driver. Use AttachmentSchemaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AttachmentSchemaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AttachmentSchemaCreateBulk is the builder for creating many AttachmentSchema entities in bulk.
+type AttachmentSchemaCreateBulk struct {
+	config
+	err      error
+	builders []*AttachmentSchemaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AttachmentSchema entities in the database.
+func (ascb *AttachmentSchemaCreateBulk) Save(ctx context.Context) ([]*AttachmentSchema, error) {
+	if ascb.err != nil {
+		return nil, ascb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ascb.builders))
+	nodes := make([]*AttachmentSchema, len(ascb.builders))
+	mutators := make([]Mutator, len(ascb.builders))
+	for i := range ascb.builders {
+		func(i int, root context.Context) {
+			builder := ascb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttachmentSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ascb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ascb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ascb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ascb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ascb *AttachmentSchemaCreateBulk) SaveX(ctx context.Context) []*AttachmentSchema {
+	v, err := ascb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ascb *AttachmentSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := ascb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ascb *AttachmentSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := ascb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AttachmentSchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttachmentSchemaUpsert) {
+//			SetTodoID(v+v).
+//		}).
+//		Exec(ctx)
+func (ascb *AttachmentSchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *AttachmentSchemaUpsertBulk {
+	ascb.conflict = opts
+	return &AttachmentSchemaUpsertBulk{
+		create: ascb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AttachmentSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ascb *AttachmentSchemaCreateBulk) OnConflictColumns(columns ...string) *AttachmentSchemaUpsertBulk {
+	ascb.conflict = append(ascb.conflict, sql.ConflictColumns(columns...))
+	return &AttachmentSchemaUpsertBulk{
+		create: ascb,
+	}
+}
+
+// AttachmentSchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of AttachmentSchema nodes.
+type AttachmentSchemaUpsertBulk struct {
+	create *AttachmentSchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AttachmentSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(attachmentschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AttachmentSchemaUpsertBulk) UpdateNewValues() *AttachmentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(attachmentschema.FieldID)
+			}
+			if _, exists := b.mutation.TodoID(); exists {
+				s.SetIgnore(attachmentschema.FieldTodoID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(attachmentschema.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AttachmentSchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AttachmentSchemaUpsertBulk) Ignore() *AttachmentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttachmentSchemaUpsertBulk) DoNothing() *AttachmentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttachmentSchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *AttachmentSchemaUpsertBulk) Update(set func(*AttachmentSchemaUpsert)) *AttachmentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttachmentSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFilename sets the "filename" field.
+func (u *AttachmentSchemaUpsertBulk) SetFilename(v string) *AttachmentSchemaUpsertBulk {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsertBulk) UpdateFilename() *AttachmentSchemaUpsertBulk {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *AttachmentSchemaUpsertBulk) SetContentType(v string) *AttachmentSchemaUpsertBulk {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsertBulk) UpdateContentType() *AttachmentSchemaUpsertBulk {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AttachmentSchemaUpsertBulk) SetSize(v int64) *AttachmentSchemaUpsertBulk {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AttachmentSchemaUpsertBulk) AddSize(v int64) *AttachmentSchemaUpsertBulk {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsertBulk) UpdateSize() *AttachmentSchemaUpsertBulk {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *AttachmentSchemaUpsertBulk) SetChecksum(v string) *AttachmentSchemaUpsertBulk {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *AttachmentSchemaUpsertBulk) UpdateChecksum() *AttachmentSchemaUpsertBulk {
+	return u.Update(func(s *AttachmentSchemaUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// Exec executes the query.
+func (u *AttachmentSchemaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entgen: OnConflict was set for builder %d. Set it on the AttachmentSchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for AttachmentSchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttachmentSchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
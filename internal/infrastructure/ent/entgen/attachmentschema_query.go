@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// AttachmentSchemaQuery is the builder for querying AttachmentSchema entities.
+type AttachmentSchemaQuery struct {
+	config
+	ctx        *QueryContext
+	order      []attachmentschema.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AttachmentSchema
+	withTodo   *TodoSchemaQuery
+	loadTotal  []func(context.Context, []*AttachmentSchema) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AttachmentSchemaQuery builder.
+func (asq *AttachmentSchemaQuery) Where(ps ...predicate.AttachmentSchema) *AttachmentSchemaQuery {
+	asq.predicates = append(asq.predicates, ps...)
+	return asq
+}
+
+// Limit the number of records to be returned by this query.
+func (asq *AttachmentSchemaQuery) Limit(limit int) *AttachmentSchemaQuery {
+	asq.ctx.Limit = &limit
+	return asq
+}
+
+// Offset to start from.
+func (asq *AttachmentSchemaQuery) Offset(offset int) *AttachmentSchemaQuery {
+	asq.ctx.Offset = &offset
+	return asq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (asq *AttachmentSchemaQuery) Unique(unique bool) *AttachmentSchemaQuery {
+	asq.ctx.Unique = &unique
+	return asq
+}
+
+// Order specifies how the records should be ordered.
+func (asq *AttachmentSchemaQuery) Order(o ...attachmentschema.OrderOption) *AttachmentSchemaQuery {
+	asq.order = append(asq.order, o...)
+	return asq
+}
+
+// QueryTodo chains the current query on the "todo" edge.
+func (asq *AttachmentSchemaQuery) QueryTodo() *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: asq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := asq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := asq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attachmentschema.Table, attachmentschema.FieldID, selector),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attachmentschema.TodoTable, attachmentschema.TodoColumn),
+		)
+		schemaConfig := asq.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.AttachmentSchema
+		fromU = sqlgraph.SetNeighbors(asq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AttachmentSchema entity from the query.
+// Returns a *NotFoundError when no AttachmentSchema was found.
+func (asq *AttachmentSchemaQuery) First(ctx context.Context) (*AttachmentSchema, error) {
+	nodes, err := asq.Limit(1).All(setContextOp(ctx, asq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{attachmentschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (asq *AttachmentSchemaQuery) FirstX(ctx context.Context) *AttachmentSchema {
+	node, err := asq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AttachmentSchema ID from the query.
+// Returns a *NotFoundError when no AttachmentSchema ID was found.
+func (asq *AttachmentSchemaQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = asq.Limit(1).IDs(setContextOp(ctx, asq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{attachmentschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (asq *AttachmentSchemaQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := asq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AttachmentSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AttachmentSchema entity is found.
+// Returns a *NotFoundError when no AttachmentSchema entities are found.
+func (asq *AttachmentSchemaQuery) Only(ctx context.Context) (*AttachmentSchema, error) {
+	nodes, err := asq.Limit(2).All(setContextOp(ctx, asq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{attachmentschema.Label}
+	default:
+		return nil, &NotSingularError{attachmentschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (asq *AttachmentSchemaQuery) OnlyX(ctx context.Context) *AttachmentSchema {
+	node, err := asq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AttachmentSchema ID in the query.
+// Returns a *NotSingularError when more than one AttachmentSchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (asq *AttachmentSchemaQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = asq.Limit(2).IDs(setContextOp(ctx, asq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{attachmentschema.Label}
+	default:
+		err = &NotSingularError{attachmentschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (asq *AttachmentSchemaQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := asq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AttachmentSchemas.
+func (asq *AttachmentSchemaQuery) All(ctx context.Context) ([]*AttachmentSchema, error) {
+	ctx = setContextOp(ctx, asq.ctx, ent.OpQueryAll)
+	if err := asq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AttachmentSchema, *AttachmentSchemaQuery]()
+	return withInterceptors[[]*AttachmentSchema](ctx, asq, qr, asq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (asq *AttachmentSchemaQuery) AllX(ctx context.Context) []*AttachmentSchema {
+	nodes, err := asq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AttachmentSchema IDs.
+func (asq *AttachmentSchemaQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if asq.ctx.Unique == nil && asq.path != nil {
+		asq.Unique(true)
+	}
+	ctx = setContextOp(ctx, asq.ctx, ent.OpQueryIDs)
+	if err = asq.Select(attachmentschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (asq *AttachmentSchemaQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := asq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (asq *AttachmentSchemaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, asq.ctx, ent.OpQueryCount)
+	if err := asq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, asq, querierCount[*AttachmentSchemaQuery](), asq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (asq *AttachmentSchemaQuery) CountX(ctx context.Context) int {
+	count, err := asq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (asq *AttachmentSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, asq.ctx, ent.OpQueryExist)
+	switch _, err := asq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entgen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (asq *AttachmentSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := asq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AttachmentSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (asq *AttachmentSchemaQuery) Clone() *AttachmentSchemaQuery {
+	if asq == nil {
+		return nil
+	}
+	return &AttachmentSchemaQuery{
+		config:     asq.config,
+		ctx:        asq.ctx.Clone(),
+		order:      append([]attachmentschema.OrderOption{}, asq.order...),
+		inters:     append([]Interceptor{}, asq.inters...),
+		predicates: append([]predicate.AttachmentSchema{}, asq.predicates...),
+		withTodo:   asq.withTodo.Clone(),
+		// clone intermediate query.
+		sql:       asq.sql.Clone(),
+		path:      asq.path,
+		modifiers: append([]func(*sql.Selector){}, asq.modifiers...),
+	}
+}
+
+// WithTodo tells the query-builder to eager-load the nodes that are connected to
+// the "todo" edge. The optional arguments are used to configure the query builder of the edge.
+func (asq *AttachmentSchemaQuery) WithTodo(opts ...func(*TodoSchemaQuery)) *AttachmentSchemaQuery {
+	query := (&TodoSchemaClient{config: asq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	asq.withTodo = query
+	return asq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TodoID uuid.UUID `json:"todo_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AttachmentSchema.Query().
+//		GroupBy(attachmentschema.FieldTodoID).
+//		Aggregate(entgen.Count()).
+//		Scan(ctx, &v)
+func (asq *AttachmentSchemaQuery) GroupBy(field string, fields ...string) *AttachmentSchemaGroupBy {
+	asq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AttachmentSchemaGroupBy{build: asq}
+	grbuild.flds = &asq.ctx.Fields
+	grbuild.label = attachmentschema.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TodoID uuid.UUID `json:"todo_id,omitempty"`
+//	}
+//
+//	client.AttachmentSchema.Query().
+//		Select(attachmentschema.FieldTodoID).
+//		Scan(ctx, &v)
+func (asq *AttachmentSchemaQuery) Select(fields ...string) *AttachmentSchemaSelect {
+	asq.ctx.Fields = append(asq.ctx.Fields, fields...)
+	sbuild := &AttachmentSchemaSelect{AttachmentSchemaQuery: asq}
+	sbuild.label = attachmentschema.Label
+	sbuild.flds, sbuild.scan = &asq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AttachmentSchemaSelect configured with the given aggregations.
+func (asq *AttachmentSchemaQuery) Aggregate(fns ...AggregateFunc) *AttachmentSchemaSelect {
+	return asq.Select().Aggregate(fns...)
+}
+
+func (asq *AttachmentSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range asq.inters {
+		if inter == nil {
+			return fmt.Errorf("entgen: uninitialized interceptor (forgotten import entgen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, asq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range asq.ctx.Fields {
+		if !attachmentschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+		}
+	}
+	if asq.path != nil {
+		prev, err := asq.path(ctx)
+		if err != nil {
+			return err
+		}
+		asq.sql = prev
+	}
+	return nil
+}
+
+func (asq *AttachmentSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AttachmentSchema, error) {
+	var (
+		nodes       = []*AttachmentSchema{}
+		_spec       = asq.querySpec()
+		loadedTypes = [1]bool{
+			asq.withTodo != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AttachmentSchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AttachmentSchema{config: asq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = asq.schemaConfig.AttachmentSchema
+	ctx = internal.NewSchemaConfigContext(ctx, asq.schemaConfig)
+	if len(asq.modifiers) > 0 {
+		_spec.Modifiers = asq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, asq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := asq.withTodo; query != nil {
+		if err := asq.loadTodo(ctx, query, nodes, nil,
+			func(n *AttachmentSchema, e *TodoSchema) { n.Edges.Todo = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range asq.loadTotal {
+		if err := asq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (asq *AttachmentSchemaQuery) loadTodo(ctx context.Context, query *TodoSchemaQuery, nodes []*AttachmentSchema, init func(*AttachmentSchema), assign func(*AttachmentSchema, *TodoSchema)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*AttachmentSchema)
+	for i := range nodes {
+		fk := nodes[i].TodoID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(todoschema.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "todo_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (asq *AttachmentSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := asq.querySpec()
+	_spec.Node.Schema = asq.schemaConfig.AttachmentSchema
+	ctx = internal.NewSchemaConfigContext(ctx, asq.schemaConfig)
+	if len(asq.modifiers) > 0 {
+		_spec.Modifiers = asq.modifiers
+	}
+	_spec.Node.Columns = asq.ctx.Fields
+	if len(asq.ctx.Fields) > 0 {
+		_spec.Unique = asq.ctx.Unique != nil && *asq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, asq.driver, _spec)
+}
+
+func (asq *AttachmentSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(attachmentschema.Table, attachmentschema.Columns, sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID))
+	_spec.From = asq.sql
+	if unique := asq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if asq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := asq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachmentschema.FieldID)
+		for i := range fields {
+			if fields[i] != attachmentschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if asq.withTodo != nil {
+			_spec.Node.AddColumnOnce(attachmentschema.FieldTodoID)
+		}
+	}
+	if ps := asq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := asq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := asq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := asq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (asq *AttachmentSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(asq.driver.Dialect())
+	t1 := builder.Table(attachmentschema.Table)
+	columns := asq.ctx.Fields
+	if len(columns) == 0 {
+		columns = attachmentschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if asq.sql != nil {
+		selector = asq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if asq.ctx.Unique != nil && *asq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(asq.schemaConfig.AttachmentSchema)
+	ctx = internal.NewSchemaConfigContext(ctx, asq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range asq.modifiers {
+		m(selector)
+	}
+	for _, p := range asq.predicates {
+		p(selector)
+	}
+	for _, p := range asq.order {
+		p(selector)
+	}
+	if offset := asq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := asq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (asq *AttachmentSchemaQuery) ForUpdate(opts ...sql.LockOption) *AttachmentSchemaQuery {
+	if asq.driver.Dialect() == dialect.Postgres {
+		asq.Unique(false)
+	}
+	asq.modifiers = append(asq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return asq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (asq *AttachmentSchemaQuery) ForShare(opts ...sql.LockOption) *AttachmentSchemaQuery {
+	if asq.driver.Dialect() == dialect.Postgres {
+		asq.Unique(false)
+	}
+	asq.modifiers = append(asq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return asq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (asq *AttachmentSchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *AttachmentSchemaSelect {
+	asq.modifiers = append(asq.modifiers, modifiers...)
+	return asq.Select()
+}
+
+// AttachmentSchemaGroupBy is the group-by builder for AttachmentSchema entities.
+type AttachmentSchemaGroupBy struct {
+	selector
+	build *AttachmentSchemaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (asgb *AttachmentSchemaGroupBy) Aggregate(fns ...AggregateFunc) *AttachmentSchemaGroupBy {
+	asgb.fns = append(asgb.fns, fns...)
+	return asgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (asgb *AttachmentSchemaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, asgb.build.ctx, ent.OpQueryGroupBy)
+	if err := asgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttachmentSchemaQuery, *AttachmentSchemaGroupBy](ctx, asgb.build, asgb, asgb.build.inters, v)
+}
+
+func (asgb *AttachmentSchemaGroupBy) sqlScan(ctx context.Context, root *AttachmentSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(asgb.fns))
+	for _, fn := range asgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*asgb.flds)+len(asgb.fns))
+		for _, f := range *asgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*asgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := asgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AttachmentSchemaSelect is the builder for selecting fields of AttachmentSchema entities.
+type AttachmentSchemaSelect struct {
+	*AttachmentSchemaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ass *AttachmentSchemaSelect) Aggregate(fns ...AggregateFunc) *AttachmentSchemaSelect {
+	ass.fns = append(ass.fns, fns...)
+	return ass
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ass *AttachmentSchemaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ass.ctx, ent.OpQuerySelect)
+	if err := ass.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AttachmentSchemaQuery, *AttachmentSchemaSelect](ctx, ass.AttachmentSchemaQuery, ass, ass.inters, v)
+}
+
+func (ass *AttachmentSchemaSelect) sqlScan(ctx context.Context, root *AttachmentSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ass.fns))
+	for _, fn := range ass.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ass.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ass.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ass *AttachmentSchemaSelect) Modify(modifiers ...func(s *sql.Selector)) *AttachmentSchemaSelect {
+	ass.modifiers = append(ass.modifiers, modifiers...)
+	return ass
+}
@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+)
+
+// AttachmentSchemaUpdate is the builder for updating AttachmentSchema entities.
+type AttachmentSchemaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AttachmentSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AttachmentSchemaUpdate builder.
+func (asu *AttachmentSchemaUpdate) Where(ps ...predicate.AttachmentSchema) *AttachmentSchemaUpdate {
+	asu.mutation.Where(ps...)
+	return asu
+}
+
+// SetFilename sets the "filename" field.
+func (asu *AttachmentSchemaUpdate) SetFilename(s string) *AttachmentSchemaUpdate {
+	asu.mutation.SetFilename(s)
+	return asu
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (asu *AttachmentSchemaUpdate) SetNillableFilename(s *string) *AttachmentSchemaUpdate {
+	if s != nil {
+		asu.SetFilename(*s)
+	}
+	return asu
+}
+
+// SetContentType sets the "content_type" field.
+func (asu *AttachmentSchemaUpdate) SetContentType(s string) *AttachmentSchemaUpdate {
+	asu.mutation.SetContentType(s)
+	return asu
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (asu *AttachmentSchemaUpdate) SetNillableContentType(s *string) *AttachmentSchemaUpdate {
+	if s != nil {
+		asu.SetContentType(*s)
+	}
+	return asu
+}
+
+// SetSize sets the "size" field.
+func (asu *AttachmentSchemaUpdate) SetSize(i int64) *AttachmentSchemaUpdate {
+	asu.mutation.ResetSize()
+	asu.mutation.SetSize(i)
+	return asu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (asu *AttachmentSchemaUpdate) SetNillableSize(i *int64) *AttachmentSchemaUpdate {
+	if i != nil {
+		asu.SetSize(*i)
+	}
+	return asu
+}
+
+// AddSize adds i to the "size" field.
+func (asu *AttachmentSchemaUpdate) AddSize(i int64) *AttachmentSchemaUpdate {
+	asu.mutation.AddSize(i)
+	return asu
+}
+
+// SetChecksum sets the "checksum" field.
+func (asu *AttachmentSchemaUpdate) SetChecksum(s string) *AttachmentSchemaUpdate {
+	asu.mutation.SetChecksum(s)
+	return asu
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (asu *AttachmentSchemaUpdate) SetNillableChecksum(s *string) *AttachmentSchemaUpdate {
+	if s != nil {
+		asu.SetChecksum(*s)
+	}
+	return asu
+}
+
+// Mutation returns the AttachmentSchemaMutation object of the builder.
+func (asu *AttachmentSchemaUpdate) Mutation() *AttachmentSchemaMutation {
+	return asu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (asu *AttachmentSchemaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, asu.sqlSave, asu.mutation, asu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (asu *AttachmentSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := asu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (asu *AttachmentSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := asu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asu *AttachmentSchemaUpdate) ExecX(ctx context.Context) {
+	if err := asu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (asu *AttachmentSchemaUpdate) check() error {
+	if v, ok := asu.mutation.Filename(); ok {
+		if err := attachmentschema.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`entgen: validator failed for field "AttachmentSchema.filename": %w`, err)}
+		}
+	}
+	if v, ok := asu.mutation.ContentType(); ok {
+		if err := attachmentschema.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`entgen: validator failed for field "AttachmentSchema.content_type": %w`, err)}
+		}
+	}
+	if v, ok := asu.mutation.Size(); ok {
+		if err := attachmentschema.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`entgen: validator failed for field "AttachmentSchema.size": %w`, err)}
+		}
+	}
+	if asu.mutation.TodoCleared() && len(asu.mutation.TodoIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "AttachmentSchema.todo"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (asu *AttachmentSchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttachmentSchemaUpdate {
+	asu.modifiers = append(asu.modifiers, modifiers...)
+	return asu
+}
+
+func (asu *AttachmentSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := asu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachmentschema.Table, attachmentschema.Columns, sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID))
+	if ps := asu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := asu.mutation.Filename(); ok {
+		_spec.SetField(attachmentschema.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := asu.mutation.ContentType(); ok {
+		_spec.SetField(attachmentschema.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := asu.mutation.Size(); ok {
+		_spec.SetField(attachmentschema.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := asu.mutation.AddedSize(); ok {
+		_spec.AddField(attachmentschema.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := asu.mutation.Checksum(); ok {
+		_spec.SetField(attachmentschema.FieldChecksum, field.TypeString, value)
+	}
+	_spec.Node.Schema = asu.schemaConfig.AttachmentSchema
+	ctx = internal.NewSchemaConfigContext(ctx, asu.schemaConfig)
+	_spec.AddModifiers(asu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, asu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachmentschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	asu.mutation.done = true
+	return n, nil
+}
+
+// AttachmentSchemaUpdateOne is the builder for updating a single AttachmentSchema entity.
+type AttachmentSchemaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AttachmentSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetFilename sets the "filename" field.
+func (asuo *AttachmentSchemaUpdateOne) SetFilename(s string) *AttachmentSchemaUpdateOne {
+	asuo.mutation.SetFilename(s)
+	return asuo
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (asuo *AttachmentSchemaUpdateOne) SetNillableFilename(s *string) *AttachmentSchemaUpdateOne {
+	if s != nil {
+		asuo.SetFilename(*s)
+	}
+	return asuo
+}
+
+// SetContentType sets the "content_type" field.
+func (asuo *AttachmentSchemaUpdateOne) SetContentType(s string) *AttachmentSchemaUpdateOne {
+	asuo.mutation.SetContentType(s)
+	return asuo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (asuo *AttachmentSchemaUpdateOne) SetNillableContentType(s *string) *AttachmentSchemaUpdateOne {
+	if s != nil {
+		asuo.SetContentType(*s)
+	}
+	return asuo
+}
+
+// SetSize sets the "size" field.
+func (asuo *AttachmentSchemaUpdateOne) SetSize(i int64) *AttachmentSchemaUpdateOne {
+	asuo.mutation.ResetSize()
+	asuo.mutation.SetSize(i)
+	return asuo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (asuo *AttachmentSchemaUpdateOne) SetNillableSize(i *int64) *AttachmentSchemaUpdateOne {
+	if i != nil {
+		asuo.SetSize(*i)
+	}
+	return asuo
+}
+
+// AddSize adds i to the "size" field.
+func (asuo *AttachmentSchemaUpdateOne) AddSize(i int64) *AttachmentSchemaUpdateOne {
+	asuo.mutation.AddSize(i)
+	return asuo
+}
+
+// SetChecksum sets the "checksum" field.
+func (asuo *AttachmentSchemaUpdateOne) SetChecksum(s string) *AttachmentSchemaUpdateOne {
+	asuo.mutation.SetChecksum(s)
+	return asuo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (asuo *AttachmentSchemaUpdateOne) SetNillableChecksum(s *string) *AttachmentSchemaUpdateOne {
+	if s != nil {
+		asuo.SetChecksum(*s)
+	}
+	return asuo
+}
+
+// Mutation returns the AttachmentSchemaMutation object of the builder.
+func (asuo *AttachmentSchemaUpdateOne) Mutation() *AttachmentSchemaMutation {
+	return asuo.mutation
+}
+
+// Where appends a list predicates to the AttachmentSchemaUpdate builder.
+func (asuo *AttachmentSchemaUpdateOne) Where(ps ...predicate.AttachmentSchema) *AttachmentSchemaUpdateOne {
+	asuo.mutation.Where(ps...)
+	return asuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (asuo *AttachmentSchemaUpdateOne) Select(field string, fields ...string) *AttachmentSchemaUpdateOne {
+	asuo.fields = append([]string{field}, fields...)
+	return asuo
+}
+
+// Save executes the query and returns the updated AttachmentSchema entity.
+func (asuo *AttachmentSchemaUpdateOne) Save(ctx context.Context) (*AttachmentSchema, error) {
+	return withHooks(ctx, asuo.sqlSave, asuo.mutation, asuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (asuo *AttachmentSchemaUpdateOne) SaveX(ctx context.Context) *AttachmentSchema {
+	node, err := asuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (asuo *AttachmentSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := asuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asuo *AttachmentSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := asuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (asuo *AttachmentSchemaUpdateOne) check() error {
+	if v, ok := asuo.mutation.Filename(); ok {
+		if err := attachmentschema.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`entgen: validator failed for field "AttachmentSchema.filename": %w`, err)}
+		}
+	}
+	if v, ok := asuo.mutation.ContentType(); ok {
+		if err := attachmentschema.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`entgen: validator failed for field "AttachmentSchema.content_type": %w`, err)}
+		}
+	}
+	if v, ok := asuo.mutation.Size(); ok {
+		if err := attachmentschema.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`entgen: validator failed for field "AttachmentSchema.size": %w`, err)}
+		}
+	}
+	if asuo.mutation.TodoCleared() && len(asuo.mutation.TodoIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "AttachmentSchema.todo"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (asuo *AttachmentSchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AttachmentSchemaUpdateOne {
+	asuo.modifiers = append(asuo.modifiers, modifiers...)
+	return asuo
+}
+
+func (asuo *AttachmentSchemaUpdateOne) sqlSave(ctx context.Context) (_node *AttachmentSchema, err error) {
+	if err := asuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachmentschema.Table, attachmentschema.Columns, sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID))
+	id, ok := asuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "AttachmentSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := asuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachmentschema.FieldID)
+		for _, f := range fields {
+			if !attachmentschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != attachmentschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := asuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := asuo.mutation.Filename(); ok {
+		_spec.SetField(attachmentschema.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := asuo.mutation.ContentType(); ok {
+		_spec.SetField(attachmentschema.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := asuo.mutation.Size(); ok {
+		_spec.SetField(attachmentschema.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := asuo.mutation.AddedSize(); ok {
+		_spec.AddField(attachmentschema.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := asuo.mutation.Checksum(); ok {
+		_spec.SetField(attachmentschema.FieldChecksum, field.TypeString, value)
+	}
+	_spec.Node.Schema = asuo.schemaConfig.AttachmentSchema
+	ctx = internal.NewSchemaConfigContext(ctx, asuo.schemaConfig)
+	_spec.AddModifiers(asuo.modifiers...)
+	_node = &AttachmentSchema{config: asuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, asuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachmentschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	asuo.mutation.done = true
+	return _node, nil
+}
@@ -15,8 +15,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
 
 	stdsql "database/sql"
 
@@ -28,10 +37,26 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AttachmentSchema is the client for interacting with the AttachmentSchema builders.
+	AttachmentSchema *AttachmentSchemaClient
+	// CommentSchema is the client for interacting with the CommentSchema builders.
+	CommentSchema *CommentSchemaClient
+	// ExternalRefSchema is the client for interacting with the ExternalRefSchema builders.
+	ExternalRefSchema *ExternalRefSchemaClient
 	// ProjectSchema is the client for interacting with the ProjectSchema builders.
 	ProjectSchema *ProjectSchemaClient
+	// TagSchema is the client for interacting with the TagSchema builders.
+	TagSchema *TagSchemaClient
+	// TodoHistorySchema is the client for interacting with the TodoHistorySchema builders.
+	TodoHistorySchema *TodoHistorySchemaClient
 	// TodoSchema is the client for interacting with the TodoSchema builders.
 	TodoSchema *TodoSchemaClient
+	// WebhookDeliverySchema is the client for interacting with the WebhookDeliverySchema builders.
+	WebhookDeliverySchema *WebhookDeliverySchemaClient
+	// WebhookEventSchema is the client for interacting with the WebhookEventSchema builders.
+	WebhookEventSchema *WebhookEventSchemaClient
+	// WebhookSchema is the client for interacting with the WebhookSchema builders.
+	WebhookSchema *WebhookSchemaClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -43,8 +68,16 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AttachmentSchema = NewAttachmentSchemaClient(c.config)
+	c.CommentSchema = NewCommentSchemaClient(c.config)
+	c.ExternalRefSchema = NewExternalRefSchemaClient(c.config)
 	c.ProjectSchema = NewProjectSchemaClient(c.config)
+	c.TagSchema = NewTagSchemaClient(c.config)
+	c.TodoHistorySchema = NewTodoHistorySchemaClient(c.config)
 	c.TodoSchema = NewTodoSchemaClient(c.config)
+	c.WebhookDeliverySchema = NewWebhookDeliverySchemaClient(c.config)
+	c.WebhookEventSchema = NewWebhookEventSchemaClient(c.config)
+	c.WebhookSchema = NewWebhookSchemaClient(c.config)
 }
 
 type (
@@ -137,10 +170,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		ProjectSchema: NewProjectSchemaClient(cfg),
-		TodoSchema:    NewTodoSchemaClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		AttachmentSchema:      NewAttachmentSchemaClient(cfg),
+		CommentSchema:         NewCommentSchemaClient(cfg),
+		ExternalRefSchema:     NewExternalRefSchemaClient(cfg),
+		ProjectSchema:         NewProjectSchemaClient(cfg),
+		TagSchema:             NewTagSchemaClient(cfg),
+		TodoHistorySchema:     NewTodoHistorySchemaClient(cfg),
+		TodoSchema:            NewTodoSchemaClient(cfg),
+		WebhookDeliverySchema: NewWebhookDeliverySchemaClient(cfg),
+		WebhookEventSchema:    NewWebhookEventSchemaClient(cfg),
+		WebhookSchema:         NewWebhookSchemaClient(cfg),
 	}, nil
 }
 
@@ -158,17 +199,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		ProjectSchema: NewProjectSchemaClient(cfg),
-		TodoSchema:    NewTodoSchemaClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		AttachmentSchema:      NewAttachmentSchemaClient(cfg),
+		CommentSchema:         NewCommentSchemaClient(cfg),
+		ExternalRefSchema:     NewExternalRefSchemaClient(cfg),
+		ProjectSchema:         NewProjectSchemaClient(cfg),
+		TagSchema:             NewTagSchemaClient(cfg),
+		TodoHistorySchema:     NewTodoHistorySchemaClient(cfg),
+		TodoSchema:            NewTodoSchemaClient(cfg),
+		WebhookDeliverySchema: NewWebhookDeliverySchemaClient(cfg),
+		WebhookEventSchema:    NewWebhookEventSchemaClient(cfg),
+		WebhookSchema:         NewWebhookSchemaClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ProjectSchema.
+//		AttachmentSchema.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,29 +239,492 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.ProjectSchema.Use(hooks...)
-	c.TodoSchema.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AttachmentSchema, c.CommentSchema, c.ExternalRefSchema, c.ProjectSchema,
+		c.TagSchema, c.TodoHistorySchema, c.TodoSchema, c.WebhookDeliverySchema,
+		c.WebhookEventSchema, c.WebhookSchema,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.ProjectSchema.Intercept(interceptors...)
-	c.TodoSchema.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AttachmentSchema, c.CommentSchema, c.ExternalRefSchema, c.ProjectSchema,
+		c.TagSchema, c.TodoHistorySchema, c.TodoSchema, c.WebhookDeliverySchema,
+		c.WebhookEventSchema, c.WebhookSchema,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AttachmentSchemaMutation:
+		return c.AttachmentSchema.mutate(ctx, m)
+	case *CommentSchemaMutation:
+		return c.CommentSchema.mutate(ctx, m)
+	case *ExternalRefSchemaMutation:
+		return c.ExternalRefSchema.mutate(ctx, m)
 	case *ProjectSchemaMutation:
 		return c.ProjectSchema.mutate(ctx, m)
+	case *TagSchemaMutation:
+		return c.TagSchema.mutate(ctx, m)
+	case *TodoHistorySchemaMutation:
+		return c.TodoHistorySchema.mutate(ctx, m)
 	case *TodoSchemaMutation:
 		return c.TodoSchema.mutate(ctx, m)
+	case *WebhookDeliverySchemaMutation:
+		return c.WebhookDeliverySchema.mutate(ctx, m)
+	case *WebhookEventSchemaMutation:
+		return c.WebhookEventSchema.mutate(ctx, m)
+	case *WebhookSchemaMutation:
+		return c.WebhookSchema.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("entgen: unknown mutation type %T", m)
 	}
 }
 
+// AttachmentSchemaClient is a client for the AttachmentSchema schema.
+type AttachmentSchemaClient struct {
+	config
+}
+
+// NewAttachmentSchemaClient returns a client for the AttachmentSchema from the given config.
+func NewAttachmentSchemaClient(c config) *AttachmentSchemaClient {
+	return &AttachmentSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attachmentschema.Hooks(f(g(h())))`.
+func (c *AttachmentSchemaClient) Use(hooks ...Hook) {
+	c.hooks.AttachmentSchema = append(c.hooks.AttachmentSchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `attachmentschema.Intercept(f(g(h())))`.
+func (c *AttachmentSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AttachmentSchema = append(c.inters.AttachmentSchema, interceptors...)
+}
+
+// Create returns a builder for creating a AttachmentSchema entity.
+func (c *AttachmentSchemaClient) Create() *AttachmentSchemaCreate {
+	mutation := newAttachmentSchemaMutation(c.config, OpCreate)
+	return &AttachmentSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AttachmentSchema entities.
+func (c *AttachmentSchemaClient) CreateBulk(builders ...*AttachmentSchemaCreate) *AttachmentSchemaCreateBulk {
+	return &AttachmentSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AttachmentSchemaClient) MapCreateBulk(slice any, setFunc func(*AttachmentSchemaCreate, int)) *AttachmentSchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AttachmentSchemaCreateBulk{err: fmt.Errorf("calling to AttachmentSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AttachmentSchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AttachmentSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AttachmentSchema.
+func (c *AttachmentSchemaClient) Update() *AttachmentSchemaUpdate {
+	mutation := newAttachmentSchemaMutation(c.config, OpUpdate)
+	return &AttachmentSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttachmentSchemaClient) UpdateOne(as *AttachmentSchema) *AttachmentSchemaUpdateOne {
+	mutation := newAttachmentSchemaMutation(c.config, OpUpdateOne, withAttachmentSchema(as))
+	return &AttachmentSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttachmentSchemaClient) UpdateOneID(id uuid.UUID) *AttachmentSchemaUpdateOne {
+	mutation := newAttachmentSchemaMutation(c.config, OpUpdateOne, withAttachmentSchemaID(id))
+	return &AttachmentSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AttachmentSchema.
+func (c *AttachmentSchemaClient) Delete() *AttachmentSchemaDelete {
+	mutation := newAttachmentSchemaMutation(c.config, OpDelete)
+	return &AttachmentSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttachmentSchemaClient) DeleteOne(as *AttachmentSchema) *AttachmentSchemaDeleteOne {
+	return c.DeleteOneID(as.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AttachmentSchemaClient) DeleteOneID(id uuid.UUID) *AttachmentSchemaDeleteOne {
+	builder := c.Delete().Where(attachmentschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttachmentSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for AttachmentSchema.
+func (c *AttachmentSchemaClient) Query() *AttachmentSchemaQuery {
+	return &AttachmentSchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAttachmentSchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AttachmentSchema entity by its id.
+func (c *AttachmentSchemaClient) Get(ctx context.Context, id uuid.UUID) (*AttachmentSchema, error) {
+	return c.Query().Where(attachmentschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttachmentSchemaClient) GetX(ctx context.Context, id uuid.UUID) *AttachmentSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTodo queries the todo edge of a AttachmentSchema.
+func (c *AttachmentSchemaClient) QueryTodo(as *AttachmentSchema) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := as.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attachmentschema.Table, attachmentschema.FieldID, id),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, attachmentschema.TodoTable, attachmentschema.TodoColumn),
+		)
+		schemaConfig := as.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.AttachmentSchema
+		fromV = sqlgraph.Neighbors(as.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttachmentSchemaClient) Hooks() []Hook {
+	return c.hooks.AttachmentSchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *AttachmentSchemaClient) Interceptors() []Interceptor {
+	return c.inters.AttachmentSchema
+}
+
+func (c *AttachmentSchemaClient) mutate(ctx context.Context, m *AttachmentSchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttachmentSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttachmentSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttachmentSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttachmentSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown AttachmentSchema mutation op: %q", m.Op())
+	}
+}
+
+// CommentSchemaClient is a client for the CommentSchema schema.
+type CommentSchemaClient struct {
+	config
+}
+
+// NewCommentSchemaClient returns a client for the CommentSchema from the given config.
+func NewCommentSchemaClient(c config) *CommentSchemaClient {
+	return &CommentSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commentschema.Hooks(f(g(h())))`.
+func (c *CommentSchemaClient) Use(hooks ...Hook) {
+	c.hooks.CommentSchema = append(c.hooks.CommentSchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commentschema.Intercept(f(g(h())))`.
+func (c *CommentSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommentSchema = append(c.inters.CommentSchema, interceptors...)
+}
+
+// Create returns a builder for creating a CommentSchema entity.
+func (c *CommentSchemaClient) Create() *CommentSchemaCreate {
+	mutation := newCommentSchemaMutation(c.config, OpCreate)
+	return &CommentSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommentSchema entities.
+func (c *CommentSchemaClient) CreateBulk(builders ...*CommentSchemaCreate) *CommentSchemaCreateBulk {
+	return &CommentSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommentSchemaClient) MapCreateBulk(slice any, setFunc func(*CommentSchemaCreate, int)) *CommentSchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommentSchemaCreateBulk{err: fmt.Errorf("calling to CommentSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommentSchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommentSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommentSchema.
+func (c *CommentSchemaClient) Update() *CommentSchemaUpdate {
+	mutation := newCommentSchemaMutation(c.config, OpUpdate)
+	return &CommentSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommentSchemaClient) UpdateOne(cs *CommentSchema) *CommentSchemaUpdateOne {
+	mutation := newCommentSchemaMutation(c.config, OpUpdateOne, withCommentSchema(cs))
+	return &CommentSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommentSchemaClient) UpdateOneID(id uuid.UUID) *CommentSchemaUpdateOne {
+	mutation := newCommentSchemaMutation(c.config, OpUpdateOne, withCommentSchemaID(id))
+	return &CommentSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommentSchema.
+func (c *CommentSchemaClient) Delete() *CommentSchemaDelete {
+	mutation := newCommentSchemaMutation(c.config, OpDelete)
+	return &CommentSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommentSchemaClient) DeleteOne(cs *CommentSchema) *CommentSchemaDeleteOne {
+	return c.DeleteOneID(cs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommentSchemaClient) DeleteOneID(id uuid.UUID) *CommentSchemaDeleteOne {
+	builder := c.Delete().Where(commentschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for CommentSchema.
+func (c *CommentSchemaClient) Query() *CommentSchemaQuery {
+	return &CommentSchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommentSchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommentSchema entity by its id.
+func (c *CommentSchemaClient) Get(ctx context.Context, id uuid.UUID) (*CommentSchema, error) {
+	return c.Query().Where(commentschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentSchemaClient) GetX(ctx context.Context, id uuid.UUID) *CommentSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTodo queries the todo edge of a CommentSchema.
+func (c *CommentSchemaClient) QueryTodo(cs *CommentSchema) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(commentschema.Table, commentschema.FieldID, id),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, commentschema.TodoTable, commentschema.TodoColumn),
+		)
+		schemaConfig := cs.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.CommentSchema
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CommentSchemaClient) Hooks() []Hook {
+	return c.hooks.CommentSchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentSchemaClient) Interceptors() []Interceptor {
+	return c.inters.CommentSchema
+}
+
+func (c *CommentSchemaClient) mutate(ctx context.Context, m *CommentSchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown CommentSchema mutation op: %q", m.Op())
+	}
+}
+
+// ExternalRefSchemaClient is a client for the ExternalRefSchema schema.
+type ExternalRefSchemaClient struct {
+	config
+}
+
+// NewExternalRefSchemaClient returns a client for the ExternalRefSchema from the given config.
+func NewExternalRefSchemaClient(c config) *ExternalRefSchemaClient {
+	return &ExternalRefSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `externalrefschema.Hooks(f(g(h())))`.
+func (c *ExternalRefSchemaClient) Use(hooks ...Hook) {
+	c.hooks.ExternalRefSchema = append(c.hooks.ExternalRefSchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `externalrefschema.Intercept(f(g(h())))`.
+func (c *ExternalRefSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExternalRefSchema = append(c.inters.ExternalRefSchema, interceptors...)
+}
+
+// Create returns a builder for creating a ExternalRefSchema entity.
+func (c *ExternalRefSchemaClient) Create() *ExternalRefSchemaCreate {
+	mutation := newExternalRefSchemaMutation(c.config, OpCreate)
+	return &ExternalRefSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExternalRefSchema entities.
+func (c *ExternalRefSchemaClient) CreateBulk(builders ...*ExternalRefSchemaCreate) *ExternalRefSchemaCreateBulk {
+	return &ExternalRefSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExternalRefSchemaClient) MapCreateBulk(slice any, setFunc func(*ExternalRefSchemaCreate, int)) *ExternalRefSchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExternalRefSchemaCreateBulk{err: fmt.Errorf("calling to ExternalRefSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExternalRefSchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExternalRefSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExternalRefSchema.
+func (c *ExternalRefSchemaClient) Update() *ExternalRefSchemaUpdate {
+	mutation := newExternalRefSchemaMutation(c.config, OpUpdate)
+	return &ExternalRefSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExternalRefSchemaClient) UpdateOne(ers *ExternalRefSchema) *ExternalRefSchemaUpdateOne {
+	mutation := newExternalRefSchemaMutation(c.config, OpUpdateOne, withExternalRefSchema(ers))
+	return &ExternalRefSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExternalRefSchemaClient) UpdateOneID(id uuid.UUID) *ExternalRefSchemaUpdateOne {
+	mutation := newExternalRefSchemaMutation(c.config, OpUpdateOne, withExternalRefSchemaID(id))
+	return &ExternalRefSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExternalRefSchema.
+func (c *ExternalRefSchemaClient) Delete() *ExternalRefSchemaDelete {
+	mutation := newExternalRefSchemaMutation(c.config, OpDelete)
+	return &ExternalRefSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExternalRefSchemaClient) DeleteOne(ers *ExternalRefSchema) *ExternalRefSchemaDeleteOne {
+	return c.DeleteOneID(ers.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExternalRefSchemaClient) DeleteOneID(id uuid.UUID) *ExternalRefSchemaDeleteOne {
+	builder := c.Delete().Where(externalrefschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExternalRefSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for ExternalRefSchema.
+func (c *ExternalRefSchemaClient) Query() *ExternalRefSchemaQuery {
+	return &ExternalRefSchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExternalRefSchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExternalRefSchema entity by its id.
+func (c *ExternalRefSchemaClient) Get(ctx context.Context, id uuid.UUID) (*ExternalRefSchema, error) {
+	return c.Query().Where(externalrefschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExternalRefSchemaClient) GetX(ctx context.Context, id uuid.UUID) *ExternalRefSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExternalRefSchemaClient) Hooks() []Hook {
+	return c.hooks.ExternalRefSchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExternalRefSchemaClient) Interceptors() []Interceptor {
+	return c.inters.ExternalRefSchema
+}
+
+func (c *ExternalRefSchemaClient) mutate(ctx context.Context, m *ExternalRefSchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExternalRefSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExternalRefSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExternalRefSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExternalRefSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown ExternalRefSchema mutation op: %q", m.Op())
+	}
+}
+
 // ProjectSchemaClient is a client for the ProjectSchema schema.
 type ProjectSchemaClient struct {
 	config
@@ -321,6 +833,25 @@ func (c *ProjectSchemaClient) GetX(ctx context.Context, id uuid.UUID) *ProjectSc
 	return obj
 }
 
+// QueryTodos queries the todos edge of a ProjectSchema.
+func (c *ProjectSchemaClient) QueryTodos(ps *ProjectSchema) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projectschema.Table, projectschema.FieldID, id),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, projectschema.TodosTable, projectschema.TodosColumn),
+		)
+		schemaConfig := ps.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		fromV = sqlgraph.Neighbors(ps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ProjectSchemaClient) Hooks() []Hook {
 	return c.hooks.ProjectSchema
@@ -346,55 +877,340 @@ func (c *ProjectSchemaClient) mutate(ctx context.Context, m *ProjectSchemaMutati
 	}
 }
 
-// TodoSchemaClient is a client for the TodoSchema schema.
-type TodoSchemaClient struct {
+// TagSchemaClient is a client for the TagSchema schema.
+type TagSchemaClient struct {
 	config
 }
 
-// NewTodoSchemaClient returns a client for the TodoSchema from the given config.
-func NewTodoSchemaClient(c config) *TodoSchemaClient {
-	return &TodoSchemaClient{config: c}
+// NewTagSchemaClient returns a client for the TagSchema from the given config.
+func NewTagSchemaClient(c config) *TagSchemaClient {
+	return &TagSchemaClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `todoschema.Hooks(f(g(h())))`.
-func (c *TodoSchemaClient) Use(hooks ...Hook) {
-	c.hooks.TodoSchema = append(c.hooks.TodoSchema, hooks...)
+// A call to `Use(f, g, h)` equals to `tagschema.Hooks(f(g(h())))`.
+func (c *TagSchemaClient) Use(hooks ...Hook) {
+	c.hooks.TagSchema = append(c.hooks.TagSchema, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `todoschema.Intercept(f(g(h())))`.
-func (c *TodoSchemaClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TodoSchema = append(c.inters.TodoSchema, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tagschema.Intercept(f(g(h())))`.
+func (c *TagSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagSchema = append(c.inters.TagSchema, interceptors...)
 }
 
-// Create returns a builder for creating a TodoSchema entity.
-func (c *TodoSchemaClient) Create() *TodoSchemaCreate {
-	mutation := newTodoSchemaMutation(c.config, OpCreate)
-	return &TodoSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TagSchema entity.
+func (c *TagSchemaClient) Create() *TagSchemaCreate {
+	mutation := newTagSchemaMutation(c.config, OpCreate)
+	return &TagSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TodoSchema entities.
-func (c *TodoSchemaClient) CreateBulk(builders ...*TodoSchemaCreate) *TodoSchemaCreateBulk {
-	return &TodoSchemaCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TagSchema entities.
+func (c *TagSchemaClient) CreateBulk(builders ...*TagSchemaCreate) *TagSchemaCreateBulk {
+	return &TagSchemaCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TodoSchemaClient) MapCreateBulk(slice any, setFunc func(*TodoSchemaCreate, int)) *TodoSchemaCreateBulk {
+func (c *TagSchemaClient) MapCreateBulk(slice any, setFunc func(*TagSchemaCreate, int)) *TagSchemaCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TodoSchemaCreateBulk{err: fmt.Errorf("calling to TodoSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TagSchemaCreateBulk{err: fmt.Errorf("calling to TagSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TodoSchemaCreate, rv.Len())
+	builders := make([]*TagSchemaCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TodoSchemaCreateBulk{config: c.config, builders: builders}
+	return &TagSchemaCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TodoSchema.
+// Update returns an update builder for TagSchema.
+func (c *TagSchemaClient) Update() *TagSchemaUpdate {
+	mutation := newTagSchemaMutation(c.config, OpUpdate)
+	return &TagSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagSchemaClient) UpdateOne(ts *TagSchema) *TagSchemaUpdateOne {
+	mutation := newTagSchemaMutation(c.config, OpUpdateOne, withTagSchema(ts))
+	return &TagSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagSchemaClient) UpdateOneID(id uuid.UUID) *TagSchemaUpdateOne {
+	mutation := newTagSchemaMutation(c.config, OpUpdateOne, withTagSchemaID(id))
+	return &TagSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TagSchema.
+func (c *TagSchemaClient) Delete() *TagSchemaDelete {
+	mutation := newTagSchemaMutation(c.config, OpDelete)
+	return &TagSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagSchemaClient) DeleteOne(ts *TagSchema) *TagSchemaDeleteOne {
+	return c.DeleteOneID(ts.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagSchemaClient) DeleteOneID(id uuid.UUID) *TagSchemaDeleteOne {
+	builder := c.Delete().Where(tagschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for TagSchema.
+func (c *TagSchemaClient) Query() *TagSchemaQuery {
+	return &TagSchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTagSchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TagSchema entity by its id.
+func (c *TagSchemaClient) Get(ctx context.Context, id uuid.UUID) (*TagSchema, error) {
+	return c.Query().Where(tagschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagSchemaClient) GetX(ctx context.Context, id uuid.UUID) *TagSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTodos queries the todos edge of a TagSchema.
+func (c *TagSchemaClient) QueryTodos(ts *TagSchema) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagschema.Table, tagschema.FieldID, id),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tagschema.TodosTable, tagschema.TodosPrimaryKey...),
+		)
+		schemaConfig := ts.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaTags
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagSchemaClient) Hooks() []Hook {
+	return c.hooks.TagSchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagSchemaClient) Interceptors() []Interceptor {
+	return c.inters.TagSchema
+}
+
+func (c *TagSchemaClient) mutate(ctx context.Context, m *TagSchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown TagSchema mutation op: %q", m.Op())
+	}
+}
+
+// TodoHistorySchemaClient is a client for the TodoHistorySchema schema.
+type TodoHistorySchemaClient struct {
+	config
+}
+
+// NewTodoHistorySchemaClient returns a client for the TodoHistorySchema from the given config.
+func NewTodoHistorySchemaClient(c config) *TodoHistorySchemaClient {
+	return &TodoHistorySchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todohistoryschema.Hooks(f(g(h())))`.
+func (c *TodoHistorySchemaClient) Use(hooks ...Hook) {
+	c.hooks.TodoHistorySchema = append(c.hooks.TodoHistorySchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todohistoryschema.Intercept(f(g(h())))`.
+func (c *TodoHistorySchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TodoHistorySchema = append(c.inters.TodoHistorySchema, interceptors...)
+}
+
+// Create returns a builder for creating a TodoHistorySchema entity.
+func (c *TodoHistorySchemaClient) Create() *TodoHistorySchemaCreate {
+	mutation := newTodoHistorySchemaMutation(c.config, OpCreate)
+	return &TodoHistorySchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoHistorySchema entities.
+func (c *TodoHistorySchemaClient) CreateBulk(builders ...*TodoHistorySchemaCreate) *TodoHistorySchemaCreateBulk {
+	return &TodoHistorySchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoHistorySchemaClient) MapCreateBulk(slice any, setFunc func(*TodoHistorySchemaCreate, int)) *TodoHistorySchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoHistorySchemaCreateBulk{err: fmt.Errorf("calling to TodoHistorySchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoHistorySchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoHistorySchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoHistorySchema.
+func (c *TodoHistorySchemaClient) Update() *TodoHistorySchemaUpdate {
+	mutation := newTodoHistorySchemaMutation(c.config, OpUpdate)
+	return &TodoHistorySchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TodoHistorySchemaClient) UpdateOne(ths *TodoHistorySchema) *TodoHistorySchemaUpdateOne {
+	mutation := newTodoHistorySchemaMutation(c.config, OpUpdateOne, withTodoHistorySchema(ths))
+	return &TodoHistorySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TodoHistorySchemaClient) UpdateOneID(id uuid.UUID) *TodoHistorySchemaUpdateOne {
+	mutation := newTodoHistorySchemaMutation(c.config, OpUpdateOne, withTodoHistorySchemaID(id))
+	return &TodoHistorySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TodoHistorySchema.
+func (c *TodoHistorySchemaClient) Delete() *TodoHistorySchemaDelete {
+	mutation := newTodoHistorySchemaMutation(c.config, OpDelete)
+	return &TodoHistorySchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TodoHistorySchemaClient) DeleteOne(ths *TodoHistorySchema) *TodoHistorySchemaDeleteOne {
+	return c.DeleteOneID(ths.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TodoHistorySchemaClient) DeleteOneID(id uuid.UUID) *TodoHistorySchemaDeleteOne {
+	builder := c.Delete().Where(todohistoryschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TodoHistorySchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for TodoHistorySchema.
+func (c *TodoHistorySchemaClient) Query() *TodoHistorySchemaQuery {
+	return &TodoHistorySchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTodoHistorySchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TodoHistorySchema entity by its id.
+func (c *TodoHistorySchemaClient) Get(ctx context.Context, id uuid.UUID) (*TodoHistorySchema, error) {
+	return c.Query().Where(todohistoryschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TodoHistorySchemaClient) GetX(ctx context.Context, id uuid.UUID) *TodoHistorySchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TodoHistorySchemaClient) Hooks() []Hook {
+	return c.hooks.TodoHistorySchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *TodoHistorySchemaClient) Interceptors() []Interceptor {
+	return c.inters.TodoHistorySchema
+}
+
+func (c *TodoHistorySchemaClient) mutate(ctx context.Context, m *TodoHistorySchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TodoHistorySchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TodoHistorySchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TodoHistorySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TodoHistorySchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown TodoHistorySchema mutation op: %q", m.Op())
+	}
+}
+
+// TodoSchemaClient is a client for the TodoSchema schema.
+type TodoSchemaClient struct {
+	config
+}
+
+// NewTodoSchemaClient returns a client for the TodoSchema from the given config.
+func NewTodoSchemaClient(c config) *TodoSchemaClient {
+	return &TodoSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `todoschema.Hooks(f(g(h())))`.
+func (c *TodoSchemaClient) Use(hooks ...Hook) {
+	c.hooks.TodoSchema = append(c.hooks.TodoSchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `todoschema.Intercept(f(g(h())))`.
+func (c *TodoSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TodoSchema = append(c.inters.TodoSchema, interceptors...)
+}
+
+// Create returns a builder for creating a TodoSchema entity.
+func (c *TodoSchemaClient) Create() *TodoSchemaCreate {
+	mutation := newTodoSchemaMutation(c.config, OpCreate)
+	return &TodoSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TodoSchema entities.
+func (c *TodoSchemaClient) CreateBulk(builders ...*TodoSchemaCreate) *TodoSchemaCreateBulk {
+	return &TodoSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TodoSchemaClient) MapCreateBulk(slice any, setFunc func(*TodoSchemaCreate, int)) *TodoSchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TodoSchemaCreateBulk{err: fmt.Errorf("calling to TodoSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TodoSchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TodoSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TodoSchema.
 func (c *TodoSchemaClient) Update() *TodoSchemaUpdate {
 	mutation := newTodoSchemaMutation(c.config, OpUpdate)
 	return &TodoSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
@@ -454,6 +1270,158 @@ func (c *TodoSchemaClient) GetX(ctx context.Context, id uuid.UUID) *TodoSchema {
 	return obj
 }
 
+// QueryProject queries the project edge of a TodoSchema.
+func (c *TodoSchemaClient) QueryProject(ts *TodoSchema) *ProjectSchemaQuery {
+	query := (&ProjectSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, id),
+			sqlgraph.To(projectschema.Table, projectschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todoschema.ProjectTable, todoschema.ProjectColumn),
+		)
+		schemaConfig := ts.schemaConfig
+		step.To.Schema = schemaConfig.ProjectSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a TodoSchema.
+func (c *TodoSchemaClient) QueryTags(ts *TodoSchema) *TagSchemaQuery {
+	query := (&TagSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, id),
+			sqlgraph.To(tagschema.Table, tagschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, todoschema.TagsTable, todoschema.TagsPrimaryKey...),
+		)
+		schemaConfig := ts.schemaConfig
+		step.To.Schema = schemaConfig.TagSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaTags
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a TodoSchema.
+func (c *TodoSchemaClient) QueryParent(ts *TodoSchema) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, id),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todoschema.ParentTable, todoschema.ParentColumn),
+		)
+		schemaConfig := ts.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a TodoSchema.
+func (c *TodoSchemaClient) QueryChildren(ts *TodoSchema) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, id),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoschema.ChildrenTable, todoschema.ChildrenColumn),
+		)
+		schemaConfig := ts.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlocks queries the blocks edge of a TodoSchema.
+func (c *TodoSchemaClient) QueryBlocks(ts *TodoSchema) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, id),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, todoschema.BlocksTable, todoschema.BlocksPrimaryKey...),
+		)
+		schemaConfig := ts.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaBlockedBy
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlockedBy queries the blocked_by edge of a TodoSchema.
+func (c *TodoSchemaClient) QueryBlockedBy(ts *TodoSchema) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, id),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, todoschema.BlockedByTable, todoschema.BlockedByPrimaryKey...),
+		)
+		schemaConfig := ts.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaBlockedBy
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a TodoSchema.
+func (c *TodoSchemaClient) QueryComments(ts *TodoSchema) *CommentSchemaQuery {
+	query := (&CommentSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, id),
+			sqlgraph.To(commentschema.Table, commentschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoschema.CommentsTable, todoschema.CommentsColumn),
+		)
+		schemaConfig := ts.schemaConfig
+		step.To.Schema = schemaConfig.CommentSchema
+		step.Edge.Schema = schemaConfig.CommentSchema
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a TodoSchema.
+func (c *TodoSchemaClient) QueryAttachments(ts *TodoSchema) *AttachmentSchemaQuery {
+	query := (&AttachmentSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ts.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, id),
+			sqlgraph.To(attachmentschema.Table, attachmentschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoschema.AttachmentsTable, todoschema.AttachmentsColumn),
+		)
+		schemaConfig := ts.schemaConfig
+		step.To.Schema = schemaConfig.AttachmentSchema
+		step.Edge.Schema = schemaConfig.AttachmentSchema
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TodoSchemaClient) Hooks() []Hook {
 	return c.hooks.TodoSchema
@@ -479,13 +1447,454 @@ func (c *TodoSchemaClient) mutate(ctx context.Context, m *TodoSchemaMutation) (V
 	}
 }
 
+// WebhookDeliverySchemaClient is a client for the WebhookDeliverySchema schema.
+type WebhookDeliverySchemaClient struct {
+	config
+}
+
+// NewWebhookDeliverySchemaClient returns a client for the WebhookDeliverySchema from the given config.
+func NewWebhookDeliverySchemaClient(c config) *WebhookDeliverySchemaClient {
+	return &WebhookDeliverySchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdeliveryschema.Hooks(f(g(h())))`.
+func (c *WebhookDeliverySchemaClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDeliverySchema = append(c.hooks.WebhookDeliverySchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdeliveryschema.Intercept(f(g(h())))`.
+func (c *WebhookDeliverySchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDeliverySchema = append(c.inters.WebhookDeliverySchema, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDeliverySchema entity.
+func (c *WebhookDeliverySchemaClient) Create() *WebhookDeliverySchemaCreate {
+	mutation := newWebhookDeliverySchemaMutation(c.config, OpCreate)
+	return &WebhookDeliverySchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDeliverySchema entities.
+func (c *WebhookDeliverySchemaClient) CreateBulk(builders ...*WebhookDeliverySchemaCreate) *WebhookDeliverySchemaCreateBulk {
+	return &WebhookDeliverySchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliverySchemaClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliverySchemaCreate, int)) *WebhookDeliverySchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliverySchemaCreateBulk{err: fmt.Errorf("calling to WebhookDeliverySchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliverySchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliverySchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDeliverySchema.
+func (c *WebhookDeliverySchemaClient) Update() *WebhookDeliverySchemaUpdate {
+	mutation := newWebhookDeliverySchemaMutation(c.config, OpUpdate)
+	return &WebhookDeliverySchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliverySchemaClient) UpdateOne(wds *WebhookDeliverySchema) *WebhookDeliverySchemaUpdateOne {
+	mutation := newWebhookDeliverySchemaMutation(c.config, OpUpdateOne, withWebhookDeliverySchema(wds))
+	return &WebhookDeliverySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliverySchemaClient) UpdateOneID(id uuid.UUID) *WebhookDeliverySchemaUpdateOne {
+	mutation := newWebhookDeliverySchemaMutation(c.config, OpUpdateOne, withWebhookDeliverySchemaID(id))
+	return &WebhookDeliverySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDeliverySchema.
+func (c *WebhookDeliverySchemaClient) Delete() *WebhookDeliverySchemaDelete {
+	mutation := newWebhookDeliverySchemaMutation(c.config, OpDelete)
+	return &WebhookDeliverySchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliverySchemaClient) DeleteOne(wds *WebhookDeliverySchema) *WebhookDeliverySchemaDeleteOne {
+	return c.DeleteOneID(wds.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliverySchemaClient) DeleteOneID(id uuid.UUID) *WebhookDeliverySchemaDeleteOne {
+	builder := c.Delete().Where(webhookdeliveryschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliverySchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDeliverySchema.
+func (c *WebhookDeliverySchemaClient) Query() *WebhookDeliverySchemaQuery {
+	return &WebhookDeliverySchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDeliverySchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDeliverySchema entity by its id.
+func (c *WebhookDeliverySchemaClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDeliverySchema, error) {
+	return c.Query().Where(webhookdeliveryschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliverySchemaClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDeliverySchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDeliverySchema.
+func (c *WebhookDeliverySchemaClient) QueryWebhook(wds *WebhookDeliverySchema) *WebhookSchemaQuery {
+	query := (&WebhookSchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wds.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdeliveryschema.Table, webhookdeliveryschema.FieldID, id),
+			sqlgraph.To(webhookschema.Table, webhookschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdeliveryschema.WebhookTable, webhookdeliveryschema.WebhookColumn),
+		)
+		schemaConfig := wds.schemaConfig
+		step.To.Schema = schemaConfig.WebhookSchema
+		step.Edge.Schema = schemaConfig.WebhookDeliverySchema
+		fromV = sqlgraph.Neighbors(wds.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliverySchemaClient) Hooks() []Hook {
+	return c.hooks.WebhookDeliverySchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliverySchemaClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDeliverySchema
+}
+
+func (c *WebhookDeliverySchemaClient) mutate(ctx context.Context, m *WebhookDeliverySchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliverySchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliverySchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliverySchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliverySchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown WebhookDeliverySchema mutation op: %q", m.Op())
+	}
+}
+
+// WebhookEventSchemaClient is a client for the WebhookEventSchema schema.
+type WebhookEventSchemaClient struct {
+	config
+}
+
+// NewWebhookEventSchemaClient returns a client for the WebhookEventSchema from the given config.
+func NewWebhookEventSchemaClient(c config) *WebhookEventSchemaClient {
+	return &WebhookEventSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookeventschema.Hooks(f(g(h())))`.
+func (c *WebhookEventSchemaClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEventSchema = append(c.hooks.WebhookEventSchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookeventschema.Intercept(f(g(h())))`.
+func (c *WebhookEventSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEventSchema = append(c.inters.WebhookEventSchema, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEventSchema entity.
+func (c *WebhookEventSchemaClient) Create() *WebhookEventSchemaCreate {
+	mutation := newWebhookEventSchemaMutation(c.config, OpCreate)
+	return &WebhookEventSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEventSchema entities.
+func (c *WebhookEventSchemaClient) CreateBulk(builders ...*WebhookEventSchemaCreate) *WebhookEventSchemaCreateBulk {
+	return &WebhookEventSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEventSchemaClient) MapCreateBulk(slice any, setFunc func(*WebhookEventSchemaCreate, int)) *WebhookEventSchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEventSchemaCreateBulk{err: fmt.Errorf("calling to WebhookEventSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEventSchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEventSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEventSchema.
+func (c *WebhookEventSchemaClient) Update() *WebhookEventSchemaUpdate {
+	mutation := newWebhookEventSchemaMutation(c.config, OpUpdate)
+	return &WebhookEventSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEventSchemaClient) UpdateOne(wes *WebhookEventSchema) *WebhookEventSchemaUpdateOne {
+	mutation := newWebhookEventSchemaMutation(c.config, OpUpdateOne, withWebhookEventSchema(wes))
+	return &WebhookEventSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEventSchemaClient) UpdateOneID(id uuid.UUID) *WebhookEventSchemaUpdateOne {
+	mutation := newWebhookEventSchemaMutation(c.config, OpUpdateOne, withWebhookEventSchemaID(id))
+	return &WebhookEventSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEventSchema.
+func (c *WebhookEventSchemaClient) Delete() *WebhookEventSchemaDelete {
+	mutation := newWebhookEventSchemaMutation(c.config, OpDelete)
+	return &WebhookEventSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEventSchemaClient) DeleteOne(wes *WebhookEventSchema) *WebhookEventSchemaDeleteOne {
+	return c.DeleteOneID(wes.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEventSchemaClient) DeleteOneID(id uuid.UUID) *WebhookEventSchemaDeleteOne {
+	builder := c.Delete().Where(webhookeventschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEventSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEventSchema.
+func (c *WebhookEventSchemaClient) Query() *WebhookEventSchemaQuery {
+	return &WebhookEventSchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEventSchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEventSchema entity by its id.
+func (c *WebhookEventSchemaClient) Get(ctx context.Context, id uuid.UUID) (*WebhookEventSchema, error) {
+	return c.Query().Where(webhookeventschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEventSchemaClient) GetX(ctx context.Context, id uuid.UUID) *WebhookEventSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEventSchemaClient) Hooks() []Hook {
+	return c.hooks.WebhookEventSchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEventSchemaClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEventSchema
+}
+
+func (c *WebhookEventSchemaClient) mutate(ctx context.Context, m *WebhookEventSchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEventSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEventSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEventSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEventSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown WebhookEventSchema mutation op: %q", m.Op())
+	}
+}
+
+// WebhookSchemaClient is a client for the WebhookSchema schema.
+type WebhookSchemaClient struct {
+	config
+}
+
+// NewWebhookSchemaClient returns a client for the WebhookSchema from the given config.
+func NewWebhookSchemaClient(c config) *WebhookSchemaClient {
+	return &WebhookSchemaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookschema.Hooks(f(g(h())))`.
+func (c *WebhookSchemaClient) Use(hooks ...Hook) {
+	c.hooks.WebhookSchema = append(c.hooks.WebhookSchema, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookschema.Intercept(f(g(h())))`.
+func (c *WebhookSchemaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookSchema = append(c.inters.WebhookSchema, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookSchema entity.
+func (c *WebhookSchemaClient) Create() *WebhookSchemaCreate {
+	mutation := newWebhookSchemaMutation(c.config, OpCreate)
+	return &WebhookSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookSchema entities.
+func (c *WebhookSchemaClient) CreateBulk(builders ...*WebhookSchemaCreate) *WebhookSchemaCreateBulk {
+	return &WebhookSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookSchemaClient) MapCreateBulk(slice any, setFunc func(*WebhookSchemaCreate, int)) *WebhookSchemaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookSchemaCreateBulk{err: fmt.Errorf("calling to WebhookSchemaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookSchemaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookSchemaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookSchema.
+func (c *WebhookSchemaClient) Update() *WebhookSchemaUpdate {
+	mutation := newWebhookSchemaMutation(c.config, OpUpdate)
+	return &WebhookSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookSchemaClient) UpdateOne(ws *WebhookSchema) *WebhookSchemaUpdateOne {
+	mutation := newWebhookSchemaMutation(c.config, OpUpdateOne, withWebhookSchema(ws))
+	return &WebhookSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookSchemaClient) UpdateOneID(id uuid.UUID) *WebhookSchemaUpdateOne {
+	mutation := newWebhookSchemaMutation(c.config, OpUpdateOne, withWebhookSchemaID(id))
+	return &WebhookSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookSchema.
+func (c *WebhookSchemaClient) Delete() *WebhookSchemaDelete {
+	mutation := newWebhookSchemaMutation(c.config, OpDelete)
+	return &WebhookSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookSchemaClient) DeleteOne(ws *WebhookSchema) *WebhookSchemaDeleteOne {
+	return c.DeleteOneID(ws.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookSchemaClient) DeleteOneID(id uuid.UUID) *WebhookSchemaDeleteOne {
+	builder := c.Delete().Where(webhookschema.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookSchemaDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookSchema.
+func (c *WebhookSchemaClient) Query() *WebhookSchemaQuery {
+	return &WebhookSchemaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookSchema},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookSchema entity by its id.
+func (c *WebhookSchemaClient) Get(ctx context.Context, id uuid.UUID) (*WebhookSchema, error) {
+	return c.Query().Where(webhookschema.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookSchemaClient) GetX(ctx context.Context, id uuid.UUID) *WebhookSchema {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeliveries queries the deliveries edge of a WebhookSchema.
+func (c *WebhookSchemaClient) QueryDeliveries(ws *WebhookSchema) *WebhookDeliverySchemaQuery {
+	query := (&WebhookDeliverySchemaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ws.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookschema.Table, webhookschema.FieldID, id),
+			sqlgraph.To(webhookdeliveryschema.Table, webhookdeliveryschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhookschema.DeliveriesTable, webhookschema.DeliveriesColumn),
+		)
+		schemaConfig := ws.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDeliverySchema
+		step.Edge.Schema = schemaConfig.WebhookDeliverySchema
+		fromV = sqlgraph.Neighbors(ws.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookSchemaClient) Hooks() []Hook {
+	return c.hooks.WebhookSchema
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookSchemaClient) Interceptors() []Interceptor {
+	return c.inters.WebhookSchema
+}
+
+func (c *WebhookSchemaClient) mutate(ctx context.Context, m *WebhookSchemaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookSchemaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookSchemaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookSchemaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookSchemaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown WebhookSchema mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ProjectSchema, TodoSchema []ent.Hook
+		AttachmentSchema, CommentSchema, ExternalRefSchema, ProjectSchema, TagSchema,
+		TodoHistorySchema, TodoSchema, WebhookDeliverySchema, WebhookEventSchema,
+		WebhookSchema []ent.Hook
 	}
 	inters struct {
-		ProjectSchema, TodoSchema []ent.Interceptor
+		AttachmentSchema, CommentSchema, ExternalRefSchema, ProjectSchema, TagSchema,
+		TodoHistorySchema, TodoSchema, WebhookDeliverySchema, WebhookEventSchema,
+		WebhookSchema []ent.Interceptor
 	}
 )
 
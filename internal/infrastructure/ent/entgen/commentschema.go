@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// CommentSchema is the model entity for the CommentSchema schema.
+type CommentSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID uuid.UUID `json:"todo_id,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentSchemaQuery when eager-loading is set.
+	Edges        CommentSchemaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CommentSchemaEdges holds the relations/edges for other nodes in the graph.
+type CommentSchemaEdges struct {
+	// Todo holds the value of the todo edge.
+	Todo *TodoSchema `json:"todo,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// TodoOrErr returns the Todo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentSchemaEdges) TodoOrErr() (*TodoSchema, error) {
+	if e.Todo != nil {
+		return e.Todo, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: todoschema.Label}
+	}
+	return nil, &NotLoadedError{edge: "todo"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommentSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commentschema.FieldAuthor, commentschema.FieldBody:
+			values[i] = new(sql.NullString)
+		case commentschema.FieldCreatedAt, commentschema.FieldUpdatedAt, commentschema.FieldEditedAt, commentschema.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case commentschema.FieldID, commentschema.FieldTodoID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommentSchema fields.
+func (cs *CommentSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commentschema.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cs.ID = *value
+			}
+		case commentschema.FieldTodoID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value != nil {
+				cs.TodoID = *value
+			}
+		case commentschema.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				cs.Author = value.String
+			}
+		case commentschema.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				cs.Body = value.String
+			}
+		case commentschema.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cs.CreatedAt = value.Time
+			}
+		case commentschema.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cs.UpdatedAt = value.Time
+			}
+		case commentschema.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				cs.EditedAt = new(time.Time)
+				*cs.EditedAt = value.Time
+			}
+		case commentschema.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				cs.DeletedAt = new(time.Time)
+				*cs.DeletedAt = value.Time
+			}
+		default:
+			cs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommentSchema.
+// This includes values selected through modifiers, order, etc.
+func (cs *CommentSchema) Value(name string) (ent.Value, error) {
+	return cs.selectValues.Get(name)
+}
+
+// QueryTodo queries the "todo" edge of the CommentSchema entity.
+func (cs *CommentSchema) QueryTodo() *TodoSchemaQuery {
+	return NewCommentSchemaClient(cs.config).QueryTodo(cs)
+}
+
+// Update returns a builder for updating this CommentSchema.
+// Note that you need to call CommentSchema.Unwrap() before calling this method if this CommentSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *CommentSchema) Update() *CommentSchemaUpdateOne {
+	return NewCommentSchemaClient(cs.config).UpdateOne(cs)
+}
+
+// Unwrap unwraps the CommentSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *CommentSchema) Unwrap() *CommentSchema {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: CommentSchema is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *CommentSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommentSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("todo_id=")
+	builder.WriteString(fmt.Sprintf("%v", cs.TodoID))
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(cs.Author)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(cs.Body)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := cs.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := cs.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommentSchemas is a parsable slice of CommentSchema.
+type CommentSchemas []*CommentSchema
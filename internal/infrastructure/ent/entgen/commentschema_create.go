@@ -0,0 +1,896 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// CommentSchemaCreate is the builder for creating a CommentSchema entity.
+type CommentSchemaCreate struct {
+	config
+	mutation *CommentSchemaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTodoID sets the "todo_id" field.
+func (csc *CommentSchemaCreate) SetTodoID(u uuid.UUID) *CommentSchemaCreate {
+	csc.mutation.SetTodoID(u)
+	return csc
+}
+
+// SetAuthor sets the "author" field.
+func (csc *CommentSchemaCreate) SetAuthor(s string) *CommentSchemaCreate {
+	csc.mutation.SetAuthor(s)
+	return csc
+}
+
+// SetBody sets the "body" field.
+func (csc *CommentSchemaCreate) SetBody(s string) *CommentSchemaCreate {
+	csc.mutation.SetBody(s)
+	return csc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csc *CommentSchemaCreate) SetCreatedAt(t time.Time) *CommentSchemaCreate {
+	csc.mutation.SetCreatedAt(t)
+	return csc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csc *CommentSchemaCreate) SetNillableCreatedAt(t *time.Time) *CommentSchemaCreate {
+	if t != nil {
+		csc.SetCreatedAt(*t)
+	}
+	return csc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (csc *CommentSchemaCreate) SetUpdatedAt(t time.Time) *CommentSchemaCreate {
+	csc.mutation.SetUpdatedAt(t)
+	return csc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (csc *CommentSchemaCreate) SetNillableUpdatedAt(t *time.Time) *CommentSchemaCreate {
+	if t != nil {
+		csc.SetUpdatedAt(*t)
+	}
+	return csc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (csc *CommentSchemaCreate) SetEditedAt(t time.Time) *CommentSchemaCreate {
+	csc.mutation.SetEditedAt(t)
+	return csc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (csc *CommentSchemaCreate) SetNillableEditedAt(t *time.Time) *CommentSchemaCreate {
+	if t != nil {
+		csc.SetEditedAt(*t)
+	}
+	return csc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (csc *CommentSchemaCreate) SetDeletedAt(t time.Time) *CommentSchemaCreate {
+	csc.mutation.SetDeletedAt(t)
+	return csc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (csc *CommentSchemaCreate) SetNillableDeletedAt(t *time.Time) *CommentSchemaCreate {
+	if t != nil {
+		csc.SetDeletedAt(*t)
+	}
+	return csc
+}
+
+// SetID sets the "id" field.
+func (csc *CommentSchemaCreate) SetID(u uuid.UUID) *CommentSchemaCreate {
+	csc.mutation.SetID(u)
+	return csc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (csc *CommentSchemaCreate) SetNillableID(u *uuid.UUID) *CommentSchemaCreate {
+	if u != nil {
+		csc.SetID(*u)
+	}
+	return csc
+}
+
+// SetTodo sets the "todo" edge to the TodoSchema entity.
+func (csc *CommentSchemaCreate) SetTodo(t *TodoSchema) *CommentSchemaCreate {
+	return csc.SetTodoID(t.ID)
+}
+
+// Mutation returns the CommentSchemaMutation object of the builder.
+func (csc *CommentSchemaCreate) Mutation() *CommentSchemaMutation {
+	return csc.mutation
+}
+
+// Save creates the CommentSchema in the database.
+func (csc *CommentSchemaCreate) Save(ctx context.Context) (*CommentSchema, error) {
+	csc.defaults()
+	return withHooks(ctx, csc.sqlSave, csc.mutation, csc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *CommentSchemaCreate) SaveX(ctx context.Context) *CommentSchema {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *CommentSchemaCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *CommentSchemaCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *CommentSchemaCreate) defaults() {
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		v := commentschema.DefaultCreatedAt()
+		csc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := csc.mutation.UpdatedAt(); !ok {
+		v := commentschema.DefaultUpdatedAt()
+		csc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := csc.mutation.ID(); !ok {
+		v := commentschema.DefaultID()
+		csc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *CommentSchemaCreate) check() error {
+	if _, ok := csc.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`entgen: missing required field "CommentSchema.todo_id"`)}
+	}
+	if _, ok := csc.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`entgen: missing required field "CommentSchema.author"`)}
+	}
+	if v, ok := csc.mutation.Author(); ok {
+		if err := commentschema.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`entgen: validator failed for field "CommentSchema.author": %w`, err)}
+		}
+	}
+	if _, ok := csc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`entgen: missing required field "CommentSchema.body"`)}
+	}
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entgen: missing required field "CommentSchema.created_at"`)}
+	}
+	if _, ok := csc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entgen: missing required field "CommentSchema.updated_at"`)}
+	}
+	if len(csc.mutation.TodoIDs()) == 0 {
+		return &ValidationError{Name: "todo", err: errors.New(`entgen: missing required edge "CommentSchema.todo"`)}
+	}
+	return nil
+}
+
+func (csc *CommentSchemaCreate) sqlSave(ctx context.Context) (*CommentSchema, error) {
+	if err := csc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	csc.mutation.id = &_node.ID
+	csc.mutation.done = true
+	return _node, nil
+}
+
+func (csc *CommentSchemaCreate) createSpec() (*CommentSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommentSchema{config: csc.config}
+		_spec = sqlgraph.NewCreateSpec(commentschema.Table, sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = csc.schemaConfig.CommentSchema
+	_spec.OnConflict = csc.conflict
+	if id, ok := csc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := csc.mutation.Author(); ok {
+		_spec.SetField(commentschema.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := csc.mutation.Body(); ok {
+		_spec.SetField(commentschema.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := csc.mutation.CreatedAt(); ok {
+		_spec.SetField(commentschema.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := csc.mutation.UpdatedAt(); ok {
+		_spec.SetField(commentschema.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := csc.mutation.EditedAt(); ok {
+		_spec.SetField(commentschema.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := csc.mutation.DeletedAt(); ok {
+		_spec.SetField(commentschema.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := csc.mutation.TodoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentschema.TodoTable,
+			Columns: []string{commentschema.TodoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = csc.schemaConfig.CommentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TodoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommentSchema.Create().
+//		SetTodoID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentSchemaUpsert) {
+//			SetTodoID(v+v).
+//		}).
+//		Exec(ctx)
+func (csc *CommentSchemaCreate) OnConflict(opts ...sql.ConflictOption) *CommentSchemaUpsertOne {
+	csc.conflict = opts
+	return &CommentSchemaUpsertOne{
+		create: csc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommentSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (csc *CommentSchemaCreate) OnConflictColumns(columns ...string) *CommentSchemaUpsertOne {
+	csc.conflict = append(csc.conflict, sql.ConflictColumns(columns...))
+	return &CommentSchemaUpsertOne{
+		create: csc,
+	}
+}
+
+type (
+	// CommentSchemaUpsertOne is the builder for "upsert"-ing
+	//  one CommentSchema node.
+	CommentSchemaUpsertOne struct {
+		create *CommentSchemaCreate
+	}
+
+	// CommentSchemaUpsert is the "OnConflict" setter.
+	CommentSchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAuthor sets the "author" field.
+func (u *CommentSchemaUpsert) SetAuthor(v string) *CommentSchemaUpsert {
+	u.Set(commentschema.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *CommentSchemaUpsert) UpdateAuthor() *CommentSchemaUpsert {
+	u.SetExcluded(commentschema.FieldAuthor)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *CommentSchemaUpsert) SetBody(v string) *CommentSchemaUpsert {
+	u.Set(commentschema.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *CommentSchemaUpsert) UpdateBody() *CommentSchemaUpsert {
+	u.SetExcluded(commentschema.FieldBody)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentSchemaUpsert) SetCreatedAt(v time.Time) *CommentSchemaUpsert {
+	u.Set(commentschema.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsert) UpdateCreatedAt() *CommentSchemaUpsert {
+	u.SetExcluded(commentschema.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentSchemaUpsert) SetUpdatedAt(v time.Time) *CommentSchemaUpsert {
+	u.Set(commentschema.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsert) UpdateUpdatedAt() *CommentSchemaUpsert {
+	u.SetExcluded(commentschema.FieldUpdatedAt)
+	return u
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *CommentSchemaUpsert) SetEditedAt(v time.Time) *CommentSchemaUpsert {
+	u.Set(commentschema.FieldEditedAt, v)
+	return u
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsert) UpdateEditedAt() *CommentSchemaUpsert {
+	u.SetExcluded(commentschema.FieldEditedAt)
+	return u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *CommentSchemaUpsert) ClearEditedAt() *CommentSchemaUpsert {
+	u.SetNull(commentschema.FieldEditedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentSchemaUpsert) SetDeletedAt(v time.Time) *CommentSchemaUpsert {
+	u.Set(commentschema.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsert) UpdateDeletedAt() *CommentSchemaUpsert {
+	u.SetExcluded(commentschema.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentSchemaUpsert) ClearDeletedAt() *CommentSchemaUpsert {
+	u.SetNull(commentschema.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CommentSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(commentschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CommentSchemaUpsertOne) UpdateNewValues() *CommentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(commentschema.FieldID)
+		}
+		if _, exists := u.create.mutation.TodoID(); exists {
+			s.SetIgnore(commentschema.FieldTodoID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommentSchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CommentSchemaUpsertOne) Ignore() *CommentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentSchemaUpsertOne) DoNothing() *CommentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentSchemaCreate.OnConflict
+// documentation for more info.
+func (u *CommentSchemaUpsertOne) Update(set func(*CommentSchemaUpsert)) *CommentSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *CommentSchemaUpsertOne) SetAuthor(v string) *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *CommentSchemaUpsertOne) UpdateAuthor() *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *CommentSchemaUpsertOne) SetBody(v string) *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *CommentSchemaUpsertOne) UpdateBody() *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentSchemaUpsertOne) SetCreatedAt(v time.Time) *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsertOne) UpdateCreatedAt() *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentSchemaUpsertOne) SetUpdatedAt(v time.Time) *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsertOne) UpdateUpdatedAt() *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *CommentSchemaUpsertOne) SetEditedAt(v time.Time) *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsertOne) UpdateEditedAt() *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *CommentSchemaUpsertOne) ClearEditedAt() *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentSchemaUpsertOne) SetDeletedAt(v time.Time) *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsertOne) UpdateDeletedAt() *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentSchemaUpsertOne) ClearDeletedAt() *CommentSchemaUpsertOne {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentSchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for CommentSchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentSchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CommentSchemaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entgen: CommentSchemaUpsertOne.ID is not supported by MySQL driver. Use CommentSchemaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CommentSchemaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CommentSchemaCreateBulk is the builder for creating many CommentSchema entities in bulk.
+type CommentSchemaCreateBulk struct {
+	config
+	err      error
+	builders []*CommentSchemaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CommentSchema entities in the database.
+func (cscb *CommentSchemaCreateBulk) Save(ctx context.Context) ([]*CommentSchema, error) {
+	if cscb.err != nil {
+		return nil, cscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*CommentSchema, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *CommentSchemaCreateBulk) SaveX(ctx context.Context) []*CommentSchema {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *CommentSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *CommentSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommentSchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentSchemaUpsert) {
+//			SetTodoID(v+v).
+//		}).
+//		Exec(ctx)
+func (cscb *CommentSchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *CommentSchemaUpsertBulk {
+	cscb.conflict = opts
+	return &CommentSchemaUpsertBulk{
+		create: cscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommentSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cscb *CommentSchemaCreateBulk) OnConflictColumns(columns ...string) *CommentSchemaUpsertBulk {
+	cscb.conflict = append(cscb.conflict, sql.ConflictColumns(columns...))
+	return &CommentSchemaUpsertBulk{
+		create: cscb,
+	}
+}
+
+// CommentSchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of CommentSchema nodes.
+type CommentSchemaUpsertBulk struct {
+	create *CommentSchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CommentSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(commentschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CommentSchemaUpsertBulk) UpdateNewValues() *CommentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(commentschema.FieldID)
+			}
+			if _, exists := b.mutation.TodoID(); exists {
+				s.SetIgnore(commentschema.FieldTodoID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommentSchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CommentSchemaUpsertBulk) Ignore() *CommentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentSchemaUpsertBulk) DoNothing() *CommentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentSchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *CommentSchemaUpsertBulk) Update(set func(*CommentSchemaUpsert)) *CommentSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *CommentSchemaUpsertBulk) SetAuthor(v string) *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *CommentSchemaUpsertBulk) UpdateAuthor() *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *CommentSchemaUpsertBulk) SetBody(v string) *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *CommentSchemaUpsertBulk) UpdateBody() *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentSchemaUpsertBulk) SetCreatedAt(v time.Time) *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsertBulk) UpdateCreatedAt() *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentSchemaUpsertBulk) SetUpdatedAt(v time.Time) *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsertBulk) UpdateUpdatedAt() *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *CommentSchemaUpsertBulk) SetEditedAt(v time.Time) *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsertBulk) UpdateEditedAt() *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *CommentSchemaUpsertBulk) ClearEditedAt() *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentSchemaUpsertBulk) SetDeletedAt(v time.Time) *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentSchemaUpsertBulk) UpdateDeletedAt() *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentSchemaUpsertBulk) ClearDeletedAt() *CommentSchemaUpsertBulk {
+	return u.Update(func(s *CommentSchemaUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentSchemaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entgen: OnConflict was set for builder %d. Set it on the CommentSchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for CommentSchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentSchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+)
+
+// CommentSchemaUpdate is the builder for updating CommentSchema entities.
+type CommentSchemaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CommentSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CommentSchemaUpdate builder.
+func (csu *CommentSchemaUpdate) Where(ps ...predicate.CommentSchema) *CommentSchemaUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// SetAuthor sets the "author" field.
+func (csu *CommentSchemaUpdate) SetAuthor(s string) *CommentSchemaUpdate {
+	csu.mutation.SetAuthor(s)
+	return csu
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (csu *CommentSchemaUpdate) SetNillableAuthor(s *string) *CommentSchemaUpdate {
+	if s != nil {
+		csu.SetAuthor(*s)
+	}
+	return csu
+}
+
+// SetBody sets the "body" field.
+func (csu *CommentSchemaUpdate) SetBody(s string) *CommentSchemaUpdate {
+	csu.mutation.SetBody(s)
+	return csu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (csu *CommentSchemaUpdate) SetNillableBody(s *string) *CommentSchemaUpdate {
+	if s != nil {
+		csu.SetBody(*s)
+	}
+	return csu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csu *CommentSchemaUpdate) SetCreatedAt(t time.Time) *CommentSchemaUpdate {
+	csu.mutation.SetCreatedAt(t)
+	return csu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csu *CommentSchemaUpdate) SetNillableCreatedAt(t *time.Time) *CommentSchemaUpdate {
+	if t != nil {
+		csu.SetCreatedAt(*t)
+	}
+	return csu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (csu *CommentSchemaUpdate) SetUpdatedAt(t time.Time) *CommentSchemaUpdate {
+	csu.mutation.SetUpdatedAt(t)
+	return csu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (csu *CommentSchemaUpdate) SetEditedAt(t time.Time) *CommentSchemaUpdate {
+	csu.mutation.SetEditedAt(t)
+	return csu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (csu *CommentSchemaUpdate) SetNillableEditedAt(t *time.Time) *CommentSchemaUpdate {
+	if t != nil {
+		csu.SetEditedAt(*t)
+	}
+	return csu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (csu *CommentSchemaUpdate) ClearEditedAt() *CommentSchemaUpdate {
+	csu.mutation.ClearEditedAt()
+	return csu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (csu *CommentSchemaUpdate) SetDeletedAt(t time.Time) *CommentSchemaUpdate {
+	csu.mutation.SetDeletedAt(t)
+	return csu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (csu *CommentSchemaUpdate) SetNillableDeletedAt(t *time.Time) *CommentSchemaUpdate {
+	if t != nil {
+		csu.SetDeletedAt(*t)
+	}
+	return csu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (csu *CommentSchemaUpdate) ClearDeletedAt() *CommentSchemaUpdate {
+	csu.mutation.ClearDeletedAt()
+	return csu
+}
+
+// Mutation returns the CommentSchemaMutation object of the builder.
+func (csu *CommentSchemaUpdate) Mutation() *CommentSchemaMutation {
+	return csu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *CommentSchemaUpdate) Save(ctx context.Context) (int, error) {
+	csu.defaults()
+	return withHooks(ctx, csu.sqlSave, csu.mutation, csu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *CommentSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *CommentSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *CommentSchemaUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csu *CommentSchemaUpdate) defaults() {
+	if _, ok := csu.mutation.UpdatedAt(); !ok {
+		v := commentschema.UpdateDefaultUpdatedAt()
+		csu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csu *CommentSchemaUpdate) check() error {
+	if v, ok := csu.mutation.Author(); ok {
+		if err := commentschema.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`entgen: validator failed for field "CommentSchema.author": %w`, err)}
+		}
+	}
+	if csu.mutation.TodoCleared() && len(csu.mutation.TodoIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "CommentSchema.todo"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (csu *CommentSchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentSchemaUpdate {
+	csu.modifiers = append(csu.modifiers, modifiers...)
+	return csu
+}
+
+func (csu *CommentSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := csu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentschema.Table, commentschema.Columns, sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID))
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csu.mutation.Author(); ok {
+		_spec.SetField(commentschema.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := csu.mutation.Body(); ok {
+		_spec.SetField(commentschema.FieldBody, field.TypeString, value)
+	}
+	if value, ok := csu.mutation.CreatedAt(); ok {
+		_spec.SetField(commentschema.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := csu.mutation.UpdatedAt(); ok {
+		_spec.SetField(commentschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := csu.mutation.EditedAt(); ok {
+		_spec.SetField(commentschema.FieldEditedAt, field.TypeTime, value)
+	}
+	if csu.mutation.EditedAtCleared() {
+		_spec.ClearField(commentschema.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := csu.mutation.DeletedAt(); ok {
+		_spec.SetField(commentschema.FieldDeletedAt, field.TypeTime, value)
+	}
+	if csu.mutation.DeletedAtCleared() {
+		_spec.ClearField(commentschema.FieldDeletedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = csu.schemaConfig.CommentSchema
+	ctx = internal.NewSchemaConfigContext(ctx, csu.schemaConfig)
+	_spec.AddModifiers(csu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	csu.mutation.done = true
+	return n, nil
+}
+
+// CommentSchemaUpdateOne is the builder for updating a single CommentSchema entity.
+type CommentSchemaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CommentSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAuthor sets the "author" field.
+func (csuo *CommentSchemaUpdateOne) SetAuthor(s string) *CommentSchemaUpdateOne {
+	csuo.mutation.SetAuthor(s)
+	return csuo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (csuo *CommentSchemaUpdateOne) SetNillableAuthor(s *string) *CommentSchemaUpdateOne {
+	if s != nil {
+		csuo.SetAuthor(*s)
+	}
+	return csuo
+}
+
+// SetBody sets the "body" field.
+func (csuo *CommentSchemaUpdateOne) SetBody(s string) *CommentSchemaUpdateOne {
+	csuo.mutation.SetBody(s)
+	return csuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (csuo *CommentSchemaUpdateOne) SetNillableBody(s *string) *CommentSchemaUpdateOne {
+	if s != nil {
+		csuo.SetBody(*s)
+	}
+	return csuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csuo *CommentSchemaUpdateOne) SetCreatedAt(t time.Time) *CommentSchemaUpdateOne {
+	csuo.mutation.SetCreatedAt(t)
+	return csuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csuo *CommentSchemaUpdateOne) SetNillableCreatedAt(t *time.Time) *CommentSchemaUpdateOne {
+	if t != nil {
+		csuo.SetCreatedAt(*t)
+	}
+	return csuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (csuo *CommentSchemaUpdateOne) SetUpdatedAt(t time.Time) *CommentSchemaUpdateOne {
+	csuo.mutation.SetUpdatedAt(t)
+	return csuo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (csuo *CommentSchemaUpdateOne) SetEditedAt(t time.Time) *CommentSchemaUpdateOne {
+	csuo.mutation.SetEditedAt(t)
+	return csuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (csuo *CommentSchemaUpdateOne) SetNillableEditedAt(t *time.Time) *CommentSchemaUpdateOne {
+	if t != nil {
+		csuo.SetEditedAt(*t)
+	}
+	return csuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (csuo *CommentSchemaUpdateOne) ClearEditedAt() *CommentSchemaUpdateOne {
+	csuo.mutation.ClearEditedAt()
+	return csuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (csuo *CommentSchemaUpdateOne) SetDeletedAt(t time.Time) *CommentSchemaUpdateOne {
+	csuo.mutation.SetDeletedAt(t)
+	return csuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (csuo *CommentSchemaUpdateOne) SetNillableDeletedAt(t *time.Time) *CommentSchemaUpdateOne {
+	if t != nil {
+		csuo.SetDeletedAt(*t)
+	}
+	return csuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (csuo *CommentSchemaUpdateOne) ClearDeletedAt() *CommentSchemaUpdateOne {
+	csuo.mutation.ClearDeletedAt()
+	return csuo
+}
+
+// Mutation returns the CommentSchemaMutation object of the builder.
+func (csuo *CommentSchemaUpdateOne) Mutation() *CommentSchemaMutation {
+	return csuo.mutation
+}
+
+// Where appends a list predicates to the CommentSchemaUpdate builder.
+func (csuo *CommentSchemaUpdateOne) Where(ps ...predicate.CommentSchema) *CommentSchemaUpdateOne {
+	csuo.mutation.Where(ps...)
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *CommentSchemaUpdateOne) Select(field string, fields ...string) *CommentSchemaUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated CommentSchema entity.
+func (csuo *CommentSchemaUpdateOne) Save(ctx context.Context) (*CommentSchema, error) {
+	csuo.defaults()
+	return withHooks(ctx, csuo.sqlSave, csuo.mutation, csuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *CommentSchemaUpdateOne) SaveX(ctx context.Context) *CommentSchema {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *CommentSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *CommentSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csuo *CommentSchemaUpdateOne) defaults() {
+	if _, ok := csuo.mutation.UpdatedAt(); !ok {
+		v := commentschema.UpdateDefaultUpdatedAt()
+		csuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csuo *CommentSchemaUpdateOne) check() error {
+	if v, ok := csuo.mutation.Author(); ok {
+		if err := commentschema.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`entgen: validator failed for field "CommentSchema.author": %w`, err)}
+		}
+	}
+	if csuo.mutation.TodoCleared() && len(csuo.mutation.TodoIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "CommentSchema.todo"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (csuo *CommentSchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentSchemaUpdateOne {
+	csuo.modifiers = append(csuo.modifiers, modifiers...)
+	return csuo
+}
+
+func (csuo *CommentSchemaUpdateOne) sqlSave(ctx context.Context) (_node *CommentSchema, err error) {
+	if err := csuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentschema.Table, commentschema.Columns, sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID))
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "CommentSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commentschema.FieldID)
+		for _, f := range fields {
+			if !commentschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != commentschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csuo.mutation.Author(); ok {
+		_spec.SetField(commentschema.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := csuo.mutation.Body(); ok {
+		_spec.SetField(commentschema.FieldBody, field.TypeString, value)
+	}
+	if value, ok := csuo.mutation.CreatedAt(); ok {
+		_spec.SetField(commentschema.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := csuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(commentschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := csuo.mutation.EditedAt(); ok {
+		_spec.SetField(commentschema.FieldEditedAt, field.TypeTime, value)
+	}
+	if csuo.mutation.EditedAtCleared() {
+		_spec.ClearField(commentschema.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := csuo.mutation.DeletedAt(); ok {
+		_spec.SetField(commentschema.FieldDeletedAt, field.TypeTime, value)
+	}
+	if csuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(commentschema.FieldDeletedAt, field.TypeTime)
+	}
+	_spec.Node.Schema = csuo.schemaConfig.CommentSchema
+	ctx = internal.NewSchemaConfigContext(ctx, csuo.schemaConfig)
+	_spec.AddModifiers(csuo.modifiers...)
+	_node = &CommentSchema{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csuo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,16 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +82,16 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			projectschema.Table: projectschema.ValidColumn,
-			todoschema.Table:    todoschema.ValidColumn,
+			attachmentschema.Table:      attachmentschema.ValidColumn,
+			commentschema.Table:         commentschema.ValidColumn,
+			externalrefschema.Table:     externalrefschema.ValidColumn,
+			projectschema.Table:         projectschema.ValidColumn,
+			tagschema.Table:             tagschema.ValidColumn,
+			todohistoryschema.Table:     todohistoryschema.ValidColumn,
+			todoschema.Table:            todoschema.ValidColumn,
+			webhookdeliveryschema.Table: webhookdeliveryschema.ValidColumn,
+			webhookeventschema.Table:    webhookeventschema.ValidColumn,
+			webhookschema.Table:         webhookschema.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,8 +3,17 @@
 package entgen
 
 import (
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -14,8 +23,65 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 2)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 10)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   attachmentschema.Table,
+			Columns: attachmentschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: attachmentschema.FieldID,
+			},
+		},
+		Type: "AttachmentSchema",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			attachmentschema.FieldTodoID:      {Type: field.TypeUUID, Column: attachmentschema.FieldTodoID},
+			attachmentschema.FieldFilename:    {Type: field.TypeString, Column: attachmentschema.FieldFilename},
+			attachmentschema.FieldContentType: {Type: field.TypeString, Column: attachmentschema.FieldContentType},
+			attachmentschema.FieldSize:        {Type: field.TypeInt64, Column: attachmentschema.FieldSize},
+			attachmentschema.FieldChecksum:    {Type: field.TypeString, Column: attachmentschema.FieldChecksum},
+			attachmentschema.FieldCreatedAt:   {Type: field.TypeTime, Column: attachmentschema.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   commentschema.Table,
+			Columns: commentschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: commentschema.FieldID,
+			},
+		},
+		Type: "CommentSchema",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			commentschema.FieldTodoID:    {Type: field.TypeUUID, Column: commentschema.FieldTodoID},
+			commentschema.FieldAuthor:    {Type: field.TypeString, Column: commentschema.FieldAuthor},
+			commentschema.FieldBody:      {Type: field.TypeString, Column: commentschema.FieldBody},
+			commentschema.FieldCreatedAt: {Type: field.TypeTime, Column: commentschema.FieldCreatedAt},
+			commentschema.FieldUpdatedAt: {Type: field.TypeTime, Column: commentschema.FieldUpdatedAt},
+			commentschema.FieldEditedAt:  {Type: field.TypeTime, Column: commentschema.FieldEditedAt},
+			commentschema.FieldDeletedAt: {Type: field.TypeTime, Column: commentschema.FieldDeletedAt},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   externalrefschema.Table,
+			Columns: externalrefschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: externalrefschema.FieldID,
+			},
+		},
+		Type: "ExternalRefSchema",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			externalrefschema.FieldSource:     {Type: field.TypeEnum, Column: externalrefschema.FieldSource},
+			externalrefschema.FieldKind:       {Type: field.TypeEnum, Column: externalrefschema.FieldKind},
+			externalrefschema.FieldExternalID: {Type: field.TypeString, Column: externalrefschema.FieldExternalID},
+			externalrefschema.FieldLocalID:    {Type: field.TypeUUID, Column: externalrefschema.FieldLocalID},
+			externalrefschema.FieldCreatedAt:  {Type: field.TypeTime, Column: externalrefschema.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   projectschema.Table,
 			Columns: projectschema.Columns,
@@ -24,10 +90,52 @@ var schemaGraph = func() *sqlgraph.Schema {
 				Column: projectschema.FieldID,
 			},
 		},
-		Type:   "ProjectSchema",
-		Fields: map[string]*sqlgraph.FieldSpec{},
+		Type: "ProjectSchema",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			projectschema.FieldName:      {Type: field.TypeString, Column: projectschema.FieldName},
+			projectschema.FieldWorkflow:  {Type: field.TypeJSON, Column: projectschema.FieldWorkflow},
+			projectschema.FieldCreatedAt: {Type: field.TypeTime, Column: projectschema.FieldCreatedAt},
+			projectschema.FieldUpdatedAt: {Type: field.TypeTime, Column: projectschema.FieldUpdatedAt},
+		},
 	}
-	graph.Nodes[1] = &sqlgraph.Node{
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   tagschema.Table,
+			Columns: tagschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: tagschema.FieldID,
+			},
+		},
+		Type: "TagSchema",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			tagschema.FieldName:      {Type: field.TypeString, Column: tagschema.FieldName},
+			tagschema.FieldColor:     {Type: field.TypeString, Column: tagschema.FieldColor},
+			tagschema.FieldCreatedAt: {Type: field.TypeTime, Column: tagschema.FieldCreatedAt},
+			tagschema.FieldUpdatedAt: {Type: field.TypeTime, Column: tagschema.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   todohistoryschema.Table,
+			Columns: todohistoryschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: todohistoryschema.FieldID,
+			},
+		},
+		Type: "TodoHistorySchema",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			todohistoryschema.FieldTodoID:     {Type: field.TypeUUID, Column: todohistoryschema.FieldTodoID},
+			todohistoryschema.FieldVersion:    {Type: field.TypeInt, Column: todohistoryschema.FieldVersion},
+			todohistoryschema.FieldProjectID:  {Type: field.TypeUUID, Column: todohistoryschema.FieldProjectID},
+			todohistoryschema.FieldActor:      {Type: field.TypeString, Column: todohistoryschema.FieldActor},
+			todohistoryschema.FieldOperation:  {Type: field.TypeEnum, Column: todohistoryschema.FieldOperation},
+			todohistoryschema.FieldChanges:    {Type: field.TypeJSON, Column: todohistoryschema.FieldChanges},
+			todohistoryschema.FieldOccurredAt: {Type: field.TypeTime, Column: todohistoryschema.FieldOccurredAt},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   todoschema.Table,
 			Columns: todoschema.Columns,
@@ -45,8 +153,240 @@ var schemaGraph = func() *sqlgraph.Schema {
 			todoschema.FieldUpdatedAt:   {Type: field.TypeTime, Column: todoschema.FieldUpdatedAt},
 			todoschema.FieldCompletedAt: {Type: field.TypeTime, Column: todoschema.FieldCompletedAt},
 			todoschema.FieldDeletedAt:   {Type: field.TypeTime, Column: todoschema.FieldDeletedAt},
+			todoschema.FieldParentID:    {Type: field.TypeUUID, Column: todoschema.FieldParentID},
+			todoschema.FieldProjectID:   {Type: field.TypeUUID, Column: todoschema.FieldProjectID},
+			todoschema.FieldStatusID:    {Type: field.TypeString, Column: todoschema.FieldStatusID},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webhookdeliveryschema.Table,
+			Columns: webhookdeliveryschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookdeliveryschema.FieldID,
+			},
+		},
+		Type: "WebhookDeliverySchema",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webhookdeliveryschema.FieldWebhookID:      {Type: field.TypeUUID, Column: webhookdeliveryschema.FieldWebhookID},
+			webhookdeliveryschema.FieldEventID:        {Type: field.TypeUUID, Column: webhookdeliveryschema.FieldEventID},
+			webhookdeliveryschema.FieldEventType:      {Type: field.TypeString, Column: webhookdeliveryschema.FieldEventType},
+			webhookdeliveryschema.FieldPayload:        {Type: field.TypeBytes, Column: webhookdeliveryschema.FieldPayload},
+			webhookdeliveryschema.FieldStatus:         {Type: field.TypeEnum, Column: webhookdeliveryschema.FieldStatus},
+			webhookdeliveryschema.FieldAttempts:       {Type: field.TypeInt, Column: webhookdeliveryschema.FieldAttempts},
+			webhookdeliveryschema.FieldResponseStatus: {Type: field.TypeInt, Column: webhookdeliveryschema.FieldResponseStatus},
+			webhookdeliveryschema.FieldLastError:      {Type: field.TypeString, Column: webhookdeliveryschema.FieldLastError},
+			webhookdeliveryschema.FieldNextAttemptAt:  {Type: field.TypeTime, Column: webhookdeliveryschema.FieldNextAttemptAt},
+			webhookdeliveryschema.FieldCreatedAt:      {Type: field.TypeTime, Column: webhookdeliveryschema.FieldCreatedAt},
+			webhookdeliveryschema.FieldUpdatedAt:      {Type: field.TypeTime, Column: webhookdeliveryschema.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webhookeventschema.Table,
+			Columns: webhookeventschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookeventschema.FieldID,
+			},
+		},
+		Type: "WebhookEventSchema",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webhookeventschema.FieldType:       {Type: field.TypeString, Column: webhookeventschema.FieldType},
+			webhookeventschema.FieldTodoID:     {Type: field.TypeUUID, Column: webhookeventschema.FieldTodoID},
+			webhookeventschema.FieldPayload:    {Type: field.TypeBytes, Column: webhookeventschema.FieldPayload},
+			webhookeventschema.FieldOccurredAt: {Type: field.TypeTime, Column: webhookeventschema.FieldOccurredAt},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webhookschema.Table,
+			Columns: webhookschema.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookschema.FieldID,
+			},
+		},
+		Type: "WebhookSchema",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webhookschema.FieldURL:                 {Type: field.TypeString, Column: webhookschema.FieldURL},
+			webhookschema.FieldSecret:              {Type: field.TypeString, Column: webhookschema.FieldSecret},
+			webhookschema.FieldEvents:              {Type: field.TypeJSON, Column: webhookschema.FieldEvents},
+			webhookschema.FieldEnabled:             {Type: field.TypeBool, Column: webhookschema.FieldEnabled},
+			webhookschema.FieldConsecutiveFailures: {Type: field.TypeInt, Column: webhookschema.FieldConsecutiveFailures},
+			webhookschema.FieldCreatedAt:           {Type: field.TypeTime, Column: webhookschema.FieldCreatedAt},
+			webhookschema.FieldUpdatedAt:           {Type: field.TypeTime, Column: webhookschema.FieldUpdatedAt},
 		},
 	}
+	graph.MustAddE(
+		"todo",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attachmentschema.TodoTable,
+			Columns: []string{attachmentschema.TodoColumn},
+			Bidi:    false,
+		},
+		"AttachmentSchema",
+		"TodoSchema",
+	)
+	graph.MustAddE(
+		"todo",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentschema.TodoTable,
+			Columns: []string{commentschema.TodoColumn},
+			Bidi:    false,
+		},
+		"CommentSchema",
+		"TodoSchema",
+	)
+	graph.MustAddE(
+		"todos",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectschema.TodosTable,
+			Columns: []string{projectschema.TodosColumn},
+			Bidi:    false,
+		},
+		"ProjectSchema",
+		"TodoSchema",
+	)
+	graph.MustAddE(
+		"todos",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tagschema.TodosTable,
+			Columns: tagschema.TodosPrimaryKey,
+			Bidi:    false,
+		},
+		"TagSchema",
+		"TodoSchema",
+	)
+	graph.MustAddE(
+		"project",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ProjectTable,
+			Columns: []string{todoschema.ProjectColumn},
+			Bidi:    false,
+		},
+		"TodoSchema",
+		"ProjectSchema",
+	)
+	graph.MustAddE(
+		"tags",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.TagsTable,
+			Columns: todoschema.TagsPrimaryKey,
+			Bidi:    false,
+		},
+		"TodoSchema",
+		"TagSchema",
+	)
+	graph.MustAddE(
+		"parent",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ParentTable,
+			Columns: []string{todoschema.ParentColumn},
+			Bidi:    false,
+		},
+		"TodoSchema",
+		"TodoSchema",
+	)
+	graph.MustAddE(
+		"children",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.ChildrenTable,
+			Columns: []string{todoschema.ChildrenColumn},
+			Bidi:    false,
+		},
+		"TodoSchema",
+		"TodoSchema",
+	)
+	graph.MustAddE(
+		"blocks",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoschema.BlocksTable,
+			Columns: todoschema.BlocksPrimaryKey,
+			Bidi:    false,
+		},
+		"TodoSchema",
+		"TodoSchema",
+	)
+	graph.MustAddE(
+		"blocked_by",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.BlockedByTable,
+			Columns: todoschema.BlockedByPrimaryKey,
+			Bidi:    false,
+		},
+		"TodoSchema",
+		"TodoSchema",
+	)
+	graph.MustAddE(
+		"comments",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.CommentsTable,
+			Columns: []string{todoschema.CommentsColumn},
+			Bidi:    false,
+		},
+		"TodoSchema",
+		"CommentSchema",
+	)
+	graph.MustAddE(
+		"attachments",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.AttachmentsTable,
+			Columns: []string{todoschema.AttachmentsColumn},
+			Bidi:    false,
+		},
+		"TodoSchema",
+		"AttachmentSchema",
+	)
+	graph.MustAddE(
+		"webhook",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdeliveryschema.WebhookTable,
+			Columns: []string{webhookdeliveryschema.WebhookColumn},
+			Bidi:    false,
+		},
+		"WebhookDeliverySchema",
+		"WebhookSchema",
+	)
+	graph.MustAddE(
+		"deliveries",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookschema.DeliveriesTable,
+			Columns: []string{webhookschema.DeliveriesColumn},
+			Bidi:    false,
+		},
+		"WebhookSchema",
+		"WebhookDeliverySchema",
+	)
 	return graph
 }()
 
@@ -56,6 +396,244 @@ type predicateAdder interface {
 	addPredicate(func(s *sql.Selector))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (asq *AttachmentSchemaQuery) addPredicate(pred func(s *sql.Selector)) {
+	asq.predicates = append(asq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AttachmentSchemaQuery builder.
+func (asq *AttachmentSchemaQuery) Filter() *AttachmentSchemaFilter {
+	return &AttachmentSchemaFilter{config: asq.config, predicateAdder: asq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AttachmentSchemaMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AttachmentSchemaMutation builder.
+func (m *AttachmentSchemaMutation) Filter() *AttachmentSchemaFilter {
+	return &AttachmentSchemaFilter{config: m.config, predicateAdder: m}
+}
+
+// AttachmentSchemaFilter provides a generic filtering capability at runtime for AttachmentSchemaQuery.
+type AttachmentSchemaFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AttachmentSchemaFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *AttachmentSchemaFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(attachmentschema.FieldID))
+}
+
+// WhereTodoID applies the entql [16]byte predicate on the todo_id field.
+func (f *AttachmentSchemaFilter) WhereTodoID(p entql.ValueP) {
+	f.Where(p.Field(attachmentschema.FieldTodoID))
+}
+
+// WhereFilename applies the entql string predicate on the filename field.
+func (f *AttachmentSchemaFilter) WhereFilename(p entql.StringP) {
+	f.Where(p.Field(attachmentschema.FieldFilename))
+}
+
+// WhereContentType applies the entql string predicate on the content_type field.
+func (f *AttachmentSchemaFilter) WhereContentType(p entql.StringP) {
+	f.Where(p.Field(attachmentschema.FieldContentType))
+}
+
+// WhereSize applies the entql int64 predicate on the size field.
+func (f *AttachmentSchemaFilter) WhereSize(p entql.Int64P) {
+	f.Where(p.Field(attachmentschema.FieldSize))
+}
+
+// WhereChecksum applies the entql string predicate on the checksum field.
+func (f *AttachmentSchemaFilter) WhereChecksum(p entql.StringP) {
+	f.Where(p.Field(attachmentschema.FieldChecksum))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AttachmentSchemaFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(attachmentschema.FieldCreatedAt))
+}
+
+// WhereHasTodo applies a predicate to check if query has an edge todo.
+func (f *AttachmentSchemaFilter) WhereHasTodo() {
+	f.Where(entql.HasEdge("todo"))
+}
+
+// WhereHasTodoWith applies a predicate to check if query has an edge todo with a given conditions (other predicates).
+func (f *AttachmentSchemaFilter) WhereHasTodoWith(preds ...predicate.TodoSchema) {
+	f.Where(entql.HasEdgeWith("todo", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (csq *CommentSchemaQuery) addPredicate(pred func(s *sql.Selector)) {
+	csq.predicates = append(csq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CommentSchemaQuery builder.
+func (csq *CommentSchemaQuery) Filter() *CommentSchemaFilter {
+	return &CommentSchemaFilter{config: csq.config, predicateAdder: csq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CommentSchemaMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CommentSchemaMutation builder.
+func (m *CommentSchemaMutation) Filter() *CommentSchemaFilter {
+	return &CommentSchemaFilter{config: m.config, predicateAdder: m}
+}
+
+// CommentSchemaFilter provides a generic filtering capability at runtime for CommentSchemaQuery.
+type CommentSchemaFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CommentSchemaFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *CommentSchemaFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(commentschema.FieldID))
+}
+
+// WhereTodoID applies the entql [16]byte predicate on the todo_id field.
+func (f *CommentSchemaFilter) WhereTodoID(p entql.ValueP) {
+	f.Where(p.Field(commentschema.FieldTodoID))
+}
+
+// WhereAuthor applies the entql string predicate on the author field.
+func (f *CommentSchemaFilter) WhereAuthor(p entql.StringP) {
+	f.Where(p.Field(commentschema.FieldAuthor))
+}
+
+// WhereBody applies the entql string predicate on the body field.
+func (f *CommentSchemaFilter) WhereBody(p entql.StringP) {
+	f.Where(p.Field(commentschema.FieldBody))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *CommentSchemaFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(commentschema.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *CommentSchemaFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(commentschema.FieldUpdatedAt))
+}
+
+// WhereEditedAt applies the entql time.Time predicate on the edited_at field.
+func (f *CommentSchemaFilter) WhereEditedAt(p entql.TimeP) {
+	f.Where(p.Field(commentschema.FieldEditedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *CommentSchemaFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(commentschema.FieldDeletedAt))
+}
+
+// WhereHasTodo applies a predicate to check if query has an edge todo.
+func (f *CommentSchemaFilter) WhereHasTodo() {
+	f.Where(entql.HasEdge("todo"))
+}
+
+// WhereHasTodoWith applies a predicate to check if query has an edge todo with a given conditions (other predicates).
+func (f *CommentSchemaFilter) WhereHasTodoWith(preds ...predicate.TodoSchema) {
+	f.Where(entql.HasEdgeWith("todo", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (ersq *ExternalRefSchemaQuery) addPredicate(pred func(s *sql.Selector)) {
+	ersq.predicates = append(ersq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ExternalRefSchemaQuery builder.
+func (ersq *ExternalRefSchemaQuery) Filter() *ExternalRefSchemaFilter {
+	return &ExternalRefSchemaFilter{config: ersq.config, predicateAdder: ersq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ExternalRefSchemaMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ExternalRefSchemaMutation builder.
+func (m *ExternalRefSchemaMutation) Filter() *ExternalRefSchemaFilter {
+	return &ExternalRefSchemaFilter{config: m.config, predicateAdder: m}
+}
+
+// ExternalRefSchemaFilter provides a generic filtering capability at runtime for ExternalRefSchemaQuery.
+type ExternalRefSchemaFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ExternalRefSchemaFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *ExternalRefSchemaFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(externalrefschema.FieldID))
+}
+
+// WhereSource applies the entql string predicate on the source field.
+func (f *ExternalRefSchemaFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(externalrefschema.FieldSource))
+}
+
+// WhereKind applies the entql string predicate on the kind field.
+func (f *ExternalRefSchemaFilter) WhereKind(p entql.StringP) {
+	f.Where(p.Field(externalrefschema.FieldKind))
+}
+
+// WhereExternalID applies the entql string predicate on the external_id field.
+func (f *ExternalRefSchemaFilter) WhereExternalID(p entql.StringP) {
+	f.Where(p.Field(externalrefschema.FieldExternalID))
+}
+
+// WhereLocalID applies the entql [16]byte predicate on the local_id field.
+func (f *ExternalRefSchemaFilter) WhereLocalID(p entql.ValueP) {
+	f.Where(p.Field(externalrefschema.FieldLocalID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ExternalRefSchemaFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(externalrefschema.FieldCreatedAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (psq *ProjectSchemaQuery) addPredicate(pred func(s *sql.Selector)) {
 	psq.predicates = append(psq.predicates, pred)
@@ -85,7 +663,7 @@ type ProjectSchemaFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ProjectSchemaFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -96,6 +674,189 @@ func (f *ProjectSchemaFilter) WhereID(p entql.ValueP) {
 	f.Where(p.Field(projectschema.FieldID))
 }
 
+// WhereName applies the entql string predicate on the name field.
+func (f *ProjectSchemaFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(projectschema.FieldName))
+}
+
+// WhereWorkflow applies the entql json.RawMessage predicate on the workflow field.
+func (f *ProjectSchemaFilter) WhereWorkflow(p entql.BytesP) {
+	f.Where(p.Field(projectschema.FieldWorkflow))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ProjectSchemaFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(projectschema.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ProjectSchemaFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(projectschema.FieldUpdatedAt))
+}
+
+// WhereHasTodos applies a predicate to check if query has an edge todos.
+func (f *ProjectSchemaFilter) WhereHasTodos() {
+	f.Where(entql.HasEdge("todos"))
+}
+
+// WhereHasTodosWith applies a predicate to check if query has an edge todos with a given conditions (other predicates).
+func (f *ProjectSchemaFilter) WhereHasTodosWith(preds ...predicate.TodoSchema) {
+	f.Where(entql.HasEdgeWith("todos", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (tsq *TagSchemaQuery) addPredicate(pred func(s *sql.Selector)) {
+	tsq.predicates = append(tsq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TagSchemaQuery builder.
+func (tsq *TagSchemaQuery) Filter() *TagSchemaFilter {
+	return &TagSchemaFilter{config: tsq.config, predicateAdder: tsq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TagSchemaMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TagSchemaMutation builder.
+func (m *TagSchemaMutation) Filter() *TagSchemaFilter {
+	return &TagSchemaFilter{config: m.config, predicateAdder: m}
+}
+
+// TagSchemaFilter provides a generic filtering capability at runtime for TagSchemaQuery.
+type TagSchemaFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TagSchemaFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *TagSchemaFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(tagschema.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *TagSchemaFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(tagschema.FieldName))
+}
+
+// WhereColor applies the entql string predicate on the color field.
+func (f *TagSchemaFilter) WhereColor(p entql.StringP) {
+	f.Where(p.Field(tagschema.FieldColor))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TagSchemaFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(tagschema.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TagSchemaFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(tagschema.FieldUpdatedAt))
+}
+
+// WhereHasTodos applies a predicate to check if query has an edge todos.
+func (f *TagSchemaFilter) WhereHasTodos() {
+	f.Where(entql.HasEdge("todos"))
+}
+
+// WhereHasTodosWith applies a predicate to check if query has an edge todos with a given conditions (other predicates).
+func (f *TagSchemaFilter) WhereHasTodosWith(preds ...predicate.TodoSchema) {
+	f.Where(entql.HasEdgeWith("todos", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (thsq *TodoHistorySchemaQuery) addPredicate(pred func(s *sql.Selector)) {
+	thsq.predicates = append(thsq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TodoHistorySchemaQuery builder.
+func (thsq *TodoHistorySchemaQuery) Filter() *TodoHistorySchemaFilter {
+	return &TodoHistorySchemaFilter{config: thsq.config, predicateAdder: thsq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TodoHistorySchemaMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TodoHistorySchemaMutation builder.
+func (m *TodoHistorySchemaMutation) Filter() *TodoHistorySchemaFilter {
+	return &TodoHistorySchemaFilter{config: m.config, predicateAdder: m}
+}
+
+// TodoHistorySchemaFilter provides a generic filtering capability at runtime for TodoHistorySchemaQuery.
+type TodoHistorySchemaFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TodoHistorySchemaFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *TodoHistorySchemaFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(todohistoryschema.FieldID))
+}
+
+// WhereTodoID applies the entql [16]byte predicate on the todo_id field.
+func (f *TodoHistorySchemaFilter) WhereTodoID(p entql.ValueP) {
+	f.Where(p.Field(todohistoryschema.FieldTodoID))
+}
+
+// WhereVersion applies the entql int predicate on the version field.
+func (f *TodoHistorySchemaFilter) WhereVersion(p entql.IntP) {
+	f.Where(p.Field(todohistoryschema.FieldVersion))
+}
+
+// WhereProjectID applies the entql [16]byte predicate on the project_id field.
+func (f *TodoHistorySchemaFilter) WhereProjectID(p entql.ValueP) {
+	f.Where(p.Field(todohistoryschema.FieldProjectID))
+}
+
+// WhereActor applies the entql string predicate on the actor field.
+func (f *TodoHistorySchemaFilter) WhereActor(p entql.StringP) {
+	f.Where(p.Field(todohistoryschema.FieldActor))
+}
+
+// WhereOperation applies the entql string predicate on the operation field.
+func (f *TodoHistorySchemaFilter) WhereOperation(p entql.StringP) {
+	f.Where(p.Field(todohistoryschema.FieldOperation))
+}
+
+// WhereChanges applies the entql json.RawMessage predicate on the changes field.
+func (f *TodoHistorySchemaFilter) WhereChanges(p entql.BytesP) {
+	f.Where(p.Field(todohistoryschema.FieldChanges))
+}
+
+// WhereOccurredAt applies the entql time.Time predicate on the occurred_at field.
+func (f *TodoHistorySchemaFilter) WhereOccurredAt(p entql.TimeP) {
+	f.Where(p.Field(todohistoryschema.FieldOccurredAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (tsq *TodoSchemaQuery) addPredicate(pred func(s *sql.Selector)) {
 	tsq.predicates = append(tsq.predicates, pred)
@@ -125,7 +886,7 @@ type TodoSchemaFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TodoSchemaFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -170,3 +931,388 @@ func (f *TodoSchemaFilter) WhereCompletedAt(p entql.TimeP) {
 func (f *TodoSchemaFilter) WhereDeletedAt(p entql.TimeP) {
 	f.Where(p.Field(todoschema.FieldDeletedAt))
 }
+
+// WhereParentID applies the entql [16]byte predicate on the parent_id field.
+func (f *TodoSchemaFilter) WhereParentID(p entql.ValueP) {
+	f.Where(p.Field(todoschema.FieldParentID))
+}
+
+// WhereProjectID applies the entql [16]byte predicate on the project_id field.
+func (f *TodoSchemaFilter) WhereProjectID(p entql.ValueP) {
+	f.Where(p.Field(todoschema.FieldProjectID))
+}
+
+// WhereStatusID applies the entql string predicate on the status_id field.
+func (f *TodoSchemaFilter) WhereStatusID(p entql.StringP) {
+	f.Where(p.Field(todoschema.FieldStatusID))
+}
+
+// WhereHasProject applies a predicate to check if query has an edge project.
+func (f *TodoSchemaFilter) WhereHasProject() {
+	f.Where(entql.HasEdge("project"))
+}
+
+// WhereHasProjectWith applies a predicate to check if query has an edge project with a given conditions (other predicates).
+func (f *TodoSchemaFilter) WhereHasProjectWith(preds ...predicate.ProjectSchema) {
+	f.Where(entql.HasEdgeWith("project", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTags applies a predicate to check if query has an edge tags.
+func (f *TodoSchemaFilter) WhereHasTags() {
+	f.Where(entql.HasEdge("tags"))
+}
+
+// WhereHasTagsWith applies a predicate to check if query has an edge tags with a given conditions (other predicates).
+func (f *TodoSchemaFilter) WhereHasTagsWith(preds ...predicate.TagSchema) {
+	f.Where(entql.HasEdgeWith("tags", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *TodoSchemaFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *TodoSchemaFilter) WhereHasParentWith(preds ...predicate.TodoSchema) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasChildren applies a predicate to check if query has an edge children.
+func (f *TodoSchemaFilter) WhereHasChildren() {
+	f.Where(entql.HasEdge("children"))
+}
+
+// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
+func (f *TodoSchemaFilter) WhereHasChildrenWith(preds ...predicate.TodoSchema) {
+	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasBlocks applies a predicate to check if query has an edge blocks.
+func (f *TodoSchemaFilter) WhereHasBlocks() {
+	f.Where(entql.HasEdge("blocks"))
+}
+
+// WhereHasBlocksWith applies a predicate to check if query has an edge blocks with a given conditions (other predicates).
+func (f *TodoSchemaFilter) WhereHasBlocksWith(preds ...predicate.TodoSchema) {
+	f.Where(entql.HasEdgeWith("blocks", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasBlockedBy applies a predicate to check if query has an edge blocked_by.
+func (f *TodoSchemaFilter) WhereHasBlockedBy() {
+	f.Where(entql.HasEdge("blocked_by"))
+}
+
+// WhereHasBlockedByWith applies a predicate to check if query has an edge blocked_by with a given conditions (other predicates).
+func (f *TodoSchemaFilter) WhereHasBlockedByWith(preds ...predicate.TodoSchema) {
+	f.Where(entql.HasEdgeWith("blocked_by", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasComments applies a predicate to check if query has an edge comments.
+func (f *TodoSchemaFilter) WhereHasComments() {
+	f.Where(entql.HasEdge("comments"))
+}
+
+// WhereHasCommentsWith applies a predicate to check if query has an edge comments with a given conditions (other predicates).
+func (f *TodoSchemaFilter) WhereHasCommentsWith(preds ...predicate.CommentSchema) {
+	f.Where(entql.HasEdgeWith("comments", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAttachments applies a predicate to check if query has an edge attachments.
+func (f *TodoSchemaFilter) WhereHasAttachments() {
+	f.Where(entql.HasEdge("attachments"))
+}
+
+// WhereHasAttachmentsWith applies a predicate to check if query has an edge attachments with a given conditions (other predicates).
+func (f *TodoSchemaFilter) WhereHasAttachmentsWith(preds ...predicate.AttachmentSchema) {
+	f.Where(entql.HasEdgeWith("attachments", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (wdsq *WebhookDeliverySchemaQuery) addPredicate(pred func(s *sql.Selector)) {
+	wdsq.predicates = append(wdsq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebhookDeliverySchemaQuery builder.
+func (wdsq *WebhookDeliverySchemaQuery) Filter() *WebhookDeliverySchemaFilter {
+	return &WebhookDeliverySchemaFilter{config: wdsq.config, predicateAdder: wdsq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebhookDeliverySchemaMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebhookDeliverySchemaMutation builder.
+func (m *WebhookDeliverySchemaMutation) Filter() *WebhookDeliverySchemaFilter {
+	return &WebhookDeliverySchemaFilter{config: m.config, predicateAdder: m}
+}
+
+// WebhookDeliverySchemaFilter provides a generic filtering capability at runtime for WebhookDeliverySchemaQuery.
+type WebhookDeliverySchemaFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebhookDeliverySchemaFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *WebhookDeliverySchemaFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldID))
+}
+
+// WhereWebhookID applies the entql [16]byte predicate on the webhook_id field.
+func (f *WebhookDeliverySchemaFilter) WhereWebhookID(p entql.ValueP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldWebhookID))
+}
+
+// WhereEventID applies the entql [16]byte predicate on the event_id field.
+func (f *WebhookDeliverySchemaFilter) WhereEventID(p entql.ValueP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldEventID))
+}
+
+// WhereEventType applies the entql string predicate on the event_type field.
+func (f *WebhookDeliverySchemaFilter) WhereEventType(p entql.StringP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldEventType))
+}
+
+// WherePayload applies the entql []byte predicate on the payload field.
+func (f *WebhookDeliverySchemaFilter) WherePayload(p entql.BytesP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldPayload))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *WebhookDeliverySchemaFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldStatus))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *WebhookDeliverySchemaFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldAttempts))
+}
+
+// WhereResponseStatus applies the entql int predicate on the response_status field.
+func (f *WebhookDeliverySchemaFilter) WhereResponseStatus(p entql.IntP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldResponseStatus))
+}
+
+// WhereLastError applies the entql string predicate on the last_error field.
+func (f *WebhookDeliverySchemaFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldLastError))
+}
+
+// WhereNextAttemptAt applies the entql time.Time predicate on the next_attempt_at field.
+func (f *WebhookDeliverySchemaFilter) WhereNextAttemptAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldNextAttemptAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WebhookDeliverySchemaFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WebhookDeliverySchemaFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdeliveryschema.FieldUpdatedAt))
+}
+
+// WhereHasWebhook applies a predicate to check if query has an edge webhook.
+func (f *WebhookDeliverySchemaFilter) WhereHasWebhook() {
+	f.Where(entql.HasEdge("webhook"))
+}
+
+// WhereHasWebhookWith applies a predicate to check if query has an edge webhook with a given conditions (other predicates).
+func (f *WebhookDeliverySchemaFilter) WhereHasWebhookWith(preds ...predicate.WebhookSchema) {
+	f.Where(entql.HasEdgeWith("webhook", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (wesq *WebhookEventSchemaQuery) addPredicate(pred func(s *sql.Selector)) {
+	wesq.predicates = append(wesq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebhookEventSchemaQuery builder.
+func (wesq *WebhookEventSchemaQuery) Filter() *WebhookEventSchemaFilter {
+	return &WebhookEventSchemaFilter{config: wesq.config, predicateAdder: wesq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebhookEventSchemaMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebhookEventSchemaMutation builder.
+func (m *WebhookEventSchemaMutation) Filter() *WebhookEventSchemaFilter {
+	return &WebhookEventSchemaFilter{config: m.config, predicateAdder: m}
+}
+
+// WebhookEventSchemaFilter provides a generic filtering capability at runtime for WebhookEventSchemaQuery.
+type WebhookEventSchemaFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebhookEventSchemaFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *WebhookEventSchemaFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(webhookeventschema.FieldID))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *WebhookEventSchemaFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(webhookeventschema.FieldType))
+}
+
+// WhereTodoID applies the entql [16]byte predicate on the todo_id field.
+func (f *WebhookEventSchemaFilter) WhereTodoID(p entql.ValueP) {
+	f.Where(p.Field(webhookeventschema.FieldTodoID))
+}
+
+// WherePayload applies the entql []byte predicate on the payload field.
+func (f *WebhookEventSchemaFilter) WherePayload(p entql.BytesP) {
+	f.Where(p.Field(webhookeventschema.FieldPayload))
+}
+
+// WhereOccurredAt applies the entql time.Time predicate on the occurred_at field.
+func (f *WebhookEventSchemaFilter) WhereOccurredAt(p entql.TimeP) {
+	f.Where(p.Field(webhookeventschema.FieldOccurredAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (wsq *WebhookSchemaQuery) addPredicate(pred func(s *sql.Selector)) {
+	wsq.predicates = append(wsq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebhookSchemaQuery builder.
+func (wsq *WebhookSchemaQuery) Filter() *WebhookSchemaFilter {
+	return &WebhookSchemaFilter{config: wsq.config, predicateAdder: wsq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebhookSchemaMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebhookSchemaMutation builder.
+func (m *WebhookSchemaMutation) Filter() *WebhookSchemaFilter {
+	return &WebhookSchemaFilter{config: m.config, predicateAdder: m}
+}
+
+// WebhookSchemaFilter provides a generic filtering capability at runtime for WebhookSchemaQuery.
+type WebhookSchemaFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebhookSchemaFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *WebhookSchemaFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(webhookschema.FieldID))
+}
+
+// WhereURL applies the entql string predicate on the url field.
+func (f *WebhookSchemaFilter) WhereURL(p entql.StringP) {
+	f.Where(p.Field(webhookschema.FieldURL))
+}
+
+// WhereSecret applies the entql string predicate on the secret field.
+func (f *WebhookSchemaFilter) WhereSecret(p entql.StringP) {
+	f.Where(p.Field(webhookschema.FieldSecret))
+}
+
+// WhereEvents applies the entql json.RawMessage predicate on the events field.
+func (f *WebhookSchemaFilter) WhereEvents(p entql.BytesP) {
+	f.Where(p.Field(webhookschema.FieldEvents))
+}
+
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *WebhookSchemaFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(webhookschema.FieldEnabled))
+}
+
+// WhereConsecutiveFailures applies the entql int predicate on the consecutive_failures field.
+func (f *WebhookSchemaFilter) WhereConsecutiveFailures(p entql.IntP) {
+	f.Where(p.Field(webhookschema.FieldConsecutiveFailures))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WebhookSchemaFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookschema.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WebhookSchemaFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookschema.FieldUpdatedAt))
+}
+
+// WhereHasDeliveries applies a predicate to check if query has an edge deliveries.
+func (f *WebhookSchemaFilter) WhereHasDeliveries() {
+	f.Where(entql.HasEdge("deliveries"))
+}
+
+// WhereHasDeliveriesWith applies a predicate to check if query has an edge deliveries with a given conditions (other predicates).
+func (f *WebhookSchemaFilter) WhereHasDeliveriesWith(preds ...predicate.WebhookDeliverySchema) {
+	f.Where(entql.HasEdgeWith("deliveries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
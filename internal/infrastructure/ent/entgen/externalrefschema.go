@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
+)
+
+// ExternalRefSchema is the model entity for the ExternalRefSchema schema.
+type ExternalRefSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source externalrefschema.Source `json:"source,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind externalrefschema.Kind `json:"kind,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID string `json:"external_id,omitempty"`
+	// LocalID holds the value of the "local_id" field.
+	LocalID uuid.UUID `json:"local_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExternalRefSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case externalrefschema.FieldSource, externalrefschema.FieldKind, externalrefschema.FieldExternalID:
+			values[i] = new(sql.NullString)
+		case externalrefschema.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case externalrefschema.FieldID, externalrefschema.FieldLocalID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExternalRefSchema fields.
+func (ers *ExternalRefSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case externalrefschema.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ers.ID = *value
+			}
+		case externalrefschema.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				ers.Source = externalrefschema.Source(value.String)
+			}
+		case externalrefschema.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ers.Kind = externalrefschema.Kind(value.String)
+			}
+		case externalrefschema.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				ers.ExternalID = value.String
+			}
+		case externalrefschema.FieldLocalID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field local_id", values[i])
+			} else if value != nil {
+				ers.LocalID = *value
+			}
+		case externalrefschema.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ers.CreatedAt = value.Time
+			}
+		default:
+			ers.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExternalRefSchema.
+// This includes values selected through modifiers, order, etc.
+func (ers *ExternalRefSchema) Value(name string) (ent.Value, error) {
+	return ers.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExternalRefSchema.
+// Note that you need to call ExternalRefSchema.Unwrap() before calling this method if this ExternalRefSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ers *ExternalRefSchema) Update() *ExternalRefSchemaUpdateOne {
+	return NewExternalRefSchemaClient(ers.config).UpdateOne(ers)
+}
+
+// Unwrap unwraps the ExternalRefSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ers *ExternalRefSchema) Unwrap() *ExternalRefSchema {
+	_tx, ok := ers.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: ExternalRefSchema is not a transactional entity")
+	}
+	ers.config.driver = _tx.drv
+	return ers
+}
+
+// String implements the fmt.Stringer.
+func (ers *ExternalRefSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExternalRefSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ers.ID))
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", ers.Source))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", ers.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("external_id=")
+	builder.WriteString(ers.ExternalID)
+	builder.WriteString(", ")
+	builder.WriteString("local_id=")
+	builder.WriteString(fmt.Sprintf("%v", ers.LocalID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ers.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExternalRefSchemas is a parsable slice of ExternalRefSchema.
+type ExternalRefSchemas []*ExternalRefSchema
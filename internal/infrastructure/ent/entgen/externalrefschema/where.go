@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package externalrefschema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldLTE(FieldID, id))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldExternalID, v))
+}
+
+// LocalID applies equality check predicate on the "local_id" field. It's identical to LocalIDEQ.
+func LocalID(v uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldLocalID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// LocalIDEQ applies the EQ predicate on the "local_id" field.
+func LocalIDEQ(v uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldLocalID, v))
+}
+
+// LocalIDNEQ applies the NEQ predicate on the "local_id" field.
+func LocalIDNEQ(v uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNEQ(FieldLocalID, v))
+}
+
+// LocalIDIn applies the In predicate on the "local_id" field.
+func LocalIDIn(vs ...uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldIn(FieldLocalID, vs...))
+}
+
+// LocalIDNotIn applies the NotIn predicate on the "local_id" field.
+func LocalIDNotIn(vs ...uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNotIn(FieldLocalID, vs...))
+}
+
+// LocalIDGT applies the GT predicate on the "local_id" field.
+func LocalIDGT(v uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldGT(FieldLocalID, v))
+}
+
+// LocalIDGTE applies the GTE predicate on the "local_id" field.
+func LocalIDGTE(v uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldGTE(FieldLocalID, v))
+}
+
+// LocalIDLT applies the LT predicate on the "local_id" field.
+func LocalIDLT(v uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldLT(FieldLocalID, v))
+}
+
+// LocalIDLTE applies the LTE predicate on the "local_id" field.
+func LocalIDLTE(v uuid.UUID) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldLTE(FieldLocalID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExternalRefSchema) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExternalRefSchema) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExternalRefSchema) predicate.ExternalRefSchema {
+	return predicate.ExternalRefSchema(sql.NotPredicates(p))
+}
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
+)
+
+// ExternalRefSchemaCreate is the builder for creating a ExternalRefSchema entity.
+type ExternalRefSchemaCreate struct {
+	config
+	mutation *ExternalRefSchemaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSource sets the "source" field.
+func (ersc *ExternalRefSchemaCreate) SetSource(e externalrefschema.Source) *ExternalRefSchemaCreate {
+	ersc.mutation.SetSource(e)
+	return ersc
+}
+
+// SetKind sets the "kind" field.
+func (ersc *ExternalRefSchemaCreate) SetKind(e externalrefschema.Kind) *ExternalRefSchemaCreate {
+	ersc.mutation.SetKind(e)
+	return ersc
+}
+
+// SetExternalID sets the "external_id" field.
+func (ersc *ExternalRefSchemaCreate) SetExternalID(s string) *ExternalRefSchemaCreate {
+	ersc.mutation.SetExternalID(s)
+	return ersc
+}
+
+// SetLocalID sets the "local_id" field.
+func (ersc *ExternalRefSchemaCreate) SetLocalID(u uuid.UUID) *ExternalRefSchemaCreate {
+	ersc.mutation.SetLocalID(u)
+	return ersc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ersc *ExternalRefSchemaCreate) SetCreatedAt(t time.Time) *ExternalRefSchemaCreate {
+	ersc.mutation.SetCreatedAt(t)
+	return ersc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ersc *ExternalRefSchemaCreate) SetNillableCreatedAt(t *time.Time) *ExternalRefSchemaCreate {
+	if t != nil {
+		ersc.SetCreatedAt(*t)
+	}
+	return ersc
+}
+
+// SetID sets the "id" field.
+func (ersc *ExternalRefSchemaCreate) SetID(u uuid.UUID) *ExternalRefSchemaCreate {
+	ersc.mutation.SetID(u)
+	return ersc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ersc *ExternalRefSchemaCreate) SetNillableID(u *uuid.UUID) *ExternalRefSchemaCreate {
+	if u != nil {
+		ersc.SetID(*u)
+	}
+	return ersc
+}
+
+// Mutation returns the ExternalRefSchemaMutation object of the builder.
+func (ersc *ExternalRefSchemaCreate) Mutation() *ExternalRefSchemaMutation {
+	return ersc.mutation
+}
+
+// Save creates the ExternalRefSchema in the database.
+func (ersc *ExternalRefSchemaCreate) Save(ctx context.Context) (*ExternalRefSchema, error) {
+	ersc.defaults()
+	return withHooks(ctx, ersc.sqlSave, ersc.mutation, ersc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ersc *ExternalRefSchemaCreate) SaveX(ctx context.Context) *ExternalRefSchema {
+	v, err := ersc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ersc *ExternalRefSchemaCreate) Exec(ctx context.Context) error {
+	_, err := ersc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ersc *ExternalRefSchemaCreate) ExecX(ctx context.Context) {
+	if err := ersc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ersc *ExternalRefSchemaCreate) defaults() {
+	if _, ok := ersc.mutation.CreatedAt(); !ok {
+		v := externalrefschema.DefaultCreatedAt()
+		ersc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ersc.mutation.ID(); !ok {
+		v := externalrefschema.DefaultID()
+		ersc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ersc *ExternalRefSchemaCreate) check() error {
+	if _, ok := ersc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`entgen: missing required field "ExternalRefSchema.source"`)}
+	}
+	if v, ok := ersc.mutation.Source(); ok {
+		if err := externalrefschema.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`entgen: validator failed for field "ExternalRefSchema.source": %w`, err)}
+		}
+	}
+	if _, ok := ersc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`entgen: missing required field "ExternalRefSchema.kind"`)}
+	}
+	if v, ok := ersc.mutation.Kind(); ok {
+		if err := externalrefschema.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`entgen: validator failed for field "ExternalRefSchema.kind": %w`, err)}
+		}
+	}
+	if _, ok := ersc.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`entgen: missing required field "ExternalRefSchema.external_id"`)}
+	}
+	if v, ok := ersc.mutation.ExternalID(); ok {
+		if err := externalrefschema.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`entgen: validator failed for field "ExternalRefSchema.external_id": %w`, err)}
+		}
+	}
+	if _, ok := ersc.mutation.LocalID(); !ok {
+		return &ValidationError{Name: "local_id", err: errors.New(`entgen: missing required field "ExternalRefSchema.local_id"`)}
+	}
+	if _, ok := ersc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entgen: missing required field "ExternalRefSchema.created_at"`)}
+	}
+	return nil
+}
+
+func (ersc *ExternalRefSchemaCreate) sqlSave(ctx context.Context) (*ExternalRefSchema, error) {
+	if err := ersc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ersc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ersc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ersc.mutation.id = &_node.ID
+	ersc.mutation.done = true
+	return _node, nil
+}
+
+func (ersc *ExternalRefSchemaCreate) createSpec() (*ExternalRefSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExternalRefSchema{config: ersc.config}
+		_spec = sqlgraph.NewCreateSpec(externalrefschema.Table, sqlgraph.NewFieldSpec(externalrefschema.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = ersc.schemaConfig.ExternalRefSchema
+	_spec.OnConflict = ersc.conflict
+	if id, ok := ersc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ersc.mutation.Source(); ok {
+		_spec.SetField(externalrefschema.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := ersc.mutation.Kind(); ok {
+		_spec.SetField(externalrefschema.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := ersc.mutation.ExternalID(); ok {
+		_spec.SetField(externalrefschema.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := ersc.mutation.LocalID(); ok {
+		_spec.SetField(externalrefschema.FieldLocalID, field.TypeUUID, value)
+		_node.LocalID = value
+	}
+	if value, ok := ersc.mutation.CreatedAt(); ok {
+		_spec.SetField(externalrefschema.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExternalRefSchema.Create().
+//		SetSource(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExternalRefSchemaUpsert) {
+//			SetSource(v+v).
+//		}).
+//		Exec(ctx)
+func (ersc *ExternalRefSchemaCreate) OnConflict(opts ...sql.ConflictOption) *ExternalRefSchemaUpsertOne {
+	ersc.conflict = opts
+	return &ExternalRefSchemaUpsertOne{
+		create: ersc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExternalRefSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ersc *ExternalRefSchemaCreate) OnConflictColumns(columns ...string) *ExternalRefSchemaUpsertOne {
+	ersc.conflict = append(ersc.conflict, sql.ConflictColumns(columns...))
+	return &ExternalRefSchemaUpsertOne{
+		create: ersc,
+	}
+}
+
+type (
+	// ExternalRefSchemaUpsertOne is the builder for "upsert"-ing
+	//  one ExternalRefSchema node.
+	ExternalRefSchemaUpsertOne struct {
+		create *ExternalRefSchemaCreate
+	}
+
+	// ExternalRefSchemaUpsert is the "OnConflict" setter.
+	ExternalRefSchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExternalRefSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(externalrefschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExternalRefSchemaUpsertOne) UpdateNewValues() *ExternalRefSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(externalrefschema.FieldID)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(externalrefschema.FieldSource)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(externalrefschema.FieldKind)
+		}
+		if _, exists := u.create.mutation.ExternalID(); exists {
+			s.SetIgnore(externalrefschema.FieldExternalID)
+		}
+		if _, exists := u.create.mutation.LocalID(); exists {
+			s.SetIgnore(externalrefschema.FieldLocalID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(externalrefschema.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExternalRefSchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExternalRefSchemaUpsertOne) Ignore() *ExternalRefSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExternalRefSchemaUpsertOne) DoNothing() *ExternalRefSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExternalRefSchemaCreate.OnConflict
+// documentation for more info.
+func (u *ExternalRefSchemaUpsertOne) Update(set func(*ExternalRefSchemaUpsert)) *ExternalRefSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExternalRefSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ExternalRefSchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for ExternalRefSchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExternalRefSchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExternalRefSchemaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entgen: ExternalRefSchemaUpsertOne.ID is not supported by MySQL driver. Use ExternalRefSchemaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExternalRefSchemaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExternalRefSchemaCreateBulk is the builder for creating many ExternalRefSchema entities in bulk.
+type ExternalRefSchemaCreateBulk struct {
+	config
+	err      error
+	builders []*ExternalRefSchemaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExternalRefSchema entities in the database.
+func (erscb *ExternalRefSchemaCreateBulk) Save(ctx context.Context) ([]*ExternalRefSchema, error) {
+	if erscb.err != nil {
+		return nil, erscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(erscb.builders))
+	nodes := make([]*ExternalRefSchema, len(erscb.builders))
+	mutators := make([]Mutator, len(erscb.builders))
+	for i := range erscb.builders {
+		func(i int, root context.Context) {
+			builder := erscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExternalRefSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, erscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = erscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, erscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, erscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (erscb *ExternalRefSchemaCreateBulk) SaveX(ctx context.Context) []*ExternalRefSchema {
+	v, err := erscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (erscb *ExternalRefSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := erscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erscb *ExternalRefSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := erscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExternalRefSchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExternalRefSchemaUpsert) {
+//			SetSource(v+v).
+//		}).
+//		Exec(ctx)
+func (erscb *ExternalRefSchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExternalRefSchemaUpsertBulk {
+	erscb.conflict = opts
+	return &ExternalRefSchemaUpsertBulk{
+		create: erscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExternalRefSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (erscb *ExternalRefSchemaCreateBulk) OnConflictColumns(columns ...string) *ExternalRefSchemaUpsertBulk {
+	erscb.conflict = append(erscb.conflict, sql.ConflictColumns(columns...))
+	return &ExternalRefSchemaUpsertBulk{
+		create: erscb,
+	}
+}
+
+// ExternalRefSchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExternalRefSchema nodes.
+type ExternalRefSchemaUpsertBulk struct {
+	create *ExternalRefSchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExternalRefSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(externalrefschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExternalRefSchemaUpsertBulk) UpdateNewValues() *ExternalRefSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(externalrefschema.FieldID)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(externalrefschema.FieldSource)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(externalrefschema.FieldKind)
+			}
+			if _, exists := b.mutation.ExternalID(); exists {
+				s.SetIgnore(externalrefschema.FieldExternalID)
+			}
+			if _, exists := b.mutation.LocalID(); exists {
+				s.SetIgnore(externalrefschema.FieldLocalID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(externalrefschema.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExternalRefSchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExternalRefSchemaUpsertBulk) Ignore() *ExternalRefSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExternalRefSchemaUpsertBulk) DoNothing() *ExternalRefSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExternalRefSchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExternalRefSchemaUpsertBulk) Update(set func(*ExternalRefSchemaUpsert)) *ExternalRefSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExternalRefSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ExternalRefSchemaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entgen: OnConflict was set for builder %d. Set it on the ExternalRefSchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for ExternalRefSchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExternalRefSchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
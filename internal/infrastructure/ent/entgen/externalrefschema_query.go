@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+)
+
+// ExternalRefSchemaQuery is the builder for querying ExternalRefSchema entities.
+type ExternalRefSchemaQuery struct {
+	config
+	ctx        *QueryContext
+	order      []externalrefschema.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ExternalRefSchema
+	loadTotal  []func(context.Context, []*ExternalRefSchema) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExternalRefSchemaQuery builder.
+func (ersq *ExternalRefSchemaQuery) Where(ps ...predicate.ExternalRefSchema) *ExternalRefSchemaQuery {
+	ersq.predicates = append(ersq.predicates, ps...)
+	return ersq
+}
+
+// Limit the number of records to be returned by this query.
+func (ersq *ExternalRefSchemaQuery) Limit(limit int) *ExternalRefSchemaQuery {
+	ersq.ctx.Limit = &limit
+	return ersq
+}
+
+// Offset to start from.
+func (ersq *ExternalRefSchemaQuery) Offset(offset int) *ExternalRefSchemaQuery {
+	ersq.ctx.Offset = &offset
+	return ersq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ersq *ExternalRefSchemaQuery) Unique(unique bool) *ExternalRefSchemaQuery {
+	ersq.ctx.Unique = &unique
+	return ersq
+}
+
+// Order specifies how the records should be ordered.
+func (ersq *ExternalRefSchemaQuery) Order(o ...externalrefschema.OrderOption) *ExternalRefSchemaQuery {
+	ersq.order = append(ersq.order, o...)
+	return ersq
+}
+
+// First returns the first ExternalRefSchema entity from the query.
+// Returns a *NotFoundError when no ExternalRefSchema was found.
+func (ersq *ExternalRefSchemaQuery) First(ctx context.Context) (*ExternalRefSchema, error) {
+	nodes, err := ersq.Limit(1).All(setContextOp(ctx, ersq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{externalrefschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ersq *ExternalRefSchemaQuery) FirstX(ctx context.Context) *ExternalRefSchema {
+	node, err := ersq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExternalRefSchema ID from the query.
+// Returns a *NotFoundError when no ExternalRefSchema ID was found.
+func (ersq *ExternalRefSchemaQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ersq.Limit(1).IDs(setContextOp(ctx, ersq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{externalrefschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ersq *ExternalRefSchemaQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ersq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExternalRefSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExternalRefSchema entity is found.
+// Returns a *NotFoundError when no ExternalRefSchema entities are found.
+func (ersq *ExternalRefSchemaQuery) Only(ctx context.Context) (*ExternalRefSchema, error) {
+	nodes, err := ersq.Limit(2).All(setContextOp(ctx, ersq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{externalrefschema.Label}
+	default:
+		return nil, &NotSingularError{externalrefschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ersq *ExternalRefSchemaQuery) OnlyX(ctx context.Context) *ExternalRefSchema {
+	node, err := ersq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExternalRefSchema ID in the query.
+// Returns a *NotSingularError when more than one ExternalRefSchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ersq *ExternalRefSchemaQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ersq.Limit(2).IDs(setContextOp(ctx, ersq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{externalrefschema.Label}
+	default:
+		err = &NotSingularError{externalrefschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ersq *ExternalRefSchemaQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ersq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExternalRefSchemas.
+func (ersq *ExternalRefSchemaQuery) All(ctx context.Context) ([]*ExternalRefSchema, error) {
+	ctx = setContextOp(ctx, ersq.ctx, ent.OpQueryAll)
+	if err := ersq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ExternalRefSchema, *ExternalRefSchemaQuery]()
+	return withInterceptors[[]*ExternalRefSchema](ctx, ersq, qr, ersq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ersq *ExternalRefSchemaQuery) AllX(ctx context.Context) []*ExternalRefSchema {
+	nodes, err := ersq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExternalRefSchema IDs.
+func (ersq *ExternalRefSchemaQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ersq.ctx.Unique == nil && ersq.path != nil {
+		ersq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ersq.ctx, ent.OpQueryIDs)
+	if err = ersq.Select(externalrefschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ersq *ExternalRefSchemaQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ersq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ersq *ExternalRefSchemaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ersq.ctx, ent.OpQueryCount)
+	if err := ersq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ersq, querierCount[*ExternalRefSchemaQuery](), ersq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ersq *ExternalRefSchemaQuery) CountX(ctx context.Context) int {
+	count, err := ersq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ersq *ExternalRefSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ersq.ctx, ent.OpQueryExist)
+	switch _, err := ersq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entgen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ersq *ExternalRefSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := ersq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExternalRefSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ersq *ExternalRefSchemaQuery) Clone() *ExternalRefSchemaQuery {
+	if ersq == nil {
+		return nil
+	}
+	return &ExternalRefSchemaQuery{
+		config:     ersq.config,
+		ctx:        ersq.ctx.Clone(),
+		order:      append([]externalrefschema.OrderOption{}, ersq.order...),
+		inters:     append([]Interceptor{}, ersq.inters...),
+		predicates: append([]predicate.ExternalRefSchema{}, ersq.predicates...),
+		// clone intermediate query.
+		sql:       ersq.sql.Clone(),
+		path:      ersq.path,
+		modifiers: append([]func(*sql.Selector){}, ersq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Source externalrefschema.Source `json:"source,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ExternalRefSchema.Query().
+//		GroupBy(externalrefschema.FieldSource).
+//		Aggregate(entgen.Count()).
+//		Scan(ctx, &v)
+func (ersq *ExternalRefSchemaQuery) GroupBy(field string, fields ...string) *ExternalRefSchemaGroupBy {
+	ersq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ExternalRefSchemaGroupBy{build: ersq}
+	grbuild.flds = &ersq.ctx.Fields
+	grbuild.label = externalrefschema.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Source externalrefschema.Source `json:"source,omitempty"`
+//	}
+//
+//	client.ExternalRefSchema.Query().
+//		Select(externalrefschema.FieldSource).
+//		Scan(ctx, &v)
+func (ersq *ExternalRefSchemaQuery) Select(fields ...string) *ExternalRefSchemaSelect {
+	ersq.ctx.Fields = append(ersq.ctx.Fields, fields...)
+	sbuild := &ExternalRefSchemaSelect{ExternalRefSchemaQuery: ersq}
+	sbuild.label = externalrefschema.Label
+	sbuild.flds, sbuild.scan = &ersq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ExternalRefSchemaSelect configured with the given aggregations.
+func (ersq *ExternalRefSchemaQuery) Aggregate(fns ...AggregateFunc) *ExternalRefSchemaSelect {
+	return ersq.Select().Aggregate(fns...)
+}
+
+func (ersq *ExternalRefSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ersq.inters {
+		if inter == nil {
+			return fmt.Errorf("entgen: uninitialized interceptor (forgotten import entgen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ersq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ersq.ctx.Fields {
+		if !externalrefschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+		}
+	}
+	if ersq.path != nil {
+		prev, err := ersq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ersq.sql = prev
+	}
+	return nil
+}
+
+func (ersq *ExternalRefSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExternalRefSchema, error) {
+	var (
+		nodes = []*ExternalRefSchema{}
+		_spec = ersq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExternalRefSchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExternalRefSchema{config: ersq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = ersq.schemaConfig.ExternalRefSchema
+	ctx = internal.NewSchemaConfigContext(ctx, ersq.schemaConfig)
+	if len(ersq.modifiers) > 0 {
+		_spec.Modifiers = ersq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ersq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range ersq.loadTotal {
+		if err := ersq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ersq *ExternalRefSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ersq.querySpec()
+	_spec.Node.Schema = ersq.schemaConfig.ExternalRefSchema
+	ctx = internal.NewSchemaConfigContext(ctx, ersq.schemaConfig)
+	if len(ersq.modifiers) > 0 {
+		_spec.Modifiers = ersq.modifiers
+	}
+	_spec.Node.Columns = ersq.ctx.Fields
+	if len(ersq.ctx.Fields) > 0 {
+		_spec.Unique = ersq.ctx.Unique != nil && *ersq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ersq.driver, _spec)
+}
+
+func (ersq *ExternalRefSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(externalrefschema.Table, externalrefschema.Columns, sqlgraph.NewFieldSpec(externalrefschema.FieldID, field.TypeUUID))
+	_spec.From = ersq.sql
+	if unique := ersq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ersq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ersq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, externalrefschema.FieldID)
+		for i := range fields {
+			if fields[i] != externalrefschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ersq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ersq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ersq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ersq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ersq *ExternalRefSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ersq.driver.Dialect())
+	t1 := builder.Table(externalrefschema.Table)
+	columns := ersq.ctx.Fields
+	if len(columns) == 0 {
+		columns = externalrefschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ersq.sql != nil {
+		selector = ersq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ersq.ctx.Unique != nil && *ersq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(ersq.schemaConfig.ExternalRefSchema)
+	ctx = internal.NewSchemaConfigContext(ctx, ersq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range ersq.modifiers {
+		m(selector)
+	}
+	for _, p := range ersq.predicates {
+		p(selector)
+	}
+	for _, p := range ersq.order {
+		p(selector)
+	}
+	if offset := ersq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ersq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ersq *ExternalRefSchemaQuery) ForUpdate(opts ...sql.LockOption) *ExternalRefSchemaQuery {
+	if ersq.driver.Dialect() == dialect.Postgres {
+		ersq.Unique(false)
+	}
+	ersq.modifiers = append(ersq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ersq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ersq *ExternalRefSchemaQuery) ForShare(opts ...sql.LockOption) *ExternalRefSchemaQuery {
+	if ersq.driver.Dialect() == dialect.Postgres {
+		ersq.Unique(false)
+	}
+	ersq.modifiers = append(ersq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ersq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ersq *ExternalRefSchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *ExternalRefSchemaSelect {
+	ersq.modifiers = append(ersq.modifiers, modifiers...)
+	return ersq.Select()
+}
+
+// ExternalRefSchemaGroupBy is the group-by builder for ExternalRefSchema entities.
+type ExternalRefSchemaGroupBy struct {
+	selector
+	build *ExternalRefSchemaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ersgb *ExternalRefSchemaGroupBy) Aggregate(fns ...AggregateFunc) *ExternalRefSchemaGroupBy {
+	ersgb.fns = append(ersgb.fns, fns...)
+	return ersgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ersgb *ExternalRefSchemaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ersgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ersgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExternalRefSchemaQuery, *ExternalRefSchemaGroupBy](ctx, ersgb.build, ersgb, ersgb.build.inters, v)
+}
+
+func (ersgb *ExternalRefSchemaGroupBy) sqlScan(ctx context.Context, root *ExternalRefSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ersgb.fns))
+	for _, fn := range ersgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ersgb.flds)+len(ersgb.fns))
+		for _, f := range *ersgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ersgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ersgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ExternalRefSchemaSelect is the builder for selecting fields of ExternalRefSchema entities.
+type ExternalRefSchemaSelect struct {
+	*ExternalRefSchemaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (erss *ExternalRefSchemaSelect) Aggregate(fns ...AggregateFunc) *ExternalRefSchemaSelect {
+	erss.fns = append(erss.fns, fns...)
+	return erss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (erss *ExternalRefSchemaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, erss.ctx, ent.OpQuerySelect)
+	if err := erss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExternalRefSchemaQuery, *ExternalRefSchemaSelect](ctx, erss.ExternalRefSchemaQuery, erss, erss.inters, v)
+}
+
+func (erss *ExternalRefSchemaSelect) sqlScan(ctx context.Context, root *ExternalRefSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(erss.fns))
+	for _, fn := range erss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*erss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := erss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (erss *ExternalRefSchemaSelect) Modify(modifiers ...func(s *sql.Selector)) *ExternalRefSchemaSelect {
+	erss.modifiers = append(erss.modifiers, modifiers...)
+	return erss
+}
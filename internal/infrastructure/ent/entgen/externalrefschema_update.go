@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+)
+
+// ExternalRefSchemaUpdate is the builder for updating ExternalRefSchema entities.
+type ExternalRefSchemaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ExternalRefSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ExternalRefSchemaUpdate builder.
+func (ersu *ExternalRefSchemaUpdate) Where(ps ...predicate.ExternalRefSchema) *ExternalRefSchemaUpdate {
+	ersu.mutation.Where(ps...)
+	return ersu
+}
+
+// Mutation returns the ExternalRefSchemaMutation object of the builder.
+func (ersu *ExternalRefSchemaUpdate) Mutation() *ExternalRefSchemaMutation {
+	return ersu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ersu *ExternalRefSchemaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ersu.sqlSave, ersu.mutation, ersu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ersu *ExternalRefSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := ersu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ersu *ExternalRefSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := ersu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ersu *ExternalRefSchemaUpdate) ExecX(ctx context.Context) {
+	if err := ersu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ersu *ExternalRefSchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExternalRefSchemaUpdate {
+	ersu.modifiers = append(ersu.modifiers, modifiers...)
+	return ersu
+}
+
+func (ersu *ExternalRefSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(externalrefschema.Table, externalrefschema.Columns, sqlgraph.NewFieldSpec(externalrefschema.FieldID, field.TypeUUID))
+	if ps := ersu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = ersu.schemaConfig.ExternalRefSchema
+	ctx = internal.NewSchemaConfigContext(ctx, ersu.schemaConfig)
+	_spec.AddModifiers(ersu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ersu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externalrefschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ersu.mutation.done = true
+	return n, nil
+}
+
+// ExternalRefSchemaUpdateOne is the builder for updating a single ExternalRefSchema entity.
+type ExternalRefSchemaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ExternalRefSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the ExternalRefSchemaMutation object of the builder.
+func (ersuo *ExternalRefSchemaUpdateOne) Mutation() *ExternalRefSchemaMutation {
+	return ersuo.mutation
+}
+
+// Where appends a list predicates to the ExternalRefSchemaUpdate builder.
+func (ersuo *ExternalRefSchemaUpdateOne) Where(ps ...predicate.ExternalRefSchema) *ExternalRefSchemaUpdateOne {
+	ersuo.mutation.Where(ps...)
+	return ersuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ersuo *ExternalRefSchemaUpdateOne) Select(field string, fields ...string) *ExternalRefSchemaUpdateOne {
+	ersuo.fields = append([]string{field}, fields...)
+	return ersuo
+}
+
+// Save executes the query and returns the updated ExternalRefSchema entity.
+func (ersuo *ExternalRefSchemaUpdateOne) Save(ctx context.Context) (*ExternalRefSchema, error) {
+	return withHooks(ctx, ersuo.sqlSave, ersuo.mutation, ersuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ersuo *ExternalRefSchemaUpdateOne) SaveX(ctx context.Context) *ExternalRefSchema {
+	node, err := ersuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ersuo *ExternalRefSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := ersuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ersuo *ExternalRefSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := ersuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ersuo *ExternalRefSchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExternalRefSchemaUpdateOne {
+	ersuo.modifiers = append(ersuo.modifiers, modifiers...)
+	return ersuo
+}
+
+func (ersuo *ExternalRefSchemaUpdateOne) sqlSave(ctx context.Context) (_node *ExternalRefSchema, err error) {
+	_spec := sqlgraph.NewUpdateSpec(externalrefschema.Table, externalrefschema.Columns, sqlgraph.NewFieldSpec(externalrefschema.FieldID, field.TypeUUID))
+	id, ok := ersuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "ExternalRefSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ersuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, externalrefschema.FieldID)
+		for _, f := range fields {
+			if !externalrefschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != externalrefschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ersuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = ersuo.schemaConfig.ExternalRefSchema
+	ctx = internal.NewSchemaConfigContext(ctx, ersuo.schemaConfig)
+	_spec.AddModifiers(ersuo.modifiers...)
+	_node = &ExternalRefSchema{config: ersuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ersuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externalrefschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ersuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (ps *ProjectSchema) Todos(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TodoOrder, where *TodoWhereInput,
+) (*TodoConnection, error) {
+	opts := []TodoPaginateOption{
+		WithTodoOrder(orderBy),
+		WithTodoFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := ps.Edges.totalCount[0][alias]
+	if nodes, err := ps.NamedTodos(alias); err == nil || hasTotalCount {
+		pager, err := newTodoPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return ps.QueryTodos().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (ts *TodoSchema) Project(ctx context.Context) (*ProjectSchema, error) {
+	result, err := ts.Edges.ProjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = ts.QueryProject().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (ts *TodoSchema) Parent(ctx context.Context) (*TodoSchema, error) {
+	result, err := ts.Edges.ParentOrErr()
+	if IsNotLoaded(err) {
+		result, err = ts.QueryParent().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (ts *TodoSchema) Children(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TodoOrder, where *TodoWhereInput,
+) (*TodoConnection, error) {
+	opts := []TodoPaginateOption{
+		WithTodoOrder(orderBy),
+		WithTodoFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := ts.Edges.totalCount[2][alias]
+	if nodes, err := ts.NamedChildren(alias); err == nil || hasTotalCount {
+		pager, err := newTodoPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return ts.QueryChildren().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (ts *TodoSchema) Blocks(ctx context.Context) (result []*TodoSchema, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = ts.NamedBlocks(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = ts.Edges.BlocksOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = ts.QueryBlocks().All(ctx)
+	}
+	return result, err
+}
+
+func (ts *TodoSchema) BlockedBy(ctx context.Context) (result []*TodoSchema, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = ts.NamedBlockedBy(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = ts.Edges.BlockedByOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = ts.QueryBlockedBy().All(ctx)
+	}
+	return result, err
+}
@@ -9,6 +9,42 @@ import (
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
 )
 
+// The AttachmentSchemaFunc type is an adapter to allow the use of ordinary
+// function as AttachmentSchema mutator.
+type AttachmentSchemaFunc func(context.Context, *entgen.AttachmentSchemaMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AttachmentSchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.AttachmentSchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.AttachmentSchemaMutation", m)
+}
+
+// The CommentSchemaFunc type is an adapter to allow the use of ordinary
+// function as CommentSchema mutator.
+type CommentSchemaFunc func(context.Context, *entgen.CommentSchemaMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommentSchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.CommentSchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.CommentSchemaMutation", m)
+}
+
+// The ExternalRefSchemaFunc type is an adapter to allow the use of ordinary
+// function as ExternalRefSchema mutator.
+type ExternalRefSchemaFunc func(context.Context, *entgen.ExternalRefSchemaMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExternalRefSchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.ExternalRefSchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.ExternalRefSchemaMutation", m)
+}
+
 // The ProjectSchemaFunc type is an adapter to allow the use of ordinary
 // function as ProjectSchema mutator.
 type ProjectSchemaFunc func(context.Context, *entgen.ProjectSchemaMutation) (entgen.Value, error)
@@ -21,6 +57,30 @@ func (f ProjectSchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entge
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.ProjectSchemaMutation", m)
 }
 
+// The TagSchemaFunc type is an adapter to allow the use of ordinary
+// function as TagSchema mutator.
+type TagSchemaFunc func(context.Context, *entgen.TagSchemaMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagSchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.TagSchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.TagSchemaMutation", m)
+}
+
+// The TodoHistorySchemaFunc type is an adapter to allow the use of ordinary
+// function as TodoHistorySchema mutator.
+type TodoHistorySchemaFunc func(context.Context, *entgen.TodoHistorySchemaMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TodoHistorySchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.TodoHistorySchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.TodoHistorySchemaMutation", m)
+}
+
 // The TodoSchemaFunc type is an adapter to allow the use of ordinary
 // function as TodoSchema mutator.
 type TodoSchemaFunc func(context.Context, *entgen.TodoSchemaMutation) (entgen.Value, error)
@@ -33,6 +93,42 @@ func (f TodoSchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.TodoSchemaMutation", m)
 }
 
+// The WebhookDeliverySchemaFunc type is an adapter to allow the use of ordinary
+// function as WebhookDeliverySchema mutator.
+type WebhookDeliverySchemaFunc func(context.Context, *entgen.WebhookDeliverySchemaMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliverySchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.WebhookDeliverySchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.WebhookDeliverySchemaMutation", m)
+}
+
+// The WebhookEventSchemaFunc type is an adapter to allow the use of ordinary
+// function as WebhookEventSchema mutator.
+type WebhookEventSchemaFunc func(context.Context, *entgen.WebhookEventSchemaMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookEventSchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.WebhookEventSchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.WebhookEventSchemaMutation", m)
+}
+
+// The WebhookSchemaFunc type is an adapter to allow the use of ordinary
+// function as WebhookSchema mutator.
+type WebhookSchemaFunc func(context.Context, *entgen.WebhookSchemaMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookSchemaFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.WebhookSchemaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.WebhookSchemaMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, entgen.Mutation) bool
 
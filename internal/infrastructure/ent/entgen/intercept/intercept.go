@@ -8,9 +8,17 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
 )
 
 // The Query interface represents an operation that queries a graph.
@@ -69,6 +77,87 @@ func (f TraverseFunc) Traverse(ctx context.Context, q entgen.Query) error {
 	return f(ctx, query)
 }
 
+// The AttachmentSchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AttachmentSchemaFunc func(context.Context, *entgen.AttachmentSchemaQuery) (entgen.Value, error)
+
+// Query calls f(ctx, q).
+func (f AttachmentSchemaFunc) Query(ctx context.Context, q entgen.Query) (entgen.Value, error) {
+	if q, ok := q.(*entgen.AttachmentSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *entgen.AttachmentSchemaQuery", q)
+}
+
+// The TraverseAttachmentSchema type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAttachmentSchema func(context.Context, *entgen.AttachmentSchemaQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAttachmentSchema) Intercept(next entgen.Querier) entgen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAttachmentSchema) Traverse(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.AttachmentSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *entgen.AttachmentSchemaQuery", q)
+}
+
+// The CommentSchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CommentSchemaFunc func(context.Context, *entgen.CommentSchemaQuery) (entgen.Value, error)
+
+// Query calls f(ctx, q).
+func (f CommentSchemaFunc) Query(ctx context.Context, q entgen.Query) (entgen.Value, error) {
+	if q, ok := q.(*entgen.CommentSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *entgen.CommentSchemaQuery", q)
+}
+
+// The TraverseCommentSchema type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCommentSchema func(context.Context, *entgen.CommentSchemaQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCommentSchema) Intercept(next entgen.Querier) entgen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCommentSchema) Traverse(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.CommentSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *entgen.CommentSchemaQuery", q)
+}
+
+// The ExternalRefSchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ExternalRefSchemaFunc func(context.Context, *entgen.ExternalRefSchemaQuery) (entgen.Value, error)
+
+// Query calls f(ctx, q).
+func (f ExternalRefSchemaFunc) Query(ctx context.Context, q entgen.Query) (entgen.Value, error) {
+	if q, ok := q.(*entgen.ExternalRefSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *entgen.ExternalRefSchemaQuery", q)
+}
+
+// The TraverseExternalRefSchema type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseExternalRefSchema func(context.Context, *entgen.ExternalRefSchemaQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseExternalRefSchema) Intercept(next entgen.Querier) entgen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseExternalRefSchema) Traverse(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.ExternalRefSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *entgen.ExternalRefSchemaQuery", q)
+}
+
 // The ProjectSchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ProjectSchemaFunc func(context.Context, *entgen.ProjectSchemaQuery) (entgen.Value, error)
 
@@ -96,6 +185,60 @@ func (f TraverseProjectSchema) Traverse(ctx context.Context, q entgen.Query) err
 	return fmt.Errorf("unexpected query type %T. expect *entgen.ProjectSchemaQuery", q)
 }
 
+// The TagSchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TagSchemaFunc func(context.Context, *entgen.TagSchemaQuery) (entgen.Value, error)
+
+// Query calls f(ctx, q).
+func (f TagSchemaFunc) Query(ctx context.Context, q entgen.Query) (entgen.Value, error) {
+	if q, ok := q.(*entgen.TagSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *entgen.TagSchemaQuery", q)
+}
+
+// The TraverseTagSchema type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTagSchema func(context.Context, *entgen.TagSchemaQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTagSchema) Intercept(next entgen.Querier) entgen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTagSchema) Traverse(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.TagSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *entgen.TagSchemaQuery", q)
+}
+
+// The TodoHistorySchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TodoHistorySchemaFunc func(context.Context, *entgen.TodoHistorySchemaQuery) (entgen.Value, error)
+
+// Query calls f(ctx, q).
+func (f TodoHistorySchemaFunc) Query(ctx context.Context, q entgen.Query) (entgen.Value, error) {
+	if q, ok := q.(*entgen.TodoHistorySchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *entgen.TodoHistorySchemaQuery", q)
+}
+
+// The TraverseTodoHistorySchema type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTodoHistorySchema func(context.Context, *entgen.TodoHistorySchemaQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTodoHistorySchema) Intercept(next entgen.Querier) entgen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTodoHistorySchema) Traverse(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.TodoHistorySchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *entgen.TodoHistorySchemaQuery", q)
+}
+
 // The TodoSchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TodoSchemaFunc func(context.Context, *entgen.TodoSchemaQuery) (entgen.Value, error)
 
@@ -123,13 +266,110 @@ func (f TraverseTodoSchema) Traverse(ctx context.Context, q entgen.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *entgen.TodoSchemaQuery", q)
 }
 
+// The WebhookDeliverySchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookDeliverySchemaFunc func(context.Context, *entgen.WebhookDeliverySchemaQuery) (entgen.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookDeliverySchemaFunc) Query(ctx context.Context, q entgen.Query) (entgen.Value, error) {
+	if q, ok := q.(*entgen.WebhookDeliverySchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *entgen.WebhookDeliverySchemaQuery", q)
+}
+
+// The TraverseWebhookDeliverySchema type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookDeliverySchema func(context.Context, *entgen.WebhookDeliverySchemaQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookDeliverySchema) Intercept(next entgen.Querier) entgen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookDeliverySchema) Traverse(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.WebhookDeliverySchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *entgen.WebhookDeliverySchemaQuery", q)
+}
+
+// The WebhookEventSchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookEventSchemaFunc func(context.Context, *entgen.WebhookEventSchemaQuery) (entgen.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookEventSchemaFunc) Query(ctx context.Context, q entgen.Query) (entgen.Value, error) {
+	if q, ok := q.(*entgen.WebhookEventSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *entgen.WebhookEventSchemaQuery", q)
+}
+
+// The TraverseWebhookEventSchema type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookEventSchema func(context.Context, *entgen.WebhookEventSchemaQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookEventSchema) Intercept(next entgen.Querier) entgen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookEventSchema) Traverse(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.WebhookEventSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *entgen.WebhookEventSchemaQuery", q)
+}
+
+// The WebhookSchemaFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookSchemaFunc func(context.Context, *entgen.WebhookSchemaQuery) (entgen.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookSchemaFunc) Query(ctx context.Context, q entgen.Query) (entgen.Value, error) {
+	if q, ok := q.(*entgen.WebhookSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *entgen.WebhookSchemaQuery", q)
+}
+
+// The TraverseWebhookSchema type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookSchema func(context.Context, *entgen.WebhookSchemaQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookSchema) Intercept(next entgen.Querier) entgen.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookSchema) Traverse(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.WebhookSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *entgen.WebhookSchemaQuery", q)
+}
+
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q entgen.Query) (Query, error) {
 	switch q := q.(type) {
+	case *entgen.AttachmentSchemaQuery:
+		return &query[*entgen.AttachmentSchemaQuery, predicate.AttachmentSchema, attachmentschema.OrderOption]{typ: entgen.TypeAttachmentSchema, tq: q}, nil
+	case *entgen.CommentSchemaQuery:
+		return &query[*entgen.CommentSchemaQuery, predicate.CommentSchema, commentschema.OrderOption]{typ: entgen.TypeCommentSchema, tq: q}, nil
+	case *entgen.ExternalRefSchemaQuery:
+		return &query[*entgen.ExternalRefSchemaQuery, predicate.ExternalRefSchema, externalrefschema.OrderOption]{typ: entgen.TypeExternalRefSchema, tq: q}, nil
 	case *entgen.ProjectSchemaQuery:
 		return &query[*entgen.ProjectSchemaQuery, predicate.ProjectSchema, projectschema.OrderOption]{typ: entgen.TypeProjectSchema, tq: q}, nil
+	case *entgen.TagSchemaQuery:
+		return &query[*entgen.TagSchemaQuery, predicate.TagSchema, tagschema.OrderOption]{typ: entgen.TypeTagSchema, tq: q}, nil
+	case *entgen.TodoHistorySchemaQuery:
+		return &query[*entgen.TodoHistorySchemaQuery, predicate.TodoHistorySchema, todohistoryschema.OrderOption]{typ: entgen.TypeTodoHistorySchema, tq: q}, nil
 	case *entgen.TodoSchemaQuery:
 		return &query[*entgen.TodoSchemaQuery, predicate.TodoSchema, todoschema.OrderOption]{typ: entgen.TypeTodoSchema, tq: q}, nil
+	case *entgen.WebhookDeliverySchemaQuery:
+		return &query[*entgen.WebhookDeliverySchemaQuery, predicate.WebhookDeliverySchema, webhookdeliveryschema.OrderOption]{typ: entgen.TypeWebhookDeliverySchema, tq: q}, nil
+	case *entgen.WebhookEventSchemaQuery:
+		return &query[*entgen.WebhookEventSchemaQuery, predicate.WebhookEventSchema, webhookeventschema.OrderOption]{typ: entgen.TypeWebhookEventSchema, tq: q}, nil
+	case *entgen.WebhookSchemaQuery:
+		return &query[*entgen.WebhookSchemaQuery, predicate.WebhookSchema, webhookschema.OrderOption]{typ: entgen.TypeWebhookSchema, tq: q}, nil
 	default:
 		return nil, fmt.Errorf("unknown query type %T", q)
 	}
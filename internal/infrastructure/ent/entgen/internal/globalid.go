@@ -2,4 +2,4 @@
 
 package internal
 
-const IncrementStarts = "{\"project\":0,\"todo\":4294967296}"
+const IncrementStarts = "{\"attachment\":17179869184,\"comment\":12884901888,\"external_ref\":25769803776,\"project\":0,\"tag\":8589934592,\"todo\":4294967296,\"todo_history\":21474836480,\"webhook\":38654705664,\"webhook_delivery\":30064771072,\"webhook_event\":34359738368}"
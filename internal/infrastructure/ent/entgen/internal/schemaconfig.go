@@ -7,8 +7,18 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
-	ProjectSchema string // ProjectSchema table.
-	TodoSchema    string // TodoSchema table.
+	AttachmentSchema      string // AttachmentSchema table.
+	CommentSchema         string // CommentSchema table.
+	ExternalRefSchema     string // ExternalRefSchema table.
+	ProjectSchema         string // ProjectSchema table.
+	TagSchema             string // TagSchema table.
+	TodoHistorySchema     string // TodoHistorySchema table.
+	TodoSchema            string // TodoSchema table.
+	TodoSchemaTags        string // TodoSchema-tags->TagSchema table.
+	TodoSchemaBlockedBy   string // TodoSchema-blocked_by->TodoSchema table.
+	WebhookDeliverySchema string // WebhookDeliverySchema table.
+	WebhookEventSchema    string // WebhookEventSchema table.
+	WebhookSchema         string // WebhookSchema table.
 }
 
 type schemaCtxKey struct{}
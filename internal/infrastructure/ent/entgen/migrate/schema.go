@@ -9,9 +9,98 @@ import (
 )
 
 var (
+	// AttachmentColumns holds the columns for the "attachment" table.
+	AttachmentColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "filename", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "checksum", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "todo_id", Type: field.TypeUUID},
+	}
+	// AttachmentTable holds the schema information for the "attachment" table.
+	AttachmentTable = &schema.Table{
+		Name:       "attachment",
+		Columns:    AttachmentColumns,
+		PrimaryKey: []*schema.Column{AttachmentColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachment_todo_attachments",
+				Columns:    []*schema.Column{AttachmentColumns[6]},
+				RefColumns: []*schema.Column{TodoColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachmentschema_todo_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentColumns[6], AttachmentColumns[5]},
+			},
+		},
+	}
+	// CommentColumns holds the columns for the "comment" table.
+	CommentColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "author", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "todo_id", Type: field.TypeUUID},
+	}
+	// CommentTable holds the schema information for the "comment" table.
+	CommentTable = &schema.Table{
+		Name:       "comment",
+		Columns:    CommentColumns,
+		PrimaryKey: []*schema.Column{CommentColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comment_todo_comments",
+				Columns:    []*schema.Column{CommentColumns[7]},
+				RefColumns: []*schema.Column{TodoColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commentschema_todo_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentColumns[7], CommentColumns[3]},
+			},
+		},
+	}
+	// ExternalRefColumns holds the columns for the "external_ref" table.
+	ExternalRefColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"todoist", "trello", "github"}},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"PROJECT", "TAG", "TODO"}},
+		{Name: "external_id", Type: field.TypeString, Size: 255},
+		{Name: "local_id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ExternalRefTable holds the schema information for the "external_ref" table.
+	ExternalRefTable = &schema.Table{
+		Name:       "external_ref",
+		Columns:    ExternalRefColumns,
+		PrimaryKey: []*schema.Column{ExternalRefColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "externalrefschema_source_kind_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{ExternalRefColumns[1], ExternalRefColumns[2], ExternalRefColumns[3]},
+			},
+		},
+	}
 	// ProjectColumns holds the columns for the "project" table.
 	ProjectColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "workflow", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
 	}
 	// ProjectTable holds the schema information for the "project" table.
 	ProjectTable = &schema.Table{
@@ -19,22 +108,87 @@ var (
 		Columns:    ProjectColumns,
 		PrimaryKey: []*schema.Column{ProjectColumns[0]},
 	}
+	// TagColumns holds the columns for the "tag" table.
+	TagColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "color", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TagTable holds the schema information for the "tag" table.
+	TagTable = &schema.Table{
+		Name:       "tag",
+		Columns:    TagColumns,
+		PrimaryKey: []*schema.Column{TagColumns[0]},
+	}
+	// TodoHistoryColumns holds the columns for the "todo_history" table.
+	TodoHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "todo_id", Type: field.TypeUUID},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "project_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"CREATE", "UPDATE", "DELETE"}},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "occurred_at", Type: field.TypeTime},
+	}
+	// TodoHistoryTable holds the schema information for the "todo_history" table.
+	TodoHistoryTable = &schema.Table{
+		Name:       "todo_history",
+		Columns:    TodoHistoryColumns,
+		PrimaryKey: []*schema.Column{TodoHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "todohistoryschema_todo_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{TodoHistoryColumns[1], TodoHistoryColumns[2]},
+			},
+			{
+				Name:    "todohistoryschema_todo_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodoHistoryColumns[1], TodoHistoryColumns[7]},
+			},
+			{
+				Name:    "todohistoryschema_project_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodoHistoryColumns[3], TodoHistoryColumns[7]},
+			},
+		},
+	}
 	// TodoColumns holds the columns for the "todo" table.
 	TodoColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "title", Type: field.TypeString},
 		{Name: "body", Type: field.TypeString, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"NOT_STARTED", "IN_PROGRESS", "COMPLETED"}, Default: "NOT_STARTED"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"NOT_STARTED", "IN_PROGRESS", "COMPLETED", "CANCELLED", "ON_HOLD"}, Default: "NOT_STARTED"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status_id", Type: field.TypeString, Nullable: true},
+		{Name: "project_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TodoTable holds the schema information for the "todo" table.
 	TodoTable = &schema.Table{
 		Name:       "todo",
 		Columns:    TodoColumns,
 		PrimaryKey: []*schema.Column{TodoColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_project_todos",
+				Columns:    []*schema.Column{TodoColumns[9]},
+				RefColumns: []*schema.Column{ProjectColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "todo_todo_children",
+				Columns:    []*schema.Column{TodoColumns[10]},
+				RefColumns: []*schema.Column{TodoColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "todoschema_deleted_at_created_at",
@@ -51,22 +205,212 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{TodoColumns[7], TodoColumns[3]},
 			},
+			{
+				Name:    "todoschema_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoColumns[10]},
+			},
+			{
+				Name:    "todoschema_project_id_status_id",
+				Unique:  false,
+				Columns: []*schema.Column{TodoColumns[9], TodoColumns[8]},
+			},
+		},
+	}
+	// WebhookDeliveryColumns holds the columns for the "webhook_delivery" table.
+	WebhookDeliveryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "SUCCEEDED", "FAILED"}},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "response_status", Type: field.TypeInt},
+		{Name: "last_error", Type: field.TypeString},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveryTable holds the schema information for the "webhook_delivery" table.
+	WebhookDeliveryTable = &schema.Table{
+		Name:       "webhook_delivery",
+		Columns:    WebhookDeliveryColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_delivery_webhook_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveryColumns[11]},
+				RefColumns: []*schema.Column{WebhookColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdeliveryschema_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveryColumns[4], WebhookDeliveryColumns[8]},
+			},
+			{
+				Name:    "webhookdeliveryschema_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveryColumns[11], WebhookDeliveryColumns[9]},
+			},
+		},
+	}
+	// WebhookEventColumns holds the columns for the "webhook_event" table.
+	WebhookEventColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeString},
+		{Name: "todo_id", Type: field.TypeUUID},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "occurred_at", Type: field.TypeTime},
+	}
+	// WebhookEventTable holds the schema information for the "webhook_event" table.
+	WebhookEventTable = &schema.Table{
+		Name:       "webhook_event",
+		Columns:    WebhookEventColumns,
+		PrimaryKey: []*schema.Column{WebhookEventColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookeventschema_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEventColumns[4]},
+			},
+		},
+	}
+	// WebhookColumns holds the columns for the "webhook" table.
+	WebhookColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhookTable holds the schema information for the "webhook" table.
+	WebhookTable = &schema.Table{
+		Name:       "webhook",
+		Columns:    WebhookColumns,
+		PrimaryKey: []*schema.Column{WebhookColumns[0]},
+	}
+	// TodoTagColumns holds the columns for the "todo_tag" table.
+	TodoTagColumns = []*schema.Column{
+		{Name: "todo_id", Type: field.TypeUUID},
+		{Name: "tag_id", Type: field.TypeUUID},
+	}
+	// TodoTagTable holds the schema information for the "todo_tag" table.
+	TodoTagTable = &schema.Table{
+		Name:       "todo_tag",
+		Columns:    TodoTagColumns,
+		PrimaryKey: []*schema.Column{TodoTagColumns[0], TodoTagColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_tag_todo_id",
+				Columns:    []*schema.Column{TodoTagColumns[0]},
+				RefColumns: []*schema.Column{TodoColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_tag_tag_id",
+				Columns:    []*schema.Column{TodoTagColumns[1]},
+				RefColumns: []*schema.Column{TagColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TodoDependencyColumns holds the columns for the "todo_dependency" table.
+	TodoDependencyColumns = []*schema.Column{
+		{Name: "todo_id", Type: field.TypeUUID},
+		{Name: "blocker_id", Type: field.TypeUUID},
+	}
+	// TodoDependencyTable holds the schema information for the "todo_dependency" table.
+	TodoDependencyTable = &schema.Table{
+		Name:       "todo_dependency",
+		Columns:    TodoDependencyColumns,
+		PrimaryKey: []*schema.Column{TodoDependencyColumns[0], TodoDependencyColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "todo_dependency_todo_id",
+				Columns:    []*schema.Column{TodoDependencyColumns[0]},
+				RefColumns: []*schema.Column{TodoColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "todo_dependency_blocker_id",
+				Columns:    []*schema.Column{TodoDependencyColumns[1]},
+				RefColumns: []*schema.Column{TodoColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AttachmentTable,
+		CommentTable,
+		ExternalRefTable,
 		ProjectTable,
+		TagTable,
+		TodoHistoryTable,
 		TodoTable,
+		WebhookDeliveryTable,
+		WebhookEventTable,
+		WebhookTable,
+		TodoTagTable,
+		TodoDependencyTable,
 	}
 )
 
 func init() {
+	AttachmentTable.ForeignKeys[0].RefTable = TodoTable
+	AttachmentTable.Annotation = &entsql.Annotation{
+		Table:          "attachment",
+		IncrementStart: func(i int) *int { return &i }(17179869184),
+	}
+	CommentTable.ForeignKeys[0].RefTable = TodoTable
+	CommentTable.Annotation = &entsql.Annotation{
+		Table:          "comment",
+		IncrementStart: func(i int) *int { return &i }(12884901888),
+	}
+	ExternalRefTable.Annotation = &entsql.Annotation{
+		Table:          "external_ref",
+		IncrementStart: func(i int) *int { return &i }(25769803776),
+	}
 	ProjectTable.Annotation = &entsql.Annotation{
 		Table:          "project",
 		IncrementStart: func(i int) *int { return &i }(0),
 	}
+	TagTable.Annotation = &entsql.Annotation{
+		Table:          "tag",
+		IncrementStart: func(i int) *int { return &i }(8589934592),
+	}
+	TodoHistoryTable.Annotation = &entsql.Annotation{
+		Table:          "todo_history",
+		IncrementStart: func(i int) *int { return &i }(21474836480),
+	}
+	TodoTable.ForeignKeys[0].RefTable = ProjectTable
+	TodoTable.ForeignKeys[1].RefTable = TodoTable
 	TodoTable.Annotation = &entsql.Annotation{
 		Table:          "todo",
 		IncrementStart: func(i int) *int { return &i }(4294967296),
 	}
+	WebhookDeliveryTable.ForeignKeys[0].RefTable = WebhookTable
+	WebhookDeliveryTable.Annotation = &entsql.Annotation{
+		Table:          "webhook_delivery",
+		IncrementStart: func(i int) *int { return &i }(30064771072),
+	}
+	WebhookEventTable.Annotation = &entsql.Annotation{
+		Table:          "webhook_event",
+		IncrementStart: func(i int) *int { return &i }(34359738368),
+	}
+	WebhookTable.Annotation = &entsql.Annotation{
+		Table:          "webhook",
+		IncrementStart: func(i int) *int { return &i }(38654705664),
+	}
+	TodoTagTable.ForeignKeys[0].RefTable = TodoTable
+	TodoTagTable.ForeignKeys[1].RefTable = TagTable
+	TodoDependencyTable.ForeignKeys[0].RefTable = TodoTable
+	TodoDependencyTable.ForeignKeys[1].RefTable = TodoTable
 }
@@ -12,8 +12,18 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
 )
 
 const (
@@ -25,33 +35,6470 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeProjectSchema = "ProjectSchema"
-	TypeTodoSchema    = "TodoSchema"
+	TypeAttachmentSchema      = "AttachmentSchema"
+	TypeCommentSchema         = "CommentSchema"
+	TypeExternalRefSchema     = "ExternalRefSchema"
+	TypeProjectSchema         = "ProjectSchema"
+	TypeTagSchema             = "TagSchema"
+	TypeTodoHistorySchema     = "TodoHistorySchema"
+	TypeTodoSchema            = "TodoSchema"
+	TypeWebhookDeliverySchema = "WebhookDeliverySchema"
+	TypeWebhookEventSchema    = "WebhookEventSchema"
+	TypeWebhookSchema         = "WebhookSchema"
 )
 
+// AttachmentSchemaMutation represents an operation that mutates the AttachmentSchema nodes in the graph.
+type AttachmentSchemaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	filename      *string
+	content_type  *string
+	size          *int64
+	addsize       *int64
+	checksum      *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	todo          *uuid.UUID
+	clearedtodo   bool
+	done          bool
+	oldValue      func(context.Context) (*AttachmentSchema, error)
+	predicates    []predicate.AttachmentSchema
+}
+
+var _ ent.Mutation = (*AttachmentSchemaMutation)(nil)
+
+// attachmentschemaOption allows management of the mutation configuration using functional options.
+type attachmentschemaOption func(*AttachmentSchemaMutation)
+
+// newAttachmentSchemaMutation creates new mutation for the AttachmentSchema entity.
+func newAttachmentSchemaMutation(c config, op Op, opts ...attachmentschemaOption) *AttachmentSchemaMutation {
+	m := &AttachmentSchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAttachmentSchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAttachmentSchemaID sets the ID field of the mutation.
+func withAttachmentSchemaID(id uuid.UUID) attachmentschemaOption {
+	return func(m *AttachmentSchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AttachmentSchema
+		)
+		m.oldValue = func(ctx context.Context) (*AttachmentSchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AttachmentSchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAttachmentSchema sets the old AttachmentSchema of the mutation.
+func withAttachmentSchema(node *AttachmentSchema) attachmentschemaOption {
+	return func(m *AttachmentSchemaMutation) {
+		m.oldValue = func(context.Context) (*AttachmentSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AttachmentSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AttachmentSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AttachmentSchema entities.
+func (m *AttachmentSchemaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AttachmentSchemaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AttachmentSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AttachmentSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTodoID sets the "todo_id" field.
+func (m *AttachmentSchemaMutation) SetTodoID(u uuid.UUID) {
+	m.todo = &u
+}
+
+// TodoID returns the value of the "todo_id" field in the mutation.
+func (m *AttachmentSchemaMutation) TodoID() (r uuid.UUID, exists bool) {
+	v := m.todo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTodoID returns the old "todo_id" field's value of the AttachmentSchema entity.
+// If the AttachmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentSchemaMutation) OldTodoID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTodoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTodoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTodoID: %w", err)
+	}
+	return oldValue.TodoID, nil
+}
+
+// ResetTodoID resets all changes to the "todo_id" field.
+func (m *AttachmentSchemaMutation) ResetTodoID() {
+	m.todo = nil
+}
+
+// SetFilename sets the "filename" field.
+func (m *AttachmentSchemaMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *AttachmentSchemaMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the AttachmentSchema entity.
+// If the AttachmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentSchemaMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *AttachmentSchemaMutation) ResetFilename() {
+	m.filename = nil
+}
+
+// SetContentType sets the "content_type" field.
+func (m *AttachmentSchemaMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *AttachmentSchemaMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the AttachmentSchema entity.
+// If the AttachmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentSchemaMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *AttachmentSchemaMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetSize sets the "size" field.
+func (m *AttachmentSchemaMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *AttachmentSchemaMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the AttachmentSchema entity.
+// If the AttachmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentSchemaMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *AttachmentSchemaMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *AttachmentSchemaMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *AttachmentSchemaMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *AttachmentSchemaMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *AttachmentSchemaMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the AttachmentSchema entity.
+// If the AttachmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentSchemaMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *AttachmentSchemaMutation) ResetChecksum() {
+	m.checksum = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AttachmentSchemaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AttachmentSchemaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AttachmentSchema entity.
+// If the AttachmentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttachmentSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AttachmentSchemaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearTodo clears the "todo" edge to the TodoSchema entity.
+func (m *AttachmentSchemaMutation) ClearTodo() {
+	m.clearedtodo = true
+	m.clearedFields[attachmentschema.FieldTodoID] = struct{}{}
+}
+
+// TodoCleared reports if the "todo" edge to the TodoSchema entity was cleared.
+func (m *AttachmentSchemaMutation) TodoCleared() bool {
+	return m.clearedtodo
+}
+
+// TodoIDs returns the "todo" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TodoID instead. It exists only for internal usage by the builders.
+func (m *AttachmentSchemaMutation) TodoIDs() (ids []uuid.UUID) {
+	if id := m.todo; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTodo resets all changes to the "todo" edge.
+func (m *AttachmentSchemaMutation) ResetTodo() {
+	m.todo = nil
+	m.clearedtodo = false
+}
+
+// Where appends a list predicates to the AttachmentSchemaMutation builder.
+func (m *AttachmentSchemaMutation) Where(ps ...predicate.AttachmentSchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AttachmentSchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AttachmentSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AttachmentSchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AttachmentSchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AttachmentSchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AttachmentSchema).
+func (m *AttachmentSchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AttachmentSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.todo != nil {
+		fields = append(fields, attachmentschema.FieldTodoID)
+	}
+	if m.filename != nil {
+		fields = append(fields, attachmentschema.FieldFilename)
+	}
+	if m.content_type != nil {
+		fields = append(fields, attachmentschema.FieldContentType)
+	}
+	if m.size != nil {
+		fields = append(fields, attachmentschema.FieldSize)
+	}
+	if m.checksum != nil {
+		fields = append(fields, attachmentschema.FieldChecksum)
+	}
+	if m.created_at != nil {
+		fields = append(fields, attachmentschema.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AttachmentSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case attachmentschema.FieldTodoID:
+		return m.TodoID()
+	case attachmentschema.FieldFilename:
+		return m.Filename()
+	case attachmentschema.FieldContentType:
+		return m.ContentType()
+	case attachmentschema.FieldSize:
+		return m.Size()
+	case attachmentschema.FieldChecksum:
+		return m.Checksum()
+	case attachmentschema.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AttachmentSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case attachmentschema.FieldTodoID:
+		return m.OldTodoID(ctx)
+	case attachmentschema.FieldFilename:
+		return m.OldFilename(ctx)
+	case attachmentschema.FieldContentType:
+		return m.OldContentType(ctx)
+	case attachmentschema.FieldSize:
+		return m.OldSize(ctx)
+	case attachmentschema.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case attachmentschema.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AttachmentSchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttachmentSchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case attachmentschema.FieldTodoID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTodoID(v)
+		return nil
+	case attachmentschema.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case attachmentschema.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case attachmentschema.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case attachmentschema.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case attachmentschema.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AttachmentSchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AttachmentSchemaMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, attachmentschema.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AttachmentSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case attachmentschema.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttachmentSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case attachmentschema.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AttachmentSchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AttachmentSchemaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AttachmentSchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AttachmentSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AttachmentSchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AttachmentSchemaMutation) ResetField(name string) error {
+	switch name {
+	case attachmentschema.FieldTodoID:
+		m.ResetTodoID()
+		return nil
+	case attachmentschema.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case attachmentschema.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case attachmentschema.FieldSize:
+		m.ResetSize()
+		return nil
+	case attachmentschema.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case attachmentschema.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AttachmentSchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AttachmentSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.todo != nil {
+		edges = append(edges, attachmentschema.EdgeTodo)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AttachmentSchemaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case attachmentschema.EdgeTodo:
+		if id := m.todo; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AttachmentSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AttachmentSchemaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AttachmentSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtodo {
+		edges = append(edges, attachmentschema.EdgeTodo)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AttachmentSchemaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case attachmentschema.EdgeTodo:
+		return m.clearedtodo
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AttachmentSchemaMutation) ClearEdge(name string) error {
+	switch name {
+	case attachmentschema.EdgeTodo:
+		m.ClearTodo()
+		return nil
+	}
+	return fmt.Errorf("unknown AttachmentSchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AttachmentSchemaMutation) ResetEdge(name string) error {
+	switch name {
+	case attachmentschema.EdgeTodo:
+		m.ResetTodo()
+		return nil
+	}
+	return fmt.Errorf("unknown AttachmentSchema edge %s", name)
+}
+
+// CommentSchemaMutation represents an operation that mutates the CommentSchema nodes in the graph.
+type CommentSchemaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	author        *string
+	body          *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	edited_at     *time.Time
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	todo          *uuid.UUID
+	clearedtodo   bool
+	done          bool
+	oldValue      func(context.Context) (*CommentSchema, error)
+	predicates    []predicate.CommentSchema
+}
+
+var _ ent.Mutation = (*CommentSchemaMutation)(nil)
+
+// commentschemaOption allows management of the mutation configuration using functional options.
+type commentschemaOption func(*CommentSchemaMutation)
+
+// newCommentSchemaMutation creates new mutation for the CommentSchema entity.
+func newCommentSchemaMutation(c config, op Op, opts ...commentschemaOption) *CommentSchemaMutation {
+	m := &CommentSchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCommentSchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommentSchemaID sets the ID field of the mutation.
+func withCommentSchemaID(id uuid.UUID) commentschemaOption {
+	return func(m *CommentSchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CommentSchema
+		)
+		m.oldValue = func(ctx context.Context) (*CommentSchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CommentSchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCommentSchema sets the old CommentSchema of the mutation.
+func withCommentSchema(node *CommentSchema) commentschemaOption {
+	return func(m *CommentSchemaMutation) {
+		m.oldValue = func(context.Context) (*CommentSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CommentSchema entities.
+func (m *CommentSchemaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentSchemaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CommentSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTodoID sets the "todo_id" field.
+func (m *CommentSchemaMutation) SetTodoID(u uuid.UUID) {
+	m.todo = &u
+}
+
+// TodoID returns the value of the "todo_id" field in the mutation.
+func (m *CommentSchemaMutation) TodoID() (r uuid.UUID, exists bool) {
+	v := m.todo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTodoID returns the old "todo_id" field's value of the CommentSchema entity.
+// If the CommentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentSchemaMutation) OldTodoID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTodoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTodoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTodoID: %w", err)
+	}
+	return oldValue.TodoID, nil
+}
+
+// ResetTodoID resets all changes to the "todo_id" field.
+func (m *CommentSchemaMutation) ResetTodoID() {
+	m.todo = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *CommentSchemaMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *CommentSchemaMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the CommentSchema entity.
+// If the CommentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentSchemaMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *CommentSchemaMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetBody sets the "body" field.
+func (m *CommentSchemaMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *CommentSchemaMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the CommentSchema entity.
+// If the CommentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentSchemaMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *CommentSchemaMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentSchemaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentSchemaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CommentSchema entity.
+// If the CommentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentSchemaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CommentSchemaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CommentSchemaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CommentSchema entity.
+// If the CommentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentSchemaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CommentSchemaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *CommentSchemaMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *CommentSchemaMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the CommentSchema entity.
+// If the CommentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentSchemaMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *CommentSchemaMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[commentschema.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *CommentSchemaMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[commentschema.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *CommentSchemaMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, commentschema.FieldEditedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CommentSchemaMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CommentSchemaMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the CommentSchema entity.
+// If the CommentSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentSchemaMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CommentSchemaMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[commentschema.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CommentSchemaMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[commentschema.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CommentSchemaMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, commentschema.FieldDeletedAt)
+}
+
+// ClearTodo clears the "todo" edge to the TodoSchema entity.
+func (m *CommentSchemaMutation) ClearTodo() {
+	m.clearedtodo = true
+	m.clearedFields[commentschema.FieldTodoID] = struct{}{}
+}
+
+// TodoCleared reports if the "todo" edge to the TodoSchema entity was cleared.
+func (m *CommentSchemaMutation) TodoCleared() bool {
+	return m.clearedtodo
+}
+
+// TodoIDs returns the "todo" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TodoID instead. It exists only for internal usage by the builders.
+func (m *CommentSchemaMutation) TodoIDs() (ids []uuid.UUID) {
+	if id := m.todo; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTodo resets all changes to the "todo" edge.
+func (m *CommentSchemaMutation) ResetTodo() {
+	m.todo = nil
+	m.clearedtodo = false
+}
+
+// Where appends a list predicates to the CommentSchemaMutation builder.
+func (m *CommentSchemaMutation) Where(ps ...predicate.CommentSchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentSchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CommentSchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentSchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentSchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CommentSchema).
+func (m *CommentSchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.todo != nil {
+		fields = append(fields, commentschema.FieldTodoID)
+	}
+	if m.author != nil {
+		fields = append(fields, commentschema.FieldAuthor)
+	}
+	if m.body != nil {
+		fields = append(fields, commentschema.FieldBody)
+	}
+	if m.created_at != nil {
+		fields = append(fields, commentschema.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, commentschema.FieldUpdatedAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, commentschema.FieldEditedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, commentschema.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case commentschema.FieldTodoID:
+		return m.TodoID()
+	case commentschema.FieldAuthor:
+		return m.Author()
+	case commentschema.FieldBody:
+		return m.Body()
+	case commentschema.FieldCreatedAt:
+		return m.CreatedAt()
+	case commentschema.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case commentschema.FieldEditedAt:
+		return m.EditedAt()
+	case commentschema.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case commentschema.FieldTodoID:
+		return m.OldTodoID(ctx)
+	case commentschema.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case commentschema.FieldBody:
+		return m.OldBody(ctx)
+	case commentschema.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case commentschema.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case commentschema.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case commentschema.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CommentSchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentSchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case commentschema.FieldTodoID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTodoID(v)
+		return nil
+	case commentschema.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case commentschema.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case commentschema.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case commentschema.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case commentschema.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case commentschema.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CommentSchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentSchemaMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CommentSchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentSchemaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(commentschema.FieldEditedAt) {
+		fields = append(fields, commentschema.FieldEditedAt)
+	}
+	if m.FieldCleared(commentschema.FieldDeletedAt) {
+		fields = append(fields, commentschema.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentSchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentSchemaMutation) ClearField(name string) error {
+	switch name {
+	case commentschema.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case commentschema.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentSchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentSchemaMutation) ResetField(name string) error {
+	switch name {
+	case commentschema.FieldTodoID:
+		m.ResetTodoID()
+		return nil
+	case commentschema.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case commentschema.FieldBody:
+		m.ResetBody()
+		return nil
+	case commentschema.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case commentschema.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case commentschema.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case commentschema.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentSchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.todo != nil {
+		edges = append(edges, commentschema.EdgeTodo)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentSchemaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case commentschema.EdgeTodo:
+		if id := m.todo; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentSchemaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtodo {
+		edges = append(edges, commentschema.EdgeTodo)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentSchemaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case commentschema.EdgeTodo:
+		return m.clearedtodo
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentSchemaMutation) ClearEdge(name string) error {
+	switch name {
+	case commentschema.EdgeTodo:
+		m.ClearTodo()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentSchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentSchemaMutation) ResetEdge(name string) error {
+	switch name {
+	case commentschema.EdgeTodo:
+		m.ResetTodo()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentSchema edge %s", name)
+}
+
+// ExternalRefSchemaMutation represents an operation that mutates the ExternalRefSchema nodes in the graph.
+type ExternalRefSchemaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	source        *externalrefschema.Source
+	kind          *externalrefschema.Kind
+	external_id   *string
+	local_id      *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExternalRefSchema, error)
+	predicates    []predicate.ExternalRefSchema
+}
+
+var _ ent.Mutation = (*ExternalRefSchemaMutation)(nil)
+
+// externalrefschemaOption allows management of the mutation configuration using functional options.
+type externalrefschemaOption func(*ExternalRefSchemaMutation)
+
+// newExternalRefSchemaMutation creates new mutation for the ExternalRefSchema entity.
+func newExternalRefSchemaMutation(c config, op Op, opts ...externalrefschemaOption) *ExternalRefSchemaMutation {
+	m := &ExternalRefSchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExternalRefSchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExternalRefSchemaID sets the ID field of the mutation.
+func withExternalRefSchemaID(id uuid.UUID) externalrefschemaOption {
+	return func(m *ExternalRefSchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExternalRefSchema
+		)
+		m.oldValue = func(ctx context.Context) (*ExternalRefSchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExternalRefSchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExternalRefSchema sets the old ExternalRefSchema of the mutation.
+func withExternalRefSchema(node *ExternalRefSchema) externalrefschemaOption {
+	return func(m *ExternalRefSchemaMutation) {
+		m.oldValue = func(context.Context) (*ExternalRefSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExternalRefSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExternalRefSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExternalRefSchema entities.
+func (m *ExternalRefSchemaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExternalRefSchemaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExternalRefSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExternalRefSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSource sets the "source" field.
+func (m *ExternalRefSchemaMutation) SetSource(e externalrefschema.Source) {
+	m.source = &e
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ExternalRefSchemaMutation) Source() (r externalrefschema.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the ExternalRefSchema entity.
+// If the ExternalRefSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefSchemaMutation) OldSource(ctx context.Context) (v externalrefschema.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ExternalRefSchemaMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *ExternalRefSchemaMutation) SetKind(e externalrefschema.Kind) {
+	m.kind = &e
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ExternalRefSchemaMutation) Kind() (r externalrefschema.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the ExternalRefSchema entity.
+// If the ExternalRefSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefSchemaMutation) OldKind(ctx context.Context) (v externalrefschema.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ExternalRefSchemaMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *ExternalRefSchemaMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *ExternalRefSchemaMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the ExternalRefSchema entity.
+// If the ExternalRefSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefSchemaMutation) OldExternalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *ExternalRefSchemaMutation) ResetExternalID() {
+	m.external_id = nil
+}
+
+// SetLocalID sets the "local_id" field.
+func (m *ExternalRefSchemaMutation) SetLocalID(u uuid.UUID) {
+	m.local_id = &u
+}
+
+// LocalID returns the value of the "local_id" field in the mutation.
+func (m *ExternalRefSchemaMutation) LocalID() (r uuid.UUID, exists bool) {
+	v := m.local_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocalID returns the old "local_id" field's value of the ExternalRefSchema entity.
+// If the ExternalRefSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefSchemaMutation) OldLocalID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocalID: %w", err)
+	}
+	return oldValue.LocalID, nil
+}
+
+// ResetLocalID resets all changes to the "local_id" field.
+func (m *ExternalRefSchemaMutation) ResetLocalID() {
+	m.local_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExternalRefSchemaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExternalRefSchemaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExternalRefSchema entity.
+// If the ExternalRefSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExternalRefSchemaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the ExternalRefSchemaMutation builder.
+func (m *ExternalRefSchemaMutation) Where(ps ...predicate.ExternalRefSchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExternalRefSchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExternalRefSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExternalRefSchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExternalRefSchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExternalRefSchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExternalRefSchema).
+func (m *ExternalRefSchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExternalRefSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.source != nil {
+		fields = append(fields, externalrefschema.FieldSource)
+	}
+	if m.kind != nil {
+		fields = append(fields, externalrefschema.FieldKind)
+	}
+	if m.external_id != nil {
+		fields = append(fields, externalrefschema.FieldExternalID)
+	}
+	if m.local_id != nil {
+		fields = append(fields, externalrefschema.FieldLocalID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, externalrefschema.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExternalRefSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case externalrefschema.FieldSource:
+		return m.Source()
+	case externalrefschema.FieldKind:
+		return m.Kind()
+	case externalrefschema.FieldExternalID:
+		return m.ExternalID()
+	case externalrefschema.FieldLocalID:
+		return m.LocalID()
+	case externalrefschema.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExternalRefSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case externalrefschema.FieldSource:
+		return m.OldSource(ctx)
+	case externalrefschema.FieldKind:
+		return m.OldKind(ctx)
+	case externalrefschema.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case externalrefschema.FieldLocalID:
+		return m.OldLocalID(ctx)
+	case externalrefschema.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExternalRefSchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExternalRefSchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case externalrefschema.FieldSource:
+		v, ok := value.(externalrefschema.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case externalrefschema.FieldKind:
+		v, ok := value.(externalrefschema.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case externalrefschema.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case externalrefschema.FieldLocalID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocalID(v)
+		return nil
+	case externalrefschema.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalRefSchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExternalRefSchemaMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExternalRefSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExternalRefSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExternalRefSchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExternalRefSchemaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExternalRefSchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExternalRefSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExternalRefSchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExternalRefSchemaMutation) ResetField(name string) error {
+	switch name {
+	case externalrefschema.FieldSource:
+		m.ResetSource()
+		return nil
+	case externalrefschema.FieldKind:
+		m.ResetKind()
+		return nil
+	case externalrefschema.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case externalrefschema.FieldLocalID:
+		m.ResetLocalID()
+		return nil
+	case externalrefschema.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalRefSchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExternalRefSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExternalRefSchemaMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExternalRefSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExternalRefSchemaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExternalRefSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExternalRefSchemaMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExternalRefSchemaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExternalRefSchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExternalRefSchemaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExternalRefSchema edge %s", name)
+}
+
 // ProjectSchemaMutation represents an operation that mutates the ProjectSchema nodes in the graph.
 type ProjectSchemaMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
+	name          *string
+	workflow      *schema.WorkflowDefinition
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	todos         map[uuid.UUID]struct{}
+	removedtodos  map[uuid.UUID]struct{}
+	clearedtodos  bool
+	done          bool
+	oldValue      func(context.Context) (*ProjectSchema, error)
+	predicates    []predicate.ProjectSchema
+}
+
+var _ ent.Mutation = (*ProjectSchemaMutation)(nil)
+
+// projectschemaOption allows management of the mutation configuration using functional options.
+type projectschemaOption func(*ProjectSchemaMutation)
+
+// newProjectSchemaMutation creates new mutation for the ProjectSchema entity.
+func newProjectSchemaMutation(c config, op Op, opts ...projectschemaOption) *ProjectSchemaMutation {
+	m := &ProjectSchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProjectSchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectSchemaID sets the ID field of the mutation.
+func withProjectSchemaID(id uuid.UUID) projectschemaOption {
+	return func(m *ProjectSchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProjectSchema
+		)
+		m.oldValue = func(ctx context.Context) (*ProjectSchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProjectSchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProjectSchema sets the old ProjectSchema of the mutation.
+func withProjectSchema(node *ProjectSchema) projectschemaOption {
+	return func(m *ProjectSchemaMutation) {
+		m.oldValue = func(context.Context) (*ProjectSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProjectSchema entities.
+func (m *ProjectSchemaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectSchemaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProjectSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProjectSchemaMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectSchemaMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ProjectSchema entity.
+// If the ProjectSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSchemaMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProjectSchemaMutation) ResetName() {
+	m.name = nil
+}
+
+// SetWorkflow sets the "workflow" field.
+func (m *ProjectSchemaMutation) SetWorkflow(sd schema.WorkflowDefinition) {
+	m.workflow = &sd
+}
+
+// Workflow returns the value of the "workflow" field in the mutation.
+func (m *ProjectSchemaMutation) Workflow() (r schema.WorkflowDefinition, exists bool) {
+	v := m.workflow
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflow returns the old "workflow" field's value of the ProjectSchema entity.
+// If the ProjectSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSchemaMutation) OldWorkflow(ctx context.Context) (v schema.WorkflowDefinition, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkflow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkflow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflow: %w", err)
+	}
+	return oldValue.Workflow, nil
+}
+
+// ResetWorkflow resets all changes to the "workflow" field.
+func (m *ProjectSchemaMutation) ResetWorkflow() {
+	m.workflow = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectSchemaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectSchemaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProjectSchema entity.
+// If the ProjectSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectSchemaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProjectSchemaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProjectSchemaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProjectSchema entity.
+// If the ProjectSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSchemaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProjectSchemaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddTodoIDs adds the "todos" edge to the TodoSchema entity by ids.
+func (m *ProjectSchemaMutation) AddTodoIDs(ids ...uuid.UUID) {
+	if m.todos == nil {
+		m.todos = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.todos[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTodos clears the "todos" edge to the TodoSchema entity.
+func (m *ProjectSchemaMutation) ClearTodos() {
+	m.clearedtodos = true
+}
+
+// TodosCleared reports if the "todos" edge to the TodoSchema entity was cleared.
+func (m *ProjectSchemaMutation) TodosCleared() bool {
+	return m.clearedtodos
+}
+
+// RemoveTodoIDs removes the "todos" edge to the TodoSchema entity by IDs.
+func (m *ProjectSchemaMutation) RemoveTodoIDs(ids ...uuid.UUID) {
+	if m.removedtodos == nil {
+		m.removedtodos = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.todos, ids[i])
+		m.removedtodos[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTodos returns the removed IDs of the "todos" edge to the TodoSchema entity.
+func (m *ProjectSchemaMutation) RemovedTodosIDs() (ids []uuid.UUID) {
+	for id := range m.removedtodos {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TodosIDs returns the "todos" edge IDs in the mutation.
+func (m *ProjectSchemaMutation) TodosIDs() (ids []uuid.UUID) {
+	for id := range m.todos {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTodos resets all changes to the "todos" edge.
+func (m *ProjectSchemaMutation) ResetTodos() {
+	m.todos = nil
+	m.clearedtodos = false
+	m.removedtodos = nil
+}
+
+// Where appends a list predicates to the ProjectSchemaMutation builder.
+func (m *ProjectSchemaMutation) Where(ps ...predicate.ProjectSchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectSchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProjectSchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectSchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectSchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProjectSchema).
+func (m *ProjectSchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, projectschema.FieldName)
+	}
+	if m.workflow != nil {
+		fields = append(fields, projectschema.FieldWorkflow)
+	}
+	if m.created_at != nil {
+		fields = append(fields, projectschema.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, projectschema.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case projectschema.FieldName:
+		return m.Name()
+	case projectschema.FieldWorkflow:
+		return m.Workflow()
+	case projectschema.FieldCreatedAt:
+		return m.CreatedAt()
+	case projectschema.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case projectschema.FieldName:
+		return m.OldName(ctx)
+	case projectschema.FieldWorkflow:
+		return m.OldWorkflow(ctx)
+	case projectschema.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case projectschema.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProjectSchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectSchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case projectschema.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case projectschema.FieldWorkflow:
+		v, ok := value.(schema.WorkflowDefinition)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflow(v)
+		return nil
+	case projectschema.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case projectschema.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectSchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectSchemaMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProjectSchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectSchemaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectSchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ProjectSchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectSchemaMutation) ResetField(name string) error {
+	switch name {
+	case projectschema.FieldName:
+		m.ResetName()
+		return nil
+	case projectschema.FieldWorkflow:
+		m.ResetWorkflow()
+		return nil
+	case projectschema.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case projectschema.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectSchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.todos != nil {
+		edges = append(edges, projectschema.EdgeTodos)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectSchemaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case projectschema.EdgeTodos:
+		ids := make([]ent.Value, 0, len(m.todos))
+		for id := range m.todos {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedtodos != nil {
+		edges = append(edges, projectschema.EdgeTodos)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectSchemaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case projectschema.EdgeTodos:
+		ids := make([]ent.Value, 0, len(m.removedtodos))
+		for id := range m.removedtodos {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtodos {
+		edges = append(edges, projectschema.EdgeTodos)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectSchemaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case projectschema.EdgeTodos:
+		return m.clearedtodos
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectSchemaMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ProjectSchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectSchemaMutation) ResetEdge(name string) error {
+	switch name {
+	case projectschema.EdgeTodos:
+		m.ResetTodos()
+		return nil
+	}
+	return fmt.Errorf("unknown ProjectSchema edge %s", name)
+}
+
+// TagSchemaMutation represents an operation that mutates the TagSchema nodes in the graph.
+type TagSchemaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	color         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	todos         map[uuid.UUID]struct{}
+	removedtodos  map[uuid.UUID]struct{}
+	clearedtodos  bool
+	done          bool
+	oldValue      func(context.Context) (*TagSchema, error)
+	predicates    []predicate.TagSchema
+}
+
+var _ ent.Mutation = (*TagSchemaMutation)(nil)
+
+// tagschemaOption allows management of the mutation configuration using functional options.
+type tagschemaOption func(*TagSchemaMutation)
+
+// newTagSchemaMutation creates new mutation for the TagSchema entity.
+func newTagSchemaMutation(c config, op Op, opts ...tagschemaOption) *TagSchemaMutation {
+	m := &TagSchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTagSchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagSchemaID sets the ID field of the mutation.
+func withTagSchemaID(id uuid.UUID) tagschemaOption {
+	return func(m *TagSchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TagSchema
+		)
+		m.oldValue = func(ctx context.Context) (*TagSchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TagSchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTagSchema sets the old TagSchema of the mutation.
+func withTagSchema(node *TagSchema) tagschemaOption {
+	return func(m *TagSchemaMutation) {
+		m.oldValue = func(context.Context) (*TagSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TagSchema entities.
+func (m *TagSchemaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagSchemaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TagSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagSchemaMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagSchemaMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TagSchema entity.
+// If the TagSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSchemaMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagSchemaMutation) ResetName() {
+	m.name = nil
+}
+
+// SetColor sets the "color" field.
+func (m *TagSchemaMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *TagSchemaMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the TagSchema entity.
+// If the TagSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSchemaMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *TagSchemaMutation) ResetColor() {
+	m.color = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagSchemaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagSchemaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TagSchema entity.
+// If the TagSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagSchemaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TagSchemaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TagSchemaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TagSchema entity.
+// If the TagSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagSchemaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TagSchemaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddTodoIDs adds the "todos" edge to the TodoSchema entity by ids.
+func (m *TagSchemaMutation) AddTodoIDs(ids ...uuid.UUID) {
+	if m.todos == nil {
+		m.todos = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.todos[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTodos clears the "todos" edge to the TodoSchema entity.
+func (m *TagSchemaMutation) ClearTodos() {
+	m.clearedtodos = true
+}
+
+// TodosCleared reports if the "todos" edge to the TodoSchema entity was cleared.
+func (m *TagSchemaMutation) TodosCleared() bool {
+	return m.clearedtodos
+}
+
+// RemoveTodoIDs removes the "todos" edge to the TodoSchema entity by IDs.
+func (m *TagSchemaMutation) RemoveTodoIDs(ids ...uuid.UUID) {
+	if m.removedtodos == nil {
+		m.removedtodos = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.todos, ids[i])
+		m.removedtodos[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTodos returns the removed IDs of the "todos" edge to the TodoSchema entity.
+func (m *TagSchemaMutation) RemovedTodosIDs() (ids []uuid.UUID) {
+	for id := range m.removedtodos {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TodosIDs returns the "todos" edge IDs in the mutation.
+func (m *TagSchemaMutation) TodosIDs() (ids []uuid.UUID) {
+	for id := range m.todos {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTodos resets all changes to the "todos" edge.
+func (m *TagSchemaMutation) ResetTodos() {
+	m.todos = nil
+	m.clearedtodos = false
+	m.removedtodos = nil
+}
+
+// Where appends a list predicates to the TagSchemaMutation builder.
+func (m *TagSchemaMutation) Where(ps ...predicate.TagSchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagSchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TagSchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagSchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagSchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TagSchema).
+func (m *TagSchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, tagschema.FieldName)
+	}
+	if m.color != nil {
+		fields = append(fields, tagschema.FieldColor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tagschema.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tagschema.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tagschema.FieldName:
+		return m.Name()
+	case tagschema.FieldColor:
+		return m.Color()
+	case tagschema.FieldCreatedAt:
+		return m.CreatedAt()
+	case tagschema.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tagschema.FieldName:
+		return m.OldName(ctx)
+	case tagschema.FieldColor:
+		return m.OldColor(ctx)
+	case tagschema.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tagschema.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TagSchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagSchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tagschema.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tagschema.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case tagschema.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tagschema.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TagSchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagSchemaMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TagSchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagSchemaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagSchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TagSchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagSchemaMutation) ResetField(name string) error {
+	switch name {
+	case tagschema.FieldName:
+		m.ResetName()
+		return nil
+	case tagschema.FieldColor:
+		m.ResetColor()
+		return nil
+	case tagschema.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tagschema.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TagSchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.todos != nil {
+		edges = append(edges, tagschema.EdgeTodos)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagSchemaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tagschema.EdgeTodos:
+		ids := make([]ent.Value, 0, len(m.todos))
+		for id := range m.todos {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedtodos != nil {
+		edges = append(edges, tagschema.EdgeTodos)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagSchemaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tagschema.EdgeTodos:
+		ids := make([]ent.Value, 0, len(m.removedtodos))
+		for id := range m.removedtodos {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtodos {
+		edges = append(edges, tagschema.EdgeTodos)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagSchemaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tagschema.EdgeTodos:
+		return m.clearedtodos
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagSchemaMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TagSchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagSchemaMutation) ResetEdge(name string) error {
+	switch name {
+	case tagschema.EdgeTodos:
+		m.ResetTodos()
+		return nil
+	}
+	return fmt.Errorf("unknown TagSchema edge %s", name)
+}
+
+// TodoHistorySchemaMutation represents an operation that mutates the TodoHistorySchema nodes in the graph.
+type TodoHistorySchemaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	todo_id       *uuid.UUID
+	version       *int
+	addversion    *int
+	project_id    *uuid.UUID
+	actor         *string
+	operation     *todohistoryschema.Operation
+	changes       *[]schema.HistoryChange
+	appendchanges []schema.HistoryChange
+	occurred_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TodoHistorySchema, error)
+	predicates    []predicate.TodoHistorySchema
+}
+
+var _ ent.Mutation = (*TodoHistorySchemaMutation)(nil)
+
+// todohistoryschemaOption allows management of the mutation configuration using functional options.
+type todohistoryschemaOption func(*TodoHistorySchemaMutation)
+
+// newTodoHistorySchemaMutation creates new mutation for the TodoHistorySchema entity.
+func newTodoHistorySchemaMutation(c config, op Op, opts ...todohistoryschemaOption) *TodoHistorySchemaMutation {
+	m := &TodoHistorySchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTodoHistorySchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTodoHistorySchemaID sets the ID field of the mutation.
+func withTodoHistorySchemaID(id uuid.UUID) todohistoryschemaOption {
+	return func(m *TodoHistorySchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TodoHistorySchema
+		)
+		m.oldValue = func(ctx context.Context) (*TodoHistorySchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TodoHistorySchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTodoHistorySchema sets the old TodoHistorySchema of the mutation.
+func withTodoHistorySchema(node *TodoHistorySchema) todohistoryschemaOption {
+	return func(m *TodoHistorySchemaMutation) {
+		m.oldValue = func(context.Context) (*TodoHistorySchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TodoHistorySchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TodoHistorySchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TodoHistorySchema entities.
+func (m *TodoHistorySchemaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TodoHistorySchemaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TodoHistorySchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TodoHistorySchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTodoID sets the "todo_id" field.
+func (m *TodoHistorySchemaMutation) SetTodoID(u uuid.UUID) {
+	m.todo_id = &u
+}
+
+// TodoID returns the value of the "todo_id" field in the mutation.
+func (m *TodoHistorySchemaMutation) TodoID() (r uuid.UUID, exists bool) {
+	v := m.todo_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTodoID returns the old "todo_id" field's value of the TodoHistorySchema entity.
+// If the TodoHistorySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoHistorySchemaMutation) OldTodoID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTodoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTodoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTodoID: %w", err)
+	}
+	return oldValue.TodoID, nil
+}
+
+// ResetTodoID resets all changes to the "todo_id" field.
+func (m *TodoHistorySchemaMutation) ResetTodoID() {
+	m.todo_id = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *TodoHistorySchemaMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *TodoHistorySchemaMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the TodoHistorySchema entity.
+// If the TodoHistorySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoHistorySchemaMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *TodoHistorySchemaMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *TodoHistorySchemaMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *TodoHistorySchemaMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *TodoHistorySchemaMutation) SetProjectID(u uuid.UUID) {
+	m.project_id = &u
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *TodoHistorySchemaMutation) ProjectID() (r uuid.UUID, exists bool) {
+	v := m.project_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the TodoHistorySchema entity.
+// If the TodoHistorySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoHistorySchemaMutation) OldProjectID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (m *TodoHistorySchemaMutation) ClearProjectID() {
+	m.project_id = nil
+	m.clearedFields[todohistoryschema.FieldProjectID] = struct{}{}
+}
+
+// ProjectIDCleared returns if the "project_id" field was cleared in this mutation.
+func (m *TodoHistorySchemaMutation) ProjectIDCleared() bool {
+	_, ok := m.clearedFields[todohistoryschema.FieldProjectID]
+	return ok
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *TodoHistorySchemaMutation) ResetProjectID() {
+	m.project_id = nil
+	delete(m.clearedFields, todohistoryschema.FieldProjectID)
+}
+
+// SetActor sets the "actor" field.
+func (m *TodoHistorySchemaMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *TodoHistorySchemaMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the TodoHistorySchema entity.
+// If the TodoHistorySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoHistorySchemaMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *TodoHistorySchemaMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetOperation sets the "operation" field.
+func (m *TodoHistorySchemaMutation) SetOperation(t todohistoryschema.Operation) {
+	m.operation = &t
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *TodoHistorySchemaMutation) Operation() (r todohistoryschema.Operation, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the TodoHistorySchema entity.
+// If the TodoHistorySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoHistorySchemaMutation) OldOperation(ctx context.Context) (v todohistoryschema.Operation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *TodoHistorySchemaMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *TodoHistorySchemaMutation) SetChanges(sc []schema.HistoryChange) {
+	m.changes = &sc
+	m.appendchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *TodoHistorySchemaMutation) Changes() (r []schema.HistoryChange, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the TodoHistorySchema entity.
+// If the TodoHistorySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoHistorySchemaMutation) OldChanges(ctx context.Context) (v []schema.HistoryChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// AppendChanges adds sc to the "changes" field.
+func (m *TodoHistorySchemaMutation) AppendChanges(sc []schema.HistoryChange) {
+	m.appendchanges = append(m.appendchanges, sc...)
+}
+
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *TodoHistorySchemaMutation) AppendedChanges() ([]schema.HistoryChange, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
+	}
+	return m.appendchanges, true
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *TodoHistorySchemaMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (m *TodoHistorySchemaMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
+}
+
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *TodoHistorySchemaMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOccurredAt returns the old "occurred_at" field's value of the TodoHistorySchema entity.
+// If the TodoHistorySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoHistorySchemaMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
+	}
+	return oldValue.OccurredAt, nil
+}
+
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *TodoHistorySchemaMutation) ResetOccurredAt() {
+	m.occurred_at = nil
+}
+
+// Where appends a list predicates to the TodoHistorySchemaMutation builder.
+func (m *TodoHistorySchemaMutation) Where(ps ...predicate.TodoHistorySchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TodoHistorySchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TodoHistorySchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TodoHistorySchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TodoHistorySchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TodoHistorySchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TodoHistorySchema).
+func (m *TodoHistorySchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TodoHistorySchemaMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.todo_id != nil {
+		fields = append(fields, todohistoryschema.FieldTodoID)
+	}
+	if m.version != nil {
+		fields = append(fields, todohistoryschema.FieldVersion)
+	}
+	if m.project_id != nil {
+		fields = append(fields, todohistoryschema.FieldProjectID)
+	}
+	if m.actor != nil {
+		fields = append(fields, todohistoryschema.FieldActor)
+	}
+	if m.operation != nil {
+		fields = append(fields, todohistoryschema.FieldOperation)
+	}
+	if m.changes != nil {
+		fields = append(fields, todohistoryschema.FieldChanges)
+	}
+	if m.occurred_at != nil {
+		fields = append(fields, todohistoryschema.FieldOccurredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TodoHistorySchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case todohistoryschema.FieldTodoID:
+		return m.TodoID()
+	case todohistoryschema.FieldVersion:
+		return m.Version()
+	case todohistoryschema.FieldProjectID:
+		return m.ProjectID()
+	case todohistoryschema.FieldActor:
+		return m.Actor()
+	case todohistoryschema.FieldOperation:
+		return m.Operation()
+	case todohistoryschema.FieldChanges:
+		return m.Changes()
+	case todohistoryschema.FieldOccurredAt:
+		return m.OccurredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TodoHistorySchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case todohistoryschema.FieldTodoID:
+		return m.OldTodoID(ctx)
+	case todohistoryschema.FieldVersion:
+		return m.OldVersion(ctx)
+	case todohistoryschema.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case todohistoryschema.FieldActor:
+		return m.OldActor(ctx)
+	case todohistoryschema.FieldOperation:
+		return m.OldOperation(ctx)
+	case todohistoryschema.FieldChanges:
+		return m.OldChanges(ctx)
+	case todohistoryschema.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TodoHistorySchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TodoHistorySchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case todohistoryschema.FieldTodoID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTodoID(v)
+		return nil
+	case todohistoryschema.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case todohistoryschema.FieldProjectID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case todohistoryschema.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case todohistoryschema.FieldOperation:
+		v, ok := value.(todohistoryschema.Operation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case todohistoryschema.FieldChanges:
+		v, ok := value.([]schema.HistoryChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case todohistoryschema.FieldOccurredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TodoHistorySchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TodoHistorySchemaMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, todohistoryschema.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TodoHistorySchemaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case todohistoryschema.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TodoHistorySchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case todohistoryschema.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TodoHistorySchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TodoHistorySchemaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(todohistoryschema.FieldProjectID) {
+		fields = append(fields, todohistoryschema.FieldProjectID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TodoHistorySchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TodoHistorySchemaMutation) ClearField(name string) error {
+	switch name {
+	case todohistoryschema.FieldProjectID:
+		m.ClearProjectID()
+		return nil
+	}
+	return fmt.Errorf("unknown TodoHistorySchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TodoHistorySchemaMutation) ResetField(name string) error {
+	switch name {
+	case todohistoryschema.FieldTodoID:
+		m.ResetTodoID()
+		return nil
+	case todohistoryschema.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case todohistoryschema.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case todohistoryschema.FieldActor:
+		m.ResetActor()
+		return nil
+	case todohistoryschema.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case todohistoryschema.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case todohistoryschema.FieldOccurredAt:
+		m.ResetOccurredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TodoHistorySchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TodoHistorySchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TodoHistorySchemaMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TodoHistorySchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TodoHistorySchemaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TodoHistorySchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TodoHistorySchemaMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TodoHistorySchemaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TodoHistorySchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TodoHistorySchemaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TodoHistorySchema edge %s", name)
+}
+
+// TodoSchemaMutation represents an operation that mutates the TodoSchema nodes in the graph.
+type TodoSchemaMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	title              *string
+	body               *string
+	status             *todoschema.Status
+	created_at         *time.Time
+	updated_at         *time.Time
+	completed_at       *time.Time
+	deleted_at         *time.Time
+	status_id          *string
+	clearedFields      map[string]struct{}
+	project            *uuid.UUID
+	clearedproject     bool
+	tags               map[uuid.UUID]struct{}
+	removedtags        map[uuid.UUID]struct{}
+	clearedtags        bool
+	parent             *uuid.UUID
+	clearedparent      bool
+	children           map[uuid.UUID]struct{}
+	removedchildren    map[uuid.UUID]struct{}
+	clearedchildren    bool
+	blocks             map[uuid.UUID]struct{}
+	removedblocks      map[uuid.UUID]struct{}
+	clearedblocks      bool
+	blocked_by         map[uuid.UUID]struct{}
+	removedblocked_by  map[uuid.UUID]struct{}
+	clearedblocked_by  bool
+	comments           map[uuid.UUID]struct{}
+	removedcomments    map[uuid.UUID]struct{}
+	clearedcomments    bool
+	attachments        map[uuid.UUID]struct{}
+	removedattachments map[uuid.UUID]struct{}
+	clearedattachments bool
+	done               bool
+	oldValue           func(context.Context) (*TodoSchema, error)
+	predicates         []predicate.TodoSchema
+}
+
+var _ ent.Mutation = (*TodoSchemaMutation)(nil)
+
+// todoschemaOption allows management of the mutation configuration using functional options.
+type todoschemaOption func(*TodoSchemaMutation)
+
+// newTodoSchemaMutation creates new mutation for the TodoSchema entity.
+func newTodoSchemaMutation(c config, op Op, opts ...todoschemaOption) *TodoSchemaMutation {
+	m := &TodoSchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTodoSchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTodoSchemaID sets the ID field of the mutation.
+func withTodoSchemaID(id uuid.UUID) todoschemaOption {
+	return func(m *TodoSchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TodoSchema
+		)
+		m.oldValue = func(ctx context.Context) (*TodoSchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TodoSchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTodoSchema sets the old TodoSchema of the mutation.
+func withTodoSchema(node *TodoSchema) todoschemaOption {
+	return func(m *TodoSchemaMutation) {
+		m.oldValue = func(context.Context) (*TodoSchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TodoSchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TodoSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TodoSchema entities.
+func (m *TodoSchemaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TodoSchemaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TodoSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TodoSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *TodoSchemaMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TodoSchemaMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TodoSchemaMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetBody sets the "body" field.
+func (m *TodoSchemaMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *TodoSchemaMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldBody(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ClearBody clears the value of the "body" field.
+func (m *TodoSchemaMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[todoschema.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *TodoSchemaMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[todoschema.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *TodoSchemaMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, todoschema.FieldBody)
+}
+
+// SetStatus sets the "status" field.
+func (m *TodoSchemaMutation) SetStatus(t todoschema.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TodoSchemaMutation) Status() (r todoschema.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldStatus(ctx context.Context) (v todoschema.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TodoSchemaMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TodoSchemaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TodoSchemaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TodoSchemaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TodoSchemaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TodoSchemaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TodoSchemaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *TodoSchemaMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *TodoSchemaMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *TodoSchemaMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[todoschema.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *TodoSchemaMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[todoschema.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *TodoSchemaMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, todoschema.FieldCompletedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TodoSchemaMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TodoSchemaMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TodoSchemaMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[todoschema.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TodoSchemaMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[todoschema.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TodoSchemaMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, todoschema.FieldDeletedAt)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *TodoSchemaMutation) SetParentID(u uuid.UUID) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *TodoSchemaMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *TodoSchemaMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[todoschema.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *TodoSchemaMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[todoschema.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *TodoSchemaMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, todoschema.FieldParentID)
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *TodoSchemaMutation) SetProjectID(u uuid.UUID) {
+	m.project = &u
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *TodoSchemaMutation) ProjectID() (r uuid.UUID, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldProjectID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (m *TodoSchemaMutation) ClearProjectID() {
+	m.project = nil
+	m.clearedFields[todoschema.FieldProjectID] = struct{}{}
+}
+
+// ProjectIDCleared returns if the "project_id" field was cleared in this mutation.
+func (m *TodoSchemaMutation) ProjectIDCleared() bool {
+	_, ok := m.clearedFields[todoschema.FieldProjectID]
+	return ok
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *TodoSchemaMutation) ResetProjectID() {
+	m.project = nil
+	delete(m.clearedFields, todoschema.FieldProjectID)
+}
+
+// SetStatusID sets the "status_id" field.
+func (m *TodoSchemaMutation) SetStatusID(s string) {
+	m.status_id = &s
+}
+
+// StatusID returns the value of the "status_id" field in the mutation.
+func (m *TodoSchemaMutation) StatusID() (r string, exists bool) {
+	v := m.status_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusID returns the old "status_id" field's value of the TodoSchema entity.
+// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoSchemaMutation) OldStatusID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusID: %w", err)
+	}
+	return oldValue.StatusID, nil
+}
+
+// ClearStatusID clears the value of the "status_id" field.
+func (m *TodoSchemaMutation) ClearStatusID() {
+	m.status_id = nil
+	m.clearedFields[todoschema.FieldStatusID] = struct{}{}
+}
+
+// StatusIDCleared returns if the "status_id" field was cleared in this mutation.
+func (m *TodoSchemaMutation) StatusIDCleared() bool {
+	_, ok := m.clearedFields[todoschema.FieldStatusID]
+	return ok
+}
+
+// ResetStatusID resets all changes to the "status_id" field.
+func (m *TodoSchemaMutation) ResetStatusID() {
+	m.status_id = nil
+	delete(m.clearedFields, todoschema.FieldStatusID)
+}
+
+// ClearProject clears the "project" edge to the ProjectSchema entity.
+func (m *TodoSchemaMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[todoschema.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the ProjectSchema entity was cleared.
+func (m *TodoSchemaMutation) ProjectCleared() bool {
+	return m.ProjectIDCleared() || m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *TodoSchemaMutation) ProjectIDs() (ids []uuid.UUID) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *TodoSchemaMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// AddTagIDs adds the "tags" edge to the TagSchema entity by ids.
+func (m *TodoSchemaMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the TagSchema entity.
+func (m *TodoSchemaMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the TagSchema entity was cleared.
+func (m *TodoSchemaMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the TagSchema entity by IDs.
+func (m *TodoSchemaMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the TagSchema entity.
+func (m *TodoSchemaMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *TodoSchemaMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *TodoSchemaMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// ClearParent clears the "parent" edge to the TodoSchema entity.
+func (m *TodoSchemaMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[todoschema.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the TodoSchema entity was cleared.
+func (m *TodoSchemaMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *TodoSchemaMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *TodoSchemaMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the TodoSchema entity by ids.
+func (m *TodoSchemaMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the TodoSchema entity.
+func (m *TodoSchemaMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the TodoSchema entity was cleared.
+func (m *TodoSchemaMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the TodoSchema entity by IDs.
+func (m *TodoSchemaMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the TodoSchema entity.
+func (m *TodoSchemaMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *TodoSchemaMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *TodoSchemaMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddBlockIDs adds the "blocks" edge to the TodoSchema entity by ids.
+func (m *TodoSchemaMutation) AddBlockIDs(ids ...uuid.UUID) {
+	if m.blocks == nil {
+		m.blocks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlocks clears the "blocks" edge to the TodoSchema entity.
+func (m *TodoSchemaMutation) ClearBlocks() {
+	m.clearedblocks = true
+}
+
+// BlocksCleared reports if the "blocks" edge to the TodoSchema entity was cleared.
+func (m *TodoSchemaMutation) BlocksCleared() bool {
+	return m.clearedblocks
+}
+
+// RemoveBlockIDs removes the "blocks" edge to the TodoSchema entity by IDs.
+func (m *TodoSchemaMutation) RemoveBlockIDs(ids ...uuid.UUID) {
+	if m.removedblocks == nil {
+		m.removedblocks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocks, ids[i])
+		m.removedblocks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlocks returns the removed IDs of the "blocks" edge to the TodoSchema entity.
+func (m *TodoSchemaMutation) RemovedBlocksIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlocksIDs returns the "blocks" edge IDs in the mutation.
+func (m *TodoSchemaMutation) BlocksIDs() (ids []uuid.UUID) {
+	for id := range m.blocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlocks resets all changes to the "blocks" edge.
+func (m *TodoSchemaMutation) ResetBlocks() {
+	m.blocks = nil
+	m.clearedblocks = false
+	m.removedblocks = nil
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the TodoSchema entity by ids.
+func (m *TodoSchemaMutation) AddBlockedByIDs(ids ...uuid.UUID) {
+	if m.blocked_by == nil {
+		m.blocked_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockedBy clears the "blocked_by" edge to the TodoSchema entity.
+func (m *TodoSchemaMutation) ClearBlockedBy() {
+	m.clearedblocked_by = true
+}
+
+// BlockedByCleared reports if the "blocked_by" edge to the TodoSchema entity was cleared.
+func (m *TodoSchemaMutation) BlockedByCleared() bool {
+	return m.clearedblocked_by
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to the TodoSchema entity by IDs.
+func (m *TodoSchemaMutation) RemoveBlockedByIDs(ids ...uuid.UUID) {
+	if m.removedblocked_by == nil {
+		m.removedblocked_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_by, ids[i])
+		m.removedblocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockedBy returns the removed IDs of the "blocked_by" edge to the TodoSchema entity.
+func (m *TodoSchemaMutation) RemovedBlockedByIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockedByIDs returns the "blocked_by" edge IDs in the mutation.
+func (m *TodoSchemaMutation) BlockedByIDs() (ids []uuid.UUID) {
+	for id := range m.blocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockedBy resets all changes to the "blocked_by" edge.
+func (m *TodoSchemaMutation) ResetBlockedBy() {
+	m.blocked_by = nil
+	m.clearedblocked_by = false
+	m.removedblocked_by = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the CommentSchema entity by ids.
+func (m *TodoSchemaMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the CommentSchema entity.
+func (m *TodoSchemaMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the CommentSchema entity was cleared.
+func (m *TodoSchemaMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the CommentSchema entity by IDs.
+func (m *TodoSchemaMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the CommentSchema entity.
+func (m *TodoSchemaMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *TodoSchemaMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *TodoSchemaMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the AttachmentSchema entity by ids.
+func (m *TodoSchemaMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the AttachmentSchema entity.
+func (m *TodoSchemaMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the AttachmentSchema entity was cleared.
+func (m *TodoSchemaMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the AttachmentSchema entity by IDs.
+func (m *TodoSchemaMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the AttachmentSchema entity.
+func (m *TodoSchemaMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *TodoSchemaMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *TodoSchemaMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// Where appends a list predicates to the TodoSchemaMutation builder.
+func (m *TodoSchemaMutation) Where(ps ...predicate.TodoSchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TodoSchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TodoSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TodoSchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TodoSchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TodoSchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TodoSchema).
+func (m *TodoSchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TodoSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.title != nil {
+		fields = append(fields, todoschema.FieldTitle)
+	}
+	if m.body != nil {
+		fields = append(fields, todoschema.FieldBody)
+	}
+	if m.status != nil {
+		fields = append(fields, todoschema.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, todoschema.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, todoschema.FieldUpdatedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, todoschema.FieldCompletedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, todoschema.FieldDeletedAt)
+	}
+	if m.parent != nil {
+		fields = append(fields, todoschema.FieldParentID)
+	}
+	if m.project != nil {
+		fields = append(fields, todoschema.FieldProjectID)
+	}
+	if m.status_id != nil {
+		fields = append(fields, todoschema.FieldStatusID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TodoSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case todoschema.FieldTitle:
+		return m.Title()
+	case todoschema.FieldBody:
+		return m.Body()
+	case todoschema.FieldStatus:
+		return m.Status()
+	case todoschema.FieldCreatedAt:
+		return m.CreatedAt()
+	case todoschema.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case todoschema.FieldCompletedAt:
+		return m.CompletedAt()
+	case todoschema.FieldDeletedAt:
+		return m.DeletedAt()
+	case todoschema.FieldParentID:
+		return m.ParentID()
+	case todoschema.FieldProjectID:
+		return m.ProjectID()
+	case todoschema.FieldStatusID:
+		return m.StatusID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TodoSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case todoschema.FieldTitle:
+		return m.OldTitle(ctx)
+	case todoschema.FieldBody:
+		return m.OldBody(ctx)
+	case todoschema.FieldStatus:
+		return m.OldStatus(ctx)
+	case todoschema.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case todoschema.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case todoschema.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case todoschema.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case todoschema.FieldParentID:
+		return m.OldParentID(ctx)
+	case todoschema.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case todoschema.FieldStatusID:
+		return m.OldStatusID(ctx)
+	}
+	return nil, fmt.Errorf("unknown TodoSchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TodoSchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case todoschema.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case todoschema.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case todoschema.FieldStatus:
+		v, ok := value.(todoschema.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case todoschema.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case todoschema.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case todoschema.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case todoschema.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case todoschema.FieldParentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case todoschema.FieldProjectID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case todoschema.FieldStatusID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TodoSchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TodoSchemaMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TodoSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TodoSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TodoSchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TodoSchemaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(todoschema.FieldBody) {
+		fields = append(fields, todoschema.FieldBody)
+	}
+	if m.FieldCleared(todoschema.FieldCompletedAt) {
+		fields = append(fields, todoschema.FieldCompletedAt)
+	}
+	if m.FieldCleared(todoschema.FieldDeletedAt) {
+		fields = append(fields, todoschema.FieldDeletedAt)
+	}
+	if m.FieldCleared(todoschema.FieldParentID) {
+		fields = append(fields, todoschema.FieldParentID)
+	}
+	if m.FieldCleared(todoschema.FieldProjectID) {
+		fields = append(fields, todoschema.FieldProjectID)
+	}
+	if m.FieldCleared(todoschema.FieldStatusID) {
+		fields = append(fields, todoschema.FieldStatusID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TodoSchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TodoSchemaMutation) ClearField(name string) error {
+	switch name {
+	case todoschema.FieldBody:
+		m.ClearBody()
+		return nil
+	case todoschema.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case todoschema.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case todoschema.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case todoschema.FieldProjectID:
+		m.ClearProjectID()
+		return nil
+	case todoschema.FieldStatusID:
+		m.ClearStatusID()
+		return nil
+	}
+	return fmt.Errorf("unknown TodoSchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TodoSchemaMutation) ResetField(name string) error {
+	switch name {
+	case todoschema.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case todoschema.FieldBody:
+		m.ResetBody()
+		return nil
+	case todoschema.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case todoschema.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case todoschema.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case todoschema.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case todoschema.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case todoschema.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case todoschema.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case todoschema.FieldStatusID:
+		m.ResetStatusID()
+		return nil
+	}
+	return fmt.Errorf("unknown TodoSchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TodoSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.project != nil {
+		edges = append(edges, todoschema.EdgeProject)
+	}
+	if m.tags != nil {
+		edges = append(edges, todoschema.EdgeTags)
+	}
+	if m.parent != nil {
+		edges = append(edges, todoschema.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, todoschema.EdgeChildren)
+	}
+	if m.blocks != nil {
+		edges = append(edges, todoschema.EdgeBlocks)
+	}
+	if m.blocked_by != nil {
+		edges = append(edges, todoschema.EdgeBlockedBy)
+	}
+	if m.comments != nil {
+		edges = append(edges, todoschema.EdgeComments)
+	}
+	if m.attachments != nil {
+		edges = append(edges, todoschema.EdgeAttachments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TodoSchemaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case todoschema.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	case todoschema.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case todoschema.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeBlocks:
+		ids := make([]ent.Value, 0, len(m.blocks))
+		for id := range m.blocks {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.blocked_by))
+		for id := range m.blocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TodoSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedtags != nil {
+		edges = append(edges, todoschema.EdgeTags)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, todoschema.EdgeChildren)
+	}
+	if m.removedblocks != nil {
+		edges = append(edges, todoschema.EdgeBlocks)
+	}
+	if m.removedblocked_by != nil {
+		edges = append(edges, todoschema.EdgeBlockedBy)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, todoschema.EdgeComments)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, todoschema.EdgeAttachments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TodoSchemaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case todoschema.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeBlocks:
+		ids := make([]ent.Value, 0, len(m.removedblocks))
+		for id := range m.removedblocks {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.removedblocked_by))
+		for id := range m.removedblocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case todoschema.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TodoSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedproject {
+		edges = append(edges, todoschema.EdgeProject)
+	}
+	if m.clearedtags {
+		edges = append(edges, todoschema.EdgeTags)
+	}
+	if m.clearedparent {
+		edges = append(edges, todoschema.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, todoschema.EdgeChildren)
+	}
+	if m.clearedblocks {
+		edges = append(edges, todoschema.EdgeBlocks)
+	}
+	if m.clearedblocked_by {
+		edges = append(edges, todoschema.EdgeBlockedBy)
+	}
+	if m.clearedcomments {
+		edges = append(edges, todoschema.EdgeComments)
+	}
+	if m.clearedattachments {
+		edges = append(edges, todoschema.EdgeAttachments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TodoSchemaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case todoschema.EdgeProject:
+		return m.clearedproject
+	case todoschema.EdgeTags:
+		return m.clearedtags
+	case todoschema.EdgeParent:
+		return m.clearedparent
+	case todoschema.EdgeChildren:
+		return m.clearedchildren
+	case todoschema.EdgeBlocks:
+		return m.clearedblocks
+	case todoschema.EdgeBlockedBy:
+		return m.clearedblocked_by
+	case todoschema.EdgeComments:
+		return m.clearedcomments
+	case todoschema.EdgeAttachments:
+		return m.clearedattachments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TodoSchemaMutation) ClearEdge(name string) error {
+	switch name {
+	case todoschema.EdgeProject:
+		m.ClearProject()
+		return nil
+	case todoschema.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown TodoSchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TodoSchemaMutation) ResetEdge(name string) error {
+	switch name {
+	case todoschema.EdgeProject:
+		m.ResetProject()
+		return nil
+	case todoschema.EdgeTags:
+		m.ResetTags()
+		return nil
+	case todoschema.EdgeParent:
+		m.ResetParent()
+		return nil
+	case todoschema.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case todoschema.EdgeBlocks:
+		m.ResetBlocks()
+		return nil
+	case todoschema.EdgeBlockedBy:
+		m.ResetBlockedBy()
+		return nil
+	case todoschema.EdgeComments:
+		m.ResetComments()
+		return nil
+	case todoschema.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	}
+	return fmt.Errorf("unknown TodoSchema edge %s", name)
+}
+
+// WebhookDeliverySchemaMutation represents an operation that mutates the WebhookDeliverySchema nodes in the graph.
+type WebhookDeliverySchemaMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	event_id           *uuid.UUID
+	event_type         *string
+	payload            *[]byte
+	status             *webhookdeliveryschema.Status
+	attempts           *int
+	addattempts        *int
+	response_status    *int
+	addresponse_status *int
+	last_error         *string
+	next_attempt_at    *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	webhook            *uuid.UUID
+	clearedwebhook     bool
+	done               bool
+	oldValue           func(context.Context) (*WebhookDeliverySchema, error)
+	predicates         []predicate.WebhookDeliverySchema
+}
+
+var _ ent.Mutation = (*WebhookDeliverySchemaMutation)(nil)
+
+// webhookdeliveryschemaOption allows management of the mutation configuration using functional options.
+type webhookdeliveryschemaOption func(*WebhookDeliverySchemaMutation)
+
+// newWebhookDeliverySchemaMutation creates new mutation for the WebhookDeliverySchema entity.
+func newWebhookDeliverySchemaMutation(c config, op Op, opts ...webhookdeliveryschemaOption) *WebhookDeliverySchemaMutation {
+	m := &WebhookDeliverySchemaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDeliverySchema,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookDeliverySchemaID sets the ID field of the mutation.
+func withWebhookDeliverySchemaID(id uuid.UUID) webhookdeliveryschemaOption {
+	return func(m *WebhookDeliverySchemaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDeliverySchema
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDeliverySchema, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDeliverySchema.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDeliverySchema sets the old WebhookDeliverySchema of the mutation.
+func withWebhookDeliverySchema(node *WebhookDeliverySchema) webhookdeliveryschemaOption {
+	return func(m *WebhookDeliverySchemaMutation) {
+		m.oldValue = func(context.Context) (*WebhookDeliverySchema, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliverySchemaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliverySchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDeliverySchema entities.
+func (m *WebhookDeliverySchemaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliverySchemaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliverySchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDeliverySchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliverySchemaMutation) SetWebhookID(u uuid.UUID) {
+	m.webhook = &u
+}
+
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) WebhookID() (r uuid.UUID, exists bool) {
+	v := m.webhook
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldWebhookID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
+	}
+	return oldValue.WebhookID, nil
+}
+
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliverySchemaMutation) ResetWebhookID() {
+	m.webhook = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliverySchemaMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliverySchemaMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliverySchemaMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliverySchemaMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliverySchemaMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliverySchemaMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WebhookDeliverySchemaMutation) SetStatus(w webhookdeliveryschema.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) Status() (r webhookdeliveryschema.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldStatus(ctx context.Context) (v webhookdeliveryschema.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliverySchemaMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliverySchemaMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliverySchemaMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliverySchemaMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliverySchemaMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliverySchemaMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldResponseStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
+	}
+	return oldValue.ResponseStatus, nil
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliverySchemaMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
+}
+
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliverySchemaMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliverySchemaMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliverySchemaMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliverySchemaMutation) ResetLastError() {
+	m.last_error = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliverySchemaMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliverySchemaMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliverySchemaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliverySchemaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookDeliverySchemaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookDeliverySchemaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDeliverySchema entity.
+// If the WebhookDeliverySchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliverySchemaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookDeliverySchemaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWebhook clears the "webhook" edge to the WebhookSchema entity.
+func (m *WebhookDeliverySchemaMutation) ClearWebhook() {
+	m.clearedwebhook = true
+	m.clearedFields[webhookdeliveryschema.FieldWebhookID] = struct{}{}
+}
+
+// WebhookCleared reports if the "webhook" edge to the WebhookSchema entity was cleared.
+func (m *WebhookDeliverySchemaMutation) WebhookCleared() bool {
+	return m.clearedwebhook
+}
+
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliverySchemaMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliverySchemaMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
+}
+
+// Where appends a list predicates to the WebhookDeliverySchemaMutation builder.
+func (m *WebhookDeliverySchemaMutation) Where(ps ...predicate.WebhookDeliverySchema) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WebhookDeliverySchemaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookDeliverySchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDeliverySchema, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WebhookDeliverySchemaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WebhookDeliverySchemaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WebhookDeliverySchema).
+func (m *WebhookDeliverySchemaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookDeliverySchemaMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.webhook != nil {
+		fields = append(fields, webhookdeliveryschema.FieldWebhookID)
+	}
+	if m.event_id != nil {
+		fields = append(fields, webhookdeliveryschema.FieldEventID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, webhookdeliveryschema.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdeliveryschema.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, webhookdeliveryschema.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdeliveryschema.FieldAttempts)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdeliveryschema.FieldResponseStatus)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdeliveryschema.FieldLastError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdeliveryschema.FieldNextAttemptAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhookdeliveryschema.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhookdeliveryschema.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookDeliverySchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdeliveryschema.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdeliveryschema.FieldEventID:
+		return m.EventID()
+	case webhookdeliveryschema.FieldEventType:
+		return m.EventType()
+	case webhookdeliveryschema.FieldPayload:
+		return m.Payload()
+	case webhookdeliveryschema.FieldStatus:
+		return m.Status()
+	case webhookdeliveryschema.FieldAttempts:
+		return m.Attempts()
+	case webhookdeliveryschema.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdeliveryschema.FieldLastError:
+		return m.LastError()
+	case webhookdeliveryschema.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdeliveryschema.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookdeliveryschema.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookDeliverySchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhookdeliveryschema.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdeliveryschema.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdeliveryschema.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdeliveryschema.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdeliveryschema.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdeliveryschema.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdeliveryschema.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdeliveryschema.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdeliveryschema.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdeliveryschema.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookdeliveryschema.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDeliverySchema field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeliverySchemaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhookdeliveryschema.FieldWebhookID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookID(v)
+		return nil
+	case webhookdeliveryschema.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case webhookdeliveryschema.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case webhookdeliveryschema.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookdeliveryschema.FieldStatus:
+		v, ok := value.(webhookdeliveryschema.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdeliveryschema.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdeliveryschema.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdeliveryschema.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdeliveryschema.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case webhookdeliveryschema.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdeliveryschema.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeliverySchema field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookDeliverySchemaMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdeliveryschema.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdeliveryschema.FieldResponseStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookDeliverySchemaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdeliveryschema.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdeliveryschema.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeliverySchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case webhookdeliveryschema.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdeliveryschema.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeliverySchema numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookDeliverySchemaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookDeliverySchemaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookDeliverySchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WebhookDeliverySchema nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookDeliverySchemaMutation) ResetField(name string) error {
+	switch name {
+	case webhookdeliveryschema.FieldWebhookID:
+		m.ResetWebhookID()
+		return nil
+	case webhookdeliveryschema.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case webhookdeliveryschema.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case webhookdeliveryschema.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdeliveryschema.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdeliveryschema.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdeliveryschema.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdeliveryschema.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdeliveryschema.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdeliveryschema.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhookdeliveryschema.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeliverySchema field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookDeliverySchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdeliveryschema.EdgeWebhook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookDeliverySchemaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhookdeliveryschema.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookDeliverySchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookDeliverySchemaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookDeliverySchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdeliveryschema.EdgeWebhook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookDeliverySchemaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhookdeliveryschema.EdgeWebhook:
+		return m.clearedwebhook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookDeliverySchemaMutation) ClearEdge(name string) error {
+	switch name {
+	case webhookdeliveryschema.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeliverySchema unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookDeliverySchemaMutation) ResetEdge(name string) error {
+	switch name {
+	case webhookdeliveryschema.EdgeWebhook:
+		m.ResetWebhook()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeliverySchema edge %s", name)
+}
+
+// WebhookEventSchemaMutation represents an operation that mutates the WebhookEventSchema nodes in the graph.
+type WebhookEventSchemaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	_type         *string
+	todo_id       *uuid.UUID
+	payload       *[]byte
+	occurred_at   *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*ProjectSchema, error)
-	predicates    []predicate.ProjectSchema
+	oldValue      func(context.Context) (*WebhookEventSchema, error)
+	predicates    []predicate.WebhookEventSchema
 }
 
-var _ ent.Mutation = (*ProjectSchemaMutation)(nil)
+var _ ent.Mutation = (*WebhookEventSchemaMutation)(nil)
 
-// projectschemaOption allows management of the mutation configuration using functional options.
-type projectschemaOption func(*ProjectSchemaMutation)
+// webhookeventschemaOption allows management of the mutation configuration using functional options.
+type webhookeventschemaOption func(*WebhookEventSchemaMutation)
 
-// newProjectSchemaMutation creates new mutation for the ProjectSchema entity.
-func newProjectSchemaMutation(c config, op Op, opts ...projectschemaOption) *ProjectSchemaMutation {
-	m := &ProjectSchemaMutation{
+// newWebhookEventSchemaMutation creates new mutation for the WebhookEventSchema entity.
+func newWebhookEventSchemaMutation(c config, op Op, opts ...webhookeventschemaOption) *WebhookEventSchemaMutation {
+	m := &WebhookEventSchemaMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProjectSchema,
+		typ:           TypeWebhookEventSchema,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -60,20 +6507,20 @@ func newProjectSchemaMutation(c config, op Op, opts ...projectschemaOption) *Pro
 	return m
 }
 
-// withProjectSchemaID sets the ID field of the mutation.
-func withProjectSchemaID(id uuid.UUID) projectschemaOption {
-	return func(m *ProjectSchemaMutation) {
+// withWebhookEventSchemaID sets the ID field of the mutation.
+func withWebhookEventSchemaID(id uuid.UUID) webhookeventschemaOption {
+	return func(m *WebhookEventSchemaMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProjectSchema
+			value *WebhookEventSchema
 		)
-		m.oldValue = func(ctx context.Context) (*ProjectSchema, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookEventSchema, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProjectSchema.Get(ctx, id)
+					value, err = m.Client().WebhookEventSchema.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -82,10 +6529,10 @@ func withProjectSchemaID(id uuid.UUID) projectschemaOption {
 	}
 }
 
-// withProjectSchema sets the old ProjectSchema of the mutation.
-func withProjectSchema(node *ProjectSchema) projectschemaOption {
-	return func(m *ProjectSchemaMutation) {
-		m.oldValue = func(context.Context) (*ProjectSchema, error) {
+// withWebhookEventSchema sets the old WebhookEventSchema of the mutation.
+func withWebhookEventSchema(node *WebhookEventSchema) webhookeventschemaOption {
+	return func(m *WebhookEventSchemaMutation) {
+		m.oldValue = func(context.Context) (*WebhookEventSchema, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -94,66 +6541,210 @@ func withProjectSchema(node *ProjectSchema) projectschemaOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProjectSchemaMutation) Client() *Client {
+func (m WebhookEventSchemaMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ProjectSchemaMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("entgen: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookEventSchemaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookEventSchema entities.
+func (m *WebhookEventSchemaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookEventSchemaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookEventSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookEventSchema.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *WebhookEventSchemaMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *WebhookEventSchemaMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the WebhookEventSchema entity.
+// If the WebhookEventSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEventSchemaMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *WebhookEventSchemaMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTodoID sets the "todo_id" field.
+func (m *WebhookEventSchemaMutation) SetTodoID(u uuid.UUID) {
+	m.todo_id = &u
+}
+
+// TodoID returns the value of the "todo_id" field in the mutation.
+func (m *WebhookEventSchemaMutation) TodoID() (r uuid.UUID, exists bool) {
+	v := m.todo_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTodoID returns the old "todo_id" field's value of the WebhookEventSchema entity.
+// If the WebhookEventSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEventSchemaMutation) OldTodoID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTodoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTodoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTodoID: %w", err)
+	}
+	return oldValue.TodoID, nil
+}
+
+// ResetTodoID resets all changes to the "todo_id" field.
+func (m *WebhookEventSchemaMutation) ResetTodoID() {
+	m.todo_id = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookEventSchemaMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookEventSchemaMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookEventSchema entity.
+// If the WebhookEventSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEventSchemaMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookEventSchemaMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProjectSchema entities.
-func (m *ProjectSchemaMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetOccurredAt sets the "occurred_at" field.
+func (m *WebhookEventSchemaMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ProjectSchemaMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *WebhookEventSchemaMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ProjectSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProjectSchema.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldOccurredAt returns the old "occurred_at" field's value of the WebhookEventSchema entity.
+// If the WebhookEventSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEventSchemaMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
+	}
+	return oldValue.OccurredAt, nil
 }
 
-// Where appends a list predicates to the ProjectSchemaMutation builder.
-func (m *ProjectSchemaMutation) Where(ps ...predicate.ProjectSchema) {
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *WebhookEventSchemaMutation) ResetOccurredAt() {
+	m.occurred_at = nil
+}
+
+// Where appends a list predicates to the WebhookEventSchemaMutation builder.
+func (m *WebhookEventSchemaMutation) Where(ps ...predicate.WebhookEventSchema) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProjectSchemaMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookEventSchemaMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProjectSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProjectSchema, len(ps))
+func (m *WebhookEventSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookEventSchema, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -161,174 +6752,255 @@ func (m *ProjectSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProjectSchemaMutation) Op() Op {
+func (m *WebhookEventSchemaMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProjectSchemaMutation) SetOp(op Op) {
+func (m *WebhookEventSchemaMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ProjectSchema).
-func (m *ProjectSchemaMutation) Type() string {
+// Type returns the node type of this mutation (WebhookEventSchema).
+func (m *WebhookEventSchemaMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProjectSchemaMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *WebhookEventSchemaMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m._type != nil {
+		fields = append(fields, webhookeventschema.FieldType)
+	}
+	if m.todo_id != nil {
+		fields = append(fields, webhookeventschema.FieldTodoID)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookeventschema.FieldPayload)
+	}
+	if m.occurred_at != nil {
+		fields = append(fields, webhookeventschema.FieldOccurredAt)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProjectSchemaMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookEventSchemaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhookeventschema.FieldType:
+		return m.GetType()
+	case webhookeventschema.FieldTodoID:
+		return m.TodoID()
+	case webhookeventschema.FieldPayload:
+		return m.Payload()
+	case webhookeventschema.FieldOccurredAt:
+		return m.OccurredAt()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProjectSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown ProjectSchema field %s", name)
+func (m *WebhookEventSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhookeventschema.FieldType:
+		return m.OldType(ctx)
+	case webhookeventschema.FieldTodoID:
+		return m.OldTodoID(ctx)
+	case webhookeventschema.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookeventschema.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookEventSchema field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectSchemaMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookEventSchemaMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case webhookeventschema.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case webhookeventschema.FieldTodoID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTodoID(v)
+		return nil
+	case webhookeventschema.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookeventschema.FieldOccurredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurredAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ProjectSchema field %s", name)
+	return fmt.Errorf("unknown WebhookEventSchema field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProjectSchemaMutation) AddedFields() []string {
+func (m *WebhookEventSchemaMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProjectSchemaMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookEventSchemaMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectSchemaMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown ProjectSchema numeric field %s", name)
+func (m *WebhookEventSchemaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WebhookEventSchema numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProjectSchemaMutation) ClearedFields() []string {
+func (m *WebhookEventSchemaMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProjectSchemaMutation) FieldCleared(name string) bool {
+func (m *WebhookEventSchemaMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProjectSchemaMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ProjectSchema nullable field %s", name)
+func (m *WebhookEventSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WebhookEventSchema nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProjectSchemaMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown ProjectSchema field %s", name)
+func (m *WebhookEventSchemaMutation) ResetField(name string) error {
+	switch name {
+	case webhookeventschema.FieldType:
+		m.ResetType()
+		return nil
+	case webhookeventschema.FieldTodoID:
+		m.ResetTodoID()
+		return nil
+	case webhookeventschema.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookeventschema.FieldOccurredAt:
+		m.ResetOccurredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookEventSchema field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProjectSchemaMutation) AddedEdges() []string {
+func (m *WebhookEventSchemaMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProjectSchemaMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookEventSchemaMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProjectSchemaMutation) RemovedEdges() []string {
+func (m *WebhookEventSchemaMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProjectSchemaMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookEventSchemaMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProjectSchemaMutation) ClearedEdges() []string {
+func (m *WebhookEventSchemaMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProjectSchemaMutation) EdgeCleared(name string) bool {
+func (m *WebhookEventSchemaMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProjectSchemaMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ProjectSchema unique edge %s", name)
+func (m *WebhookEventSchemaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookEventSchema unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProjectSchemaMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ProjectSchema edge %s", name)
+func (m *WebhookEventSchemaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookEventSchema edge %s", name)
 }
 
-// TodoSchemaMutation represents an operation that mutates the TodoSchema nodes in the graph.
-type TodoSchemaMutation struct {
+// WebhookSchemaMutation represents an operation that mutates the WebhookSchema nodes in the graph.
+type WebhookSchemaMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	title         *string
-	body          *string
-	status        *todoschema.Status
-	created_at    *time.Time
-	updated_at    *time.Time
-	completed_at  *time.Time
-	deleted_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*TodoSchema, error)
-	predicates    []predicate.TodoSchema
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	url                     *string
+	secret                  *string
+	events                  *[]string
+	appendevents            []string
+	enabled                 *bool
+	consecutive_failures    *int
+	addconsecutive_failures *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	deliveries              map[uuid.UUID]struct{}
+	removeddeliveries       map[uuid.UUID]struct{}
+	cleareddeliveries       bool
+	done                    bool
+	oldValue                func(context.Context) (*WebhookSchema, error)
+	predicates              []predicate.WebhookSchema
 }
 
-var _ ent.Mutation = (*TodoSchemaMutation)(nil)
+var _ ent.Mutation = (*WebhookSchemaMutation)(nil)
 
-// todoschemaOption allows management of the mutation configuration using functional options.
-type todoschemaOption func(*TodoSchemaMutation)
+// webhookschemaOption allows management of the mutation configuration using functional options.
+type webhookschemaOption func(*WebhookSchemaMutation)
 
-// newTodoSchemaMutation creates new mutation for the TodoSchema entity.
-func newTodoSchemaMutation(c config, op Op, opts ...todoschemaOption) *TodoSchemaMutation {
-	m := &TodoSchemaMutation{
+// newWebhookSchemaMutation creates new mutation for the WebhookSchema entity.
+func newWebhookSchemaMutation(c config, op Op, opts ...webhookschemaOption) *WebhookSchemaMutation {
+	m := &WebhookSchemaMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTodoSchema,
+		typ:           TypeWebhookSchema,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -337,20 +7009,20 @@ func newTodoSchemaMutation(c config, op Op, opts ...todoschemaOption) *TodoSchem
 	return m
 }
 
-// withTodoSchemaID sets the ID field of the mutation.
-func withTodoSchemaID(id uuid.UUID) todoschemaOption {
-	return func(m *TodoSchemaMutation) {
+// withWebhookSchemaID sets the ID field of the mutation.
+func withWebhookSchemaID(id uuid.UUID) webhookschemaOption {
+	return func(m *WebhookSchemaMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TodoSchema
+			value *WebhookSchema
 		)
-		m.oldValue = func(ctx context.Context) (*TodoSchema, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookSchema, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TodoSchema.Get(ctx, id)
+					value, err = m.Client().WebhookSchema.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -359,10 +7031,10 @@ func withTodoSchemaID(id uuid.UUID) todoschemaOption {
 	}
 }
 
-// withTodoSchema sets the old TodoSchema of the mutation.
-func withTodoSchema(node *TodoSchema) todoschemaOption {
-	return func(m *TodoSchemaMutation) {
-		m.oldValue = func(context.Context) (*TodoSchema, error) {
+// withWebhookSchema sets the old WebhookSchema of the mutation.
+func withWebhookSchema(node *WebhookSchema) webhookschemaOption {
+	return func(m *WebhookSchemaMutation) {
+		m.oldValue = func(context.Context) (*WebhookSchema, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -371,7 +7043,7 @@ func withTodoSchema(node *TodoSchema) todoschemaOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TodoSchemaMutation) Client() *Client {
+func (m WebhookSchemaMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -379,7 +7051,7 @@ func (m TodoSchemaMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TodoSchemaMutation) Tx() (*Tx, error) {
+func (m WebhookSchemaMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("entgen: mutation is not running in a transaction")
 	}
@@ -389,14 +7061,14 @@ func (m TodoSchemaMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TodoSchema entities.
-func (m *TodoSchemaMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookSchema entities.
+func (m *WebhookSchemaMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TodoSchemaMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookSchemaMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -407,7 +7079,7 @@ func (m *TodoSchemaMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TodoSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -416,140 +7088,234 @@ func (m *TodoSchemaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TodoSchema.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookSchema.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *TodoSchemaMutation) SetTitle(s string) {
-	m.title = &s
+// SetURL sets the "url" field.
+func (m *WebhookSchemaMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *TodoSchemaMutation) Title() (r string, exists bool) {
-	v := m.title
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookSchemaMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the TodoSchema entity.
-// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the WebhookSchema entity.
+// If the WebhookSchema object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TodoSchemaMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *WebhookSchemaMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.URL, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *TodoSchemaMutation) ResetTitle() {
-	m.title = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookSchemaMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetBody sets the "body" field.
-func (m *TodoSchemaMutation) SetBody(s string) {
-	m.body = &s
+// SetSecret sets the "secret" field.
+func (m *WebhookSchemaMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *TodoSchemaMutation) Body() (r string, exists bool) {
-	v := m.body
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookSchemaMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the TodoSchema entity.
-// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the WebhookSchema entity.
+// If the WebhookSchema object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TodoSchemaMutation) OldBody(ctx context.Context) (v *string, err error) {
+func (m *WebhookSchemaMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.Body, nil
+	return oldValue.Secret, nil
 }
 
-// ClearBody clears the value of the "body" field.
-func (m *TodoSchemaMutation) ClearBody() {
-	m.body = nil
-	m.clearedFields[todoschema.FieldBody] = struct{}{}
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookSchemaMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// BodyCleared returns if the "body" field was cleared in this mutation.
-func (m *TodoSchemaMutation) BodyCleared() bool {
-	_, ok := m.clearedFields[todoschema.FieldBody]
-	return ok
+// SetEvents sets the "events" field.
+func (m *WebhookSchemaMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
 }
 
-// ResetBody resets all changes to the "body" field.
-func (m *TodoSchemaMutation) ResetBody() {
-	m.body = nil
-	delete(m.clearedFields, todoschema.FieldBody)
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookSchemaMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetStatus sets the "status" field.
-func (m *TodoSchemaMutation) SetStatus(t todoschema.Status) {
-	m.status = &t
+// OldEvents returns the old "events" field's value of the WebhookSchema entity.
+// If the WebhookSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSchemaMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TodoSchemaMutation) Status() (r todoschema.Status, exists bool) {
-	v := m.status
+// AppendEvents adds s to the "events" field.
+func (m *WebhookSchemaMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookSchemaMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookSchemaMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *WebhookSchemaMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookSchemaMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the TodoSchema entity.
-// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the WebhookSchema entity.
+// If the WebhookSchema object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TodoSchemaMutation) OldStatus(ctx context.Context) (v todoschema.Status, err error) {
+func (m *WebhookSchemaMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Enabled, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TodoSchemaMutation) ResetStatus() {
-	m.status = nil
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookSchemaMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *WebhookSchemaMutation) SetConsecutiveFailures(i int) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
+}
+
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *WebhookSchemaMutation) ConsecutiveFailures() (r int, exists bool) {
+	v := m.consecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the WebhookSchema entity.
+// If the WebhookSchema object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSchemaMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFailures, nil
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *WebhookSchemaMutation) AddConsecutiveFailures(i int) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
+}
+
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *WebhookSchemaMutation) AddedConsecutiveFailures() (r int, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *WebhookSchemaMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TodoSchemaMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookSchemaMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TodoSchemaMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookSchemaMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -557,10 +7323,10 @@ func (m *TodoSchemaMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TodoSchema entity.
-// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookSchema entity.
+// If the WebhookSchema object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TodoSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -575,17 +7341,17 @@ func (m *TodoSchemaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TodoSchemaMutation) ResetCreatedAt() {
+func (m *WebhookSchemaMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TodoSchemaMutation) SetUpdatedAt(t time.Time) {
+func (m *WebhookSchemaMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TodoSchemaMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebhookSchemaMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -593,10 +7359,10 @@ func (m *TodoSchemaMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the TodoSchema entity.
-// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookSchema entity.
+// If the WebhookSchema object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TodoSchemaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSchemaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -611,117 +7377,73 @@ func (m *TodoSchemaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TodoSchemaMutation) ResetUpdatedAt() {
+func (m *WebhookSchemaMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *TodoSchemaMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
-}
-
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *TodoSchemaMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCompletedAt returns the old "completed_at" field's value of the TodoSchema entity.
-// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TodoSchemaMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDeliverySchema entity by ids.
+func (m *WebhookSchemaMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
 	}
-	return oldValue.CompletedAt, nil
-}
-
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *TodoSchemaMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[todoschema.FieldCompletedAt] = struct{}{}
-}
-
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *TodoSchemaMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[todoschema.FieldCompletedAt]
-	return ok
-}
-
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *TodoSchemaMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, todoschema.FieldCompletedAt)
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *TodoSchemaMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// ClearDeliveries clears the "deliveries" edge to the WebhookDeliverySchema entity.
+func (m *WebhookSchemaMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *TodoSchemaMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDeliverySchema entity was cleared.
+func (m *WebhookSchemaMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the TodoSchema entity.
-// If the TodoSchema object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TodoSchemaMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDeliverySchema entity by IDs.
+func (m *WebhookSchemaMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
-	return oldValue.DeletedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *TodoSchemaMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[todoschema.FieldDeletedAt] = struct{}{}
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDeliverySchema entity.
+func (m *WebhookSchemaMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *TodoSchemaMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[todoschema.FieldDeletedAt]
-	return ok
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookSchemaMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *TodoSchemaMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, todoschema.FieldDeletedAt)
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookSchemaMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the TodoSchemaMutation builder.
-func (m *TodoSchemaMutation) Where(ps ...predicate.TodoSchema) {
+// Where appends a list predicates to the WebhookSchemaMutation builder.
+func (m *WebhookSchemaMutation) Where(ps ...predicate.WebhookSchema) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TodoSchemaMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookSchemaMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TodoSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TodoSchema, len(ps))
+func (m *WebhookSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookSchema, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -729,45 +7451,45 @@ func (m *TodoSchemaMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TodoSchemaMutation) Op() Op {
+func (m *WebhookSchemaMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TodoSchemaMutation) SetOp(op Op) {
+func (m *WebhookSchemaMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TodoSchema).
-func (m *TodoSchemaMutation) Type() string {
+// Type returns the node type of this mutation (WebhookSchema).
+func (m *WebhookSchemaMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TodoSchemaMutation) Fields() []string {
+func (m *WebhookSchemaMutation) Fields() []string {
 	fields := make([]string, 0, 7)
-	if m.title != nil {
-		fields = append(fields, todoschema.FieldTitle)
+	if m.url != nil {
+		fields = append(fields, webhookschema.FieldURL)
 	}
-	if m.body != nil {
-		fields = append(fields, todoschema.FieldBody)
+	if m.secret != nil {
+		fields = append(fields, webhookschema.FieldSecret)
 	}
-	if m.status != nil {
-		fields = append(fields, todoschema.FieldStatus)
+	if m.events != nil {
+		fields = append(fields, webhookschema.FieldEvents)
 	}
-	if m.created_at != nil {
-		fields = append(fields, todoschema.FieldCreatedAt)
+	if m.enabled != nil {
+		fields = append(fields, webhookschema.FieldEnabled)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, todoschema.FieldUpdatedAt)
+	if m.consecutive_failures != nil {
+		fields = append(fields, webhookschema.FieldConsecutiveFailures)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, todoschema.FieldCompletedAt)
+	if m.created_at != nil {
+		fields = append(fields, webhookschema.FieldCreatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, todoschema.FieldDeletedAt)
+	if m.updated_at != nil {
+		fields = append(fields, webhookschema.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -775,22 +7497,22 @@ func (m *TodoSchemaMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TodoSchemaMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookSchemaMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case todoschema.FieldTitle:
-		return m.Title()
-	case todoschema.FieldBody:
-		return m.Body()
-	case todoschema.FieldStatus:
-		return m.Status()
-	case todoschema.FieldCreatedAt:
+	case webhookschema.FieldURL:
+		return m.URL()
+	case webhookschema.FieldSecret:
+		return m.Secret()
+	case webhookschema.FieldEvents:
+		return m.Events()
+	case webhookschema.FieldEnabled:
+		return m.Enabled()
+	case webhookschema.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case webhookschema.FieldCreatedAt:
 		return m.CreatedAt()
-	case todoschema.FieldUpdatedAt:
+	case webhookschema.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case todoschema.FieldCompletedAt:
-		return m.CompletedAt()
-	case todoschema.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -798,219 +7520,249 @@ func (m *TodoSchemaMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TodoSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookSchemaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case todoschema.FieldTitle:
-		return m.OldTitle(ctx)
-	case todoschema.FieldBody:
-		return m.OldBody(ctx)
-	case todoschema.FieldStatus:
-		return m.OldStatus(ctx)
-	case todoschema.FieldCreatedAt:
+	case webhookschema.FieldURL:
+		return m.OldURL(ctx)
+	case webhookschema.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhookschema.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhookschema.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhookschema.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case webhookschema.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case todoschema.FieldUpdatedAt:
+	case webhookschema.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case todoschema.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case todoschema.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TodoSchema field %s", name)
+	return nil, fmt.Errorf("unknown WebhookSchema field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TodoSchemaMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookSchemaMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case todoschema.FieldTitle:
+	case webhookschema.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetURL(v)
 		return nil
-	case todoschema.FieldBody:
+	case webhookschema.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBody(v)
+		m.SetSecret(v)
 		return nil
-	case todoschema.FieldStatus:
-		v, ok := value.(todoschema.Status)
+	case webhookschema.FieldEvents:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetEvents(v)
 		return nil
-	case todoschema.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case webhookschema.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetEnabled(v)
 		return nil
-	case todoschema.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case webhookschema.FieldConsecutiveFailures:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetConsecutiveFailures(v)
 		return nil
-	case todoschema.FieldCompletedAt:
+	case webhookschema.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case todoschema.FieldDeletedAt:
+	case webhookschema.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TodoSchema field %s", name)
+	return fmt.Errorf("unknown WebhookSchema field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TodoSchemaMutation) AddedFields() []string {
-	return nil
+func (m *WebhookSchemaMutation) AddedFields() []string {
+	var fields []string
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, webhookschema.FieldConsecutiveFailures)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TodoSchemaMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookSchemaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookschema.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TodoSchemaMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookSchemaMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookschema.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
 	}
-	return fmt.Errorf("unknown TodoSchema numeric field %s", name)
+	return fmt.Errorf("unknown WebhookSchema numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TodoSchemaMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(todoschema.FieldBody) {
-		fields = append(fields, todoschema.FieldBody)
-	}
-	if m.FieldCleared(todoschema.FieldCompletedAt) {
-		fields = append(fields, todoschema.FieldCompletedAt)
-	}
-	if m.FieldCleared(todoschema.FieldDeletedAt) {
-		fields = append(fields, todoschema.FieldDeletedAt)
-	}
-	return fields
+func (m *WebhookSchemaMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TodoSchemaMutation) FieldCleared(name string) bool {
+func (m *WebhookSchemaMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TodoSchemaMutation) ClearField(name string) error {
-	switch name {
-	case todoschema.FieldBody:
-		m.ClearBody()
-		return nil
-	case todoschema.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	case todoschema.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown TodoSchema nullable field %s", name)
+func (m *WebhookSchemaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WebhookSchema nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TodoSchemaMutation) ResetField(name string) error {
+func (m *WebhookSchemaMutation) ResetField(name string) error {
 	switch name {
-	case todoschema.FieldTitle:
-		m.ResetTitle()
+	case webhookschema.FieldURL:
+		m.ResetURL()
 		return nil
-	case todoschema.FieldBody:
-		m.ResetBody()
+	case webhookschema.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case todoschema.FieldStatus:
-		m.ResetStatus()
+	case webhookschema.FieldEvents:
+		m.ResetEvents()
 		return nil
-	case todoschema.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case webhookschema.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case todoschema.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case webhookschema.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
 		return nil
-	case todoschema.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case webhookschema.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case todoschema.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case webhookschema.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TodoSchema field %s", name)
+	return fmt.Errorf("unknown WebhookSchema field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TodoSchemaMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookSchemaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deliveries != nil {
+		edges = append(edges, webhookschema.EdgeDeliveries)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TodoSchemaMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookSchemaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhookschema.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TodoSchemaMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookSchemaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhookschema.EdgeDeliveries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TodoSchemaMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookSchemaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhookschema.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TodoSchemaMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookSchemaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeliveries {
+		edges = append(edges, webhookschema.EdgeDeliveries)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TodoSchemaMutation) EdgeCleared(name string) bool {
+func (m *WebhookSchemaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhookschema.EdgeDeliveries:
+		return m.cleareddeliveries
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TodoSchemaMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TodoSchema unique edge %s", name)
+func (m *WebhookSchemaMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WebhookSchema unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TodoSchemaMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TodoSchema edge %s", name)
+func (m *WebhookSchemaMutation) ResetEdge(name string) error {
+	switch name {
+	case webhookschema.EdgeDeliveries:
+		m.ResetDeliveries()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookSchema edge %s", name)
 }
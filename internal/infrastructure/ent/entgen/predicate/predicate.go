@@ -6,8 +6,32 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AttachmentSchema is the predicate function for attachmentschema builders.
+type AttachmentSchema func(*sql.Selector)
+
+// CommentSchema is the predicate function for commentschema builders.
+type CommentSchema func(*sql.Selector)
+
+// ExternalRefSchema is the predicate function for externalrefschema builders.
+type ExternalRefSchema func(*sql.Selector)
+
 // ProjectSchema is the predicate function for projectschema builders.
 type ProjectSchema func(*sql.Selector)
 
+// TagSchema is the predicate function for tagschema builders.
+type TagSchema func(*sql.Selector)
+
+// TodoHistorySchema is the predicate function for todohistoryschema builders.
+type TodoHistorySchema func(*sql.Selector)
+
 // TodoSchema is the predicate function for todoschema builders.
 type TodoSchema func(*sql.Selector)
+
+// WebhookDeliverySchema is the predicate function for webhookdeliveryschema builders.
+type WebhookDeliverySchema func(*sql.Selector)
+
+// WebhookEventSchema is the predicate function for webhookeventschema builders.
+type WebhookEventSchema func(*sql.Selector)
+
+// WebhookSchema is the predicate function for webhookschema builders.
+type WebhookSchema func(*sql.Selector)
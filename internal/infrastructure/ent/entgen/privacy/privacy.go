@@ -111,6 +111,78 @@ func DenyMutationOperationRule(op entgen.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The AttachmentSchemaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AttachmentSchemaQueryRuleFunc func(context.Context, *entgen.AttachmentSchemaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AttachmentSchemaQueryRuleFunc) EvalQuery(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.AttachmentSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("entgen/privacy: unexpected query type %T, expect *entgen.AttachmentSchemaQuery", q)
+}
+
+// The AttachmentSchemaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AttachmentSchemaMutationRuleFunc func(context.Context, *entgen.AttachmentSchemaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AttachmentSchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entgen.Mutation) error {
+	if m, ok := m.(*entgen.AttachmentSchemaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.AttachmentSchemaMutation", m)
+}
+
+// The CommentSchemaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CommentSchemaQueryRuleFunc func(context.Context, *entgen.CommentSchemaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CommentSchemaQueryRuleFunc) EvalQuery(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.CommentSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("entgen/privacy: unexpected query type %T, expect *entgen.CommentSchemaQuery", q)
+}
+
+// The CommentSchemaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CommentSchemaMutationRuleFunc func(context.Context, *entgen.CommentSchemaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CommentSchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entgen.Mutation) error {
+	if m, ok := m.(*entgen.CommentSchemaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.CommentSchemaMutation", m)
+}
+
+// The ExternalRefSchemaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ExternalRefSchemaQueryRuleFunc func(context.Context, *entgen.ExternalRefSchemaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ExternalRefSchemaQueryRuleFunc) EvalQuery(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.ExternalRefSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("entgen/privacy: unexpected query type %T, expect *entgen.ExternalRefSchemaQuery", q)
+}
+
+// The ExternalRefSchemaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ExternalRefSchemaMutationRuleFunc func(context.Context, *entgen.ExternalRefSchemaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ExternalRefSchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entgen.Mutation) error {
+	if m, ok := m.(*entgen.ExternalRefSchemaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.ExternalRefSchemaMutation", m)
+}
+
 // The ProjectSchemaQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type ProjectSchemaQueryRuleFunc func(context.Context, *entgen.ProjectSchemaQuery) error
@@ -135,6 +207,54 @@ func (f ProjectSchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entge
 	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.ProjectSchemaMutation", m)
 }
 
+// The TagSchemaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TagSchemaQueryRuleFunc func(context.Context, *entgen.TagSchemaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TagSchemaQueryRuleFunc) EvalQuery(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.TagSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("entgen/privacy: unexpected query type %T, expect *entgen.TagSchemaQuery", q)
+}
+
+// The TagSchemaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TagSchemaMutationRuleFunc func(context.Context, *entgen.TagSchemaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TagSchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entgen.Mutation) error {
+	if m, ok := m.(*entgen.TagSchemaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.TagSchemaMutation", m)
+}
+
+// The TodoHistorySchemaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TodoHistorySchemaQueryRuleFunc func(context.Context, *entgen.TodoHistorySchemaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TodoHistorySchemaQueryRuleFunc) EvalQuery(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.TodoHistorySchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("entgen/privacy: unexpected query type %T, expect *entgen.TodoHistorySchemaQuery", q)
+}
+
+// The TodoHistorySchemaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TodoHistorySchemaMutationRuleFunc func(context.Context, *entgen.TodoHistorySchemaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TodoHistorySchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entgen.Mutation) error {
+	if m, ok := m.(*entgen.TodoHistorySchemaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.TodoHistorySchemaMutation", m)
+}
+
 // The TodoSchemaQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TodoSchemaQueryRuleFunc func(context.Context, *entgen.TodoSchemaQuery) error
@@ -159,6 +279,78 @@ func (f TodoSchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entgen.M
 	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.TodoSchemaMutation", m)
 }
 
+// The WebhookDeliverySchemaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WebhookDeliverySchemaQueryRuleFunc func(context.Context, *entgen.WebhookDeliverySchemaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WebhookDeliverySchemaQueryRuleFunc) EvalQuery(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.WebhookDeliverySchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("entgen/privacy: unexpected query type %T, expect *entgen.WebhookDeliverySchemaQuery", q)
+}
+
+// The WebhookDeliverySchemaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WebhookDeliverySchemaMutationRuleFunc func(context.Context, *entgen.WebhookDeliverySchemaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WebhookDeliverySchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entgen.Mutation) error {
+	if m, ok := m.(*entgen.WebhookDeliverySchemaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.WebhookDeliverySchemaMutation", m)
+}
+
+// The WebhookEventSchemaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WebhookEventSchemaQueryRuleFunc func(context.Context, *entgen.WebhookEventSchemaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WebhookEventSchemaQueryRuleFunc) EvalQuery(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.WebhookEventSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("entgen/privacy: unexpected query type %T, expect *entgen.WebhookEventSchemaQuery", q)
+}
+
+// The WebhookEventSchemaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WebhookEventSchemaMutationRuleFunc func(context.Context, *entgen.WebhookEventSchemaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WebhookEventSchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entgen.Mutation) error {
+	if m, ok := m.(*entgen.WebhookEventSchemaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.WebhookEventSchemaMutation", m)
+}
+
+// The WebhookSchemaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WebhookSchemaQueryRuleFunc func(context.Context, *entgen.WebhookSchemaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WebhookSchemaQueryRuleFunc) EvalQuery(ctx context.Context, q entgen.Query) error {
+	if q, ok := q.(*entgen.WebhookSchemaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("entgen/privacy: unexpected query type %T, expect *entgen.WebhookSchemaQuery", q)
+}
+
+// The WebhookSchemaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WebhookSchemaMutationRuleFunc func(context.Context, *entgen.WebhookSchemaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WebhookSchemaMutationRuleFunc) EvalMutation(ctx context.Context, m entgen.Mutation) error {
+	if m, ok := m.(*entgen.WebhookSchemaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("entgen/privacy: unexpected mutation type %T, expect *entgen.WebhookSchemaMutation", m)
+}
+
 type (
 	// Filter is the interface that wraps the Where function
 	// for filtering nodes in queries and mutations.
@@ -194,10 +386,26 @@ var _ QueryMutationRule = FilterFunc(nil)
 
 func queryFilter(q entgen.Query) (Filter, error) {
 	switch q := q.(type) {
+	case *entgen.AttachmentSchemaQuery:
+		return q.Filter(), nil
+	case *entgen.CommentSchemaQuery:
+		return q.Filter(), nil
+	case *entgen.ExternalRefSchemaQuery:
+		return q.Filter(), nil
 	case *entgen.ProjectSchemaQuery:
 		return q.Filter(), nil
+	case *entgen.TagSchemaQuery:
+		return q.Filter(), nil
+	case *entgen.TodoHistorySchemaQuery:
+		return q.Filter(), nil
 	case *entgen.TodoSchemaQuery:
 		return q.Filter(), nil
+	case *entgen.WebhookDeliverySchemaQuery:
+		return q.Filter(), nil
+	case *entgen.WebhookEventSchemaQuery:
+		return q.Filter(), nil
+	case *entgen.WebhookSchemaQuery:
+		return q.Filter(), nil
 	default:
 		return nil, Denyf("entgen/privacy: unexpected query type %T for query filter", q)
 	}
@@ -205,10 +413,26 @@ func queryFilter(q entgen.Query) (Filter, error) {
 
 func mutationFilter(m entgen.Mutation) (Filter, error) {
 	switch m := m.(type) {
+	case *entgen.AttachmentSchemaMutation:
+		return m.Filter(), nil
+	case *entgen.CommentSchemaMutation:
+		return m.Filter(), nil
+	case *entgen.ExternalRefSchemaMutation:
+		return m.Filter(), nil
 	case *entgen.ProjectSchemaMutation:
 		return m.Filter(), nil
+	case *entgen.TagSchemaMutation:
+		return m.Filter(), nil
+	case *entgen.TodoHistorySchemaMutation:
+		return m.Filter(), nil
 	case *entgen.TodoSchemaMutation:
 		return m.Filter(), nil
+	case *entgen.WebhookDeliverySchemaMutation:
+		return m.Filter(), nil
+	case *entgen.WebhookEventSchemaMutation:
+		return m.Filter(), nil
+	case *entgen.WebhookSchemaMutation:
+		return m.Filter(), nil
 	default:
 		return nil, Denyf("entgen/privacy: unexpected mutation type %T for mutation filter", m)
 	}
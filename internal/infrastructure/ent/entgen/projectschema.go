@@ -3,28 +3,70 @@
 package entgen
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
 )
 
 // ProjectSchema is the model entity for the ProjectSchema schema.
 type ProjectSchema struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
-	ID           uuid.UUID `json:"id,omitempty"`
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Workflow holds the value of the "workflow" field.
+	Workflow schema.WorkflowDefinition `json:"workflow,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectSchemaQuery when eager-loading is set.
+	Edges        ProjectSchemaEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// ProjectSchemaEdges holds the relations/edges for other nodes in the graph.
+type ProjectSchemaEdges struct {
+	// Todos holds the value of the todos edge.
+	Todos []*TodoSchema `json:"todos,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedTodos map[string][]*TodoSchema
+}
+
+// TodosOrErr returns the Todos value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectSchemaEdges) TodosOrErr() ([]*TodoSchema, error) {
+	if e.loadedTypes[0] {
+		return e.Todos, nil
+	}
+	return nil, &NotLoadedError{edge: "todos"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ProjectSchema) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case projectschema.FieldWorkflow:
+			values[i] = new([]byte)
+		case projectschema.FieldName:
+			values[i] = new(sql.NullString)
+		case projectschema.FieldCreatedAt, projectschema.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		case projectschema.FieldID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -48,6 +90,32 @@ func (ps *ProjectSchema) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				ps.ID = *value
 			}
+		case projectschema.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ps.Name = value.String
+			}
+		case projectschema.FieldWorkflow:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ps.Workflow); err != nil {
+					return fmt.Errorf("unmarshal field workflow: %w", err)
+				}
+			}
+		case projectschema.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ps.CreatedAt = value.Time
+			}
+		case projectschema.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ps.UpdatedAt = value.Time
+			}
 		default:
 			ps.selectValues.Set(columns[i], values[i])
 		}
@@ -61,6 +129,11 @@ func (ps *ProjectSchema) Value(name string) (ent.Value, error) {
 	return ps.selectValues.Get(name)
 }
 
+// QueryTodos queries the "todos" edge of the ProjectSchema entity.
+func (ps *ProjectSchema) QueryTodos() *TodoSchemaQuery {
+	return NewProjectSchemaClient(ps.config).QueryTodos(ps)
+}
+
 // Update returns a builder for updating this ProjectSchema.
 // Note that you need to call ProjectSchema.Unwrap() before calling this method if this ProjectSchema
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -83,10 +156,45 @@ func (ps *ProjectSchema) Unwrap() *ProjectSchema {
 func (ps *ProjectSchema) String() string {
 	var builder strings.Builder
 	builder.WriteString("ProjectSchema(")
-	builder.WriteString(fmt.Sprintf("id=%v", ps.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", ps.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ps.Name)
+	builder.WriteString(", ")
+	builder.WriteString("workflow=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Workflow))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ps.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ps.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedTodos returns the Todos named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ps *ProjectSchema) NamedTodos(name string) ([]*TodoSchema, error) {
+	if ps.Edges.namedTodos == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ps.Edges.namedTodos[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ps *ProjectSchema) appendNamedTodos(name string, edges ...*TodoSchema) {
+	if ps.Edges.namedTodos == nil {
+		ps.Edges.namedTodos = make(map[string][]*TodoSchema)
+	}
+	if len(edges) == 0 {
+		ps.Edges.namedTodos[name] = []*TodoSchema{}
+	} else {
+		ps.Edges.namedTodos[name] = append(ps.Edges.namedTodos[name], edges...)
+	}
+}
+
 // ProjectSchemas is a parsable slice of ProjectSchema.
 type ProjectSchemas []*ProjectSchema
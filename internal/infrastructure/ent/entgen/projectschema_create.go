@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -13,6 +14,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
 )
 
 // ProjectSchemaCreate is the builder for creating a ProjectSchema entity.
@@ -23,6 +26,46 @@ type ProjectSchemaCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetName sets the "name" field.
+func (psc *ProjectSchemaCreate) SetName(s string) *ProjectSchemaCreate {
+	psc.mutation.SetName(s)
+	return psc
+}
+
+// SetWorkflow sets the "workflow" field.
+func (psc *ProjectSchemaCreate) SetWorkflow(sd schema.WorkflowDefinition) *ProjectSchemaCreate {
+	psc.mutation.SetWorkflow(sd)
+	return psc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (psc *ProjectSchemaCreate) SetCreatedAt(t time.Time) *ProjectSchemaCreate {
+	psc.mutation.SetCreatedAt(t)
+	return psc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (psc *ProjectSchemaCreate) SetNillableCreatedAt(t *time.Time) *ProjectSchemaCreate {
+	if t != nil {
+		psc.SetCreatedAt(*t)
+	}
+	return psc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psc *ProjectSchemaCreate) SetUpdatedAt(t time.Time) *ProjectSchemaCreate {
+	psc.mutation.SetUpdatedAt(t)
+	return psc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (psc *ProjectSchemaCreate) SetNillableUpdatedAt(t *time.Time) *ProjectSchemaCreate {
+	if t != nil {
+		psc.SetUpdatedAt(*t)
+	}
+	return psc
+}
+
 // SetID sets the "id" field.
 func (psc *ProjectSchemaCreate) SetID(u uuid.UUID) *ProjectSchemaCreate {
 	psc.mutation.SetID(u)
@@ -37,6 +80,21 @@ func (psc *ProjectSchemaCreate) SetNillableID(u *uuid.UUID) *ProjectSchemaCreate
 	return psc
 }
 
+// AddTodoIDs adds the "todos" edge to the TodoSchema entity by IDs.
+func (psc *ProjectSchemaCreate) AddTodoIDs(ids ...uuid.UUID) *ProjectSchemaCreate {
+	psc.mutation.AddTodoIDs(ids...)
+	return psc
+}
+
+// AddTodos adds the "todos" edges to the TodoSchema entity.
+func (psc *ProjectSchemaCreate) AddTodos(t ...*TodoSchema) *ProjectSchemaCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return psc.AddTodoIDs(ids...)
+}
+
 // Mutation returns the ProjectSchemaMutation object of the builder.
 func (psc *ProjectSchemaCreate) Mutation() *ProjectSchemaMutation {
 	return psc.mutation
@@ -72,6 +130,14 @@ func (psc *ProjectSchemaCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (psc *ProjectSchemaCreate) defaults() {
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		v := projectschema.DefaultCreatedAt()
+		psc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := psc.mutation.UpdatedAt(); !ok {
+		v := projectschema.DefaultUpdatedAt()
+		psc.mutation.SetUpdatedAt(v)
+	}
 	if _, ok := psc.mutation.ID(); !ok {
 		v := projectschema.DefaultID()
 		psc.mutation.SetID(v)
@@ -80,6 +146,23 @@ func (psc *ProjectSchemaCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (psc *ProjectSchemaCreate) check() error {
+	if _, ok := psc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entgen: missing required field "ProjectSchema.name"`)}
+	}
+	if v, ok := psc.mutation.Name(); ok {
+		if err := projectschema.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "ProjectSchema.name": %w`, err)}
+		}
+	}
+	if _, ok := psc.mutation.Workflow(); !ok {
+		return &ValidationError{Name: "workflow", err: errors.New(`entgen: missing required field "ProjectSchema.workflow"`)}
+	}
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entgen: missing required field "ProjectSchema.created_at"`)}
+	}
+	if _, ok := psc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entgen: missing required field "ProjectSchema.updated_at"`)}
+	}
 	return nil
 }
 
@@ -117,6 +200,39 @@ func (psc *ProjectSchemaCreate) createSpec() (*ProjectSchema, *sqlgraph.CreateSp
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := psc.mutation.Name(); ok {
+		_spec.SetField(projectschema.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := psc.mutation.Workflow(); ok {
+		_spec.SetField(projectschema.FieldWorkflow, field.TypeJSON, value)
+		_node.Workflow = value
+	}
+	if value, ok := psc.mutation.CreatedAt(); ok {
+		_spec.SetField(projectschema.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := psc.mutation.UpdatedAt(); ok {
+		_spec.SetField(projectschema.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := psc.mutation.TodosIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectschema.TodosTable,
+			Columns: []string{projectschema.TodosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = psc.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -124,11 +240,17 @@ func (psc *ProjectSchemaCreate) createSpec() (*ProjectSchema, *sqlgraph.CreateSp
 // of the `INSERT` statement. For example:
 //
 //	client.ProjectSchema.Create().
+//		SetName(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
 //			sql.ResolveWithNewValues(),
 //		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProjectSchemaUpsert) {
+//			SetName(v+v).
+//		}).
 //		Exec(ctx)
 func (psc *ProjectSchemaCreate) OnConflict(opts ...sql.ConflictOption) *ProjectSchemaUpsertOne {
 	psc.conflict = opts
@@ -163,6 +285,54 @@ type (
 	}
 )
 
+// SetName sets the "name" field.
+func (u *ProjectSchemaUpsert) SetName(v string) *ProjectSchemaUpsert {
+	u.Set(projectschema.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProjectSchemaUpsert) UpdateName() *ProjectSchemaUpsert {
+	u.SetExcluded(projectschema.FieldName)
+	return u
+}
+
+// SetWorkflow sets the "workflow" field.
+func (u *ProjectSchemaUpsert) SetWorkflow(v schema.WorkflowDefinition) *ProjectSchemaUpsert {
+	u.Set(projectschema.FieldWorkflow, v)
+	return u
+}
+
+// UpdateWorkflow sets the "workflow" field to the value that was provided on create.
+func (u *ProjectSchemaUpsert) UpdateWorkflow() *ProjectSchemaUpsert {
+	u.SetExcluded(projectschema.FieldWorkflow)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ProjectSchemaUpsert) SetCreatedAt(v time.Time) *ProjectSchemaUpsert {
+	u.Set(projectschema.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ProjectSchemaUpsert) UpdateCreatedAt() *ProjectSchemaUpsert {
+	u.SetExcluded(projectschema.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProjectSchemaUpsert) SetUpdatedAt(v time.Time) *ProjectSchemaUpsert {
+	u.Set(projectschema.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProjectSchemaUpsert) UpdateUpdatedAt() *ProjectSchemaUpsert {
+	u.SetExcluded(projectschema.FieldUpdatedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -211,6 +381,62 @@ func (u *ProjectSchemaUpsertOne) Update(set func(*ProjectSchemaUpsert)) *Project
 	return u
 }
 
+// SetName sets the "name" field.
+func (u *ProjectSchemaUpsertOne) SetName(v string) *ProjectSchemaUpsertOne {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProjectSchemaUpsertOne) UpdateName() *ProjectSchemaUpsertOne {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetWorkflow sets the "workflow" field.
+func (u *ProjectSchemaUpsertOne) SetWorkflow(v schema.WorkflowDefinition) *ProjectSchemaUpsertOne {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.SetWorkflow(v)
+	})
+}
+
+// UpdateWorkflow sets the "workflow" field to the value that was provided on create.
+func (u *ProjectSchemaUpsertOne) UpdateWorkflow() *ProjectSchemaUpsertOne {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.UpdateWorkflow()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ProjectSchemaUpsertOne) SetCreatedAt(v time.Time) *ProjectSchemaUpsertOne {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ProjectSchemaUpsertOne) UpdateCreatedAt() *ProjectSchemaUpsertOne {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProjectSchemaUpsertOne) SetUpdatedAt(v time.Time) *ProjectSchemaUpsertOne {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProjectSchemaUpsertOne) UpdateUpdatedAt() *ProjectSchemaUpsertOne {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProjectSchemaUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -344,6 +570,11 @@ func (pscb *ProjectSchemaCreateBulk) ExecX(ctx context.Context) {
 //			// the was proposed for insertion.
 //			sql.ResolveWithNewValues(),
 //		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProjectSchemaUpsert) {
+//			SetName(v+v).
+//		}).
 //		Exec(ctx)
 func (pscb *ProjectSchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProjectSchemaUpsertBulk {
 	pscb.conflict = opts
@@ -421,6 +652,62 @@ func (u *ProjectSchemaUpsertBulk) Update(set func(*ProjectSchemaUpsert)) *Projec
 	return u
 }
 
+// SetName sets the "name" field.
+func (u *ProjectSchemaUpsertBulk) SetName(v string) *ProjectSchemaUpsertBulk {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProjectSchemaUpsertBulk) UpdateName() *ProjectSchemaUpsertBulk {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetWorkflow sets the "workflow" field.
+func (u *ProjectSchemaUpsertBulk) SetWorkflow(v schema.WorkflowDefinition) *ProjectSchemaUpsertBulk {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.SetWorkflow(v)
+	})
+}
+
+// UpdateWorkflow sets the "workflow" field to the value that was provided on create.
+func (u *ProjectSchemaUpsertBulk) UpdateWorkflow() *ProjectSchemaUpsertBulk {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.UpdateWorkflow()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ProjectSchemaUpsertBulk) SetCreatedAt(v time.Time) *ProjectSchemaUpsertBulk {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ProjectSchemaUpsertBulk) UpdateCreatedAt() *ProjectSchemaUpsertBulk {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProjectSchemaUpsertBulk) SetUpdatedAt(v time.Time) *ProjectSchemaUpsertBulk {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProjectSchemaUpsertBulk) UpdateUpdatedAt() *ProjectSchemaUpsertBulk {
+	return u.Update(func(s *ProjectSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProjectSchemaUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -4,6 +4,7 @@ package entgen
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -16,16 +17,20 @@ import (
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
 )
 
 // ProjectSchemaQuery is the builder for querying ProjectSchema entities.
 type ProjectSchemaQuery struct {
 	config
-	ctx        *QueryContext
-	order      []projectschema.OrderOption
-	inters     []Interceptor
-	predicates []predicate.ProjectSchema
-	modifiers  []func(*sql.Selector)
+	ctx            *QueryContext
+	order          []projectschema.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.ProjectSchema
+	withTodos      *TodoSchemaQuery
+	loadTotal      []func(context.Context, []*ProjectSchema) error
+	modifiers      []func(*sql.Selector)
+	withNamedTodos map[string]*TodoSchemaQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -62,6 +67,31 @@ func (psq *ProjectSchemaQuery) Order(o ...projectschema.OrderOption) *ProjectSch
 	return psq
 }
 
+// QueryTodos chains the current query on the "todos" edge.
+func (psq *ProjectSchemaQuery) QueryTodos() *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: psq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := psq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := psq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(projectschema.Table, projectschema.FieldID, selector),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, projectschema.TodosTable, projectschema.TodosColumn),
+		)
+		schemaConfig := psq.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		fromU = sqlgraph.SetNeighbors(psq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ProjectSchema entity from the query.
 // Returns a *NotFoundError when no ProjectSchema was found.
 func (psq *ProjectSchemaQuery) First(ctx context.Context) (*ProjectSchema, error) {
@@ -254,6 +284,7 @@ func (psq *ProjectSchemaQuery) Clone() *ProjectSchemaQuery {
 		order:      append([]projectschema.OrderOption{}, psq.order...),
 		inters:     append([]Interceptor{}, psq.inters...),
 		predicates: append([]predicate.ProjectSchema{}, psq.predicates...),
+		withTodos:  psq.withTodos.Clone(),
 		// clone intermediate query.
 		sql:       psq.sql.Clone(),
 		path:      psq.path,
@@ -261,8 +292,31 @@ func (psq *ProjectSchemaQuery) Clone() *ProjectSchemaQuery {
 	}
 }
 
+// WithTodos tells the query-builder to eager-load the nodes that are connected to
+// the "todos" edge. The optional arguments are used to configure the query builder of the edge.
+func (psq *ProjectSchemaQuery) WithTodos(opts ...func(*TodoSchemaQuery)) *ProjectSchemaQuery {
+	query := (&TodoSchemaClient{config: psq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	psq.withTodos = query
+	return psq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProjectSchema.Query().
+//		GroupBy(projectschema.FieldName).
+//		Aggregate(entgen.Count()).
+//		Scan(ctx, &v)
 func (psq *ProjectSchemaQuery) GroupBy(field string, fields ...string) *ProjectSchemaGroupBy {
 	psq.ctx.Fields = append([]string{field}, fields...)
 	grbuild := &ProjectSchemaGroupBy{build: psq}
@@ -274,6 +328,16 @@ func (psq *ProjectSchemaQuery) GroupBy(field string, fields ...string) *ProjectS
 
 // Select allows the selection one or more fields/columns for the given query,
 // instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.ProjectSchema.Query().
+//		Select(projectschema.FieldName).
+//		Scan(ctx, &v)
 func (psq *ProjectSchemaQuery) Select(fields ...string) *ProjectSchemaSelect {
 	psq.ctx.Fields = append(psq.ctx.Fields, fields...)
 	sbuild := &ProjectSchemaSelect{ProjectSchemaQuery: psq}
@@ -315,8 +379,11 @@ func (psq *ProjectSchemaQuery) prepareQuery(ctx context.Context) error {
 
 func (psq *ProjectSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProjectSchema, error) {
 	var (
-		nodes = []*ProjectSchema{}
-		_spec = psq.querySpec()
+		nodes       = []*ProjectSchema{}
+		_spec       = psq.querySpec()
+		loadedTypes = [1]bool{
+			psq.withTodos != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*ProjectSchema).scanValues(nil, columns)
@@ -324,6 +391,7 @@ func (psq *ProjectSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &ProjectSchema{config: psq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	_spec.Node.Schema = psq.schemaConfig.ProjectSchema
@@ -340,9 +408,72 @@ func (psq *ProjectSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := psq.withTodos; query != nil {
+		if err := psq.loadTodos(ctx, query, nodes,
+			func(n *ProjectSchema) { n.Edges.Todos = []*TodoSchema{} },
+			func(n *ProjectSchema, e *TodoSchema) {
+				n.Edges.Todos = append(n.Edges.Todos, e)
+				if !e.Edges.loadedTypes[0] {
+					e.Edges.Project = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range psq.withNamedTodos {
+		if err := psq.loadTodos(ctx, query, nodes,
+			func(n *ProjectSchema) { n.appendNamedTodos(name) },
+			func(n *ProjectSchema, e *TodoSchema) {
+				n.appendNamedTodos(name, e)
+				if !e.Edges.loadedTypes[0] {
+					e.Edges.Project = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for i := range psq.loadTotal {
+		if err := psq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (psq *ProjectSchemaQuery) loadTodos(ctx context.Context, query *TodoSchemaQuery, nodes []*ProjectSchema, init func(*ProjectSchema), assign func(*ProjectSchema, *TodoSchema)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ProjectSchema)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(todoschema.FieldProjectID)
+	}
+	query.Where(predicate.TodoSchema(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(projectschema.TodosColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "project_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (psq *ProjectSchemaQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := psq.querySpec()
 	_spec.Node.Schema = psq.schemaConfig.ProjectSchema
@@ -467,6 +598,20 @@ func (psq *ProjectSchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *Proje
 	return psq.Select()
 }
 
+// WithNamedTodos tells the query-builder to eager-load the nodes that are connected to the "todos"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (psq *ProjectSchemaQuery) WithNamedTodos(name string, opts ...func(*TodoSchemaQuery)) *ProjectSchemaQuery {
+	query := (&TodoSchemaClient{config: psq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if psq.withNamedTodos == nil {
+		psq.withNamedTodos = make(map[string]*TodoSchemaQuery)
+	}
+	psq.withNamedTodos[name] = query
+	return psq
+}
+
 // ProjectSchemaGroupBy is the group-by builder for ProjectSchema entities.
 type ProjectSchemaGroupBy struct {
 	selector
@@ -6,13 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
 )
 
 // ProjectSchemaUpdate is the builder for updating ProjectSchema entities.
@@ -29,13 +33,98 @@ func (psu *ProjectSchemaUpdate) Where(ps ...predicate.ProjectSchema) *ProjectSch
 	return psu
 }
 
+// SetName sets the "name" field.
+func (psu *ProjectSchemaUpdate) SetName(s string) *ProjectSchemaUpdate {
+	psu.mutation.SetName(s)
+	return psu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (psu *ProjectSchemaUpdate) SetNillableName(s *string) *ProjectSchemaUpdate {
+	if s != nil {
+		psu.SetName(*s)
+	}
+	return psu
+}
+
+// SetWorkflow sets the "workflow" field.
+func (psu *ProjectSchemaUpdate) SetWorkflow(sd schema.WorkflowDefinition) *ProjectSchemaUpdate {
+	psu.mutation.SetWorkflow(sd)
+	return psu
+}
+
+// SetNillableWorkflow sets the "workflow" field if the given value is not nil.
+func (psu *ProjectSchemaUpdate) SetNillableWorkflow(sd *schema.WorkflowDefinition) *ProjectSchemaUpdate {
+	if sd != nil {
+		psu.SetWorkflow(*sd)
+	}
+	return psu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (psu *ProjectSchemaUpdate) SetCreatedAt(t time.Time) *ProjectSchemaUpdate {
+	psu.mutation.SetCreatedAt(t)
+	return psu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (psu *ProjectSchemaUpdate) SetNillableCreatedAt(t *time.Time) *ProjectSchemaUpdate {
+	if t != nil {
+		psu.SetCreatedAt(*t)
+	}
+	return psu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psu *ProjectSchemaUpdate) SetUpdatedAt(t time.Time) *ProjectSchemaUpdate {
+	psu.mutation.SetUpdatedAt(t)
+	return psu
+}
+
+// AddTodoIDs adds the "todos" edge to the TodoSchema entity by IDs.
+func (psu *ProjectSchemaUpdate) AddTodoIDs(ids ...uuid.UUID) *ProjectSchemaUpdate {
+	psu.mutation.AddTodoIDs(ids...)
+	return psu
+}
+
+// AddTodos adds the "todos" edges to the TodoSchema entity.
+func (psu *ProjectSchemaUpdate) AddTodos(t ...*TodoSchema) *ProjectSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return psu.AddTodoIDs(ids...)
+}
+
 // Mutation returns the ProjectSchemaMutation object of the builder.
 func (psu *ProjectSchemaUpdate) Mutation() *ProjectSchemaMutation {
 	return psu.mutation
 }
 
+// ClearTodos clears all "todos" edges to the TodoSchema entity.
+func (psu *ProjectSchemaUpdate) ClearTodos() *ProjectSchemaUpdate {
+	psu.mutation.ClearTodos()
+	return psu
+}
+
+// RemoveTodoIDs removes the "todos" edge to TodoSchema entities by IDs.
+func (psu *ProjectSchemaUpdate) RemoveTodoIDs(ids ...uuid.UUID) *ProjectSchemaUpdate {
+	psu.mutation.RemoveTodoIDs(ids...)
+	return psu
+}
+
+// RemoveTodos removes "todos" edges to TodoSchema entities.
+func (psu *ProjectSchemaUpdate) RemoveTodos(t ...*TodoSchema) *ProjectSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return psu.RemoveTodoIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (psu *ProjectSchemaUpdate) Save(ctx context.Context) (int, error) {
+	psu.defaults()
 	return withHooks(ctx, psu.sqlSave, psu.mutation, psu.hooks)
 }
 
@@ -61,6 +150,24 @@ func (psu *ProjectSchemaUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (psu *ProjectSchemaUpdate) defaults() {
+	if _, ok := psu.mutation.UpdatedAt(); !ok {
+		v := projectschema.UpdateDefaultUpdatedAt()
+		psu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psu *ProjectSchemaUpdate) check() error {
+	if v, ok := psu.mutation.Name(); ok {
+		if err := projectschema.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "ProjectSchema.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (psu *ProjectSchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProjectSchemaUpdate {
 	psu.modifiers = append(psu.modifiers, modifiers...)
@@ -68,6 +175,9 @@ func (psu *ProjectSchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder))
 }
 
 func (psu *ProjectSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := psu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(projectschema.Table, projectschema.Columns, sqlgraph.NewFieldSpec(projectschema.FieldID, field.TypeUUID))
 	if ps := psu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -76,6 +186,66 @@ func (psu *ProjectSchemaUpdate) sqlSave(ctx context.Context) (n int, err error)
 			}
 		}
 	}
+	if value, ok := psu.mutation.Name(); ok {
+		_spec.SetField(projectschema.FieldName, field.TypeString, value)
+	}
+	if value, ok := psu.mutation.Workflow(); ok {
+		_spec.SetField(projectschema.FieldWorkflow, field.TypeJSON, value)
+	}
+	if value, ok := psu.mutation.CreatedAt(); ok {
+		_spec.SetField(projectschema.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := psu.mutation.UpdatedAt(); ok {
+		_spec.SetField(projectschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if psu.mutation.TodosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectschema.TodosTable,
+			Columns: []string{projectschema.TodosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = psu.schemaConfig.TodoSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.RemovedTodosIDs(); len(nodes) > 0 && !psu.mutation.TodosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectschema.TodosTable,
+			Columns: []string{projectschema.TodosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = psu.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psu.mutation.TodosIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectschema.TodosTable,
+			Columns: []string{projectschema.TodosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = psu.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = psu.schemaConfig.ProjectSchema
 	ctx = internal.NewSchemaConfigContext(ctx, psu.schemaConfig)
 	_spec.AddModifiers(psu.modifiers...)
@@ -100,11 +270,95 @@ type ProjectSchemaUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
+// SetName sets the "name" field.
+func (psuo *ProjectSchemaUpdateOne) SetName(s string) *ProjectSchemaUpdateOne {
+	psuo.mutation.SetName(s)
+	return psuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (psuo *ProjectSchemaUpdateOne) SetNillableName(s *string) *ProjectSchemaUpdateOne {
+	if s != nil {
+		psuo.SetName(*s)
+	}
+	return psuo
+}
+
+// SetWorkflow sets the "workflow" field.
+func (psuo *ProjectSchemaUpdateOne) SetWorkflow(sd schema.WorkflowDefinition) *ProjectSchemaUpdateOne {
+	psuo.mutation.SetWorkflow(sd)
+	return psuo
+}
+
+// SetNillableWorkflow sets the "workflow" field if the given value is not nil.
+func (psuo *ProjectSchemaUpdateOne) SetNillableWorkflow(sd *schema.WorkflowDefinition) *ProjectSchemaUpdateOne {
+	if sd != nil {
+		psuo.SetWorkflow(*sd)
+	}
+	return psuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (psuo *ProjectSchemaUpdateOne) SetCreatedAt(t time.Time) *ProjectSchemaUpdateOne {
+	psuo.mutation.SetCreatedAt(t)
+	return psuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (psuo *ProjectSchemaUpdateOne) SetNillableCreatedAt(t *time.Time) *ProjectSchemaUpdateOne {
+	if t != nil {
+		psuo.SetCreatedAt(*t)
+	}
+	return psuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psuo *ProjectSchemaUpdateOne) SetUpdatedAt(t time.Time) *ProjectSchemaUpdateOne {
+	psuo.mutation.SetUpdatedAt(t)
+	return psuo
+}
+
+// AddTodoIDs adds the "todos" edge to the TodoSchema entity by IDs.
+func (psuo *ProjectSchemaUpdateOne) AddTodoIDs(ids ...uuid.UUID) *ProjectSchemaUpdateOne {
+	psuo.mutation.AddTodoIDs(ids...)
+	return psuo
+}
+
+// AddTodos adds the "todos" edges to the TodoSchema entity.
+func (psuo *ProjectSchemaUpdateOne) AddTodos(t ...*TodoSchema) *ProjectSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return psuo.AddTodoIDs(ids...)
+}
+
 // Mutation returns the ProjectSchemaMutation object of the builder.
 func (psuo *ProjectSchemaUpdateOne) Mutation() *ProjectSchemaMutation {
 	return psuo.mutation
 }
 
+// ClearTodos clears all "todos" edges to the TodoSchema entity.
+func (psuo *ProjectSchemaUpdateOne) ClearTodos() *ProjectSchemaUpdateOne {
+	psuo.mutation.ClearTodos()
+	return psuo
+}
+
+// RemoveTodoIDs removes the "todos" edge to TodoSchema entities by IDs.
+func (psuo *ProjectSchemaUpdateOne) RemoveTodoIDs(ids ...uuid.UUID) *ProjectSchemaUpdateOne {
+	psuo.mutation.RemoveTodoIDs(ids...)
+	return psuo
+}
+
+// RemoveTodos removes "todos" edges to TodoSchema entities.
+func (psuo *ProjectSchemaUpdateOne) RemoveTodos(t ...*TodoSchema) *ProjectSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return psuo.RemoveTodoIDs(ids...)
+}
+
 // Where appends a list predicates to the ProjectSchemaUpdate builder.
 func (psuo *ProjectSchemaUpdateOne) Where(ps ...predicate.ProjectSchema) *ProjectSchemaUpdateOne {
 	psuo.mutation.Where(ps...)
@@ -120,6 +374,7 @@ func (psuo *ProjectSchemaUpdateOne) Select(field string, fields ...string) *Proj
 
 // Save executes the query and returns the updated ProjectSchema entity.
 func (psuo *ProjectSchemaUpdateOne) Save(ctx context.Context) (*ProjectSchema, error) {
+	psuo.defaults()
 	return withHooks(ctx, psuo.sqlSave, psuo.mutation, psuo.hooks)
 }
 
@@ -145,6 +400,24 @@ func (psuo *ProjectSchemaUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (psuo *ProjectSchemaUpdateOne) defaults() {
+	if _, ok := psuo.mutation.UpdatedAt(); !ok {
+		v := projectschema.UpdateDefaultUpdatedAt()
+		psuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psuo *ProjectSchemaUpdateOne) check() error {
+	if v, ok := psuo.mutation.Name(); ok {
+		if err := projectschema.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "ProjectSchema.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (psuo *ProjectSchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProjectSchemaUpdateOne {
 	psuo.modifiers = append(psuo.modifiers, modifiers...)
@@ -152,6 +425,9 @@ func (psuo *ProjectSchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilde
 }
 
 func (psuo *ProjectSchemaUpdateOne) sqlSave(ctx context.Context) (_node *ProjectSchema, err error) {
+	if err := psuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(projectschema.Table, projectschema.Columns, sqlgraph.NewFieldSpec(projectschema.FieldID, field.TypeUUID))
 	id, ok := psuo.mutation.ID()
 	if !ok {
@@ -177,6 +453,66 @@ func (psuo *ProjectSchemaUpdateOne) sqlSave(ctx context.Context) (_node *Project
 			}
 		}
 	}
+	if value, ok := psuo.mutation.Name(); ok {
+		_spec.SetField(projectschema.FieldName, field.TypeString, value)
+	}
+	if value, ok := psuo.mutation.Workflow(); ok {
+		_spec.SetField(projectschema.FieldWorkflow, field.TypeJSON, value)
+	}
+	if value, ok := psuo.mutation.CreatedAt(); ok {
+		_spec.SetField(projectschema.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := psuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(projectschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if psuo.mutation.TodosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectschema.TodosTable,
+			Columns: []string{projectschema.TodosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = psuo.schemaConfig.TodoSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.RemovedTodosIDs(); len(nodes) > 0 && !psuo.mutation.TodosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectschema.TodosTable,
+			Columns: []string{projectschema.TodosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = psuo.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := psuo.mutation.TodosIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   projectschema.TodosTable,
+			Columns: []string{projectschema.TodosColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = psuo.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = psuo.schemaConfig.ProjectSchema
 	ctx = internal.NewSchemaConfigContext(ctx, psuo.schemaConfig)
 	_spec.AddModifiers(psuo.modifiers...)
@@ -6,8 +6,16 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
 )
 
@@ -15,15 +23,152 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	attachmentschemaMixin := schema.AttachmentSchema{}.Mixin()
+	attachmentschemaMixinFields0 := attachmentschemaMixin[0].Fields()
+	_ = attachmentschemaMixinFields0
+	attachmentschemaFields := schema.AttachmentSchema{}.Fields()
+	_ = attachmentschemaFields
+	// attachmentschemaDescFilename is the schema descriptor for filename field.
+	attachmentschemaDescFilename := attachmentschemaFields[1].Descriptor()
+	// attachmentschema.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	attachmentschema.FilenameValidator = attachmentschemaDescFilename.Validators[0].(func(string) error)
+	// attachmentschemaDescContentType is the schema descriptor for content_type field.
+	attachmentschemaDescContentType := attachmentschemaFields[2].Descriptor()
+	// attachmentschema.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	attachmentschema.ContentTypeValidator = attachmentschemaDescContentType.Validators[0].(func(string) error)
+	// attachmentschemaDescSize is the schema descriptor for size field.
+	attachmentschemaDescSize := attachmentschemaFields[3].Descriptor()
+	// attachmentschema.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachmentschema.SizeValidator = attachmentschemaDescSize.Validators[0].(func(int64) error)
+	// attachmentschemaDescCreatedAt is the schema descriptor for created_at field.
+	attachmentschemaDescCreatedAt := attachmentschemaFields[5].Descriptor()
+	// attachmentschema.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachmentschema.DefaultCreatedAt = attachmentschemaDescCreatedAt.Default.(func() time.Time)
+	// attachmentschemaDescID is the schema descriptor for id field.
+	attachmentschemaDescID := attachmentschemaMixinFields0[0].Descriptor()
+	// attachmentschema.DefaultID holds the default value on creation for the id field.
+	attachmentschema.DefaultID = attachmentschemaDescID.Default.(func() uuid.UUID)
+	commentschemaMixin := schema.CommentSchema{}.Mixin()
+	commentschemaMixinFields0 := commentschemaMixin[0].Fields()
+	_ = commentschemaMixinFields0
+	commentschemaFields := schema.CommentSchema{}.Fields()
+	_ = commentschemaFields
+	// commentschemaDescAuthor is the schema descriptor for author field.
+	commentschemaDescAuthor := commentschemaFields[1].Descriptor()
+	// commentschema.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	commentschema.AuthorValidator = commentschemaDescAuthor.Validators[0].(func(string) error)
+	// commentschemaDescCreatedAt is the schema descriptor for created_at field.
+	commentschemaDescCreatedAt := commentschemaFields[3].Descriptor()
+	// commentschema.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commentschema.DefaultCreatedAt = commentschemaDescCreatedAt.Default.(func() time.Time)
+	// commentschemaDescUpdatedAt is the schema descriptor for updated_at field.
+	commentschemaDescUpdatedAt := commentschemaFields[4].Descriptor()
+	// commentschema.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	commentschema.DefaultUpdatedAt = commentschemaDescUpdatedAt.Default.(func() time.Time)
+	// commentschema.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	commentschema.UpdateDefaultUpdatedAt = commentschemaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commentschemaDescID is the schema descriptor for id field.
+	commentschemaDescID := commentschemaMixinFields0[0].Descriptor()
+	// commentschema.DefaultID holds the default value on creation for the id field.
+	commentschema.DefaultID = commentschemaDescID.Default.(func() uuid.UUID)
+	externalrefschemaMixin := schema.ExternalRefSchema{}.Mixin()
+	externalrefschemaMixinFields0 := externalrefschemaMixin[0].Fields()
+	_ = externalrefschemaMixinFields0
+	externalrefschemaFields := schema.ExternalRefSchema{}.Fields()
+	_ = externalrefschemaFields
+	// externalrefschemaDescExternalID is the schema descriptor for external_id field.
+	externalrefschemaDescExternalID := externalrefschemaFields[2].Descriptor()
+	// externalrefschema.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	externalrefschema.ExternalIDValidator = func() func(string) error {
+		validators := externalrefschemaDescExternalID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(external_id string) error {
+			for _, fn := range fns {
+				if err := fn(external_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// externalrefschemaDescCreatedAt is the schema descriptor for created_at field.
+	externalrefschemaDescCreatedAt := externalrefschemaFields[4].Descriptor()
+	// externalrefschema.DefaultCreatedAt holds the default value on creation for the created_at field.
+	externalrefschema.DefaultCreatedAt = externalrefschemaDescCreatedAt.Default.(func() time.Time)
+	// externalrefschemaDescID is the schema descriptor for id field.
+	externalrefschemaDescID := externalrefschemaMixinFields0[0].Descriptor()
+	// externalrefschema.DefaultID holds the default value on creation for the id field.
+	externalrefschema.DefaultID = externalrefschemaDescID.Default.(func() uuid.UUID)
 	projectschemaMixin := schema.ProjectSchema{}.Mixin()
 	projectschemaMixinFields0 := projectschemaMixin[0].Fields()
 	_ = projectschemaMixinFields0
 	projectschemaFields := schema.ProjectSchema{}.Fields()
 	_ = projectschemaFields
+	// projectschemaDescName is the schema descriptor for name field.
+	projectschemaDescName := projectschemaFields[0].Descriptor()
+	// projectschema.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	projectschema.NameValidator = projectschemaDescName.Validators[0].(func(string) error)
+	// projectschemaDescCreatedAt is the schema descriptor for created_at field.
+	projectschemaDescCreatedAt := projectschemaFields[2].Descriptor()
+	// projectschema.DefaultCreatedAt holds the default value on creation for the created_at field.
+	projectschema.DefaultCreatedAt = projectschemaDescCreatedAt.Default.(func() time.Time)
+	// projectschemaDescUpdatedAt is the schema descriptor for updated_at field.
+	projectschemaDescUpdatedAt := projectschemaFields[3].Descriptor()
+	// projectschema.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	projectschema.DefaultUpdatedAt = projectschemaDescUpdatedAt.Default.(func() time.Time)
+	// projectschema.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	projectschema.UpdateDefaultUpdatedAt = projectschemaDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// projectschemaDescID is the schema descriptor for id field.
 	projectschemaDescID := projectschemaMixinFields0[0].Descriptor()
 	// projectschema.DefaultID holds the default value on creation for the id field.
 	projectschema.DefaultID = projectschemaDescID.Default.(func() uuid.UUID)
+	tagschemaMixin := schema.TagSchema{}.Mixin()
+	tagschemaMixinFields0 := tagschemaMixin[0].Fields()
+	_ = tagschemaMixinFields0
+	tagschemaFields := schema.TagSchema{}.Fields()
+	_ = tagschemaFields
+	// tagschemaDescName is the schema descriptor for name field.
+	tagschemaDescName := tagschemaFields[0].Descriptor()
+	// tagschema.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tagschema.NameValidator = tagschemaDescName.Validators[0].(func(string) error)
+	// tagschemaDescCreatedAt is the schema descriptor for created_at field.
+	tagschemaDescCreatedAt := tagschemaFields[2].Descriptor()
+	// tagschema.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tagschema.DefaultCreatedAt = tagschemaDescCreatedAt.Default.(func() time.Time)
+	// tagschemaDescUpdatedAt is the schema descriptor for updated_at field.
+	tagschemaDescUpdatedAt := tagschemaFields[3].Descriptor()
+	// tagschema.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tagschema.DefaultUpdatedAt = tagschemaDescUpdatedAt.Default.(func() time.Time)
+	// tagschema.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tagschema.UpdateDefaultUpdatedAt = tagschemaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagschemaDescID is the schema descriptor for id field.
+	tagschemaDescID := tagschemaMixinFields0[0].Descriptor()
+	// tagschema.DefaultID holds the default value on creation for the id field.
+	tagschema.DefaultID = tagschemaDescID.Default.(func() uuid.UUID)
+	todohistoryschemaMixin := schema.TodoHistorySchema{}.Mixin()
+	todohistoryschemaMixinFields0 := todohistoryschemaMixin[0].Fields()
+	_ = todohistoryschemaMixinFields0
+	todohistoryschemaFields := schema.TodoHistorySchema{}.Fields()
+	_ = todohistoryschemaFields
+	// todohistoryschemaDescVersion is the schema descriptor for version field.
+	todohistoryschemaDescVersion := todohistoryschemaFields[1].Descriptor()
+	// todohistoryschema.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	todohistoryschema.VersionValidator = todohistoryschemaDescVersion.Validators[0].(func(int) error)
+	// todohistoryschemaDescActor is the schema descriptor for actor field.
+	todohistoryschemaDescActor := todohistoryschemaFields[3].Descriptor()
+	// todohistoryschema.ActorValidator is a validator for the "actor" field. It is called by the builders before save.
+	todohistoryschema.ActorValidator = todohistoryschemaDescActor.Validators[0].(func(string) error)
+	// todohistoryschemaDescOccurredAt is the schema descriptor for occurred_at field.
+	todohistoryschemaDescOccurredAt := todohistoryschemaFields[6].Descriptor()
+	// todohistoryschema.DefaultOccurredAt holds the default value on creation for the occurred_at field.
+	todohistoryschema.DefaultOccurredAt = todohistoryschemaDescOccurredAt.Default.(func() time.Time)
+	// todohistoryschemaDescID is the schema descriptor for id field.
+	todohistoryschemaDescID := todohistoryschemaMixinFields0[0].Descriptor()
+	// todohistoryschema.DefaultID holds the default value on creation for the id field.
+	todohistoryschema.DefaultID = todohistoryschemaDescID.Default.(func() uuid.UUID)
 	todoschemaMixin := schema.TodoSchema{}.Mixin()
 	todoschemaMixinFields0 := todoschemaMixin[0].Fields()
 	_ = todoschemaMixinFields0
@@ -47,4 +192,103 @@ func init() {
 	todoschemaDescID := todoschemaMixinFields0[0].Descriptor()
 	// todoschema.DefaultID holds the default value on creation for the id field.
 	todoschema.DefaultID = todoschemaDescID.Default.(func() uuid.UUID)
+	webhookdeliveryschemaMixin := schema.WebhookDeliverySchema{}.Mixin()
+	webhookdeliveryschemaMixinFields0 := webhookdeliveryschemaMixin[0].Fields()
+	_ = webhookdeliveryschemaMixinFields0
+	webhookdeliveryschemaFields := schema.WebhookDeliverySchema{}.Fields()
+	_ = webhookdeliveryschemaFields
+	// webhookdeliveryschemaDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryschemaDescEventType := webhookdeliveryschemaFields[2].Descriptor()
+	// webhookdeliveryschema.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdeliveryschema.EventTypeValidator = webhookdeliveryschemaDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryschemaDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryschemaDescAttempts := webhookdeliveryschemaFields[5].Descriptor()
+	// webhookdeliveryschema.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdeliveryschema.AttemptsValidator = webhookdeliveryschemaDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryschemaDescResponseStatus is the schema descriptor for response_status field.
+	webhookdeliveryschemaDescResponseStatus := webhookdeliveryschemaFields[6].Descriptor()
+	// webhookdeliveryschema.ResponseStatusValidator is a validator for the "response_status" field. It is called by the builders before save.
+	webhookdeliveryschema.ResponseStatusValidator = webhookdeliveryschemaDescResponseStatus.Validators[0].(func(int) error)
+	// webhookdeliveryschemaDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryschemaDescCreatedAt := webhookdeliveryschemaFields[9].Descriptor()
+	// webhookdeliveryschema.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdeliveryschema.DefaultCreatedAt = webhookdeliveryschemaDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryschemaDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryschemaDescUpdatedAt := webhookdeliveryschemaFields[10].Descriptor()
+	// webhookdeliveryschema.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdeliveryschema.DefaultUpdatedAt = webhookdeliveryschemaDescUpdatedAt.Default.(func() time.Time)
+	// webhookdeliveryschema.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdeliveryschema.UpdateDefaultUpdatedAt = webhookdeliveryschemaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryschemaDescID is the schema descriptor for id field.
+	webhookdeliveryschemaDescID := webhookdeliveryschemaMixinFields0[0].Descriptor()
+	// webhookdeliveryschema.DefaultID holds the default value on creation for the id field.
+	webhookdeliveryschema.DefaultID = webhookdeliveryschemaDescID.Default.(func() uuid.UUID)
+	webhookeventschemaMixin := schema.WebhookEventSchema{}.Mixin()
+	webhookeventschemaMixinFields0 := webhookeventschemaMixin[0].Fields()
+	_ = webhookeventschemaMixinFields0
+	webhookeventschemaFields := schema.WebhookEventSchema{}.Fields()
+	_ = webhookeventschemaFields
+	// webhookeventschemaDescType is the schema descriptor for type field.
+	webhookeventschemaDescType := webhookeventschemaFields[0].Descriptor()
+	// webhookeventschema.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	webhookeventschema.TypeValidator = webhookeventschemaDescType.Validators[0].(func(string) error)
+	// webhookeventschemaDescOccurredAt is the schema descriptor for occurred_at field.
+	webhookeventschemaDescOccurredAt := webhookeventschemaFields[3].Descriptor()
+	// webhookeventschema.DefaultOccurredAt holds the default value on creation for the occurred_at field.
+	webhookeventschema.DefaultOccurredAt = webhookeventschemaDescOccurredAt.Default.(func() time.Time)
+	// webhookeventschemaDescID is the schema descriptor for id field.
+	webhookeventschemaDescID := webhookeventschemaMixinFields0[0].Descriptor()
+	// webhookeventschema.DefaultID holds the default value on creation for the id field.
+	webhookeventschema.DefaultID = webhookeventschemaDescID.Default.(func() uuid.UUID)
+	webhookschemaMixin := schema.WebhookSchema{}.Mixin()
+	webhookschemaMixinFields0 := webhookschemaMixin[0].Fields()
+	_ = webhookschemaMixinFields0
+	webhookschemaFields := schema.WebhookSchema{}.Fields()
+	_ = webhookschemaFields
+	// webhookschemaDescURL is the schema descriptor for url field.
+	webhookschemaDescURL := webhookschemaFields[0].Descriptor()
+	// webhookschema.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhookschema.URLValidator = func() func(string) error {
+		validators := webhookschemaDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookschemaDescSecret is the schema descriptor for secret field.
+	webhookschemaDescSecret := webhookschemaFields[1].Descriptor()
+	// webhookschema.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhookschema.SecretValidator = webhookschemaDescSecret.Validators[0].(func(string) error)
+	// webhookschemaDescEnabled is the schema descriptor for enabled field.
+	webhookschemaDescEnabled := webhookschemaFields[3].Descriptor()
+	// webhookschema.DefaultEnabled holds the default value on creation for the enabled field.
+	webhookschema.DefaultEnabled = webhookschemaDescEnabled.Default.(bool)
+	// webhookschemaDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	webhookschemaDescConsecutiveFailures := webhookschemaFields[4].Descriptor()
+	// webhookschema.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	webhookschema.DefaultConsecutiveFailures = webhookschemaDescConsecutiveFailures.Default.(int)
+	// webhookschema.ConsecutiveFailuresValidator is a validator for the "consecutive_failures" field. It is called by the builders before save.
+	webhookschema.ConsecutiveFailuresValidator = webhookschemaDescConsecutiveFailures.Validators[0].(func(int) error)
+	// webhookschemaDescCreatedAt is the schema descriptor for created_at field.
+	webhookschemaDescCreatedAt := webhookschemaFields[5].Descriptor()
+	// webhookschema.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookschema.DefaultCreatedAt = webhookschemaDescCreatedAt.Default.(func() time.Time)
+	// webhookschemaDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookschemaDescUpdatedAt := webhookschemaFields[6].Descriptor()
+	// webhookschema.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookschema.DefaultUpdatedAt = webhookschemaDescUpdatedAt.Default.(func() time.Time)
+	// webhookschema.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookschema.UpdateDefaultUpdatedAt = webhookschemaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookschemaDescID is the schema descriptor for id field.
+	webhookschemaDescID := webhookschemaMixinFields0[0].Descriptor()
+	// webhookschema.DefaultID holds the default value on creation for the id field.
+	webhookschema.DefaultID = webhookschemaDescID.Default.(func() uuid.UUID)
 }
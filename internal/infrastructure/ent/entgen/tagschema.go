@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+)
+
+// TagSchema is the model entity for the TagSchema schema.
+type TagSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Color holds the value of the "color" field.
+	Color string `json:"color,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TagSchemaQuery when eager-loading is set.
+	Edges        TagSchemaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TagSchemaEdges holds the relations/edges for other nodes in the graph.
+type TagSchemaEdges struct {
+	// Todos holds the value of the todos edge.
+	Todos []*TodoSchema `json:"todos,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedTodos map[string][]*TodoSchema
+}
+
+// TodosOrErr returns the Todos value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagSchemaEdges) TodosOrErr() ([]*TodoSchema, error) {
+	if e.loadedTypes[0] {
+		return e.Todos, nil
+	}
+	return nil, &NotLoadedError{edge: "todos"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TagSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tagschema.FieldName, tagschema.FieldColor:
+			values[i] = new(sql.NullString)
+		case tagschema.FieldCreatedAt, tagschema.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case tagschema.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TagSchema fields.
+func (ts *TagSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tagschema.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ts.ID = *value
+			}
+		case tagschema.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ts.Name = value.String
+			}
+		case tagschema.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				ts.Color = value.String
+			}
+		case tagschema.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ts.CreatedAt = value.Time
+			}
+		case tagschema.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ts.UpdatedAt = value.Time
+			}
+		default:
+			ts.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TagSchema.
+// This includes values selected through modifiers, order, etc.
+func (ts *TagSchema) Value(name string) (ent.Value, error) {
+	return ts.selectValues.Get(name)
+}
+
+// QueryTodos queries the "todos" edge of the TagSchema entity.
+func (ts *TagSchema) QueryTodos() *TodoSchemaQuery {
+	return NewTagSchemaClient(ts.config).QueryTodos(ts)
+}
+
+// Update returns a builder for updating this TagSchema.
+// Note that you need to call TagSchema.Unwrap() before calling this method if this TagSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ts *TagSchema) Update() *TagSchemaUpdateOne {
+	return NewTagSchemaClient(ts.config).UpdateOne(ts)
+}
+
+// Unwrap unwraps the TagSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ts *TagSchema) Unwrap() *TagSchema {
+	_tx, ok := ts.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: TagSchema is not a transactional entity")
+	}
+	ts.config.driver = _tx.drv
+	return ts
+}
+
+// String implements the fmt.Stringer.
+func (ts *TagSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("TagSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ts.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ts.Name)
+	builder.WriteString(", ")
+	builder.WriteString("color=")
+	builder.WriteString(ts.Color)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ts.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ts.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedTodos returns the Todos named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ts *TagSchema) NamedTodos(name string) ([]*TodoSchema, error) {
+	if ts.Edges.namedTodos == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ts.Edges.namedTodos[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ts *TagSchema) appendNamedTodos(name string, edges ...*TodoSchema) {
+	if ts.Edges.namedTodos == nil {
+		ts.Edges.namedTodos = make(map[string][]*TodoSchema)
+	}
+	if len(edges) == 0 {
+		ts.Edges.namedTodos[name] = []*TodoSchema{}
+	} else {
+		ts.Edges.namedTodos[name] = append(ts.Edges.namedTodos[name], edges...)
+	}
+}
+
+// TagSchemas is a parsable slice of TagSchema.
+type TagSchemas []*TagSchema
@@ -0,0 +1,731 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// TagSchemaCreate is the builder for creating a TagSchema entity.
+type TagSchemaCreate struct {
+	config
+	mutation *TagSchemaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (tsc *TagSchemaCreate) SetName(s string) *TagSchemaCreate {
+	tsc.mutation.SetName(s)
+	return tsc
+}
+
+// SetColor sets the "color" field.
+func (tsc *TagSchemaCreate) SetColor(s string) *TagSchemaCreate {
+	tsc.mutation.SetColor(s)
+	return tsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsc *TagSchemaCreate) SetCreatedAt(t time.Time) *TagSchemaCreate {
+	tsc.mutation.SetCreatedAt(t)
+	return tsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsc *TagSchemaCreate) SetNillableCreatedAt(t *time.Time) *TagSchemaCreate {
+	if t != nil {
+		tsc.SetCreatedAt(*t)
+	}
+	return tsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsc *TagSchemaCreate) SetUpdatedAt(t time.Time) *TagSchemaCreate {
+	tsc.mutation.SetUpdatedAt(t)
+	return tsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tsc *TagSchemaCreate) SetNillableUpdatedAt(t *time.Time) *TagSchemaCreate {
+	if t != nil {
+		tsc.SetUpdatedAt(*t)
+	}
+	return tsc
+}
+
+// SetID sets the "id" field.
+func (tsc *TagSchemaCreate) SetID(u uuid.UUID) *TagSchemaCreate {
+	tsc.mutation.SetID(u)
+	return tsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tsc *TagSchemaCreate) SetNillableID(u *uuid.UUID) *TagSchemaCreate {
+	if u != nil {
+		tsc.SetID(*u)
+	}
+	return tsc
+}
+
+// AddTodoIDs adds the "todos" edge to the TodoSchema entity by IDs.
+func (tsc *TagSchemaCreate) AddTodoIDs(ids ...uuid.UUID) *TagSchemaCreate {
+	tsc.mutation.AddTodoIDs(ids...)
+	return tsc
+}
+
+// AddTodos adds the "todos" edges to the TodoSchema entity.
+func (tsc *TagSchemaCreate) AddTodos(t ...*TodoSchema) *TagSchemaCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsc.AddTodoIDs(ids...)
+}
+
+// Mutation returns the TagSchemaMutation object of the builder.
+func (tsc *TagSchemaCreate) Mutation() *TagSchemaMutation {
+	return tsc.mutation
+}
+
+// Save creates the TagSchema in the database.
+func (tsc *TagSchemaCreate) Save(ctx context.Context) (*TagSchema, error) {
+	tsc.defaults()
+	return withHooks(ctx, tsc.sqlSave, tsc.mutation, tsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsc *TagSchemaCreate) SaveX(ctx context.Context) *TagSchema {
+	v, err := tsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsc *TagSchemaCreate) Exec(ctx context.Context) error {
+	_, err := tsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsc *TagSchemaCreate) ExecX(ctx context.Context) {
+	if err := tsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsc *TagSchemaCreate) defaults() {
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		v := tagschema.DefaultCreatedAt()
+		tsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tsc.mutation.UpdatedAt(); !ok {
+		v := tagschema.DefaultUpdatedAt()
+		tsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tsc.mutation.ID(); !ok {
+		v := tagschema.DefaultID()
+		tsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsc *TagSchemaCreate) check() error {
+	if _, ok := tsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entgen: missing required field "TagSchema.name"`)}
+	}
+	if v, ok := tsc.mutation.Name(); ok {
+		if err := tagschema.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "TagSchema.name": %w`, err)}
+		}
+	}
+	if _, ok := tsc.mutation.Color(); !ok {
+		return &ValidationError{Name: "color", err: errors.New(`entgen: missing required field "TagSchema.color"`)}
+	}
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entgen: missing required field "TagSchema.created_at"`)}
+	}
+	if _, ok := tsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entgen: missing required field "TagSchema.updated_at"`)}
+	}
+	return nil
+}
+
+func (tsc *TagSchemaCreate) sqlSave(ctx context.Context) (*TagSchema, error) {
+	if err := tsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tsc.mutation.id = &_node.ID
+	tsc.mutation.done = true
+	return _node, nil
+}
+
+func (tsc *TagSchemaCreate) createSpec() (*TagSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagSchema{config: tsc.config}
+		_spec = sqlgraph.NewCreateSpec(tagschema.Table, sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = tsc.schemaConfig.TagSchema
+	_spec.OnConflict = tsc.conflict
+	if id, ok := tsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tsc.mutation.Name(); ok {
+		_spec.SetField(tagschema.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tsc.mutation.Color(); ok {
+		_spec.SetField(tagschema.FieldColor, field.TypeString, value)
+		_node.Color = value
+	}
+	if value, ok := tsc.mutation.CreatedAt(); ok {
+		_spec.SetField(tagschema.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(tagschema.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := tsc.mutation.TodosIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tagschema.TodosTable,
+			Columns: tagschema.TodosPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsc.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagSchema.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagSchemaUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (tsc *TagSchemaCreate) OnConflict(opts ...sql.ConflictOption) *TagSchemaUpsertOne {
+	tsc.conflict = opts
+	return &TagSchemaUpsertOne{
+		create: tsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tsc *TagSchemaCreate) OnConflictColumns(columns ...string) *TagSchemaUpsertOne {
+	tsc.conflict = append(tsc.conflict, sql.ConflictColumns(columns...))
+	return &TagSchemaUpsertOne{
+		create: tsc,
+	}
+}
+
+type (
+	// TagSchemaUpsertOne is the builder for "upsert"-ing
+	//  one TagSchema node.
+	TagSchemaUpsertOne struct {
+		create *TagSchemaCreate
+	}
+
+	// TagSchemaUpsert is the "OnConflict" setter.
+	TagSchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TagSchemaUpsert) SetName(v string) *TagSchemaUpsert {
+	u.Set(tagschema.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagSchemaUpsert) UpdateName() *TagSchemaUpsert {
+	u.SetExcluded(tagschema.FieldName)
+	return u
+}
+
+// SetColor sets the "color" field.
+func (u *TagSchemaUpsert) SetColor(v string) *TagSchemaUpsert {
+	u.Set(tagschema.FieldColor, v)
+	return u
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *TagSchemaUpsert) UpdateColor() *TagSchemaUpsert {
+	u.SetExcluded(tagschema.FieldColor)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TagSchemaUpsert) SetCreatedAt(v time.Time) *TagSchemaUpsert {
+	u.Set(tagschema.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TagSchemaUpsert) UpdateCreatedAt() *TagSchemaUpsert {
+	u.SetExcluded(tagschema.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TagSchemaUpsert) SetUpdatedAt(v time.Time) *TagSchemaUpsert {
+	u.Set(tagschema.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TagSchemaUpsert) UpdateUpdatedAt() *TagSchemaUpsert {
+	u.SetExcluded(tagschema.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TagSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tagschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TagSchemaUpsertOne) UpdateNewValues() *TagSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tagschema.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagSchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TagSchemaUpsertOne) Ignore() *TagSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagSchemaUpsertOne) DoNothing() *TagSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagSchemaCreate.OnConflict
+// documentation for more info.
+func (u *TagSchemaUpsertOne) Update(set func(*TagSchemaUpsert)) *TagSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagSchemaUpsertOne) SetName(v string) *TagSchemaUpsertOne {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagSchemaUpsertOne) UpdateName() *TagSchemaUpsertOne {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *TagSchemaUpsertOne) SetColor(v string) *TagSchemaUpsertOne {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *TagSchemaUpsertOne) UpdateColor() *TagSchemaUpsertOne {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TagSchemaUpsertOne) SetCreatedAt(v time.Time) *TagSchemaUpsertOne {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TagSchemaUpsertOne) UpdateCreatedAt() *TagSchemaUpsertOne {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TagSchemaUpsertOne) SetUpdatedAt(v time.Time) *TagSchemaUpsertOne {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TagSchemaUpsertOne) UpdateUpdatedAt() *TagSchemaUpsertOne {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TagSchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for TagSchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagSchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TagSchemaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entgen: TagSchemaUpsertOne.ID is not supported by MySQL driver. Use TagSchemaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TagSchemaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TagSchemaCreateBulk is the builder for creating many TagSchema entities in bulk.
+type TagSchemaCreateBulk struct {
+	config
+	err      error
+	builders []*TagSchemaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TagSchema entities in the database.
+func (tscb *TagSchemaCreateBulk) Save(ctx context.Context) ([]*TagSchema, error) {
+	if tscb.err != nil {
+		return nil, tscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tscb.builders))
+	nodes := make([]*TagSchema, len(tscb.builders))
+	mutators := make([]Mutator, len(tscb.builders))
+	for i := range tscb.builders {
+		func(i int, root context.Context) {
+			builder := tscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tscb *TagSchemaCreateBulk) SaveX(ctx context.Context) []*TagSchema {
+	v, err := tscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tscb *TagSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := tscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscb *TagSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := tscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagSchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagSchemaUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (tscb *TagSchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *TagSchemaUpsertBulk {
+	tscb.conflict = opts
+	return &TagSchemaUpsertBulk{
+		create: tscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tscb *TagSchemaCreateBulk) OnConflictColumns(columns ...string) *TagSchemaUpsertBulk {
+	tscb.conflict = append(tscb.conflict, sql.ConflictColumns(columns...))
+	return &TagSchemaUpsertBulk{
+		create: tscb,
+	}
+}
+
+// TagSchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of TagSchema nodes.
+type TagSchemaUpsertBulk struct {
+	create *TagSchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TagSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tagschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TagSchemaUpsertBulk) UpdateNewValues() *TagSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tagschema.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagSchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TagSchemaUpsertBulk) Ignore() *TagSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagSchemaUpsertBulk) DoNothing() *TagSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagSchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *TagSchemaUpsertBulk) Update(set func(*TagSchemaUpsert)) *TagSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagSchemaUpsertBulk) SetName(v string) *TagSchemaUpsertBulk {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagSchemaUpsertBulk) UpdateName() *TagSchemaUpsertBulk {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetColor sets the "color" field.
+func (u *TagSchemaUpsertBulk) SetColor(v string) *TagSchemaUpsertBulk {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.SetColor(v)
+	})
+}
+
+// UpdateColor sets the "color" field to the value that was provided on create.
+func (u *TagSchemaUpsertBulk) UpdateColor() *TagSchemaUpsertBulk {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.UpdateColor()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TagSchemaUpsertBulk) SetCreatedAt(v time.Time) *TagSchemaUpsertBulk {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TagSchemaUpsertBulk) UpdateCreatedAt() *TagSchemaUpsertBulk {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TagSchemaUpsertBulk) SetUpdatedAt(v time.Time) *TagSchemaUpsertBulk {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TagSchemaUpsertBulk) UpdateUpdatedAt() *TagSchemaUpsertBulk {
+	return u.Update(func(s *TagSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TagSchemaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entgen: OnConflict was set for builder %d. Set it on the TagSchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for TagSchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagSchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,728 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// TagSchemaQuery is the builder for querying TagSchema entities.
+type TagSchemaQuery struct {
+	config
+	ctx            *QueryContext
+	order          []tagschema.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.TagSchema
+	withTodos      *TodoSchemaQuery
+	loadTotal      []func(context.Context, []*TagSchema) error
+	modifiers      []func(*sql.Selector)
+	withNamedTodos map[string]*TodoSchemaQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TagSchemaQuery builder.
+func (tsq *TagSchemaQuery) Where(ps ...predicate.TagSchema) *TagSchemaQuery {
+	tsq.predicates = append(tsq.predicates, ps...)
+	return tsq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsq *TagSchemaQuery) Limit(limit int) *TagSchemaQuery {
+	tsq.ctx.Limit = &limit
+	return tsq
+}
+
+// Offset to start from.
+func (tsq *TagSchemaQuery) Offset(offset int) *TagSchemaQuery {
+	tsq.ctx.Offset = &offset
+	return tsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsq *TagSchemaQuery) Unique(unique bool) *TagSchemaQuery {
+	tsq.ctx.Unique = &unique
+	return tsq
+}
+
+// Order specifies how the records should be ordered.
+func (tsq *TagSchemaQuery) Order(o ...tagschema.OrderOption) *TagSchemaQuery {
+	tsq.order = append(tsq.order, o...)
+	return tsq
+}
+
+// QueryTodos chains the current query on the "todos" edge.
+func (tsq *TagSchemaQuery) QueryTodos() *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagschema.Table, tagschema.FieldID, selector),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tagschema.TodosTable, tagschema.TodosPrimaryKey...),
+		)
+		schemaConfig := tsq.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaTags
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TagSchema entity from the query.
+// Returns a *NotFoundError when no TagSchema was found.
+func (tsq *TagSchemaQuery) First(ctx context.Context) (*TagSchema, error) {
+	nodes, err := tsq.Limit(1).All(setContextOp(ctx, tsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tagschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsq *TagSchemaQuery) FirstX(ctx context.Context) *TagSchema {
+	node, err := tsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TagSchema ID from the query.
+// Returns a *NotFoundError when no TagSchema ID was found.
+func (tsq *TagSchemaQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsq.Limit(1).IDs(setContextOp(ctx, tsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tagschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsq *TagSchemaQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TagSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TagSchema entity is found.
+// Returns a *NotFoundError when no TagSchema entities are found.
+func (tsq *TagSchemaQuery) Only(ctx context.Context) (*TagSchema, error) {
+	nodes, err := tsq.Limit(2).All(setContextOp(ctx, tsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tagschema.Label}
+	default:
+		return nil, &NotSingularError{tagschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsq *TagSchemaQuery) OnlyX(ctx context.Context) *TagSchema {
+	node, err := tsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TagSchema ID in the query.
+// Returns a *NotSingularError when more than one TagSchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsq *TagSchemaQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tsq.Limit(2).IDs(setContextOp(ctx, tsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tagschema.Label}
+	default:
+		err = &NotSingularError{tagschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsq *TagSchemaQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TagSchemas.
+func (tsq *TagSchemaQuery) All(ctx context.Context) ([]*TagSchema, error) {
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryAll)
+	if err := tsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TagSchema, *TagSchemaQuery]()
+	return withInterceptors[[]*TagSchema](ctx, tsq, qr, tsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsq *TagSchemaQuery) AllX(ctx context.Context) []*TagSchema {
+	nodes, err := tsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TagSchema IDs.
+func (tsq *TagSchemaQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tsq.ctx.Unique == nil && tsq.path != nil {
+		tsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryIDs)
+	if err = tsq.Select(tagschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsq *TagSchemaQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsq *TagSchemaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryCount)
+	if err := tsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsq, querierCount[*TagSchemaQuery](), tsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsq *TagSchemaQuery) CountX(ctx context.Context) int {
+	count, err := tsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsq *TagSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryExist)
+	switch _, err := tsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entgen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsq *TagSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TagSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsq *TagSchemaQuery) Clone() *TagSchemaQuery {
+	if tsq == nil {
+		return nil
+	}
+	return &TagSchemaQuery{
+		config:     tsq.config,
+		ctx:        tsq.ctx.Clone(),
+		order:      append([]tagschema.OrderOption{}, tsq.order...),
+		inters:     append([]Interceptor{}, tsq.inters...),
+		predicates: append([]predicate.TagSchema{}, tsq.predicates...),
+		withTodos:  tsq.withTodos.Clone(),
+		// clone intermediate query.
+		sql:       tsq.sql.Clone(),
+		path:      tsq.path,
+		modifiers: append([]func(*sql.Selector){}, tsq.modifiers...),
+	}
+}
+
+// WithTodos tells the query-builder to eager-load the nodes that are connected to
+// the "todos" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TagSchemaQuery) WithTodos(opts ...func(*TodoSchemaQuery)) *TagSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withTodos = query
+	return tsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TagSchema.Query().
+//		GroupBy(tagschema.FieldName).
+//		Aggregate(entgen.Count()).
+//		Scan(ctx, &v)
+func (tsq *TagSchemaQuery) GroupBy(field string, fields ...string) *TagSchemaGroupBy {
+	tsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TagSchemaGroupBy{build: tsq}
+	grbuild.flds = &tsq.ctx.Fields
+	grbuild.label = tagschema.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.TagSchema.Query().
+//		Select(tagschema.FieldName).
+//		Scan(ctx, &v)
+func (tsq *TagSchemaQuery) Select(fields ...string) *TagSchemaSelect {
+	tsq.ctx.Fields = append(tsq.ctx.Fields, fields...)
+	sbuild := &TagSchemaSelect{TagSchemaQuery: tsq}
+	sbuild.label = tagschema.Label
+	sbuild.flds, sbuild.scan = &tsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TagSchemaSelect configured with the given aggregations.
+func (tsq *TagSchemaQuery) Aggregate(fns ...AggregateFunc) *TagSchemaSelect {
+	return tsq.Select().Aggregate(fns...)
+}
+
+func (tsq *TagSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsq.inters {
+		if inter == nil {
+			return fmt.Errorf("entgen: uninitialized interceptor (forgotten import entgen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsq.ctx.Fields {
+		if !tagschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+		}
+	}
+	if tsq.path != nil {
+		prev, err := tsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsq.sql = prev
+	}
+	return nil
+}
+
+func (tsq *TagSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TagSchema, error) {
+	var (
+		nodes       = []*TagSchema{}
+		_spec       = tsq.querySpec()
+		loadedTypes = [1]bool{
+			tsq.withTodos != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TagSchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TagSchema{config: tsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = tsq.schemaConfig.TagSchema
+	ctx = internal.NewSchemaConfigContext(ctx, tsq.schemaConfig)
+	if len(tsq.modifiers) > 0 {
+		_spec.Modifiers = tsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tsq.withTodos; query != nil {
+		if err := tsq.loadTodos(ctx, query, nodes,
+			func(n *TagSchema) { n.Edges.Todos = []*TodoSchema{} },
+			func(n *TagSchema, e *TodoSchema) { n.Edges.Todos = append(n.Edges.Todos, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range tsq.withNamedTodos {
+		if err := tsq.loadTodos(ctx, query, nodes,
+			func(n *TagSchema) { n.appendNamedTodos(name) },
+			func(n *TagSchema, e *TodoSchema) { n.appendNamedTodos(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range tsq.loadTotal {
+		if err := tsq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tsq *TagSchemaQuery) loadTodos(ctx context.Context, query *TodoSchemaQuery, nodes []*TagSchema, init func(*TagSchema), assign func(*TagSchema, *TodoSchema)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*TagSchema)
+	nids := make(map[uuid.UUID]map[*TagSchema]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(tagschema.TodosTable)
+		joinT.Schema(tsq.schemaConfig.TodoSchemaTags)
+		s.Join(joinT).On(s.C(todoschema.FieldID), joinT.C(tagschema.TodosPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(tagschema.TodosPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(tagschema.TodosPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*TagSchema]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*TodoSchema](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "todos" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (tsq *TagSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsq.querySpec()
+	_spec.Node.Schema = tsq.schemaConfig.TagSchema
+	ctx = internal.NewSchemaConfigContext(ctx, tsq.schemaConfig)
+	if len(tsq.modifiers) > 0 {
+		_spec.Modifiers = tsq.modifiers
+	}
+	_spec.Node.Columns = tsq.ctx.Fields
+	if len(tsq.ctx.Fields) > 0 {
+		_spec.Unique = tsq.ctx.Unique != nil && *tsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsq.driver, _spec)
+}
+
+func (tsq *TagSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tagschema.Table, tagschema.Columns, sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID))
+	_spec.From = tsq.sql
+	if unique := tsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagschema.FieldID)
+		for i := range fields {
+			if fields[i] != tagschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsq *TagSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsq.driver.Dialect())
+	t1 := builder.Table(tagschema.Table)
+	columns := tsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tagschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsq.sql != nil {
+		selector = tsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsq.ctx.Unique != nil && *tsq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(tsq.schemaConfig.TagSchema)
+	ctx = internal.NewSchemaConfigContext(ctx, tsq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range tsq.modifiers {
+		m(selector)
+	}
+	for _, p := range tsq.predicates {
+		p(selector)
+	}
+	for _, p := range tsq.order {
+		p(selector)
+	}
+	if offset := tsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tsq *TagSchemaQuery) ForUpdate(opts ...sql.LockOption) *TagSchemaQuery {
+	if tsq.driver.Dialect() == dialect.Postgres {
+		tsq.Unique(false)
+	}
+	tsq.modifiers = append(tsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tsq *TagSchemaQuery) ForShare(opts ...sql.LockOption) *TagSchemaQuery {
+	if tsq.driver.Dialect() == dialect.Postgres {
+		tsq.Unique(false)
+	}
+	tsq.modifiers = append(tsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tsq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tsq *TagSchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *TagSchemaSelect {
+	tsq.modifiers = append(tsq.modifiers, modifiers...)
+	return tsq.Select()
+}
+
+// WithNamedTodos tells the query-builder to eager-load the nodes that are connected to the "todos"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TagSchemaQuery) WithNamedTodos(name string, opts ...func(*TodoSchemaQuery)) *TagSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if tsq.withNamedTodos == nil {
+		tsq.withNamedTodos = make(map[string]*TodoSchemaQuery)
+	}
+	tsq.withNamedTodos[name] = query
+	return tsq
+}
+
+// TagSchemaGroupBy is the group-by builder for TagSchema entities.
+type TagSchemaGroupBy struct {
+	selector
+	build *TagSchemaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tsgb *TagSchemaGroupBy) Aggregate(fns ...AggregateFunc) *TagSchemaGroupBy {
+	tsgb.fns = append(tsgb.fns, fns...)
+	return tsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsgb *TagSchemaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TagSchemaQuery, *TagSchemaGroupBy](ctx, tsgb.build, tsgb, tsgb.build.inters, v)
+}
+
+func (tsgb *TagSchemaGroupBy) sqlScan(ctx context.Context, root *TagSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tsgb.fns))
+	for _, fn := range tsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tsgb.flds)+len(tsgb.fns))
+		for _, f := range *tsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TagSchemaSelect is the builder for selecting fields of TagSchema entities.
+type TagSchemaSelect struct {
+	*TagSchemaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tss *TagSchemaSelect) Aggregate(fns ...AggregateFunc) *TagSchemaSelect {
+	tss.fns = append(tss.fns, fns...)
+	return tss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tss *TagSchemaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tss.ctx, ent.OpQuerySelect)
+	if err := tss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TagSchemaQuery, *TagSchemaSelect](ctx, tss.TagSchemaQuery, tss, tss.inters, v)
+}
+
+func (tss *TagSchemaSelect) sqlScan(ctx context.Context, root *TagSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tss.fns))
+	for _, fn := range tss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tss *TagSchemaSelect) Modify(modifiers ...func(s *sql.Selector)) *TagSchemaSelect {
+	tss.modifiers = append(tss.modifiers, modifiers...)
+	return tss
+}
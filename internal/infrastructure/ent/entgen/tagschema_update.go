@@ -0,0 +1,531 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+)
+
+// TagSchemaUpdate is the builder for updating TagSchema entities.
+type TagSchemaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TagSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TagSchemaUpdate builder.
+func (tsu *TagSchemaUpdate) Where(ps ...predicate.TagSchema) *TagSchemaUpdate {
+	tsu.mutation.Where(ps...)
+	return tsu
+}
+
+// SetName sets the "name" field.
+func (tsu *TagSchemaUpdate) SetName(s string) *TagSchemaUpdate {
+	tsu.mutation.SetName(s)
+	return tsu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tsu *TagSchemaUpdate) SetNillableName(s *string) *TagSchemaUpdate {
+	if s != nil {
+		tsu.SetName(*s)
+	}
+	return tsu
+}
+
+// SetColor sets the "color" field.
+func (tsu *TagSchemaUpdate) SetColor(s string) *TagSchemaUpdate {
+	tsu.mutation.SetColor(s)
+	return tsu
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (tsu *TagSchemaUpdate) SetNillableColor(s *string) *TagSchemaUpdate {
+	if s != nil {
+		tsu.SetColor(*s)
+	}
+	return tsu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsu *TagSchemaUpdate) SetCreatedAt(t time.Time) *TagSchemaUpdate {
+	tsu.mutation.SetCreatedAt(t)
+	return tsu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsu *TagSchemaUpdate) SetNillableCreatedAt(t *time.Time) *TagSchemaUpdate {
+	if t != nil {
+		tsu.SetCreatedAt(*t)
+	}
+	return tsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsu *TagSchemaUpdate) SetUpdatedAt(t time.Time) *TagSchemaUpdate {
+	tsu.mutation.SetUpdatedAt(t)
+	return tsu
+}
+
+// AddTodoIDs adds the "todos" edge to the TodoSchema entity by IDs.
+func (tsu *TagSchemaUpdate) AddTodoIDs(ids ...uuid.UUID) *TagSchemaUpdate {
+	tsu.mutation.AddTodoIDs(ids...)
+	return tsu
+}
+
+// AddTodos adds the "todos" edges to the TodoSchema entity.
+func (tsu *TagSchemaUpdate) AddTodos(t ...*TodoSchema) *TagSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.AddTodoIDs(ids...)
+}
+
+// Mutation returns the TagSchemaMutation object of the builder.
+func (tsu *TagSchemaUpdate) Mutation() *TagSchemaMutation {
+	return tsu.mutation
+}
+
+// ClearTodos clears all "todos" edges to the TodoSchema entity.
+func (tsu *TagSchemaUpdate) ClearTodos() *TagSchemaUpdate {
+	tsu.mutation.ClearTodos()
+	return tsu
+}
+
+// RemoveTodoIDs removes the "todos" edge to TodoSchema entities by IDs.
+func (tsu *TagSchemaUpdate) RemoveTodoIDs(ids ...uuid.UUID) *TagSchemaUpdate {
+	tsu.mutation.RemoveTodoIDs(ids...)
+	return tsu
+}
+
+// RemoveTodos removes "todos" edges to TodoSchema entities.
+func (tsu *TagSchemaUpdate) RemoveTodos(t ...*TodoSchema) *TagSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.RemoveTodoIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsu *TagSchemaUpdate) Save(ctx context.Context) (int, error) {
+	tsu.defaults()
+	return withHooks(ctx, tsu.sqlSave, tsu.mutation, tsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsu *TagSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsu *TagSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := tsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsu *TagSchemaUpdate) ExecX(ctx context.Context) {
+	if err := tsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsu *TagSchemaUpdate) defaults() {
+	if _, ok := tsu.mutation.UpdatedAt(); !ok {
+		v := tagschema.UpdateDefaultUpdatedAt()
+		tsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsu *TagSchemaUpdate) check() error {
+	if v, ok := tsu.mutation.Name(); ok {
+		if err := tagschema.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "TagSchema.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tsu *TagSchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagSchemaUpdate {
+	tsu.modifiers = append(tsu.modifiers, modifiers...)
+	return tsu
+}
+
+func (tsu *TagSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagschema.Table, tagschema.Columns, sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID))
+	if ps := tsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsu.mutation.Name(); ok {
+		_spec.SetField(tagschema.FieldName, field.TypeString, value)
+	}
+	if value, ok := tsu.mutation.Color(); ok {
+		_spec.SetField(tagschema.FieldColor, field.TypeString, value)
+	}
+	if value, ok := tsu.mutation.CreatedAt(); ok {
+		_spec.SetField(tagschema.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(tagschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tsu.mutation.TodosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tagschema.TodosTable,
+			Columns: tagschema.TodosPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaTags
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedTodosIDs(); len(nodes) > 0 && !tsu.mutation.TodosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tagschema.TodosTable,
+			Columns: tagschema.TodosPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.TodosIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tagschema.TodosTable,
+			Columns: tagschema.TodosPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = tsu.schemaConfig.TagSchema
+	ctx = internal.NewSchemaConfigContext(ctx, tsu.schemaConfig)
+	_spec.AddModifiers(tsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsu.mutation.done = true
+	return n, nil
+}
+
+// TagSchemaUpdateOne is the builder for updating a single TagSchema entity.
+type TagSchemaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TagSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (tsuo *TagSchemaUpdateOne) SetName(s string) *TagSchemaUpdateOne {
+	tsuo.mutation.SetName(s)
+	return tsuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tsuo *TagSchemaUpdateOne) SetNillableName(s *string) *TagSchemaUpdateOne {
+	if s != nil {
+		tsuo.SetName(*s)
+	}
+	return tsuo
+}
+
+// SetColor sets the "color" field.
+func (tsuo *TagSchemaUpdateOne) SetColor(s string) *TagSchemaUpdateOne {
+	tsuo.mutation.SetColor(s)
+	return tsuo
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (tsuo *TagSchemaUpdateOne) SetNillableColor(s *string) *TagSchemaUpdateOne {
+	if s != nil {
+		tsuo.SetColor(*s)
+	}
+	return tsuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsuo *TagSchemaUpdateOne) SetCreatedAt(t time.Time) *TagSchemaUpdateOne {
+	tsuo.mutation.SetCreatedAt(t)
+	return tsuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsuo *TagSchemaUpdateOne) SetNillableCreatedAt(t *time.Time) *TagSchemaUpdateOne {
+	if t != nil {
+		tsuo.SetCreatedAt(*t)
+	}
+	return tsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tsuo *TagSchemaUpdateOne) SetUpdatedAt(t time.Time) *TagSchemaUpdateOne {
+	tsuo.mutation.SetUpdatedAt(t)
+	return tsuo
+}
+
+// AddTodoIDs adds the "todos" edge to the TodoSchema entity by IDs.
+func (tsuo *TagSchemaUpdateOne) AddTodoIDs(ids ...uuid.UUID) *TagSchemaUpdateOne {
+	tsuo.mutation.AddTodoIDs(ids...)
+	return tsuo
+}
+
+// AddTodos adds the "todos" edges to the TodoSchema entity.
+func (tsuo *TagSchemaUpdateOne) AddTodos(t ...*TodoSchema) *TagSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.AddTodoIDs(ids...)
+}
+
+// Mutation returns the TagSchemaMutation object of the builder.
+func (tsuo *TagSchemaUpdateOne) Mutation() *TagSchemaMutation {
+	return tsuo.mutation
+}
+
+// ClearTodos clears all "todos" edges to the TodoSchema entity.
+func (tsuo *TagSchemaUpdateOne) ClearTodos() *TagSchemaUpdateOne {
+	tsuo.mutation.ClearTodos()
+	return tsuo
+}
+
+// RemoveTodoIDs removes the "todos" edge to TodoSchema entities by IDs.
+func (tsuo *TagSchemaUpdateOne) RemoveTodoIDs(ids ...uuid.UUID) *TagSchemaUpdateOne {
+	tsuo.mutation.RemoveTodoIDs(ids...)
+	return tsuo
+}
+
+// RemoveTodos removes "todos" edges to TodoSchema entities.
+func (tsuo *TagSchemaUpdateOne) RemoveTodos(t ...*TodoSchema) *TagSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.RemoveTodoIDs(ids...)
+}
+
+// Where appends a list predicates to the TagSchemaUpdate builder.
+func (tsuo *TagSchemaUpdateOne) Where(ps ...predicate.TagSchema) *TagSchemaUpdateOne {
+	tsuo.mutation.Where(ps...)
+	return tsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsuo *TagSchemaUpdateOne) Select(field string, fields ...string) *TagSchemaUpdateOne {
+	tsuo.fields = append([]string{field}, fields...)
+	return tsuo
+}
+
+// Save executes the query and returns the updated TagSchema entity.
+func (tsuo *TagSchemaUpdateOne) Save(ctx context.Context) (*TagSchema, error) {
+	tsuo.defaults()
+	return withHooks(ctx, tsuo.sqlSave, tsuo.mutation, tsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsuo *TagSchemaUpdateOne) SaveX(ctx context.Context) *TagSchema {
+	node, err := tsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsuo *TagSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsuo *TagSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := tsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsuo *TagSchemaUpdateOne) defaults() {
+	if _, ok := tsuo.mutation.UpdatedAt(); !ok {
+		v := tagschema.UpdateDefaultUpdatedAt()
+		tsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsuo *TagSchemaUpdateOne) check() error {
+	if v, ok := tsuo.mutation.Name(); ok {
+		if err := tagschema.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "TagSchema.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tsuo *TagSchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagSchemaUpdateOne {
+	tsuo.modifiers = append(tsuo.modifiers, modifiers...)
+	return tsuo
+}
+
+func (tsuo *TagSchemaUpdateOne) sqlSave(ctx context.Context) (_node *TagSchema, err error) {
+	if err := tsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagschema.Table, tagschema.Columns, sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID))
+	id, ok := tsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "TagSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagschema.FieldID)
+		for _, f := range fields {
+			if !tagschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != tagschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsuo.mutation.Name(); ok {
+		_spec.SetField(tagschema.FieldName, field.TypeString, value)
+	}
+	if value, ok := tsuo.mutation.Color(); ok {
+		_spec.SetField(tagschema.FieldColor, field.TypeString, value)
+	}
+	if value, ok := tsuo.mutation.CreatedAt(); ok {
+		_spec.SetField(tagschema.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(tagschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tsuo.mutation.TodosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tagschema.TodosTable,
+			Columns: tagschema.TodosPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaTags
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedTodosIDs(); len(nodes) > 0 && !tsuo.mutation.TodosCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tagschema.TodosTable,
+			Columns: tagschema.TodosPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.TodosIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tagschema.TodosTable,
+			Columns: tagschema.TodosPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = tsuo.schemaConfig.TagSchema
+	ctx = internal.NewSchemaConfigContext(ctx, tsuo.schemaConfig)
+	_spec.AddModifiers(tsuo.modifiers...)
+	_node = &TagSchema{config: tsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsuo.mutation.done = true
+	return _node, nil
+}
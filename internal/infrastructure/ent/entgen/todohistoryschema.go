@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
+)
+
+// TodoHistorySchema is the model entity for the TodoHistorySchema schema.
+type TodoHistorySchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID uuid.UUID `json:"todo_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID *uuid.UUID `json:"project_id,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation todohistoryschema.Operation `json:"operation,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes []schema.HistoryChange `json:"changes,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt   time.Time `json:"occurred_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TodoHistorySchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case todohistoryschema.FieldProjectID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case todohistoryschema.FieldChanges:
+			values[i] = new([]byte)
+		case todohistoryschema.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case todohistoryschema.FieldActor, todohistoryschema.FieldOperation:
+			values[i] = new(sql.NullString)
+		case todohistoryschema.FieldOccurredAt:
+			values[i] = new(sql.NullTime)
+		case todohistoryschema.FieldID, todohistoryschema.FieldTodoID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TodoHistorySchema fields.
+func (ths *TodoHistorySchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case todohistoryschema.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ths.ID = *value
+			}
+		case todohistoryschema.FieldTodoID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value != nil {
+				ths.TodoID = *value
+			}
+		case todohistoryschema.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				ths.Version = int(value.Int64)
+			}
+		case todohistoryschema.FieldProjectID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				ths.ProjectID = new(uuid.UUID)
+				*ths.ProjectID = *value.S.(*uuid.UUID)
+			}
+		case todohistoryschema.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				ths.Actor = value.String
+			}
+		case todohistoryschema.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				ths.Operation = todohistoryschema.Operation(value.String)
+			}
+		case todohistoryschema.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ths.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case todohistoryschema.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				ths.OccurredAt = value.Time
+			}
+		default:
+			ths.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TodoHistorySchema.
+// This includes values selected through modifiers, order, etc.
+func (ths *TodoHistorySchema) Value(name string) (ent.Value, error) {
+	return ths.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TodoHistorySchema.
+// Note that you need to call TodoHistorySchema.Unwrap() before calling this method if this TodoHistorySchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ths *TodoHistorySchema) Update() *TodoHistorySchemaUpdateOne {
+	return NewTodoHistorySchemaClient(ths.config).UpdateOne(ths)
+}
+
+// Unwrap unwraps the TodoHistorySchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ths *TodoHistorySchema) Unwrap() *TodoHistorySchema {
+	_tx, ok := ths.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: TodoHistorySchema is not a transactional entity")
+	}
+	ths.config.driver = _tx.drv
+	return ths
+}
+
+// String implements the fmt.Stringer.
+func (ths *TodoHistorySchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("TodoHistorySchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ths.ID))
+	builder.WriteString("todo_id=")
+	builder.WriteString(fmt.Sprintf("%v", ths.TodoID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", ths.Version))
+	builder.WriteString(", ")
+	if v := ths.ProjectID; v != nil {
+		builder.WriteString("project_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(ths.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", ths.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", ths.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(ths.OccurredAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TodoHistorySchemas is a parsable slice of TodoHistorySchema.
+type TodoHistorySchemas []*TodoHistorySchema
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package todohistoryschema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLTE(FieldID, id))
+}
+
+// TodoID applies equality check predicate on the "todo_id" field. It's identical to TodoIDEQ.
+func TodoID(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldTodoID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldVersion, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldProjectID, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldActor, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// TodoIDEQ applies the EQ predicate on the "todo_id" field.
+func TodoIDEQ(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldTodoID, v))
+}
+
+// TodoIDNEQ applies the NEQ predicate on the "todo_id" field.
+func TodoIDNEQ(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNEQ(FieldTodoID, v))
+}
+
+// TodoIDIn applies the In predicate on the "todo_id" field.
+func TodoIDIn(vs ...uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldIn(FieldTodoID, vs...))
+}
+
+// TodoIDNotIn applies the NotIn predicate on the "todo_id" field.
+func TodoIDNotIn(vs ...uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNotIn(FieldTodoID, vs...))
+}
+
+// TodoIDGT applies the GT predicate on the "todo_id" field.
+func TodoIDGT(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGT(FieldTodoID, v))
+}
+
+// TodoIDGTE applies the GTE predicate on the "todo_id" field.
+func TodoIDGTE(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGTE(FieldTodoID, v))
+}
+
+// TodoIDLT applies the LT predicate on the "todo_id" field.
+func TodoIDLT(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLT(FieldTodoID, v))
+}
+
+// TodoIDLTE applies the LTE predicate on the "todo_id" field.
+func TodoIDLTE(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLTE(FieldTodoID, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLTE(FieldVersion, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v uuid.UUID) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNotNull(FieldProjectID))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldContainsFold(FieldActor, v))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v Operation) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v Operation) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...Operation) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...Operation) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TodoHistorySchema) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TodoHistorySchema) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TodoHistorySchema) predicate.TodoHistorySchema {
+	return predicate.TodoHistorySchema(sql.NotPredicates(p))
+}
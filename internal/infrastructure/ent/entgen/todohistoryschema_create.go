@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
+)
+
+// TodoHistorySchemaCreate is the builder for creating a TodoHistorySchema entity.
+type TodoHistorySchemaCreate struct {
+	config
+	mutation *TodoHistorySchemaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTodoID sets the "todo_id" field.
+func (thsc *TodoHistorySchemaCreate) SetTodoID(u uuid.UUID) *TodoHistorySchemaCreate {
+	thsc.mutation.SetTodoID(u)
+	return thsc
+}
+
+// SetVersion sets the "version" field.
+func (thsc *TodoHistorySchemaCreate) SetVersion(i int) *TodoHistorySchemaCreate {
+	thsc.mutation.SetVersion(i)
+	return thsc
+}
+
+// SetProjectID sets the "project_id" field.
+func (thsc *TodoHistorySchemaCreate) SetProjectID(u uuid.UUID) *TodoHistorySchemaCreate {
+	thsc.mutation.SetProjectID(u)
+	return thsc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (thsc *TodoHistorySchemaCreate) SetNillableProjectID(u *uuid.UUID) *TodoHistorySchemaCreate {
+	if u != nil {
+		thsc.SetProjectID(*u)
+	}
+	return thsc
+}
+
+// SetActor sets the "actor" field.
+func (thsc *TodoHistorySchemaCreate) SetActor(s string) *TodoHistorySchemaCreate {
+	thsc.mutation.SetActor(s)
+	return thsc
+}
+
+// SetOperation sets the "operation" field.
+func (thsc *TodoHistorySchemaCreate) SetOperation(t todohistoryschema.Operation) *TodoHistorySchemaCreate {
+	thsc.mutation.SetOperation(t)
+	return thsc
+}
+
+// SetChanges sets the "changes" field.
+func (thsc *TodoHistorySchemaCreate) SetChanges(sc []schema.HistoryChange) *TodoHistorySchemaCreate {
+	thsc.mutation.SetChanges(sc)
+	return thsc
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (thsc *TodoHistorySchemaCreate) SetOccurredAt(t time.Time) *TodoHistorySchemaCreate {
+	thsc.mutation.SetOccurredAt(t)
+	return thsc
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (thsc *TodoHistorySchemaCreate) SetNillableOccurredAt(t *time.Time) *TodoHistorySchemaCreate {
+	if t != nil {
+		thsc.SetOccurredAt(*t)
+	}
+	return thsc
+}
+
+// SetID sets the "id" field.
+func (thsc *TodoHistorySchemaCreate) SetID(u uuid.UUID) *TodoHistorySchemaCreate {
+	thsc.mutation.SetID(u)
+	return thsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (thsc *TodoHistorySchemaCreate) SetNillableID(u *uuid.UUID) *TodoHistorySchemaCreate {
+	if u != nil {
+		thsc.SetID(*u)
+	}
+	return thsc
+}
+
+// Mutation returns the TodoHistorySchemaMutation object of the builder.
+func (thsc *TodoHistorySchemaCreate) Mutation() *TodoHistorySchemaMutation {
+	return thsc.mutation
+}
+
+// Save creates the TodoHistorySchema in the database.
+func (thsc *TodoHistorySchemaCreate) Save(ctx context.Context) (*TodoHistorySchema, error) {
+	thsc.defaults()
+	return withHooks(ctx, thsc.sqlSave, thsc.mutation, thsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (thsc *TodoHistorySchemaCreate) SaveX(ctx context.Context) *TodoHistorySchema {
+	v, err := thsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (thsc *TodoHistorySchemaCreate) Exec(ctx context.Context) error {
+	_, err := thsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thsc *TodoHistorySchemaCreate) ExecX(ctx context.Context) {
+	if err := thsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (thsc *TodoHistorySchemaCreate) defaults() {
+	if _, ok := thsc.mutation.OccurredAt(); !ok {
+		v := todohistoryschema.DefaultOccurredAt()
+		thsc.mutation.SetOccurredAt(v)
+	}
+	if _, ok := thsc.mutation.ID(); !ok {
+		v := todohistoryschema.DefaultID()
+		thsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (thsc *TodoHistorySchemaCreate) check() error {
+	if _, ok := thsc.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`entgen: missing required field "TodoHistorySchema.todo_id"`)}
+	}
+	if _, ok := thsc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`entgen: missing required field "TodoHistorySchema.version"`)}
+	}
+	if v, ok := thsc.mutation.Version(); ok {
+		if err := todohistoryschema.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`entgen: validator failed for field "TodoHistorySchema.version": %w`, err)}
+		}
+	}
+	if _, ok := thsc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`entgen: missing required field "TodoHistorySchema.actor"`)}
+	}
+	if v, ok := thsc.mutation.Actor(); ok {
+		if err := todohistoryschema.ActorValidator(v); err != nil {
+			return &ValidationError{Name: "actor", err: fmt.Errorf(`entgen: validator failed for field "TodoHistorySchema.actor": %w`, err)}
+		}
+	}
+	if _, ok := thsc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`entgen: missing required field "TodoHistorySchema.operation"`)}
+	}
+	if v, ok := thsc.mutation.Operation(); ok {
+		if err := todohistoryschema.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`entgen: validator failed for field "TodoHistorySchema.operation": %w`, err)}
+		}
+	}
+	if _, ok := thsc.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`entgen: missing required field "TodoHistorySchema.changes"`)}
+	}
+	if _, ok := thsc.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`entgen: missing required field "TodoHistorySchema.occurred_at"`)}
+	}
+	return nil
+}
+
+func (thsc *TodoHistorySchemaCreate) sqlSave(ctx context.Context) (*TodoHistorySchema, error) {
+	if err := thsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := thsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, thsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	thsc.mutation.id = &_node.ID
+	thsc.mutation.done = true
+	return _node, nil
+}
+
+func (thsc *TodoHistorySchemaCreate) createSpec() (*TodoHistorySchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TodoHistorySchema{config: thsc.config}
+		_spec = sqlgraph.NewCreateSpec(todohistoryschema.Table, sqlgraph.NewFieldSpec(todohistoryschema.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = thsc.schemaConfig.TodoHistorySchema
+	_spec.OnConflict = thsc.conflict
+	if id, ok := thsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := thsc.mutation.TodoID(); ok {
+		_spec.SetField(todohistoryschema.FieldTodoID, field.TypeUUID, value)
+		_node.TodoID = value
+	}
+	if value, ok := thsc.mutation.Version(); ok {
+		_spec.SetField(todohistoryschema.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := thsc.mutation.ProjectID(); ok {
+		_spec.SetField(todohistoryschema.FieldProjectID, field.TypeUUID, value)
+		_node.ProjectID = &value
+	}
+	if value, ok := thsc.mutation.Actor(); ok {
+		_spec.SetField(todohistoryschema.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := thsc.mutation.Operation(); ok {
+		_spec.SetField(todohistoryschema.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := thsc.mutation.Changes(); ok {
+		_spec.SetField(todohistoryschema.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := thsc.mutation.OccurredAt(); ok {
+		_spec.SetField(todohistoryschema.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TodoHistorySchema.Create().
+//		SetTodoID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TodoHistorySchemaUpsert) {
+//			SetTodoID(v+v).
+//		}).
+//		Exec(ctx)
+func (thsc *TodoHistorySchemaCreate) OnConflict(opts ...sql.ConflictOption) *TodoHistorySchemaUpsertOne {
+	thsc.conflict = opts
+	return &TodoHistorySchemaUpsertOne{
+		create: thsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TodoHistorySchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (thsc *TodoHistorySchemaCreate) OnConflictColumns(columns ...string) *TodoHistorySchemaUpsertOne {
+	thsc.conflict = append(thsc.conflict, sql.ConflictColumns(columns...))
+	return &TodoHistorySchemaUpsertOne{
+		create: thsc,
+	}
+}
+
+type (
+	// TodoHistorySchemaUpsertOne is the builder for "upsert"-ing
+	//  one TodoHistorySchema node.
+	TodoHistorySchemaUpsertOne struct {
+		create *TodoHistorySchemaCreate
+	}
+
+	// TodoHistorySchemaUpsert is the "OnConflict" setter.
+	TodoHistorySchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TodoHistorySchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(todohistoryschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TodoHistorySchemaUpsertOne) UpdateNewValues() *TodoHistorySchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(todohistoryschema.FieldID)
+		}
+		if _, exists := u.create.mutation.TodoID(); exists {
+			s.SetIgnore(todohistoryschema.FieldTodoID)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(todohistoryschema.FieldVersion)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(todohistoryschema.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.Actor(); exists {
+			s.SetIgnore(todohistoryschema.FieldActor)
+		}
+		if _, exists := u.create.mutation.Operation(); exists {
+			s.SetIgnore(todohistoryschema.FieldOperation)
+		}
+		if _, exists := u.create.mutation.Changes(); exists {
+			s.SetIgnore(todohistoryschema.FieldChanges)
+		}
+		if _, exists := u.create.mutation.OccurredAt(); exists {
+			s.SetIgnore(todohistoryschema.FieldOccurredAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TodoHistorySchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TodoHistorySchemaUpsertOne) Ignore() *TodoHistorySchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TodoHistorySchemaUpsertOne) DoNothing() *TodoHistorySchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TodoHistorySchemaCreate.OnConflict
+// documentation for more info.
+func (u *TodoHistorySchemaUpsertOne) Update(set func(*TodoHistorySchemaUpsert)) *TodoHistorySchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TodoHistorySchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TodoHistorySchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for TodoHistorySchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TodoHistorySchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TodoHistorySchemaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entgen: TodoHistorySchemaUpsertOne.ID is not supported by MySQL driver. Use TodoHistorySchemaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TodoHistorySchemaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TodoHistorySchemaCreateBulk is the builder for creating many TodoHistorySchema entities in bulk.
+type TodoHistorySchemaCreateBulk struct {
+	config
+	err      error
+	builders []*TodoHistorySchemaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TodoHistorySchema entities in the database.
+func (thscb *TodoHistorySchemaCreateBulk) Save(ctx context.Context) ([]*TodoHistorySchema, error) {
+	if thscb.err != nil {
+		return nil, thscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(thscb.builders))
+	nodes := make([]*TodoHistorySchema, len(thscb.builders))
+	mutators := make([]Mutator, len(thscb.builders))
+	for i := range thscb.builders {
+		func(i int, root context.Context) {
+			builder := thscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TodoHistorySchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, thscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = thscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, thscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, thscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (thscb *TodoHistorySchemaCreateBulk) SaveX(ctx context.Context) []*TodoHistorySchema {
+	v, err := thscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (thscb *TodoHistorySchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := thscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thscb *TodoHistorySchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := thscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TodoHistorySchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TodoHistorySchemaUpsert) {
+//			SetTodoID(v+v).
+//		}).
+//		Exec(ctx)
+func (thscb *TodoHistorySchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *TodoHistorySchemaUpsertBulk {
+	thscb.conflict = opts
+	return &TodoHistorySchemaUpsertBulk{
+		create: thscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TodoHistorySchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (thscb *TodoHistorySchemaCreateBulk) OnConflictColumns(columns ...string) *TodoHistorySchemaUpsertBulk {
+	thscb.conflict = append(thscb.conflict, sql.ConflictColumns(columns...))
+	return &TodoHistorySchemaUpsertBulk{
+		create: thscb,
+	}
+}
+
+// TodoHistorySchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of TodoHistorySchema nodes.
+type TodoHistorySchemaUpsertBulk struct {
+	create *TodoHistorySchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TodoHistorySchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(todohistoryschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TodoHistorySchemaUpsertBulk) UpdateNewValues() *TodoHistorySchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(todohistoryschema.FieldID)
+			}
+			if _, exists := b.mutation.TodoID(); exists {
+				s.SetIgnore(todohistoryschema.FieldTodoID)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(todohistoryschema.FieldVersion)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(todohistoryschema.FieldProjectID)
+			}
+			if _, exists := b.mutation.Actor(); exists {
+				s.SetIgnore(todohistoryschema.FieldActor)
+			}
+			if _, exists := b.mutation.Operation(); exists {
+				s.SetIgnore(todohistoryschema.FieldOperation)
+			}
+			if _, exists := b.mutation.Changes(); exists {
+				s.SetIgnore(todohistoryschema.FieldChanges)
+			}
+			if _, exists := b.mutation.OccurredAt(); exists {
+				s.SetIgnore(todohistoryschema.FieldOccurredAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TodoHistorySchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TodoHistorySchemaUpsertBulk) Ignore() *TodoHistorySchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TodoHistorySchemaUpsertBulk) DoNothing() *TodoHistorySchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TodoHistorySchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *TodoHistorySchemaUpsertBulk) Update(set func(*TodoHistorySchemaUpsert)) *TodoHistorySchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TodoHistorySchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TodoHistorySchemaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entgen: OnConflict was set for builder %d. Set it on the TodoHistorySchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for TodoHistorySchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TodoHistorySchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
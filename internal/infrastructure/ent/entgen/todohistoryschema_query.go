@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
+)
+
+// TodoHistorySchemaQuery is the builder for querying TodoHistorySchema entities.
+type TodoHistorySchemaQuery struct {
+	config
+	ctx        *QueryContext
+	order      []todohistoryschema.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TodoHistorySchema
+	loadTotal  []func(context.Context, []*TodoHistorySchema) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TodoHistorySchemaQuery builder.
+func (thsq *TodoHistorySchemaQuery) Where(ps ...predicate.TodoHistorySchema) *TodoHistorySchemaQuery {
+	thsq.predicates = append(thsq.predicates, ps...)
+	return thsq
+}
+
+// Limit the number of records to be returned by this query.
+func (thsq *TodoHistorySchemaQuery) Limit(limit int) *TodoHistorySchemaQuery {
+	thsq.ctx.Limit = &limit
+	return thsq
+}
+
+// Offset to start from.
+func (thsq *TodoHistorySchemaQuery) Offset(offset int) *TodoHistorySchemaQuery {
+	thsq.ctx.Offset = &offset
+	return thsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (thsq *TodoHistorySchemaQuery) Unique(unique bool) *TodoHistorySchemaQuery {
+	thsq.ctx.Unique = &unique
+	return thsq
+}
+
+// Order specifies how the records should be ordered.
+func (thsq *TodoHistorySchemaQuery) Order(o ...todohistoryschema.OrderOption) *TodoHistorySchemaQuery {
+	thsq.order = append(thsq.order, o...)
+	return thsq
+}
+
+// First returns the first TodoHistorySchema entity from the query.
+// Returns a *NotFoundError when no TodoHistorySchema was found.
+func (thsq *TodoHistorySchemaQuery) First(ctx context.Context) (*TodoHistorySchema, error) {
+	nodes, err := thsq.Limit(1).All(setContextOp(ctx, thsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{todohistoryschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (thsq *TodoHistorySchemaQuery) FirstX(ctx context.Context) *TodoHistorySchema {
+	node, err := thsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TodoHistorySchema ID from the query.
+// Returns a *NotFoundError when no TodoHistorySchema ID was found.
+func (thsq *TodoHistorySchemaQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = thsq.Limit(1).IDs(setContextOp(ctx, thsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{todohistoryschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (thsq *TodoHistorySchemaQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := thsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TodoHistorySchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TodoHistorySchema entity is found.
+// Returns a *NotFoundError when no TodoHistorySchema entities are found.
+func (thsq *TodoHistorySchemaQuery) Only(ctx context.Context) (*TodoHistorySchema, error) {
+	nodes, err := thsq.Limit(2).All(setContextOp(ctx, thsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{todohistoryschema.Label}
+	default:
+		return nil, &NotSingularError{todohistoryschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (thsq *TodoHistorySchemaQuery) OnlyX(ctx context.Context) *TodoHistorySchema {
+	node, err := thsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TodoHistorySchema ID in the query.
+// Returns a *NotSingularError when more than one TodoHistorySchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (thsq *TodoHistorySchemaQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = thsq.Limit(2).IDs(setContextOp(ctx, thsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{todohistoryschema.Label}
+	default:
+		err = &NotSingularError{todohistoryschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (thsq *TodoHistorySchemaQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := thsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TodoHistorySchemas.
+func (thsq *TodoHistorySchemaQuery) All(ctx context.Context) ([]*TodoHistorySchema, error) {
+	ctx = setContextOp(ctx, thsq.ctx, ent.OpQueryAll)
+	if err := thsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TodoHistorySchema, *TodoHistorySchemaQuery]()
+	return withInterceptors[[]*TodoHistorySchema](ctx, thsq, qr, thsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (thsq *TodoHistorySchemaQuery) AllX(ctx context.Context) []*TodoHistorySchema {
+	nodes, err := thsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TodoHistorySchema IDs.
+func (thsq *TodoHistorySchemaQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if thsq.ctx.Unique == nil && thsq.path != nil {
+		thsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, thsq.ctx, ent.OpQueryIDs)
+	if err = thsq.Select(todohistoryschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (thsq *TodoHistorySchemaQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := thsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (thsq *TodoHistorySchemaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, thsq.ctx, ent.OpQueryCount)
+	if err := thsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, thsq, querierCount[*TodoHistorySchemaQuery](), thsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (thsq *TodoHistorySchemaQuery) CountX(ctx context.Context) int {
+	count, err := thsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (thsq *TodoHistorySchemaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, thsq.ctx, ent.OpQueryExist)
+	switch _, err := thsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entgen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (thsq *TodoHistorySchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := thsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TodoHistorySchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (thsq *TodoHistorySchemaQuery) Clone() *TodoHistorySchemaQuery {
+	if thsq == nil {
+		return nil
+	}
+	return &TodoHistorySchemaQuery{
+		config:     thsq.config,
+		ctx:        thsq.ctx.Clone(),
+		order:      append([]todohistoryschema.OrderOption{}, thsq.order...),
+		inters:     append([]Interceptor{}, thsq.inters...),
+		predicates: append([]predicate.TodoHistorySchema{}, thsq.predicates...),
+		// clone intermediate query.
+		sql:       thsq.sql.Clone(),
+		path:      thsq.path,
+		modifiers: append([]func(*sql.Selector){}, thsq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TodoID uuid.UUID `json:"todo_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TodoHistorySchema.Query().
+//		GroupBy(todohistoryschema.FieldTodoID).
+//		Aggregate(entgen.Count()).
+//		Scan(ctx, &v)
+func (thsq *TodoHistorySchemaQuery) GroupBy(field string, fields ...string) *TodoHistorySchemaGroupBy {
+	thsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TodoHistorySchemaGroupBy{build: thsq}
+	grbuild.flds = &thsq.ctx.Fields
+	grbuild.label = todohistoryschema.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TodoID uuid.UUID `json:"todo_id,omitempty"`
+//	}
+//
+//	client.TodoHistorySchema.Query().
+//		Select(todohistoryschema.FieldTodoID).
+//		Scan(ctx, &v)
+func (thsq *TodoHistorySchemaQuery) Select(fields ...string) *TodoHistorySchemaSelect {
+	thsq.ctx.Fields = append(thsq.ctx.Fields, fields...)
+	sbuild := &TodoHistorySchemaSelect{TodoHistorySchemaQuery: thsq}
+	sbuild.label = todohistoryschema.Label
+	sbuild.flds, sbuild.scan = &thsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TodoHistorySchemaSelect configured with the given aggregations.
+func (thsq *TodoHistorySchemaQuery) Aggregate(fns ...AggregateFunc) *TodoHistorySchemaSelect {
+	return thsq.Select().Aggregate(fns...)
+}
+
+func (thsq *TodoHistorySchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range thsq.inters {
+		if inter == nil {
+			return fmt.Errorf("entgen: uninitialized interceptor (forgotten import entgen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, thsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range thsq.ctx.Fields {
+		if !todohistoryschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+		}
+	}
+	if thsq.path != nil {
+		prev, err := thsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		thsq.sql = prev
+	}
+	return nil
+}
+
+func (thsq *TodoHistorySchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TodoHistorySchema, error) {
+	var (
+		nodes = []*TodoHistorySchema{}
+		_spec = thsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TodoHistorySchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TodoHistorySchema{config: thsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = thsq.schemaConfig.TodoHistorySchema
+	ctx = internal.NewSchemaConfigContext(ctx, thsq.schemaConfig)
+	if len(thsq.modifiers) > 0 {
+		_spec.Modifiers = thsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, thsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range thsq.loadTotal {
+		if err := thsq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (thsq *TodoHistorySchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := thsq.querySpec()
+	_spec.Node.Schema = thsq.schemaConfig.TodoHistorySchema
+	ctx = internal.NewSchemaConfigContext(ctx, thsq.schemaConfig)
+	if len(thsq.modifiers) > 0 {
+		_spec.Modifiers = thsq.modifiers
+	}
+	_spec.Node.Columns = thsq.ctx.Fields
+	if len(thsq.ctx.Fields) > 0 {
+		_spec.Unique = thsq.ctx.Unique != nil && *thsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, thsq.driver, _spec)
+}
+
+func (thsq *TodoHistorySchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(todohistoryschema.Table, todohistoryschema.Columns, sqlgraph.NewFieldSpec(todohistoryschema.FieldID, field.TypeUUID))
+	_spec.From = thsq.sql
+	if unique := thsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if thsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := thsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todohistoryschema.FieldID)
+		for i := range fields {
+			if fields[i] != todohistoryschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := thsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := thsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := thsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := thsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (thsq *TodoHistorySchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(thsq.driver.Dialect())
+	t1 := builder.Table(todohistoryschema.Table)
+	columns := thsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = todohistoryschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if thsq.sql != nil {
+		selector = thsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if thsq.ctx.Unique != nil && *thsq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(thsq.schemaConfig.TodoHistorySchema)
+	ctx = internal.NewSchemaConfigContext(ctx, thsq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range thsq.modifiers {
+		m(selector)
+	}
+	for _, p := range thsq.predicates {
+		p(selector)
+	}
+	for _, p := range thsq.order {
+		p(selector)
+	}
+	if offset := thsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := thsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (thsq *TodoHistorySchemaQuery) ForUpdate(opts ...sql.LockOption) *TodoHistorySchemaQuery {
+	if thsq.driver.Dialect() == dialect.Postgres {
+		thsq.Unique(false)
+	}
+	thsq.modifiers = append(thsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return thsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (thsq *TodoHistorySchemaQuery) ForShare(opts ...sql.LockOption) *TodoHistorySchemaQuery {
+	if thsq.driver.Dialect() == dialect.Postgres {
+		thsq.Unique(false)
+	}
+	thsq.modifiers = append(thsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return thsq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (thsq *TodoHistorySchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *TodoHistorySchemaSelect {
+	thsq.modifiers = append(thsq.modifiers, modifiers...)
+	return thsq.Select()
+}
+
+// TodoHistorySchemaGroupBy is the group-by builder for TodoHistorySchema entities.
+type TodoHistorySchemaGroupBy struct {
+	selector
+	build *TodoHistorySchemaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (thsgb *TodoHistorySchemaGroupBy) Aggregate(fns ...AggregateFunc) *TodoHistorySchemaGroupBy {
+	thsgb.fns = append(thsgb.fns, fns...)
+	return thsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (thsgb *TodoHistorySchemaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, thsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := thsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TodoHistorySchemaQuery, *TodoHistorySchemaGroupBy](ctx, thsgb.build, thsgb, thsgb.build.inters, v)
+}
+
+func (thsgb *TodoHistorySchemaGroupBy) sqlScan(ctx context.Context, root *TodoHistorySchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(thsgb.fns))
+	for _, fn := range thsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*thsgb.flds)+len(thsgb.fns))
+		for _, f := range *thsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*thsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := thsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TodoHistorySchemaSelect is the builder for selecting fields of TodoHistorySchema entities.
+type TodoHistorySchemaSelect struct {
+	*TodoHistorySchemaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (thss *TodoHistorySchemaSelect) Aggregate(fns ...AggregateFunc) *TodoHistorySchemaSelect {
+	thss.fns = append(thss.fns, fns...)
+	return thss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (thss *TodoHistorySchemaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, thss.ctx, ent.OpQuerySelect)
+	if err := thss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TodoHistorySchemaQuery, *TodoHistorySchemaSelect](ctx, thss.TodoHistorySchemaQuery, thss, thss.inters, v)
+}
+
+func (thss *TodoHistorySchemaSelect) sqlScan(ctx context.Context, root *TodoHistorySchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(thss.fns))
+	for _, fn := range thss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*thss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := thss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (thss *TodoHistorySchemaSelect) Modify(modifiers ...func(s *sql.Selector)) *TodoHistorySchemaSelect {
+	thss.modifiers = append(thss.modifiers, modifiers...)
+	return thss
+}
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
+)
+
+// TodoHistorySchemaUpdate is the builder for updating TodoHistorySchema entities.
+type TodoHistorySchemaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TodoHistorySchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TodoHistorySchemaUpdate builder.
+func (thsu *TodoHistorySchemaUpdate) Where(ps ...predicate.TodoHistorySchema) *TodoHistorySchemaUpdate {
+	thsu.mutation.Where(ps...)
+	return thsu
+}
+
+// Mutation returns the TodoHistorySchemaMutation object of the builder.
+func (thsu *TodoHistorySchemaUpdate) Mutation() *TodoHistorySchemaMutation {
+	return thsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (thsu *TodoHistorySchemaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, thsu.sqlSave, thsu.mutation, thsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (thsu *TodoHistorySchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := thsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (thsu *TodoHistorySchemaUpdate) Exec(ctx context.Context) error {
+	_, err := thsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thsu *TodoHistorySchemaUpdate) ExecX(ctx context.Context) {
+	if err := thsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (thsu *TodoHistorySchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TodoHistorySchemaUpdate {
+	thsu.modifiers = append(thsu.modifiers, modifiers...)
+	return thsu
+}
+
+func (thsu *TodoHistorySchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(todohistoryschema.Table, todohistoryschema.Columns, sqlgraph.NewFieldSpec(todohistoryschema.FieldID, field.TypeUUID))
+	if ps := thsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if thsu.mutation.ProjectIDCleared() {
+		_spec.ClearField(todohistoryschema.FieldProjectID, field.TypeUUID)
+	}
+	_spec.Node.Schema = thsu.schemaConfig.TodoHistorySchema
+	ctx = internal.NewSchemaConfigContext(ctx, thsu.schemaConfig)
+	_spec.AddModifiers(thsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, thsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todohistoryschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	thsu.mutation.done = true
+	return n, nil
+}
+
+// TodoHistorySchemaUpdateOne is the builder for updating a single TodoHistorySchema entity.
+type TodoHistorySchemaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TodoHistorySchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the TodoHistorySchemaMutation object of the builder.
+func (thsuo *TodoHistorySchemaUpdateOne) Mutation() *TodoHistorySchemaMutation {
+	return thsuo.mutation
+}
+
+// Where appends a list predicates to the TodoHistorySchemaUpdate builder.
+func (thsuo *TodoHistorySchemaUpdateOne) Where(ps ...predicate.TodoHistorySchema) *TodoHistorySchemaUpdateOne {
+	thsuo.mutation.Where(ps...)
+	return thsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (thsuo *TodoHistorySchemaUpdateOne) Select(field string, fields ...string) *TodoHistorySchemaUpdateOne {
+	thsuo.fields = append([]string{field}, fields...)
+	return thsuo
+}
+
+// Save executes the query and returns the updated TodoHistorySchema entity.
+func (thsuo *TodoHistorySchemaUpdateOne) Save(ctx context.Context) (*TodoHistorySchema, error) {
+	return withHooks(ctx, thsuo.sqlSave, thsuo.mutation, thsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (thsuo *TodoHistorySchemaUpdateOne) SaveX(ctx context.Context) *TodoHistorySchema {
+	node, err := thsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (thsuo *TodoHistorySchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := thsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thsuo *TodoHistorySchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := thsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (thsuo *TodoHistorySchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TodoHistorySchemaUpdateOne {
+	thsuo.modifiers = append(thsuo.modifiers, modifiers...)
+	return thsuo
+}
+
+func (thsuo *TodoHistorySchemaUpdateOne) sqlSave(ctx context.Context) (_node *TodoHistorySchema, err error) {
+	_spec := sqlgraph.NewUpdateSpec(todohistoryschema.Table, todohistoryschema.Columns, sqlgraph.NewFieldSpec(todohistoryschema.FieldID, field.TypeUUID))
+	id, ok := thsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "TodoHistorySchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := thsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, todohistoryschema.FieldID)
+		for _, f := range fields {
+			if !todohistoryschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != todohistoryschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := thsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if thsuo.mutation.ProjectIDCleared() {
+		_spec.ClearField(todohistoryschema.FieldProjectID, field.TypeUUID)
+	}
+	_spec.Node.Schema = thsuo.schemaConfig.TodoHistorySchema
+	ctx = internal.NewSchemaConfigContext(ctx, thsuo.schemaConfig)
+	_spec.AddModifiers(thsuo.modifiers...)
+	_node = &TodoHistorySchema{config: thsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, thsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{todohistoryschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	thsuo.mutation.done = true
+	return _node, nil
+}
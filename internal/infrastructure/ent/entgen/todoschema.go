@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
 )
 
@@ -31,16 +32,135 @@ type TodoSchema struct {
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
-	DeletedAt    time.Time `json:"deleted_at,omitempty"`
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID *uuid.UUID `json:"project_id,omitempty"`
+	// StatusID holds the value of the "status_id" field.
+	StatusID string `json:"status_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoSchemaQuery when eager-loading is set.
+	Edges        TodoSchemaEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// TodoSchemaEdges holds the relations/edges for other nodes in the graph.
+type TodoSchemaEdges struct {
+	// Project holds the value of the project edge.
+	Project *ProjectSchema `json:"project,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*TagSchema `json:"tags,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *TodoSchema `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*TodoSchema `json:"children,omitempty"`
+	// Blocks holds the value of the blocks edge.
+	Blocks []*TodoSchema `json:"blocks,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*TodoSchema `json:"blocked_by,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*CommentSchema `json:"comments,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*AttachmentSchema `json:"attachments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [8]bool
+	// totalCount holds the count of the edges above.
+	totalCount [5]map[string]int
+
+	namedTags        map[string][]*TagSchema
+	namedChildren    map[string][]*TodoSchema
+	namedBlocks      map[string][]*TodoSchema
+	namedBlockedBy   map[string][]*TodoSchema
+	namedComments    map[string][]*CommentSchema
+	namedAttachments map[string][]*AttachmentSchema
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoSchemaEdges) ProjectOrErr() (*ProjectSchema, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: projectschema.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoSchemaEdges) TagsOrErr() ([]*TagSchema, error) {
+	if e.loadedTypes[1] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoSchemaEdges) ParentOrErr() (*TodoSchema, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: todoschema.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoSchemaEdges) ChildrenOrErr() ([]*TodoSchema, error) {
+	if e.loadedTypes[3] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// BlocksOrErr returns the Blocks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoSchemaEdges) BlocksOrErr() ([]*TodoSchema, error) {
+	if e.loadedTypes[4] {
+		return e.Blocks, nil
+	}
+	return nil, &NotLoadedError{edge: "blocks"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoSchemaEdges) BlockedByOrErr() ([]*TodoSchema, error) {
+	if e.loadedTypes[5] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoSchemaEdges) CommentsOrErr() ([]*CommentSchema, error) {
+	if e.loadedTypes[6] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoSchemaEdges) AttachmentsOrErr() ([]*AttachmentSchema, error) {
+	if e.loadedTypes[7] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*TodoSchema) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case todoschema.FieldTitle, todoschema.FieldBody, todoschema.FieldStatus:
+		case todoschema.FieldParentID, todoschema.FieldProjectID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case todoschema.FieldTitle, todoschema.FieldBody, todoschema.FieldStatus, todoschema.FieldStatusID:
 			values[i] = new(sql.NullString)
 		case todoschema.FieldCreatedAt, todoschema.FieldUpdatedAt, todoschema.FieldCompletedAt, todoschema.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -111,6 +231,26 @@ func (ts *TodoSchema) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ts.DeletedAt = value.Time
 			}
+		case todoschema.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				ts.ParentID = new(uuid.UUID)
+				*ts.ParentID = *value.S.(*uuid.UUID)
+			}
+		case todoschema.FieldProjectID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				ts.ProjectID = new(uuid.UUID)
+				*ts.ProjectID = *value.S.(*uuid.UUID)
+			}
+		case todoschema.FieldStatusID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_id", values[i])
+			} else if value.Valid {
+				ts.StatusID = value.String
+			}
 		default:
 			ts.selectValues.Set(columns[i], values[i])
 		}
@@ -124,6 +264,46 @@ func (ts *TodoSchema) Value(name string) (ent.Value, error) {
 	return ts.selectValues.Get(name)
 }
 
+// QueryProject queries the "project" edge of the TodoSchema entity.
+func (ts *TodoSchema) QueryProject() *ProjectSchemaQuery {
+	return NewTodoSchemaClient(ts.config).QueryProject(ts)
+}
+
+// QueryTags queries the "tags" edge of the TodoSchema entity.
+func (ts *TodoSchema) QueryTags() *TagSchemaQuery {
+	return NewTodoSchemaClient(ts.config).QueryTags(ts)
+}
+
+// QueryParent queries the "parent" edge of the TodoSchema entity.
+func (ts *TodoSchema) QueryParent() *TodoSchemaQuery {
+	return NewTodoSchemaClient(ts.config).QueryParent(ts)
+}
+
+// QueryChildren queries the "children" edge of the TodoSchema entity.
+func (ts *TodoSchema) QueryChildren() *TodoSchemaQuery {
+	return NewTodoSchemaClient(ts.config).QueryChildren(ts)
+}
+
+// QueryBlocks queries the "blocks" edge of the TodoSchema entity.
+func (ts *TodoSchema) QueryBlocks() *TodoSchemaQuery {
+	return NewTodoSchemaClient(ts.config).QueryBlocks(ts)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the TodoSchema entity.
+func (ts *TodoSchema) QueryBlockedBy() *TodoSchemaQuery {
+	return NewTodoSchemaClient(ts.config).QueryBlockedBy(ts)
+}
+
+// QueryComments queries the "comments" edge of the TodoSchema entity.
+func (ts *TodoSchema) QueryComments() *CommentSchemaQuery {
+	return NewTodoSchemaClient(ts.config).QueryComments(ts)
+}
+
+// QueryAttachments queries the "attachments" edge of the TodoSchema entity.
+func (ts *TodoSchema) QueryAttachments() *AttachmentSchemaQuery {
+	return NewTodoSchemaClient(ts.config).QueryAttachments(ts)
+}
+
 // Update returns a builder for updating this TodoSchema.
 // Note that you need to call TodoSchema.Unwrap() before calling this method if this TodoSchema
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -171,9 +351,166 @@ func (ts *TodoSchema) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("deleted_at=")
 	builder.WriteString(ts.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ts.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ts.ProjectID; v != nil {
+		builder.WriteString("project_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status_id=")
+	builder.WriteString(ts.StatusID)
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedTags returns the Tags named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ts *TodoSchema) NamedTags(name string) ([]*TagSchema, error) {
+	if ts.Edges.namedTags == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ts.Edges.namedTags[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ts *TodoSchema) appendNamedTags(name string, edges ...*TagSchema) {
+	if ts.Edges.namedTags == nil {
+		ts.Edges.namedTags = make(map[string][]*TagSchema)
+	}
+	if len(edges) == 0 {
+		ts.Edges.namedTags[name] = []*TagSchema{}
+	} else {
+		ts.Edges.namedTags[name] = append(ts.Edges.namedTags[name], edges...)
+	}
+}
+
+// NamedChildren returns the Children named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ts *TodoSchema) NamedChildren(name string) ([]*TodoSchema, error) {
+	if ts.Edges.namedChildren == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ts.Edges.namedChildren[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ts *TodoSchema) appendNamedChildren(name string, edges ...*TodoSchema) {
+	if ts.Edges.namedChildren == nil {
+		ts.Edges.namedChildren = make(map[string][]*TodoSchema)
+	}
+	if len(edges) == 0 {
+		ts.Edges.namedChildren[name] = []*TodoSchema{}
+	} else {
+		ts.Edges.namedChildren[name] = append(ts.Edges.namedChildren[name], edges...)
+	}
+}
+
+// NamedBlocks returns the Blocks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ts *TodoSchema) NamedBlocks(name string) ([]*TodoSchema, error) {
+	if ts.Edges.namedBlocks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ts.Edges.namedBlocks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ts *TodoSchema) appendNamedBlocks(name string, edges ...*TodoSchema) {
+	if ts.Edges.namedBlocks == nil {
+		ts.Edges.namedBlocks = make(map[string][]*TodoSchema)
+	}
+	if len(edges) == 0 {
+		ts.Edges.namedBlocks[name] = []*TodoSchema{}
+	} else {
+		ts.Edges.namedBlocks[name] = append(ts.Edges.namedBlocks[name], edges...)
+	}
+}
+
+// NamedBlockedBy returns the BlockedBy named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ts *TodoSchema) NamedBlockedBy(name string) ([]*TodoSchema, error) {
+	if ts.Edges.namedBlockedBy == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ts.Edges.namedBlockedBy[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ts *TodoSchema) appendNamedBlockedBy(name string, edges ...*TodoSchema) {
+	if ts.Edges.namedBlockedBy == nil {
+		ts.Edges.namedBlockedBy = make(map[string][]*TodoSchema)
+	}
+	if len(edges) == 0 {
+		ts.Edges.namedBlockedBy[name] = []*TodoSchema{}
+	} else {
+		ts.Edges.namedBlockedBy[name] = append(ts.Edges.namedBlockedBy[name], edges...)
+	}
+}
+
+// NamedComments returns the Comments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ts *TodoSchema) NamedComments(name string) ([]*CommentSchema, error) {
+	if ts.Edges.namedComments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ts.Edges.namedComments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ts *TodoSchema) appendNamedComments(name string, edges ...*CommentSchema) {
+	if ts.Edges.namedComments == nil {
+		ts.Edges.namedComments = make(map[string][]*CommentSchema)
+	}
+	if len(edges) == 0 {
+		ts.Edges.namedComments[name] = []*CommentSchema{}
+	} else {
+		ts.Edges.namedComments[name] = append(ts.Edges.namedComments[name], edges...)
+	}
+}
+
+// NamedAttachments returns the Attachments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ts *TodoSchema) NamedAttachments(name string) ([]*AttachmentSchema, error) {
+	if ts.Edges.namedAttachments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ts.Edges.namedAttachments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ts *TodoSchema) appendNamedAttachments(name string, edges ...*AttachmentSchema) {
+	if ts.Edges.namedAttachments == nil {
+		ts.Edges.namedAttachments = make(map[string][]*AttachmentSchema)
+	}
+	if len(edges) == 0 {
+		ts.Edges.namedAttachments[name] = []*AttachmentSchema{}
+	} else {
+		ts.Edges.namedAttachments[name] = append(ts.Edges.namedAttachments[name], edges...)
+	}
+}
+
 // TodoSchemas is a parsable slice of TodoSchema.
 type TodoSchemas []*TodoSchema
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
 )
 
@@ -85,6 +87,21 @@ func DeletedAt(v time.Time) predicate.TodoSchema {
 	return predicate.TodoSchema(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldEQ(FieldParentID, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldEQ(FieldProjectID, v))
+}
+
+// StatusID applies equality check predicate on the "status_id" field. It's identical to StatusIDEQ.
+func StatusID(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldEQ(FieldStatusID, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.TodoSchema {
 	return predicate.TodoSchema(sql.FieldEQ(FieldTitle, v))
@@ -425,6 +442,373 @@ func DeletedAtNotNil() predicate.TodoSchema {
 	return predicate.TodoSchema(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldNotNull(FieldParentID))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...uuid.UUID) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldNotNull(FieldProjectID))
+}
+
+// StatusIDEQ applies the EQ predicate on the "status_id" field.
+func StatusIDEQ(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldEQ(FieldStatusID, v))
+}
+
+// StatusIDNEQ applies the NEQ predicate on the "status_id" field.
+func StatusIDNEQ(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldNEQ(FieldStatusID, v))
+}
+
+// StatusIDIn applies the In predicate on the "status_id" field.
+func StatusIDIn(vs ...string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldIn(FieldStatusID, vs...))
+}
+
+// StatusIDNotIn applies the NotIn predicate on the "status_id" field.
+func StatusIDNotIn(vs ...string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldNotIn(FieldStatusID, vs...))
+}
+
+// StatusIDGT applies the GT predicate on the "status_id" field.
+func StatusIDGT(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldGT(FieldStatusID, v))
+}
+
+// StatusIDGTE applies the GTE predicate on the "status_id" field.
+func StatusIDGTE(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldGTE(FieldStatusID, v))
+}
+
+// StatusIDLT applies the LT predicate on the "status_id" field.
+func StatusIDLT(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldLT(FieldStatusID, v))
+}
+
+// StatusIDLTE applies the LTE predicate on the "status_id" field.
+func StatusIDLTE(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldLTE(FieldStatusID, v))
+}
+
+// StatusIDContains applies the Contains predicate on the "status_id" field.
+func StatusIDContains(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldContains(FieldStatusID, v))
+}
+
+// StatusIDHasPrefix applies the HasPrefix predicate on the "status_id" field.
+func StatusIDHasPrefix(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldHasPrefix(FieldStatusID, v))
+}
+
+// StatusIDHasSuffix applies the HasSuffix predicate on the "status_id" field.
+func StatusIDHasSuffix(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldHasSuffix(FieldStatusID, v))
+}
+
+// StatusIDIsNil applies the IsNil predicate on the "status_id" field.
+func StatusIDIsNil() predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldIsNull(FieldStatusID))
+}
+
+// StatusIDNotNil applies the NotNil predicate on the "status_id" field.
+func StatusIDNotNil() predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldNotNull(FieldStatusID))
+}
+
+// StatusIDEqualFold applies the EqualFold predicate on the "status_id" field.
+func StatusIDEqualFold(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldEqualFold(FieldStatusID, v))
+}
+
+// StatusIDContainsFold applies the ContainsFold predicate on the "status_id" field.
+func StatusIDContainsFold(v string) predicate.TodoSchema {
+	return predicate.TodoSchema(sql.FieldContainsFold(FieldStatusID, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ProjectSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.ProjectSchema) predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := newProjectStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ProjectSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TagSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaTags
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.TagSchema) predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := newTagsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TagSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaTags
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.TodoSchema) predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := newParentStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.TodoSchema) predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := newChildrenStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocks applies the HasEdge predicate on the "blocks" edge.
+func HasBlocks() predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, BlocksTable, BlocksPrimaryKey...),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaBlockedBy
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlocksWith applies the HasEdge predicate on the "blocks" edge with a given conditions (other predicates).
+func HasBlocksWith(preds ...predicate.TodoSchema) predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := newBlocksStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaBlockedBy
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedBy applies the HasEdge predicate on the "blocked_by" edge.
+func HasBlockedBy() predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, BlockedByTable, BlockedByPrimaryKey...),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaBlockedBy
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedByWith applies the HasEdge predicate on the "blocked_by" edge with a given conditions (other predicates).
+func HasBlockedByWith(preds ...predicate.TodoSchema) predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := newBlockedByStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaBlockedBy
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.CommentSchema
+		step.Edge.Schema = schemaConfig.CommentSchema
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.CommentSchema) predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := newCommentsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.CommentSchema
+		step.Edge.Schema = schemaConfig.CommentSchema
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.AttachmentSchema
+		step.Edge.Schema = schemaConfig.AttachmentSchema
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.AttachmentSchema) predicate.TodoSchema {
+	return predicate.TodoSchema(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.AttachmentSchema
+		step.Edge.Schema = schemaConfig.AttachmentSchema
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.TodoSchema) predicate.TodoSchema {
 	return predicate.TodoSchema(sql.AndPredicates(predicates...))
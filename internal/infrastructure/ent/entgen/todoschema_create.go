@@ -13,6 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
 )
 
@@ -114,6 +118,48 @@ func (tsc *TodoSchemaCreate) SetNillableDeletedAt(t *time.Time) *TodoSchemaCreat
 	return tsc
 }
 
+// SetParentID sets the "parent_id" field.
+func (tsc *TodoSchemaCreate) SetParentID(u uuid.UUID) *TodoSchemaCreate {
+	tsc.mutation.SetParentID(u)
+	return tsc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tsc *TodoSchemaCreate) SetNillableParentID(u *uuid.UUID) *TodoSchemaCreate {
+	if u != nil {
+		tsc.SetParentID(*u)
+	}
+	return tsc
+}
+
+// SetProjectID sets the "project_id" field.
+func (tsc *TodoSchemaCreate) SetProjectID(u uuid.UUID) *TodoSchemaCreate {
+	tsc.mutation.SetProjectID(u)
+	return tsc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tsc *TodoSchemaCreate) SetNillableProjectID(u *uuid.UUID) *TodoSchemaCreate {
+	if u != nil {
+		tsc.SetProjectID(*u)
+	}
+	return tsc
+}
+
+// SetStatusID sets the "status_id" field.
+func (tsc *TodoSchemaCreate) SetStatusID(s string) *TodoSchemaCreate {
+	tsc.mutation.SetStatusID(s)
+	return tsc
+}
+
+// SetNillableStatusID sets the "status_id" field if the given value is not nil.
+func (tsc *TodoSchemaCreate) SetNillableStatusID(s *string) *TodoSchemaCreate {
+	if s != nil {
+		tsc.SetStatusID(*s)
+	}
+	return tsc
+}
+
 // SetID sets the "id" field.
 func (tsc *TodoSchemaCreate) SetID(u uuid.UUID) *TodoSchemaCreate {
 	tsc.mutation.SetID(u)
@@ -128,6 +174,106 @@ func (tsc *TodoSchemaCreate) SetNillableID(u *uuid.UUID) *TodoSchemaCreate {
 	return tsc
 }
 
+// SetProject sets the "project" edge to the ProjectSchema entity.
+func (tsc *TodoSchemaCreate) SetProject(p *ProjectSchema) *TodoSchemaCreate {
+	return tsc.SetProjectID(p.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the TagSchema entity by IDs.
+func (tsc *TodoSchemaCreate) AddTagIDs(ids ...uuid.UUID) *TodoSchemaCreate {
+	tsc.mutation.AddTagIDs(ids...)
+	return tsc
+}
+
+// AddTags adds the "tags" edges to the TagSchema entity.
+func (tsc *TodoSchemaCreate) AddTags(t ...*TagSchema) *TodoSchemaCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsc.AddTagIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the TodoSchema entity.
+func (tsc *TodoSchemaCreate) SetParent(t *TodoSchema) *TodoSchemaCreate {
+	return tsc.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the TodoSchema entity by IDs.
+func (tsc *TodoSchemaCreate) AddChildIDs(ids ...uuid.UUID) *TodoSchemaCreate {
+	tsc.mutation.AddChildIDs(ids...)
+	return tsc
+}
+
+// AddChildren adds the "children" edges to the TodoSchema entity.
+func (tsc *TodoSchemaCreate) AddChildren(t ...*TodoSchema) *TodoSchemaCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsc.AddChildIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the TodoSchema entity by IDs.
+func (tsc *TodoSchemaCreate) AddBlockIDs(ids ...uuid.UUID) *TodoSchemaCreate {
+	tsc.mutation.AddBlockIDs(ids...)
+	return tsc
+}
+
+// AddBlocks adds the "blocks" edges to the TodoSchema entity.
+func (tsc *TodoSchemaCreate) AddBlocks(t ...*TodoSchema) *TodoSchemaCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsc.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the TodoSchema entity by IDs.
+func (tsc *TodoSchemaCreate) AddBlockedByIDs(ids ...uuid.UUID) *TodoSchemaCreate {
+	tsc.mutation.AddBlockedByIDs(ids...)
+	return tsc
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the TodoSchema entity.
+func (tsc *TodoSchemaCreate) AddBlockedBy(t ...*TodoSchema) *TodoSchemaCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsc.AddBlockedByIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the CommentSchema entity by IDs.
+func (tsc *TodoSchemaCreate) AddCommentIDs(ids ...uuid.UUID) *TodoSchemaCreate {
+	tsc.mutation.AddCommentIDs(ids...)
+	return tsc
+}
+
+// AddComments adds the "comments" edges to the CommentSchema entity.
+func (tsc *TodoSchemaCreate) AddComments(c ...*CommentSchema) *TodoSchemaCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tsc.AddCommentIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the AttachmentSchema entity by IDs.
+func (tsc *TodoSchemaCreate) AddAttachmentIDs(ids ...uuid.UUID) *TodoSchemaCreate {
+	tsc.mutation.AddAttachmentIDs(ids...)
+	return tsc
+}
+
+// AddAttachments adds the "attachments" edges to the AttachmentSchema entity.
+func (tsc *TodoSchemaCreate) AddAttachments(a ...*AttachmentSchema) *TodoSchemaCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tsc.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TodoSchemaMutation object of the builder.
 func (tsc *TodoSchemaCreate) Mutation() *TodoSchemaMutation {
 	return tsc.mutation
@@ -270,6 +416,148 @@ func (tsc *TodoSchemaCreate) createSpec() (*TodoSchema, *sqlgraph.CreateSpec) {
 		_spec.SetField(todoschema.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
 	}
+	if value, ok := tsc.mutation.StatusID(); ok {
+		_spec.SetField(todoschema.FieldStatusID, field.TypeString, value)
+		_node.StatusID = value
+	}
+	if nodes := tsc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ProjectTable,
+			Columns: []string{todoschema.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsc.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.TagsTable,
+			Columns: todoschema.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsc.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ParentTable,
+			Columns: []string{todoschema.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsc.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.ChildrenTable,
+			Columns: []string{todoschema.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsc.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoschema.BlocksTable,
+			Columns: todoschema.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsc.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.BlockedByTable,
+			Columns: todoschema.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsc.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.CommentsTable,
+			Columns: []string{todoschema.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsc.schemaConfig.CommentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tsc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.AttachmentsTable,
+			Columns: []string{todoschema.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsc.schemaConfig.AttachmentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -424,6 +712,60 @@ func (u *TodoSchemaUpsert) ClearDeletedAt() *TodoSchemaUpsert {
 	return u
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *TodoSchemaUpsert) SetParentID(v uuid.UUID) *TodoSchemaUpsert {
+	u.Set(todoschema.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TodoSchemaUpsert) UpdateParentID() *TodoSchemaUpsert {
+	u.SetExcluded(todoschema.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TodoSchemaUpsert) ClearParentID() *TodoSchemaUpsert {
+	u.SetNull(todoschema.FieldParentID)
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TodoSchemaUpsert) SetProjectID(v uuid.UUID) *TodoSchemaUpsert {
+	u.Set(todoschema.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TodoSchemaUpsert) UpdateProjectID() *TodoSchemaUpsert {
+	u.SetExcluded(todoschema.FieldProjectID)
+	return u
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TodoSchemaUpsert) ClearProjectID() *TodoSchemaUpsert {
+	u.SetNull(todoschema.FieldProjectID)
+	return u
+}
+
+// SetStatusID sets the "status_id" field.
+func (u *TodoSchemaUpsert) SetStatusID(v string) *TodoSchemaUpsert {
+	u.Set(todoschema.FieldStatusID, v)
+	return u
+}
+
+// UpdateStatusID sets the "status_id" field to the value that was provided on create.
+func (u *TodoSchemaUpsert) UpdateStatusID() *TodoSchemaUpsert {
+	u.SetExcluded(todoschema.FieldStatusID)
+	return u
+}
+
+// ClearStatusID clears the value of the "status_id" field.
+func (u *TodoSchemaUpsert) ClearStatusID() *TodoSchemaUpsert {
+	u.SetNull(todoschema.FieldStatusID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -591,6 +933,69 @@ func (u *TodoSchemaUpsertOne) ClearDeletedAt() *TodoSchemaUpsertOne {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *TodoSchemaUpsertOne) SetParentID(v uuid.UUID) *TodoSchemaUpsertOne {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TodoSchemaUpsertOne) UpdateParentID() *TodoSchemaUpsertOne {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TodoSchemaUpsertOne) ClearParentID() *TodoSchemaUpsertOne {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TodoSchemaUpsertOne) SetProjectID(v uuid.UUID) *TodoSchemaUpsertOne {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TodoSchemaUpsertOne) UpdateProjectID() *TodoSchemaUpsertOne {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TodoSchemaUpsertOne) ClearProjectID() *TodoSchemaUpsertOne {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetStatusID sets the "status_id" field.
+func (u *TodoSchemaUpsertOne) SetStatusID(v string) *TodoSchemaUpsertOne {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.SetStatusID(v)
+	})
+}
+
+// UpdateStatusID sets the "status_id" field to the value that was provided on create.
+func (u *TodoSchemaUpsertOne) UpdateStatusID() *TodoSchemaUpsertOne {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.UpdateStatusID()
+	})
+}
+
+// ClearStatusID clears the value of the "status_id" field.
+func (u *TodoSchemaUpsertOne) ClearStatusID() *TodoSchemaUpsertOne {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.ClearStatusID()
+	})
+}
+
 // Exec executes the query.
 func (u *TodoSchemaUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -925,6 +1330,69 @@ func (u *TodoSchemaUpsertBulk) ClearDeletedAt() *TodoSchemaUpsertBulk {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *TodoSchemaUpsertBulk) SetParentID(v uuid.UUID) *TodoSchemaUpsertBulk {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *TodoSchemaUpsertBulk) UpdateParentID() *TodoSchemaUpsertBulk {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *TodoSchemaUpsertBulk) ClearParentID() *TodoSchemaUpsertBulk {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *TodoSchemaUpsertBulk) SetProjectID(v uuid.UUID) *TodoSchemaUpsertBulk {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *TodoSchemaUpsertBulk) UpdateProjectID() *TodoSchemaUpsertBulk {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (u *TodoSchemaUpsertBulk) ClearProjectID() *TodoSchemaUpsertBulk {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.ClearProjectID()
+	})
+}
+
+// SetStatusID sets the "status_id" field.
+func (u *TodoSchemaUpsertBulk) SetStatusID(v string) *TodoSchemaUpsertBulk {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.SetStatusID(v)
+	})
+}
+
+// UpdateStatusID sets the "status_id" field to the value that was provided on create.
+func (u *TodoSchemaUpsertBulk) UpdateStatusID() *TodoSchemaUpsertBulk {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.UpdateStatusID()
+	})
+}
+
+// ClearStatusID clears the value of the "status_id" field.
+func (u *TodoSchemaUpsertBulk) ClearStatusID() *TodoSchemaUpsertBulk {
+	return u.Update(func(s *TodoSchemaUpsert) {
+		s.ClearStatusID()
+	})
+}
+
 // Exec executes the query.
 func (u *TodoSchemaUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -4,6 +4,7 @@ package entgen
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -13,19 +14,38 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
 )
 
 // TodoSchemaQuery is the builder for querying TodoSchema entities.
 type TodoSchemaQuery struct {
 	config
-	ctx        *QueryContext
-	order      []todoschema.OrderOption
-	inters     []Interceptor
-	predicates []predicate.TodoSchema
-	modifiers  []func(*sql.Selector)
+	ctx                  *QueryContext
+	order                []todoschema.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.TodoSchema
+	withProject          *ProjectSchemaQuery
+	withTags             *TagSchemaQuery
+	withParent           *TodoSchemaQuery
+	withChildren         *TodoSchemaQuery
+	withBlocks           *TodoSchemaQuery
+	withBlockedBy        *TodoSchemaQuery
+	withComments         *CommentSchemaQuery
+	withAttachments      *AttachmentSchemaQuery
+	loadTotal            []func(context.Context, []*TodoSchema) error
+	modifiers            []func(*sql.Selector)
+	withNamedTags        map[string]*TagSchemaQuery
+	withNamedChildren    map[string]*TodoSchemaQuery
+	withNamedBlocks      map[string]*TodoSchemaQuery
+	withNamedBlockedBy   map[string]*TodoSchemaQuery
+	withNamedComments    map[string]*CommentSchemaQuery
+	withNamedAttachments map[string]*AttachmentSchemaQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -62,6 +82,206 @@ func (tsq *TodoSchemaQuery) Order(o ...todoschema.OrderOption) *TodoSchemaQuery
 	return tsq
 }
 
+// QueryProject chains the current query on the "project" edge.
+func (tsq *TodoSchemaQuery) QueryProject() *ProjectSchemaQuery {
+	query := (&ProjectSchemaClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, selector),
+			sqlgraph.To(projectschema.Table, projectschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todoschema.ProjectTable, todoschema.ProjectColumn),
+		)
+		schemaConfig := tsq.schemaConfig
+		step.To.Schema = schemaConfig.ProjectSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (tsq *TodoSchemaQuery) QueryTags() *TagSchemaQuery {
+	query := (&TagSchemaClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, selector),
+			sqlgraph.To(tagschema.Table, tagschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, todoschema.TagsTable, todoschema.TagsPrimaryKey...),
+		)
+		schemaConfig := tsq.schemaConfig
+		step.To.Schema = schemaConfig.TagSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaTags
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (tsq *TodoSchemaQuery) QueryParent() *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, selector),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todoschema.ParentTable, todoschema.ParentColumn),
+		)
+		schemaConfig := tsq.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (tsq *TodoSchemaQuery) QueryChildren() *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, selector),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoschema.ChildrenTable, todoschema.ChildrenColumn),
+		)
+		schemaConfig := tsq.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchema
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlocks chains the current query on the "blocks" edge.
+func (tsq *TodoSchemaQuery) QueryBlocks() *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, selector),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, todoschema.BlocksTable, todoschema.BlocksPrimaryKey...),
+		)
+		schemaConfig := tsq.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaBlockedBy
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedBy chains the current query on the "blocked_by" edge.
+func (tsq *TodoSchemaQuery) QueryBlockedBy() *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, selector),
+			sqlgraph.To(todoschema.Table, todoschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, todoschema.BlockedByTable, todoschema.BlockedByPrimaryKey...),
+		)
+		schemaConfig := tsq.schemaConfig
+		step.To.Schema = schemaConfig.TodoSchema
+		step.Edge.Schema = schemaConfig.TodoSchemaBlockedBy
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComments chains the current query on the "comments" edge.
+func (tsq *TodoSchemaQuery) QueryComments() *CommentSchemaQuery {
+	query := (&CommentSchemaClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, selector),
+			sqlgraph.To(commentschema.Table, commentschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoschema.CommentsTable, todoschema.CommentsColumn),
+		)
+		schemaConfig := tsq.schemaConfig
+		step.To.Schema = schemaConfig.CommentSchema
+		step.Edge.Schema = schemaConfig.CommentSchema
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (tsq *TodoSchemaQuery) QueryAttachments() *AttachmentSchemaQuery {
+	query := (&AttachmentSchemaClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todoschema.Table, todoschema.FieldID, selector),
+			sqlgraph.To(attachmentschema.Table, attachmentschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todoschema.AttachmentsTable, todoschema.AttachmentsColumn),
+		)
+		schemaConfig := tsq.schemaConfig
+		step.To.Schema = schemaConfig.AttachmentSchema
+		step.Edge.Schema = schemaConfig.AttachmentSchema
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first TodoSchema entity from the query.
 // Returns a *NotFoundError when no TodoSchema was found.
 func (tsq *TodoSchemaQuery) First(ctx context.Context) (*TodoSchema, error) {
@@ -249,11 +469,19 @@ func (tsq *TodoSchemaQuery) Clone() *TodoSchemaQuery {
 		return nil
 	}
 	return &TodoSchemaQuery{
-		config:     tsq.config,
-		ctx:        tsq.ctx.Clone(),
-		order:      append([]todoschema.OrderOption{}, tsq.order...),
-		inters:     append([]Interceptor{}, tsq.inters...),
-		predicates: append([]predicate.TodoSchema{}, tsq.predicates...),
+		config:          tsq.config,
+		ctx:             tsq.ctx.Clone(),
+		order:           append([]todoschema.OrderOption{}, tsq.order...),
+		inters:          append([]Interceptor{}, tsq.inters...),
+		predicates:      append([]predicate.TodoSchema{}, tsq.predicates...),
+		withProject:     tsq.withProject.Clone(),
+		withTags:        tsq.withTags.Clone(),
+		withParent:      tsq.withParent.Clone(),
+		withChildren:    tsq.withChildren.Clone(),
+		withBlocks:      tsq.withBlocks.Clone(),
+		withBlockedBy:   tsq.withBlockedBy.Clone(),
+		withComments:    tsq.withComments.Clone(),
+		withAttachments: tsq.withAttachments.Clone(),
 		// clone intermediate query.
 		sql:       tsq.sql.Clone(),
 		path:      tsq.path,
@@ -261,6 +489,94 @@ func (tsq *TodoSchemaQuery) Clone() *TodoSchemaQuery {
 	}
 }
 
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithProject(opts ...func(*ProjectSchemaQuery)) *TodoSchemaQuery {
+	query := (&ProjectSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withProject = query
+	return tsq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithTags(opts ...func(*TagSchemaQuery)) *TodoSchemaQuery {
+	query := (&TagSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withTags = query
+	return tsq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithParent(opts ...func(*TodoSchemaQuery)) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withParent = query
+	return tsq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithChildren(opts ...func(*TodoSchemaQuery)) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withChildren = query
+	return tsq
+}
+
+// WithBlocks tells the query-builder to eager-load the nodes that are connected to
+// the "blocks" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithBlocks(opts ...func(*TodoSchemaQuery)) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withBlocks = query
+	return tsq
+}
+
+// WithBlockedBy tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithBlockedBy(opts ...func(*TodoSchemaQuery)) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withBlockedBy = query
+	return tsq
+}
+
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithComments(opts ...func(*CommentSchemaQuery)) *TodoSchemaQuery {
+	query := (&CommentSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withComments = query
+	return tsq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithAttachments(opts ...func(*AttachmentSchemaQuery)) *TodoSchemaQuery {
+	query := (&AttachmentSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withAttachments = query
+	return tsq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -337,8 +653,18 @@ func (tsq *TodoSchemaQuery) prepareQuery(ctx context.Context) error {
 
 func (tsq *TodoSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TodoSchema, error) {
 	var (
-		nodes = []*TodoSchema{}
-		_spec = tsq.querySpec()
+		nodes       = []*TodoSchema{}
+		_spec       = tsq.querySpec()
+		loadedTypes = [8]bool{
+			tsq.withProject != nil,
+			tsq.withTags != nil,
+			tsq.withParent != nil,
+			tsq.withChildren != nil,
+			tsq.withBlocks != nil,
+			tsq.withBlockedBy != nil,
+			tsq.withComments != nil,
+			tsq.withAttachments != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*TodoSchema).scanValues(nil, columns)
@@ -346,6 +672,7 @@ func (tsq *TodoSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &TodoSchema{config: tsq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	_spec.Node.Schema = tsq.schemaConfig.TodoSchema
@@ -362,9 +689,484 @@ func (tsq *TodoSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := tsq.withProject; query != nil {
+		if err := tsq.loadProject(ctx, query, nodes, nil,
+			func(n *TodoSchema, e *ProjectSchema) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withTags; query != nil {
+		if err := tsq.loadTags(ctx, query, nodes,
+			func(n *TodoSchema) { n.Edges.Tags = []*TagSchema{} },
+			func(n *TodoSchema, e *TagSchema) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withParent; query != nil {
+		if err := tsq.loadParent(ctx, query, nodes, nil,
+			func(n *TodoSchema, e *TodoSchema) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withChildren; query != nil {
+		if err := tsq.loadChildren(ctx, query, nodes,
+			func(n *TodoSchema) { n.Edges.Children = []*TodoSchema{} },
+			func(n *TodoSchema, e *TodoSchema) {
+				n.Edges.Children = append(n.Edges.Children, e)
+				if !e.Edges.loadedTypes[2] {
+					e.Edges.Parent = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withBlocks; query != nil {
+		if err := tsq.loadBlocks(ctx, query, nodes,
+			func(n *TodoSchema) { n.Edges.Blocks = []*TodoSchema{} },
+			func(n *TodoSchema, e *TodoSchema) { n.Edges.Blocks = append(n.Edges.Blocks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withBlockedBy; query != nil {
+		if err := tsq.loadBlockedBy(ctx, query, nodes,
+			func(n *TodoSchema) { n.Edges.BlockedBy = []*TodoSchema{} },
+			func(n *TodoSchema, e *TodoSchema) { n.Edges.BlockedBy = append(n.Edges.BlockedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withComments; query != nil {
+		if err := tsq.loadComments(ctx, query, nodes,
+			func(n *TodoSchema) { n.Edges.Comments = []*CommentSchema{} },
+			func(n *TodoSchema, e *CommentSchema) {
+				n.Edges.Comments = append(n.Edges.Comments, e)
+				if !e.Edges.loadedTypes[0] {
+					e.Edges.Todo = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := tsq.withAttachments; query != nil {
+		if err := tsq.loadAttachments(ctx, query, nodes,
+			func(n *TodoSchema) { n.Edges.Attachments = []*AttachmentSchema{} },
+			func(n *TodoSchema, e *AttachmentSchema) {
+				n.Edges.Attachments = append(n.Edges.Attachments, e)
+				if !e.Edges.loadedTypes[0] {
+					e.Edges.Todo = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range tsq.withNamedTags {
+		if err := tsq.loadTags(ctx, query, nodes,
+			func(n *TodoSchema) { n.appendNamedTags(name) },
+			func(n *TodoSchema, e *TagSchema) { n.appendNamedTags(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range tsq.withNamedChildren {
+		if err := tsq.loadChildren(ctx, query, nodes,
+			func(n *TodoSchema) { n.appendNamedChildren(name) },
+			func(n *TodoSchema, e *TodoSchema) {
+				n.appendNamedChildren(name, e)
+				if !e.Edges.loadedTypes[2] {
+					e.Edges.Parent = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range tsq.withNamedBlocks {
+		if err := tsq.loadBlocks(ctx, query, nodes,
+			func(n *TodoSchema) { n.appendNamedBlocks(name) },
+			func(n *TodoSchema, e *TodoSchema) { n.appendNamedBlocks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range tsq.withNamedBlockedBy {
+		if err := tsq.loadBlockedBy(ctx, query, nodes,
+			func(n *TodoSchema) { n.appendNamedBlockedBy(name) },
+			func(n *TodoSchema, e *TodoSchema) { n.appendNamedBlockedBy(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range tsq.withNamedComments {
+		if err := tsq.loadComments(ctx, query, nodes,
+			func(n *TodoSchema) { n.appendNamedComments(name) },
+			func(n *TodoSchema, e *CommentSchema) {
+				n.appendNamedComments(name, e)
+				if !e.Edges.loadedTypes[0] {
+					e.Edges.Todo = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range tsq.withNamedAttachments {
+		if err := tsq.loadAttachments(ctx, query, nodes,
+			func(n *TodoSchema) { n.appendNamedAttachments(name) },
+			func(n *TodoSchema, e *AttachmentSchema) {
+				n.appendNamedAttachments(name, e)
+				if !e.Edges.loadedTypes[0] {
+					e.Edges.Todo = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for i := range tsq.loadTotal {
+		if err := tsq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (tsq *TodoSchemaQuery) loadProject(ctx context.Context, query *ProjectSchemaQuery, nodes []*TodoSchema, init func(*TodoSchema), assign func(*TodoSchema, *ProjectSchema)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TodoSchema)
+	for i := range nodes {
+		if nodes[i].ProjectID == nil {
+			continue
+		}
+		fk := *nodes[i].ProjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(projectschema.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tsq *TodoSchemaQuery) loadTags(ctx context.Context, query *TagSchemaQuery, nodes []*TodoSchema, init func(*TodoSchema), assign func(*TodoSchema, *TagSchema)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*TodoSchema)
+	nids := make(map[uuid.UUID]map[*TodoSchema]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(todoschema.TagsTable)
+		joinT.Schema(tsq.schemaConfig.TodoSchemaTags)
+		s.Join(joinT).On(s.C(tagschema.FieldID), joinT.C(todoschema.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(todoschema.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(todoschema.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*TodoSchema]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*TagSchema](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (tsq *TodoSchemaQuery) loadParent(ctx context.Context, query *TodoSchemaQuery, nodes []*TodoSchema, init func(*TodoSchema), assign func(*TodoSchema, *TodoSchema)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TodoSchema)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(todoschema.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tsq *TodoSchemaQuery) loadChildren(ctx context.Context, query *TodoSchemaQuery, nodes []*TodoSchema, init func(*TodoSchema), assign func(*TodoSchema, *TodoSchema)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TodoSchema)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(todoschema.FieldParentID)
+	}
+	query.Where(predicate.TodoSchema(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(todoschema.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tsq *TodoSchemaQuery) loadBlocks(ctx context.Context, query *TodoSchemaQuery, nodes []*TodoSchema, init func(*TodoSchema), assign func(*TodoSchema, *TodoSchema)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*TodoSchema)
+	nids := make(map[uuid.UUID]map[*TodoSchema]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(todoschema.BlocksTable)
+		joinT.Schema(tsq.schemaConfig.TodoSchemaBlockedBy)
+		s.Join(joinT).On(s.C(todoschema.FieldID), joinT.C(todoschema.BlocksPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(todoschema.BlocksPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(todoschema.BlocksPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*TodoSchema]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*TodoSchema](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocks" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (tsq *TodoSchemaQuery) loadBlockedBy(ctx context.Context, query *TodoSchemaQuery, nodes []*TodoSchema, init func(*TodoSchema), assign func(*TodoSchema, *TodoSchema)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*TodoSchema)
+	nids := make(map[uuid.UUID]map[*TodoSchema]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(todoschema.BlockedByTable)
+		joinT.Schema(tsq.schemaConfig.TodoSchemaBlockedBy)
+		s.Join(joinT).On(s.C(todoschema.FieldID), joinT.C(todoschema.BlockedByPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(todoschema.BlockedByPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(todoschema.BlockedByPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*TodoSchema]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*TodoSchema](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocked_by" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (tsq *TodoSchemaQuery) loadComments(ctx context.Context, query *CommentSchemaQuery, nodes []*TodoSchema, init func(*TodoSchema), assign func(*TodoSchema, *CommentSchema)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TodoSchema)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(commentschema.FieldTodoID)
+	}
+	query.Where(predicate.CommentSchema(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(todoschema.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TodoID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "todo_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tsq *TodoSchemaQuery) loadAttachments(ctx context.Context, query *AttachmentSchemaQuery, nodes []*TodoSchema, init func(*TodoSchema), assign func(*TodoSchema, *AttachmentSchema)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TodoSchema)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachmentschema.FieldTodoID)
+	}
+	query.Where(predicate.AttachmentSchema(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(todoschema.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TodoID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "todo_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (tsq *TodoSchemaQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tsq.querySpec()
 	_spec.Node.Schema = tsq.schemaConfig.TodoSchema
@@ -395,6 +1197,12 @@ func (tsq *TodoSchemaQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if tsq.withProject != nil {
+			_spec.Node.AddColumnOnce(todoschema.FieldProjectID)
+		}
+		if tsq.withParent != nil {
+			_spec.Node.AddColumnOnce(todoschema.FieldParentID)
+		}
 	}
 	if ps := tsq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -489,6 +1297,90 @@ func (tsq *TodoSchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *TodoSche
 	return tsq.Select()
 }
 
+// WithNamedTags tells the query-builder to eager-load the nodes that are connected to the "tags"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithNamedTags(name string, opts ...func(*TagSchemaQuery)) *TodoSchemaQuery {
+	query := (&TagSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if tsq.withNamedTags == nil {
+		tsq.withNamedTags = make(map[string]*TagSchemaQuery)
+	}
+	tsq.withNamedTags[name] = query
+	return tsq
+}
+
+// WithNamedChildren tells the query-builder to eager-load the nodes that are connected to the "children"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithNamedChildren(name string, opts ...func(*TodoSchemaQuery)) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if tsq.withNamedChildren == nil {
+		tsq.withNamedChildren = make(map[string]*TodoSchemaQuery)
+	}
+	tsq.withNamedChildren[name] = query
+	return tsq
+}
+
+// WithNamedBlocks tells the query-builder to eager-load the nodes that are connected to the "blocks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithNamedBlocks(name string, opts ...func(*TodoSchemaQuery)) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if tsq.withNamedBlocks == nil {
+		tsq.withNamedBlocks = make(map[string]*TodoSchemaQuery)
+	}
+	tsq.withNamedBlocks[name] = query
+	return tsq
+}
+
+// WithNamedBlockedBy tells the query-builder to eager-load the nodes that are connected to the "blocked_by"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithNamedBlockedBy(name string, opts ...func(*TodoSchemaQuery)) *TodoSchemaQuery {
+	query := (&TodoSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if tsq.withNamedBlockedBy == nil {
+		tsq.withNamedBlockedBy = make(map[string]*TodoSchemaQuery)
+	}
+	tsq.withNamedBlockedBy[name] = query
+	return tsq
+}
+
+// WithNamedComments tells the query-builder to eager-load the nodes that are connected to the "comments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithNamedComments(name string, opts ...func(*CommentSchemaQuery)) *TodoSchemaQuery {
+	query := (&CommentSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if tsq.withNamedComments == nil {
+		tsq.withNamedComments = make(map[string]*CommentSchemaQuery)
+	}
+	tsq.withNamedComments[name] = query
+	return tsq
+}
+
+// WithNamedAttachments tells the query-builder to eager-load the nodes that are connected to the "attachments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TodoSchemaQuery) WithNamedAttachments(name string, opts ...func(*AttachmentSchemaQuery)) *TodoSchemaQuery {
+	query := (&AttachmentSchemaClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if tsq.withNamedAttachments == nil {
+		tsq.withNamedAttachments = make(map[string]*AttachmentSchemaQuery)
+	}
+	tsq.withNamedAttachments[name] = query
+	return tsq
+}
+
 // TodoSchemaGroupBy is the group-by builder for TodoSchema entities.
 type TodoSchemaGroupBy struct {
 	selector
@@ -11,8 +11,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
 )
 
@@ -138,11 +143,309 @@ func (tsu *TodoSchemaUpdate) ClearDeletedAt() *TodoSchemaUpdate {
 	return tsu
 }
 
+// SetParentID sets the "parent_id" field.
+func (tsu *TodoSchemaUpdate) SetParentID(u uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.SetParentID(u)
+	return tsu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tsu *TodoSchemaUpdate) SetNillableParentID(u *uuid.UUID) *TodoSchemaUpdate {
+	if u != nil {
+		tsu.SetParentID(*u)
+	}
+	return tsu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tsu *TodoSchemaUpdate) ClearParentID() *TodoSchemaUpdate {
+	tsu.mutation.ClearParentID()
+	return tsu
+}
+
+// SetProjectID sets the "project_id" field.
+func (tsu *TodoSchemaUpdate) SetProjectID(u uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.SetProjectID(u)
+	return tsu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tsu *TodoSchemaUpdate) SetNillableProjectID(u *uuid.UUID) *TodoSchemaUpdate {
+	if u != nil {
+		tsu.SetProjectID(*u)
+	}
+	return tsu
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tsu *TodoSchemaUpdate) ClearProjectID() *TodoSchemaUpdate {
+	tsu.mutation.ClearProjectID()
+	return tsu
+}
+
+// SetStatusID sets the "status_id" field.
+func (tsu *TodoSchemaUpdate) SetStatusID(s string) *TodoSchemaUpdate {
+	tsu.mutation.SetStatusID(s)
+	return tsu
+}
+
+// SetNillableStatusID sets the "status_id" field if the given value is not nil.
+func (tsu *TodoSchemaUpdate) SetNillableStatusID(s *string) *TodoSchemaUpdate {
+	if s != nil {
+		tsu.SetStatusID(*s)
+	}
+	return tsu
+}
+
+// ClearStatusID clears the value of the "status_id" field.
+func (tsu *TodoSchemaUpdate) ClearStatusID() *TodoSchemaUpdate {
+	tsu.mutation.ClearStatusID()
+	return tsu
+}
+
+// SetProject sets the "project" edge to the ProjectSchema entity.
+func (tsu *TodoSchemaUpdate) SetProject(p *ProjectSchema) *TodoSchemaUpdate {
+	return tsu.SetProjectID(p.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the TagSchema entity by IDs.
+func (tsu *TodoSchemaUpdate) AddTagIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.AddTagIDs(ids...)
+	return tsu
+}
+
+// AddTags adds the "tags" edges to the TagSchema entity.
+func (tsu *TodoSchemaUpdate) AddTags(t ...*TagSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.AddTagIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the TodoSchema entity.
+func (tsu *TodoSchemaUpdate) SetParent(t *TodoSchema) *TodoSchemaUpdate {
+	return tsu.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the TodoSchema entity by IDs.
+func (tsu *TodoSchemaUpdate) AddChildIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.AddChildIDs(ids...)
+	return tsu
+}
+
+// AddChildren adds the "children" edges to the TodoSchema entity.
+func (tsu *TodoSchemaUpdate) AddChildren(t ...*TodoSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.AddChildIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the TodoSchema entity by IDs.
+func (tsu *TodoSchemaUpdate) AddBlockIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.AddBlockIDs(ids...)
+	return tsu
+}
+
+// AddBlocks adds the "blocks" edges to the TodoSchema entity.
+func (tsu *TodoSchemaUpdate) AddBlocks(t ...*TodoSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the TodoSchema entity by IDs.
+func (tsu *TodoSchemaUpdate) AddBlockedByIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.AddBlockedByIDs(ids...)
+	return tsu
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the TodoSchema entity.
+func (tsu *TodoSchemaUpdate) AddBlockedBy(t ...*TodoSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.AddBlockedByIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the CommentSchema entity by IDs.
+func (tsu *TodoSchemaUpdate) AddCommentIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.AddCommentIDs(ids...)
+	return tsu
+}
+
+// AddComments adds the "comments" edges to the CommentSchema entity.
+func (tsu *TodoSchemaUpdate) AddComments(c ...*CommentSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tsu.AddCommentIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the AttachmentSchema entity by IDs.
+func (tsu *TodoSchemaUpdate) AddAttachmentIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.AddAttachmentIDs(ids...)
+	return tsu
+}
+
+// AddAttachments adds the "attachments" edges to the AttachmentSchema entity.
+func (tsu *TodoSchemaUpdate) AddAttachments(a ...*AttachmentSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tsu.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TodoSchemaMutation object of the builder.
 func (tsu *TodoSchemaUpdate) Mutation() *TodoSchemaMutation {
 	return tsu.mutation
 }
 
+// ClearProject clears the "project" edge to the ProjectSchema entity.
+func (tsu *TodoSchemaUpdate) ClearProject() *TodoSchemaUpdate {
+	tsu.mutation.ClearProject()
+	return tsu
+}
+
+// ClearTags clears all "tags" edges to the TagSchema entity.
+func (tsu *TodoSchemaUpdate) ClearTags() *TodoSchemaUpdate {
+	tsu.mutation.ClearTags()
+	return tsu
+}
+
+// RemoveTagIDs removes the "tags" edge to TagSchema entities by IDs.
+func (tsu *TodoSchemaUpdate) RemoveTagIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.RemoveTagIDs(ids...)
+	return tsu
+}
+
+// RemoveTags removes "tags" edges to TagSchema entities.
+func (tsu *TodoSchemaUpdate) RemoveTags(t ...*TagSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.RemoveTagIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the TodoSchema entity.
+func (tsu *TodoSchemaUpdate) ClearParent() *TodoSchemaUpdate {
+	tsu.mutation.ClearParent()
+	return tsu
+}
+
+// ClearChildren clears all "children" edges to the TodoSchema entity.
+func (tsu *TodoSchemaUpdate) ClearChildren() *TodoSchemaUpdate {
+	tsu.mutation.ClearChildren()
+	return tsu
+}
+
+// RemoveChildIDs removes the "children" edge to TodoSchema entities by IDs.
+func (tsu *TodoSchemaUpdate) RemoveChildIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.RemoveChildIDs(ids...)
+	return tsu
+}
+
+// RemoveChildren removes "children" edges to TodoSchema entities.
+func (tsu *TodoSchemaUpdate) RemoveChildren(t ...*TodoSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.RemoveChildIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the TodoSchema entity.
+func (tsu *TodoSchemaUpdate) ClearBlocks() *TodoSchemaUpdate {
+	tsu.mutation.ClearBlocks()
+	return tsu
+}
+
+// RemoveBlockIDs removes the "blocks" edge to TodoSchema entities by IDs.
+func (tsu *TodoSchemaUpdate) RemoveBlockIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.RemoveBlockIDs(ids...)
+	return tsu
+}
+
+// RemoveBlocks removes "blocks" edges to TodoSchema entities.
+func (tsu *TodoSchemaUpdate) RemoveBlocks(t ...*TodoSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.RemoveBlockIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the TodoSchema entity.
+func (tsu *TodoSchemaUpdate) ClearBlockedBy() *TodoSchemaUpdate {
+	tsu.mutation.ClearBlockedBy()
+	return tsu
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to TodoSchema entities by IDs.
+func (tsu *TodoSchemaUpdate) RemoveBlockedByIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.RemoveBlockedByIDs(ids...)
+	return tsu
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to TodoSchema entities.
+func (tsu *TodoSchemaUpdate) RemoveBlockedBy(t ...*TodoSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsu.RemoveBlockedByIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the CommentSchema entity.
+func (tsu *TodoSchemaUpdate) ClearComments() *TodoSchemaUpdate {
+	tsu.mutation.ClearComments()
+	return tsu
+}
+
+// RemoveCommentIDs removes the "comments" edge to CommentSchema entities by IDs.
+func (tsu *TodoSchemaUpdate) RemoveCommentIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.RemoveCommentIDs(ids...)
+	return tsu
+}
+
+// RemoveComments removes "comments" edges to CommentSchema entities.
+func (tsu *TodoSchemaUpdate) RemoveComments(c ...*CommentSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tsu.RemoveCommentIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the AttachmentSchema entity.
+func (tsu *TodoSchemaUpdate) ClearAttachments() *TodoSchemaUpdate {
+	tsu.mutation.ClearAttachments()
+	return tsu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to AttachmentSchema entities by IDs.
+func (tsu *TodoSchemaUpdate) RemoveAttachmentIDs(ids ...uuid.UUID) *TodoSchemaUpdate {
+	tsu.mutation.RemoveAttachmentIDs(ids...)
+	return tsu
+}
+
+// RemoveAttachments removes "attachments" edges to AttachmentSchema entities.
+func (tsu *TodoSchemaUpdate) RemoveAttachments(a ...*AttachmentSchema) *TodoSchemaUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tsu.RemoveAttachmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tsu *TodoSchemaUpdate) Save(ctx context.Context) (int, error) {
 	tsu.defaults()
@@ -242,6 +545,362 @@ func (tsu *TodoSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tsu.mutation.DeletedAtCleared() {
 		_spec.ClearField(todoschema.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := tsu.mutation.StatusID(); ok {
+		_spec.SetField(todoschema.FieldStatusID, field.TypeString, value)
+	}
+	if tsu.mutation.StatusIDCleared() {
+		_spec.ClearField(todoschema.FieldStatusID, field.TypeString)
+	}
+	if tsu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ProjectTable,
+			Columns: []string{todoschema.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ProjectTable,
+			Columns: []string{todoschema.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.TagsTable,
+			Columns: todoschema.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaTags
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tsu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.TagsTable,
+			Columns: todoschema.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.TagsTable,
+			Columns: todoschema.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ParentTable,
+			Columns: []string{todoschema.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ParentTable,
+			Columns: []string{todoschema.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.ChildrenTable,
+			Columns: []string{todoschema.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tsu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.ChildrenTable,
+			Columns: []string{todoschema.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.ChildrenTable,
+			Columns: []string{todoschema.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoschema.BlocksTable,
+			Columns: todoschema.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaBlockedBy
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !tsu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoschema.BlocksTable,
+			Columns: todoschema.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoschema.BlocksTable,
+			Columns: todoschema.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.BlockedByTable,
+			Columns: todoschema.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaBlockedBy
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !tsu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.BlockedByTable,
+			Columns: todoschema.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.BlockedByTable,
+			Columns: todoschema.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.CommentsTable,
+			Columns: []string{todoschema.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.CommentSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tsu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.CommentsTable,
+			Columns: []string{todoschema.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.CommentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.CommentsTable,
+			Columns: []string{todoschema.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.CommentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.AttachmentsTable,
+			Columns: []string{todoschema.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.AttachmentSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !tsu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.AttachmentsTable,
+			Columns: []string{todoschema.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.AttachmentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.AttachmentsTable,
+			Columns: []string{todoschema.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsu.schemaConfig.AttachmentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = tsu.schemaConfig.TodoSchema
 	ctx = internal.NewSchemaConfigContext(ctx, tsu.schemaConfig)
 	_spec.AddModifiers(tsu.modifiers...)
@@ -374,11 +1033,309 @@ func (tsuo *TodoSchemaUpdateOne) ClearDeletedAt() *TodoSchemaUpdateOne {
 	return tsuo
 }
 
+// SetParentID sets the "parent_id" field.
+func (tsuo *TodoSchemaUpdateOne) SetParentID(u uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.SetParentID(u)
+	return tsuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tsuo *TodoSchemaUpdateOne) SetNillableParentID(u *uuid.UUID) *TodoSchemaUpdateOne {
+	if u != nil {
+		tsuo.SetParentID(*u)
+	}
+	return tsuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tsuo *TodoSchemaUpdateOne) ClearParentID() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearParentID()
+	return tsuo
+}
+
+// SetProjectID sets the "project_id" field.
+func (tsuo *TodoSchemaUpdateOne) SetProjectID(u uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.SetProjectID(u)
+	return tsuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tsuo *TodoSchemaUpdateOne) SetNillableProjectID(u *uuid.UUID) *TodoSchemaUpdateOne {
+	if u != nil {
+		tsuo.SetProjectID(*u)
+	}
+	return tsuo
+}
+
+// ClearProjectID clears the value of the "project_id" field.
+func (tsuo *TodoSchemaUpdateOne) ClearProjectID() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearProjectID()
+	return tsuo
+}
+
+// SetStatusID sets the "status_id" field.
+func (tsuo *TodoSchemaUpdateOne) SetStatusID(s string) *TodoSchemaUpdateOne {
+	tsuo.mutation.SetStatusID(s)
+	return tsuo
+}
+
+// SetNillableStatusID sets the "status_id" field if the given value is not nil.
+func (tsuo *TodoSchemaUpdateOne) SetNillableStatusID(s *string) *TodoSchemaUpdateOne {
+	if s != nil {
+		tsuo.SetStatusID(*s)
+	}
+	return tsuo
+}
+
+// ClearStatusID clears the value of the "status_id" field.
+func (tsuo *TodoSchemaUpdateOne) ClearStatusID() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearStatusID()
+	return tsuo
+}
+
+// SetProject sets the "project" edge to the ProjectSchema entity.
+func (tsuo *TodoSchemaUpdateOne) SetProject(p *ProjectSchema) *TodoSchemaUpdateOne {
+	return tsuo.SetProjectID(p.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the TagSchema entity by IDs.
+func (tsuo *TodoSchemaUpdateOne) AddTagIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.AddTagIDs(ids...)
+	return tsuo
+}
+
+// AddTags adds the "tags" edges to the TagSchema entity.
+func (tsuo *TodoSchemaUpdateOne) AddTags(t ...*TagSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.AddTagIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the TodoSchema entity.
+func (tsuo *TodoSchemaUpdateOne) SetParent(t *TodoSchema) *TodoSchemaUpdateOne {
+	return tsuo.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the TodoSchema entity by IDs.
+func (tsuo *TodoSchemaUpdateOne) AddChildIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.AddChildIDs(ids...)
+	return tsuo
+}
+
+// AddChildren adds the "children" edges to the TodoSchema entity.
+func (tsuo *TodoSchemaUpdateOne) AddChildren(t ...*TodoSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.AddChildIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the TodoSchema entity by IDs.
+func (tsuo *TodoSchemaUpdateOne) AddBlockIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.AddBlockIDs(ids...)
+	return tsuo
+}
+
+// AddBlocks adds the "blocks" edges to the TodoSchema entity.
+func (tsuo *TodoSchemaUpdateOne) AddBlocks(t ...*TodoSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.AddBlockIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the TodoSchema entity by IDs.
+func (tsuo *TodoSchemaUpdateOne) AddBlockedByIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.AddBlockedByIDs(ids...)
+	return tsuo
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the TodoSchema entity.
+func (tsuo *TodoSchemaUpdateOne) AddBlockedBy(t ...*TodoSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.AddBlockedByIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the CommentSchema entity by IDs.
+func (tsuo *TodoSchemaUpdateOne) AddCommentIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.AddCommentIDs(ids...)
+	return tsuo
+}
+
+// AddComments adds the "comments" edges to the CommentSchema entity.
+func (tsuo *TodoSchemaUpdateOne) AddComments(c ...*CommentSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tsuo.AddCommentIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the AttachmentSchema entity by IDs.
+func (tsuo *TodoSchemaUpdateOne) AddAttachmentIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.AddAttachmentIDs(ids...)
+	return tsuo
+}
+
+// AddAttachments adds the "attachments" edges to the AttachmentSchema entity.
+func (tsuo *TodoSchemaUpdateOne) AddAttachments(a ...*AttachmentSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tsuo.AddAttachmentIDs(ids...)
+}
+
 // Mutation returns the TodoSchemaMutation object of the builder.
 func (tsuo *TodoSchemaUpdateOne) Mutation() *TodoSchemaMutation {
 	return tsuo.mutation
 }
 
+// ClearProject clears the "project" edge to the ProjectSchema entity.
+func (tsuo *TodoSchemaUpdateOne) ClearProject() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearProject()
+	return tsuo
+}
+
+// ClearTags clears all "tags" edges to the TagSchema entity.
+func (tsuo *TodoSchemaUpdateOne) ClearTags() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearTags()
+	return tsuo
+}
+
+// RemoveTagIDs removes the "tags" edge to TagSchema entities by IDs.
+func (tsuo *TodoSchemaUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.RemoveTagIDs(ids...)
+	return tsuo
+}
+
+// RemoveTags removes "tags" edges to TagSchema entities.
+func (tsuo *TodoSchemaUpdateOne) RemoveTags(t ...*TagSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.RemoveTagIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the TodoSchema entity.
+func (tsuo *TodoSchemaUpdateOne) ClearParent() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearParent()
+	return tsuo
+}
+
+// ClearChildren clears all "children" edges to the TodoSchema entity.
+func (tsuo *TodoSchemaUpdateOne) ClearChildren() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearChildren()
+	return tsuo
+}
+
+// RemoveChildIDs removes the "children" edge to TodoSchema entities by IDs.
+func (tsuo *TodoSchemaUpdateOne) RemoveChildIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.RemoveChildIDs(ids...)
+	return tsuo
+}
+
+// RemoveChildren removes "children" edges to TodoSchema entities.
+func (tsuo *TodoSchemaUpdateOne) RemoveChildren(t ...*TodoSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.RemoveChildIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the TodoSchema entity.
+func (tsuo *TodoSchemaUpdateOne) ClearBlocks() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearBlocks()
+	return tsuo
+}
+
+// RemoveBlockIDs removes the "blocks" edge to TodoSchema entities by IDs.
+func (tsuo *TodoSchemaUpdateOne) RemoveBlockIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.RemoveBlockIDs(ids...)
+	return tsuo
+}
+
+// RemoveBlocks removes "blocks" edges to TodoSchema entities.
+func (tsuo *TodoSchemaUpdateOne) RemoveBlocks(t ...*TodoSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.RemoveBlockIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the TodoSchema entity.
+func (tsuo *TodoSchemaUpdateOne) ClearBlockedBy() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearBlockedBy()
+	return tsuo
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to TodoSchema entities by IDs.
+func (tsuo *TodoSchemaUpdateOne) RemoveBlockedByIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.RemoveBlockedByIDs(ids...)
+	return tsuo
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to TodoSchema entities.
+func (tsuo *TodoSchemaUpdateOne) RemoveBlockedBy(t ...*TodoSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tsuo.RemoveBlockedByIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the CommentSchema entity.
+func (tsuo *TodoSchemaUpdateOne) ClearComments() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearComments()
+	return tsuo
+}
+
+// RemoveCommentIDs removes the "comments" edge to CommentSchema entities by IDs.
+func (tsuo *TodoSchemaUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.RemoveCommentIDs(ids...)
+	return tsuo
+}
+
+// RemoveComments removes "comments" edges to CommentSchema entities.
+func (tsuo *TodoSchemaUpdateOne) RemoveComments(c ...*CommentSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tsuo.RemoveCommentIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the AttachmentSchema entity.
+func (tsuo *TodoSchemaUpdateOne) ClearAttachments() *TodoSchemaUpdateOne {
+	tsuo.mutation.ClearAttachments()
+	return tsuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to AttachmentSchema entities by IDs.
+func (tsuo *TodoSchemaUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *TodoSchemaUpdateOne {
+	tsuo.mutation.RemoveAttachmentIDs(ids...)
+	return tsuo
+}
+
+// RemoveAttachments removes "attachments" edges to AttachmentSchema entities.
+func (tsuo *TodoSchemaUpdateOne) RemoveAttachments(a ...*AttachmentSchema) *TodoSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tsuo.RemoveAttachmentIDs(ids...)
+}
+
 // Where appends a list predicates to the TodoSchemaUpdate builder.
 func (tsuo *TodoSchemaUpdateOne) Where(ps ...predicate.TodoSchema) *TodoSchemaUpdateOne {
 	tsuo.mutation.Where(ps...)
@@ -508,6 +1465,362 @@ func (tsuo *TodoSchemaUpdateOne) sqlSave(ctx context.Context) (_node *TodoSchema
 	if tsuo.mutation.DeletedAtCleared() {
 		_spec.ClearField(todoschema.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := tsuo.mutation.StatusID(); ok {
+		_spec.SetField(todoschema.FieldStatusID, field.TypeString, value)
+	}
+	if tsuo.mutation.StatusIDCleared() {
+		_spec.ClearField(todoschema.FieldStatusID, field.TypeString)
+	}
+	if tsuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ProjectTable,
+			Columns: []string{todoschema.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ProjectTable,
+			Columns: []string{todoschema.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.TagsTable,
+			Columns: todoschema.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaTags
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tsuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.TagsTable,
+			Columns: todoschema.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.TagsTable,
+			Columns: todoschema.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaTags
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ParentTable,
+			Columns: []string{todoschema.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   todoschema.ParentTable,
+			Columns: []string{todoschema.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.ChildrenTable,
+			Columns: []string{todoschema.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tsuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.ChildrenTable,
+			Columns: []string{todoschema.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.ChildrenTable,
+			Columns: []string{todoschema.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoschema.BlocksTable,
+			Columns: todoschema.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaBlockedBy
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !tsuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoschema.BlocksTable,
+			Columns: todoschema.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   todoschema.BlocksTable,
+			Columns: todoschema.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.BlockedByTable,
+			Columns: todoschema.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaBlockedBy
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !tsuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.BlockedByTable,
+			Columns: todoschema.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   todoschema.BlockedByTable,
+			Columns: todoschema.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(todoschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.TodoSchemaBlockedBy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.CommentsTable,
+			Columns: []string{todoschema.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.CommentSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tsuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.CommentsTable,
+			Columns: []string{todoschema.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.CommentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.CommentsTable,
+			Columns: []string{todoschema.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.CommentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tsuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.AttachmentsTable,
+			Columns: []string{todoschema.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.AttachmentSchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !tsuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.AttachmentsTable,
+			Columns: []string{todoschema.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.AttachmentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   todoschema.AttachmentsTable,
+			Columns: []string{todoschema.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachmentschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = tsuo.schemaConfig.AttachmentSchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = tsuo.schemaConfig.TodoSchema
 	ctx = internal.NewSchemaConfigContext(ctx, tsuo.schemaConfig)
 	_spec.AddModifiers(tsuo.modifiers...)
@@ -14,10 +14,26 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AttachmentSchema is the client for interacting with the AttachmentSchema builders.
+	AttachmentSchema *AttachmentSchemaClient
+	// CommentSchema is the client for interacting with the CommentSchema builders.
+	CommentSchema *CommentSchemaClient
+	// ExternalRefSchema is the client for interacting with the ExternalRefSchema builders.
+	ExternalRefSchema *ExternalRefSchemaClient
 	// ProjectSchema is the client for interacting with the ProjectSchema builders.
 	ProjectSchema *ProjectSchemaClient
+	// TagSchema is the client for interacting with the TagSchema builders.
+	TagSchema *TagSchemaClient
+	// TodoHistorySchema is the client for interacting with the TodoHistorySchema builders.
+	TodoHistorySchema *TodoHistorySchemaClient
 	// TodoSchema is the client for interacting with the TodoSchema builders.
 	TodoSchema *TodoSchemaClient
+	// WebhookDeliverySchema is the client for interacting with the WebhookDeliverySchema builders.
+	WebhookDeliverySchema *WebhookDeliverySchemaClient
+	// WebhookEventSchema is the client for interacting with the WebhookEventSchema builders.
+	WebhookEventSchema *WebhookEventSchemaClient
+	// WebhookSchema is the client for interacting with the WebhookSchema builders.
+	WebhookSchema *WebhookSchemaClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,8 +165,16 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AttachmentSchema = NewAttachmentSchemaClient(tx.config)
+	tx.CommentSchema = NewCommentSchemaClient(tx.config)
+	tx.ExternalRefSchema = NewExternalRefSchemaClient(tx.config)
 	tx.ProjectSchema = NewProjectSchemaClient(tx.config)
+	tx.TagSchema = NewTagSchemaClient(tx.config)
+	tx.TodoHistorySchema = NewTodoHistorySchemaClient(tx.config)
 	tx.TodoSchema = NewTodoSchemaClient(tx.config)
+	tx.WebhookDeliverySchema = NewWebhookDeliverySchemaClient(tx.config)
+	tx.WebhookEventSchema = NewWebhookEventSchemaClient(tx.config)
+	tx.WebhookSchema = NewWebhookSchemaClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -160,7 +184,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ProjectSchema.QueryXXX(), the query will be executed
+// applies a query, for example: AttachmentSchema.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
+)
+
+// WebhookDeliverySchema is the model entity for the WebhookDeliverySchema schema.
+type WebhookDeliverySchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WebhookID holds the value of the "webhook_id" field.
+	WebhookID uuid.UUID `json:"webhook_id,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID uuid.UUID `json:"event_id,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType string `json:"event_type,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload []byte `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status webhookdeliveryschema.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ResponseStatus holds the value of the "response_status" field.
+	ResponseStatus int `json:"response_status,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookDeliverySchemaQuery when eager-loading is set.
+	Edges        WebhookDeliverySchemaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookDeliverySchemaEdges holds the relations/edges for other nodes in the graph.
+type WebhookDeliverySchemaEdges struct {
+	// Webhook holds the value of the webhook edge.
+	Webhook *WebhookSchema `json:"webhook,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WebhookOrErr returns the Webhook value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliverySchemaEdges) WebhookOrErr() (*WebhookSchema, error) {
+	if e.Webhook != nil {
+		return e.Webhook, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: webhookschema.Label}
+	}
+	return nil, &NotLoadedError{edge: "webhook"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDeliverySchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdeliveryschema.FieldPayload:
+			values[i] = new([]byte)
+		case webhookdeliveryschema.FieldAttempts, webhookdeliveryschema.FieldResponseStatus:
+			values[i] = new(sql.NullInt64)
+		case webhookdeliveryschema.FieldEventType, webhookdeliveryschema.FieldStatus, webhookdeliveryschema.FieldLastError:
+			values[i] = new(sql.NullString)
+		case webhookdeliveryschema.FieldNextAttemptAt, webhookdeliveryschema.FieldCreatedAt, webhookdeliveryschema.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case webhookdeliveryschema.FieldID, webhookdeliveryschema.FieldWebhookID, webhookdeliveryschema.FieldEventID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDeliverySchema fields.
+func (wds *WebhookDeliverySchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdeliveryschema.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wds.ID = *value
+			}
+		case webhookdeliveryschema.FieldWebhookID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_id", values[i])
+			} else if value != nil {
+				wds.WebhookID = *value
+			}
+		case webhookdeliveryschema.FieldEventID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value != nil {
+				wds.EventID = *value
+			}
+		case webhookdeliveryschema.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				wds.EventType = value.String
+			}
+		case webhookdeliveryschema.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				wds.Payload = *value
+			}
+		case webhookdeliveryschema.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wds.Status = webhookdeliveryschema.Status(value.String)
+			}
+		case webhookdeliveryschema.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				wds.Attempts = int(value.Int64)
+			}
+		case webhookdeliveryschema.FieldResponseStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_status", values[i])
+			} else if value.Valid {
+				wds.ResponseStatus = int(value.Int64)
+			}
+		case webhookdeliveryschema.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				wds.LastError = value.String
+			}
+		case webhookdeliveryschema.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				wds.NextAttemptAt = value.Time
+			}
+		case webhookdeliveryschema.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wds.CreatedAt = value.Time
+			}
+		case webhookdeliveryschema.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wds.UpdatedAt = value.Time
+			}
+		default:
+			wds.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookDeliverySchema.
+// This includes values selected through modifiers, order, etc.
+func (wds *WebhookDeliverySchema) Value(name string) (ent.Value, error) {
+	return wds.selectValues.Get(name)
+}
+
+// QueryWebhook queries the "webhook" edge of the WebhookDeliverySchema entity.
+func (wds *WebhookDeliverySchema) QueryWebhook() *WebhookSchemaQuery {
+	return NewWebhookDeliverySchemaClient(wds.config).QueryWebhook(wds)
+}
+
+// Update returns a builder for updating this WebhookDeliverySchema.
+// Note that you need to call WebhookDeliverySchema.Unwrap() before calling this method if this WebhookDeliverySchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wds *WebhookDeliverySchema) Update() *WebhookDeliverySchemaUpdateOne {
+	return NewWebhookDeliverySchemaClient(wds.config).UpdateOne(wds)
+}
+
+// Unwrap unwraps the WebhookDeliverySchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wds *WebhookDeliverySchema) Unwrap() *WebhookDeliverySchema {
+	_tx, ok := wds.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: WebhookDeliverySchema is not a transactional entity")
+	}
+	wds.config.driver = _tx.drv
+	return wds
+}
+
+// String implements the fmt.Stringer.
+func (wds *WebhookDeliverySchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDeliverySchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wds.ID))
+	builder.WriteString("webhook_id=")
+	builder.WriteString(fmt.Sprintf("%v", wds.WebhookID))
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", wds.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(wds.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", wds.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", wds.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", wds.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("response_status=")
+	builder.WriteString(fmt.Sprintf("%v", wds.ResponseStatus))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(wds.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(wds.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wds.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wds.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeliverySchemas is a parsable slice of WebhookDeliverySchema.
+type WebhookDeliverySchemas []*WebhookDeliverySchema
@@ -0,0 +1,977 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
+)
+
+// WebhookDeliverySchemaCreate is the builder for creating a WebhookDeliverySchema entity.
+type WebhookDeliverySchemaCreate struct {
+	config
+	mutation *WebhookDeliverySchemaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetWebhookID(u uuid.UUID) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetWebhookID(u)
+	return wdsc
+}
+
+// SetEventID sets the "event_id" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetEventID(u uuid.UUID) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetEventID(u)
+	return wdsc
+}
+
+// SetEventType sets the "event_type" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetEventType(s string) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetEventType(s)
+	return wdsc
+}
+
+// SetPayload sets the "payload" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetPayload(b []byte) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetPayload(b)
+	return wdsc
+}
+
+// SetStatus sets the "status" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetStatus(w webhookdeliveryschema.Status) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetStatus(w)
+	return wdsc
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetAttempts(i int) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetAttempts(i)
+	return wdsc
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetResponseStatus(i int) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetResponseStatus(i)
+	return wdsc
+}
+
+// SetLastError sets the "last_error" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetLastError(s string) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetLastError(s)
+	return wdsc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetNextAttemptAt(t time.Time) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetNextAttemptAt(t)
+	return wdsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetCreatedAt(t time.Time) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetCreatedAt(t)
+	return wdsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdsc *WebhookDeliverySchemaCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeliverySchemaCreate {
+	if t != nil {
+		wdsc.SetCreatedAt(*t)
+	}
+	return wdsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetUpdatedAt(t time.Time) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetUpdatedAt(t)
+	return wdsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wdsc *WebhookDeliverySchemaCreate) SetNillableUpdatedAt(t *time.Time) *WebhookDeliverySchemaCreate {
+	if t != nil {
+		wdsc.SetUpdatedAt(*t)
+	}
+	return wdsc
+}
+
+// SetID sets the "id" field.
+func (wdsc *WebhookDeliverySchemaCreate) SetID(u uuid.UUID) *WebhookDeliverySchemaCreate {
+	wdsc.mutation.SetID(u)
+	return wdsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wdsc *WebhookDeliverySchemaCreate) SetNillableID(u *uuid.UUID) *WebhookDeliverySchemaCreate {
+	if u != nil {
+		wdsc.SetID(*u)
+	}
+	return wdsc
+}
+
+// SetWebhook sets the "webhook" edge to the WebhookSchema entity.
+func (wdsc *WebhookDeliverySchemaCreate) SetWebhook(w *WebhookSchema) *WebhookDeliverySchemaCreate {
+	return wdsc.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliverySchemaMutation object of the builder.
+func (wdsc *WebhookDeliverySchemaCreate) Mutation() *WebhookDeliverySchemaMutation {
+	return wdsc.mutation
+}
+
+// Save creates the WebhookDeliverySchema in the database.
+func (wdsc *WebhookDeliverySchemaCreate) Save(ctx context.Context) (*WebhookDeliverySchema, error) {
+	wdsc.defaults()
+	return withHooks(ctx, wdsc.sqlSave, wdsc.mutation, wdsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdsc *WebhookDeliverySchemaCreate) SaveX(ctx context.Context) *WebhookDeliverySchema {
+	v, err := wdsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdsc *WebhookDeliverySchemaCreate) Exec(ctx context.Context) error {
+	_, err := wdsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdsc *WebhookDeliverySchemaCreate) ExecX(ctx context.Context) {
+	if err := wdsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdsc *WebhookDeliverySchemaCreate) defaults() {
+	if _, ok := wdsc.mutation.CreatedAt(); !ok {
+		v := webhookdeliveryschema.DefaultCreatedAt()
+		wdsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdsc.mutation.UpdatedAt(); !ok {
+		v := webhookdeliveryschema.DefaultUpdatedAt()
+		wdsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wdsc.mutation.ID(); !ok {
+		v := webhookdeliveryschema.DefaultID()
+		wdsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdsc *WebhookDeliverySchemaCreate) check() error {
+	if _, ok := wdsc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook_id", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.webhook_id"`)}
+	}
+	if _, ok := wdsc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.event_id"`)}
+	}
+	if _, ok := wdsc.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.event_type"`)}
+	}
+	if v, ok := wdsc.mutation.EventType(); ok {
+		if err := webhookdeliveryschema.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.event_type": %w`, err)}
+		}
+	}
+	if _, ok := wdsc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.payload"`)}
+	}
+	if _, ok := wdsc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.status"`)}
+	}
+	if v, ok := wdsc.mutation.Status(); ok {
+		if err := webhookdeliveryschema.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.status": %w`, err)}
+		}
+	}
+	if _, ok := wdsc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.attempts"`)}
+	}
+	if v, ok := wdsc.mutation.Attempts(); ok {
+		if err := webhookdeliveryschema.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.attempts": %w`, err)}
+		}
+	}
+	if _, ok := wdsc.mutation.ResponseStatus(); !ok {
+		return &ValidationError{Name: "response_status", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.response_status"`)}
+	}
+	if v, ok := wdsc.mutation.ResponseStatus(); ok {
+		if err := webhookdeliveryschema.ResponseStatusValidator(v); err != nil {
+			return &ValidationError{Name: "response_status", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.response_status": %w`, err)}
+		}
+	}
+	if _, ok := wdsc.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.last_error"`)}
+	}
+	if _, ok := wdsc.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.next_attempt_at"`)}
+	}
+	if _, ok := wdsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.created_at"`)}
+	}
+	if _, ok := wdsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entgen: missing required field "WebhookDeliverySchema.updated_at"`)}
+	}
+	if len(wdsc.mutation.WebhookIDs()) == 0 {
+		return &ValidationError{Name: "webhook", err: errors.New(`entgen: missing required edge "WebhookDeliverySchema.webhook"`)}
+	}
+	return nil
+}
+
+func (wdsc *WebhookDeliverySchemaCreate) sqlSave(ctx context.Context) (*WebhookDeliverySchema, error) {
+	if err := wdsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wdsc.mutation.id = &_node.ID
+	wdsc.mutation.done = true
+	return _node, nil
+}
+
+func (wdsc *WebhookDeliverySchemaCreate) createSpec() (*WebhookDeliverySchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDeliverySchema{config: wdsc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdeliveryschema.Table, sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = wdsc.schemaConfig.WebhookDeliverySchema
+	_spec.OnConflict = wdsc.conflict
+	if id, ok := wdsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wdsc.mutation.EventID(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldEventID, field.TypeUUID, value)
+		_node.EventID = value
+	}
+	if value, ok := wdsc.mutation.EventType(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := wdsc.mutation.Payload(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := wdsc.mutation.Status(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wdsc.mutation.Attempts(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := wdsc.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldResponseStatus, field.TypeInt, value)
+		_node.ResponseStatus = value
+	}
+	if value, ok := wdsc.mutation.LastError(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := wdsc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := wdsc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wdsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := wdsc.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdeliveryschema.WebhookTable,
+			Columns: []string{webhookdeliveryschema.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wdsc.schemaConfig.WebhookDeliverySchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WebhookID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDeliverySchema.Create().
+//		SetWebhookID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliverySchemaUpsert) {
+//			SetWebhookID(v+v).
+//		}).
+//		Exec(ctx)
+func (wdsc *WebhookDeliverySchemaCreate) OnConflict(opts ...sql.ConflictOption) *WebhookDeliverySchemaUpsertOne {
+	wdsc.conflict = opts
+	return &WebhookDeliverySchemaUpsertOne{
+		create: wdsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDeliverySchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdsc *WebhookDeliverySchemaCreate) OnConflictColumns(columns ...string) *WebhookDeliverySchemaUpsertOne {
+	wdsc.conflict = append(wdsc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliverySchemaUpsertOne{
+		create: wdsc,
+	}
+}
+
+type (
+	// WebhookDeliverySchemaUpsertOne is the builder for "upsert"-ing
+	//  one WebhookDeliverySchema node.
+	WebhookDeliverySchemaUpsertOne struct {
+		create *WebhookDeliverySchemaCreate
+	}
+
+	// WebhookDeliverySchemaUpsert is the "OnConflict" setter.
+	WebhookDeliverySchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliverySchemaUpsert) SetStatus(v webhookdeliveryschema.Status) *WebhookDeliverySchemaUpsert {
+	u.Set(webhookdeliveryschema.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsert) UpdateStatus() *WebhookDeliverySchemaUpsert {
+	u.SetExcluded(webhookdeliveryschema.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliverySchemaUpsert) SetAttempts(v int) *WebhookDeliverySchemaUpsert {
+	u.Set(webhookdeliveryschema.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsert) UpdateAttempts() *WebhookDeliverySchemaUpsert {
+	u.SetExcluded(webhookdeliveryschema.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliverySchemaUpsert) AddAttempts(v int) *WebhookDeliverySchemaUpsert {
+	u.Add(webhookdeliveryschema.FieldAttempts, v)
+	return u
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *WebhookDeliverySchemaUpsert) SetResponseStatus(v int) *WebhookDeliverySchemaUpsert {
+	u.Set(webhookdeliveryschema.FieldResponseStatus, v)
+	return u
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsert) UpdateResponseStatus() *WebhookDeliverySchemaUpsert {
+	u.SetExcluded(webhookdeliveryschema.FieldResponseStatus)
+	return u
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *WebhookDeliverySchemaUpsert) AddResponseStatus(v int) *WebhookDeliverySchemaUpsert {
+	u.Add(webhookdeliveryschema.FieldResponseStatus, v)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *WebhookDeliverySchemaUpsert) SetLastError(v string) *WebhookDeliverySchemaUpsert {
+	u.Set(webhookdeliveryschema.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsert) UpdateLastError() *WebhookDeliverySchemaUpsert {
+	u.SetExcluded(webhookdeliveryschema.FieldLastError)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *WebhookDeliverySchemaUpsert) SetNextAttemptAt(v time.Time) *WebhookDeliverySchemaUpsert {
+	u.Set(webhookdeliveryschema.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsert) UpdateNextAttemptAt() *WebhookDeliverySchemaUpsert {
+	u.SetExcluded(webhookdeliveryschema.FieldNextAttemptAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookDeliverySchemaUpsert) SetUpdatedAt(v time.Time) *WebhookDeliverySchemaUpsert {
+	u.Set(webhookdeliveryschema.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsert) UpdateUpdatedAt() *WebhookDeliverySchemaUpsert {
+	u.SetExcluded(webhookdeliveryschema.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDeliverySchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdeliveryschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliverySchemaUpsertOne) UpdateNewValues() *WebhookDeliverySchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhookdeliveryschema.FieldID)
+		}
+		if _, exists := u.create.mutation.WebhookID(); exists {
+			s.SetIgnore(webhookdeliveryschema.FieldWebhookID)
+		}
+		if _, exists := u.create.mutation.EventID(); exists {
+			s.SetIgnore(webhookdeliveryschema.FieldEventID)
+		}
+		if _, exists := u.create.mutation.EventType(); exists {
+			s.SetIgnore(webhookdeliveryschema.FieldEventType)
+		}
+		if _, exists := u.create.mutation.Payload(); exists {
+			s.SetIgnore(webhookdeliveryschema.FieldPayload)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(webhookdeliveryschema.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDeliverySchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookDeliverySchemaUpsertOne) Ignore() *WebhookDeliverySchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliverySchemaUpsertOne) DoNothing() *WebhookDeliverySchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliverySchemaCreate.OnConflict
+// documentation for more info.
+func (u *WebhookDeliverySchemaUpsertOne) Update(set func(*WebhookDeliverySchemaUpsert)) *WebhookDeliverySchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliverySchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliverySchemaUpsertOne) SetStatus(v webhookdeliveryschema.Status) *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertOne) UpdateStatus() *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliverySchemaUpsertOne) SetAttempts(v int) *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliverySchemaUpsertOne) AddAttempts(v int) *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertOne) UpdateAttempts() *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *WebhookDeliverySchemaUpsertOne) SetResponseStatus(v int) *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetResponseStatus(v)
+	})
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *WebhookDeliverySchemaUpsertOne) AddResponseStatus(v int) *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.AddResponseStatus(v)
+	})
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertOne) UpdateResponseStatus() *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateResponseStatus()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *WebhookDeliverySchemaUpsertOne) SetLastError(v string) *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertOne) UpdateLastError() *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *WebhookDeliverySchemaUpsertOne) SetNextAttemptAt(v time.Time) *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertOne) UpdateNextAttemptAt() *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookDeliverySchemaUpsertOne) SetUpdatedAt(v time.Time) *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertOne) UpdateUpdatedAt() *WebhookDeliverySchemaUpsertOne {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDeliverySchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for WebhookDeliverySchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliverySchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookDeliverySchemaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entgen: WebhookDeliverySchemaUpsertOne.ID is not supported by MySQL driver. Use WebhookDeliverySchemaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookDeliverySchemaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookDeliverySchemaCreateBulk is the builder for creating many WebhookDeliverySchema entities in bulk.
+type WebhookDeliverySchemaCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliverySchemaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookDeliverySchema entities in the database.
+func (wdscb *WebhookDeliverySchemaCreateBulk) Save(ctx context.Context) ([]*WebhookDeliverySchema, error) {
+	if wdscb.err != nil {
+		return nil, wdscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdscb.builders))
+	nodes := make([]*WebhookDeliverySchema, len(wdscb.builders))
+	mutators := make([]Mutator, len(wdscb.builders))
+	for i := range wdscb.builders {
+		func(i int, root context.Context) {
+			builder := wdscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliverySchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wdscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdscb *WebhookDeliverySchemaCreateBulk) SaveX(ctx context.Context) []*WebhookDeliverySchema {
+	v, err := wdscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdscb *WebhookDeliverySchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdscb *WebhookDeliverySchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := wdscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDeliverySchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliverySchemaUpsert) {
+//			SetWebhookID(v+v).
+//		}).
+//		Exec(ctx)
+func (wdscb *WebhookDeliverySchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookDeliverySchemaUpsertBulk {
+	wdscb.conflict = opts
+	return &WebhookDeliverySchemaUpsertBulk{
+		create: wdscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDeliverySchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdscb *WebhookDeliverySchemaCreateBulk) OnConflictColumns(columns ...string) *WebhookDeliverySchemaUpsertBulk {
+	wdscb.conflict = append(wdscb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliverySchemaUpsertBulk{
+		create: wdscb,
+	}
+}
+
+// WebhookDeliverySchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookDeliverySchema nodes.
+type WebhookDeliverySchemaUpsertBulk struct {
+	create *WebhookDeliverySchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookDeliverySchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdeliveryschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliverySchemaUpsertBulk) UpdateNewValues() *WebhookDeliverySchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhookdeliveryschema.FieldID)
+			}
+			if _, exists := b.mutation.WebhookID(); exists {
+				s.SetIgnore(webhookdeliveryschema.FieldWebhookID)
+			}
+			if _, exists := b.mutation.EventID(); exists {
+				s.SetIgnore(webhookdeliveryschema.FieldEventID)
+			}
+			if _, exists := b.mutation.EventType(); exists {
+				s.SetIgnore(webhookdeliveryschema.FieldEventType)
+			}
+			if _, exists := b.mutation.Payload(); exists {
+				s.SetIgnore(webhookdeliveryschema.FieldPayload)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(webhookdeliveryschema.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDeliverySchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookDeliverySchemaUpsertBulk) Ignore() *WebhookDeliverySchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliverySchemaUpsertBulk) DoNothing() *WebhookDeliverySchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliverySchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookDeliverySchemaUpsertBulk) Update(set func(*WebhookDeliverySchemaUpsert)) *WebhookDeliverySchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliverySchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliverySchemaUpsertBulk) SetStatus(v webhookdeliveryschema.Status) *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertBulk) UpdateStatus() *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliverySchemaUpsertBulk) SetAttempts(v int) *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliverySchemaUpsertBulk) AddAttempts(v int) *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertBulk) UpdateAttempts() *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *WebhookDeliverySchemaUpsertBulk) SetResponseStatus(v int) *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetResponseStatus(v)
+	})
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *WebhookDeliverySchemaUpsertBulk) AddResponseStatus(v int) *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.AddResponseStatus(v)
+	})
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertBulk) UpdateResponseStatus() *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateResponseStatus()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *WebhookDeliverySchemaUpsertBulk) SetLastError(v string) *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertBulk) UpdateLastError() *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *WebhookDeliverySchemaUpsertBulk) SetNextAttemptAt(v time.Time) *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertBulk) UpdateNextAttemptAt() *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookDeliverySchemaUpsertBulk) SetUpdatedAt(v time.Time) *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookDeliverySchemaUpsertBulk) UpdateUpdatedAt() *WebhookDeliverySchemaUpsertBulk {
+	return u.Update(func(s *WebhookDeliverySchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDeliverySchemaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entgen: OnConflict was set for builder %d. Set it on the WebhookDeliverySchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for WebhookDeliverySchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliverySchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
+)
+
+// WebhookDeliverySchemaQuery is the builder for querying WebhookDeliverySchema entities.
+type WebhookDeliverySchemaQuery struct {
+	config
+	ctx         *QueryContext
+	order       []webhookdeliveryschema.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.WebhookDeliverySchema
+	withWebhook *WebhookSchemaQuery
+	loadTotal   []func(context.Context, []*WebhookDeliverySchema) error
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookDeliverySchemaQuery builder.
+func (wdsq *WebhookDeliverySchemaQuery) Where(ps ...predicate.WebhookDeliverySchema) *WebhookDeliverySchemaQuery {
+	wdsq.predicates = append(wdsq.predicates, ps...)
+	return wdsq
+}
+
+// Limit the number of records to be returned by this query.
+func (wdsq *WebhookDeliverySchemaQuery) Limit(limit int) *WebhookDeliverySchemaQuery {
+	wdsq.ctx.Limit = &limit
+	return wdsq
+}
+
+// Offset to start from.
+func (wdsq *WebhookDeliverySchemaQuery) Offset(offset int) *WebhookDeliverySchemaQuery {
+	wdsq.ctx.Offset = &offset
+	return wdsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wdsq *WebhookDeliverySchemaQuery) Unique(unique bool) *WebhookDeliverySchemaQuery {
+	wdsq.ctx.Unique = &unique
+	return wdsq
+}
+
+// Order specifies how the records should be ordered.
+func (wdsq *WebhookDeliverySchemaQuery) Order(o ...webhookdeliveryschema.OrderOption) *WebhookDeliverySchemaQuery {
+	wdsq.order = append(wdsq.order, o...)
+	return wdsq
+}
+
+// QueryWebhook chains the current query on the "webhook" edge.
+func (wdsq *WebhookDeliverySchemaQuery) QueryWebhook() *WebhookSchemaQuery {
+	query := (&WebhookSchemaClient{config: wdsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wdsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wdsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdeliveryschema.Table, webhookdeliveryschema.FieldID, selector),
+			sqlgraph.To(webhookschema.Table, webhookschema.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdeliveryschema.WebhookTable, webhookdeliveryschema.WebhookColumn),
+		)
+		schemaConfig := wdsq.schemaConfig
+		step.To.Schema = schemaConfig.WebhookSchema
+		step.Edge.Schema = schemaConfig.WebhookDeliverySchema
+		fromU = sqlgraph.SetNeighbors(wdsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WebhookDeliverySchema entity from the query.
+// Returns a *NotFoundError when no WebhookDeliverySchema was found.
+func (wdsq *WebhookDeliverySchemaQuery) First(ctx context.Context) (*WebhookDeliverySchema, error) {
+	nodes, err := wdsq.Limit(1).All(setContextOp(ctx, wdsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookdeliveryschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wdsq *WebhookDeliverySchemaQuery) FirstX(ctx context.Context) *WebhookDeliverySchema {
+	node, err := wdsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookDeliverySchema ID from the query.
+// Returns a *NotFoundError when no WebhookDeliverySchema ID was found.
+func (wdsq *WebhookDeliverySchemaQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wdsq.Limit(1).IDs(setContextOp(ctx, wdsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookdeliveryschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wdsq *WebhookDeliverySchemaQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wdsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookDeliverySchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WebhookDeliverySchema entity is found.
+// Returns a *NotFoundError when no WebhookDeliverySchema entities are found.
+func (wdsq *WebhookDeliverySchemaQuery) Only(ctx context.Context) (*WebhookDeliverySchema, error) {
+	nodes, err := wdsq.Limit(2).All(setContextOp(ctx, wdsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookdeliveryschema.Label}
+	default:
+		return nil, &NotSingularError{webhookdeliveryschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wdsq *WebhookDeliverySchemaQuery) OnlyX(ctx context.Context) *WebhookDeliverySchema {
+	node, err := wdsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookDeliverySchema ID in the query.
+// Returns a *NotSingularError when more than one WebhookDeliverySchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wdsq *WebhookDeliverySchemaQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wdsq.Limit(2).IDs(setContextOp(ctx, wdsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookdeliveryschema.Label}
+	default:
+		err = &NotSingularError{webhookdeliveryschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wdsq *WebhookDeliverySchemaQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wdsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookDeliverySchemas.
+func (wdsq *WebhookDeliverySchemaQuery) All(ctx context.Context) ([]*WebhookDeliverySchema, error) {
+	ctx = setContextOp(ctx, wdsq.ctx, ent.OpQueryAll)
+	if err := wdsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WebhookDeliverySchema, *WebhookDeliverySchemaQuery]()
+	return withInterceptors[[]*WebhookDeliverySchema](ctx, wdsq, qr, wdsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wdsq *WebhookDeliverySchemaQuery) AllX(ctx context.Context) []*WebhookDeliverySchema {
+	nodes, err := wdsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookDeliverySchema IDs.
+func (wdsq *WebhookDeliverySchemaQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wdsq.ctx.Unique == nil && wdsq.path != nil {
+		wdsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wdsq.ctx, ent.OpQueryIDs)
+	if err = wdsq.Select(webhookdeliveryschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wdsq *WebhookDeliverySchemaQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wdsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wdsq *WebhookDeliverySchemaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wdsq.ctx, ent.OpQueryCount)
+	if err := wdsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wdsq, querierCount[*WebhookDeliverySchemaQuery](), wdsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wdsq *WebhookDeliverySchemaQuery) CountX(ctx context.Context) int {
+	count, err := wdsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wdsq *WebhookDeliverySchemaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wdsq.ctx, ent.OpQueryExist)
+	switch _, err := wdsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entgen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wdsq *WebhookDeliverySchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := wdsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookDeliverySchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wdsq *WebhookDeliverySchemaQuery) Clone() *WebhookDeliverySchemaQuery {
+	if wdsq == nil {
+		return nil
+	}
+	return &WebhookDeliverySchemaQuery{
+		config:      wdsq.config,
+		ctx:         wdsq.ctx.Clone(),
+		order:       append([]webhookdeliveryschema.OrderOption{}, wdsq.order...),
+		inters:      append([]Interceptor{}, wdsq.inters...),
+		predicates:  append([]predicate.WebhookDeliverySchema{}, wdsq.predicates...),
+		withWebhook: wdsq.withWebhook.Clone(),
+		// clone intermediate query.
+		sql:       wdsq.sql.Clone(),
+		path:      wdsq.path,
+		modifiers: append([]func(*sql.Selector){}, wdsq.modifiers...),
+	}
+}
+
+// WithWebhook tells the query-builder to eager-load the nodes that are connected to
+// the "webhook" edge. The optional arguments are used to configure the query builder of the edge.
+func (wdsq *WebhookDeliverySchemaQuery) WithWebhook(opts ...func(*WebhookSchemaQuery)) *WebhookDeliverySchemaQuery {
+	query := (&WebhookSchemaClient{config: wdsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wdsq.withWebhook = query
+	return wdsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WebhookID uuid.UUID `json:"webhook_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookDeliverySchema.Query().
+//		GroupBy(webhookdeliveryschema.FieldWebhookID).
+//		Aggregate(entgen.Count()).
+//		Scan(ctx, &v)
+func (wdsq *WebhookDeliverySchemaQuery) GroupBy(field string, fields ...string) *WebhookDeliverySchemaGroupBy {
+	wdsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WebhookDeliverySchemaGroupBy{build: wdsq}
+	grbuild.flds = &wdsq.ctx.Fields
+	grbuild.label = webhookdeliveryschema.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WebhookID uuid.UUID `json:"webhook_id,omitempty"`
+//	}
+//
+//	client.WebhookDeliverySchema.Query().
+//		Select(webhookdeliveryschema.FieldWebhookID).
+//		Scan(ctx, &v)
+func (wdsq *WebhookDeliverySchemaQuery) Select(fields ...string) *WebhookDeliverySchemaSelect {
+	wdsq.ctx.Fields = append(wdsq.ctx.Fields, fields...)
+	sbuild := &WebhookDeliverySchemaSelect{WebhookDeliverySchemaQuery: wdsq}
+	sbuild.label = webhookdeliveryschema.Label
+	sbuild.flds, sbuild.scan = &wdsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WebhookDeliverySchemaSelect configured with the given aggregations.
+func (wdsq *WebhookDeliverySchemaQuery) Aggregate(fns ...AggregateFunc) *WebhookDeliverySchemaSelect {
+	return wdsq.Select().Aggregate(fns...)
+}
+
+func (wdsq *WebhookDeliverySchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wdsq.inters {
+		if inter == nil {
+			return fmt.Errorf("entgen: uninitialized interceptor (forgotten import entgen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wdsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wdsq.ctx.Fields {
+		if !webhookdeliveryschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+		}
+	}
+	if wdsq.path != nil {
+		prev, err := wdsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wdsq.sql = prev
+	}
+	return nil
+}
+
+func (wdsq *WebhookDeliverySchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebhookDeliverySchema, error) {
+	var (
+		nodes       = []*WebhookDeliverySchema{}
+		_spec       = wdsq.querySpec()
+		loadedTypes = [1]bool{
+			wdsq.withWebhook != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WebhookDeliverySchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WebhookDeliverySchema{config: wdsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = wdsq.schemaConfig.WebhookDeliverySchema
+	ctx = internal.NewSchemaConfigContext(ctx, wdsq.schemaConfig)
+	if len(wdsq.modifiers) > 0 {
+		_spec.Modifiers = wdsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wdsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wdsq.withWebhook; query != nil {
+		if err := wdsq.loadWebhook(ctx, query, nodes, nil,
+			func(n *WebhookDeliverySchema, e *WebhookSchema) { n.Edges.Webhook = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range wdsq.loadTotal {
+		if err := wdsq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wdsq *WebhookDeliverySchemaQuery) loadWebhook(ctx context.Context, query *WebhookSchemaQuery, nodes []*WebhookDeliverySchema, init func(*WebhookDeliverySchema), assign func(*WebhookDeliverySchema, *WebhookSchema)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*WebhookDeliverySchema)
+	for i := range nodes {
+		fk := nodes[i].WebhookID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(webhookschema.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "webhook_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wdsq *WebhookDeliverySchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wdsq.querySpec()
+	_spec.Node.Schema = wdsq.schemaConfig.WebhookDeliverySchema
+	ctx = internal.NewSchemaConfigContext(ctx, wdsq.schemaConfig)
+	if len(wdsq.modifiers) > 0 {
+		_spec.Modifiers = wdsq.modifiers
+	}
+	_spec.Node.Columns = wdsq.ctx.Fields
+	if len(wdsq.ctx.Fields) > 0 {
+		_spec.Unique = wdsq.ctx.Unique != nil && *wdsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wdsq.driver, _spec)
+}
+
+func (wdsq *WebhookDeliverySchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(webhookdeliveryschema.Table, webhookdeliveryschema.Columns, sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID))
+	_spec.From = wdsq.sql
+	if unique := wdsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wdsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wdsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdeliveryschema.FieldID)
+		for i := range fields {
+			if fields[i] != webhookdeliveryschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if wdsq.withWebhook != nil {
+			_spec.Node.AddColumnOnce(webhookdeliveryschema.FieldWebhookID)
+		}
+	}
+	if ps := wdsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wdsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wdsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wdsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wdsq *WebhookDeliverySchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wdsq.driver.Dialect())
+	t1 := builder.Table(webhookdeliveryschema.Table)
+	columns := wdsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = webhookdeliveryschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wdsq.sql != nil {
+		selector = wdsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wdsq.ctx.Unique != nil && *wdsq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(wdsq.schemaConfig.WebhookDeliverySchema)
+	ctx = internal.NewSchemaConfigContext(ctx, wdsq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range wdsq.modifiers {
+		m(selector)
+	}
+	for _, p := range wdsq.predicates {
+		p(selector)
+	}
+	for _, p := range wdsq.order {
+		p(selector)
+	}
+	if offset := wdsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wdsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wdsq *WebhookDeliverySchemaQuery) ForUpdate(opts ...sql.LockOption) *WebhookDeliverySchemaQuery {
+	if wdsq.driver.Dialect() == dialect.Postgres {
+		wdsq.Unique(false)
+	}
+	wdsq.modifiers = append(wdsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wdsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wdsq *WebhookDeliverySchemaQuery) ForShare(opts ...sql.LockOption) *WebhookDeliverySchemaQuery {
+	if wdsq.driver.Dialect() == dialect.Postgres {
+		wdsq.Unique(false)
+	}
+	wdsq.modifiers = append(wdsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wdsq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wdsq *WebhookDeliverySchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *WebhookDeliverySchemaSelect {
+	wdsq.modifiers = append(wdsq.modifiers, modifiers...)
+	return wdsq.Select()
+}
+
+// WebhookDeliverySchemaGroupBy is the group-by builder for WebhookDeliverySchema entities.
+type WebhookDeliverySchemaGroupBy struct {
+	selector
+	build *WebhookDeliverySchemaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wdsgb *WebhookDeliverySchemaGroupBy) Aggregate(fns ...AggregateFunc) *WebhookDeliverySchemaGroupBy {
+	wdsgb.fns = append(wdsgb.fns, fns...)
+	return wdsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wdsgb *WebhookDeliverySchemaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wdsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wdsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookDeliverySchemaQuery, *WebhookDeliverySchemaGroupBy](ctx, wdsgb.build, wdsgb, wdsgb.build.inters, v)
+}
+
+func (wdsgb *WebhookDeliverySchemaGroupBy) sqlScan(ctx context.Context, root *WebhookDeliverySchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wdsgb.fns))
+	for _, fn := range wdsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wdsgb.flds)+len(wdsgb.fns))
+		for _, f := range *wdsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wdsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wdsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WebhookDeliverySchemaSelect is the builder for selecting fields of WebhookDeliverySchema entities.
+type WebhookDeliverySchemaSelect struct {
+	*WebhookDeliverySchemaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wdss *WebhookDeliverySchemaSelect) Aggregate(fns ...AggregateFunc) *WebhookDeliverySchemaSelect {
+	wdss.fns = append(wdss.fns, fns...)
+	return wdss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wdss *WebhookDeliverySchemaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wdss.ctx, ent.OpQuerySelect)
+	if err := wdss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookDeliverySchemaQuery, *WebhookDeliverySchemaSelect](ctx, wdss.WebhookDeliverySchemaQuery, wdss, wdss.inters, v)
+}
+
+func (wdss *WebhookDeliverySchemaSelect) sqlScan(ctx context.Context, root *WebhookDeliverySchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wdss.fns))
+	for _, fn := range wdss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wdss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wdss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wdss *WebhookDeliverySchemaSelect) Modify(modifiers ...func(s *sql.Selector)) *WebhookDeliverySchemaSelect {
+	wdss.modifiers = append(wdss.modifiers, modifiers...)
+	return wdss
+}
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+)
+
+// WebhookDeliverySchemaUpdate is the builder for updating WebhookDeliverySchema entities.
+type WebhookDeliverySchemaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookDeliverySchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookDeliverySchemaUpdate builder.
+func (wdsu *WebhookDeliverySchemaUpdate) Where(ps ...predicate.WebhookDeliverySchema) *WebhookDeliverySchemaUpdate {
+	wdsu.mutation.Where(ps...)
+	return wdsu
+}
+
+// SetStatus sets the "status" field.
+func (wdsu *WebhookDeliverySchemaUpdate) SetStatus(w webhookdeliveryschema.Status) *WebhookDeliverySchemaUpdate {
+	wdsu.mutation.SetStatus(w)
+	return wdsu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdsu *WebhookDeliverySchemaUpdate) SetNillableStatus(w *webhookdeliveryschema.Status) *WebhookDeliverySchemaUpdate {
+	if w != nil {
+		wdsu.SetStatus(*w)
+	}
+	return wdsu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdsu *WebhookDeliverySchemaUpdate) SetAttempts(i int) *WebhookDeliverySchemaUpdate {
+	wdsu.mutation.ResetAttempts()
+	wdsu.mutation.SetAttempts(i)
+	return wdsu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdsu *WebhookDeliverySchemaUpdate) SetNillableAttempts(i *int) *WebhookDeliverySchemaUpdate {
+	if i != nil {
+		wdsu.SetAttempts(*i)
+	}
+	return wdsu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdsu *WebhookDeliverySchemaUpdate) AddAttempts(i int) *WebhookDeliverySchemaUpdate {
+	wdsu.mutation.AddAttempts(i)
+	return wdsu
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdsu *WebhookDeliverySchemaUpdate) SetResponseStatus(i int) *WebhookDeliverySchemaUpdate {
+	wdsu.mutation.ResetResponseStatus()
+	wdsu.mutation.SetResponseStatus(i)
+	return wdsu
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdsu *WebhookDeliverySchemaUpdate) SetNillableResponseStatus(i *int) *WebhookDeliverySchemaUpdate {
+	if i != nil {
+		wdsu.SetResponseStatus(*i)
+	}
+	return wdsu
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wdsu *WebhookDeliverySchemaUpdate) AddResponseStatus(i int) *WebhookDeliverySchemaUpdate {
+	wdsu.mutation.AddResponseStatus(i)
+	return wdsu
+}
+
+// SetLastError sets the "last_error" field.
+func (wdsu *WebhookDeliverySchemaUpdate) SetLastError(s string) *WebhookDeliverySchemaUpdate {
+	wdsu.mutation.SetLastError(s)
+	return wdsu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdsu *WebhookDeliverySchemaUpdate) SetNillableLastError(s *string) *WebhookDeliverySchemaUpdate {
+	if s != nil {
+		wdsu.SetLastError(*s)
+	}
+	return wdsu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdsu *WebhookDeliverySchemaUpdate) SetNextAttemptAt(t time.Time) *WebhookDeliverySchemaUpdate {
+	wdsu.mutation.SetNextAttemptAt(t)
+	return wdsu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdsu *WebhookDeliverySchemaUpdate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliverySchemaUpdate {
+	if t != nil {
+		wdsu.SetNextAttemptAt(*t)
+	}
+	return wdsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdsu *WebhookDeliverySchemaUpdate) SetUpdatedAt(t time.Time) *WebhookDeliverySchemaUpdate {
+	wdsu.mutation.SetUpdatedAt(t)
+	return wdsu
+}
+
+// Mutation returns the WebhookDeliverySchemaMutation object of the builder.
+func (wdsu *WebhookDeliverySchemaUpdate) Mutation() *WebhookDeliverySchemaMutation {
+	return wdsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdsu *WebhookDeliverySchemaUpdate) Save(ctx context.Context) (int, error) {
+	wdsu.defaults()
+	return withHooks(ctx, wdsu.sqlSave, wdsu.mutation, wdsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdsu *WebhookDeliverySchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdsu *WebhookDeliverySchemaUpdate) Exec(ctx context.Context) error {
+	_, err := wdsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdsu *WebhookDeliverySchemaUpdate) ExecX(ctx context.Context) {
+	if err := wdsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdsu *WebhookDeliverySchemaUpdate) defaults() {
+	if _, ok := wdsu.mutation.UpdatedAt(); !ok {
+		v := webhookdeliveryschema.UpdateDefaultUpdatedAt()
+		wdsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdsu *WebhookDeliverySchemaUpdate) check() error {
+	if v, ok := wdsu.mutation.Status(); ok {
+		if err := webhookdeliveryschema.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.status": %w`, err)}
+		}
+	}
+	if v, ok := wdsu.mutation.Attempts(); ok {
+		if err := webhookdeliveryschema.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.attempts": %w`, err)}
+		}
+	}
+	if v, ok := wdsu.mutation.ResponseStatus(); ok {
+		if err := webhookdeliveryschema.ResponseStatusValidator(v); err != nil {
+			return &ValidationError{Name: "response_status", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.response_status": %w`, err)}
+		}
+	}
+	if wdsu.mutation.WebhookCleared() && len(wdsu.mutation.WebhookIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "WebhookDeliverySchema.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wdsu *WebhookDeliverySchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliverySchemaUpdate {
+	wdsu.modifiers = append(wdsu.modifiers, modifiers...)
+	return wdsu
+}
+
+func (wdsu *WebhookDeliverySchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdeliveryschema.Table, webhookdeliveryschema.Columns, sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID))
+	if ps := wdsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdsu.mutation.Status(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wdsu.mutation.Attempts(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdsu.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdeliveryschema.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdsu.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdsu.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdeliveryschema.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdsu.mutation.LastError(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := wdsu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := wdsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = wdsu.schemaConfig.WebhookDeliverySchema
+	ctx = internal.NewSchemaConfigContext(ctx, wdsu.schemaConfig)
+	_spec.AddModifiers(wdsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wdsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdeliveryschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdsu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliverySchemaUpdateOne is the builder for updating a single WebhookDeliverySchema entity.
+type WebhookDeliverySchemaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookDeliverySchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetStatus(w webhookdeliveryschema.Status) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.mutation.SetStatus(w)
+	return wdsuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetNillableStatus(w *webhookdeliveryschema.Status) *WebhookDeliverySchemaUpdateOne {
+	if w != nil {
+		wdsuo.SetStatus(*w)
+	}
+	return wdsuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetAttempts(i int) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.mutation.ResetAttempts()
+	wdsuo.mutation.SetAttempts(i)
+	return wdsuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetNillableAttempts(i *int) *WebhookDeliverySchemaUpdateOne {
+	if i != nil {
+		wdsuo.SetAttempts(*i)
+	}
+	return wdsuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) AddAttempts(i int) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.mutation.AddAttempts(i)
+	return wdsuo
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetResponseStatus(i int) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.mutation.ResetResponseStatus()
+	wdsuo.mutation.SetResponseStatus(i)
+	return wdsuo
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetNillableResponseStatus(i *int) *WebhookDeliverySchemaUpdateOne {
+	if i != nil {
+		wdsuo.SetResponseStatus(*i)
+	}
+	return wdsuo
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) AddResponseStatus(i int) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.mutation.AddResponseStatus(i)
+	return wdsuo
+}
+
+// SetLastError sets the "last_error" field.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetLastError(s string) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.mutation.SetLastError(s)
+	return wdsuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetNillableLastError(s *string) *WebhookDeliverySchemaUpdateOne {
+	if s != nil {
+		wdsuo.SetLastError(*s)
+	}
+	return wdsuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetNextAttemptAt(t time.Time) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.mutation.SetNextAttemptAt(t)
+	return wdsuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliverySchemaUpdateOne {
+	if t != nil {
+		wdsuo.SetNextAttemptAt(*t)
+	}
+	return wdsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SetUpdatedAt(t time.Time) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.mutation.SetUpdatedAt(t)
+	return wdsuo
+}
+
+// Mutation returns the WebhookDeliverySchemaMutation object of the builder.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) Mutation() *WebhookDeliverySchemaMutation {
+	return wdsuo.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliverySchemaUpdate builder.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) Where(ps ...predicate.WebhookDeliverySchema) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.mutation.Where(ps...)
+	return wdsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) Select(field string, fields ...string) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.fields = append([]string{field}, fields...)
+	return wdsuo
+}
+
+// Save executes the query and returns the updated WebhookDeliverySchema entity.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) Save(ctx context.Context) (*WebhookDeliverySchema, error) {
+	wdsuo.defaults()
+	return withHooks(ctx, wdsuo.sqlSave, wdsuo.mutation, wdsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) SaveX(ctx context.Context) *WebhookDeliverySchema {
+	node, err := wdsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := wdsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := wdsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) defaults() {
+	if _, ok := wdsuo.mutation.UpdatedAt(); !ok {
+		v := webhookdeliveryschema.UpdateDefaultUpdatedAt()
+		wdsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) check() error {
+	if v, ok := wdsuo.mutation.Status(); ok {
+		if err := webhookdeliveryschema.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.status": %w`, err)}
+		}
+	}
+	if v, ok := wdsuo.mutation.Attempts(); ok {
+		if err := webhookdeliveryschema.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.attempts": %w`, err)}
+		}
+	}
+	if v, ok := wdsuo.mutation.ResponseStatus(); ok {
+		if err := webhookdeliveryschema.ResponseStatusValidator(v); err != nil {
+			return &ValidationError{Name: "response_status", err: fmt.Errorf(`entgen: validator failed for field "WebhookDeliverySchema.response_status": %w`, err)}
+		}
+	}
+	if wdsuo.mutation.WebhookCleared() && len(wdsuo.mutation.WebhookIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "WebhookDeliverySchema.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wdsuo *WebhookDeliverySchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliverySchemaUpdateOne {
+	wdsuo.modifiers = append(wdsuo.modifiers, modifiers...)
+	return wdsuo
+}
+
+func (wdsuo *WebhookDeliverySchemaUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDeliverySchema, err error) {
+	if err := wdsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdeliveryschema.Table, webhookdeliveryschema.Columns, sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID))
+	id, ok := wdsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "WebhookDeliverySchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wdsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdeliveryschema.FieldID)
+		for _, f := range fields {
+			if !webhookdeliveryschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != webhookdeliveryschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wdsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdsuo.mutation.Status(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wdsuo.mutation.Attempts(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdsuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdeliveryschema.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdsuo.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdsuo.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdeliveryschema.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdsuo.mutation.LastError(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := wdsuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := wdsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdeliveryschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.Node.Schema = wdsuo.schemaConfig.WebhookDeliverySchema
+	ctx = internal.NewSchemaConfigContext(ctx, wdsuo.schemaConfig)
+	_spec.AddModifiers(wdsuo.modifiers...)
+	_node = &WebhookDeliverySchema{config: wdsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wdsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdeliveryschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wdsuo.mutation.done = true
+	return _node, nil
+}
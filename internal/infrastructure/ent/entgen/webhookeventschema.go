@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+)
+
+// WebhookEventSchema is the model entity for the WebhookEventSchema schema.
+type WebhookEventSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// TodoID holds the value of the "todo_id" field.
+	TodoID uuid.UUID `json:"todo_id,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload []byte `json:"payload,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt   time.Time `json:"occurred_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookEventSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookeventschema.FieldPayload:
+			values[i] = new([]byte)
+		case webhookeventschema.FieldType:
+			values[i] = new(sql.NullString)
+		case webhookeventschema.FieldOccurredAt:
+			values[i] = new(sql.NullTime)
+		case webhookeventschema.FieldID, webhookeventschema.FieldTodoID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookEventSchema fields.
+func (wes *WebhookEventSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookeventschema.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wes.ID = *value
+			}
+		case webhookeventschema.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				wes.Type = value.String
+			}
+		case webhookeventschema.FieldTodoID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field todo_id", values[i])
+			} else if value != nil {
+				wes.TodoID = *value
+			}
+		case webhookeventschema.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				wes.Payload = *value
+			}
+		case webhookeventschema.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				wes.OccurredAt = value.Time
+			}
+		default:
+			wes.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookEventSchema.
+// This includes values selected through modifiers, order, etc.
+func (wes *WebhookEventSchema) Value(name string) (ent.Value, error) {
+	return wes.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebhookEventSchema.
+// Note that you need to call WebhookEventSchema.Unwrap() before calling this method if this WebhookEventSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wes *WebhookEventSchema) Update() *WebhookEventSchemaUpdateOne {
+	return NewWebhookEventSchemaClient(wes.config).UpdateOne(wes)
+}
+
+// Unwrap unwraps the WebhookEventSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wes *WebhookEventSchema) Unwrap() *WebhookEventSchema {
+	_tx, ok := wes.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: WebhookEventSchema is not a transactional entity")
+	}
+	wes.config.driver = _tx.drv
+	return wes
+}
+
+// String implements the fmt.Stringer.
+func (wes *WebhookEventSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookEventSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wes.ID))
+	builder.WriteString("type=")
+	builder.WriteString(wes.Type)
+	builder.WriteString(", ")
+	builder.WriteString("todo_id=")
+	builder.WriteString(fmt.Sprintf("%v", wes.TodoID))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", wes.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(wes.OccurredAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookEventSchemas is a parsable slice of WebhookEventSchema.
+type WebhookEventSchemas []*WebhookEventSchema
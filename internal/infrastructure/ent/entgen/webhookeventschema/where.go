@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webhookeventschema
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLTE(FieldID, id))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldType, v))
+}
+
+// TodoID applies equality check predicate on the "todo_id" field. It's identical to TodoIDEQ.
+func TodoID(v uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldTodoID, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v []byte) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldPayload, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldContainsFold(FieldType, v))
+}
+
+// TodoIDEQ applies the EQ predicate on the "todo_id" field.
+func TodoIDEQ(v uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldTodoID, v))
+}
+
+// TodoIDNEQ applies the NEQ predicate on the "todo_id" field.
+func TodoIDNEQ(v uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNEQ(FieldTodoID, v))
+}
+
+// TodoIDIn applies the In predicate on the "todo_id" field.
+func TodoIDIn(vs ...uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldIn(FieldTodoID, vs...))
+}
+
+// TodoIDNotIn applies the NotIn predicate on the "todo_id" field.
+func TodoIDNotIn(vs ...uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNotIn(FieldTodoID, vs...))
+}
+
+// TodoIDGT applies the GT predicate on the "todo_id" field.
+func TodoIDGT(v uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGT(FieldTodoID, v))
+}
+
+// TodoIDGTE applies the GTE predicate on the "todo_id" field.
+func TodoIDGTE(v uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGTE(FieldTodoID, v))
+}
+
+// TodoIDLT applies the LT predicate on the "todo_id" field.
+func TodoIDLT(v uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLT(FieldTodoID, v))
+}
+
+// TodoIDLTE applies the LTE predicate on the "todo_id" field.
+func TodoIDLTE(v uuid.UUID) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLTE(FieldTodoID, v))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v []byte) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v []byte) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...[]byte) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...[]byte) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v []byte) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v []byte) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v []byte) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v []byte) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLTE(FieldPayload, v))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebhookEventSchema) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebhookEventSchema) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebhookEventSchema) predicate.WebhookEventSchema {
+	return predicate.WebhookEventSchema(sql.NotPredicates(p))
+}
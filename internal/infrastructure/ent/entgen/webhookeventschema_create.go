@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+)
+
+// WebhookEventSchemaCreate is the builder for creating a WebhookEventSchema entity.
+type WebhookEventSchemaCreate struct {
+	config
+	mutation *WebhookEventSchemaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetType sets the "type" field.
+func (wesc *WebhookEventSchemaCreate) SetType(s string) *WebhookEventSchemaCreate {
+	wesc.mutation.SetType(s)
+	return wesc
+}
+
+// SetTodoID sets the "todo_id" field.
+func (wesc *WebhookEventSchemaCreate) SetTodoID(u uuid.UUID) *WebhookEventSchemaCreate {
+	wesc.mutation.SetTodoID(u)
+	return wesc
+}
+
+// SetPayload sets the "payload" field.
+func (wesc *WebhookEventSchemaCreate) SetPayload(b []byte) *WebhookEventSchemaCreate {
+	wesc.mutation.SetPayload(b)
+	return wesc
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (wesc *WebhookEventSchemaCreate) SetOccurredAt(t time.Time) *WebhookEventSchemaCreate {
+	wesc.mutation.SetOccurredAt(t)
+	return wesc
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (wesc *WebhookEventSchemaCreate) SetNillableOccurredAt(t *time.Time) *WebhookEventSchemaCreate {
+	if t != nil {
+		wesc.SetOccurredAt(*t)
+	}
+	return wesc
+}
+
+// SetID sets the "id" field.
+func (wesc *WebhookEventSchemaCreate) SetID(u uuid.UUID) *WebhookEventSchemaCreate {
+	wesc.mutation.SetID(u)
+	return wesc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wesc *WebhookEventSchemaCreate) SetNillableID(u *uuid.UUID) *WebhookEventSchemaCreate {
+	if u != nil {
+		wesc.SetID(*u)
+	}
+	return wesc
+}
+
+// Mutation returns the WebhookEventSchemaMutation object of the builder.
+func (wesc *WebhookEventSchemaCreate) Mutation() *WebhookEventSchemaMutation {
+	return wesc.mutation
+}
+
+// Save creates the WebhookEventSchema in the database.
+func (wesc *WebhookEventSchemaCreate) Save(ctx context.Context) (*WebhookEventSchema, error) {
+	wesc.defaults()
+	return withHooks(ctx, wesc.sqlSave, wesc.mutation, wesc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wesc *WebhookEventSchemaCreate) SaveX(ctx context.Context) *WebhookEventSchema {
+	v, err := wesc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wesc *WebhookEventSchemaCreate) Exec(ctx context.Context) error {
+	_, err := wesc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wesc *WebhookEventSchemaCreate) ExecX(ctx context.Context) {
+	if err := wesc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wesc *WebhookEventSchemaCreate) defaults() {
+	if _, ok := wesc.mutation.OccurredAt(); !ok {
+		v := webhookeventschema.DefaultOccurredAt()
+		wesc.mutation.SetOccurredAt(v)
+	}
+	if _, ok := wesc.mutation.ID(); !ok {
+		v := webhookeventschema.DefaultID()
+		wesc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wesc *WebhookEventSchemaCreate) check() error {
+	if _, ok := wesc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`entgen: missing required field "WebhookEventSchema.type"`)}
+	}
+	if v, ok := wesc.mutation.GetType(); ok {
+		if err := webhookeventschema.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`entgen: validator failed for field "WebhookEventSchema.type": %w`, err)}
+		}
+	}
+	if _, ok := wesc.mutation.TodoID(); !ok {
+		return &ValidationError{Name: "todo_id", err: errors.New(`entgen: missing required field "WebhookEventSchema.todo_id"`)}
+	}
+	if _, ok := wesc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`entgen: missing required field "WebhookEventSchema.payload"`)}
+	}
+	if _, ok := wesc.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`entgen: missing required field "WebhookEventSchema.occurred_at"`)}
+	}
+	return nil
+}
+
+func (wesc *WebhookEventSchemaCreate) sqlSave(ctx context.Context) (*WebhookEventSchema, error) {
+	if err := wesc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wesc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wesc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wesc.mutation.id = &_node.ID
+	wesc.mutation.done = true
+	return _node, nil
+}
+
+func (wesc *WebhookEventSchemaCreate) createSpec() (*WebhookEventSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookEventSchema{config: wesc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookeventschema.Table, sqlgraph.NewFieldSpec(webhookeventschema.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = wesc.schemaConfig.WebhookEventSchema
+	_spec.OnConflict = wesc.conflict
+	if id, ok := wesc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wesc.mutation.GetType(); ok {
+		_spec.SetField(webhookeventschema.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := wesc.mutation.TodoID(); ok {
+		_spec.SetField(webhookeventschema.FieldTodoID, field.TypeUUID, value)
+		_node.TodoID = value
+	}
+	if value, ok := wesc.mutation.Payload(); ok {
+		_spec.SetField(webhookeventschema.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := wesc.mutation.OccurredAt(); ok {
+		_spec.SetField(webhookeventschema.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookEventSchema.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookEventSchemaUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (wesc *WebhookEventSchemaCreate) OnConflict(opts ...sql.ConflictOption) *WebhookEventSchemaUpsertOne {
+	wesc.conflict = opts
+	return &WebhookEventSchemaUpsertOne{
+		create: wesc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookEventSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wesc *WebhookEventSchemaCreate) OnConflictColumns(columns ...string) *WebhookEventSchemaUpsertOne {
+	wesc.conflict = append(wesc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookEventSchemaUpsertOne{
+		create: wesc,
+	}
+}
+
+type (
+	// WebhookEventSchemaUpsertOne is the builder for "upsert"-ing
+	//  one WebhookEventSchema node.
+	WebhookEventSchemaUpsertOne struct {
+		create *WebhookEventSchemaCreate
+	}
+
+	// WebhookEventSchemaUpsert is the "OnConflict" setter.
+	WebhookEventSchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookEventSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookeventschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookEventSchemaUpsertOne) UpdateNewValues() *WebhookEventSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhookeventschema.FieldID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(webhookeventschema.FieldType)
+		}
+		if _, exists := u.create.mutation.TodoID(); exists {
+			s.SetIgnore(webhookeventschema.FieldTodoID)
+		}
+		if _, exists := u.create.mutation.Payload(); exists {
+			s.SetIgnore(webhookeventschema.FieldPayload)
+		}
+		if _, exists := u.create.mutation.OccurredAt(); exists {
+			s.SetIgnore(webhookeventschema.FieldOccurredAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookEventSchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookEventSchemaUpsertOne) Ignore() *WebhookEventSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookEventSchemaUpsertOne) DoNothing() *WebhookEventSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookEventSchemaCreate.OnConflict
+// documentation for more info.
+func (u *WebhookEventSchemaUpsertOne) Update(set func(*WebhookEventSchemaUpsert)) *WebhookEventSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookEventSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *WebhookEventSchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for WebhookEventSchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookEventSchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookEventSchemaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entgen: WebhookEventSchemaUpsertOne.ID is not supported by MySQL driver. Use WebhookEventSchemaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookEventSchemaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookEventSchemaCreateBulk is the builder for creating many WebhookEventSchema entities in bulk.
+type WebhookEventSchemaCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookEventSchemaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookEventSchema entities in the database.
+func (wescb *WebhookEventSchemaCreateBulk) Save(ctx context.Context) ([]*WebhookEventSchema, error) {
+	if wescb.err != nil {
+		return nil, wescb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wescb.builders))
+	nodes := make([]*WebhookEventSchema, len(wescb.builders))
+	mutators := make([]Mutator, len(wescb.builders))
+	for i := range wescb.builders {
+		func(i int, root context.Context) {
+			builder := wescb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookEventSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wescb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wescb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wescb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wescb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wescb *WebhookEventSchemaCreateBulk) SaveX(ctx context.Context) []*WebhookEventSchema {
+	v, err := wescb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wescb *WebhookEventSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := wescb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wescb *WebhookEventSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := wescb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookEventSchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookEventSchemaUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (wescb *WebhookEventSchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookEventSchemaUpsertBulk {
+	wescb.conflict = opts
+	return &WebhookEventSchemaUpsertBulk{
+		create: wescb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookEventSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wescb *WebhookEventSchemaCreateBulk) OnConflictColumns(columns ...string) *WebhookEventSchemaUpsertBulk {
+	wescb.conflict = append(wescb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookEventSchemaUpsertBulk{
+		create: wescb,
+	}
+}
+
+// WebhookEventSchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookEventSchema nodes.
+type WebhookEventSchemaUpsertBulk struct {
+	create *WebhookEventSchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookEventSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookeventschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookEventSchemaUpsertBulk) UpdateNewValues() *WebhookEventSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhookeventschema.FieldID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(webhookeventschema.FieldType)
+			}
+			if _, exists := b.mutation.TodoID(); exists {
+				s.SetIgnore(webhookeventschema.FieldTodoID)
+			}
+			if _, exists := b.mutation.Payload(); exists {
+				s.SetIgnore(webhookeventschema.FieldPayload)
+			}
+			if _, exists := b.mutation.OccurredAt(); exists {
+				s.SetIgnore(webhookeventschema.FieldOccurredAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookEventSchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookEventSchemaUpsertBulk) Ignore() *WebhookEventSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookEventSchemaUpsertBulk) DoNothing() *WebhookEventSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookEventSchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookEventSchemaUpsertBulk) Update(set func(*WebhookEventSchemaUpsert)) *WebhookEventSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookEventSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *WebhookEventSchemaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entgen: OnConflict was set for builder %d. Set it on the WebhookEventSchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for WebhookEventSchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookEventSchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
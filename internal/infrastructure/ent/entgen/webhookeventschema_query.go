@@ -0,0 +1,592 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+)
+
+// WebhookEventSchemaQuery is the builder for querying WebhookEventSchema entities.
+type WebhookEventSchemaQuery struct {
+	config
+	ctx        *QueryContext
+	order      []webhookeventschema.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WebhookEventSchema
+	loadTotal  []func(context.Context, []*WebhookEventSchema) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookEventSchemaQuery builder.
+func (wesq *WebhookEventSchemaQuery) Where(ps ...predicate.WebhookEventSchema) *WebhookEventSchemaQuery {
+	wesq.predicates = append(wesq.predicates, ps...)
+	return wesq
+}
+
+// Limit the number of records to be returned by this query.
+func (wesq *WebhookEventSchemaQuery) Limit(limit int) *WebhookEventSchemaQuery {
+	wesq.ctx.Limit = &limit
+	return wesq
+}
+
+// Offset to start from.
+func (wesq *WebhookEventSchemaQuery) Offset(offset int) *WebhookEventSchemaQuery {
+	wesq.ctx.Offset = &offset
+	return wesq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wesq *WebhookEventSchemaQuery) Unique(unique bool) *WebhookEventSchemaQuery {
+	wesq.ctx.Unique = &unique
+	return wesq
+}
+
+// Order specifies how the records should be ordered.
+func (wesq *WebhookEventSchemaQuery) Order(o ...webhookeventschema.OrderOption) *WebhookEventSchemaQuery {
+	wesq.order = append(wesq.order, o...)
+	return wesq
+}
+
+// First returns the first WebhookEventSchema entity from the query.
+// Returns a *NotFoundError when no WebhookEventSchema was found.
+func (wesq *WebhookEventSchemaQuery) First(ctx context.Context) (*WebhookEventSchema, error) {
+	nodes, err := wesq.Limit(1).All(setContextOp(ctx, wesq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookeventschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wesq *WebhookEventSchemaQuery) FirstX(ctx context.Context) *WebhookEventSchema {
+	node, err := wesq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookEventSchema ID from the query.
+// Returns a *NotFoundError when no WebhookEventSchema ID was found.
+func (wesq *WebhookEventSchemaQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wesq.Limit(1).IDs(setContextOp(ctx, wesq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookeventschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wesq *WebhookEventSchemaQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wesq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookEventSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WebhookEventSchema entity is found.
+// Returns a *NotFoundError when no WebhookEventSchema entities are found.
+func (wesq *WebhookEventSchemaQuery) Only(ctx context.Context) (*WebhookEventSchema, error) {
+	nodes, err := wesq.Limit(2).All(setContextOp(ctx, wesq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookeventschema.Label}
+	default:
+		return nil, &NotSingularError{webhookeventschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wesq *WebhookEventSchemaQuery) OnlyX(ctx context.Context) *WebhookEventSchema {
+	node, err := wesq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookEventSchema ID in the query.
+// Returns a *NotSingularError when more than one WebhookEventSchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wesq *WebhookEventSchemaQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wesq.Limit(2).IDs(setContextOp(ctx, wesq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookeventschema.Label}
+	default:
+		err = &NotSingularError{webhookeventschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wesq *WebhookEventSchemaQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wesq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookEventSchemas.
+func (wesq *WebhookEventSchemaQuery) All(ctx context.Context) ([]*WebhookEventSchema, error) {
+	ctx = setContextOp(ctx, wesq.ctx, ent.OpQueryAll)
+	if err := wesq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WebhookEventSchema, *WebhookEventSchemaQuery]()
+	return withInterceptors[[]*WebhookEventSchema](ctx, wesq, qr, wesq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wesq *WebhookEventSchemaQuery) AllX(ctx context.Context) []*WebhookEventSchema {
+	nodes, err := wesq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookEventSchema IDs.
+func (wesq *WebhookEventSchemaQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wesq.ctx.Unique == nil && wesq.path != nil {
+		wesq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wesq.ctx, ent.OpQueryIDs)
+	if err = wesq.Select(webhookeventschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wesq *WebhookEventSchemaQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wesq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wesq *WebhookEventSchemaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wesq.ctx, ent.OpQueryCount)
+	if err := wesq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wesq, querierCount[*WebhookEventSchemaQuery](), wesq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wesq *WebhookEventSchemaQuery) CountX(ctx context.Context) int {
+	count, err := wesq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wesq *WebhookEventSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wesq.ctx, ent.OpQueryExist)
+	switch _, err := wesq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entgen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wesq *WebhookEventSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := wesq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookEventSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wesq *WebhookEventSchemaQuery) Clone() *WebhookEventSchemaQuery {
+	if wesq == nil {
+		return nil
+	}
+	return &WebhookEventSchemaQuery{
+		config:     wesq.config,
+		ctx:        wesq.ctx.Clone(),
+		order:      append([]webhookeventschema.OrderOption{}, wesq.order...),
+		inters:     append([]Interceptor{}, wesq.inters...),
+		predicates: append([]predicate.WebhookEventSchema{}, wesq.predicates...),
+		// clone intermediate query.
+		sql:       wesq.sql.Clone(),
+		path:      wesq.path,
+		modifiers: append([]func(*sql.Selector){}, wesq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookEventSchema.Query().
+//		GroupBy(webhookeventschema.FieldType).
+//		Aggregate(entgen.Count()).
+//		Scan(ctx, &v)
+func (wesq *WebhookEventSchemaQuery) GroupBy(field string, fields ...string) *WebhookEventSchemaGroupBy {
+	wesq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WebhookEventSchemaGroupBy{build: wesq}
+	grbuild.flds = &wesq.ctx.Fields
+	grbuild.label = webhookeventschema.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//	}
+//
+//	client.WebhookEventSchema.Query().
+//		Select(webhookeventschema.FieldType).
+//		Scan(ctx, &v)
+func (wesq *WebhookEventSchemaQuery) Select(fields ...string) *WebhookEventSchemaSelect {
+	wesq.ctx.Fields = append(wesq.ctx.Fields, fields...)
+	sbuild := &WebhookEventSchemaSelect{WebhookEventSchemaQuery: wesq}
+	sbuild.label = webhookeventschema.Label
+	sbuild.flds, sbuild.scan = &wesq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WebhookEventSchemaSelect configured with the given aggregations.
+func (wesq *WebhookEventSchemaQuery) Aggregate(fns ...AggregateFunc) *WebhookEventSchemaSelect {
+	return wesq.Select().Aggregate(fns...)
+}
+
+func (wesq *WebhookEventSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wesq.inters {
+		if inter == nil {
+			return fmt.Errorf("entgen: uninitialized interceptor (forgotten import entgen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wesq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wesq.ctx.Fields {
+		if !webhookeventschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+		}
+	}
+	if wesq.path != nil {
+		prev, err := wesq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wesq.sql = prev
+	}
+	return nil
+}
+
+func (wesq *WebhookEventSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebhookEventSchema, error) {
+	var (
+		nodes = []*WebhookEventSchema{}
+		_spec = wesq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WebhookEventSchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WebhookEventSchema{config: wesq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = wesq.schemaConfig.WebhookEventSchema
+	ctx = internal.NewSchemaConfigContext(ctx, wesq.schemaConfig)
+	if len(wesq.modifiers) > 0 {
+		_spec.Modifiers = wesq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wesq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range wesq.loadTotal {
+		if err := wesq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wesq *WebhookEventSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wesq.querySpec()
+	_spec.Node.Schema = wesq.schemaConfig.WebhookEventSchema
+	ctx = internal.NewSchemaConfigContext(ctx, wesq.schemaConfig)
+	if len(wesq.modifiers) > 0 {
+		_spec.Modifiers = wesq.modifiers
+	}
+	_spec.Node.Columns = wesq.ctx.Fields
+	if len(wesq.ctx.Fields) > 0 {
+		_spec.Unique = wesq.ctx.Unique != nil && *wesq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wesq.driver, _spec)
+}
+
+func (wesq *WebhookEventSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(webhookeventschema.Table, webhookeventschema.Columns, sqlgraph.NewFieldSpec(webhookeventschema.FieldID, field.TypeUUID))
+	_spec.From = wesq.sql
+	if unique := wesq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wesq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wesq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookeventschema.FieldID)
+		for i := range fields {
+			if fields[i] != webhookeventschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wesq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wesq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wesq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wesq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wesq *WebhookEventSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wesq.driver.Dialect())
+	t1 := builder.Table(webhookeventschema.Table)
+	columns := wesq.ctx.Fields
+	if len(columns) == 0 {
+		columns = webhookeventschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wesq.sql != nil {
+		selector = wesq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wesq.ctx.Unique != nil && *wesq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(wesq.schemaConfig.WebhookEventSchema)
+	ctx = internal.NewSchemaConfigContext(ctx, wesq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range wesq.modifiers {
+		m(selector)
+	}
+	for _, p := range wesq.predicates {
+		p(selector)
+	}
+	for _, p := range wesq.order {
+		p(selector)
+	}
+	if offset := wesq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wesq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wesq *WebhookEventSchemaQuery) ForUpdate(opts ...sql.LockOption) *WebhookEventSchemaQuery {
+	if wesq.driver.Dialect() == dialect.Postgres {
+		wesq.Unique(false)
+	}
+	wesq.modifiers = append(wesq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wesq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wesq *WebhookEventSchemaQuery) ForShare(opts ...sql.LockOption) *WebhookEventSchemaQuery {
+	if wesq.driver.Dialect() == dialect.Postgres {
+		wesq.Unique(false)
+	}
+	wesq.modifiers = append(wesq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wesq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wesq *WebhookEventSchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *WebhookEventSchemaSelect {
+	wesq.modifiers = append(wesq.modifiers, modifiers...)
+	return wesq.Select()
+}
+
+// WebhookEventSchemaGroupBy is the group-by builder for WebhookEventSchema entities.
+type WebhookEventSchemaGroupBy struct {
+	selector
+	build *WebhookEventSchemaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wesgb *WebhookEventSchemaGroupBy) Aggregate(fns ...AggregateFunc) *WebhookEventSchemaGroupBy {
+	wesgb.fns = append(wesgb.fns, fns...)
+	return wesgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wesgb *WebhookEventSchemaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wesgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wesgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookEventSchemaQuery, *WebhookEventSchemaGroupBy](ctx, wesgb.build, wesgb, wesgb.build.inters, v)
+}
+
+func (wesgb *WebhookEventSchemaGroupBy) sqlScan(ctx context.Context, root *WebhookEventSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wesgb.fns))
+	for _, fn := range wesgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wesgb.flds)+len(wesgb.fns))
+		for _, f := range *wesgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wesgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wesgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WebhookEventSchemaSelect is the builder for selecting fields of WebhookEventSchema entities.
+type WebhookEventSchemaSelect struct {
+	*WebhookEventSchemaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wess *WebhookEventSchemaSelect) Aggregate(fns ...AggregateFunc) *WebhookEventSchemaSelect {
+	wess.fns = append(wess.fns, fns...)
+	return wess
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wess *WebhookEventSchemaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wess.ctx, ent.OpQuerySelect)
+	if err := wess.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookEventSchemaQuery, *WebhookEventSchemaSelect](ctx, wess.WebhookEventSchemaQuery, wess, wess.inters, v)
+}
+
+func (wess *WebhookEventSchemaSelect) sqlScan(ctx context.Context, root *WebhookEventSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wess.fns))
+	for _, fn := range wess.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wess.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wess.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wess *WebhookEventSchemaSelect) Modify(modifiers ...func(s *sql.Selector)) *WebhookEventSchemaSelect {
+	wess.modifiers = append(wess.modifiers, modifiers...)
+	return wess
+}
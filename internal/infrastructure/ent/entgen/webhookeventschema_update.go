@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+)
+
+// WebhookEventSchemaUpdate is the builder for updating WebhookEventSchema entities.
+type WebhookEventSchemaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookEventSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookEventSchemaUpdate builder.
+func (wesu *WebhookEventSchemaUpdate) Where(ps ...predicate.WebhookEventSchema) *WebhookEventSchemaUpdate {
+	wesu.mutation.Where(ps...)
+	return wesu
+}
+
+// Mutation returns the WebhookEventSchemaMutation object of the builder.
+func (wesu *WebhookEventSchemaUpdate) Mutation() *WebhookEventSchemaMutation {
+	return wesu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wesu *WebhookEventSchemaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wesu.sqlSave, wesu.mutation, wesu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wesu *WebhookEventSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := wesu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wesu *WebhookEventSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := wesu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wesu *WebhookEventSchemaUpdate) ExecX(ctx context.Context) {
+	if err := wesu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wesu *WebhookEventSchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookEventSchemaUpdate {
+	wesu.modifiers = append(wesu.modifiers, modifiers...)
+	return wesu
+}
+
+func (wesu *WebhookEventSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookeventschema.Table, webhookeventschema.Columns, sqlgraph.NewFieldSpec(webhookeventschema.FieldID, field.TypeUUID))
+	if ps := wesu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = wesu.schemaConfig.WebhookEventSchema
+	ctx = internal.NewSchemaConfigContext(ctx, wesu.schemaConfig)
+	_spec.AddModifiers(wesu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wesu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookeventschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wesu.mutation.done = true
+	return n, nil
+}
+
+// WebhookEventSchemaUpdateOne is the builder for updating a single WebhookEventSchema entity.
+type WebhookEventSchemaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookEventSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the WebhookEventSchemaMutation object of the builder.
+func (wesuo *WebhookEventSchemaUpdateOne) Mutation() *WebhookEventSchemaMutation {
+	return wesuo.mutation
+}
+
+// Where appends a list predicates to the WebhookEventSchemaUpdate builder.
+func (wesuo *WebhookEventSchemaUpdateOne) Where(ps ...predicate.WebhookEventSchema) *WebhookEventSchemaUpdateOne {
+	wesuo.mutation.Where(ps...)
+	return wesuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wesuo *WebhookEventSchemaUpdateOne) Select(field string, fields ...string) *WebhookEventSchemaUpdateOne {
+	wesuo.fields = append([]string{field}, fields...)
+	return wesuo
+}
+
+// Save executes the query and returns the updated WebhookEventSchema entity.
+func (wesuo *WebhookEventSchemaUpdateOne) Save(ctx context.Context) (*WebhookEventSchema, error) {
+	return withHooks(ctx, wesuo.sqlSave, wesuo.mutation, wesuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wesuo *WebhookEventSchemaUpdateOne) SaveX(ctx context.Context) *WebhookEventSchema {
+	node, err := wesuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wesuo *WebhookEventSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := wesuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wesuo *WebhookEventSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := wesuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wesuo *WebhookEventSchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookEventSchemaUpdateOne {
+	wesuo.modifiers = append(wesuo.modifiers, modifiers...)
+	return wesuo
+}
+
+func (wesuo *WebhookEventSchemaUpdateOne) sqlSave(ctx context.Context) (_node *WebhookEventSchema, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhookeventschema.Table, webhookeventschema.Columns, sqlgraph.NewFieldSpec(webhookeventschema.FieldID, field.TypeUUID))
+	id, ok := wesuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "WebhookEventSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wesuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookeventschema.FieldID)
+		for _, f := range fields {
+			if !webhookeventschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != webhookeventschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wesuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = wesuo.schemaConfig.WebhookEventSchema
+	ctx = internal.NewSchemaConfigContext(ctx, wesuo.schemaConfig)
+	_spec.AddModifiers(wesuo.modifiers...)
+	_node = &WebhookEventSchema{config: wesuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wesuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookeventschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wesuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
+)
+
+// WebhookSchema is the model entity for the WebhookSchema schema.
+type WebhookSchema struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Events holds the value of the "events" field.
+	Events []string `json:"events,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// ConsecutiveFailures holds the value of the "consecutive_failures" field.
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookSchemaQuery when eager-loading is set.
+	Edges        WebhookSchemaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookSchemaEdges holds the relations/edges for other nodes in the graph.
+type WebhookSchemaEdges struct {
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebhookDeliverySchema `json:"deliveries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+
+	namedDeliveries map[string][]*WebhookDeliverySchema
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WebhookSchemaEdges) DeliveriesOrErr() ([]*WebhookDeliverySchema, error) {
+	if e.loadedTypes[0] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookSchema) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookschema.FieldEvents:
+			values[i] = new([]byte)
+		case webhookschema.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case webhookschema.FieldConsecutiveFailures:
+			values[i] = new(sql.NullInt64)
+		case webhookschema.FieldURL, webhookschema.FieldSecret:
+			values[i] = new(sql.NullString)
+		case webhookschema.FieldCreatedAt, webhookschema.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case webhookschema.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookSchema fields.
+func (ws *WebhookSchema) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookschema.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ws.ID = *value
+			}
+		case webhookschema.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				ws.URL = value.String
+			}
+		case webhookschema.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				ws.Secret = value.String
+			}
+		case webhookschema.FieldEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ws.Events); err != nil {
+					return fmt.Errorf("unmarshal field events: %w", err)
+				}
+			}
+		case webhookschema.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ws.Enabled = value.Bool
+			}
+		case webhookschema.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				ws.ConsecutiveFailures = int(value.Int64)
+			}
+		case webhookschema.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ws.CreatedAt = value.Time
+			}
+		case webhookschema.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ws.UpdatedAt = value.Time
+			}
+		default:
+			ws.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookSchema.
+// This includes values selected through modifiers, order, etc.
+func (ws *WebhookSchema) Value(name string) (ent.Value, error) {
+	return ws.selectValues.Get(name)
+}
+
+// QueryDeliveries queries the "deliveries" edge of the WebhookSchema entity.
+func (ws *WebhookSchema) QueryDeliveries() *WebhookDeliverySchemaQuery {
+	return NewWebhookSchemaClient(ws.config).QueryDeliveries(ws)
+}
+
+// Update returns a builder for updating this WebhookSchema.
+// Note that you need to call WebhookSchema.Unwrap() before calling this method if this WebhookSchema
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ws *WebhookSchema) Update() *WebhookSchemaUpdateOne {
+	return NewWebhookSchemaClient(ws.config).UpdateOne(ws)
+}
+
+// Unwrap unwraps the WebhookSchema entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ws *WebhookSchema) Unwrap() *WebhookSchema {
+	_tx, ok := ws.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: WebhookSchema is not a transactional entity")
+	}
+	ws.config.driver = _tx.drv
+	return ws
+}
+
+// String implements the fmt.Stringer.
+func (ws *WebhookSchema) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookSchema(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ws.ID))
+	builder.WriteString("url=")
+	builder.WriteString(ws.URL)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("events=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Events))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", ws.ConsecutiveFailures))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ws.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ws.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedDeliveries returns the Deliveries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ws *WebhookSchema) NamedDeliveries(name string) ([]*WebhookDeliverySchema, error) {
+	if ws.Edges.namedDeliveries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ws.Edges.namedDeliveries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ws *WebhookSchema) appendNamedDeliveries(name string, edges ...*WebhookDeliverySchema) {
+	if ws.Edges.namedDeliveries == nil {
+		ws.Edges.namedDeliveries = make(map[string][]*WebhookDeliverySchema)
+	}
+	if len(edges) == 0 {
+		ws.Edges.namedDeliveries[name] = []*WebhookDeliverySchema{}
+	} else {
+		ws.Edges.namedDeliveries[name] = append(ws.Edges.namedDeliveries[name], edges...)
+	}
+}
+
+// WebhookSchemas is a parsable slice of WebhookSchema.
+type WebhookSchemas []*WebhookSchema
@@ -0,0 +1,842 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
+)
+
+// WebhookSchemaCreate is the builder for creating a WebhookSchema entity.
+type WebhookSchemaCreate struct {
+	config
+	mutation *WebhookSchemaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetURL sets the "url" field.
+func (wsc *WebhookSchemaCreate) SetURL(s string) *WebhookSchemaCreate {
+	wsc.mutation.SetURL(s)
+	return wsc
+}
+
+// SetSecret sets the "secret" field.
+func (wsc *WebhookSchemaCreate) SetSecret(s string) *WebhookSchemaCreate {
+	wsc.mutation.SetSecret(s)
+	return wsc
+}
+
+// SetEvents sets the "events" field.
+func (wsc *WebhookSchemaCreate) SetEvents(s []string) *WebhookSchemaCreate {
+	wsc.mutation.SetEvents(s)
+	return wsc
+}
+
+// SetEnabled sets the "enabled" field.
+func (wsc *WebhookSchemaCreate) SetEnabled(b bool) *WebhookSchemaCreate {
+	wsc.mutation.SetEnabled(b)
+	return wsc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wsc *WebhookSchemaCreate) SetNillableEnabled(b *bool) *WebhookSchemaCreate {
+	if b != nil {
+		wsc.SetEnabled(*b)
+	}
+	return wsc
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (wsc *WebhookSchemaCreate) SetConsecutiveFailures(i int) *WebhookSchemaCreate {
+	wsc.mutation.SetConsecutiveFailures(i)
+	return wsc
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (wsc *WebhookSchemaCreate) SetNillableConsecutiveFailures(i *int) *WebhookSchemaCreate {
+	if i != nil {
+		wsc.SetConsecutiveFailures(*i)
+	}
+	return wsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wsc *WebhookSchemaCreate) SetCreatedAt(t time.Time) *WebhookSchemaCreate {
+	wsc.mutation.SetCreatedAt(t)
+	return wsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wsc *WebhookSchemaCreate) SetNillableCreatedAt(t *time.Time) *WebhookSchemaCreate {
+	if t != nil {
+		wsc.SetCreatedAt(*t)
+	}
+	return wsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsc *WebhookSchemaCreate) SetUpdatedAt(t time.Time) *WebhookSchemaCreate {
+	wsc.mutation.SetUpdatedAt(t)
+	return wsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wsc *WebhookSchemaCreate) SetNillableUpdatedAt(t *time.Time) *WebhookSchemaCreate {
+	if t != nil {
+		wsc.SetUpdatedAt(*t)
+	}
+	return wsc
+}
+
+// SetID sets the "id" field.
+func (wsc *WebhookSchemaCreate) SetID(u uuid.UUID) *WebhookSchemaCreate {
+	wsc.mutation.SetID(u)
+	return wsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wsc *WebhookSchemaCreate) SetNillableID(u *uuid.UUID) *WebhookSchemaCreate {
+	if u != nil {
+		wsc.SetID(*u)
+	}
+	return wsc
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDeliverySchema entity by IDs.
+func (wsc *WebhookSchemaCreate) AddDeliveryIDs(ids ...uuid.UUID) *WebhookSchemaCreate {
+	wsc.mutation.AddDeliveryIDs(ids...)
+	return wsc
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDeliverySchema entity.
+func (wsc *WebhookSchemaCreate) AddDeliveries(w ...*WebhookDeliverySchema) *WebhookSchemaCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsc.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookSchemaMutation object of the builder.
+func (wsc *WebhookSchemaCreate) Mutation() *WebhookSchemaMutation {
+	return wsc.mutation
+}
+
+// Save creates the WebhookSchema in the database.
+func (wsc *WebhookSchemaCreate) Save(ctx context.Context) (*WebhookSchema, error) {
+	wsc.defaults()
+	return withHooks(ctx, wsc.sqlSave, wsc.mutation, wsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsc *WebhookSchemaCreate) SaveX(ctx context.Context) *WebhookSchema {
+	v, err := wsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsc *WebhookSchemaCreate) Exec(ctx context.Context) error {
+	_, err := wsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsc *WebhookSchemaCreate) ExecX(ctx context.Context) {
+	if err := wsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsc *WebhookSchemaCreate) defaults() {
+	if _, ok := wsc.mutation.Enabled(); !ok {
+		v := webhookschema.DefaultEnabled
+		wsc.mutation.SetEnabled(v)
+	}
+	if _, ok := wsc.mutation.ConsecutiveFailures(); !ok {
+		v := webhookschema.DefaultConsecutiveFailures
+		wsc.mutation.SetConsecutiveFailures(v)
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		v := webhookschema.DefaultCreatedAt()
+		wsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wsc.mutation.UpdatedAt(); !ok {
+		v := webhookschema.DefaultUpdatedAt()
+		wsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wsc.mutation.ID(); !ok {
+		v := webhookschema.DefaultID()
+		wsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsc *WebhookSchemaCreate) check() error {
+	if _, ok := wsc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`entgen: missing required field "WebhookSchema.url"`)}
+	}
+	if v, ok := wsc.mutation.URL(); ok {
+		if err := webhookschema.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`entgen: validator failed for field "WebhookSchema.url": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`entgen: missing required field "WebhookSchema.secret"`)}
+	}
+	if v, ok := wsc.mutation.Secret(); ok {
+		if err := webhookschema.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`entgen: validator failed for field "WebhookSchema.secret": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.Events(); !ok {
+		return &ValidationError{Name: "events", err: errors.New(`entgen: missing required field "WebhookSchema.events"`)}
+	}
+	if _, ok := wsc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`entgen: missing required field "WebhookSchema.enabled"`)}
+	}
+	if _, ok := wsc.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`entgen: missing required field "WebhookSchema.consecutive_failures"`)}
+	}
+	if v, ok := wsc.mutation.ConsecutiveFailures(); ok {
+		if err := webhookschema.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`entgen: validator failed for field "WebhookSchema.consecutive_failures": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entgen: missing required field "WebhookSchema.created_at"`)}
+	}
+	if _, ok := wsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entgen: missing required field "WebhookSchema.updated_at"`)}
+	}
+	return nil
+}
+
+func (wsc *WebhookSchemaCreate) sqlSave(ctx context.Context) (*WebhookSchema, error) {
+	if err := wsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wsc.mutation.id = &_node.ID
+	wsc.mutation.done = true
+	return _node, nil
+}
+
+func (wsc *WebhookSchemaCreate) createSpec() (*WebhookSchema, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookSchema{config: wsc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookschema.Table, sqlgraph.NewFieldSpec(webhookschema.FieldID, field.TypeUUID))
+	)
+	_spec.Schema = wsc.schemaConfig.WebhookSchema
+	_spec.OnConflict = wsc.conflict
+	if id, ok := wsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wsc.mutation.URL(); ok {
+		_spec.SetField(webhookschema.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wsc.mutation.Secret(); ok {
+		_spec.SetField(webhookschema.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := wsc.mutation.Events(); ok {
+		_spec.SetField(webhookschema.FieldEvents, field.TypeJSON, value)
+		_node.Events = value
+	}
+	if value, ok := wsc.mutation.Enabled(); ok {
+		_spec.SetField(webhookschema.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := wsc.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhookschema.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := wsc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookschema.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookschema.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := wsc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookschema.DeliveriesTable,
+			Columns: []string{webhookschema.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wsc.schemaConfig.WebhookDeliverySchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookSchema.Create().
+//		SetURL(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookSchemaUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (wsc *WebhookSchemaCreate) OnConflict(opts ...sql.ConflictOption) *WebhookSchemaUpsertOne {
+	wsc.conflict = opts
+	return &WebhookSchemaUpsertOne{
+		create: wsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wsc *WebhookSchemaCreate) OnConflictColumns(columns ...string) *WebhookSchemaUpsertOne {
+	wsc.conflict = append(wsc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookSchemaUpsertOne{
+		create: wsc,
+	}
+}
+
+type (
+	// WebhookSchemaUpsertOne is the builder for "upsert"-ing
+	//  one WebhookSchema node.
+	WebhookSchemaUpsertOne struct {
+		create *WebhookSchemaCreate
+	}
+
+	// WebhookSchemaUpsert is the "OnConflict" setter.
+	WebhookSchemaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEvents sets the "events" field.
+func (u *WebhookSchemaUpsert) SetEvents(v []string) *WebhookSchemaUpsert {
+	u.Set(webhookschema.FieldEvents, v)
+	return u
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *WebhookSchemaUpsert) UpdateEvents() *WebhookSchemaUpsert {
+	u.SetExcluded(webhookschema.FieldEvents)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WebhookSchemaUpsert) SetEnabled(v bool) *WebhookSchemaUpsert {
+	u.Set(webhookschema.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WebhookSchemaUpsert) UpdateEnabled() *WebhookSchemaUpsert {
+	u.SetExcluded(webhookschema.FieldEnabled)
+	return u
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *WebhookSchemaUpsert) SetConsecutiveFailures(v int) *WebhookSchemaUpsert {
+	u.Set(webhookschema.FieldConsecutiveFailures, v)
+	return u
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *WebhookSchemaUpsert) UpdateConsecutiveFailures() *WebhookSchemaUpsert {
+	u.SetExcluded(webhookschema.FieldConsecutiveFailures)
+	return u
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *WebhookSchemaUpsert) AddConsecutiveFailures(v int) *WebhookSchemaUpsert {
+	u.Add(webhookschema.FieldConsecutiveFailures, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookSchemaUpsert) SetUpdatedAt(v time.Time) *WebhookSchemaUpsert {
+	u.Set(webhookschema.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookSchemaUpsert) UpdateUpdatedAt() *WebhookSchemaUpsert {
+	u.SetExcluded(webhookschema.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookSchemaUpsertOne) UpdateNewValues() *WebhookSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhookschema.FieldID)
+		}
+		if _, exists := u.create.mutation.URL(); exists {
+			s.SetIgnore(webhookschema.FieldURL)
+		}
+		if _, exists := u.create.mutation.Secret(); exists {
+			s.SetIgnore(webhookschema.FieldSecret)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(webhookschema.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookSchema.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookSchemaUpsertOne) Ignore() *WebhookSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookSchemaUpsertOne) DoNothing() *WebhookSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookSchemaCreate.OnConflict
+// documentation for more info.
+func (u *WebhookSchemaUpsertOne) Update(set func(*WebhookSchemaUpsert)) *WebhookSchemaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEvents sets the "events" field.
+func (u *WebhookSchemaUpsertOne) SetEvents(v []string) *WebhookSchemaUpsertOne {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *WebhookSchemaUpsertOne) UpdateEvents() *WebhookSchemaUpsertOne {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WebhookSchemaUpsertOne) SetEnabled(v bool) *WebhookSchemaUpsertOne {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WebhookSchemaUpsertOne) UpdateEnabled() *WebhookSchemaUpsertOne {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *WebhookSchemaUpsertOne) SetConsecutiveFailures(v int) *WebhookSchemaUpsertOne {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.SetConsecutiveFailures(v)
+	})
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *WebhookSchemaUpsertOne) AddConsecutiveFailures(v int) *WebhookSchemaUpsertOne {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.AddConsecutiveFailures(v)
+	})
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *WebhookSchemaUpsertOne) UpdateConsecutiveFailures() *WebhookSchemaUpsertOne {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.UpdateConsecutiveFailures()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookSchemaUpsertOne) SetUpdatedAt(v time.Time) *WebhookSchemaUpsertOne {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookSchemaUpsertOne) UpdateUpdatedAt() *WebhookSchemaUpsertOne {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookSchemaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for WebhookSchemaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookSchemaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookSchemaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entgen: WebhookSchemaUpsertOne.ID is not supported by MySQL driver. Use WebhookSchemaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookSchemaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookSchemaCreateBulk is the builder for creating many WebhookSchema entities in bulk.
+type WebhookSchemaCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookSchemaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookSchema entities in the database.
+func (wscb *WebhookSchemaCreateBulk) Save(ctx context.Context) ([]*WebhookSchema, error) {
+	if wscb.err != nil {
+		return nil, wscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wscb.builders))
+	nodes := make([]*WebhookSchema, len(wscb.builders))
+	mutators := make([]Mutator, len(wscb.builders))
+	for i := range wscb.builders {
+		func(i int, root context.Context) {
+			builder := wscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookSchemaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wscb *WebhookSchemaCreateBulk) SaveX(ctx context.Context) []*WebhookSchema {
+	v, err := wscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wscb *WebhookSchemaCreateBulk) Exec(ctx context.Context) error {
+	_, err := wscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wscb *WebhookSchemaCreateBulk) ExecX(ctx context.Context) {
+	if err := wscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookSchema.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookSchemaUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (wscb *WebhookSchemaCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookSchemaUpsertBulk {
+	wscb.conflict = opts
+	return &WebhookSchemaUpsertBulk{
+		create: wscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookSchema.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wscb *WebhookSchemaCreateBulk) OnConflictColumns(columns ...string) *WebhookSchemaUpsertBulk {
+	wscb.conflict = append(wscb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookSchemaUpsertBulk{
+		create: wscb,
+	}
+}
+
+// WebhookSchemaUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookSchema nodes.
+type WebhookSchemaUpsertBulk struct {
+	create *WebhookSchemaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookSchema.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookschema.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookSchemaUpsertBulk) UpdateNewValues() *WebhookSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhookschema.FieldID)
+			}
+			if _, exists := b.mutation.URL(); exists {
+				s.SetIgnore(webhookschema.FieldURL)
+			}
+			if _, exists := b.mutation.Secret(); exists {
+				s.SetIgnore(webhookschema.FieldSecret)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(webhookschema.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookSchema.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookSchemaUpsertBulk) Ignore() *WebhookSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookSchemaUpsertBulk) DoNothing() *WebhookSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookSchemaCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookSchemaUpsertBulk) Update(set func(*WebhookSchemaUpsert)) *WebhookSchemaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookSchemaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEvents sets the "events" field.
+func (u *WebhookSchemaUpsertBulk) SetEvents(v []string) *WebhookSchemaUpsertBulk {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *WebhookSchemaUpsertBulk) UpdateEvents() *WebhookSchemaUpsertBulk {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WebhookSchemaUpsertBulk) SetEnabled(v bool) *WebhookSchemaUpsertBulk {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WebhookSchemaUpsertBulk) UpdateEnabled() *WebhookSchemaUpsertBulk {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (u *WebhookSchemaUpsertBulk) SetConsecutiveFailures(v int) *WebhookSchemaUpsertBulk {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.SetConsecutiveFailures(v)
+	})
+}
+
+// AddConsecutiveFailures adds v to the "consecutive_failures" field.
+func (u *WebhookSchemaUpsertBulk) AddConsecutiveFailures(v int) *WebhookSchemaUpsertBulk {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.AddConsecutiveFailures(v)
+	})
+}
+
+// UpdateConsecutiveFailures sets the "consecutive_failures" field to the value that was provided on create.
+func (u *WebhookSchemaUpsertBulk) UpdateConsecutiveFailures() *WebhookSchemaUpsertBulk {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.UpdateConsecutiveFailures()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookSchemaUpsertBulk) SetUpdatedAt(v time.Time) *WebhookSchemaUpsertBulk {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookSchemaUpsertBulk) UpdateUpdatedAt() *WebhookSchemaUpsertBulk {
+	return u.Update(func(s *WebhookSchemaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookSchemaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entgen: OnConflict was set for builder %d. Set it on the WebhookSchemaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entgen: missing options for WebhookSchemaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookSchemaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
+)
+
+// WebhookSchemaQuery is the builder for querying WebhookSchema entities.
+type WebhookSchemaQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []webhookschema.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.WebhookSchema
+	withDeliveries      *WebhookDeliverySchemaQuery
+	loadTotal           []func(context.Context, []*WebhookSchema) error
+	modifiers           []func(*sql.Selector)
+	withNamedDeliveries map[string]*WebhookDeliverySchemaQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookSchemaQuery builder.
+func (wsq *WebhookSchemaQuery) Where(ps ...predicate.WebhookSchema) *WebhookSchemaQuery {
+	wsq.predicates = append(wsq.predicates, ps...)
+	return wsq
+}
+
+// Limit the number of records to be returned by this query.
+func (wsq *WebhookSchemaQuery) Limit(limit int) *WebhookSchemaQuery {
+	wsq.ctx.Limit = &limit
+	return wsq
+}
+
+// Offset to start from.
+func (wsq *WebhookSchemaQuery) Offset(offset int) *WebhookSchemaQuery {
+	wsq.ctx.Offset = &offset
+	return wsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wsq *WebhookSchemaQuery) Unique(unique bool) *WebhookSchemaQuery {
+	wsq.ctx.Unique = &unique
+	return wsq
+}
+
+// Order specifies how the records should be ordered.
+func (wsq *WebhookSchemaQuery) Order(o ...webhookschema.OrderOption) *WebhookSchemaQuery {
+	wsq.order = append(wsq.order, o...)
+	return wsq
+}
+
+// QueryDeliveries chains the current query on the "deliveries" edge.
+func (wsq *WebhookSchemaQuery) QueryDeliveries() *WebhookDeliverySchemaQuery {
+	query := (&WebhookDeliverySchemaClient{config: wsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookschema.Table, webhookschema.FieldID, selector),
+			sqlgraph.To(webhookdeliveryschema.Table, webhookdeliveryschema.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhookschema.DeliveriesTable, webhookschema.DeliveriesColumn),
+		)
+		schemaConfig := wsq.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDeliverySchema
+		step.Edge.Schema = schemaConfig.WebhookDeliverySchema
+		fromU = sqlgraph.SetNeighbors(wsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WebhookSchema entity from the query.
+// Returns a *NotFoundError when no WebhookSchema was found.
+func (wsq *WebhookSchemaQuery) First(ctx context.Context) (*WebhookSchema, error) {
+	nodes, err := wsq.Limit(1).All(setContextOp(ctx, wsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookschema.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wsq *WebhookSchemaQuery) FirstX(ctx context.Context) *WebhookSchema {
+	node, err := wsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookSchema ID from the query.
+// Returns a *NotFoundError when no WebhookSchema ID was found.
+func (wsq *WebhookSchemaQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wsq.Limit(1).IDs(setContextOp(ctx, wsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookschema.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wsq *WebhookSchemaQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookSchema entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WebhookSchema entity is found.
+// Returns a *NotFoundError when no WebhookSchema entities are found.
+func (wsq *WebhookSchemaQuery) Only(ctx context.Context) (*WebhookSchema, error) {
+	nodes, err := wsq.Limit(2).All(setContextOp(ctx, wsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookschema.Label}
+	default:
+		return nil, &NotSingularError{webhookschema.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wsq *WebhookSchemaQuery) OnlyX(ctx context.Context) *WebhookSchema {
+	node, err := wsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookSchema ID in the query.
+// Returns a *NotSingularError when more than one WebhookSchema ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wsq *WebhookSchemaQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wsq.Limit(2).IDs(setContextOp(ctx, wsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookschema.Label}
+	default:
+		err = &NotSingularError{webhookschema.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wsq *WebhookSchemaQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookSchemas.
+func (wsq *WebhookSchemaQuery) All(ctx context.Context) ([]*WebhookSchema, error) {
+	ctx = setContextOp(ctx, wsq.ctx, ent.OpQueryAll)
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WebhookSchema, *WebhookSchemaQuery]()
+	return withInterceptors[[]*WebhookSchema](ctx, wsq, qr, wsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wsq *WebhookSchemaQuery) AllX(ctx context.Context) []*WebhookSchema {
+	nodes, err := wsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookSchema IDs.
+func (wsq *WebhookSchemaQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if wsq.ctx.Unique == nil && wsq.path != nil {
+		wsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wsq.ctx, ent.OpQueryIDs)
+	if err = wsq.Select(webhookschema.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wsq *WebhookSchemaQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wsq *WebhookSchemaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wsq.ctx, ent.OpQueryCount)
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wsq, querierCount[*WebhookSchemaQuery](), wsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wsq *WebhookSchemaQuery) CountX(ctx context.Context) int {
+	count, err := wsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wsq *WebhookSchemaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wsq.ctx, ent.OpQueryExist)
+	switch _, err := wsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entgen: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wsq *WebhookSchemaQuery) ExistX(ctx context.Context) bool {
+	exist, err := wsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookSchemaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wsq *WebhookSchemaQuery) Clone() *WebhookSchemaQuery {
+	if wsq == nil {
+		return nil
+	}
+	return &WebhookSchemaQuery{
+		config:         wsq.config,
+		ctx:            wsq.ctx.Clone(),
+		order:          append([]webhookschema.OrderOption{}, wsq.order...),
+		inters:         append([]Interceptor{}, wsq.inters...),
+		predicates:     append([]predicate.WebhookSchema{}, wsq.predicates...),
+		withDeliveries: wsq.withDeliveries.Clone(),
+		// clone intermediate query.
+		sql:       wsq.sql.Clone(),
+		path:      wsq.path,
+		modifiers: append([]func(*sql.Selector){}, wsq.modifiers...),
+	}
+}
+
+// WithDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WebhookSchemaQuery) WithDeliveries(opts ...func(*WebhookDeliverySchemaQuery)) *WebhookSchemaQuery {
+	query := (&WebhookDeliverySchemaClient{config: wsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wsq.withDeliveries = query
+	return wsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		URL string `json:"url,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookSchema.Query().
+//		GroupBy(webhookschema.FieldURL).
+//		Aggregate(entgen.Count()).
+//		Scan(ctx, &v)
+func (wsq *WebhookSchemaQuery) GroupBy(field string, fields ...string) *WebhookSchemaGroupBy {
+	wsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WebhookSchemaGroupBy{build: wsq}
+	grbuild.flds = &wsq.ctx.Fields
+	grbuild.label = webhookschema.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		URL string `json:"url,omitempty"`
+//	}
+//
+//	client.WebhookSchema.Query().
+//		Select(webhookschema.FieldURL).
+//		Scan(ctx, &v)
+func (wsq *WebhookSchemaQuery) Select(fields ...string) *WebhookSchemaSelect {
+	wsq.ctx.Fields = append(wsq.ctx.Fields, fields...)
+	sbuild := &WebhookSchemaSelect{WebhookSchemaQuery: wsq}
+	sbuild.label = webhookschema.Label
+	sbuild.flds, sbuild.scan = &wsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WebhookSchemaSelect configured with the given aggregations.
+func (wsq *WebhookSchemaQuery) Aggregate(fns ...AggregateFunc) *WebhookSchemaSelect {
+	return wsq.Select().Aggregate(fns...)
+}
+
+func (wsq *WebhookSchemaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wsq.inters {
+		if inter == nil {
+			return fmt.Errorf("entgen: uninitialized interceptor (forgotten import entgen/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wsq.ctx.Fields {
+		if !webhookschema.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+		}
+	}
+	if wsq.path != nil {
+		prev, err := wsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wsq.sql = prev
+	}
+	return nil
+}
+
+func (wsq *WebhookSchemaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebhookSchema, error) {
+	var (
+		nodes       = []*WebhookSchema{}
+		_spec       = wsq.querySpec()
+		loadedTypes = [1]bool{
+			wsq.withDeliveries != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WebhookSchema).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WebhookSchema{config: wsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = wsq.schemaConfig.WebhookSchema
+	ctx = internal.NewSchemaConfigContext(ctx, wsq.schemaConfig)
+	if len(wsq.modifiers) > 0 {
+		_spec.Modifiers = wsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wsq.withDeliveries; query != nil {
+		if err := wsq.loadDeliveries(ctx, query, nodes,
+			func(n *WebhookSchema) { n.Edges.Deliveries = []*WebhookDeliverySchema{} },
+			func(n *WebhookSchema, e *WebhookDeliverySchema) {
+				n.Edges.Deliveries = append(n.Edges.Deliveries, e)
+				if !e.Edges.loadedTypes[0] {
+					e.Edges.Webhook = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range wsq.withNamedDeliveries {
+		if err := wsq.loadDeliveries(ctx, query, nodes,
+			func(n *WebhookSchema) { n.appendNamedDeliveries(name) },
+			func(n *WebhookSchema, e *WebhookDeliverySchema) {
+				n.appendNamedDeliveries(name, e)
+				if !e.Edges.loadedTypes[0] {
+					e.Edges.Webhook = n
+				}
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for i := range wsq.loadTotal {
+		if err := wsq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wsq *WebhookSchemaQuery) loadDeliveries(ctx context.Context, query *WebhookDeliverySchemaQuery, nodes []*WebhookSchema, init func(*WebhookSchema), assign func(*WebhookSchema, *WebhookDeliverySchema)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*WebhookSchema)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhookdeliveryschema.FieldWebhookID)
+	}
+	query.Where(predicate.WebhookDeliverySchema(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(webhookschema.DeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WebhookID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "webhook_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (wsq *WebhookSchemaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wsq.querySpec()
+	_spec.Node.Schema = wsq.schemaConfig.WebhookSchema
+	ctx = internal.NewSchemaConfigContext(ctx, wsq.schemaConfig)
+	if len(wsq.modifiers) > 0 {
+		_spec.Modifiers = wsq.modifiers
+	}
+	_spec.Node.Columns = wsq.ctx.Fields
+	if len(wsq.ctx.Fields) > 0 {
+		_spec.Unique = wsq.ctx.Unique != nil && *wsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wsq.driver, _spec)
+}
+
+func (wsq *WebhookSchemaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(webhookschema.Table, webhookschema.Columns, sqlgraph.NewFieldSpec(webhookschema.FieldID, field.TypeUUID))
+	_spec.From = wsq.sql
+	if unique := wsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookschema.FieldID)
+		for i := range fields {
+			if fields[i] != webhookschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wsq *WebhookSchemaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wsq.driver.Dialect())
+	t1 := builder.Table(webhookschema.Table)
+	columns := wsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = webhookschema.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wsq.sql != nil {
+		selector = wsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wsq.ctx.Unique != nil && *wsq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(wsq.schemaConfig.WebhookSchema)
+	ctx = internal.NewSchemaConfigContext(ctx, wsq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range wsq.modifiers {
+		m(selector)
+	}
+	for _, p := range wsq.predicates {
+		p(selector)
+	}
+	for _, p := range wsq.order {
+		p(selector)
+	}
+	if offset := wsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (wsq *WebhookSchemaQuery) ForUpdate(opts ...sql.LockOption) *WebhookSchemaQuery {
+	if wsq.driver.Dialect() == dialect.Postgres {
+		wsq.Unique(false)
+	}
+	wsq.modifiers = append(wsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return wsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (wsq *WebhookSchemaQuery) ForShare(opts ...sql.LockOption) *WebhookSchemaQuery {
+	if wsq.driver.Dialect() == dialect.Postgres {
+		wsq.Unique(false)
+	}
+	wsq.modifiers = append(wsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return wsq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wsq *WebhookSchemaQuery) Modify(modifiers ...func(s *sql.Selector)) *WebhookSchemaSelect {
+	wsq.modifiers = append(wsq.modifiers, modifiers...)
+	return wsq.Select()
+}
+
+// WithNamedDeliveries tells the query-builder to eager-load the nodes that are connected to the "deliveries"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WebhookSchemaQuery) WithNamedDeliveries(name string, opts ...func(*WebhookDeliverySchemaQuery)) *WebhookSchemaQuery {
+	query := (&WebhookDeliverySchemaClient{config: wsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if wsq.withNamedDeliveries == nil {
+		wsq.withNamedDeliveries = make(map[string]*WebhookDeliverySchemaQuery)
+	}
+	wsq.withNamedDeliveries[name] = query
+	return wsq
+}
+
+// WebhookSchemaGroupBy is the group-by builder for WebhookSchema entities.
+type WebhookSchemaGroupBy struct {
+	selector
+	build *WebhookSchemaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wsgb *WebhookSchemaGroupBy) Aggregate(fns ...AggregateFunc) *WebhookSchemaGroupBy {
+	wsgb.fns = append(wsgb.fns, fns...)
+	return wsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wsgb *WebhookSchemaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookSchemaQuery, *WebhookSchemaGroupBy](ctx, wsgb.build, wsgb, wsgb.build.inters, v)
+}
+
+func (wsgb *WebhookSchemaGroupBy) sqlScan(ctx context.Context, root *WebhookSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wsgb.fns))
+	for _, fn := range wsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wsgb.flds)+len(wsgb.fns))
+		for _, f := range *wsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WebhookSchemaSelect is the builder for selecting fields of WebhookSchema entities.
+type WebhookSchemaSelect struct {
+	*WebhookSchemaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wss *WebhookSchemaSelect) Aggregate(fns ...AggregateFunc) *WebhookSchemaSelect {
+	wss.fns = append(wss.fns, fns...)
+	return wss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wss *WebhookSchemaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wss.ctx, ent.OpQuerySelect)
+	if err := wss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebhookSchemaQuery, *WebhookSchemaSelect](ctx, wss.WebhookSchemaQuery, wss, wss.inters, v)
+}
+
+func (wss *WebhookSchemaSelect) sqlScan(ctx context.Context, root *WebhookSchemaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wss.fns))
+	for _, fn := range wss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wss *WebhookSchemaSelect) Modify(modifiers ...func(s *sql.Selector)) *WebhookSchemaSelect {
+	wss.modifiers = append(wss.modifiers, modifiers...)
+	return wss
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/internal"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
+)
+
+// WebhookSchemaUpdate is the builder for updating WebhookSchema entities.
+type WebhookSchemaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookSchemaUpdate builder.
+func (wsu *WebhookSchemaUpdate) Where(ps ...predicate.WebhookSchema) *WebhookSchemaUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetEvents sets the "events" field.
+func (wsu *WebhookSchemaUpdate) SetEvents(s []string) *WebhookSchemaUpdate {
+	wsu.mutation.SetEvents(s)
+	return wsu
+}
+
+// AppendEvents appends s to the "events" field.
+func (wsu *WebhookSchemaUpdate) AppendEvents(s []string) *WebhookSchemaUpdate {
+	wsu.mutation.AppendEvents(s)
+	return wsu
+}
+
+// SetEnabled sets the "enabled" field.
+func (wsu *WebhookSchemaUpdate) SetEnabled(b bool) *WebhookSchemaUpdate {
+	wsu.mutation.SetEnabled(b)
+	return wsu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wsu *WebhookSchemaUpdate) SetNillableEnabled(b *bool) *WebhookSchemaUpdate {
+	if b != nil {
+		wsu.SetEnabled(*b)
+	}
+	return wsu
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (wsu *WebhookSchemaUpdate) SetConsecutiveFailures(i int) *WebhookSchemaUpdate {
+	wsu.mutation.ResetConsecutiveFailures()
+	wsu.mutation.SetConsecutiveFailures(i)
+	return wsu
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (wsu *WebhookSchemaUpdate) SetNillableConsecutiveFailures(i *int) *WebhookSchemaUpdate {
+	if i != nil {
+		wsu.SetConsecutiveFailures(*i)
+	}
+	return wsu
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (wsu *WebhookSchemaUpdate) AddConsecutiveFailures(i int) *WebhookSchemaUpdate {
+	wsu.mutation.AddConsecutiveFailures(i)
+	return wsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsu *WebhookSchemaUpdate) SetUpdatedAt(t time.Time) *WebhookSchemaUpdate {
+	wsu.mutation.SetUpdatedAt(t)
+	return wsu
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDeliverySchema entity by IDs.
+func (wsu *WebhookSchemaUpdate) AddDeliveryIDs(ids ...uuid.UUID) *WebhookSchemaUpdate {
+	wsu.mutation.AddDeliveryIDs(ids...)
+	return wsu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDeliverySchema entity.
+func (wsu *WebhookSchemaUpdate) AddDeliveries(w ...*WebhookDeliverySchema) *WebhookSchemaUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookSchemaMutation object of the builder.
+func (wsu *WebhookSchemaUpdate) Mutation() *WebhookSchemaMutation {
+	return wsu.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDeliverySchema entity.
+func (wsu *WebhookSchemaUpdate) ClearDeliveries() *WebhookSchemaUpdate {
+	wsu.mutation.ClearDeliveries()
+	return wsu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDeliverySchema entities by IDs.
+func (wsu *WebhookSchemaUpdate) RemoveDeliveryIDs(ids ...uuid.UUID) *WebhookSchemaUpdate {
+	wsu.mutation.RemoveDeliveryIDs(ids...)
+	return wsu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDeliverySchema entities.
+func (wsu *WebhookSchemaUpdate) RemoveDeliveries(w ...*WebhookDeliverySchema) *WebhookSchemaUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WebhookSchemaUpdate) Save(ctx context.Context) (int, error) {
+	wsu.defaults()
+	return withHooks(ctx, wsu.sqlSave, wsu.mutation, wsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WebhookSchemaUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WebhookSchemaUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WebhookSchemaUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsu *WebhookSchemaUpdate) defaults() {
+	if _, ok := wsu.mutation.UpdatedAt(); !ok {
+		v := webhookschema.UpdateDefaultUpdatedAt()
+		wsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsu *WebhookSchemaUpdate) check() error {
+	if v, ok := wsu.mutation.ConsecutiveFailures(); ok {
+		if err := webhookschema.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`entgen: validator failed for field "WebhookSchema.consecutive_failures": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsu *WebhookSchemaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookSchemaUpdate {
+	wsu.modifiers = append(wsu.modifiers, modifiers...)
+	return wsu
+}
+
+func (wsu *WebhookSchemaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookschema.Table, webhookschema.Columns, sqlgraph.NewFieldSpec(webhookschema.FieldID, field.TypeUUID))
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsu.mutation.Events(); ok {
+		_spec.SetField(webhookschema.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := wsu.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookschema.FieldEvents, value)
+		})
+	}
+	if value, ok := wsu.mutation.Enabled(); ok {
+		_spec.SetField(webhookschema.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := wsu.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhookschema.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(webhookschema.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := wsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wsu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookschema.DeliveriesTable,
+			Columns: []string{webhookschema.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wsu.schemaConfig.WebhookDeliverySchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wsu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookschema.DeliveriesTable,
+			Columns: []string{webhookschema.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wsu.schemaConfig.WebhookDeliverySchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookschema.DeliveriesTable,
+			Columns: []string{webhookschema.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wsu.schemaConfig.WebhookDeliverySchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wsu.schemaConfig.WebhookSchema
+	ctx = internal.NewSchemaConfigContext(ctx, wsu.schemaConfig)
+	_spec.AddModifiers(wsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wsu.mutation.done = true
+	return n, nil
+}
+
+// WebhookSchemaUpdateOne is the builder for updating a single WebhookSchema entity.
+type WebhookSchemaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookSchemaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetEvents sets the "events" field.
+func (wsuo *WebhookSchemaUpdateOne) SetEvents(s []string) *WebhookSchemaUpdateOne {
+	wsuo.mutation.SetEvents(s)
+	return wsuo
+}
+
+// AppendEvents appends s to the "events" field.
+func (wsuo *WebhookSchemaUpdateOne) AppendEvents(s []string) *WebhookSchemaUpdateOne {
+	wsuo.mutation.AppendEvents(s)
+	return wsuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (wsuo *WebhookSchemaUpdateOne) SetEnabled(b bool) *WebhookSchemaUpdateOne {
+	wsuo.mutation.SetEnabled(b)
+	return wsuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wsuo *WebhookSchemaUpdateOne) SetNillableEnabled(b *bool) *WebhookSchemaUpdateOne {
+	if b != nil {
+		wsuo.SetEnabled(*b)
+	}
+	return wsuo
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (wsuo *WebhookSchemaUpdateOne) SetConsecutiveFailures(i int) *WebhookSchemaUpdateOne {
+	wsuo.mutation.ResetConsecutiveFailures()
+	wsuo.mutation.SetConsecutiveFailures(i)
+	return wsuo
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (wsuo *WebhookSchemaUpdateOne) SetNillableConsecutiveFailures(i *int) *WebhookSchemaUpdateOne {
+	if i != nil {
+		wsuo.SetConsecutiveFailures(*i)
+	}
+	return wsuo
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (wsuo *WebhookSchemaUpdateOne) AddConsecutiveFailures(i int) *WebhookSchemaUpdateOne {
+	wsuo.mutation.AddConsecutiveFailures(i)
+	return wsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsuo *WebhookSchemaUpdateOne) SetUpdatedAt(t time.Time) *WebhookSchemaUpdateOne {
+	wsuo.mutation.SetUpdatedAt(t)
+	return wsuo
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDeliverySchema entity by IDs.
+func (wsuo *WebhookSchemaUpdateOne) AddDeliveryIDs(ids ...uuid.UUID) *WebhookSchemaUpdateOne {
+	wsuo.mutation.AddDeliveryIDs(ids...)
+	return wsuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDeliverySchema entity.
+func (wsuo *WebhookSchemaUpdateOne) AddDeliveries(w ...*WebhookDeliverySchema) *WebhookSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookSchemaMutation object of the builder.
+func (wsuo *WebhookSchemaUpdateOne) Mutation() *WebhookSchemaMutation {
+	return wsuo.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDeliverySchema entity.
+func (wsuo *WebhookSchemaUpdateOne) ClearDeliveries() *WebhookSchemaUpdateOne {
+	wsuo.mutation.ClearDeliveries()
+	return wsuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDeliverySchema entities by IDs.
+func (wsuo *WebhookSchemaUpdateOne) RemoveDeliveryIDs(ids ...uuid.UUID) *WebhookSchemaUpdateOne {
+	wsuo.mutation.RemoveDeliveryIDs(ids...)
+	return wsuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDeliverySchema entities.
+func (wsuo *WebhookSchemaUpdateOne) RemoveDeliveries(w ...*WebhookDeliverySchema) *WebhookSchemaUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WebhookSchemaUpdate builder.
+func (wsuo *WebhookSchemaUpdateOne) Where(ps ...predicate.WebhookSchema) *WebhookSchemaUpdateOne {
+	wsuo.mutation.Where(ps...)
+	return wsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WebhookSchemaUpdateOne) Select(field string, fields ...string) *WebhookSchemaUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WebhookSchema entity.
+func (wsuo *WebhookSchemaUpdateOne) Save(ctx context.Context) (*WebhookSchema, error) {
+	wsuo.defaults()
+	return withHooks(ctx, wsuo.sqlSave, wsuo.mutation, wsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WebhookSchemaUpdateOne) SaveX(ctx context.Context) *WebhookSchema {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WebhookSchemaUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WebhookSchemaUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsuo *WebhookSchemaUpdateOne) defaults() {
+	if _, ok := wsuo.mutation.UpdatedAt(); !ok {
+		v := webhookschema.UpdateDefaultUpdatedAt()
+		wsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsuo *WebhookSchemaUpdateOne) check() error {
+	if v, ok := wsuo.mutation.ConsecutiveFailures(); ok {
+		if err := webhookschema.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`entgen: validator failed for field "WebhookSchema.consecutive_failures": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsuo *WebhookSchemaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookSchemaUpdateOne {
+	wsuo.modifiers = append(wsuo.modifiers, modifiers...)
+	return wsuo
+}
+
+func (wsuo *WebhookSchemaUpdateOne) sqlSave(ctx context.Context) (_node *WebhookSchema, err error) {
+	if err := wsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookschema.Table, webhookschema.Columns, sqlgraph.NewFieldSpec(webhookschema.FieldID, field.TypeUUID))
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "WebhookSchema.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookschema.FieldID)
+		for _, f := range fields {
+			if !webhookschema.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != webhookschema.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsuo.mutation.Events(); ok {
+		_spec.SetField(webhookschema.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := wsuo.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookschema.FieldEvents, value)
+		})
+	}
+	if value, ok := wsuo.mutation.Enabled(); ok {
+		_spec.SetField(webhookschema.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := wsuo.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(webhookschema.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(webhookschema.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := wsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookschema.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wsuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookschema.DeliveriesTable,
+			Columns: []string{webhookschema.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wsuo.schemaConfig.WebhookDeliverySchema
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wsuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookschema.DeliveriesTable,
+			Columns: []string{webhookschema.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wsuo.schemaConfig.WebhookDeliverySchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhookschema.DeliveriesTable,
+			Columns: []string{webhookschema.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdeliveryschema.FieldID, field.TypeUUID),
+			},
+		}
+		edge.Schema = wsuo.schemaConfig.WebhookDeliverySchema
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wsuo.schemaConfig.WebhookSchema
+	ctx = internal.NewSchemaConfigContext(ctx, wsuo.schemaConfig)
+	_spec.AddModifiers(wsuo.modifiers...)
+	_node = &WebhookSchema{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookschema.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,156 @@
+package attachmentrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/attachmentschema"
+	"github.com/samber/do"
+)
+
+// attachmentRepository is the implementation of the AttachmentRepository interface.
+type attachmentRepository struct{}
+
+// NewAttachmentRepository creates a new AttachmentRepository.
+func NewAttachmentRepository(i *do.Injector) (attachment.AttachmentRepository, error) {
+	return &attachmentRepository{}, nil
+}
+
+// Create creates the Attachment.
+func (r attachmentRepository) Create(ctx context.Context, attachment *attachment.Attachment) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.AttachmentSchema.Create().
+		SetID(attachment.ID().UUID()).
+		SetTodoID(attachment.TodoID().UUID()).
+		SetFilename(attachment.Filename()).
+		SetContentType(attachment.ContentType()).
+		SetSize(attachment.Size()).
+		SetChecksum(attachment.Checksum()).
+		SetCreatedAt(attachment.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		return db.TranslateError(err, attachmentErrors(attachment.ID()), "failed to create attachment")
+	}
+	return nil
+}
+
+// FindByID returns the Attachment with the given ID.
+func (r attachmentRepository) FindByID(ctx context.Context, id attachment.AttachmentID) (*attachment.Attachment, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := tx.AttachmentSchema.Get(ctx, id.UUID())
+	if err != nil {
+		return nil, db.TranslateError(err, attachmentErrors(id), "failed to find attachment %v", id)
+	}
+
+	return convertEntToAttachment(entity), nil
+}
+
+// FindByIDs returns the attachments that exist among the given IDs.
+func (r attachmentRepository) FindByIDs(
+	ctx context.Context,
+	ids []attachment.AttachmentID,
+) ([]*attachment.Attachment, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		uuids[i] = id.UUID()
+	}
+
+	entities, err := tx.AttachmentSchema.
+		Query().
+		Where(attachmentschema.IDIn(uuids...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find attachments: %w", err)
+	}
+	return convertEntsToAttachments(entities), nil
+}
+
+// FindByTodoIDs returns the attachments of the todos, oldest first.
+func (r attachmentRepository) FindByTodoIDs(
+	ctx context.Context,
+	todoIDs []todo.TodoID,
+) ([]*attachment.Attachment, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make([]uuid.UUID, len(todoIDs))
+	for i, id := range todoIDs {
+		uuids[i] = id.UUID()
+	}
+
+	entities, err := tx.AttachmentSchema.
+		Query().
+		Where(attachmentschema.TodoIDIn(uuids...)).
+		Order(entgen.Asc(attachmentschema.FieldCreatedAt), entgen.Asc(attachmentschema.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find attachments of todos: %w", err)
+	}
+	return convertEntsToAttachments(entities), nil
+}
+
+// Delete deletes the Attachment with the given ID. The blob is left to the caller.
+func (r attachmentRepository) Delete(ctx context.Context, id attachment.AttachmentID) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.AttachmentSchema.DeleteOneID(id.UUID()).Exec(ctx)
+	if err != nil {
+		return db.TranslateError(err, attachmentErrors(id), "failed to delete attachment %v", id)
+	}
+	return nil
+}
+
+// convertEntsToAttachments converts ent.AttachmentSchema entities to domain Attachments
+func convertEntsToAttachments(entities []*entgen.AttachmentSchema) []*attachment.Attachment {
+	attachments := make([]*attachment.Attachment, len(entities))
+	for i, entity := range entities {
+		attachments[i] = convertEntToAttachment(entity)
+	}
+	return attachments
+}
+
+// attachmentErrors returns the domain errors of the attachment with the ID.
+func attachmentErrors(id attachment.AttachmentID) db.DomainErrors {
+	return db.DomainErrors{
+		NotFound: func() error { return &attachment.NotFoundError{ID: id} },
+		Invalid: func(field, message string) error {
+			return &attachment.ValidationError{Field: field, Message: message}
+		},
+	}
+}
+
+// convertEntToAttachment converts ent.AttachmentSchema to domain Attachment
+func convertEntToAttachment(v *entgen.AttachmentSchema) *attachment.Attachment {
+	return attachment.ReconstructAttachment(
+		v.ID,
+		todo.TodoID(v.TodoID),
+		v.Filename,
+		v.ContentType,
+		v.Size,
+		v.Checksum,
+		v.CreatedAt,
+	)
+}
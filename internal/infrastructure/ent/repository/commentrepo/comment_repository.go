@@ -0,0 +1,176 @@
+package commentrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/commentschema"
+	"github.com/samber/do"
+)
+
+// commentRepository is the implementation of the CommentRepository interface.
+type commentRepository struct{}
+
+// NewCommentRepository creates a new CommentRepository.
+func NewCommentRepository(i *do.Injector) (comment.CommentRepository, error) {
+	return &commentRepository{}, nil
+}
+
+// Create creates the Comment.
+func (r commentRepository) Create(ctx context.Context, comment *comment.Comment) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.CommentSchema.Create().
+		SetID(comment.ID().UUID()).
+		SetTodoID(comment.TodoID().UUID()).
+		SetAuthor(comment.Author()).
+		SetBody(comment.Body()).
+		SetCreatedAt(comment.CreatedAt()).
+		SetUpdatedAt(comment.UpdatedAt()).
+		SetNillableEditedAt(comment.EditedAt()).
+		SetNillableDeletedAt(comment.DeletedAt()).
+		Save(ctx)
+	if err != nil {
+		return db.TranslateError(err, commentErrors(comment.ID()), "failed to create comment")
+	}
+	return nil
+}
+
+// Update updates the Comment with the given ID.
+func (r commentRepository) Update(ctx context.Context, comment *comment.Comment) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.CommentSchema.UpdateOneID(comment.ID().UUID()).
+		SetBody(comment.Body()).
+		SetNillableEditedAt(comment.EditedAt()).
+		SetNillableDeletedAt(comment.DeletedAt()).
+		SetUpdatedAt(comment.UpdatedAt()).
+		Save(ctx)
+	if err != nil {
+		return db.TranslateError(err, commentErrors(comment.ID()), "failed to update comment %v", comment.ID())
+	}
+	return nil
+}
+
+// FindByID returns the Comment with the given ID.
+func (r commentRepository) FindByID(ctx context.Context, id comment.CommentID) (*comment.Comment, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := tx.CommentSchema.Get(ctx, id.UUID())
+	if err != nil {
+		return nil, db.TranslateError(err, commentErrors(id), "failed to find comment %v", id)
+	}
+
+	return convertEntToComment(entity), nil
+}
+
+// FindByTodoID returns the comments on the Todo, oldest first, deleted ones included.
+func (r commentRepository) FindByTodoID(
+	ctx context.Context,
+	todoID todo.TodoID,
+	limit int,
+	offset int,
+) ([]*comment.Comment, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.CommentSchema.
+		Query().
+		Where(commentschema.TodoID(todoID.UUID())).
+		Order(entgen.Asc(commentschema.FieldCreatedAt), entgen.Asc(commentschema.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comments on todo %v: %w", todoID, err)
+	}
+
+	comments := make([]*comment.Comment, len(entities))
+	for i, entity := range entities {
+		comments[i] = convertEntToComment(entity)
+	}
+	return comments, nil
+}
+
+// CountByTodoIDs counts the comments that are not deleted on each of the todos.
+func (r commentRepository) CountByTodoIDs(
+	ctx context.Context,
+	todoIDs []todo.TodoID,
+) (map[todo.TodoID]int, error) {
+	counts := make(map[todo.TodoID]int)
+	if len(todoIDs) == 0 {
+		return counts, nil
+	}
+
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(todoIDs))
+	for i, id := range todoIDs {
+		ids[i] = id.UUID()
+	}
+
+	var rows []struct {
+		TodoID uuid.UUID `json:"todo_id"`
+		Count  int       `json:"count"`
+	}
+	err = tx.CommentSchema.
+		Query().
+		Where(
+			commentschema.TodoIDIn(ids...),
+			commentschema.DeletedAtIsNil(),
+		).
+		GroupBy(commentschema.FieldTodoID).
+		Aggregate(entgen.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	for _, row := range rows {
+		counts[todo.TodoID(row.TodoID)] = row.Count
+	}
+	return counts, nil
+}
+
+// commentErrors returns the domain errors of the comment with the ID.
+func commentErrors(id comment.CommentID) db.DomainErrors {
+	return db.DomainErrors{
+		NotFound: func() error { return &comment.NotFoundError{ID: id} },
+		Invalid: func(field, message string) error {
+			return &comment.ValidationError{Field: field, Message: message}
+		},
+	}
+}
+
+// convertEntToComment converts ent.CommentSchema to domain Comment
+func convertEntToComment(v *entgen.CommentSchema) *comment.Comment {
+	return comment.ReconstructComment(
+		v.ID,
+		todo.TodoID(v.TodoID),
+		v.Author,
+		v.Body,
+		v.CreatedAt,
+		v.UpdatedAt,
+		v.EditedAt,
+		v.DeletedAt,
+	)
+}
@@ -0,0 +1,81 @@
+package externalrefrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/externalref"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/externalrefschema"
+	"github.com/samber/do"
+)
+
+// externalRefRepository is the implementation of the ExternalRefRepository interface.
+type externalRefRepository struct{}
+
+// NewExternalRefRepository creates a new ExternalRefRepository.
+func NewExternalRefRepository(i *do.Injector) (externalref.ExternalRefRepository, error) {
+	return &externalRefRepository{}, nil
+}
+
+// Create creates the ExternalRef.
+func (r externalRefRepository) Create(ctx context.Context, ref *externalref.ExternalRef) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExternalRefSchema.Create().
+		SetSource(externalrefschema.Source(ref.Source().String())).
+		SetKind(externalrefschema.Kind(ref.Kind().String())).
+		SetExternalID(ref.ExternalID()).
+		SetLocalID(ref.LocalID()).
+		SetCreatedAt(ref.CreatedAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create external ref: %w", err)
+	}
+	return nil
+}
+
+// Find returns the ExternalRef of the imported item.
+func (r externalRefRepository) Find(
+	ctx context.Context,
+	source externalref.Source,
+	kind externalref.Kind,
+	externalID string,
+) (*externalref.ExternalRef, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := tx.ExternalRefSchema.
+		Query().
+		Where(
+			externalrefschema.SourceEQ(externalrefschema.Source(source.String())),
+			externalrefschema.KindEQ(externalrefschema.Kind(kind.String())),
+			externalrefschema.ExternalID(externalID),
+		).
+		Only(ctx)
+	if err != nil {
+		if entgen.IsNotFound(err) {
+			return nil, &externalref.NotFoundError{Source: source, Kind: kind, ExternalID: externalID}
+		}
+		return nil, fmt.Errorf("failed to find external ref %s %s %s: %w", source, kind, externalID, err)
+	}
+
+	return convertEntToExternalRef(entity), nil
+}
+
+// convertEntToExternalRef converts an ent entity to a domain ExternalRef.
+func convertEntToExternalRef(entity *entgen.ExternalRefSchema) *externalref.ExternalRef {
+	return externalref.ReconstructExternalRef(
+		externalref.Source(entity.Source),
+		externalref.Kind(entity.Kind),
+		entity.ExternalID,
+		entity.LocalID,
+		entity.CreatedAt,
+	)
+}
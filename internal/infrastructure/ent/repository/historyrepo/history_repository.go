@@ -0,0 +1,179 @@
+package historyrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todohistoryschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
+	"github.com/samber/do"
+)
+
+// historyRepository is the implementation of the HistoryRepository interface.
+type historyRepository struct{}
+
+// NewHistoryRepository creates a new HistoryRepository.
+func NewHistoryRepository(i *do.Injector) (history.HistoryRepository, error) {
+	return &historyRepository{}, nil
+}
+
+// CreateEntry saves the HistoryEntry in the transaction as the next version of its Todo,
+// and returns the saved entry with its version.
+// The TodoRepository calls it in the transaction of the mutation the entry records.
+func CreateEntry(ctx context.Context, tx *entgen.Tx, entry *history.HistoryEntry) (*history.HistoryEntry, error) {
+	version := 1
+	latest, err := tx.TodoHistorySchema.
+		Query().
+		Where(todohistoryschema.TodoID(entry.TodoID().UUID())).
+		Order(entgen.Desc(todohistoryschema.FieldVersion)).
+		First(ctx)
+	if err != nil && !entgen.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to find latest version of todo %v: %w", entry.TodoID(), err)
+	}
+	if latest != nil {
+		version = latest.Version + 1
+	}
+
+	changes := make([]schema.HistoryChange, len(entry.Changes()))
+	for i, change := range entry.Changes() {
+		changes[i] = schema.HistoryChange{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		}
+	}
+
+	create := tx.TodoHistorySchema.Create().
+		SetID(entry.ID().UUID()).
+		SetTodoID(entry.TodoID().UUID()).
+		SetVersion(version).
+		SetActor(entry.Actor()).
+		SetOperation(todohistoryschema.Operation(entry.Operation().String())).
+		SetChanges(changes).
+		SetOccurredAt(entry.OccurredAt())
+	if entry.ProjectID() != nil {
+		create = create.SetProjectID(entry.ProjectID().UUID())
+	}
+	saved, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create history entry of todo %v: %w", entry.TodoID(), err)
+	}
+	return convertEntToHistoryEntry(saved), nil
+}
+
+// FindByTodoID returns the history of the Todo, oldest first.
+func (r historyRepository) FindByTodoID(
+	ctx context.Context,
+	todoID todo.TodoID,
+	limit int,
+	offset int,
+) ([]*history.HistoryEntry, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.TodoHistorySchema.
+		Query().
+		Where(todohistoryschema.TodoID(todoID.UUID())).
+		Order(entgen.Asc(todohistoryschema.FieldVersion)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find history of todo %v: %w", todoID, err)
+	}
+	return convertEntsToHistoryEntries(entities), nil
+}
+
+// FindByTodoIDUntil returns the history of the Todo recorded at or before until, oldest first.
+func (r historyRepository) FindByTodoIDUntil(
+	ctx context.Context,
+	todoID todo.TodoID,
+	until time.Time,
+) ([]*history.HistoryEntry, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.TodoHistorySchema.
+		Query().
+		Where(
+			todohistoryschema.TodoID(todoID.UUID()),
+			todohistoryschema.OccurredAtLTE(until),
+		).
+		Order(entgen.Asc(todohistoryschema.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find history of todo %v until %v: %w", todoID, until, err)
+	}
+	return convertEntsToHistoryEntries(entities), nil
+}
+
+// FindByProjectID returns the history of the todos of the Project, newest first.
+func (r historyRepository) FindByProjectID(
+	ctx context.Context,
+	projectID project.ProjectID,
+	limit int,
+	offset int,
+) ([]*history.HistoryEntry, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.TodoHistorySchema.
+		Query().
+		Where(todohistoryschema.ProjectID(projectID.UUID())).
+		Order(entgen.Desc(todohistoryschema.FieldOccurredAt), entgen.Desc(todohistoryschema.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activity of project %v: %w", projectID, err)
+	}
+	return convertEntsToHistoryEntries(entities), nil
+}
+
+// convertEntsToHistoryEntries converts ent.TodoHistorySchemas to domain HistoryEntries
+func convertEntsToHistoryEntries(entities []*entgen.TodoHistorySchema) []*history.HistoryEntry {
+	entries := make([]*history.HistoryEntry, len(entities))
+	for i, entity := range entities {
+		entries[i] = convertEntToHistoryEntry(entity)
+	}
+	return entries
+}
+
+// convertEntToHistoryEntry converts ent.TodoHistorySchema to domain HistoryEntry
+func convertEntToHistoryEntry(v *entgen.TodoHistorySchema) *history.HistoryEntry {
+	changes := make([]history.FieldChange, len(v.Changes))
+	for i, change := range v.Changes {
+		changes[i] = history.FieldChange{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		}
+	}
+	var projectID *project.ProjectID
+	if v.ProjectID != nil {
+		id := project.ProjectID(*v.ProjectID)
+		projectID = &id
+	}
+	return history.ReconstructHistoryEntry(
+		v.ID,
+		todo.TodoID(v.TodoID),
+		v.Version,
+		projectID,
+		v.Actor,
+		history.Operation(v.Operation),
+		changes,
+		v.OccurredAt,
+	)
+}
@@ -0,0 +1,164 @@
+package projectrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/projectschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
+	"github.com/samber/do"
+)
+
+// projectRepository is the implementation of the ProjectRepository interface.
+type projectRepository struct{}
+
+// NewProjectRepository creates a new ProjectRepository.
+func NewProjectRepository(i *do.Injector) (project.ProjectRepository, error) {
+	return &projectRepository{}, nil
+}
+
+// Create creates the Project.
+func (r projectRepository) Create(ctx context.Context, project *project.Project) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ProjectSchema.Create().
+		SetID(project.ID().UUID()).
+		SetName(project.Name()).
+		SetWorkflow(ConvertWorkflowToEnt(project.Workflow())).
+		SetCreatedAt(project.CreatedAt()).
+		SetUpdatedAt(project.UpdatedAt()).
+		Save(ctx)
+	if err != nil {
+		return db.TranslateError(err, projectErrors(project.ID()), "failed to create project")
+	}
+	return nil
+}
+
+// FindAll returns all Projects ordered by name.
+func (r projectRepository) FindAll(ctx context.Context) ([]*project.Project, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.ProjectSchema.
+		Query().
+		Order(entgen.Asc(projectschema.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find all projects: %w", err)
+	}
+
+	projects := make([]*project.Project, len(entities))
+	for i, entity := range entities {
+		p, err := convertEntToProject(entity)
+		if err != nil {
+			return nil, err
+		}
+		projects[i] = p
+	}
+	return projects, nil
+}
+
+// FindByID returns the Project with the given ID.
+func (r projectRepository) FindByID(ctx context.Context, id project.ProjectID) (*project.Project, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := tx.ProjectSchema.Get(ctx, id.UUID())
+	if err != nil {
+		return nil, db.TranslateError(err, projectErrors(id), "failed to find project %v", id)
+	}
+
+	return convertEntToProject(entity)
+}
+
+// Delete deletes the Project with the given ID.
+func (r projectRepository) Delete(ctx context.Context, id project.ProjectID) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.ProjectSchema.DeleteOneID(id.UUID()).Exec(ctx)
+	if err != nil {
+		return db.TranslateError(err, projectErrors(id), "failed to delete project %v", id)
+	}
+	return nil
+}
+
+// ConvertWorkflowToEnt converts a domain Workflow to its stored JSON representation
+func ConvertWorkflowToEnt(workflow *project.Workflow) schema.WorkflowDefinition {
+	var def schema.WorkflowDefinition
+	for _, s := range workflow.Statuses() {
+		def.Statuses = append(def.Statuses, schema.WorkflowStatusDefinition{
+			ID:       s.ID.String(),
+			Name:     s.Name,
+			Category: s.Category.String(),
+		})
+	}
+	for _, t := range workflow.Transitions() {
+		def.Transitions = append(def.Transitions, schema.WorkflowTransitionDefinition{
+			From: t.From.String(),
+			To:   t.To.String(),
+		})
+	}
+	return def
+}
+
+// ConvertEntToWorkflow converts the stored JSON representation to a domain Workflow
+func ConvertEntToWorkflow(def schema.WorkflowDefinition) (*project.Workflow, error) {
+	statuses := make([]project.WorkflowStatus, len(def.Statuses))
+	for i, s := range def.Statuses {
+		category, err := project.NewStatusCategoryFromString(s.Category)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert status %q: %w", s.ID, err)
+		}
+		statuses[i] = project.WorkflowStatus{
+			ID:       project.StatusID(s.ID),
+			Name:     s.Name,
+			Category: category,
+		}
+	}
+	transitions := make([]project.Transition, len(def.Transitions))
+	for i, t := range def.Transitions {
+		transitions[i] = project.Transition{
+			From: project.StatusID(t.From),
+			To:   project.StatusID(t.To),
+		}
+	}
+	return project.ReconstructWorkflow(statuses, transitions), nil
+}
+
+// projectErrors returns the domain errors of the project with the ID.
+func projectErrors(id project.ProjectID) db.DomainErrors {
+	return db.DomainErrors{
+		NotFound: func() error { return &project.NotFoundError{ID: id} },
+		Invalid: func(field, message string) error {
+			return &project.ValidationError{Field: field, Message: message}
+		},
+	}
+}
+
+// convertEntToProject converts ent.ProjectSchema to domain Project
+func convertEntToProject(v *entgen.ProjectSchema) (*project.Project, error) {
+	workflow, err := ConvertEntToWorkflow(v.Workflow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert workflow of project %v: %w", v.ID, err)
+	}
+	return project.ReconstructProject(
+		v.ID,
+		v.Name,
+		workflow,
+		v.CreatedAt,
+		v.UpdatedAt,
+	), nil
+}
@@ -0,0 +1,148 @@
+package tagrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
+	"github.com/samber/do"
+)
+
+// tagRepository is the implementation of the TagRepository interface.
+type tagRepository struct{}
+
+// NewTagRepository creates a new TagRepository.
+func NewTagRepository(i *do.Injector) (tag.TagRepository, error) {
+	return &tagRepository{}, nil
+}
+
+// Create creates the Tag.
+func (r tagRepository) Create(ctx context.Context, tag *tag.Tag) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.TagSchema.Create().
+		SetID(tag.ID().UUID()).
+		SetName(tag.Name()).
+		SetColor(tag.Color()).
+		SetCreatedAt(tag.CreatedAt()).
+		SetUpdatedAt(tag.UpdatedAt()).
+		Save(ctx)
+	if err != nil {
+		return db.TranslateError(err, tagErrors(tag.ID(), tag.Name()), "failed to create tag")
+	}
+	return nil
+}
+
+// FindAll returns all Tags ordered by name.
+func (r tagRepository) FindAll(ctx context.Context) (tags []*tag.Tag, err error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.TagSchema.
+		Query().
+		Order(entgen.Asc(tagschema.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find all tags: %w", err)
+	}
+
+	tags = make([]*tag.Tag, len(entities))
+	for i, entity := range entities {
+		tags[i] = convertEntToTag(entity)
+	}
+	return tags, nil
+}
+
+// FindByID returns the Tag with the given ID.
+func (r tagRepository) FindByID(ctx context.Context, id tag.TagID) (*tag.Tag, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := tx.TagSchema.Get(ctx, id.UUID())
+	if err != nil {
+		return nil, db.TranslateError(err, tagErrors(id, ""), "failed to find tag %v", id)
+	}
+
+	return convertEntToTag(entity), nil
+}
+
+// ExistsByName checks if a Tag with the given name exists.
+func (r tagRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	exists, err := tx.TagSchema.
+		Query().
+		Where(tagschema.Name(name)).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check tag name %q: %w", name, err)
+	}
+	return exists, nil
+}
+
+// Update updates the Tag with the given ID.
+func (r tagRepository) Update(ctx context.Context, tag *tag.Tag) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.TagSchema.UpdateOneID(tag.ID().UUID()).
+		SetName(tag.Name()).
+		SetColor(tag.Color()).
+		SetUpdatedAt(tag.UpdatedAt()).
+		Save(ctx)
+	if err != nil {
+		return db.TranslateError(err, tagErrors(tag.ID(), tag.Name()), "failed to update tag %v", tag.ID())
+	}
+	return nil
+}
+
+// Delete deletes the Tag with the given ID. The tag is detached from all todos.
+func (r tagRepository) Delete(ctx context.Context, id tag.TagID) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.TagSchema.DeleteOneID(id.UUID()).Exec(ctx)
+	if err != nil {
+		return db.TranslateError(err, tagErrors(id, ""), "failed to delete tag %v", id)
+	}
+	return nil
+}
+
+// tagErrors returns the domain errors of the tag with the ID and, when it is written, the name.
+func tagErrors(id tag.TagID, name string) db.DomainErrors {
+	return db.DomainErrors{
+		NotFound:      func() error { return &tag.NotFoundError{ID: id} },
+		AlreadyExists: func() error { return &tag.AlreadyExistsError{Name: name} },
+		Invalid: func(field, message string) error {
+			return &tag.ValidationError{Field: field, Message: message}
+		},
+	}
+}
+
+// convertEntToTag converts ent.TagSchema to domain Tag
+func convertEntToTag(v *entgen.TagSchema) *tag.Tag {
+	return tag.ReconstructTag(
+		v.ID,
+		v.Name,
+		v.Color,
+		v.CreatedAt,
+		v.UpdatedAt,
+	)
+}
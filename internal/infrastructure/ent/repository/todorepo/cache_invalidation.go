@@ -0,0 +1,88 @@
+package todorepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/cache"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/projectrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/tagrepo"
+	"github.com/samber/do"
+)
+
+// tagRepositoryInvalidatingTodos invalidates the todo cache when a tag is deleted,
+// because the database detaches the deleted tag from the todos.
+type tagRepositoryInvalidatingTodos struct {
+	tag.TagRepository
+	todos cachedTodoRepository
+}
+
+// NewTagRepositoryWithTodoCache creates the TagRepository, which invalidates the todo cache
+// of the injector when a tag is deleted. It returns the TagRepository alone when caching is disabled.
+func NewTagRepositoryWithTodoCache(i *do.Injector) (tag.TagRepository, error) {
+	next, err := tagrepo.NewTagRepository(i)
+	if err != nil {
+		return nil, err
+	}
+	todos, ok, err := invokeTodoCache(i)
+	if err != nil || !ok {
+		return next, err
+	}
+	return &tagRepositoryInvalidatingTodos{TagRepository: next, todos: todos}, nil
+}
+
+// Delete deletes the Tag and invalidates the todo cache once the transaction is committed.
+func (r tagRepositoryInvalidatingTodos) Delete(ctx context.Context, id tag.TagID) error {
+	if err := r.TagRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	return r.todos.invalidateAfterCommit(ctx)
+}
+
+// projectRepositoryInvalidatingTodos invalidates the todo cache when a project is deleted,
+// because the database removes the project from the todos still referencing it.
+type projectRepositoryInvalidatingTodos struct {
+	project.ProjectRepository
+	todos cachedTodoRepository
+}
+
+// NewProjectRepositoryWithTodoCache creates the ProjectRepository, which invalidates the todo cache
+// of the injector when a project is deleted. It returns the ProjectRepository alone when caching is disabled.
+func NewProjectRepositoryWithTodoCache(i *do.Injector) (project.ProjectRepository, error) {
+	next, err := projectrepo.NewProjectRepository(i)
+	if err != nil {
+		return nil, err
+	}
+	todos, ok, err := invokeTodoCache(i)
+	if err != nil || !ok {
+		return next, err
+	}
+	return &projectRepositoryInvalidatingTodos{ProjectRepository: next, todos: todos}, nil
+}
+
+// Delete deletes the Project and invalidates the todo cache once the transaction is committed.
+func (r projectRepositoryInvalidatingTodos) Delete(ctx context.Context, id project.ProjectID) error {
+	if err := r.ProjectRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	return r.todos.invalidateAfterCommit(ctx)
+}
+
+// invokeTodoCache returns a cachedTodoRepository on the cache of the injector, used only
+// for its invalidation, or false when caching is disabled.
+func invokeTodoCache(i *do.Injector) (cachedTodoRepository, bool, error) {
+	store, err := do.Invoke[cache.Store](i)
+	if err != nil {
+		return cachedTodoRepository{}, false, fmt.Errorf("failed to invoke cache store: %w", err)
+	}
+	if store == nil {
+		return cachedTodoRepository{}, false, nil
+	}
+	stats, err := do.Invoke[*cache.Stats](i)
+	if err != nil {
+		return cachedTodoRepository{}, false, fmt.Errorf("failed to invoke cache stats: %w", err)
+	}
+	return cachedTodoRepository{store: store, stats: stats}, true, nil
+}
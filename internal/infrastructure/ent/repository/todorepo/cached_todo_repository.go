@@ -0,0 +1,306 @@
+package todorepo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/cache"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/projectrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/schema"
+	"github.com/samber/do"
+)
+
+// cacheKeyPrefix is the prefix of every key written by the todo cache.
+const cacheKeyPrefix = "oniongo:todos:"
+
+// cacheNamespaceKey holds the random namespace of the current cache entries.
+// Every key of a todo or a list is inside the namespace, so replacing it
+// invalidates all of them at once, in every process sharing the store.
+const cacheNamespaceKey = cacheKeyPrefix + "namespace"
+
+// cachedTodoRepository is a read-through cache in front of a TodoRepository.
+//
+// Only reads made with a context marked by uow.WithReadOnly are served from the cache,
+// so the reads of a use case that writes the todo back always see the database.
+// A committed Create, Update or Delete invalidates every cached todo and list, because
+// a change to one todo can change others, such as the blockers of a deleted todo or the
+// todos listed as actionable. Nothing is invalidated when the transaction is rolled back.
+// Deleting a tag or a project changes the todos too; see NewTagRepositoryWithTodoCache
+// and NewProjectRepositoryWithTodoCache.
+type cachedTodoRepository struct {
+	next  todo.TodoRepository
+	store cache.Store
+	stats *cache.Stats
+}
+
+// NewCachedTodoRepository creates the TodoRepository, cached in the cache.Store of the injector.
+// It returns the TodoRepository without a cache when caching is disabled.
+func NewCachedTodoRepository(i *do.Injector) (todo.TodoRepository, error) {
+	next, err := NewTodoRepository(i)
+	if err != nil {
+		return nil, err
+	}
+	cached, ok, err := invokeTodoCache(i)
+	if err != nil || !ok {
+		return next, err
+	}
+	cached.next = next
+	return &cached, nil
+}
+
+// Create creates the Todo and invalidates the cache once the transaction is committed.
+func (r cachedTodoRepository) Create(ctx context.Context, t *todo.Todo) error {
+	if err := r.next.Create(ctx, t); err != nil {
+		return err
+	}
+	return r.invalidateAfterCommit(ctx)
+}
+
+// Update updates the Todo and invalidates the cache once the transaction is committed.
+func (r cachedTodoRepository) Update(ctx context.Context, t *todo.Todo) error {
+	if err := r.next.Update(ctx, t); err != nil {
+		return err
+	}
+	return r.invalidateAfterCommit(ctx)
+}
+
+// Delete deletes the Todo and invalidates the cache once the transaction is committed.
+func (r cachedTodoRepository) Delete(ctx context.Context, id todo.TodoID) error {
+	if err := r.next.Delete(ctx, id); err != nil {
+		return err
+	}
+	return r.invalidateAfterCommit(ctx)
+}
+
+// FindByID returns the Todo from the cache for read-only work, or from the database.
+func (r cachedTodoRepository) FindByID(ctx context.Context, id todo.TodoID) (*todo.Todo, error) {
+	if !uow.IsReadOnly(ctx) {
+		return r.next.FindByID(ctx, id)
+	}
+	key, ok := r.key(ctx, "todo:"+id.String())
+	if !ok {
+		return r.next.FindByID(ctx, id)
+	}
+	var cached cachedTodo
+	if r.lookup(ctx, key, &cached) {
+		if t, err := cached.toTodo(); err == nil {
+			return t, nil
+		}
+		r.stats.Error()
+	}
+
+	t, err := r.next.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.fill(ctx, key, newCachedTodo(t))
+	return t, nil
+}
+
+// FindAll returns the todos from the cache for read-only work, or from the database.
+func (r cachedTodoRepository) FindAll(ctx context.Context, filter todo.TodoFilter) ([]*todo.Todo, error) {
+	if !uow.IsReadOnly(ctx) {
+		return r.next.FindAll(ctx, filter)
+	}
+	filterJSON, err := json.Marshal(filter)
+	if err != nil {
+		return r.next.FindAll(ctx, filter)
+	}
+	sum := sha256.Sum256(filterJSON)
+	key, ok := r.key(ctx, "list:"+hex.EncodeToString(sum[:]))
+	if !ok {
+		return r.next.FindAll(ctx, filter)
+	}
+	var cached []cachedTodo
+	if r.lookup(ctx, key, &cached) {
+		if todos, err := cachedTodosToTodos(cached); err == nil {
+			return todos, nil
+		}
+		r.stats.Error()
+	}
+
+	todos, err := r.next.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]cachedTodo, len(todos))
+	for i, t := range todos {
+		entries[i] = newCachedTodo(t)
+	}
+	r.fill(ctx, key, entries)
+	return todos, nil
+}
+
+// FindAncestorIDs returns the IDs of the ancestors of the Todo from the database.
+func (r cachedTodoRepository) FindAncestorIDs(ctx context.Context, id todo.TodoID) ([]todo.TodoID, error) {
+	return r.next.FindAncestorIDs(ctx, id)
+}
+
+// FindDescendants returns the subtree below the Todo from the database.
+func (r cachedTodoRepository) FindDescendants(ctx context.Context, id todo.TodoID) ([]*todo.Todo, error) {
+	return r.next.FindDescendants(ctx, id)
+}
+
+// key returns the key of name in the current namespace, starting a namespace when there is none.
+// It returns false when the store fails, and the cache is then bypassed.
+func (r cachedTodoRepository) key(ctx context.Context, name string) (string, bool) {
+	namespace, ok, err := r.store.Get(ctx, cacheNamespaceKey)
+	if err != nil {
+		r.stats.Error()
+		return "", false
+	}
+	if !ok {
+		namespace = []byte(uuid.NewString())
+		if err := r.store.Set(ctx, cacheNamespaceKey, namespace); err != nil {
+			r.stats.Error()
+			return "", false
+		}
+	}
+	return cacheKeyPrefix + string(namespace) + ":" + name, true
+}
+
+// lookup decodes the cached value of the key into v, and counts the hit or the miss.
+func (r cachedTodoRepository) lookup(ctx context.Context, key string, v any) bool {
+	value, ok, err := r.store.Get(ctx, key)
+	if err != nil {
+		r.stats.Error()
+	}
+	if !ok || json.Unmarshal(value, v) != nil {
+		r.stats.Miss()
+		return false
+	}
+	r.stats.Hit()
+	return true
+}
+
+// fill stores what was read from the database. If a write was committed since
+// the namespace of the key was read, the key is already unreachable.
+func (r cachedTodoRepository) fill(ctx context.Context, key string, v any) {
+	value, err := json.Marshal(v)
+	if err == nil {
+		err = r.store.Set(ctx, key, value)
+	}
+	if err != nil {
+		r.stats.Error()
+	}
+}
+
+// invalidateAfterCommit invalidates the cache once the transaction of ctx is committed.
+func (r cachedTodoRepository) invalidateAfterCommit(ctx context.Context) error {
+	return uow.AfterCommit(ctx, r.invalidate)
+}
+
+// invalidate drops the current namespace. A failure leaves stale entries in the
+// cache until they expire, so it is logged as the write itself has been committed.
+func (r cachedTodoRepository) invalidate(ctx context.Context) {
+	if err := r.store.Delete(ctx, cacheNamespaceKey); err != nil {
+		r.stats.Error()
+		log.Printf("failed to invalidate the todo cache: %v", err)
+	}
+}
+
+// cachedTodo is the representation of a Todo in the cache.
+type cachedTodo struct {
+	ID          uuid.UUID                  `json:"id"`
+	Title       string                     `json:"title"`
+	Body        string                     `json:"body"`
+	Status      string                     `json:"status"`
+	CreatedAt   time.Time                  `json:"created_at"`
+	UpdatedAt   time.Time                  `json:"updated_at"`
+	CompletedAt *time.Time                 `json:"completed_at,omitempty"`
+	TagIDs      []uuid.UUID                `json:"tag_ids,omitempty"`
+	ParentID    *uuid.UUID                 `json:"parent_id,omitempty"`
+	BlockerIDs  []uuid.UUID                `json:"blocker_ids,omitempty"`
+	ProjectID   *uuid.UUID                 `json:"project_id,omitempty"`
+	Workflow    *schema.WorkflowDefinition `json:"workflow,omitempty"`
+	StatusID    string                     `json:"status_id,omitempty"`
+}
+
+func newCachedTodo(t *todo.Todo) cachedTodo {
+	c := cachedTodo{
+		ID:          t.ID().UUID(),
+		Title:       t.Title(),
+		Body:        t.Body(),
+		Status:      t.Status().String(),
+		CreatedAt:   t.CreatedAt(),
+		UpdatedAt:   t.UpdatedAt(),
+		CompletedAt: t.CompletedAt(),
+		TagIDs:      tagIDsToUUIDs(t.TagIDs()),
+		ParentID:    todoIDToUUIDPtr(t.ParentID()),
+		BlockerIDs:  todoIDsToUUIDs(t.BlockerIDs()),
+		ProjectID:   projectIDToUUIDPtr(t.ProjectID()),
+		StatusID:    workflowStatusID(t),
+	}
+	if workflow := t.Workflow(); workflow != nil {
+		def := projectrepo.ConvertWorkflowToEnt(workflow)
+		c.Workflow = &def
+	}
+	return c
+}
+
+func (c cachedTodo) toTodo() (*todo.Todo, error) {
+	status, err := todo.NewTodoStatusFromString(c.Status)
+	if err != nil {
+		return nil, err
+	}
+	tagIDs := make([]tag.TagID, len(c.TagIDs))
+	for i, id := range c.TagIDs {
+		tagIDs[i] = tag.TagID(id)
+	}
+	blockerIDs := make([]todo.TodoID, len(c.BlockerIDs))
+	for i, id := range c.BlockerIDs {
+		blockerIDs[i] = todo.TodoID(id)
+	}
+	var parentID *todo.TodoID
+	if c.ParentID != nil {
+		id := todo.TodoID(*c.ParentID)
+		parentID = &id
+	}
+	var projectID *project.ProjectID
+	if c.ProjectID != nil {
+		id := project.ProjectID(*c.ProjectID)
+		projectID = &id
+	}
+	var workflow *project.Workflow
+	if c.Workflow != nil {
+		if workflow, err = projectrepo.ConvertEntToWorkflow(*c.Workflow); err != nil {
+			return nil, err
+		}
+	}
+	return todo.ReconstructTodoWithStatus(
+		c.ID,
+		c.Title,
+		c.Body,
+		status,
+		c.CreatedAt,
+		c.UpdatedAt,
+		c.CompletedAt,
+		tagIDs,
+		parentID,
+		blockerIDs,
+		projectID,
+		workflow,
+		project.StatusID(c.StatusID),
+	), nil
+}
+
+func cachedTodosToTodos(cached []cachedTodo) ([]*todo.Todo, error) {
+	todos := make([]*todo.Todo, len(cached))
+	for i, c := range cached {
+		t, err := c.toTodo()
+		if err != nil {
+			return nil, err
+		}
+		todos[i] = t
+	}
+	return todos, nil
+}
@@ -0,0 +1,377 @@
+package todorepo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/cache"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/enttest"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/projectrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/tagrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/repotest"
+	"github.com/iktakahiro/oniongo/internal/mocks/domain/mock_todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCachedRepository(t *testing.T, store cache.Store) (*cachedTodoRepository, *mock_todo.MockTodoRepository) {
+	t.Helper()
+	next := mock_todo.NewMockTodoRepository(t)
+	return &cachedTodoRepository{next: next, store: store, stats: &cache.Stats{}}, next
+}
+
+// newTxRunner returns a transaction runner on an empty in-memory database,
+// so that commit and rollback hooks run as they do with the real database.
+func newTxRunner(t *testing.T) uow.TransactionRunner {
+	t.Helper()
+	client, err := entgen.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return db.NewClientTransactionRunner(client)
+}
+
+func newProjectTodo(t *testing.T) *todo.Todo {
+	t.Helper()
+	p, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Launch", nil)
+	require.NoError(t, err)
+	created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Buy milk", "2 bottles")
+	require.NoError(t, err)
+	require.NoError(t, created.AddTag(time.Now(), tag.NewTagID()))
+	require.NoError(t, created.AssignProject(time.Now(), p))
+	require.NoError(t, created.Start(time.Now()))
+	return created
+}
+
+func TestCachedTodoRepository_FindByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("serves read-only reads from the cache", func(t *testing.T) {
+		// Given
+		repo, next := newTestCachedRepository(t, cache.NewMemoryStore(100, time.Minute))
+		found := newProjectTodo(t)
+		next.EXPECT().FindByID(mock.Anything, found.ID()).Return(found, nil).Once()
+		readOnly := uow.WithReadOnly(ctx)
+
+		// When
+		first, err1 := repo.FindByID(readOnly, found.ID())
+		second, err2 := repo.FindByID(readOnly, found.ID())
+
+		// Then
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		assert.Same(t, found, first)
+		assertSameTodo(t, found, second)
+		assert.NotSame(t, found, second)
+		assert.Equal(t, uint64(1), repo.stats.Hits())
+		assert.Equal(t, uint64(1), repo.stats.Misses())
+	})
+
+	t.Run("reads the database when the work is not read-only", func(t *testing.T) {
+		// Given
+		repo, next := newTestCachedRepository(t, cache.NewMemoryStore(100, time.Minute))
+		found := newProjectTodo(t)
+		next.EXPECT().FindByID(mock.Anything, found.ID()).Return(found, nil).Times(3)
+
+		// When
+		_, err := repo.FindByID(uow.WithReadOnly(ctx), found.ID())
+		require.NoError(t, err)
+		_, err = repo.FindByID(ctx, found.ID())
+		require.NoError(t, err)
+		_, err = repo.FindByID(ctx, found.ID())
+		require.NoError(t, err)
+
+		// Then
+		assert.Zero(t, repo.stats.Hits())
+		assert.Equal(t, uint64(1), repo.stats.Misses())
+	})
+
+	t.Run("does not cache a missing todo", func(t *testing.T) {
+		// Given
+		repo, next := newTestCachedRepository(t, cache.NewMemoryStore(100, time.Minute))
+		id := todo.NewTodoID()
+		next.EXPECT().FindByID(mock.Anything, id).Return(nil, &todo.NotFoundError{ID: id}).Twice()
+
+		// When
+		_, err1 := repo.FindByID(uow.WithReadOnly(ctx), id)
+		_, err2 := repo.FindByID(uow.WithReadOnly(ctx), id)
+
+		// Then
+		var notFoundErr *todo.NotFoundError
+		assert.ErrorAs(t, err1, &notFoundErr)
+		assert.ErrorAs(t, err2, &notFoundErr)
+	})
+
+	t.Run("falls back to the database when the store fails", func(t *testing.T) {
+		// Given
+		repo, next := newTestCachedRepository(t, failingStore{})
+		found := newProjectTodo(t)
+		next.EXPECT().FindByID(mock.Anything, found.ID()).Return(found, nil).Twice()
+
+		// When
+		_, err1 := repo.FindByID(uow.WithReadOnly(ctx), found.ID())
+		_, err2 := repo.FindByID(uow.WithReadOnly(ctx), found.ID())
+
+		// Then
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		assert.Equal(t, uint64(2), repo.stats.Errors())
+	})
+}
+
+func TestCachedTodoRepository_FindAll(t *testing.T) {
+	// Given
+	ctx := uow.WithReadOnly(context.Background())
+	repo, next := newTestCachedRepository(t, cache.NewMemoryStore(100, time.Minute))
+	all := []*todo.Todo{newProjectTodo(t), newProjectTodo(t)}
+	actionable := todo.TodoFilter{Actionable: true}
+	next.EXPECT().FindAll(mock.Anything, todo.TodoFilter{}).Return(all, nil).Once()
+	next.EXPECT().FindAll(mock.Anything, actionable).Return(all[:1], nil).Once()
+
+	// When
+	_, err := repo.FindAll(ctx, todo.TodoFilter{})
+	require.NoError(t, err)
+	_, err = repo.FindAll(ctx, actionable)
+	require.NoError(t, err)
+	cachedAll, err := repo.FindAll(ctx, todo.TodoFilter{})
+	require.NoError(t, err)
+	cachedActionable, err := repo.FindAll(ctx, actionable)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, cachedAll, 2)
+	assertSameTodo(t, all[0], cachedAll[0])
+	assertSameTodo(t, all[1], cachedAll[1])
+	require.Len(t, cachedActionable, 1)
+	assertSameTodo(t, all[0], cachedActionable[0])
+	assert.Equal(t, uint64(2), repo.stats.Hits())
+	assert.Equal(t, uint64(2), repo.stats.Misses())
+}
+
+func TestCachedTodoRepository_Invalidation(t *testing.T) {
+	t.Run("a committed update invalidates the cache", func(t *testing.T) {
+		// Given
+		repo, next := newTestCachedRepository(t, cache.NewMemoryStore(100, time.Minute))
+		found := newProjectTodo(t)
+		next.EXPECT().FindByID(mock.Anything, found.ID()).Return(found, nil).Twice()
+		next.EXPECT().Update(mock.Anything, found).Return(nil).Once()
+		readOnly := uow.WithReadOnly(context.Background())
+		_, err := repo.FindByID(readOnly, found.ID())
+		require.NoError(t, err)
+
+		// When
+		err = newTxRunner(t).RunInTx(context.Background(), func(ctx context.Context) error {
+			if err := repo.Update(ctx, found); err != nil {
+				return err
+			}
+			_, err := repo.FindByID(readOnly, found.ID())
+			return err
+		})
+		require.NoError(t, err)
+		_, err = repo.FindByID(readOnly, found.ID())
+		require.NoError(t, err)
+
+		// Then
+		assert.Equal(t, uint64(1), repo.stats.Hits(), "the cache is used until the update is committed")
+		assert.Equal(t, uint64(2), repo.stats.Misses())
+	})
+
+	t.Run("a rolled back delete keeps the cache", func(t *testing.T) {
+		// Given
+		repo, next := newTestCachedRepository(t, cache.NewMemoryStore(100, time.Minute))
+		found := newProjectTodo(t)
+		next.EXPECT().FindByID(mock.Anything, found.ID()).Return(found, nil).Once()
+		next.EXPECT().Delete(mock.Anything, found.ID()).Return(nil).Once()
+		readOnly := uow.WithReadOnly(context.Background())
+		_, err := repo.FindByID(readOnly, found.ID())
+		require.NoError(t, err)
+
+		// When
+		err = newTxRunner(t).RunInTx(context.Background(), func(ctx context.Context) error {
+			if err := repo.Delete(ctx, found.ID()); err != nil {
+				return err
+			}
+			return errors.New("canceled")
+		})
+		require.EqualError(t, err, "canceled")
+		_, err = repo.FindByID(readOnly, found.ID())
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), repo.stats.Hits())
+	})
+
+	t.Run("a read that overlaps a commit does not fill the cache", func(t *testing.T) {
+		// Given
+		repo, next := newTestCachedRepository(t, cache.NewMemoryStore(100, time.Minute))
+		stale := newProjectTodo(t)
+		fresh := *stale
+		require.NoError(t, fresh.SetTitle(time.Now(), "Buy oat milk"))
+		hooks := &uow.Hooks{}
+		next.EXPECT().Update(mock.Anything, &fresh).Return(nil).Once()
+		require.NoError(t, repo.Update(uow.WithHooks(context.Background(), hooks), &fresh))
+
+		// The first read sees the database before the update is committed.
+		next.EXPECT().FindByID(mock.Anything, stale.ID()).
+			Run(func(ctx context.Context, _ todo.TodoID) { hooks.RunAfterCommit(ctx) }).
+			Return(stale, nil).Once()
+		next.EXPECT().FindByID(mock.Anything, stale.ID()).Return(&fresh, nil).Once()
+		readOnly := uow.WithReadOnly(context.Background())
+
+		// When
+		first, err1 := repo.FindByID(readOnly, stale.ID())
+		second, err2 := repo.FindByID(readOnly, stale.ID())
+
+		// Then
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		assert.Equal(t, "Buy milk", first.Title())
+		assert.Equal(t, "Buy oat milk", second.Title())
+	})
+
+	t.Run("a failed write does not register an invalidation", func(t *testing.T) {
+		// Given
+		repo, next := newTestCachedRepository(t, cache.NewMemoryStore(100, time.Minute))
+		created := newProjectTodo(t)
+		next.EXPECT().Create(mock.Anything, created).Return(errors.New("constraint failed")).Once()
+
+		// When
+		err := repo.Create(context.Background(), created)
+
+		// Then
+		assert.EqualError(t, err, "constraint failed")
+	})
+}
+
+func TestCachedTodoRepository_InvalidationByOtherRepositories(t *testing.T) {
+	// newHarness runs the cached repository on a migrated in-memory database,
+	// with a todo in a project and with a tag, already read into the cache.
+	newHarness := func(t *testing.T) (uow.TransactionRunner, *cachedTodoRepository, cachedTodoRepository, *todo.Todo) {
+		t.Helper()
+		client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+			enttest.WithMigrateOptions(db.MigrateOptions()...),
+		)
+		t.Cleanup(func() { _ = client.Close() })
+		txRunner := db.NewClientTransactionRunner(client)
+		next := newTodoRepository(clock.System(), idgen.UUIDv7(), &repotest.EntryRecorder{})
+		repo := &cachedTodoRepository{next: next, store: cache.NewMemoryStore(100, time.Minute), stats: &cache.Stats{}}
+
+		p, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Launch", nil)
+		require.NoError(t, err)
+		tg, err := tag.NewTag(idgen.UUIDv7(), time.Now(), "errand", "")
+		require.NoError(t, err)
+		created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), "Buy milk", "")
+		require.NoError(t, err)
+		require.NoError(t, created.AddTag(time.Now(), tg.ID()))
+		require.NoError(t, created.AssignProject(time.Now(), p))
+		projects, err := projectrepo.NewProjectRepository(nil)
+		require.NoError(t, err)
+		tags, err := tagrepo.NewTagRepository(nil)
+		require.NoError(t, err)
+		require.NoError(t, txRunner.RunInTx(context.Background(), func(ctx context.Context) error {
+			if err := projects.Create(ctx, p); err != nil {
+				return err
+			}
+			if err := tags.Create(ctx, tg); err != nil {
+				return err
+			}
+			return repo.Create(ctx, created)
+		}))
+		cached, err := findReadOnly(txRunner, repo, created.ID())
+		require.NoError(t, err)
+		require.Equal(t, created.TagIDs(), cached.TagIDs())
+		return txRunner, repo, cachedTodoRepository{store: repo.store, stats: repo.stats}, created
+	}
+
+	t.Run("a committed tag delete removes the tag from the cached todo", func(t *testing.T) {
+		// Given
+		txRunner, repo, todos, created := newHarness(t)
+		next, err := tagrepo.NewTagRepository(nil)
+		require.NoError(t, err)
+		tags := tagRepositoryInvalidatingTodos{TagRepository: next, todos: todos}
+
+		// When
+		err = txRunner.RunInTx(context.Background(), func(ctx context.Context) error {
+			return tags.Delete(ctx, created.TagIDs()[0])
+		})
+		require.NoError(t, err)
+		found, err := findReadOnly(txRunner, repo, created.ID())
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), repo.stats.Misses(), "the todo is read from the database again")
+		assert.Empty(t, found.TagIDs())
+	})
+
+	t.Run("a committed project delete removes the project from the cached todo", func(t *testing.T) {
+		// Given
+		txRunner, repo, todos, created := newHarness(t)
+		next, err := projectrepo.NewProjectRepository(nil)
+		require.NoError(t, err)
+		projects := projectRepositoryInvalidatingTodos{ProjectRepository: next, todos: todos}
+
+		// When
+		err = txRunner.RunInTx(context.Background(), func(ctx context.Context) error {
+			return projects.Delete(ctx, *created.ProjectID())
+		})
+		require.NoError(t, err)
+		found, err := findReadOnly(txRunner, repo, created.ID())
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), repo.stats.Misses(), "the todo is read from the database again")
+		assert.Nil(t, found.ProjectID())
+	})
+}
+
+// findReadOnly finds the Todo in a read-only transaction, which is served by the cache.
+func findReadOnly(txRunner uow.TransactionRunner, repo *cachedTodoRepository, id todo.TodoID) (found *todo.Todo, err error) {
+	err = txRunner.RunInTx(uow.WithReadOnly(context.Background()), func(ctx context.Context) error {
+		found, err = repo.FindByID(ctx, id)
+		return err
+	})
+	return found, err
+}
+
+// assertSameTodo asserts that the Todo read from the cache has the state of the Todo read from the database.
+func assertSameTodo(t *testing.T, expected, actual *todo.Todo) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID())
+	assert.Equal(t, expected.Title(), actual.Title())
+	assert.Equal(t, expected.Body(), actual.Body())
+	assert.Equal(t, expected.Status(), actual.Status())
+	assert.Equal(t, expected.StatusID(), actual.StatusID())
+	assert.True(t, expected.CreatedAt().Equal(actual.CreatedAt()))
+	assert.True(t, expected.UpdatedAt().Equal(actual.UpdatedAt()))
+	assert.Equal(t, expected.CompletedAt() == nil, actual.CompletedAt() == nil)
+	assert.Equal(t, expected.TagIDs(), actual.TagIDs())
+	assert.Equal(t, expected.ParentID(), actual.ParentID())
+	assert.ElementsMatch(t, expected.BlockerIDs(), actual.BlockerIDs())
+	assert.Equal(t, expected.ProjectID(), actual.ProjectID())
+	assert.Equal(t, expected.Workflow(), actual.Workflow())
+}
+
+// failingStore is a Store whose every operation fails.
+type failingStore struct{}
+
+func (failingStore) Get(context.Context, string) ([]byte, bool, error) {
+	return nil, false, errors.New("connection refused")
+}
+
+func (failingStore) Set(context.Context, string, []byte) error {
+	return errors.New("connection refused")
+}
+
+func (failingStore) Delete(context.Context, ...string) error {
+	return errors.New("connection refused")
+}
@@ -4,22 +4,54 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
 	"github.com/iktakahiro/oniongo/internal/domain/todo"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/predicate"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/tagschema"
 	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/historyrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/projectrepo"
 	"github.com/samber/do"
 )
 
 // todoPsqlRepository is the implementation of the TodoRepository interface.
-type todoRepository struct{}
+// The history entries it records occur at the time of clock and take their IDs from ids,
+// and listener is told about each of them once it is saved.
+type todoRepository struct {
+	clock    clock.Clock
+	ids      idgen.IDGenerator
+	listener history.EntryListener
+}
 
 // NewTodoSqliteRepository creates a new TodoSqliteRepository.
 func NewTodoRepository(i *do.Injector) (todo.TodoRepository, error) {
-	return &todoRepository{}, nil
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	ids, err := do.Invoke[idgen.IDGenerator](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke id generator: %w", err)
+	}
+	listener, err := do.Invoke[history.EntryListener](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke history entry listener: %w", err)
+	}
+	return newTodoRepository(clk, ids, listener), nil
 }
 
-// Create creates the Todo.
+func newTodoRepository(clk clock.Clock, ids idgen.IDGenerator, listener history.EntryListener) *todoRepository {
+	return &todoRepository{clock: clk, ids: ids, listener: listener}
+}
+
+// Create creates the Todo and records its creation in the history.
 func (r todoRepository) Create(ctx context.Context, todo *todo.Todo) error {
 	tx, err := db.GetTx(ctx)
 	if err != nil {
@@ -28,18 +60,30 @@ func (r todoRepository) Create(ctx context.Context, todo *todo.Todo) error {
 
 	status := todoschema.Status(todo.Status().String())
 	_, err = tx.TodoSchema.Create().
+		SetID(todo.ID().UUID()).
 		SetTitle(todo.Title()).
 		SetBody(todo.Body()).
 		SetStatus(status).
+		SetCreatedAt(todo.CreatedAt()).
+		SetUpdatedAt(todo.UpdatedAt()).
+		SetNillableCompletedAt(todo.CompletedAt()).
+		AddTagIDs(tagIDsToUUIDs(todo.TagIDs())...).
+		SetNillableParentID(todoIDToUUIDPtr(todo.ParentID())).
+		AddBlockedByIDs(todoIDsToUUIDs(todo.BlockerIDs())...).
+		SetNillableProjectID(projectIDToUUIDPtr(todo.ProjectID())).
+		SetStatusID(workflowStatusID(todo)).
 		Save(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create todo: %w", err)
+		return db.TranslateError(err, todoErrors(todo.ID()), "failed to create todo")
 	}
-	return nil
+	return r.recordHistory(ctx, tx, history.TodoCreated(r.ids, r.clock.Now(), history.ActorFromContext(ctx), todo))
 }
 
-// FindAll returns all Todos.
-func (r todoRepository) FindAll(ctx context.Context) (todos []*todo.Todo, err error) {
+// FindAll returns all Todos that match the filter.
+func (r todoRepository) FindAll(
+	ctx context.Context,
+	filter todo.TodoFilter,
+) (todos []*todo.Todo, err error) {
 	tx, err := db.GetTx(ctx)
 	if err != nil {
 		return nil, err
@@ -48,6 +92,10 @@ func (r todoRepository) FindAll(ctx context.Context) (todos []*todo.Todo, err er
 	entities, err := tx.TodoSchema.
 		Query().
 		Where(todoschema.DeletedAtIsNil()).
+		Where(filterPredicates(filter)...).
+		WithTags(selectTagID).
+		WithBlockedBy(selectTodoID).
+		WithProject().
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find all todos: %w", err)
@@ -73,61 +121,311 @@ func (r todoRepository) FindByID(
 		return nil, err
 	}
 
-	entity, err := tx.TodoSchema.Get(ctx, id.UUID())
+	entity, err := tx.TodoSchema.
+		Query().
+		Where(todoschema.ID(id.UUID())).
+		WithTags(selectTagID).
+		WithBlockedBy(selectTodoID).
+		WithProject().
+		Only(ctx)
 	if err != nil {
-		if entgen.IsNotFound(err) {
-			return nil, &todo.NotFoundError{ID: id}
-		}
-		return nil, fmt.Errorf("failed to find todo %v: %w", id, err)
+		return nil, db.TranslateError(err, todoErrors(id), "failed to find todo %v", id)
 	}
 
 	return convertEntToTodo(entity)
 }
 
-// Update updates the Todo with the given ID.
+// Update updates the Todo with the given ID and records the changed fields in the history.
 func (r todoRepository) Update(ctx context.Context, todo *todo.Todo) (err error) {
 	tx, err := db.GetTx(ctx)
 	if err != nil {
 		return err
 	}
 
+	before, err := r.FindByID(ctx, todo.ID())
+	if err != nil {
+		return err
+	}
+
 	status := todoschema.Status(todo.Status().String())
 	update := tx.TodoSchema.UpdateOneID(todo.ID().UUID()).
 		SetTitle(todo.Title()).
 		SetBody(todo.Body()).
-		SetStatus(status)
+		SetStatus(status).
+		SetUpdatedAt(todo.UpdatedAt()).
+		ClearTags().
+		AddTagIDs(tagIDsToUUIDs(todo.TagIDs())...).
+		ClearBlockedBy().
+		AddBlockedByIDs(todoIDsToUUIDs(todo.BlockerIDs())...).
+		SetStatusID(workflowStatusID(todo))
+
+	if todo.ParentID() != nil {
+		update = update.SetParentID(todo.ParentID().UUID())
+	} else {
+		update = update.ClearParentID()
+	}
+
+	if todo.ProjectID() != nil {
+		update = update.SetProjectID(todo.ProjectID().UUID())
+	} else {
+		update = update.ClearProjectID()
+	}
 
 	if todo.CompletedAt() != nil {
 		update = update.SetCompletedAt(*todo.CompletedAt())
+	} else {
+		update = update.ClearCompletedAt()
 	}
 
 	_, err = update.Save(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to update todo %v: %w", todo.ID(), err)
+		return db.TranslateError(err, todoErrors(todo.ID()), "failed to update todo %v", todo.ID())
+	}
+	if entry := history.TodoUpdated(r.ids, r.clock.Now(), history.ActorFromContext(ctx), before, todo); entry != nil {
+		return r.recordHistory(ctx, tx, entry)
 	}
 	return nil
 }
 
-// Delete deletes the Todo with the given ID.
+// FindAncestorIDs returns the IDs of the ancestors of the Todo, nearest first.
+func (r todoRepository) FindAncestorIDs(
+	ctx context.Context,
+	id todo.TodoID,
+) ([]todo.TodoID, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ancestorIDs []todo.TodoID
+	current := id
+	// The depth limit bounds the walk even if the stored tree is corrupted.
+	for range todo.MaxDepth + 1 {
+		entity, err := tx.TodoSchema.
+			Query().
+			Where(todoschema.ID(current.UUID())).
+			Select(todoschema.FieldParentID).
+			Only(ctx)
+		if err != nil {
+			return nil, db.TranslateError(err, todoErrors(current), "failed to find parent of todo %v", current)
+		}
+		if entity.ParentID == nil {
+			return ancestorIDs, nil
+		}
+		current = todo.TodoID(*entity.ParentID)
+		ancestorIDs = append(ancestorIDs, current)
+	}
+	return ancestorIDs, nil
+}
+
+// FindDescendants returns every Todo in the subtree below the Todo.
+func (r todoRepository) FindDescendants(
+	ctx context.Context,
+	id todo.TodoID,
+) ([]*todo.Todo, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var descendants []*todo.Todo
+	parentIDs := []uuid.UUID{id.UUID()}
+	for range todo.MaxDepth {
+		entities, err := tx.TodoSchema.
+			Query().
+			Where(todoschema.ParentIDIn(parentIDs...)).
+			WithTags(selectTagID).
+			WithBlockedBy(selectTodoID).
+			WithProject().
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find descendants of todo %v: %w", id, err)
+		}
+		if len(entities) == 0 {
+			break
+		}
+		parentIDs = make([]uuid.UUID, len(entities))
+		for i, entity := range entities {
+			t, err := convertEntToTodo(entity)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert to domain %v: %w", entity.ID, err)
+			}
+			descendants = append(descendants, t)
+			parentIDs[i] = entity.ID
+		}
+	}
+	return descendants, nil
+}
+
+// Delete deletes the Todo with the given ID together with its subtree,
+// and records the deletion of each of them in the history.
 func (r todoRepository) Delete(ctx context.Context, id todo.TodoID) (err error) {
 	tx, err := db.GetTx(ctx)
 	if err != nil {
 		return err
 	}
 
+	deleted, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	descendants, err := r.FindDescendants(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	err = tx.TodoSchema.DeleteOneID(id.UUID()).Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete todo %v: %w", id, err)
+		return db.TranslateError(err, todoErrors(id), "failed to delete todo %v", id)
+	}
+
+	actor, now := history.ActorFromContext(ctx), r.clock.Now()
+	for _, t := range append([]*todo.Todo{deleted}, descendants...) {
+		if err := r.recordHistory(ctx, tx, history.TodoDeleted(r.ids, now, actor, t)); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// recordHistory saves the entry in the transaction and tells the listener about it.
+func (r todoRepository) recordHistory(ctx context.Context, tx *entgen.Tx, entry *history.HistoryEntry) error {
+	saved, err := historyrepo.CreateEntry(ctx, tx, entry)
+	if err != nil {
+		return err
+	}
+	return r.listener.EntryRecorded(ctx, saved)
+}
+
+// finishedStatuses are the statuses for which todo.TodoStatus.IsFinished reports true
+var finishedStatuses = []todoschema.Status{todoschema.StatusCOMPLETED, todoschema.StatusCANCELLED}
+
+// filterPredicates converts a domain TodoFilter to ent predicates
+func filterPredicates(filter todo.TodoFilter) []predicate.TodoSchema {
+	var predicates []predicate.TodoSchema
+	if filter.ParentID != nil {
+		predicates = append(predicates, todoschema.ParentID(filter.ParentID.UUID()))
+	}
+	if filter.ProjectID != nil {
+		predicates = append(predicates, todoschema.ProjectID(filter.ProjectID.UUID()))
+	}
+	if filter.Actionable {
+		predicates = append(predicates,
+			todoschema.StatusNotIn(finishedStatuses...),
+			todoschema.Not(todoschema.HasBlockedByWith(todoschema.StatusNotIn(finishedStatuses...))),
+		)
+	}
+	if len(filter.TagIDs) == 0 {
+		return predicates
+	}
+	ids := tagIDsToUUIDs(filter.TagIDs)
+	if filter.TagMatch == todo.TagMatchAll {
+		for _, id := range ids {
+			predicates = append(predicates, todoschema.HasTagsWith(tagschema.ID(id)))
+		}
+		return predicates
+	}
+	return append(predicates, todoschema.HasTagsWith(tagschema.IDIn(ids...)))
+}
+
+// selectTagID loads only the IDs of the tags edge
+func selectTagID(q *entgen.TagSchemaQuery) {
+	q.Select(tagschema.FieldID)
+}
+
+// selectTodoID loads only the IDs of a todo edge
+func selectTodoID(q *entgen.TodoSchemaQuery) {
+	q.Select(todoschema.FieldID)
+}
+
+// todoIDsToUUIDs converts domain TodoIDs to UUIDs
+func todoIDsToUUIDs(todoIDs []todo.TodoID) []uuid.UUID {
+	ids := make([]uuid.UUID, len(todoIDs))
+	for i, todoID := range todoIDs {
+		ids[i] = todoID.UUID()
+	}
+	return ids
+}
+
+// tagIDsToUUIDs converts domain TagIDs to UUIDs
+func tagIDsToUUIDs(tagIDs []tag.TagID) []uuid.UUID {
+	ids := make([]uuid.UUID, len(tagIDs))
+	for i, tagID := range tagIDs {
+		ids[i] = tagID.UUID()
+	}
+	return ids
+}
+
+// todoIDToUUIDPtr converts an optional domain TodoID to an optional UUID
+func todoIDToUUIDPtr(id *todo.TodoID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	u := id.UUID()
+	return &u
+}
+
+// projectIDToUUIDPtr converts an optional domain ProjectID to an optional UUID
+func projectIDToUUIDPtr(id *project.ProjectID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	u := id.UUID()
+	return &u
+}
+
+// workflowStatusID returns the status ID stored for the Todo. It is empty
+// for todos without a project, whose status is stored in the status column only.
+func workflowStatusID(t *todo.Todo) string {
+	if t.ProjectID() == nil {
+		return ""
+	}
+	return t.StatusID().String()
+}
+
+// todoErrors returns the domain errors of the todo with the ID.
+func todoErrors(id todo.TodoID) db.DomainErrors {
+	return db.DomainErrors{
+		NotFound:      func() error { return &todo.NotFoundError{ID: id} },
+		AlreadyExists: func() error { return &todo.AlreadyExistsError{ID: id} },
+		Invalid: func(field, message string) error {
+			return &todo.ValidationError{Field: field, Message: message}
+		},
+	}
+}
+
 // convertEntToTodo converts ent.TodoSchema to domain Todo
 func convertEntToTodo(v *entgen.TodoSchema) (*todo.Todo, error) {
 	status, err := todo.NewTodoStatusFromString(string(v.Status))
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert status %v: %w", v.Status, err)
 	}
+	tagIDs := make([]tag.TagID, len(v.Edges.Tags))
+	for i, t := range v.Edges.Tags {
+		tagIDs[i] = tag.TagID(t.ID)
+	}
+	blockerIDs := make([]todo.TodoID, len(v.Edges.BlockedBy))
+	for i, b := range v.Edges.BlockedBy {
+		blockerIDs[i] = todo.TodoID(b.ID)
+	}
+	var parentID *todo.TodoID
+	if v.ParentID != nil {
+		id := todo.TodoID(*v.ParentID)
+		parentID = &id
+	}
+	var projectID *project.ProjectID
+	var workflow *project.Workflow
+	if v.ProjectID != nil {
+		id := project.ProjectID(*v.ProjectID)
+		projectID = &id
+		if v.Edges.Project == nil {
+			return nil, fmt.Errorf("project %v is not loaded", id)
+		}
+		workflow, err = projectrepo.ConvertEntToWorkflow(v.Edges.Project.Workflow)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return todo.ReconstructTodoWithStatus(
 		v.ID,
 		v.Title,
@@ -136,5 +434,11 @@ func convertEntToTodo(v *entgen.TodoSchema) (*todo.Todo, error) {
 		v.CreatedAt,
 		v.UpdatedAt,
 		v.CompletedAt,
+		tagIDs,
+		parentID,
+		blockerIDs,
+		projectID,
+		workflow,
+		project.StatusID(v.StatusID),
 	), nil
 }
@@ -0,0 +1,43 @@
+package todorepo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/enttest"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/historyrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/projectrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/tagrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/repotest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoRepository(t *testing.T) {
+	repotest.TestTodoRepository(t, func(t *testing.T) repotest.TodoHarness {
+		client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+			enttest.WithMigrateOptions(db.MigrateOptions()...),
+		)
+		t.Cleanup(func() { _ = client.Close() })
+
+		clk := clock.NewFake(time.Now())
+		entries, err := historyrepo.NewHistoryRepository(nil)
+		require.NoError(t, err)
+		tags, err := tagrepo.NewTagRepository(nil)
+		require.NoError(t, err)
+		projects, err := projectrepo.NewProjectRepository(nil)
+		require.NoError(t, err)
+		listener := &repotest.EntryRecorder{}
+		return repotest.TodoHarness{
+			Todos:    newTodoRepository(clk, idgen.NewSequence(), listener),
+			History:  entries,
+			TxRunner: db.NewClientTransactionRunner(client),
+			Tags:     tags,
+			Projects: projects,
+			Clock:    clk,
+			Listener: listener,
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package todorepo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/todoschema"
+	"github.com/samber/do"
+)
+
+// searchQuery ranks the matches with bm25, weighting the title over the body.
+// The todo_id column is not indexed, so its weight is unused.
+const searchQuery = `
+SELECT todo_fts.todo_id,
+	bm25(todo_fts, 0.0, 10.0, 1.0) AS rank,
+	highlight(todo_fts, 1, ?, ?),
+	snippet(todo_fts, 2, ?, ?, '…', 16)
+FROM todo_fts
+JOIN todo ON todo.id = todo_fts.todo_id
+WHERE todo_fts MATCH ? AND todo.deleted_at IS NULL
+ORDER BY rank
+LIMIT ? OFFSET ?`
+
+// todoSearchRepository is the SQLite FTS5 implementation of the TodoSearchRepository interface.
+// The index is created by db.Migrate.
+type todoSearchRepository struct{}
+
+// NewTodoSearchRepository creates a new TodoSearchRepository.
+func NewTodoSearchRepository(i *do.Injector) (todo.TodoSearchRepository, error) {
+	return &todoSearchRepository{}, nil
+}
+
+// Search returns the todos that match the query, best match first.
+func (r todoSearchRepository) Search(
+	ctx context.Context,
+	query todo.SearchQuery,
+	limit int,
+	offset int,
+) ([]*todo.SearchHit, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, searchQuery,
+		todo.HighlightStart, todo.HighlightEnd,
+		todo.HighlightStart, todo.HighlightEnd,
+		matchExpression(query),
+		limit, offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search todos: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []*todo.SearchHit
+	var ids []uuid.UUID
+	for rows.Next() {
+		var (
+			idStr        string
+			rank         float64
+			titleSnippet string
+			bodySnippet  string
+		)
+		if err := rows.Scan(&idStr, &rank, &titleSnippet, &bodySnippet); err != nil {
+			return nil, fmt.Errorf("failed to scan search result: %w", err)
+		}
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse todo id %q: %w", idStr, err)
+		}
+		// snippet returns the start of the body even if only the title matches.
+		if !strings.Contains(bodySnippet, todo.HighlightStart) {
+			bodySnippet = ""
+		}
+		ids = append(ids, id)
+		hits = append(hits, &todo.SearchHit{
+			// bm25 is negative, and lower is better.
+			Score:        -rank,
+			TitleSnippet: titleSnippet,
+			BodySnippet:  bodySnippet,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search todos: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	entities, err := tx.TodoSchema.
+		Query().
+		Where(todoschema.IDIn(ids...)).
+		WithTags(selectTagID).
+		WithBlockedBy(selectTodoID).
+		WithProject().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find searched todos: %w", err)
+	}
+	todos := make(map[uuid.UUID]*todo.Todo, len(entities))
+	for _, entity := range entities {
+		todos[entity.ID], err = convertEntToTodo(entity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to domain %v: %w", entity.ID, err)
+		}
+	}
+	for i, id := range ids {
+		hits[i].Todo = todos[id]
+	}
+	return hits, nil
+}
+
+// matchExpression converts the query to the FTS5 query syntax.
+// Every word is quoted, so words such as OR and NEAR are not read as operators.
+func matchExpression(query todo.SearchQuery) string {
+	terms := query.Terms()
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		phrase := `"` + strings.Join(term.Words, " ") + `"`
+		if term.Prefix {
+			phrase += " *"
+		}
+		parts[i] = phrase
+	}
+	return strings.Join(parts, " AND ")
+}
@@ -0,0 +1,33 @@
+//go:build sqlite_fts5
+
+package todorepo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/enttest"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/repotest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoSearchRepository(t *testing.T) {
+	repotest.TestTodoSearchRepository(t, func(t *testing.T) repotest.TodoSearchHarness {
+		client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+			enttest.WithMigrateOptions(db.MigrateOptions()...),
+		)
+		t.Cleanup(func() { _ = client.Close() })
+		require.NoError(t, db.CreateSearchIndex(context.Background(), client))
+
+		search, err := NewTodoSearchRepository(nil)
+		require.NoError(t, err)
+		return repotest.TodoSearchHarness{
+			Todos:    newTodoRepository(clock.System(), idgen.UUIDv7(), &repotest.EntryRecorder{}),
+			Search:   search,
+			TxRunner: db.NewClientTransactionRunner(client),
+		}
+	})
+}
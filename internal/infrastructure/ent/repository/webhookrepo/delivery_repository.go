@@ -0,0 +1,143 @@
+package webhookrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookdeliveryschema"
+	"github.com/samber/do"
+)
+
+// deliveryRepository is the implementation of the DeliveryRepository interface.
+type deliveryRepository struct{}
+
+// NewDeliveryRepository creates a new DeliveryRepository.
+func NewDeliveryRepository(i *do.Injector) (webhook.DeliveryRepository, error) {
+	return &deliveryRepository{}, nil
+}
+
+// Create creates the Delivery.
+func (r deliveryRepository) Create(ctx context.Context, d *webhook.Delivery) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.WebhookDeliverySchema.Create().
+		SetID(d.ID().UUID()).
+		SetWebhookID(d.WebhookID().UUID()).
+		SetEventID(d.EventID().UUID()).
+		SetEventType(d.EventType().String()).
+		SetPayload(d.Payload()).
+		SetStatus(webhookdeliveryschema.Status(d.Status().String())).
+		SetAttempts(d.Attempts()).
+		SetResponseStatus(d.ResponseStatus()).
+		SetLastError(d.LastError()).
+		SetNextAttemptAt(d.NextAttemptAt()).
+		SetCreatedAt(d.CreatedAt()).
+		SetUpdatedAt(d.UpdatedAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// Update saves the outcome of the attempts of the Delivery.
+func (r deliveryRepository) Update(ctx context.Context, d *webhook.Delivery) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.WebhookDeliverySchema.UpdateOneID(d.ID().UUID()).
+		SetStatus(webhookdeliveryschema.Status(d.Status().String())).
+		SetAttempts(d.Attempts()).
+		SetResponseStatus(d.ResponseStatus()).
+		SetLastError(d.LastError()).
+		SetNextAttemptAt(d.NextAttemptAt()).
+		SetUpdatedAt(d.UpdatedAt()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery %v: %w", d.ID(), err)
+	}
+	return nil
+}
+
+// FindDue returns up to limit pending deliveries whose next attempt is due at now, earliest first.
+func (r deliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.WebhookDeliverySchema.
+		Query().
+		Where(
+			webhookdeliveryschema.StatusEQ(webhookdeliveryschema.StatusPENDING),
+			webhookdeliveryschema.NextAttemptAtLTE(now),
+		).
+		Order(entgen.Asc(webhookdeliveryschema.FieldNextAttemptAt), entgen.Asc(webhookdeliveryschema.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due webhook deliveries: %w", err)
+	}
+	return convertEntsToDeliveries(entities), nil
+}
+
+// FindByWebhookID returns the deliveries of the webhook, newest first.
+func (r deliveryRepository) FindByWebhookID(
+	ctx context.Context,
+	webhookID webhook.WebhookID,
+	limit int,
+	offset int,
+) ([]*webhook.Delivery, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.WebhookDeliverySchema.
+		Query().
+		Where(webhookdeliveryschema.WebhookID(webhookID.UUID())).
+		Order(entgen.Desc(webhookdeliveryschema.FieldCreatedAt), entgen.Desc(webhookdeliveryschema.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deliveries of webhook %v: %w", webhookID, err)
+	}
+	return convertEntsToDeliveries(entities), nil
+}
+
+// convertEntsToDeliveries converts ent.WebhookDeliverySchemas to domain Deliveries
+func convertEntsToDeliveries(entities []*entgen.WebhookDeliverySchema) []*webhook.Delivery {
+	deliveries := make([]*webhook.Delivery, len(entities))
+	for i, entity := range entities {
+		deliveries[i] = convertEntToDelivery(entity)
+	}
+	return deliveries
+}
+
+// convertEntToDelivery converts ent.WebhookDeliverySchema to domain Delivery
+func convertEntToDelivery(v *entgen.WebhookDeliverySchema) *webhook.Delivery {
+	return webhook.ReconstructDelivery(
+		v.ID,
+		webhook.WebhookID(v.WebhookID),
+		webhook.EventID(v.EventID),
+		webhook.EventType(v.EventType),
+		v.Payload,
+		webhook.DeliveryStatus(v.Status),
+		v.Attempts,
+		v.ResponseStatus,
+		v.LastError,
+		v.NextAttemptAt,
+		v.CreatedAt,
+		v.UpdatedAt,
+	)
+}
@@ -0,0 +1,87 @@
+package webhookrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookeventschema"
+	"github.com/samber/do"
+)
+
+// eventRepository is the implementation of the EventRepository interface.
+type eventRepository struct{}
+
+// NewEventRepository creates a new EventRepository.
+func NewEventRepository(i *do.Injector) (webhook.EventRepository, error) {
+	return &eventRepository{}, nil
+}
+
+// Create queues the event.
+func (r eventRepository) Create(ctx context.Context, event *webhook.Event) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.WebhookEventSchema.Create().
+		SetID(event.ID().UUID()).
+		SetType(event.Type().String()).
+		SetTodoID(event.TodoID().UUID()).
+		SetPayload(event.Payload()).
+		SetOccurredAt(event.OccurredAt()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to queue %s event of todo %v: %w", event.Type(), event.TodoID(), err)
+	}
+	return nil
+}
+
+// FindPending returns up to limit queued events, oldest first.
+func (r eventRepository) FindPending(ctx context.Context, limit int) ([]*webhook.Event, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.WebhookEventSchema.
+		Query().
+		Order(entgen.Asc(webhookeventschema.FieldOccurredAt), entgen.Asc(webhookeventschema.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending webhook events: %w", err)
+	}
+	events := make([]*webhook.Event, len(entities))
+	for i, entity := range entities {
+		events[i] = webhook.ReconstructEvent(
+			entity.ID,
+			webhook.EventType(entity.Type),
+			todo.TodoID(entity.TodoID),
+			entity.Payload,
+			entity.OccurredAt,
+		)
+	}
+	return events, nil
+}
+
+// Delete removes the events from the queue.
+func (r eventRepository) Delete(ctx context.Context, ids []webhook.EventID) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	uuids := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		uuids[i] = id.UUID()
+	}
+	if _, err := tx.WebhookEventSchema.Delete().Where(webhookeventschema.IDIn(uuids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete webhook events: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package webhookrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/webhookschema"
+	"github.com/samber/do"
+)
+
+// webhookRepository is the implementation of the WebhookRepository interface.
+type webhookRepository struct{}
+
+// NewWebhookRepository creates a new WebhookRepository.
+func NewWebhookRepository(i *do.Injector) (webhook.WebhookRepository, error) {
+	return &webhookRepository{}, nil
+}
+
+// Create creates the Webhook.
+func (r webhookRepository) Create(ctx context.Context, w *webhook.Webhook) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.WebhookSchema.Create().
+		SetID(w.ID().UUID()).
+		SetURL(w.URL()).
+		SetSecret(w.Secret()).
+		SetEvents(eventTypesToStrings(w.Events())).
+		SetEnabled(w.Enabled()).
+		SetConsecutiveFailures(w.ConsecutiveFailures()).
+		SetCreatedAt(w.CreatedAt()).
+		SetUpdatedAt(w.UpdatedAt()).
+		Save(ctx)
+	if err != nil {
+		return db.TranslateError(err, webhookErrors(w.ID()), "failed to create webhook")
+	}
+	return nil
+}
+
+// FindByID returns the Webhook with the given ID.
+func (r webhookRepository) FindByID(ctx context.Context, id webhook.WebhookID) (*webhook.Webhook, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := tx.WebhookSchema.Get(ctx, id.UUID())
+	if err != nil {
+		return nil, db.TranslateError(err, webhookErrors(id), "failed to find webhook %v", id)
+	}
+	return convertEntToWebhook(entity), nil
+}
+
+// FindAll returns every webhook, oldest first.
+func (r webhookRepository) FindAll(ctx context.Context) ([]*webhook.Webhook, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := tx.WebhookSchema.
+		Query().
+		Order(entgen.Asc(webhookschema.FieldCreatedAt), entgen.Asc(webhookschema.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhooks: %w", err)
+	}
+	webhooks := make([]*webhook.Webhook, len(entities))
+	for i, entity := range entities {
+		webhooks[i] = convertEntToWebhook(entity)
+	}
+	return webhooks, nil
+}
+
+// HasEnabled reports whether any webhook is enabled.
+func (r webhookRepository) HasEnabled(ctx context.Context) (bool, error) {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	enabled, err := tx.WebhookSchema.Query().Where(webhookschema.Enabled(true)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to find enabled webhooks: %w", err)
+	}
+	return enabled, nil
+}
+
+// Update saves the subscriptions and the failure state of the Webhook.
+func (r webhookRepository) Update(ctx context.Context, w *webhook.Webhook) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.WebhookSchema.UpdateOneID(w.ID().UUID()).
+		SetEvents(eventTypesToStrings(w.Events())).
+		SetEnabled(w.Enabled()).
+		SetConsecutiveFailures(w.ConsecutiveFailures()).
+		SetUpdatedAt(w.UpdatedAt()).
+		Exec(ctx)
+	if err != nil {
+		return db.TranslateError(err, webhookErrors(w.ID()), "failed to update webhook %v", w.ID())
+	}
+	return nil
+}
+
+// Delete deletes the Webhook with the given ID. Its deliveries are deleted by the foreign key.
+func (r webhookRepository) Delete(ctx context.Context, id webhook.WebhookID) error {
+	tx, err := db.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.WebhookSchema.DeleteOneID(id.UUID()).Exec(ctx); err != nil {
+		return db.TranslateError(err, webhookErrors(id), "failed to delete webhook %v", id)
+	}
+	return nil
+}
+
+// eventTypesToStrings converts event types to the strings stored in the events column.
+func eventTypesToStrings(eventTypes []webhook.EventType) []string {
+	events := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		events[i] = eventType.String()
+	}
+	return events
+}
+
+// webhookErrors returns the domain errors of the webhook with the ID.
+func webhookErrors(id webhook.WebhookID) db.DomainErrors {
+	return db.DomainErrors{
+		NotFound: func() error { return &webhook.NotFoundError{ID: id} },
+		Invalid: func(field, message string) error {
+			return &webhook.ValidationError{Field: field, Message: message}
+		},
+	}
+}
+
+// convertEntToWebhook converts ent.WebhookSchema to domain Webhook
+func convertEntToWebhook(v *entgen.WebhookSchema) *webhook.Webhook {
+	events := make([]webhook.EventType, len(v.Events))
+	for i, event := range v.Events {
+		events[i] = webhook.EventType(event)
+	}
+	return webhook.ReconstructWebhook(
+		v.ID,
+		v.URL,
+		v.Secret,
+		events,
+		v.Enabled,
+		v.ConsecutiveFailures,
+		v.CreatedAt,
+		v.UpdatedAt,
+	)
+}
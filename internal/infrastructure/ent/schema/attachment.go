@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AttachmentSchema holds the schema definition for the Attachment entity.
+type AttachmentSchema struct {
+	ent.Schema
+}
+
+func (AttachmentSchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "attachment"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (AttachmentSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		EntityMixin{},
+	}
+}
+
+// Fields of the Attachment.
+func (AttachmentSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("todo_id", uuid.UUID{}).
+			Immutable(),
+		field.String("filename").NotEmpty(),
+		field.String("content_type").NotEmpty(),
+		field.Int64("size").NonNegative(),
+		// checksum is the hex encoded SHA-256 digest of the content.
+		field.String("checksum"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Attachment.
+func (AttachmentSchema) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", TodoSchema.Type).
+			Ref("attachments").
+			Field("todo_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (AttachmentSchema) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("todo_id", "created_at"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CommentSchema holds the schema definition for the Comment entity.
+type CommentSchema struct {
+	ent.Schema
+}
+
+func (CommentSchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "comment"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (CommentSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		EntityMixin{},
+	}
+}
+
+// Fields of the Comment.
+func (CommentSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("todo_id", uuid.UUID{}).
+			Immutable(),
+		field.String("author").NotEmpty(),
+		field.Text("body"),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("edited_at").
+			Optional().
+			Nillable(),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Comment.
+func (CommentSchema) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todo", TodoSchema.Type).
+			Ref("comments").
+			Field("todo_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (CommentSchema) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("todo_id", "created_at"),
+	}
+}
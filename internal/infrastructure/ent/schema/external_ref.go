@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ExternalRefSchema holds the schema definition for the identifiers of imported items.
+// local_id refers to a project, tag or todo depending on kind, so it has no edge.
+type ExternalRefSchema struct {
+	ent.Schema
+}
+
+func (ExternalRefSchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "external_ref"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (ExternalRefSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		EntityMixin{},
+	}
+}
+
+// Fields of the ExternalRef.
+func (ExternalRefSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("source").
+			Values("todoist", "trello", "github").
+			Immutable(),
+		field.Enum("kind").
+			Values("PROJECT", "TAG", "TODO").
+			Immutable(),
+		field.String("external_id").
+			NotEmpty().
+			MaxLen(255).
+			Immutable(),
+		field.UUID("local_id", uuid.UUID{}).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ExternalRefSchema) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source", "kind", "external_id").
+			Unique(),
+	}
+}
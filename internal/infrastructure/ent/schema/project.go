@@ -1,11 +1,35 @@
 package schema
 
 import (
+	"time"
+
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
 )
 
+// WorkflowDefinition is the JSON representation of a project workflow.
+type WorkflowDefinition struct {
+	Statuses    []WorkflowStatusDefinition     `json:"statuses"`
+	Transitions []WorkflowTransitionDefinition `json:"transitions"`
+}
+
+// WorkflowStatusDefinition is the JSON representation of a workflow status.
+type WorkflowStatusDefinition struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+// WorkflowTransitionDefinition is the JSON representation of an allowed transition.
+type WorkflowTransitionDefinition struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
 // ProjectSchema holds the schema definition for the ProjectSchema entity.
 type ProjectSchema struct {
 	ent.Schema
@@ -20,15 +44,32 @@ func (ProjectSchema) Mixin() []ent.Mixin {
 func (ProjectSchema) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entsql.Annotation{Table: "project"},
+		entgql.Type("Project"),
+		entgql.QueryField("projects"),
+		entgql.RelayConnection(),
 	}
 }
 
 // Fields of the ProjectSchema.
 func (ProjectSchema) Fields() []ent.Field {
-	return nil
+	return []ent.Field{
+		field.String("name").NotEmpty().
+			Annotations(entgql.OrderField("NAME")),
+		field.JSON("workflow", WorkflowDefinition{}).
+			Annotations(entgql.Skip()),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
 }
 
 // Edges of the ProjectSchema.
 func (ProjectSchema) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("todos", TodoSchema.Type).
+			Annotations(entgql.RelayConnection()),
+	}
 }
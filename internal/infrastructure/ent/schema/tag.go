@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TagSchema holds the schema definition for the Tag entity.
+type TagSchema struct {
+	ent.Schema
+}
+
+func (TagSchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "tag"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (TagSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		EntityMixin{},
+	}
+}
+
+// Fields of the Tag.
+func (TagSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().Unique(),
+		field.String("color"),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Tag.
+func (TagSchema) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("todos", TodoSchema.Type).
+			Ref("tags"),
+	}
+}
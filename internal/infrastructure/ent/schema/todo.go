@@ -3,11 +3,14 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 )
 
 // Todo holds the schema definition for the Todo entity.
@@ -18,6 +21,9 @@ type TodoSchema struct {
 func (TodoSchema) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entsql.Annotation{Table: "todo"},
+		entgql.Type("Todo"),
+		entgql.QueryField("todos"),
+		entgql.RelayConnection(),
 	}
 }
 
@@ -30,27 +36,63 @@ func (TodoSchema) Mixin() []ent.Mixin {
 // Fields of the Todo.
 func (TodoSchema) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("title").NotEmpty(),
+		field.String("title").NotEmpty().
+			Annotations(entgql.OrderField("TITLE")),
 		field.String("body").Optional().Nillable(),
 		field.Enum("status").
-			Values("NOT_STARTED", "IN_PROGRESS", "COMPLETED").
-			Default("NOT_STARTED"),
+			Values("NOT_STARTED", "IN_PROGRESS", "COMPLETED", "CANCELLED", "ON_HOLD").
+			Default("NOT_STARTED").
+			Annotations(entgql.OrderField("STATUS")),
 		field.Time("created_at").
-			Default(time.Now),
+			Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
 		field.Time("updated_at").
 			Default(time.Now).
-			UpdateDefault(time.Now),
+			UpdateDefault(time.Now).
+			Annotations(entgql.OrderField("UPDATED_AT")),
 		field.Time("completed_at").
 			Optional().
 			Nillable(),
 		field.Time("deleted_at").
+			Optional().
+			Annotations(entgql.Skip()),
+		field.UUID("parent_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.UUID("project_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		// status_id is the workflow status of todos that belong to a project.
+		field.String("status_id").
 			Optional(),
 	}
 }
 
 // Edges of the Todo.
 func (TodoSchema) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("project", ProjectSchema.Type).
+			Ref("todos").
+			Field("project_id").
+			Unique(),
+		edge.To("tags", TagSchema.Type).
+			StorageKey(edge.Table("todo_tag"), edge.Columns("todo_id", "tag_id")),
+		edge.To("children", TodoSchema.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade), entgql.RelayConnection()).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		// The inverse edge is declared first so that ent resolves the pair as a
+		// directed M2M relation rather than a bidirectional one.
+		edge.From("blocks", TodoSchema.Type).
+			Ref("blocked_by"),
+		edge.To("blocked_by", TodoSchema.Type).
+			StorageKey(edge.Table("todo_dependency"), edge.Columns("todo_id", "blocker_id")),
+		edge.To("comments", CommentSchema.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("attachments", AttachmentSchema.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
 }
 
 func (TodoSchema) Indexes() []ent.Index {
@@ -58,5 +100,7 @@ func (TodoSchema) Indexes() []ent.Index {
 		index.Fields("deleted_at", "created_at"),
 		index.Fields("deleted_at", "updated_at"),
 		index.Fields("deleted_at", "status"),
+		index.Fields("parent_id"),
+		index.Fields("project_id", "status_id"),
 	}
 }
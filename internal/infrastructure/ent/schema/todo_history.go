@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// HistoryChange is the value of a field of a todo before and after a mutation.
+type HistoryChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// TodoHistorySchema holds the schema definition for the history entries of todos.
+// It has no edge to the todo, so the history outlives the deletion of the todo.
+type TodoHistorySchema struct {
+	ent.Schema
+}
+
+func (TodoHistorySchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "todo_history"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (TodoHistorySchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		EntityMixin{},
+	}
+}
+
+// Fields of the TodoHistory.
+func (TodoHistorySchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("todo_id", uuid.UUID{}).
+			Immutable(),
+		// version numbers the entries of a todo from 1 in the order they were recorded.
+		field.Int("version").
+			Positive().
+			Immutable(),
+		field.UUID("project_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("actor").
+			NotEmpty().
+			Immutable(),
+		field.Enum("operation").
+			Values("CREATE", "UPDATE", "DELETE").
+			Immutable(),
+		field.JSON("changes", []HistoryChange{}).
+			Immutable(),
+		field.Time("occurred_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (TodoHistorySchema) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("todo_id", "version").
+			Unique(),
+		index.Fields("todo_id", "occurred_at"),
+		index.Fields("project_id", "occurred_at"),
+	}
+}
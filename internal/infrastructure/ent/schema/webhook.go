@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookSchema holds the schema definition for the Webhook entity.
+type WebhookSchema struct {
+	ent.Schema
+}
+
+func (WebhookSchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (WebhookSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		EntityMixin{},
+	}
+}
+
+// Fields of the Webhook.
+func (WebhookSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048).
+			Immutable(),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Immutable(),
+		// events are the event types the webhook subscribes to, such as todo.created.
+		field.Strings("events"),
+		field.Bool("enabled").
+			Default(true),
+		field.Int("consecutive_failures").
+			NonNegative().
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Webhook.
+func (WebhookSchema) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDeliverySchema.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
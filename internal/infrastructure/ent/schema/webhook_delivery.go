@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDeliverySchema holds the schema definition for the Delivery entity.
+type WebhookDeliverySchema struct {
+	ent.Schema
+}
+
+func (WebhookDeliverySchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook_delivery"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (WebhookDeliverySchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		EntityMixin{},
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDeliverySchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("webhook_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("event_id", uuid.UUID{}).
+			Immutable(),
+		field.String("event_type").
+			NotEmpty().
+			Immutable(),
+		field.Bytes("payload").
+			Immutable(),
+		field.Enum("status").
+			Values("PENDING", "SUCCEEDED", "FAILED"),
+		field.Int("attempts").
+			NonNegative(),
+		// response_status is the HTTP status of the last response, or 0 when there was none.
+		field.Int("response_status").
+			NonNegative(),
+		field.String("last_error"),
+		field.Time("next_attempt_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDeliverySchema) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", WebhookSchema.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (WebhookDeliverySchema) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id", "created_at"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookEventSchema holds the schema definition for the queue of events waiting to be
+// delivered to the webhooks. The rows are written in the transaction of the change they
+// are about, and removed once the deliveries of the event are created.
+type WebhookEventSchema struct {
+	ent.Schema
+}
+
+func (WebhookEventSchema) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook_event"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (WebhookEventSchema) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		EntityMixin{},
+	}
+}
+
+// Fields of the WebhookEvent.
+func (WebhookEventSchema) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").
+			NotEmpty().
+			Immutable(),
+		field.UUID("todo_id", uuid.UUID{}).
+			Immutable(),
+		// payload is the JSON body sent to the endpoints.
+		field.Bytes("payload").
+			Immutable(),
+		field.Time("occurred_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (WebhookEventSchema) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("occurred_at"),
+	}
+}
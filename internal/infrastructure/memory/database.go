@@ -0,0 +1,151 @@
+// Package memory provides in-memory implementations of the todo repositories and of the
+// transaction runner. Nothing is persisted, which makes them suited to fast tests.
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// Database is the in-memory storage shared by the repositories and the transaction runner of this package.
+type Database struct {
+	// mu is held for writing by a transaction that can write, and for reading by a
+	// read-only one, so that writes are serialized and never seen before they are committed.
+	mu      sync.RWMutex
+	todos   map[todo.TodoID]todoRecord
+	history []*history.HistoryEntry
+}
+
+// NewDatabase creates an empty Database.
+func NewDatabase() *Database {
+	return &Database{todos: map[todo.TodoID]todoRecord{}}
+}
+
+type txKey struct{}
+
+// errReadOnly is returned by the writes of a read-only transaction.
+var errReadOnly = errors.New("transaction is read-only")
+
+// tx is a transaction of a Database. Its writes are staged until it is committed.
+type tx struct {
+	db       *Database
+	readOnly bool
+	// todos are the staged todos. A nil record is a staged deletion.
+	todos   map[todo.TodoID]*todoRecord
+	history []*history.HistoryEntry
+	// hooks collect the callbacks of the innermost savepoint.
+	hooks *uow.Hooks
+}
+
+// getTx returns the transaction from the context.
+func getTx(ctx context.Context) (*tx, error) {
+	t, ok := ctx.Value(txKey{}).(*tx)
+	if !ok {
+		return nil, errors.New("tx not found")
+	}
+	return t, nil
+}
+
+// reset drops the staged writes and callbacks, to run the transaction again.
+func (t *tx) reset() {
+	t.todos = map[todo.TodoID]*todoRecord{}
+	t.history = nil
+	t.hooks = &uow.Hooks{}
+}
+
+// todo returns the record of the todo as seen by the transaction.
+func (t *tx) todo(id todo.TodoID) (todoRecord, bool) {
+	if staged, ok := t.todos[id]; ok {
+		if staged == nil {
+			return todoRecord{}, false
+		}
+		return *staged, true
+	}
+	record, ok := t.db.todos[id]
+	return record, ok
+}
+
+// allTodos returns the records of every todo as seen by the transaction, oldest first.
+func (t *tx) allTodos() []todoRecord {
+	records := make([]todoRecord, 0, len(t.db.todos)+len(t.todos))
+	for id, record := range t.db.todos {
+		if _, ok := t.todos[id]; !ok {
+			records = append(records, record)
+		}
+	}
+	for _, staged := range t.todos {
+		if staged != nil {
+			records = append(records, *staged)
+		}
+	}
+	// Todo IDs are UUIDv7, so they sort in the order the todos were created.
+	slices.SortFunc(records, func(a, b todoRecord) int {
+		return cmp.Compare(a.id.String(), b.id.String())
+	})
+	return records
+}
+
+func (t *tx) putTodo(record todoRecord) error {
+	if t.readOnly {
+		return errReadOnly
+	}
+	t.todos[record.id] = &record
+	return nil
+}
+
+func (t *tx) deleteTodo(id todo.TodoID) error {
+	if t.readOnly {
+		return errReadOnly
+	}
+	t.todos[id] = nil
+	return nil
+}
+
+// appendHistory stages the entry as the next version of its todo and returns the staged entry.
+func (t *tx) appendHistory(entry *history.HistoryEntry) (*history.HistoryEntry, error) {
+	if t.readOnly {
+		return nil, errReadOnly
+	}
+	version := 1
+	for _, e := range t.allHistory() {
+		if e.TodoID() == entry.TodoID() {
+			version = max(version, e.Version()+1)
+		}
+	}
+	staged := history.ReconstructHistoryEntry(
+		entry.ID().UUID(),
+		entry.TodoID(),
+		version,
+		entry.ProjectID(),
+		entry.Actor(),
+		entry.Operation(),
+		entry.Changes(),
+		entry.OccurredAt(),
+	)
+	t.history = append(t.history, staged)
+	return staged, nil
+}
+
+// allHistory returns every history entry as seen by the transaction, in the order they were recorded.
+func (t *tx) allHistory() []*history.HistoryEntry {
+	return append(slices.Clip(t.db.history), t.history...)
+}
+
+// commit applies the staged writes to the database. The caller holds the write lock.
+func (t *tx) commit() {
+	for id, staged := range t.todos {
+		if staged == nil {
+			delete(t.db.todos, id)
+			continue
+		}
+		t.db.todos[id] = *staged
+	}
+	t.db.history = append(t.db.history, t.history...)
+}
@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// historyRepository is the in-memory implementation of the HistoryRepository interface.
+// The entries are written by the in-memory TodoRepository.
+type historyRepository struct{}
+
+// NewHistoryRepository creates a new in-memory HistoryRepository.
+func NewHistoryRepository() history.HistoryRepository {
+	return &historyRepository{}
+}
+
+// FindByTodoID returns the history of the Todo, oldest first.
+func (r historyRepository) FindByTodoID(
+	ctx context.Context,
+	todoID todo.TodoID,
+	limit int,
+	offset int,
+) ([]*history.HistoryEntry, error) {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := filterHistory(tx, func(e *history.HistoryEntry) bool {
+		return e.TodoID() == todoID
+	})
+	sortByVersion(entries)
+	return paginate(entries, limit, offset), nil
+}
+
+// FindByTodoIDUntil returns the history of the Todo recorded at or before until, oldest first.
+func (r historyRepository) FindByTodoIDUntil(
+	ctx context.Context,
+	todoID todo.TodoID,
+	until time.Time,
+) ([]*history.HistoryEntry, error) {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := filterHistory(tx, func(e *history.HistoryEntry) bool {
+		return e.TodoID() == todoID && !e.OccurredAt().After(until)
+	})
+	sortByVersion(entries)
+	return entries, nil
+}
+
+// FindByProjectID returns the history of the todos of the Project, newest first.
+func (r historyRepository) FindByProjectID(
+	ctx context.Context,
+	projectID project.ProjectID,
+	limit int,
+	offset int,
+) ([]*history.HistoryEntry, error) {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := filterHistory(tx, func(e *history.HistoryEntry) bool {
+		return e.ProjectID() != nil && *e.ProjectID() == projectID
+	})
+	slices.SortFunc(entries, func(a, b *history.HistoryEntry) int {
+		if c := b.OccurredAt().Compare(a.OccurredAt()); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.ID().String(), a.ID().String())
+	})
+	return paginate(entries, limit, offset), nil
+}
+
+func filterHistory(tx *tx, keep func(*history.HistoryEntry) bool) []*history.HistoryEntry {
+	var entries []*history.HistoryEntry
+	for _, e := range tx.allHistory() {
+		if keep(e) {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+func sortByVersion(entries []*history.HistoryEntry) {
+	slices.SortFunc(entries, func(a, b *history.HistoryEntry) int {
+		return cmp.Compare(a.Version(), b.Version())
+	})
+}
+
+// paginate returns up to limit entries after skipping offset of them.
+func paginate(entries []*history.HistoryEntry, limit int, offset int) []*history.HistoryEntry {
+	if offset >= len(entries) {
+		return nil
+	}
+	entries = entries[offset:]
+	if limit < len(entries) {
+		entries = entries[:limit]
+	}
+	return entries
+}
@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+)
+
+// tagRepository is a TagRepository that detaches the deleted tags from the todos of the Database,
+// as the database does for the todos it keeps. The tags themselves are kept by the next repository.
+type tagRepository struct {
+	tag.TagRepository
+}
+
+// NewTagRepository creates a TagRepository that keeps the tags in next, for the todos of the Database.
+func NewTagRepository(next tag.TagRepository) tag.TagRepository {
+	return &tagRepository{TagRepository: next}
+}
+
+// Delete deletes the Tag with the given ID and detaches it from all todos.
+func (r tagRepository) Delete(ctx context.Context, id tag.TagID) error {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := r.TagRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	for _, record := range tx.allTodos() {
+		if !slices.Contains(record.tagIDs, id) {
+			continue
+		}
+		record.tagIDs = slices.DeleteFunc(slices.Clone(record.tagIDs), func(tagID tag.TagID) bool {
+			return tagID == id
+		})
+		if err := tx.putTodo(record); err != nil {
+			return fmt.Errorf("failed to detach tag %v: %w", id, err)
+		}
+	}
+	return nil
+}
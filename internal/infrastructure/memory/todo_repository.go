@@ -0,0 +1,341 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+// todoRepository is the in-memory implementation of the TodoRepository interface.
+// The tags and projects that todos refer to are kept by other repositories, which
+// are asked whether they exist, as the database would check the references.
+type todoRepository struct {
+	clock    clock.Clock
+	ids      idgen.IDGenerator
+	listener history.EntryListener
+	tags     tag.TagRepository
+	projects project.ProjectRepository
+}
+
+// NewTodoRepository creates a new in-memory TodoRepository.
+// It stores the todos in the Database of the transaction in the context,
+// and finds the tags and projects they refer to in the given repositories.
+// The history entries it records occur at the time of clk and take their IDs from ids,
+// and listener is told about each of them once it is staged.
+func NewTodoRepository(
+	clk clock.Clock,
+	ids idgen.IDGenerator,
+	listener history.EntryListener,
+	tags tag.TagRepository,
+	projects project.ProjectRepository,
+) todo.TodoRepository {
+	return &todoRepository{clock: clk, ids: ids, listener: listener, tags: tags, projects: projects}
+}
+
+// Create creates the Todo and records its creation in the history.
+func (r todoRepository) Create(ctx context.Context, t *todo.Todo) error {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := tx.todo(t.ID()); ok {
+		return &todo.AlreadyExistsError{ID: t.ID()}
+	}
+	if err := r.checkReferences(ctx, t, nil); err != nil {
+		return err
+	}
+	if err := tx.putTodo(newTodoRecord(t)); err != nil {
+		return fmt.Errorf("failed to create todo: %w", err)
+	}
+	return r.recordHistory(ctx, tx, history.TodoCreated(r.ids, r.clock.Now(), history.ActorFromContext(ctx), t))
+}
+
+// FindAll returns all Todos that match the filter, oldest first.
+func (r todoRepository) FindAll(ctx context.Context, filter todo.TodoFilter) ([]*todo.Todo, error) {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	todos := []*todo.Todo{}
+	for _, record := range tx.allTodos() {
+		t := record.toTodo()
+		if !filter.Matches(t) {
+			continue
+		}
+		if filter.Actionable && isBlocked(tx, t) {
+			continue
+		}
+		todos = append(todos, t)
+	}
+	return todos, nil
+}
+
+// FindByID returns the Todo with the given ID.
+func (r todoRepository) FindByID(ctx context.Context, id todo.TodoID) (*todo.Todo, error) {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	record, ok := tx.todo(id)
+	if !ok {
+		return nil, &todo.NotFoundError{ID: id}
+	}
+	return record.toTodo(), nil
+}
+
+// Update updates the Todo with the given ID and records the changed fields in the history.
+func (r todoRepository) Update(ctx context.Context, t *todo.Todo) error {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return err
+	}
+	before, err := r.FindByID(ctx, t.ID())
+	if err != nil {
+		return err
+	}
+	if err := r.checkReferences(ctx, t, before); err != nil {
+		return err
+	}
+	if err := tx.putTodo(newTodoRecord(t)); err != nil {
+		return fmt.Errorf("failed to update todo %v: %w", t.ID(), err)
+	}
+	if entry := history.TodoUpdated(r.ids, r.clock.Now(), history.ActorFromContext(ctx), before, t); entry != nil {
+		return r.recordHistory(ctx, tx, entry)
+	}
+	return nil
+}
+
+// FindAncestorIDs returns the IDs of the ancestors of the Todo, nearest first.
+func (r todoRepository) FindAncestorIDs(ctx context.Context, id todo.TodoID) ([]todo.TodoID, error) {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ancestorIDs []todo.TodoID
+	current := id
+	// The depth limit bounds the walk even if the stored tree is corrupted.
+	for range todo.MaxDepth + 1 {
+		record, ok := tx.todo(current)
+		if !ok {
+			return nil, &todo.NotFoundError{ID: current}
+		}
+		if record.parentID == nil {
+			return ancestorIDs, nil
+		}
+		current = *record.parentID
+		ancestorIDs = append(ancestorIDs, current)
+	}
+	return ancestorIDs, nil
+}
+
+// FindDescendants returns every Todo in the subtree below the Todo.
+func (r todoRepository) FindDescendants(ctx context.Context, id todo.TodoID) ([]*todo.Todo, error) {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	all := tx.allTodos()
+	var descendants []*todo.Todo
+	parentIDs := []todo.TodoID{id}
+	for range todo.MaxDepth {
+		var childIDs []todo.TodoID
+		for _, record := range all {
+			if record.parentID != nil && slices.Contains(parentIDs, *record.parentID) {
+				descendants = append(descendants, record.toTodo())
+				childIDs = append(childIDs, record.id)
+			}
+		}
+		if len(childIDs) == 0 {
+			break
+		}
+		parentIDs = childIDs
+	}
+	return descendants, nil
+}
+
+// Delete deletes the Todo with the given ID together with its subtree,
+// and records the deletion of each of them in the history.
+// The deleted todos no longer block other todos.
+func (r todoRepository) Delete(ctx context.Context, id todo.TodoID) error {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	descendants, err := r.FindDescendants(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	deletedTodos := append([]*todo.Todo{deleted}, descendants...)
+	deletedIDs := make([]todo.TodoID, len(deletedTodos))
+	for i, t := range deletedTodos {
+		deletedIDs[i] = t.ID()
+		if err := tx.deleteTodo(t.ID()); err != nil {
+			return fmt.Errorf("failed to delete todo %v: %w", t.ID(), err)
+		}
+	}
+	for _, record := range tx.allTodos() {
+		blockerIDs := slices.DeleteFunc(slices.Clone(record.blockerIDs), func(blockerID todo.TodoID) bool {
+			return slices.Contains(deletedIDs, blockerID)
+		})
+		if len(blockerIDs) != len(record.blockerIDs) {
+			record.blockerIDs = blockerIDs
+			if err := tx.putTodo(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	actor, now := history.ActorFromContext(ctx), r.clock.Now()
+	for _, t := range deletedTodos {
+		if err := r.recordHistory(ctx, tx, history.TodoDeleted(r.ids, now, actor, t)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkReferences checks that the tags and the project of the Todo exist.
+// Only the references added since before are checked when the Todo is updated.
+func (r todoRepository) checkReferences(ctx context.Context, t *todo.Todo, before *todo.Todo) error {
+	for _, tagID := range t.TagIDs() {
+		if before != nil && before.HasTag(tagID) {
+			continue
+		}
+		_, err := r.tags.FindByID(ctx, tagID)
+		var notFoundErr *tag.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return &todo.ValidationError{Field: "tag_ids", Message: fmt.Sprintf("tag %v does not exist", tagID)}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find tag %v: %w", tagID, err)
+		}
+	}
+
+	projectID := t.ProjectID()
+	if projectID == nil || (before != nil && before.ProjectID() != nil && *before.ProjectID() == *projectID) {
+		return nil
+	}
+	_, err := r.projects.FindByID(ctx, *projectID)
+	var notFoundErr *project.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return &todo.ValidationError{Field: "project_id", Message: fmt.Sprintf("project %v does not exist", *projectID)}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find project %v: %w", *projectID, err)
+	}
+	return nil
+}
+
+// recordHistory stages the entry in the transaction and tells the listener about it.
+func (r todoRepository) recordHistory(ctx context.Context, tx *tx, entry *history.HistoryEntry) error {
+	staged, err := tx.appendHistory(entry)
+	if err != nil {
+		return err
+	}
+	return r.listener.EntryRecorded(ctx, staged)
+}
+
+// isBlocked reports whether any blocker of the Todo is unfinished.
+func isBlocked(tx *tx, t *todo.Todo) bool {
+	for _, blockerID := range t.BlockerIDs() {
+		if blocker, ok := tx.todo(blockerID); ok && !blocker.status.IsFinished() {
+			return true
+		}
+	}
+	return false
+}
+
+// todoRecord is the stored state of a Todo. Records are copied in and out of the
+// database, so the todos returned by the repository never share state with it.
+type todoRecord struct {
+	id          todo.TodoID
+	title       string
+	body        string
+	status      todo.TodoStatus
+	createdAt   time.Time
+	updatedAt   time.Time
+	completedAt *time.Time
+	tagIDs      []tag.TagID
+	parentID    *todo.TodoID
+	blockerIDs  []todo.TodoID
+	projectID   *project.ProjectID
+	// workflow is immutable, so it is shared between the records and the todos.
+	workflow *project.Workflow
+	statusID project.StatusID
+}
+
+func newTodoRecord(t *todo.Todo) todoRecord {
+	record := todoRecord{
+		id:          t.ID(),
+		title:       t.Title(),
+		body:        t.Body(),
+		status:      t.Status(),
+		createdAt:   t.CreatedAt(),
+		updatedAt:   t.UpdatedAt(),
+		completedAt: copyTime(t.CompletedAt()),
+		tagIDs:      t.TagIDs(),
+		parentID:    t.ParentID(),
+		blockerIDs:  t.BlockerIDs(),
+		projectID:   t.ProjectID(),
+		workflow:    t.Workflow(),
+	}
+	// Todos without a project keep their status in the status only, as in the database.
+	if record.projectID != nil {
+		record.statusID = t.StatusID()
+	}
+	return record
+}
+
+func (r todoRecord) toTodo() *todo.Todo {
+	var parentID *todo.TodoID
+	if r.parentID != nil {
+		id := *r.parentID
+		parentID = &id
+	}
+	var projectID *project.ProjectID
+	if r.projectID != nil {
+		id := *r.projectID
+		projectID = &id
+	}
+	return todo.ReconstructTodoWithStatus(
+		r.id.UUID(),
+		r.title,
+		r.body,
+		r.status,
+		r.createdAt,
+		r.updatedAt,
+		copyTime(r.completedAt),
+		slices.Clone(r.tagIDs),
+		parentID,
+		slices.Clone(r.blockerIDs),
+		projectID,
+		r.workflow,
+		r.statusID,
+	)
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/db"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/entgen/enttest"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/projectrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/ent/repository/tagrepo"
+	"github.com/iktakahiro/oniongo/internal/infrastructure/repotest"
+	"github.com/stretchr/testify/require"
+)
+
+// newHarness returns the in-memory repositories with the tags and projects kept by the ent
+// repositories, and every transaction spanning both stores.
+func newHarness(t *testing.T) repotest.TodoHarness {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(db.MigrateOptions()...),
+	)
+	t.Cleanup(func() { _ = client.Close() })
+
+	tags, err := tagrepo.NewTagRepository(nil)
+	require.NoError(t, err)
+	projects, err := projectrepo.NewProjectRepository(nil)
+	require.NoError(t, err)
+	clk := clock.NewFake(time.Now())
+	listener := &repotest.EntryRecorder{}
+	return repotest.TodoHarness{
+		Todos:    NewTodoRepository(clk, idgen.NewSequence(), listener, tags, projects),
+		History:  NewHistoryRepository(),
+		TxRunner: NewTransactionRunner(NewDatabase(), db.NewClientTransactionRunner(client)),
+		Tags:     NewTagRepository(tags),
+		Projects: projects,
+		Clock:    clk,
+		Listener: listener,
+	}
+}
+
+func TestTodoRepository(t *testing.T) {
+	repotest.TestTodoRepository(t, newHarness)
+}
+
+func TestTodoSearchRepository(t *testing.T) {
+	repotest.TestTodoSearchRepository(t, func(t *testing.T) repotest.TodoSearchHarness {
+		h := newHarness(t)
+		return repotest.TodoSearchHarness{
+			Todos:    h.Todos,
+			Search:   NewTodoSearchRepository(),
+			TxRunner: h.TxRunner,
+		}
+	})
+}
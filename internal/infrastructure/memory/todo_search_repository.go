@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+)
+
+const (
+	// titleWeight and bodyWeight weight a match in the title over one in the body, as the database does.
+	titleWeight = 10
+	bodyWeight  = 1
+	// snippetTokens is the number of words of a body snippet.
+	snippetTokens = 16
+)
+
+// todoSearchRepository is the in-memory implementation of the TodoSearchRepository interface.
+// It reads every todo of the transaction, and scores each by its matches.
+type todoSearchRepository struct{}
+
+// NewTodoSearchRepository creates a new in-memory TodoSearchRepository.
+func NewTodoSearchRepository() todo.TodoSearchRepository {
+	return &todoSearchRepository{}
+}
+
+// Search returns the todos that match the query, best match first.
+func (r todoSearchRepository) Search(
+	ctx context.Context,
+	query todo.SearchQuery,
+	limit int,
+	offset int,
+) ([]*todo.SearchHit, error) {
+	tx, err := getTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := query.Terms()
+	for i := range terms {
+		for j, word := range terms[i].Words {
+			terms[i].Words[j] = strings.ToLower(word)
+		}
+	}
+
+	var hits []*todo.SearchHit
+	for _, record := range tx.allTodos() {
+		title, body := tokenize(record.title), tokenize(record.body)
+		var titleSpans, bodySpans []span
+		matched := true
+		for _, term := range terms {
+			inTitle, inBody := title.match(term), body.match(term)
+			if len(inTitle) == 0 && len(inBody) == 0 {
+				matched = false
+				break
+			}
+			titleSpans = append(titleSpans, inTitle...)
+			bodySpans = append(bodySpans, inBody...)
+		}
+		if !matched {
+			continue
+		}
+		hits = append(hits, &todo.SearchHit{
+			Todo:         record.toTodo(),
+			Score:        float64(titleWeight*len(titleSpans) + bodyWeight*len(bodySpans)),
+			TitleSnippet: title.highlight(titleSpans, 0, len(title.tokens)),
+			BodySnippet:  body.snippet(bodySpans),
+		})
+	}
+
+	// The todos that score the same are kept in the order they were created.
+	slices.SortStableFunc(hits, func(a, b *todo.SearchHit) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	if offset >= len(hits) {
+		return nil, nil
+	}
+	return hits[offset:min(offset+limit, len(hits))], nil
+}
+
+// token is a word of a text, at text[start:end].
+type token struct {
+	word       string
+	start, end int
+}
+
+// span is a match of a term, from the token at first to the token at last.
+type span struct {
+	first, last int
+}
+
+// tokenizedText is a text split into words, as ParseSearchQuery splits a query.
+type tokenizedText struct {
+	text   string
+	tokens []token
+}
+
+func tokenize(text string) tokenizedText {
+	t := tokenizedText{text: text}
+	start := -1
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsNumber(r)
+		if isWord && start < 0 {
+			start = i
+		}
+		if !isWord && start >= 0 {
+			t.tokens = append(t.tokens, token{word: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		t.tokens = append(t.tokens, token{word: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return t
+}
+
+// match returns where the words of the term appear next to each other in the text.
+func (t tokenizedText) match(term todo.SearchTerm) []span {
+	var spans []span
+	n := len(term.Words)
+	for first := 0; first+n <= len(t.tokens); first++ {
+		matched := true
+		for j, word := range term.Words {
+			got := t.tokens[first+j].word
+			if got != word && !(term.Prefix && j == n-1 && strings.HasPrefix(got, word)) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			spans = append(spans, span{first: first, last: first + n - 1})
+		}
+	}
+	return spans
+}
+
+// highlight returns the text of the tokens from first to end, exclusive, with the spans highlighted.
+func (t tokenizedText) highlight(spans []span, first int, end int) string {
+	if len(t.tokens) == 0 {
+		return t.text
+	}
+	marked := make([]bool, len(t.tokens))
+	for _, s := range spans {
+		for i := s.first; i <= s.last; i++ {
+			marked[i] = true
+		}
+	}
+
+	from, to := 0, len(t.text)
+	if first > 0 {
+		from = t.tokens[first].start
+	}
+	if end < len(t.tokens) {
+		to = t.tokens[end-1].end
+	}
+	var b strings.Builder
+	pos := from
+	for i := first; i < end; i++ {
+		if !marked[i] || (i > first && marked[i-1]) {
+			continue
+		}
+		last := i
+		for last+1 < end && marked[last+1] {
+			last++
+		}
+		b.WriteString(t.text[pos:t.tokens[i].start])
+		b.WriteString(todo.HighlightStart)
+		b.WriteString(t.text[t.tokens[i].start:t.tokens[last].end])
+		b.WriteString(todo.HighlightEnd)
+		pos = t.tokens[last].end
+	}
+	b.WriteString(t.text[pos:to])
+	return b.String()
+}
+
+// snippet returns the words around the first match, highlighted, or an empty string without matches.
+func (t tokenizedText) snippet(spans []span) string {
+	if len(spans) == 0 {
+		return ""
+	}
+	first := slices.MinFunc(spans, func(a, b span) int { return cmp.Compare(a.first, b.first) }).first
+	start := max(0, min(first-snippetTokens/4, len(t.tokens)-snippetTokens))
+	end := min(len(t.tokens), start+snippetTokens)
+
+	snippet := t.highlight(spans, start, end)
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(t.tokens) {
+		snippet += "…"
+	}
+	return snippet
+}
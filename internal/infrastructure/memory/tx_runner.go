@@ -0,0 +1,113 @@
+package memory
+
+import (
+	"context"
+	"maps"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+)
+
+// transactionRunner is the implementation of the TransactionRunner interface on a Database.
+type transactionRunner struct {
+	db   *Database
+	next uow.TransactionRunner
+}
+
+// NewTransactionRunner creates a TransactionRunner on the database.
+//
+// When next is not nil, fn also runs in a transaction of next, so that the repositories
+// of both can be used together. The in-memory writes are committed only once next has
+// committed, which cannot fail, so both transactions commit or neither does. next may
+// run fn again, and the in-memory writes of the failed attempt are dropped.
+//
+// Read-only work runs concurrently with other read-only work and cannot write.
+// Other transactions run one at a time.
+func NewTransactionRunner(db *Database, next uow.TransactionRunner) uow.TransactionRunner {
+	return &transactionRunner{db: db, next: next}
+}
+
+// RunInTx runs a function in a transaction, or in a savepoint of the transaction in ctx.
+// The isolation level is always serializable.
+func (r transactionRunner) RunInTx(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+	opts ...uow.TxOption,
+) error {
+	if t, err := getTx(ctx); err == nil {
+		return r.runInSavepoint(ctx, t, fn, opts)
+	}
+
+	hooks, err := r.run(ctx, uow.NewTxOptions(ctx, opts...), fn, opts)
+	if err != nil {
+		return err
+	}
+	// The callbacks run without the lock, so that they can start transactions of their own.
+	hooks.RunAfterCommit(ctx)
+	return nil
+}
+
+// run runs fn in a new transaction and commits it.
+func (r transactionRunner) run(
+	ctx context.Context,
+	o uow.TxOptions,
+	fn func(ctx context.Context) error,
+	opts []uow.TxOption,
+) (*uow.Hooks, error) {
+	if o.ReadOnly {
+		r.db.mu.RLock()
+		defer r.db.mu.RUnlock()
+	} else {
+		r.db.mu.Lock()
+		defer r.db.mu.Unlock()
+	}
+
+	t := &tx{db: r.db, readOnly: o.ReadOnly}
+	attempt := func(ctx context.Context) error {
+		t.reset()
+		ctx = context.WithValue(ctx, txKey{}, t)
+		return fn(uow.WithHooks(ctx, t.hooks))
+	}
+
+	var err error
+	if r.next != nil {
+		err = r.next.RunInTx(ctx, attempt, opts...)
+	} else {
+		err = attempt(ctx)
+	}
+	if err != nil {
+		// The staged writes are dropped with the transaction.
+		return nil, err
+	}
+	t.commit()
+	return t.hooks, nil
+}
+
+// runInSavepoint runs fn in the transaction t, and drops the writes and callbacks of fn when it fails.
+func (r transactionRunner) runInSavepoint(
+	ctx context.Context,
+	t *tx,
+	fn func(ctx context.Context) error,
+	opts []uow.TxOption,
+) error {
+	todos, historyLen, parent := maps.Clone(t.todos), len(t.history), t.hooks
+	t.hooks = &uow.Hooks{}
+	savepoint := t.hooks
+	defer func() { t.hooks = parent }()
+
+	attempt := func(ctx context.Context) error {
+		return fn(uow.WithHooks(ctx, savepoint))
+	}
+
+	var err error
+	if r.next != nil {
+		err = r.next.RunInTx(ctx, attempt, opts...)
+	} else {
+		err = attempt(ctx)
+	}
+	if err != nil {
+		t.todos, t.history = todos, t.history[:historyLen]
+		return err
+	}
+	parent.Merge(savepoint)
+	return nil
+}
@@ -0,0 +1,546 @@
+// Package repotest provides the behavioural contracts of the domain repositories,
+// so that every implementation of a repository is checked by the same tests.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TodoHarness is a TodoRepository under test, with the repositories and the
+// TransactionRunner it works with.
+type TodoHarness struct {
+	Todos    todo.TodoRepository
+	History  history.HistoryRepository
+	TxRunner uow.TransactionRunner
+	// Tags and Projects store the tags and projects that todos refer to.
+	Tags     tag.TagRepository
+	Projects project.ProjectRepository
+	// Clock is the clock the TodoRepository records the history with.
+	// The history IDs must come from an idgen.Sequence used by nothing else.
+	Clock *clock.Fake
+	// Listener is the EntryListener the TodoRepository tells about the entries it records.
+	Listener *EntryRecorder
+}
+
+// EntryRecorder is a history.EntryListener that keeps the entries it is told about,
+// or fails with Err when it is set.
+type EntryRecorder struct {
+	Err     error
+	entries []*history.HistoryEntry
+}
+
+func (r *EntryRecorder) EntryRecorded(_ context.Context, entry *history.HistoryEntry) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+// Entries returns the entries told about, in the order they were recorded.
+func (r *EntryRecorder) Entries() []*history.HistoryEntry {
+	return r.entries
+}
+
+// TestTodoRepository runs the contract of todo.TodoRepository and of the history it records.
+// newHarness is called for each test and must return empty repositories.
+func TestTodoRepository(t *testing.T, newHarness func(t *testing.T) TodoHarness) {
+	t.Run("finds a created todo by ID", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		created := newTodo(t, "Buy milk")
+		tagged := h.createTag(t, ctx)
+		require.NoError(t, created.AddTag(time.Now(), tagged.ID()))
+
+		h.run(t, ctx, func(ctx context.Context) error { return h.Todos.Create(ctx, created) })
+
+		found := h.findByID(t, ctx, created.ID())
+		assertSameTodo(t, created, found)
+	})
+
+	t.Run("returns NotFoundError for a missing todo", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		id := todo.NewTodoID()
+
+		err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error {
+			_, err := h.Todos.FindByID(ctx, id)
+			return err
+		})
+
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		assert.Equal(t, id, notFoundErr.ID)
+	})
+
+	t.Run("rejects a todo created twice", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		created := newTodo(t, "Buy milk")
+		h.create(t, ctx, created)
+
+		err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error { return h.Todos.Create(ctx, created) })
+
+		var alreadyExistsErr *todo.AlreadyExistsError
+		require.ErrorAs(t, err, &alreadyExistsErr)
+		assert.Equal(t, created.ID(), alreadyExistsErr.ID)
+	})
+
+	t.Run("rejects a reference to a missing tag", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		created := newTodo(t, "Buy milk")
+		require.NoError(t, created.AddTag(time.Now(), tag.NewTagID()))
+
+		err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error { return h.Todos.Create(ctx, created) })
+
+		var validationErr *todo.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("rejects a reference to a missing project", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		updated := newTodo(t, "Buy milk")
+		h.create(t, ctx, updated)
+		missing, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Launch", nil)
+		require.NoError(t, err)
+		require.NoError(t, updated.AssignProject(time.Now(), missing))
+
+		err = h.TxRunner.RunInTx(ctx, func(ctx context.Context) error { return h.Todos.Update(ctx, updated) })
+
+		var validationErr *todo.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("does not share state with the returned todos", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		created := newTodo(t, "Buy milk")
+		h.create(t, ctx, created)
+
+		found := h.findByID(t, ctx, created.ID())
+		require.NoError(t, found.SetTitle(time.Now(), "Changed without an update"))
+		require.NoError(t, created.SetTitle(time.Now(), "Changed after it was created"))
+
+		assert.Equal(t, "Buy milk", h.findByID(t, ctx, created.ID()).Title())
+	})
+
+	t.Run("updates a todo", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		parent := newTodo(t, "Plan the trip")
+		blocker := newTodo(t, "Book the flight")
+		updated := newTodo(t, "Pack")
+		h.create(t, ctx, parent, blocker, updated)
+		tagged := h.createTag(t, ctx)
+
+		require.NoError(t, updated.SetTitle(time.Now(), "Pack the bags"))
+		require.NoError(t, updated.SetBody(time.Now(), "Passport first"))
+		require.NoError(t, updated.AddTag(time.Now(), tagged.ID()))
+		require.NoError(t, updated.AddBlocker(time.Now(), blocker.ID()))
+		require.NoError(t, updated.SetParent(time.Now(), parent, nil, 1))
+		require.NoError(t, updated.Cancel(time.Now()))
+		h.run(t, ctx, func(ctx context.Context) error { return h.Todos.Update(ctx, updated) })
+
+		assertSameTodo(t, updated, h.findByID(t, ctx, updated.ID()))
+	})
+
+	t.Run("returns NotFoundError when updating a missing todo", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		missing := newTodo(t, "Buy milk")
+
+		err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error { return h.Todos.Update(ctx, missing) })
+
+		var notFoundErr *todo.NotFoundError
+		require.ErrorAs(t, err, &notFoundErr)
+		assert.Equal(t, missing.ID(), notFoundErr.ID)
+	})
+
+	t.Run("finds todos by filter", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		home, work := h.createTag(t, ctx), h.createTag(t, ctx)
+		launch := h.createProject(t, ctx)
+
+		parent := newTodo(t, "Parent")
+		both := newTodo(t, "Both tags")
+		require.NoError(t, both.AddTag(time.Now(), home.ID()))
+		require.NoError(t, both.AddTag(time.Now(), work.ID()))
+		require.NoError(t, both.SetParent(time.Now(), parent, nil, 1))
+		homeOnly := newTodo(t, "Home tag")
+		require.NoError(t, homeOnly.AddTag(time.Now(), home.ID()))
+		inProject := newTodo(t, "In the project")
+		require.NoError(t, inProject.AssignProject(time.Now(), launch))
+		blocked := newTodo(t, "Blocked")
+		require.NoError(t, blocked.AddBlocker(time.Now(), homeOnly.ID()))
+		done := newTodo(t, "Done")
+		require.NoError(t, done.Start(time.Now()))
+		require.NoError(t, done.Complete(time.Now()))
+		h.create(t, ctx, parent, both, homeOnly, inProject, blocked, done)
+
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{}), parent, both, homeOnly, inProject, blocked, done)
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{TagIDs: []tag.TagID{home.ID(), work.ID()}}), both, homeOnly)
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{
+			TagIDs:   []tag.TagID{home.ID(), work.ID()},
+			TagMatch: todo.TagMatchAll,
+		}), both)
+		parentID, projectID := parent.ID(), launch.ID()
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{ParentID: &parentID}), both)
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{ProjectID: &projectID}), inProject)
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{Actionable: true}), parent, both, homeOnly, inProject)
+
+		// A todo becomes actionable once its blockers are finished.
+		require.NoError(t, homeOnly.Start(time.Now()))
+		require.NoError(t, homeOnly.Complete(time.Now()))
+		h.run(t, ctx, func(ctx context.Context) error { return h.Todos.Update(ctx, homeOnly) })
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{Actionable: true}), parent, both, inProject, blocked)
+	})
+
+	t.Run("finds the ancestors and the descendants of a todo", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		root := newTodo(t, "Root")
+		child := newTodo(t, "Child")
+		require.NoError(t, child.SetParent(time.Now(), root, nil, 1))
+		grandchild := newTodo(t, "Grandchild")
+		require.NoError(t, grandchild.SetParent(time.Now(), child, []todo.TodoID{root.ID()}, 1))
+		sibling := newTodo(t, "Sibling")
+		require.NoError(t, sibling.SetParent(time.Now(), root, nil, 1))
+		h.create(t, ctx, root, child, grandchild, sibling)
+
+		var ancestorIDs []todo.TodoID
+		var descendants, leafDescendants []*todo.Todo
+		h.run(t, ctx, func(ctx context.Context) error {
+			var err error
+			if ancestorIDs, err = h.Todos.FindAncestorIDs(ctx, grandchild.ID()); err != nil {
+				return err
+			}
+			if descendants, err = h.Todos.FindDescendants(ctx, root.ID()); err != nil {
+				return err
+			}
+			leafDescendants, err = h.Todos.FindDescendants(ctx, grandchild.ID())
+			return err
+		})
+
+		assert.Equal(t, []todo.TodoID{child.ID(), root.ID()}, ancestorIDs)
+		assertIDs(t, descendants, child, grandchild, sibling)
+		assert.Empty(t, leafDescendants)
+
+		err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error {
+			_, err := h.Todos.FindAncestorIDs(ctx, todo.NewTodoID())
+			return err
+		})
+		var notFoundErr *todo.NotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("detaches a deleted tag from the todos", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		kept, deleted := h.createTag(t, ctx), h.createTag(t, ctx)
+		tagged := newTodo(t, "Buy milk")
+		require.NoError(t, tagged.AddTag(time.Now(), kept.ID()))
+		require.NoError(t, tagged.AddTag(time.Now(), deleted.ID()))
+		h.create(t, ctx, tagged)
+
+		h.run(t, ctx, func(ctx context.Context) error { return h.Tags.Delete(ctx, deleted.ID()) })
+
+		assert.Equal(t, []tag.TagID{kept.ID()}, h.findByID(t, ctx, tagged.ID()).TagIDs())
+	})
+
+	t.Run("deletes a todo with its subtree", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		root := newTodo(t, "Root")
+		child := newTodo(t, "Child")
+		require.NoError(t, child.SetParent(time.Now(), root, nil, 1))
+		blocked := newTodo(t, "Blocked by the child")
+		require.NoError(t, blocked.AddBlocker(time.Now(), child.ID()))
+		h.create(t, ctx, root, child, blocked)
+
+		h.run(t, ctx, func(ctx context.Context) error { return h.Todos.Delete(ctx, root.ID()) })
+
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{}), blocked)
+		assert.Empty(t, h.findByID(t, ctx, blocked.ID()).BlockerIDs())
+		err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error { return h.Todos.Delete(ctx, root.ID()) })
+		var notFoundErr *todo.NotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("records the history of a todo", func(t *testing.T) {
+		h := newHarness(t)
+		ctx := history.WithActor(context.Background(), "alice")
+		launch := h.createProject(t, ctx)
+		recorded := newTodo(t, "Buy milk")
+		require.NoError(t, recorded.AssignProject(time.Now(), launch))
+		createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		h.Clock.Set(createdAt)
+		h.create(t, ctx, recorded)
+		require.NoError(t, recorded.SetTitle(time.Now(), "Buy oat milk"))
+		h.Clock.Advance(time.Hour)
+		h.run(t, ctx, func(ctx context.Context) error { return h.Todos.Update(ctx, recorded) })
+		// An update that changes nothing is not recorded.
+		h.run(t, ctx, func(ctx context.Context) error { return h.Todos.Update(ctx, recorded) })
+		h.Clock.Advance(time.Hour)
+		h.run(t, ctx, func(ctx context.Context) error { return h.Todos.Delete(ctx, recorded.ID()) })
+
+		var entries, page, activity []*history.HistoryEntry
+		h.run(t, ctx, func(ctx context.Context) error {
+			var err error
+			if entries, err = h.History.FindByTodoID(ctx, recorded.ID(), 10, 0); err != nil {
+				return err
+			}
+			if page, err = h.History.FindByTodoID(ctx, recorded.ID(), 1, 1); err != nil {
+				return err
+			}
+			activity, err = h.History.FindByProjectID(ctx, launch.ID(), 10, 0)
+			return err
+		})
+
+		require.Len(t, entries, 3)
+		ids := idgen.NewSequence()
+		for i, operation := range []history.Operation{history.OperationCreate, history.OperationUpdate, history.OperationDelete} {
+			assert.Equal(t, history.HistoryEntryID(ids.NewID()), entries[i].ID())
+			assert.Equal(t, i+1, entries[i].Version())
+			assert.Equal(t, operation, entries[i].Operation())
+			assert.Equal(t, "alice", entries[i].Actor())
+			assert.WithinDuration(t, createdAt.Add(time.Duration(i)*time.Hour), entries[i].OccurredAt(), 0)
+		}
+		require.Len(t, page, 1)
+		assert.Equal(t, 2, page[0].Version())
+		require.Len(t, activity, 3)
+		assert.Equal(t, history.OperationDelete, activity[0].Operation())
+		told := h.Listener.Entries()
+		require.Len(t, told, 3)
+		for i, entry := range told {
+			assert.Equal(t, entries[i].ID(), entry.ID())
+			assert.Equal(t, entries[i].Version(), entry.Version())
+		}
+	})
+
+	t.Run("rolls back a change the history listener fails on", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		failure := errors.New("failure")
+		h.Listener.Err = failure
+		created := newTodo(t, "Buy milk")
+
+		err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error { return h.Todos.Create(ctx, created) })
+
+		assert.ErrorIs(t, err, failure)
+		assert.Empty(t, h.findAll(t, ctx, todo.TodoFilter{}))
+	})
+
+	t.Run("sees its own writes before it commits", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		created := newTodo(t, "Buy milk")
+
+		h.run(t, ctx, func(ctx context.Context) error {
+			if err := h.Todos.Create(ctx, created); err != nil {
+				return err
+			}
+			found, err := h.Todos.FindByID(ctx, created.ID())
+			if err != nil {
+				return err
+			}
+			assert.Equal(t, created.ID(), found.ID())
+			all, err := h.Todos.FindAll(ctx, todo.TodoFilter{})
+			assertIDs(t, all, created)
+			return err
+		})
+	})
+
+	t.Run("discards the writes of a failed transaction", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		kept := newTodo(t, "Kept")
+		h.create(t, ctx, kept)
+		discarded := newTodo(t, "Discarded")
+		failure := errors.New("failure")
+
+		err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error {
+			if err := h.Todos.Create(ctx, discarded); err != nil {
+				return err
+			}
+			require.NoError(t, kept.SetTitle(time.Now(), "Renamed"))
+			if err := h.Todos.Update(ctx, kept); err != nil {
+				return err
+			}
+			return failure
+		})
+
+		assert.ErrorIs(t, err, failure)
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{}), kept)
+		assert.Equal(t, "Kept", h.findByID(t, ctx, kept.ID()).Title())
+		var entries []*history.HistoryEntry
+		h.run(t, ctx, func(ctx context.Context) error {
+			var err error
+			entries, err = h.History.FindByTodoID(ctx, kept.ID(), 10, 0)
+			return err
+		})
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("rolls back a failed nested transaction alone", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		outer, inner, after := newTodo(t, "Outer"), newTodo(t, "Inner"), newTodo(t, "After")
+		failure := errors.New("failure")
+		var called []string
+
+		h.run(t, ctx, func(ctx context.Context) error {
+			if err := h.Todos.Create(ctx, outer); err != nil {
+				return err
+			}
+			err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error {
+				if err := h.Todos.Create(ctx, inner); err != nil {
+					return err
+				}
+				require.NoError(t, uow.AfterCommit(ctx, func(context.Context) { called = append(called, "inner") }))
+				return failure
+			})
+			require.ErrorIs(t, err, failure)
+			assertIDs(t, h.mustFindAll(t, ctx), outer)
+
+			// A nested transaction that succeeds is committed with the outer one.
+			return h.TxRunner.RunInTx(ctx, func(ctx context.Context) error {
+				require.NoError(t, uow.AfterCommit(ctx, func(context.Context) { called = append(called, "after") }))
+				return h.Todos.Create(ctx, after)
+			})
+		})
+
+		assertIDs(t, h.findAll(t, ctx, todo.TodoFilter{}), outer, after)
+		assert.Equal(t, []string{"after"}, called)
+	})
+
+	t.Run("calls the after-commit callbacks once committed", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		created := newTodo(t, "Buy milk")
+		var committed []todo.TodoID
+
+		h.run(t, ctx, func(ctx context.Context) error {
+			if err := h.Todos.Create(ctx, created); err != nil {
+				return err
+			}
+			return uow.AfterCommit(ctx, func(ctx context.Context) {
+				// The callback sees the committed todo in a transaction of its own.
+				committed = append(committed, h.findByID(t, ctx, created.ID()).ID())
+			})
+		})
+		err := h.TxRunner.RunInTx(ctx, func(ctx context.Context) error {
+			require.NoError(t, uow.AfterCommit(ctx, func(context.Context) { t.Error("called after a rollback") }))
+			return errors.New("failure")
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, []todo.TodoID{created.ID()}, committed)
+	})
+}
+
+func (h TodoHarness) mustFindAll(t *testing.T, ctx context.Context) []*todo.Todo {
+	t.Helper()
+	found, err := h.Todos.FindAll(ctx, todo.TodoFilter{})
+	require.NoError(t, err)
+	return found
+}
+
+func (h TodoHarness) run(t *testing.T, ctx context.Context, fn func(ctx context.Context) error) {
+	t.Helper()
+	require.NoError(t, h.TxRunner.RunInTx(ctx, fn))
+}
+
+func (h TodoHarness) create(t *testing.T, ctx context.Context, todos ...*todo.Todo) {
+	t.Helper()
+	h.run(t, ctx, func(ctx context.Context) error {
+		for _, created := range todos {
+			if err := h.Todos.Create(ctx, created); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (h TodoHarness) findByID(t *testing.T, ctx context.Context, id todo.TodoID) *todo.Todo {
+	t.Helper()
+	var found *todo.Todo
+	h.run(t, ctx, func(ctx context.Context) error {
+		var err error
+		found, err = h.Todos.FindByID(ctx, id)
+		return err
+	})
+	return found
+}
+
+func (h TodoHarness) findAll(t *testing.T, ctx context.Context, filter todo.TodoFilter) []*todo.Todo {
+	t.Helper()
+	var found []*todo.Todo
+	h.run(t, ctx, func(ctx context.Context) error {
+		var err error
+		found, err = h.Todos.FindAll(ctx, filter)
+		return err
+	})
+	return found
+}
+
+func (h TodoHarness) createTag(t *testing.T, ctx context.Context) *tag.Tag {
+	t.Helper()
+	created, err := tag.NewTag(idgen.UUIDv7(), time.Now(), "tag-"+todo.NewTodoID().String(), "")
+	require.NoError(t, err)
+	h.run(t, ctx, func(ctx context.Context) error { return h.Tags.Create(ctx, created) })
+	return created
+}
+
+func (h TodoHarness) createProject(t *testing.T, ctx context.Context) *project.Project {
+	t.Helper()
+	created, err := project.NewProject(idgen.UUIDv7(), time.Now(), "Launch", nil)
+	require.NoError(t, err)
+	h.run(t, ctx, func(ctx context.Context) error { return h.Projects.Create(ctx, created) })
+	return created
+}
+
+func newTodo(t *testing.T, title string) *todo.Todo {
+	t.Helper()
+	created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), title, "")
+	require.NoError(t, err)
+	// Stored times may lose precision, so the todos are created with whole seconds.
+	return todo.ReconstructTodo(
+		created.ID().UUID(),
+		created.Title(),
+		created.Body(),
+		created.Status(),
+		created.CreatedAt().Truncate(time.Second),
+		created.UpdatedAt().Truncate(time.Second),
+	)
+}
+
+// assertSameTodo asserts that the found Todo has the state of the expected one.
+func assertSameTodo(t *testing.T, expected, found *todo.Todo) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), found.ID())
+	assert.Equal(t, expected.Title(), found.Title())
+	assert.Equal(t, expected.Body(), found.Body())
+	assert.Equal(t, expected.Status(), found.Status())
+	assert.Equal(t, expected.StatusID(), found.StatusID())
+	assert.True(t, expected.CreatedAt().Equal(found.CreatedAt()))
+	assert.True(t, expected.UpdatedAt().Equal(found.UpdatedAt()))
+	assert.Equal(t, expected.CompletedAt() == nil, found.CompletedAt() == nil)
+	assert.ElementsMatch(t, expected.TagIDs(), found.TagIDs())
+	assert.Equal(t, expected.ParentID(), found.ParentID())
+	assert.ElementsMatch(t, expected.BlockerIDs(), found.BlockerIDs())
+	assert.Equal(t, expected.ProjectID(), found.ProjectID())
+}
+
+// assertIDs asserts that the found todos are the expected ones, in any order.
+func assertIDs(t *testing.T, found []*todo.Todo, expected ...*todo.Todo) {
+	t.Helper()
+	foundIDs := make([]todo.TodoID, len(found))
+	for i, f := range found {
+		foundIDs[i] = f.ID()
+	}
+	expectedIDs := make([]todo.TodoID, len(expected))
+	for i, e := range expected {
+		expectedIDs[i] = e.ID()
+	}
+	assert.ElementsMatch(t, expectedIDs, foundIDs)
+}
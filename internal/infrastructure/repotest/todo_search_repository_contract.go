@@ -0,0 +1,124 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/application/uow"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TodoSearchHarness is a TodoSearchRepository under test, with the TodoRepository
+// whose todos it searches and the TransactionRunner they work with.
+type TodoSearchHarness struct {
+	Todos    todo.TodoRepository
+	Search   todo.TodoSearchRepository
+	TxRunner uow.TransactionRunner
+}
+
+// TestTodoSearchRepository runs the contract of todo.TodoSearchRepository.
+// newHarness is called for each test and must return empty repositories.
+func TestTodoSearchRepository(t *testing.T, newHarness func(t *testing.T) TodoSearchHarness) {
+	t.Run("ranks title matches over body matches and highlights them", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		inBody := h.create(t, ctx, "Write notes", "Mention the release date")
+		inTitle := h.create(t, ctx, "Release the app", "")
+		h.create(t, ctx, "Buy milk", "")
+
+		hits := h.search(t, ctx, "release")
+
+		require.Len(t, hits, 2)
+		assert.Equal(t, inTitle.ID(), hits[0].Todo.ID())
+		assert.Equal(t, "<mark>Release</mark> the app", hits[0].TitleSnippet)
+		assert.Empty(t, hits[0].BodySnippet)
+		assert.Equal(t, inBody.ID(), hits[1].Todo.ID())
+		assert.Equal(t, "Write notes", hits[1].TitleSnippet)
+		assert.Contains(t, hits[1].BodySnippet, "<mark>release</mark>")
+		assert.Greater(t, hits[0].Score, hits[1].Score)
+	})
+
+	t.Run("matches prefixes and phrases, and requires every term", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		deployment := h.create(t, ctx, "Plan the deployment", "Write the release notes")
+		h.create(t, ctx, "Deploy notes", "")
+
+		prefixed := h.search(t, ctx, `deploym* "release notes"`)
+		missing := h.search(t, ctx, "deployment milk")
+
+		require.Len(t, prefixed, 1)
+		assert.Equal(t, deployment.ID(), prefixed[0].Todo.ID())
+		assert.Contains(t, prefixed[0].BodySnippet, "<mark>release notes</mark>")
+		assert.Empty(t, missing)
+	})
+
+	t.Run("pages through the hits", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		for range 3 {
+			h.create(t, ctx, "Buy milk", "")
+		}
+
+		var found []*todo.SearchHit
+		h.run(t, ctx, func(ctx context.Context) error {
+			var err error
+			found, err = h.Search.Search(ctx, mustParse(t, "milk"), 2, 2)
+			return err
+		})
+
+		assert.Len(t, found, 1)
+	})
+
+	t.Run("follows updates and deletes of the todos", func(t *testing.T) {
+		h, ctx := newHarness(t), context.Background()
+		renamed := h.create(t, ctx, "Buy milk", "")
+		deleted := h.create(t, ctx, "Buy bread", "")
+		require.NoError(t, renamed.SetTitle(time.Now(), "Buy oat milk"))
+		h.run(t, ctx, func(ctx context.Context) error {
+			if err := h.Todos.Update(ctx, renamed); err != nil {
+				return err
+			}
+			return h.Todos.Delete(ctx, deleted.ID())
+		})
+
+		oat := h.search(t, ctx, "oat")
+		bread := h.search(t, ctx, "bread")
+
+		require.Len(t, oat, 1)
+		assert.Equal(t, "Buy oat milk", oat[0].Todo.Title())
+		assert.Empty(t, bread)
+	})
+}
+
+func (h TodoSearchHarness) run(t *testing.T, ctx context.Context, fn func(ctx context.Context) error) {
+	t.Helper()
+	require.NoError(t, h.TxRunner.RunInTx(ctx, fn))
+}
+
+func (h TodoSearchHarness) create(t *testing.T, ctx context.Context, title string, body string) *todo.Todo {
+	t.Helper()
+	created, err := todo.NewTodo(idgen.UUIDv7(), time.Now(), title, body)
+	require.NoError(t, err)
+	h.run(t, ctx, func(ctx context.Context) error { return h.Todos.Create(ctx, created) })
+	return created
+}
+
+func (h TodoSearchHarness) search(t *testing.T, ctx context.Context, query string) []*todo.SearchHit {
+	t.Helper()
+	var hits []*todo.SearchHit
+	h.run(t, ctx, func(ctx context.Context) error {
+		var err error
+		hits, err = h.Search.Search(ctx, mustParse(t, query), 10, 0)
+		return err
+	})
+	return hits
+}
+
+func mustParse(t *testing.T, query string) todo.SearchQuery {
+	t.Helper()
+	parsed, err := todo.ParseSearchQuery(query)
+	require.NoError(t, err)
+	return parsed
+}
@@ -0,0 +1,6 @@
+-- Create "tag" table
+CREATE TABLE `tag` (`id` uuid NOT NULL, `name` text NOT NULL, `color` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Create index "tag_name_key" to table: "tag"
+CREATE UNIQUE INDEX `tag_name_key` ON `tag` (`name`);
+-- Create "todo_tag" table
+CREATE TABLE `todo_tag` (`todo_id` uuid NOT NULL, `tag_id` uuid NOT NULL, PRIMARY KEY (`todo_id`, `tag_id`), CONSTRAINT `todo_tag_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE, CONSTRAINT `todo_tag_tag_id` FOREIGN KEY (`tag_id`) REFERENCES `tag` (`id`) ON DELETE CASCADE);
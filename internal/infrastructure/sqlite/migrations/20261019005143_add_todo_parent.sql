@@ -0,0 +1,20 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_todo" table
+CREATE TABLE `new_todo` (`id` uuid NOT NULL, `title` text NOT NULL, `body` text NULL, `status` text NOT NULL DEFAULT ('NOT_STARTED'), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `completed_at` datetime NULL, `deleted_at` datetime NULL, `parent_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `todo_todo_children` FOREIGN KEY (`parent_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "todo" to new temporary table "new_todo"
+INSERT INTO `new_todo` (`id`, `title`, `body`, `status`, `created_at`, `updated_at`, `completed_at`, `deleted_at`) SELECT `id`, `title`, `body`, `status`, `created_at`, `updated_at`, `completed_at`, `deleted_at` FROM `todo`;
+-- Drop "todo" table after copying rows
+DROP TABLE `todo`;
+-- Rename temporary table "new_todo" to "todo"
+ALTER TABLE `new_todo` RENAME TO `todo`;
+-- Create index "todoschema_deleted_at_created_at" to table: "todo"
+CREATE INDEX `todoschema_deleted_at_created_at` ON `todo` (`deleted_at`, `created_at`);
+-- Create index "todoschema_deleted_at_updated_at" to table: "todo"
+CREATE INDEX `todoschema_deleted_at_updated_at` ON `todo` (`deleted_at`, `updated_at`);
+-- Create index "todoschema_deleted_at_status" to table: "todo"
+CREATE INDEX `todoschema_deleted_at_status` ON `todo` (`deleted_at`, `status`);
+-- Create index "todoschema_parent_id" to table: "todo"
+CREATE INDEX `todoschema_parent_id` ON `todo` (`parent_id`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+-- Create "todo_dependency" table
+CREATE TABLE `todo_dependency` (`todo_id` uuid NOT NULL, `blocker_id` uuid NOT NULL, PRIMARY KEY (`todo_id`, `blocker_id`), CONSTRAINT `todo_dependency_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE, CONSTRAINT `todo_dependency_blocker_id` FOREIGN KEY (`blocker_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE);
@@ -0,0 +1,31 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_project" table
+CREATE TABLE `new_project` (`id` uuid NOT NULL, `name` text NOT NULL, `workflow` json NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Copy rows from old table "project" to new temporary table "new_project",
+-- backfilling the new columns with a placeholder name and the default workflow
+INSERT INTO `new_project` (`id`, `name`, `workflow`, `created_at`, `updated_at`) SELECT `id`, 'Untitled project', '{"statuses":[{"id":"todo","name":"To Do","category":"TODO"},{"id":"doing","name":"Doing","category":"DOING"},{"id":"done","name":"Done","category":"DONE"}],"transitions":[{"from":"todo","to":"doing"},{"from":"todo","to":"done"},{"from":"doing","to":"todo"},{"from":"doing","to":"done"},{"from":"done","to":"todo"},{"from":"done","to":"doing"}]}', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP FROM `project`;
+-- Drop "project" table after copying rows
+DROP TABLE `project`;
+-- Rename temporary table "new_project" to "project"
+ALTER TABLE `new_project` RENAME TO `project`;
+-- Create "new_todo" table
+CREATE TABLE `new_todo` (`id` uuid NOT NULL, `title` text NOT NULL, `body` text NULL, `status` text NOT NULL DEFAULT ('NOT_STARTED'), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `completed_at` datetime NULL, `deleted_at` datetime NULL, `status_id` text NULL, `project_id` uuid NULL, `parent_id` uuid NULL, PRIMARY KEY (`id`), CONSTRAINT `todo_project_todos` FOREIGN KEY (`project_id`) REFERENCES `project` (`id`) ON DELETE SET NULL, CONSTRAINT `todo_todo_children` FOREIGN KEY (`parent_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "todo" to new temporary table "new_todo"
+INSERT INTO `new_todo` (`id`, `title`, `body`, `status`, `created_at`, `updated_at`, `completed_at`, `deleted_at`, `parent_id`) SELECT `id`, `title`, `body`, `status`, `created_at`, `updated_at`, `completed_at`, `deleted_at`, `parent_id` FROM `todo`;
+-- Drop "todo" table after copying rows
+DROP TABLE `todo`;
+-- Rename temporary table "new_todo" to "todo"
+ALTER TABLE `new_todo` RENAME TO `todo`;
+-- Create index "todoschema_deleted_at_created_at" to table: "todo"
+CREATE INDEX `todoschema_deleted_at_created_at` ON `todo` (`deleted_at`, `created_at`);
+-- Create index "todoschema_deleted_at_updated_at" to table: "todo"
+CREATE INDEX `todoschema_deleted_at_updated_at` ON `todo` (`deleted_at`, `updated_at`);
+-- Create index "todoschema_deleted_at_status" to table: "todo"
+CREATE INDEX `todoschema_deleted_at_status` ON `todo` (`deleted_at`, `status`);
+-- Create index "todoschema_parent_id" to table: "todo"
+CREATE INDEX `todoschema_parent_id` ON `todo` (`parent_id`);
+-- Create index "todoschema_project_id_status_id" to table: "todo"
+CREATE INDEX `todoschema_project_id_status_id` ON `todo` (`project_id`, `status_id`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,8 @@
+-- Create the FTS5 full-text search index for todos. It needs SQLite built with FTS5.
+CREATE VIRTUAL TABLE `todo_fts` USING fts5(`todo_id` UNINDEXED, `title`, `body`, tokenize = 'unicode61 remove_diacritics 2');
+-- Keep the index in sync with the "todo" table
+CREATE TRIGGER `todo_fts_after_insert` AFTER INSERT ON `todo` BEGIN INSERT INTO `todo_fts` (`todo_id`, `title`, `body`) VALUES (new.`id`, new.`title`, coalesce(new.`body`, '')); END;
+CREATE TRIGGER `todo_fts_after_update` AFTER UPDATE OF `title`, `body` ON `todo` WHEN old.`title` IS NOT new.`title` OR old.`body` IS NOT new.`body` BEGIN DELETE FROM `todo_fts` WHERE `todo_id` = old.`id`; INSERT INTO `todo_fts` (`todo_id`, `title`, `body`) VALUES (new.`id`, new.`title`, coalesce(new.`body`, '')); END;
+CREATE TRIGGER `todo_fts_after_delete` AFTER DELETE ON `todo` BEGIN DELETE FROM `todo_fts` WHERE `todo_id` = old.`id`; END;
+-- Index the existing todos
+INSERT INTO `todo_fts` (`todo_id`, `title`, `body`) SELECT `id`, `title`, coalesce(`body`, '') FROM `todo`;
@@ -0,0 +1,4 @@
+-- Create "comment" table
+CREATE TABLE `comment` (`id` uuid NOT NULL, `author` text NOT NULL, `body` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `edited_at` datetime NULL, `deleted_at` datetime NULL, `todo_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `comment_todo_comments` FOREIGN KEY (`todo_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE);
+-- Create index "commentschema_todo_id_created_at" to table: "comment"
+CREATE INDEX `commentschema_todo_id_created_at` ON `comment` (`todo_id`, `created_at`);
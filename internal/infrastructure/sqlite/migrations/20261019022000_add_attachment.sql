@@ -0,0 +1,4 @@
+-- Create "attachment" table
+CREATE TABLE `attachment` (`id` uuid NOT NULL, `filename` text NOT NULL, `content_type` text NOT NULL, `size` integer NOT NULL, `checksum` text NOT NULL, `created_at` datetime NOT NULL, `todo_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `attachment_todo_attachments` FOREIGN KEY (`todo_id`) REFERENCES `todo` (`id`) ON DELETE CASCADE);
+-- Create index "attachmentschema_todo_id_created_at" to table: "attachment"
+CREATE INDEX `attachmentschema_todo_id_created_at` ON `attachment` (`todo_id`, `created_at`);
@@ -0,0 +1,6 @@
+-- Create "todo_history" table
+CREATE TABLE `todo_history` (`id` uuid NOT NULL, `todo_id` uuid NOT NULL, `project_id` uuid NULL, `actor` text NOT NULL, `operation` text NOT NULL, `changes` json NOT NULL, `occurred_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Create index "todohistoryschema_todo_id_occurred_at" to table: "todo_history"
+CREATE INDEX `todohistoryschema_todo_id_occurred_at` ON `todo_history` (`todo_id`, `occurred_at`);
+-- Create index "todohistoryschema_project_id_occurred_at" to table: "todo_history"
+CREATE INDEX `todohistoryschema_project_id_occurred_at` ON `todo_history` (`project_id`, `occurred_at`);
@@ -0,0 +1,18 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_todo_history" table
+CREATE TABLE `new_todo_history` (`id` uuid NOT NULL, `todo_id` uuid NOT NULL, `version` integer NOT NULL, `project_id` uuid NULL, `actor` text NOT NULL, `operation` text NOT NULL, `changes` json NOT NULL, `occurred_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Copy rows from old table "todo_history" to new temporary table "new_todo_history", numbering the versions of each todo in the order they were recorded
+INSERT INTO `new_todo_history` (`id`, `todo_id`, `version`, `project_id`, `actor`, `operation`, `changes`, `occurred_at`) SELECT `id`, `todo_id`, ROW_NUMBER() OVER (PARTITION BY `todo_id` ORDER BY `occurred_at`, `id`), `project_id`, `actor`, `operation`, `changes`, `occurred_at` FROM `todo_history`;
+-- Drop "todo_history" table after copying rows
+DROP TABLE `todo_history`;
+-- Rename temporary table "new_todo_history" to "todo_history"
+ALTER TABLE `new_todo_history` RENAME TO `todo_history`;
+-- Create index "todohistoryschema_todo_id_version" to table: "todo_history"
+CREATE UNIQUE INDEX `todohistoryschema_todo_id_version` ON `todo_history` (`todo_id`, `version`);
+-- Create index "todohistoryschema_todo_id_occurred_at" to table: "todo_history"
+CREATE INDEX `todohistoryschema_todo_id_occurred_at` ON `todo_history` (`todo_id`, `occurred_at`);
+-- Create index "todohistoryschema_project_id_occurred_at" to table: "todo_history"
+CREATE INDEX `todohistoryschema_project_id_occurred_at` ON `todo_history` (`project_id`, `occurred_at`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
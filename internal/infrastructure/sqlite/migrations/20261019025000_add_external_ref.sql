@@ -0,0 +1,4 @@
+-- Create "external_ref" table
+CREATE TABLE `external_ref` (`id` uuid NOT NULL, `source` text NOT NULL, `kind` text NOT NULL, `external_id` text NOT NULL, `local_id` uuid NOT NULL, `created_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Create index "externalrefschema_source_kind_external_id" to table: "external_ref"
+CREATE UNIQUE INDEX `externalrefschema_source_kind_external_id` ON `external_ref` (`source`, `kind`, `external_id`);
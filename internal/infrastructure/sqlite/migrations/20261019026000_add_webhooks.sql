@@ -0,0 +1,12 @@
+-- Create "webhook_delivery" table
+CREATE TABLE `webhook_delivery` (`id` uuid NOT NULL, `event_id` uuid NOT NULL, `event_type` text NOT NULL, `payload` blob NOT NULL, `status` text NOT NULL, `attempts` integer NOT NULL, `response_status` integer NOT NULL, `last_error` text NOT NULL, `next_attempt_at` datetime NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `webhook_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `webhook_delivery_webhook_deliveries` FOREIGN KEY (`webhook_id`) REFERENCES `webhook` (`id`) ON DELETE CASCADE);
+-- Create index "webhookdeliveryschema_status_next_attempt_at" to table: "webhook_delivery"
+CREATE INDEX `webhookdeliveryschema_status_next_attempt_at` ON `webhook_delivery` (`status`, `next_attempt_at`);
+-- Create index "webhookdeliveryschema_webhook_id_created_at" to table: "webhook_delivery"
+CREATE INDEX `webhookdeliveryschema_webhook_id_created_at` ON `webhook_delivery` (`webhook_id`, `created_at`);
+-- Create "webhook_event" table
+CREATE TABLE `webhook_event` (`id` uuid NOT NULL, `type` text NOT NULL, `todo_id` uuid NOT NULL, `payload` blob NOT NULL, `occurred_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- Create index "webhookeventschema_occurred_at" to table: "webhook_event"
+CREATE INDEX `webhookeventschema_occurred_at` ON `webhook_event` (`occurred_at`);
+-- Create "webhook" table
+CREATE TABLE `webhook` (`id` uuid NOT NULL, `url` text NOT NULL, `secret` text NOT NULL, `events` json NOT NULL, `enabled` bool NOT NULL DEFAULT (true), `consecutive_failures` integer NOT NULL DEFAULT (0), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
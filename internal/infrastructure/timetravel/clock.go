@@ -0,0 +1,46 @@
+// Package timetravel provides the Clock of the server, which can be moved to another time for demos.
+package timetravel
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/samber/do"
+)
+
+// NewClock creates the Clock selected by the TIME_TRAVEL environment variable.
+//
+//   - unset (default): the system time
+//   - an RFC 3339 time: the clock is at that time when the server starts and runs
+//     at the normal speed from there, so a staging server can be shown on another day
+func NewClock(i *do.Injector) (clock.Clock, error) {
+	s := os.Getenv("TIME_TRAVEL")
+	if s == "" {
+		return clock.System(), nil
+	}
+	start, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("TIME_TRAVEL must be an RFC 3339 time: %w", err)
+	}
+	log.Printf("time travel is on: the clock starts at %s", start.Format(time.RFC3339))
+	return NewOffsetClock(clock.System(), start), nil
+}
+
+// OffsetClock is a Clock that runs at the speed of another Clock from a different time.
+type OffsetClock struct {
+	base   clock.Clock
+	offset time.Duration
+}
+
+// NewOffsetClock creates an OffsetClock that is at start now and follows base from there.
+func NewOffsetClock(base clock.Clock, start time.Time) *OffsetClock {
+	return &OffsetClock{base: base, offset: start.Sub(base.Now())}
+}
+
+// Now returns the time of base moved by the offset.
+func (c *OffsetClock) Now() time.Time {
+	return c.base.Now().Add(c.offset)
+}
@@ -0,0 +1,62 @@
+package timetravel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClock(t *testing.T) {
+	t.Run("uses the system time by default", func(t *testing.T) {
+		// Given
+		t.Setenv("TIME_TRAVEL", "")
+
+		// When
+		c, err := NewClock(nil)
+
+		// Then
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now(), c.Now(), time.Minute)
+	})
+
+	t.Run("starts at the time of TIME_TRAVEL", func(t *testing.T) {
+		// Given
+		t.Setenv("TIME_TRAVEL", "2030-01-01T09:00:00Z")
+
+		// When
+		c, err := NewClock(nil)
+
+		// Then
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC), c.Now(), time.Minute)
+	})
+
+	t.Run("rejects a time that is not RFC 3339", func(t *testing.T) {
+		// Given
+		t.Setenv("TIME_TRAVEL", "next monday")
+
+		// When
+		_, err := NewClock(nil)
+
+		// Then
+		require.Error(t, err)
+	})
+}
+
+func TestOffsetClock(t *testing.T) {
+	// Given
+	base := clock.NewFake(time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC))
+	start := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	c := NewOffsetClock(base, start)
+
+	// When
+	first := c.Now()
+	base.Advance(90 * time.Minute)
+	later := c.Now()
+
+	// Then
+	require.True(t, start.Equal(first))
+	require.True(t, start.Add(90*time.Minute).Equal(later))
+}
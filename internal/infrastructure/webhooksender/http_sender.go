@@ -0,0 +1,75 @@
+// Package webhooksender provides the implementation of the webhook Sender.
+package webhooksender
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/samber/do"
+)
+
+const (
+	// DefaultTimeout is the time an endpoint has to respond to a delivery.
+	DefaultTimeout = 10 * time.Second
+	// EventHeader is the HTTP header that carries the type of the delivered event.
+	EventHeader = "X-Oniongo-Event"
+	// DeliveryHeader is the HTTP header that carries the ID of the delivery.
+	DeliveryHeader = "X-Oniongo-Delivery"
+	// userAgent identifies the deliveries in the logs of the endpoints.
+	userAgent = "oniongo-webhook/1"
+	// maxResponseBytes is the part of a response body read before the connection is reused.
+	maxResponseBytes = 64 * 1024
+)
+
+// httpSender is the implementation of the Sender interface that posts deliveries over HTTP.
+type httpSender struct {
+	client *http.Client
+	clock  clock.Clock
+}
+
+// NewSender creates a new Sender with DefaultTimeout.
+func NewSender(i *do.Injector) (webhook.Sender, error) {
+	clk, err := do.Invoke[clock.Clock](i)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke clock: %w", err)
+	}
+	return NewHTTPSender(&http.Client{Timeout: DefaultTimeout}, clk), nil
+}
+
+// NewHTTPSender creates a new Sender posting with the client and signing at the time of clk.
+// Redirects are not followed, so that a moved endpoint shows up as a failed delivery
+// instead of a silent success.
+func NewHTTPSender(client *http.Client, clk clock.Clock) webhook.Sender {
+	c := *client
+	c.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return &httpSender{client: &c, clock: clk}
+}
+
+// Send posts the payload of the delivery to the URL of the webhook, signed with its secret.
+func (s httpSender) Send(ctx context.Context, w *webhook.Webhook, d *webhook.Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL(), bytes.NewReader(d.Payload()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(EventHeader, d.EventType().String())
+	req.Header.Set(DeliveryHeader, d.ID().String())
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(w.Secret(), s.clock.Now(), d.Payload()))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+	return resp.StatusCode, nil
+}
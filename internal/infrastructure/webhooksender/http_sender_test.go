@@ -0,0 +1,108 @@
+package webhooksender
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/clock"
+	"github.com/iktakahiro/oniongo/internal/domain/idgen"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	newDelivery := func(t *testing.T, url string) (*webhook.Webhook, *webhook.Delivery) {
+		t.Helper()
+		w, err := webhook.NewWebhook(idgen.NewSequence(), time.Now(), url, []webhook.EventType{webhook.EventTodoCreated})
+		require.NoError(t, err)
+		ids := idgen.NewSequence()
+		event, err := webhook.NewPingEvent(ids, time.Now(), w.ID())
+		require.NoError(t, err)
+		return w, webhook.NewDelivery(ids, time.Now(), w, event)
+	}
+
+	t.Run("posts the signed payload", func(t *testing.T) {
+		// Given
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			rw.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+		w, delivery := newDelivery(t, server.URL+"/hooks")
+		clk := clock.NewFake(time.Now().Add(-time.Hour))
+
+		// When
+		status, err := NewHTTPSender(server.Client(), clk).Send(context.Background(), w, delivery)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, status)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "/hooks", received.URL.Path)
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, "ping", received.Header.Get(EventHeader))
+		assert.Equal(t, delivery.ID().String(), received.Header.Get(DeliveryHeader))
+		assert.Equal(t, delivery.Payload(), body)
+		assert.Equal(t, webhook.Sign(w.Secret(), clk.Now(), body), received.Header.Get(webhook.SignatureHeader))
+	})
+
+	t.Run("returns the status of an error response", func(t *testing.T) {
+		// Given
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			http.Error(rw, "boom", http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		w, delivery := newDelivery(t, server.URL)
+
+		// When
+		status, err := NewHTTPSender(server.Client(), clock.System()).Send(context.Background(), w, delivery)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, status)
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		// Given
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			http.Redirect(rw, r, "/elsewhere", http.StatusFound)
+		}))
+		defer server.Close()
+		w, delivery := newDelivery(t, server.URL)
+
+		// When
+		status, err := NewHTTPSender(server.Client(), clock.System()).Send(context.Background(), w, delivery)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusFound, status)
+	})
+
+	t.Run("returns an error when the endpoint does not respond in time", func(t *testing.T) {
+		// Given
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+		defer close(release)
+		w, delivery := newDelivery(t, server.URL)
+		client := server.Client()
+		client.Timeout = 50 * time.Millisecond
+
+		// When
+		status, err := NewHTTPSender(client, clock.System()).Send(context.Background(), w, delivery)
+
+		// Then
+		require.Error(t, err)
+		assert.Zero(t, status)
+	})
+}
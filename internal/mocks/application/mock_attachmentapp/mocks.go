@@ -0,0 +1,422 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_attachmentapp
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/application/attachmentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCollectGarbageUseCase creates a new instance of MockCollectGarbageUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCollectGarbageUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCollectGarbageUseCase {
+	mock := &MockCollectGarbageUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCollectGarbageUseCase is an autogenerated mock type for the CollectGarbageUseCase type
+type MockCollectGarbageUseCase struct {
+	mock.Mock
+}
+
+type MockCollectGarbageUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCollectGarbageUseCase) EXPECT() *MockCollectGarbageUseCase_Expecter {
+	return &MockCollectGarbageUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockCollectGarbageUseCase
+func (_mock *MockCollectGarbageUseCase) Execute(ctx context.Context, req attachmentapp.CollectGarbageRequest) (*attachmentapp.CollectGarbageResult, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *attachmentapp.CollectGarbageResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachmentapp.CollectGarbageRequest) (*attachmentapp.CollectGarbageResult, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachmentapp.CollectGarbageRequest) *attachmentapp.CollectGarbageResult); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*attachmentapp.CollectGarbageResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, attachmentapp.CollectGarbageRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollectGarbageUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCollectGarbageUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockCollectGarbageUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockCollectGarbageUseCase_Execute_Call {
+	return &MockCollectGarbageUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockCollectGarbageUseCase_Execute_Call) Run(run func(ctx context.Context, req attachmentapp.CollectGarbageRequest)) *MockCollectGarbageUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(attachmentapp.CollectGarbageRequest))
+	})
+	return _c
+}
+
+func (_c *MockCollectGarbageUseCase_Execute_Call) Return(collectGarbageResult *attachmentapp.CollectGarbageResult, err error) *MockCollectGarbageUseCase_Execute_Call {
+	_c.Call.Return(collectGarbageResult, err)
+	return _c
+}
+
+func (_c *MockCollectGarbageUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req attachmentapp.CollectGarbageRequest) (*attachmentapp.CollectGarbageResult, error)) *MockCollectGarbageUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteAttachmentUseCase creates a new instance of MockDeleteAttachmentUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteAttachmentUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteAttachmentUseCase {
+	mock := &MockDeleteAttachmentUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleteAttachmentUseCase is an autogenerated mock type for the DeleteAttachmentUseCase type
+type MockDeleteAttachmentUseCase struct {
+	mock.Mock
+}
+
+type MockDeleteAttachmentUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteAttachmentUseCase) EXPECT() *MockDeleteAttachmentUseCase_Expecter {
+	return &MockDeleteAttachmentUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockDeleteAttachmentUseCase
+func (_mock *MockDeleteAttachmentUseCase) Execute(ctx context.Context, req attachmentapp.DeleteAttachmentRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachmentapp.DeleteAttachmentRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeleteAttachmentUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDeleteAttachmentUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockDeleteAttachmentUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockDeleteAttachmentUseCase_Execute_Call {
+	return &MockDeleteAttachmentUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockDeleteAttachmentUseCase_Execute_Call) Run(run func(ctx context.Context, req attachmentapp.DeleteAttachmentRequest)) *MockDeleteAttachmentUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(attachmentapp.DeleteAttachmentRequest))
+	})
+	return _c
+}
+
+func (_c *MockDeleteAttachmentUseCase_Execute_Call) Return(err error) *MockDeleteAttachmentUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeleteAttachmentUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req attachmentapp.DeleteAttachmentRequest) error) *MockDeleteAttachmentUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDownloadAttachmentUseCase creates a new instance of MockDownloadAttachmentUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDownloadAttachmentUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDownloadAttachmentUseCase {
+	mock := &MockDownloadAttachmentUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDownloadAttachmentUseCase is an autogenerated mock type for the DownloadAttachmentUseCase type
+type MockDownloadAttachmentUseCase struct {
+	mock.Mock
+}
+
+type MockDownloadAttachmentUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDownloadAttachmentUseCase) EXPECT() *MockDownloadAttachmentUseCase_Expecter {
+	return &MockDownloadAttachmentUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockDownloadAttachmentUseCase
+func (_mock *MockDownloadAttachmentUseCase) Execute(ctx context.Context, req attachmentapp.DownloadAttachmentRequest) (*attachmentapp.DownloadAttachmentResult, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *attachmentapp.DownloadAttachmentResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachmentapp.DownloadAttachmentRequest) (*attachmentapp.DownloadAttachmentResult, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachmentapp.DownloadAttachmentRequest) *attachmentapp.DownloadAttachmentResult); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*attachmentapp.DownloadAttachmentResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, attachmentapp.DownloadAttachmentRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDownloadAttachmentUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDownloadAttachmentUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockDownloadAttachmentUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockDownloadAttachmentUseCase_Execute_Call {
+	return &MockDownloadAttachmentUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockDownloadAttachmentUseCase_Execute_Call) Run(run func(ctx context.Context, req attachmentapp.DownloadAttachmentRequest)) *MockDownloadAttachmentUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(attachmentapp.DownloadAttachmentRequest))
+	})
+	return _c
+}
+
+func (_c *MockDownloadAttachmentUseCase_Execute_Call) Return(downloadAttachmentResult *attachmentapp.DownloadAttachmentResult, err error) *MockDownloadAttachmentUseCase_Execute_Call {
+	_c.Call.Return(downloadAttachmentResult, err)
+	return _c
+}
+
+func (_c *MockDownloadAttachmentUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req attachmentapp.DownloadAttachmentRequest) (*attachmentapp.DownloadAttachmentResult, error)) *MockDownloadAttachmentUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListAttachmentsUseCase creates a new instance of MockListAttachmentsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListAttachmentsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListAttachmentsUseCase {
+	mock := &MockListAttachmentsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListAttachmentsUseCase is an autogenerated mock type for the ListAttachmentsUseCase type
+type MockListAttachmentsUseCase struct {
+	mock.Mock
+}
+
+type MockListAttachmentsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListAttachmentsUseCase) EXPECT() *MockListAttachmentsUseCase_Expecter {
+	return &MockListAttachmentsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockListAttachmentsUseCase
+func (_mock *MockListAttachmentsUseCase) Execute(ctx context.Context, req attachmentapp.ListAttachmentsRequest) ([]*attachment.Attachment, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []*attachment.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachmentapp.ListAttachmentsRequest) ([]*attachment.Attachment, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachmentapp.ListAttachmentsRequest) []*attachment.Attachment); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*attachment.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, attachmentapp.ListAttachmentsRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListAttachmentsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListAttachmentsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockListAttachmentsUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockListAttachmentsUseCase_Execute_Call {
+	return &MockListAttachmentsUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockListAttachmentsUseCase_Execute_Call) Run(run func(ctx context.Context, req attachmentapp.ListAttachmentsRequest)) *MockListAttachmentsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(attachmentapp.ListAttachmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockListAttachmentsUseCase_Execute_Call) Return(attachments []*attachment.Attachment, err error) *MockListAttachmentsUseCase_Execute_Call {
+	_c.Call.Return(attachments, err)
+	return _c
+}
+
+func (_c *MockListAttachmentsUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req attachmentapp.ListAttachmentsRequest) ([]*attachment.Attachment, error)) *MockListAttachmentsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUploadAttachmentUseCase creates a new instance of MockUploadAttachmentUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUploadAttachmentUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUploadAttachmentUseCase {
+	mock := &MockUploadAttachmentUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUploadAttachmentUseCase is an autogenerated mock type for the UploadAttachmentUseCase type
+type MockUploadAttachmentUseCase struct {
+	mock.Mock
+}
+
+type MockUploadAttachmentUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUploadAttachmentUseCase) EXPECT() *MockUploadAttachmentUseCase_Expecter {
+	return &MockUploadAttachmentUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockUploadAttachmentUseCase
+func (_mock *MockUploadAttachmentUseCase) Execute(ctx context.Context, req attachmentapp.UploadAttachmentRequest) (*attachment.Attachment, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *attachment.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachmentapp.UploadAttachmentRequest) (*attachment.Attachment, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachmentapp.UploadAttachmentRequest) *attachment.Attachment); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*attachment.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, attachmentapp.UploadAttachmentRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUploadAttachmentUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockUploadAttachmentUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockUploadAttachmentUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockUploadAttachmentUseCase_Execute_Call {
+	return &MockUploadAttachmentUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockUploadAttachmentUseCase_Execute_Call) Run(run func(ctx context.Context, req attachmentapp.UploadAttachmentRequest)) *MockUploadAttachmentUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(attachmentapp.UploadAttachmentRequest))
+	})
+	return _c
+}
+
+func (_c *MockUploadAttachmentUseCase_Execute_Call) Return(attachment1 *attachment.Attachment, err error) *MockUploadAttachmentUseCase_Execute_Call {
+	_c.Call.Return(attachment1, err)
+	return _c
+}
+
+func (_c *MockUploadAttachmentUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req attachmentapp.UploadAttachmentRequest) (*attachment.Attachment, error)) *MockUploadAttachmentUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,423 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_commentapp
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/application/commentapp"
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAddCommentUseCase creates a new instance of MockAddCommentUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAddCommentUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAddCommentUseCase {
+	mock := &MockAddCommentUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAddCommentUseCase is an autogenerated mock type for the AddCommentUseCase type
+type MockAddCommentUseCase struct {
+	mock.Mock
+}
+
+type MockAddCommentUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAddCommentUseCase) EXPECT() *MockAddCommentUseCase_Expecter {
+	return &MockAddCommentUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockAddCommentUseCase
+func (_mock *MockAddCommentUseCase) Execute(ctx context.Context, req commentapp.AddCommentRequest) (*comment.Comment, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *comment.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commentapp.AddCommentRequest) (*comment.Comment, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commentapp.AddCommentRequest) *comment.Comment); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*comment.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, commentapp.AddCommentRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAddCommentUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockAddCommentUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockAddCommentUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockAddCommentUseCase_Execute_Call {
+	return &MockAddCommentUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockAddCommentUseCase_Execute_Call) Run(run func(ctx context.Context, req commentapp.AddCommentRequest)) *MockAddCommentUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(commentapp.AddCommentRequest))
+	})
+	return _c
+}
+
+func (_c *MockAddCommentUseCase_Execute_Call) Return(comment1 *comment.Comment, err error) *MockAddCommentUseCase_Execute_Call {
+	_c.Call.Return(comment1, err)
+	return _c
+}
+
+func (_c *MockAddCommentUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req commentapp.AddCommentRequest) (*comment.Comment, error)) *MockAddCommentUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCountCommentsUseCase creates a new instance of MockCountCommentsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCountCommentsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCountCommentsUseCase {
+	mock := &MockCountCommentsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCountCommentsUseCase is an autogenerated mock type for the CountCommentsUseCase type
+type MockCountCommentsUseCase struct {
+	mock.Mock
+}
+
+type MockCountCommentsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCountCommentsUseCase) EXPECT() *MockCountCommentsUseCase_Expecter {
+	return &MockCountCommentsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockCountCommentsUseCase
+func (_mock *MockCountCommentsUseCase) Execute(ctx context.Context, req commentapp.CountCommentsRequest) (map[todo.TodoID]int, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 map[todo.TodoID]int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commentapp.CountCommentsRequest) (map[todo.TodoID]int, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commentapp.CountCommentsRequest) map[todo.TodoID]int); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[todo.TodoID]int)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, commentapp.CountCommentsRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCountCommentsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCountCommentsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockCountCommentsUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockCountCommentsUseCase_Execute_Call {
+	return &MockCountCommentsUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockCountCommentsUseCase_Execute_Call) Run(run func(ctx context.Context, req commentapp.CountCommentsRequest)) *MockCountCommentsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(commentapp.CountCommentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockCountCommentsUseCase_Execute_Call) Return(todoIDToInt map[todo.TodoID]int, err error) *MockCountCommentsUseCase_Execute_Call {
+	_c.Call.Return(todoIDToInt, err)
+	return _c
+}
+
+func (_c *MockCountCommentsUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req commentapp.CountCommentsRequest) (map[todo.TodoID]int, error)) *MockCountCommentsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteCommentUseCase creates a new instance of MockDeleteCommentUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteCommentUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteCommentUseCase {
+	mock := &MockDeleteCommentUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleteCommentUseCase is an autogenerated mock type for the DeleteCommentUseCase type
+type MockDeleteCommentUseCase struct {
+	mock.Mock
+}
+
+type MockDeleteCommentUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteCommentUseCase) EXPECT() *MockDeleteCommentUseCase_Expecter {
+	return &MockDeleteCommentUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockDeleteCommentUseCase
+func (_mock *MockDeleteCommentUseCase) Execute(ctx context.Context, req commentapp.DeleteCommentRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commentapp.DeleteCommentRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeleteCommentUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDeleteCommentUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockDeleteCommentUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockDeleteCommentUseCase_Execute_Call {
+	return &MockDeleteCommentUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockDeleteCommentUseCase_Execute_Call) Run(run func(ctx context.Context, req commentapp.DeleteCommentRequest)) *MockDeleteCommentUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(commentapp.DeleteCommentRequest))
+	})
+	return _c
+}
+
+func (_c *MockDeleteCommentUseCase_Execute_Call) Return(err error) *MockDeleteCommentUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeleteCommentUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req commentapp.DeleteCommentRequest) error) *MockDeleteCommentUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEditCommentUseCase creates a new instance of MockEditCommentUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEditCommentUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEditCommentUseCase {
+	mock := &MockEditCommentUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEditCommentUseCase is an autogenerated mock type for the EditCommentUseCase type
+type MockEditCommentUseCase struct {
+	mock.Mock
+}
+
+type MockEditCommentUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEditCommentUseCase) EXPECT() *MockEditCommentUseCase_Expecter {
+	return &MockEditCommentUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockEditCommentUseCase
+func (_mock *MockEditCommentUseCase) Execute(ctx context.Context, req commentapp.EditCommentRequest) (*comment.Comment, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *comment.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commentapp.EditCommentRequest) (*comment.Comment, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commentapp.EditCommentRequest) *comment.Comment); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*comment.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, commentapp.EditCommentRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEditCommentUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockEditCommentUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockEditCommentUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockEditCommentUseCase_Execute_Call {
+	return &MockEditCommentUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockEditCommentUseCase_Execute_Call) Run(run func(ctx context.Context, req commentapp.EditCommentRequest)) *MockEditCommentUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(commentapp.EditCommentRequest))
+	})
+	return _c
+}
+
+func (_c *MockEditCommentUseCase_Execute_Call) Return(comment1 *comment.Comment, err error) *MockEditCommentUseCase_Execute_Call {
+	_c.Call.Return(comment1, err)
+	return _c
+}
+
+func (_c *MockEditCommentUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req commentapp.EditCommentRequest) (*comment.Comment, error)) *MockEditCommentUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListCommentsUseCase creates a new instance of MockListCommentsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListCommentsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListCommentsUseCase {
+	mock := &MockListCommentsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListCommentsUseCase is an autogenerated mock type for the ListCommentsUseCase type
+type MockListCommentsUseCase struct {
+	mock.Mock
+}
+
+type MockListCommentsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListCommentsUseCase) EXPECT() *MockListCommentsUseCase_Expecter {
+	return &MockListCommentsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockListCommentsUseCase
+func (_mock *MockListCommentsUseCase) Execute(ctx context.Context, req commentapp.ListCommentsRequest) (*commentapp.ListCommentsResult, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *commentapp.ListCommentsResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commentapp.ListCommentsRequest) (*commentapp.ListCommentsResult, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, commentapp.ListCommentsRequest) *commentapp.ListCommentsResult); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commentapp.ListCommentsResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, commentapp.ListCommentsRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListCommentsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListCommentsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockListCommentsUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockListCommentsUseCase_Execute_Call {
+	return &MockListCommentsUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockListCommentsUseCase_Execute_Call) Run(run func(ctx context.Context, req commentapp.ListCommentsRequest)) *MockListCommentsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(commentapp.ListCommentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockListCommentsUseCase_Execute_Call) Return(listCommentsResult *commentapp.ListCommentsResult, err error) *MockListCommentsUseCase_Execute_Call {
+	_c.Call.Return(listCommentsResult, err)
+	return _c
+}
+
+func (_c *MockListCommentsUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req commentapp.ListCommentsRequest) (*commentapp.ListCommentsResult, error)) *MockListCommentsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
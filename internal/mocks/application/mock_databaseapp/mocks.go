@@ -0,0 +1,159 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_databaseapp
+
+import (
+	"context"
+	"io"
+
+	"github.com/iktakahiro/oniongo/internal/application/databaseapp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockBackuper creates a new instance of MockBackuper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBackuper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBackuper {
+	mock := &MockBackuper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBackuper is an autogenerated mock type for the Backuper type
+type MockBackuper struct {
+	mock.Mock
+}
+
+type MockBackuper_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBackuper) EXPECT() *MockBackuper_Expecter {
+	return &MockBackuper_Expecter{mock: &_m.Mock}
+}
+
+// Backup provides a mock function for the type MockBackuper
+func (_mock *MockBackuper) Backup(ctx context.Context, w io.Writer) error {
+	ret := _mock.Called(ctx, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backup")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, io.Writer) error); ok {
+		r0 = returnFunc(ctx, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBackuper_Backup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backup'
+type MockBackuper_Backup_Call struct {
+	*mock.Call
+}
+
+// Backup is a helper method to define mock.On call
+//   - ctx
+//   - w
+func (_e *MockBackuper_Expecter) Backup(ctx interface{}, w interface{}) *MockBackuper_Backup_Call {
+	return &MockBackuper_Backup_Call{Call: _e.mock.On("Backup", ctx, w)}
+}
+
+func (_c *MockBackuper_Backup_Call) Run(run func(ctx context.Context, w io.Writer)) *MockBackuper_Backup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockBackuper_Backup_Call) Return(err error) *MockBackuper_Backup_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBackuper_Backup_Call) RunAndReturn(run func(ctx context.Context, w io.Writer) error) *MockBackuper_Backup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBackupDatabaseUseCase creates a new instance of MockBackupDatabaseUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBackupDatabaseUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBackupDatabaseUseCase {
+	mock := &MockBackupDatabaseUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBackupDatabaseUseCase is an autogenerated mock type for the BackupDatabaseUseCase type
+type MockBackupDatabaseUseCase struct {
+	mock.Mock
+}
+
+type MockBackupDatabaseUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBackupDatabaseUseCase) EXPECT() *MockBackupDatabaseUseCase_Expecter {
+	return &MockBackupDatabaseUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockBackupDatabaseUseCase
+func (_mock *MockBackupDatabaseUseCase) Execute(ctx context.Context, req databaseapp.BackupDatabaseRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, databaseapp.BackupDatabaseRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBackupDatabaseUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockBackupDatabaseUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockBackupDatabaseUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockBackupDatabaseUseCase_Execute_Call {
+	return &MockBackupDatabaseUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockBackupDatabaseUseCase_Execute_Call) Run(run func(ctx context.Context, req databaseapp.BackupDatabaseRequest)) *MockBackupDatabaseUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(databaseapp.BackupDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *MockBackupDatabaseUseCase_Execute_Call) Return(err error) *MockBackupDatabaseUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBackupDatabaseUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req databaseapp.BackupDatabaseRequest) error) *MockBackupDatabaseUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,180 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_historyapp
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/application/historyapp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGetTodoHistoryUseCase creates a new instance of MockGetTodoHistoryUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetTodoHistoryUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetTodoHistoryUseCase {
+	mock := &MockGetTodoHistoryUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetTodoHistoryUseCase is an autogenerated mock type for the GetTodoHistoryUseCase type
+type MockGetTodoHistoryUseCase struct {
+	mock.Mock
+}
+
+type MockGetTodoHistoryUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetTodoHistoryUseCase) EXPECT() *MockGetTodoHistoryUseCase_Expecter {
+	return &MockGetTodoHistoryUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockGetTodoHistoryUseCase
+func (_mock *MockGetTodoHistoryUseCase) Execute(ctx context.Context, req historyapp.GetTodoHistoryRequest) (*historyapp.HistoryPage, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *historyapp.HistoryPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, historyapp.GetTodoHistoryRequest) (*historyapp.HistoryPage, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, historyapp.GetTodoHistoryRequest) *historyapp.HistoryPage); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*historyapp.HistoryPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, historyapp.GetTodoHistoryRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetTodoHistoryUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetTodoHistoryUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockGetTodoHistoryUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockGetTodoHistoryUseCase_Execute_Call {
+	return &MockGetTodoHistoryUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockGetTodoHistoryUseCase_Execute_Call) Run(run func(ctx context.Context, req historyapp.GetTodoHistoryRequest)) *MockGetTodoHistoryUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(historyapp.GetTodoHistoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockGetTodoHistoryUseCase_Execute_Call) Return(historyPage *historyapp.HistoryPage, err error) *MockGetTodoHistoryUseCase_Execute_Call {
+	_c.Call.Return(historyPage, err)
+	return _c
+}
+
+func (_c *MockGetTodoHistoryUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req historyapp.GetTodoHistoryRequest) (*historyapp.HistoryPage, error)) *MockGetTodoHistoryUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListActivityUseCase creates a new instance of MockListActivityUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListActivityUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListActivityUseCase {
+	mock := &MockListActivityUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListActivityUseCase is an autogenerated mock type for the ListActivityUseCase type
+type MockListActivityUseCase struct {
+	mock.Mock
+}
+
+type MockListActivityUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListActivityUseCase) EXPECT() *MockListActivityUseCase_Expecter {
+	return &MockListActivityUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockListActivityUseCase
+func (_mock *MockListActivityUseCase) Execute(ctx context.Context, req historyapp.ListActivityRequest) (*historyapp.HistoryPage, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *historyapp.HistoryPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, historyapp.ListActivityRequest) (*historyapp.HistoryPage, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, historyapp.ListActivityRequest) *historyapp.HistoryPage); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*historyapp.HistoryPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, historyapp.ListActivityRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListActivityUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListActivityUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockListActivityUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockListActivityUseCase_Execute_Call {
+	return &MockListActivityUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockListActivityUseCase_Execute_Call) Run(run func(ctx context.Context, req historyapp.ListActivityRequest)) *MockListActivityUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(historyapp.ListActivityRequest))
+	})
+	return _c
+}
+
+func (_c *MockListActivityUseCase_Execute_Call) Return(historyPage *historyapp.HistoryPage, err error) *MockListActivityUseCase_Execute_Call {
+	_c.Call.Return(historyPage, err)
+	return _c
+}
+
+func (_c *MockListActivityUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req historyapp.ListActivityRequest) (*historyapp.HistoryPage, error)) *MockListActivityUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
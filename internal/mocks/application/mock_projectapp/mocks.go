@@ -0,0 +1,338 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_projectapp
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/application/projectapp"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCreateProjectUseCase creates a new instance of MockCreateProjectUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateProjectUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateProjectUseCase {
+	mock := &MockCreateProjectUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCreateProjectUseCase is an autogenerated mock type for the CreateProjectUseCase type
+type MockCreateProjectUseCase struct {
+	mock.Mock
+}
+
+type MockCreateProjectUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateProjectUseCase) EXPECT() *MockCreateProjectUseCase_Expecter {
+	return &MockCreateProjectUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockCreateProjectUseCase
+func (_mock *MockCreateProjectUseCase) Execute(ctx context.Context, req projectapp.CreateProjectRequest) (*project.Project, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *project.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, projectapp.CreateProjectRequest) (*project.Project, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, projectapp.CreateProjectRequest) *project.Project); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, projectapp.CreateProjectRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCreateProjectUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCreateProjectUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockCreateProjectUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockCreateProjectUseCase_Execute_Call {
+	return &MockCreateProjectUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockCreateProjectUseCase_Execute_Call) Run(run func(ctx context.Context, req projectapp.CreateProjectRequest)) *MockCreateProjectUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(projectapp.CreateProjectRequest))
+	})
+	return _c
+}
+
+func (_c *MockCreateProjectUseCase_Execute_Call) Return(project1 *project.Project, err error) *MockCreateProjectUseCase_Execute_Call {
+	_c.Call.Return(project1, err)
+	return _c
+}
+
+func (_c *MockCreateProjectUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req projectapp.CreateProjectRequest) (*project.Project, error)) *MockCreateProjectUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteProjectUseCase creates a new instance of MockDeleteProjectUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteProjectUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteProjectUseCase {
+	mock := &MockDeleteProjectUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleteProjectUseCase is an autogenerated mock type for the DeleteProjectUseCase type
+type MockDeleteProjectUseCase struct {
+	mock.Mock
+}
+
+type MockDeleteProjectUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteProjectUseCase) EXPECT() *MockDeleteProjectUseCase_Expecter {
+	return &MockDeleteProjectUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockDeleteProjectUseCase
+func (_mock *MockDeleteProjectUseCase) Execute(ctx context.Context, req projectapp.DeleteProjectRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, projectapp.DeleteProjectRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeleteProjectUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDeleteProjectUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockDeleteProjectUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockDeleteProjectUseCase_Execute_Call {
+	return &MockDeleteProjectUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockDeleteProjectUseCase_Execute_Call) Run(run func(ctx context.Context, req projectapp.DeleteProjectRequest)) *MockDeleteProjectUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(projectapp.DeleteProjectRequest))
+	})
+	return _c
+}
+
+func (_c *MockDeleteProjectUseCase_Execute_Call) Return(err error) *MockDeleteProjectUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeleteProjectUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req projectapp.DeleteProjectRequest) error) *MockDeleteProjectUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetProjectUseCase creates a new instance of MockGetProjectUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetProjectUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetProjectUseCase {
+	mock := &MockGetProjectUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetProjectUseCase is an autogenerated mock type for the GetProjectUseCase type
+type MockGetProjectUseCase struct {
+	mock.Mock
+}
+
+type MockGetProjectUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetProjectUseCase) EXPECT() *MockGetProjectUseCase_Expecter {
+	return &MockGetProjectUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockGetProjectUseCase
+func (_mock *MockGetProjectUseCase) Execute(ctx context.Context, req projectapp.GetProjectRequest) (*project.Project, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *project.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, projectapp.GetProjectRequest) (*project.Project, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, projectapp.GetProjectRequest) *project.Project); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, projectapp.GetProjectRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetProjectUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetProjectUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockGetProjectUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockGetProjectUseCase_Execute_Call {
+	return &MockGetProjectUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockGetProjectUseCase_Execute_Call) Run(run func(ctx context.Context, req projectapp.GetProjectRequest)) *MockGetProjectUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(projectapp.GetProjectRequest))
+	})
+	return _c
+}
+
+func (_c *MockGetProjectUseCase_Execute_Call) Return(project1 *project.Project, err error) *MockGetProjectUseCase_Execute_Call {
+	_c.Call.Return(project1, err)
+	return _c
+}
+
+func (_c *MockGetProjectUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req projectapp.GetProjectRequest) (*project.Project, error)) *MockGetProjectUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListProjectsUseCase creates a new instance of MockListProjectsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListProjectsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListProjectsUseCase {
+	mock := &MockListProjectsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListProjectsUseCase is an autogenerated mock type for the ListProjectsUseCase type
+type MockListProjectsUseCase struct {
+	mock.Mock
+}
+
+type MockListProjectsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListProjectsUseCase) EXPECT() *MockListProjectsUseCase_Expecter {
+	return &MockListProjectsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockListProjectsUseCase
+func (_mock *MockListProjectsUseCase) Execute(ctx context.Context, req projectapp.ListProjectsRequest) ([]*project.Project, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []*project.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, projectapp.ListProjectsRequest) ([]*project.Project, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, projectapp.ListProjectsRequest) []*project.Project); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*project.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, projectapp.ListProjectsRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListProjectsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListProjectsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockListProjectsUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockListProjectsUseCase_Execute_Call {
+	return &MockListProjectsUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockListProjectsUseCase_Execute_Call) Run(run func(ctx context.Context, req projectapp.ListProjectsRequest)) *MockListProjectsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(projectapp.ListProjectsRequest))
+	})
+	return _c
+}
+
+func (_c *MockListProjectsUseCase_Execute_Call) Return(projects []*project.Project, err error) *MockListProjectsUseCase_Execute_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *MockListProjectsUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req projectapp.ListProjectsRequest) ([]*project.Project, error)) *MockListProjectsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
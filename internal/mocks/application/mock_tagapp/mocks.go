@@ -0,0 +1,327 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_tagapp
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/application/tagapp"
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCreateTagUseCase creates a new instance of MockCreateTagUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateTagUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateTagUseCase {
+	mock := &MockCreateTagUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCreateTagUseCase is an autogenerated mock type for the CreateTagUseCase type
+type MockCreateTagUseCase struct {
+	mock.Mock
+}
+
+type MockCreateTagUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateTagUseCase) EXPECT() *MockCreateTagUseCase_Expecter {
+	return &MockCreateTagUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockCreateTagUseCase
+func (_mock *MockCreateTagUseCase) Execute(ctx context.Context, req tagapp.CreateTagRequest) (*tag.Tag, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *tag.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tagapp.CreateTagRequest) (*tag.Tag, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tagapp.CreateTagRequest) *tag.Tag); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tag.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, tagapp.CreateTagRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCreateTagUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCreateTagUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockCreateTagUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockCreateTagUseCase_Execute_Call {
+	return &MockCreateTagUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockCreateTagUseCase_Execute_Call) Run(run func(ctx context.Context, req tagapp.CreateTagRequest)) *MockCreateTagUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tagapp.CreateTagRequest))
+	})
+	return _c
+}
+
+func (_c *MockCreateTagUseCase_Execute_Call) Return(tag1 *tag.Tag, err error) *MockCreateTagUseCase_Execute_Call {
+	_c.Call.Return(tag1, err)
+	return _c
+}
+
+func (_c *MockCreateTagUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req tagapp.CreateTagRequest) (*tag.Tag, error)) *MockCreateTagUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteTagUseCase creates a new instance of MockDeleteTagUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteTagUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteTagUseCase {
+	mock := &MockDeleteTagUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleteTagUseCase is an autogenerated mock type for the DeleteTagUseCase type
+type MockDeleteTagUseCase struct {
+	mock.Mock
+}
+
+type MockDeleteTagUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteTagUseCase) EXPECT() *MockDeleteTagUseCase_Expecter {
+	return &MockDeleteTagUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockDeleteTagUseCase
+func (_mock *MockDeleteTagUseCase) Execute(ctx context.Context, req tagapp.DeleteTagRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tagapp.DeleteTagRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeleteTagUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDeleteTagUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockDeleteTagUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockDeleteTagUseCase_Execute_Call {
+	return &MockDeleteTagUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockDeleteTagUseCase_Execute_Call) Run(run func(ctx context.Context, req tagapp.DeleteTagRequest)) *MockDeleteTagUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tagapp.DeleteTagRequest))
+	})
+	return _c
+}
+
+func (_c *MockDeleteTagUseCase_Execute_Call) Return(err error) *MockDeleteTagUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeleteTagUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req tagapp.DeleteTagRequest) error) *MockDeleteTagUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListTagsUseCase creates a new instance of MockListTagsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListTagsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListTagsUseCase {
+	mock := &MockListTagsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListTagsUseCase is an autogenerated mock type for the ListTagsUseCase type
+type MockListTagsUseCase struct {
+	mock.Mock
+}
+
+type MockListTagsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListTagsUseCase) EXPECT() *MockListTagsUseCase_Expecter {
+	return &MockListTagsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockListTagsUseCase
+func (_mock *MockListTagsUseCase) Execute(ctx context.Context, req tagapp.ListTagsRequest) ([]*tag.Tag, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []*tag.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tagapp.ListTagsRequest) ([]*tag.Tag, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tagapp.ListTagsRequest) []*tag.Tag); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*tag.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, tagapp.ListTagsRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListTagsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListTagsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockListTagsUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockListTagsUseCase_Execute_Call {
+	return &MockListTagsUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockListTagsUseCase_Execute_Call) Run(run func(ctx context.Context, req tagapp.ListTagsRequest)) *MockListTagsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tagapp.ListTagsRequest))
+	})
+	return _c
+}
+
+func (_c *MockListTagsUseCase_Execute_Call) Return(tags []*tag.Tag, err error) *MockListTagsUseCase_Execute_Call {
+	_c.Call.Return(tags, err)
+	return _c
+}
+
+func (_c *MockListTagsUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req tagapp.ListTagsRequest) ([]*tag.Tag, error)) *MockListTagsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRenameTagUseCase creates a new instance of MockRenameTagUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRenameTagUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRenameTagUseCase {
+	mock := &MockRenameTagUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRenameTagUseCase is an autogenerated mock type for the RenameTagUseCase type
+type MockRenameTagUseCase struct {
+	mock.Mock
+}
+
+type MockRenameTagUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRenameTagUseCase) EXPECT() *MockRenameTagUseCase_Expecter {
+	return &MockRenameTagUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockRenameTagUseCase
+func (_mock *MockRenameTagUseCase) Execute(ctx context.Context, req tagapp.RenameTagRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tagapp.RenameTagRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRenameTagUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRenameTagUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockRenameTagUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockRenameTagUseCase_Execute_Call {
+	return &MockRenameTagUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockRenameTagUseCase_Execute_Call) Run(run func(ctx context.Context, req tagapp.RenameTagRequest)) *MockRenameTagUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tagapp.RenameTagRequest))
+	})
+	return _c
+}
+
+func (_c *MockRenameTagUseCase_Execute_Call) Return(err error) *MockRenameTagUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRenameTagUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req tagapp.RenameTagRequest) error) *MockRenameTagUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
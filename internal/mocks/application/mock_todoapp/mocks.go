@@ -12,6 +12,225 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockAddDependencyUseCase creates a new instance of MockAddDependencyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAddDependencyUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAddDependencyUseCase {
+	mock := &MockAddDependencyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAddDependencyUseCase is an autogenerated mock type for the AddDependencyUseCase type
+type MockAddDependencyUseCase struct {
+	mock.Mock
+}
+
+type MockAddDependencyUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAddDependencyUseCase) EXPECT() *MockAddDependencyUseCase_Expecter {
+	return &MockAddDependencyUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockAddDependencyUseCase
+func (_mock *MockAddDependencyUseCase) Execute(ctx context.Context, req todoapp.AddDependencyRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.AddDependencyRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAddDependencyUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockAddDependencyUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockAddDependencyUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockAddDependencyUseCase_Execute_Call {
+	return &MockAddDependencyUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockAddDependencyUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.AddDependencyRequest)) *MockAddDependencyUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.AddDependencyRequest))
+	})
+	return _c
+}
+
+func (_c *MockAddDependencyUseCase_Execute_Call) Return(err error) *MockAddDependencyUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAddDependencyUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.AddDependencyRequest) error) *MockAddDependencyUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAddTodoTagUseCase creates a new instance of MockAddTodoTagUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAddTodoTagUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAddTodoTagUseCase {
+	mock := &MockAddTodoTagUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAddTodoTagUseCase is an autogenerated mock type for the AddTodoTagUseCase type
+type MockAddTodoTagUseCase struct {
+	mock.Mock
+}
+
+type MockAddTodoTagUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAddTodoTagUseCase) EXPECT() *MockAddTodoTagUseCase_Expecter {
+	return &MockAddTodoTagUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockAddTodoTagUseCase
+func (_mock *MockAddTodoTagUseCase) Execute(ctx context.Context, req todoapp.AddTodoTagRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.AddTodoTagRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAddTodoTagUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockAddTodoTagUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockAddTodoTagUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockAddTodoTagUseCase_Execute_Call {
+	return &MockAddTodoTagUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockAddTodoTagUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.AddTodoTagRequest)) *MockAddTodoTagUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.AddTodoTagRequest))
+	})
+	return _c
+}
+
+func (_c *MockAddTodoTagUseCase_Execute_Call) Return(err error) *MockAddTodoTagUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAddTodoTagUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.AddTodoTagRequest) error) *MockAddTodoTagUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCancelTodoUseCase creates a new instance of MockCancelTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCancelTodoUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCancelTodoUseCase {
+	mock := &MockCancelTodoUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCancelTodoUseCase is an autogenerated mock type for the CancelTodoUseCase type
+type MockCancelTodoUseCase struct {
+	mock.Mock
+}
+
+type MockCancelTodoUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCancelTodoUseCase) EXPECT() *MockCancelTodoUseCase_Expecter {
+	return &MockCancelTodoUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockCancelTodoUseCase
+func (_mock *MockCancelTodoUseCase) Execute(ctx context.Context, req todoapp.CancelTodoRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.CancelTodoRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCancelTodoUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCancelTodoUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockCancelTodoUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockCancelTodoUseCase_Execute_Call {
+	return &MockCancelTodoUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockCancelTodoUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.CancelTodoRequest)) *MockCancelTodoUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.CancelTodoRequest))
+	})
+	return _c
+}
+
+func (_c *MockCancelTodoUseCase_Execute_Call) Return(err error) *MockCancelTodoUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCancelTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.CancelTodoRequest) error) *MockCancelTodoUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCompleteTodoUseCase creates a new instance of MockCompleteTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCompleteTodoUseCase(t interface {
@@ -113,20 +332,31 @@ func (_m *MockCreateTodoUseCase) EXPECT() *MockCreateTodoUseCase_Expecter {
 }
 
 // Execute provides a mock function for the type MockCreateTodoUseCase
-func (_mock *MockCreateTodoUseCase) Execute(ctx context.Context, req todoapp.CreateTodoRequest) error {
+func (_mock *MockCreateTodoUseCase) Execute(ctx context.Context, req todoapp.CreateTodoRequest) (*todo.Todo, error) {
 	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.CreateTodoRequest) error); ok {
+	var r0 *todo.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.CreateTodoRequest) (*todo.Todo, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.CreateTodoRequest) *todo.Todo); ok {
 		r0 = returnFunc(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*todo.Todo)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todoapp.CreateTodoRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockCreateTodoUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
@@ -148,12 +378,12 @@ func (_c *MockCreateTodoUseCase_Execute_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockCreateTodoUseCase_Execute_Call) Return(err error) *MockCreateTodoUseCase_Execute_Call {
-	_c.Call.Return(err)
+func (_c *MockCreateTodoUseCase_Execute_Call) Return(todo1 *todo.Todo, err error) *MockCreateTodoUseCase_Execute_Call {
+	_c.Call.Return(todo1, err)
 	return _c
 }
 
-func (_c *MockCreateTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.CreateTodoRequest) error) *MockCreateTodoUseCase_Execute_Call {
+func (_c *MockCreateTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.CreateTodoRequest) (*todo.Todo, error)) *MockCreateTodoUseCase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -231,6 +461,90 @@ func (_c *MockDeleteTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// NewMockGetTodoTreeUseCase creates a new instance of MockGetTodoTreeUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetTodoTreeUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetTodoTreeUseCase {
+	mock := &MockGetTodoTreeUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetTodoTreeUseCase is an autogenerated mock type for the GetTodoTreeUseCase type
+type MockGetTodoTreeUseCase struct {
+	mock.Mock
+}
+
+type MockGetTodoTreeUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetTodoTreeUseCase) EXPECT() *MockGetTodoTreeUseCase_Expecter {
+	return &MockGetTodoTreeUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockGetTodoTreeUseCase
+func (_mock *MockGetTodoTreeUseCase) Execute(ctx context.Context, req todoapp.GetTodoTreeRequest) (*todo.TodoTree, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *todo.TodoTree
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.GetTodoTreeRequest) (*todo.TodoTree, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.GetTodoTreeRequest) *todo.TodoTree); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*todo.TodoTree)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todoapp.GetTodoTreeRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetTodoTreeUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetTodoTreeUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockGetTodoTreeUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockGetTodoTreeUseCase_Execute_Call {
+	return &MockGetTodoTreeUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockGetTodoTreeUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.GetTodoTreeRequest)) *MockGetTodoTreeUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.GetTodoTreeRequest))
+	})
+	return _c
+}
+
+func (_c *MockGetTodoTreeUseCase_Execute_Call) Return(todoTree *todo.TodoTree, err error) *MockGetTodoTreeUseCase_Execute_Call {
+	_c.Call.Return(todoTree, err)
+	return _c
+}
+
+func (_c *MockGetTodoTreeUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.GetTodoTreeRequest) (*todo.TodoTree, error)) *MockGetTodoTreeUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockGetTodoUseCase creates a new instance of MockGetTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockGetTodoUseCase(t interface {
@@ -315,13 +629,13 @@ func (_c *MockGetTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
-// NewMockGetTodosUseCase creates a new instance of MockGetTodosUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockGetTodoVersionUseCase creates a new instance of MockGetTodoVersionUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockGetTodosUseCase(t interface {
+func NewMockGetTodoVersionUseCase(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockGetTodosUseCase {
-	mock := &MockGetTodosUseCase{}
+}) *MockGetTodoVersionUseCase {
+	mock := &MockGetTodoVersionUseCase{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -329,40 +643,40 @@ func NewMockGetTodosUseCase(t interface {
 	return mock
 }
 
-// MockGetTodosUseCase is an autogenerated mock type for the GetTodosUseCase type
-type MockGetTodosUseCase struct {
+// MockGetTodoVersionUseCase is an autogenerated mock type for the GetTodoVersionUseCase type
+type MockGetTodoVersionUseCase struct {
 	mock.Mock
 }
 
-type MockGetTodosUseCase_Expecter struct {
+type MockGetTodoVersionUseCase_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockGetTodosUseCase) EXPECT() *MockGetTodosUseCase_Expecter {
-	return &MockGetTodosUseCase_Expecter{mock: &_m.Mock}
+func (_m *MockGetTodoVersionUseCase) EXPECT() *MockGetTodoVersionUseCase_Expecter {
+	return &MockGetTodoVersionUseCase_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function for the type MockGetTodosUseCase
-func (_mock *MockGetTodosUseCase) Execute(ctx context.Context, req todoapp.GetTodosRequest) ([]*todo.Todo, error) {
+// Execute provides a mock function for the type MockGetTodoVersionUseCase
+func (_mock *MockGetTodoVersionUseCase) Execute(ctx context.Context, req todoapp.GetTodoVersionRequest) (*todoapp.TodoVersion, error) {
 	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
-	var r0 []*todo.Todo
+	var r0 *todoapp.TodoVersion
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.GetTodosRequest) ([]*todo.Todo, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.GetTodoVersionRequest) (*todoapp.TodoVersion, error)); ok {
 		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.GetTodosRequest) []*todo.Todo); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.GetTodoVersionRequest) *todoapp.TodoVersion); ok {
 		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*todo.Todo)
+			r0 = ret.Get(0).(*todoapp.TodoVersion)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, todoapp.GetTodosRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todoapp.GetTodoVersionRequest) error); ok {
 		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -370,31 +684,710 @@ func (_mock *MockGetTodosUseCase) Execute(ctx context.Context, req todoapp.GetTo
 	return r0, r1
 }
 
-// MockGetTodosUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
-type MockGetTodosUseCase_Execute_Call struct {
+// MockGetTodoVersionUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetTodoVersionUseCase_Execute_Call struct {
 	*mock.Call
 }
 
 // Execute is a helper method to define mock.On call
 //   - ctx
 //   - req
-func (_e *MockGetTodosUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockGetTodosUseCase_Execute_Call {
-	return &MockGetTodosUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+func (_e *MockGetTodoVersionUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockGetTodoVersionUseCase_Execute_Call {
+	return &MockGetTodoVersionUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
 }
 
-func (_c *MockGetTodosUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.GetTodosRequest)) *MockGetTodosUseCase_Execute_Call {
+func (_c *MockGetTodoVersionUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.GetTodoVersionRequest)) *MockGetTodoVersionUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.GetTodoVersionRequest))
+	})
+	return _c
+}
+
+func (_c *MockGetTodoVersionUseCase_Execute_Call) Return(todoVersion *todoapp.TodoVersion, err error) *MockGetTodoVersionUseCase_Execute_Call {
+	_c.Call.Return(todoVersion, err)
+	return _c
+}
+
+func (_c *MockGetTodoVersionUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.GetTodoVersionRequest) (*todoapp.TodoVersion, error)) *MockGetTodoVersionUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetTodosUseCase creates a new instance of MockGetTodosUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetTodosUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetTodosUseCase {
+	mock := &MockGetTodosUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetTodosUseCase is an autogenerated mock type for the GetTodosUseCase type
+type MockGetTodosUseCase struct {
+	mock.Mock
+}
+
+type MockGetTodosUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetTodosUseCase) EXPECT() *MockGetTodosUseCase_Expecter {
+	return &MockGetTodosUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockGetTodosUseCase
+func (_mock *MockGetTodosUseCase) Execute(ctx context.Context, req todoapp.GetTodosRequest) ([]*todo.Todo, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []*todo.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.GetTodosRequest) ([]*todo.Todo, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.GetTodosRequest) []*todo.Todo); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*todo.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todoapp.GetTodosRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetTodosUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetTodosUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockGetTodosUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockGetTodosUseCase_Execute_Call {
+	return &MockGetTodosUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockGetTodosUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.GetTodosRequest)) *MockGetTodosUseCase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(todoapp.GetTodosRequest))
 	})
 	return _c
 }
 
-func (_c *MockGetTodosUseCase_Execute_Call) Return(todos []*todo.Todo, err error) *MockGetTodosUseCase_Execute_Call {
-	_c.Call.Return(todos, err)
+func (_c *MockGetTodosUseCase_Execute_Call) Return(todos []*todo.Todo, err error) *MockGetTodosUseCase_Execute_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockGetTodosUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.GetTodosRequest) ([]*todo.Todo, error)) *MockGetTodosUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMoveTodoUseCase creates a new instance of MockMoveTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMoveTodoUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMoveTodoUseCase {
+	mock := &MockMoveTodoUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMoveTodoUseCase is an autogenerated mock type for the MoveTodoUseCase type
+type MockMoveTodoUseCase struct {
+	mock.Mock
+}
+
+type MockMoveTodoUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMoveTodoUseCase) EXPECT() *MockMoveTodoUseCase_Expecter {
+	return &MockMoveTodoUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockMoveTodoUseCase
+func (_mock *MockMoveTodoUseCase) Execute(ctx context.Context, req todoapp.MoveTodoRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.MoveTodoRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMoveTodoUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockMoveTodoUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockMoveTodoUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockMoveTodoUseCase_Execute_Call {
+	return &MockMoveTodoUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockMoveTodoUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.MoveTodoRequest)) *MockMoveTodoUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.MoveTodoRequest))
+	})
+	return _c
+}
+
+func (_c *MockMoveTodoUseCase_Execute_Call) Return(err error) *MockMoveTodoUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMoveTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.MoveTodoRequest) error) *MockMoveTodoUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPauseTodoUseCase creates a new instance of MockPauseTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPauseTodoUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPauseTodoUseCase {
+	mock := &MockPauseTodoUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPauseTodoUseCase is an autogenerated mock type for the PauseTodoUseCase type
+type MockPauseTodoUseCase struct {
+	mock.Mock
+}
+
+type MockPauseTodoUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPauseTodoUseCase) EXPECT() *MockPauseTodoUseCase_Expecter {
+	return &MockPauseTodoUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockPauseTodoUseCase
+func (_mock *MockPauseTodoUseCase) Execute(ctx context.Context, req todoapp.PauseTodoRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.PauseTodoRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPauseTodoUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockPauseTodoUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockPauseTodoUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockPauseTodoUseCase_Execute_Call {
+	return &MockPauseTodoUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockPauseTodoUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.PauseTodoRequest)) *MockPauseTodoUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.PauseTodoRequest))
+	})
+	return _c
+}
+
+func (_c *MockPauseTodoUseCase_Execute_Call) Return(err error) *MockPauseTodoUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPauseTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.PauseTodoRequest) error) *MockPauseTodoUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRemoveDependencyUseCase creates a new instance of MockRemoveDependencyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRemoveDependencyUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRemoveDependencyUseCase {
+	mock := &MockRemoveDependencyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRemoveDependencyUseCase is an autogenerated mock type for the RemoveDependencyUseCase type
+type MockRemoveDependencyUseCase struct {
+	mock.Mock
+}
+
+type MockRemoveDependencyUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRemoveDependencyUseCase) EXPECT() *MockRemoveDependencyUseCase_Expecter {
+	return &MockRemoveDependencyUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockRemoveDependencyUseCase
+func (_mock *MockRemoveDependencyUseCase) Execute(ctx context.Context, req todoapp.RemoveDependencyRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.RemoveDependencyRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRemoveDependencyUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRemoveDependencyUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockRemoveDependencyUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockRemoveDependencyUseCase_Execute_Call {
+	return &MockRemoveDependencyUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockRemoveDependencyUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.RemoveDependencyRequest)) *MockRemoveDependencyUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.RemoveDependencyRequest))
+	})
+	return _c
+}
+
+func (_c *MockRemoveDependencyUseCase_Execute_Call) Return(err error) *MockRemoveDependencyUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRemoveDependencyUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.RemoveDependencyRequest) error) *MockRemoveDependencyUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRemoveTodoTagUseCase creates a new instance of MockRemoveTodoTagUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRemoveTodoTagUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRemoveTodoTagUseCase {
+	mock := &MockRemoveTodoTagUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRemoveTodoTagUseCase is an autogenerated mock type for the RemoveTodoTagUseCase type
+type MockRemoveTodoTagUseCase struct {
+	mock.Mock
+}
+
+type MockRemoveTodoTagUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRemoveTodoTagUseCase) EXPECT() *MockRemoveTodoTagUseCase_Expecter {
+	return &MockRemoveTodoTagUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockRemoveTodoTagUseCase
+func (_mock *MockRemoveTodoTagUseCase) Execute(ctx context.Context, req todoapp.RemoveTodoTagRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.RemoveTodoTagRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRemoveTodoTagUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRemoveTodoTagUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockRemoveTodoTagUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockRemoveTodoTagUseCase_Execute_Call {
+	return &MockRemoveTodoTagUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockRemoveTodoTagUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.RemoveTodoTagRequest)) *MockRemoveTodoTagUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.RemoveTodoTagRequest))
+	})
+	return _c
+}
+
+func (_c *MockRemoveTodoTagUseCase_Execute_Call) Return(err error) *MockRemoveTodoTagUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRemoveTodoTagUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.RemoveTodoTagRequest) error) *MockRemoveTodoTagUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReopenTodoUseCase creates a new instance of MockReopenTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReopenTodoUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReopenTodoUseCase {
+	mock := &MockReopenTodoUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReopenTodoUseCase is an autogenerated mock type for the ReopenTodoUseCase type
+type MockReopenTodoUseCase struct {
+	mock.Mock
+}
+
+type MockReopenTodoUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReopenTodoUseCase) EXPECT() *MockReopenTodoUseCase_Expecter {
+	return &MockReopenTodoUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockReopenTodoUseCase
+func (_mock *MockReopenTodoUseCase) Execute(ctx context.Context, req todoapp.ReopenTodoRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.ReopenTodoRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReopenTodoUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockReopenTodoUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockReopenTodoUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockReopenTodoUseCase_Execute_Call {
+	return &MockReopenTodoUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockReopenTodoUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.ReopenTodoRequest)) *MockReopenTodoUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.ReopenTodoRequest))
+	})
+	return _c
+}
+
+func (_c *MockReopenTodoUseCase_Execute_Call) Return(err error) *MockReopenTodoUseCase_Execute_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockGetTodosUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.GetTodosRequest) ([]*todo.Todo, error)) *MockGetTodosUseCase_Execute_Call {
+func (_c *MockReopenTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.ReopenTodoRequest) error) *MockReopenTodoUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockResumeTodoUseCase creates a new instance of MockResumeTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockResumeTodoUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockResumeTodoUseCase {
+	mock := &MockResumeTodoUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockResumeTodoUseCase is an autogenerated mock type for the ResumeTodoUseCase type
+type MockResumeTodoUseCase struct {
+	mock.Mock
+}
+
+type MockResumeTodoUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockResumeTodoUseCase) EXPECT() *MockResumeTodoUseCase_Expecter {
+	return &MockResumeTodoUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockResumeTodoUseCase
+func (_mock *MockResumeTodoUseCase) Execute(ctx context.Context, req todoapp.ResumeTodoRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.ResumeTodoRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockResumeTodoUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockResumeTodoUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockResumeTodoUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockResumeTodoUseCase_Execute_Call {
+	return &MockResumeTodoUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockResumeTodoUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.ResumeTodoRequest)) *MockResumeTodoUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.ResumeTodoRequest))
+	})
+	return _c
+}
+
+func (_c *MockResumeTodoUseCase_Execute_Call) Return(err error) *MockResumeTodoUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockResumeTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.ResumeTodoRequest) error) *MockResumeTodoUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevertTodoUseCase creates a new instance of MockRevertTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevertTodoUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevertTodoUseCase {
+	mock := &MockRevertTodoUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRevertTodoUseCase is an autogenerated mock type for the RevertTodoUseCase type
+type MockRevertTodoUseCase struct {
+	mock.Mock
+}
+
+type MockRevertTodoUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevertTodoUseCase) EXPECT() *MockRevertTodoUseCase_Expecter {
+	return &MockRevertTodoUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockRevertTodoUseCase
+func (_mock *MockRevertTodoUseCase) Execute(ctx context.Context, req todoapp.RevertTodoRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.RevertTodoRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRevertTodoUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRevertTodoUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockRevertTodoUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockRevertTodoUseCase_Execute_Call {
+	return &MockRevertTodoUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockRevertTodoUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.RevertTodoRequest)) *MockRevertTodoUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.RevertTodoRequest))
+	})
+	return _c
+}
+
+func (_c *MockRevertTodoUseCase_Execute_Call) Return(err error) *MockRevertTodoUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRevertTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.RevertTodoRequest) error) *MockRevertTodoUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSearchTodosUseCase creates a new instance of MockSearchTodosUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSearchTodosUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSearchTodosUseCase {
+	mock := &MockSearchTodosUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSearchTodosUseCase is an autogenerated mock type for the SearchTodosUseCase type
+type MockSearchTodosUseCase struct {
+	mock.Mock
+}
+
+type MockSearchTodosUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSearchTodosUseCase) EXPECT() *MockSearchTodosUseCase_Expecter {
+	return &MockSearchTodosUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockSearchTodosUseCase
+func (_mock *MockSearchTodosUseCase) Execute(ctx context.Context, req todoapp.SearchTodosRequest) (*todoapp.SearchTodosResult, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *todoapp.SearchTodosResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.SearchTodosRequest) (*todoapp.SearchTodosResult, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.SearchTodosRequest) *todoapp.SearchTodosResult); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*todoapp.SearchTodosResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todoapp.SearchTodosRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSearchTodosUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockSearchTodosUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockSearchTodosUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockSearchTodosUseCase_Execute_Call {
+	return &MockSearchTodosUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockSearchTodosUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.SearchTodosRequest)) *MockSearchTodosUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.SearchTodosRequest))
+	})
+	return _c
+}
+
+func (_c *MockSearchTodosUseCase_Execute_Call) Return(searchTodosResult *todoapp.SearchTodosResult, err error) *MockSearchTodosUseCase_Execute_Call {
+	_c.Call.Return(searchTodosResult, err)
+	return _c
+}
+
+func (_c *MockSearchTodosUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.SearchTodosRequest) (*todoapp.SearchTodosResult, error)) *MockSearchTodosUseCase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -472,6 +1465,79 @@ func (_c *MockStartTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// NewMockTransitionTodoUseCase creates a new instance of MockTransitionTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransitionTodoUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransitionTodoUseCase {
+	mock := &MockTransitionTodoUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTransitionTodoUseCase is an autogenerated mock type for the TransitionTodoUseCase type
+type MockTransitionTodoUseCase struct {
+	mock.Mock
+}
+
+type MockTransitionTodoUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransitionTodoUseCase) EXPECT() *MockTransitionTodoUseCase_Expecter {
+	return &MockTransitionTodoUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockTransitionTodoUseCase
+func (_mock *MockTransitionTodoUseCase) Execute(ctx context.Context, req todoapp.TransitionTodoRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todoapp.TransitionTodoRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTransitionTodoUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockTransitionTodoUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockTransitionTodoUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockTransitionTodoUseCase_Execute_Call {
+	return &MockTransitionTodoUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockTransitionTodoUseCase_Execute_Call) Run(run func(ctx context.Context, req todoapp.TransitionTodoRequest)) *MockTransitionTodoUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todoapp.TransitionTodoRequest))
+	})
+	return _c
+}
+
+func (_c *MockTransitionTodoUseCase_Execute_Call) Return(err error) *MockTransitionTodoUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTransitionTodoUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req todoapp.TransitionTodoRequest) error) *MockTransitionTodoUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUpdateTodoUseCase creates a new instance of MockUpdateTodoUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUpdateTodoUseCase(t interface {
@@ -0,0 +1,253 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_transferapp
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/application/transferapp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockExportTodosUseCase creates a new instance of MockExportTodosUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportTodosUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportTodosUseCase {
+	mock := &MockExportTodosUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExportTodosUseCase is an autogenerated mock type for the ExportTodosUseCase type
+type MockExportTodosUseCase struct {
+	mock.Mock
+}
+
+type MockExportTodosUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportTodosUseCase) EXPECT() *MockExportTodosUseCase_Expecter {
+	return &MockExportTodosUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockExportTodosUseCase
+func (_mock *MockExportTodosUseCase) Execute(ctx context.Context, req transferapp.ExportTodosRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transferapp.ExportTodosRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExportTodosUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockExportTodosUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockExportTodosUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockExportTodosUseCase_Execute_Call {
+	return &MockExportTodosUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockExportTodosUseCase_Execute_Call) Run(run func(ctx context.Context, req transferapp.ExportTodosRequest)) *MockExportTodosUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(transferapp.ExportTodosRequest))
+	})
+	return _c
+}
+
+func (_c *MockExportTodosUseCase_Execute_Call) Return(err error) *MockExportTodosUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExportTodosUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req transferapp.ExportTodosRequest) error) *MockExportTodosUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockImportFromSourceUseCase creates a new instance of MockImportFromSourceUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockImportFromSourceUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockImportFromSourceUseCase {
+	mock := &MockImportFromSourceUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockImportFromSourceUseCase is an autogenerated mock type for the ImportFromSourceUseCase type
+type MockImportFromSourceUseCase struct {
+	mock.Mock
+}
+
+type MockImportFromSourceUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockImportFromSourceUseCase) EXPECT() *MockImportFromSourceUseCase_Expecter {
+	return &MockImportFromSourceUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockImportFromSourceUseCase
+func (_mock *MockImportFromSourceUseCase) Execute(ctx context.Context, req transferapp.ImportFromSourceRequest) (*transferapp.SourceImportReport, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *transferapp.SourceImportReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transferapp.ImportFromSourceRequest) (*transferapp.SourceImportReport, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transferapp.ImportFromSourceRequest) *transferapp.SourceImportReport); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transferapp.SourceImportReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, transferapp.ImportFromSourceRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockImportFromSourceUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockImportFromSourceUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockImportFromSourceUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockImportFromSourceUseCase_Execute_Call {
+	return &MockImportFromSourceUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockImportFromSourceUseCase_Execute_Call) Run(run func(ctx context.Context, req transferapp.ImportFromSourceRequest)) *MockImportFromSourceUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(transferapp.ImportFromSourceRequest))
+	})
+	return _c
+}
+
+func (_c *MockImportFromSourceUseCase_Execute_Call) Return(sourceImportReport *transferapp.SourceImportReport, err error) *MockImportFromSourceUseCase_Execute_Call {
+	_c.Call.Return(sourceImportReport, err)
+	return _c
+}
+
+func (_c *MockImportFromSourceUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req transferapp.ImportFromSourceRequest) (*transferapp.SourceImportReport, error)) *MockImportFromSourceUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockImportTodosUseCase creates a new instance of MockImportTodosUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockImportTodosUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockImportTodosUseCase {
+	mock := &MockImportTodosUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockImportTodosUseCase is an autogenerated mock type for the ImportTodosUseCase type
+type MockImportTodosUseCase struct {
+	mock.Mock
+}
+
+type MockImportTodosUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockImportTodosUseCase) EXPECT() *MockImportTodosUseCase_Expecter {
+	return &MockImportTodosUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockImportTodosUseCase
+func (_mock *MockImportTodosUseCase) Execute(ctx context.Context, req transferapp.ImportTodosRequest) (*transferapp.ImportReport, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *transferapp.ImportReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transferapp.ImportTodosRequest) (*transferapp.ImportReport, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transferapp.ImportTodosRequest) *transferapp.ImportReport); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transferapp.ImportReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, transferapp.ImportTodosRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockImportTodosUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockImportTodosUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockImportTodosUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockImportTodosUseCase_Execute_Call {
+	return &MockImportTodosUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockImportTodosUseCase_Execute_Call) Run(run func(ctx context.Context, req transferapp.ImportTodosRequest)) *MockImportTodosUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(transferapp.ImportTodosRequest))
+	})
+	return _c
+}
+
+func (_c *MockImportTodosUseCase_Execute_Call) Return(importReport *transferapp.ImportReport, err error) *MockImportTodosUseCase_Execute_Call {
+	_c.Call.Return(importReport, err)
+	return _c
+}
+
+func (_c *MockImportTodosUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req transferapp.ImportTodosRequest) (*transferapp.ImportReport, error)) *MockImportTodosUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
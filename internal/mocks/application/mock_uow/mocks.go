@@ -7,6 +7,7 @@ package mock_uow
 import (
 	"context"
 
+	"github.com/iktakahiro/oniongo/internal/application/uow"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,16 +39,22 @@ func (_m *MockTransactionRunner) EXPECT() *MockTransactionRunner_Expecter {
 }
 
 // RunInTx provides a mock function for the type MockTransactionRunner
-func (_mock *MockTransactionRunner) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	ret := _mock.Called(ctx, fn)
+func (_mock *MockTransactionRunner) RunInTx(ctx context.Context, fn func(ctx context.Context) error, opts ...uow.TxOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, fn)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for RunInTx")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) error); ok {
-		r0 = returnFunc(ctx, fn)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error, ...uow.TxOption) error); ok {
+		r0 = returnFunc(ctx, fn, opts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -62,13 +69,16 @@ type MockTransactionRunner_RunInTx_Call struct {
 // RunInTx is a helper method to define mock.On call
 //   - ctx
 //   - fn
-func (_e *MockTransactionRunner_Expecter) RunInTx(ctx interface{}, fn interface{}) *MockTransactionRunner_RunInTx_Call {
-	return &MockTransactionRunner_RunInTx_Call{Call: _e.mock.On("RunInTx", ctx, fn)}
+//   - opts
+func (_e *MockTransactionRunner_Expecter) RunInTx(ctx interface{}, fn interface{}, opts ...interface{}) *MockTransactionRunner_RunInTx_Call {
+	return &MockTransactionRunner_RunInTx_Call{Call: _e.mock.On("RunInTx",
+		append([]interface{}{ctx, fn}, opts...)...)}
 }
 
-func (_c *MockTransactionRunner_RunInTx_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error)) *MockTransactionRunner_RunInTx_Call {
+func (_c *MockTransactionRunner_RunInTx_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error, opts ...uow.TxOption)) *MockTransactionRunner_RunInTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(func(ctx context.Context) error))
+		variadicArgs := args[2].([]uow.TxOption)
+		run(args[0].(context.Context), args[1].(func(ctx context.Context) error), variadicArgs...)
 	})
 	return _c
 }
@@ -78,7 +88,7 @@ func (_c *MockTransactionRunner_RunInTx_Call) Return(err error) *MockTransaction
 	return _c
 }
 
-func (_c *MockTransactionRunner_RunInTx_Call) RunAndReturn(run func(ctx context.Context, fn func(ctx context.Context) error) error) *MockTransactionRunner_RunInTx_Call {
+func (_c *MockTransactionRunner_RunInTx_Call) RunAndReturn(run func(ctx context.Context, fn func(ctx context.Context) error, opts ...uow.TxOption) error) *MockTransactionRunner_RunInTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
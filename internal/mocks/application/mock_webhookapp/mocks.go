@@ -0,0 +1,504 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_webhookapp
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/application/webhookapp"
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCreateWebhookUseCase creates a new instance of MockCreateWebhookUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateWebhookUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateWebhookUseCase {
+	mock := &MockCreateWebhookUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCreateWebhookUseCase is an autogenerated mock type for the CreateWebhookUseCase type
+type MockCreateWebhookUseCase struct {
+	mock.Mock
+}
+
+type MockCreateWebhookUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateWebhookUseCase) EXPECT() *MockCreateWebhookUseCase_Expecter {
+	return &MockCreateWebhookUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockCreateWebhookUseCase
+func (_mock *MockCreateWebhookUseCase) Execute(ctx context.Context, req webhookapp.CreateWebhookRequest) (*webhook.Webhook, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *webhook.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhookapp.CreateWebhookRequest) (*webhook.Webhook, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhookapp.CreateWebhookRequest) *webhook.Webhook); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, webhookapp.CreateWebhookRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCreateWebhookUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCreateWebhookUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockCreateWebhookUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockCreateWebhookUseCase_Execute_Call {
+	return &MockCreateWebhookUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockCreateWebhookUseCase_Execute_Call) Run(run func(ctx context.Context, req webhookapp.CreateWebhookRequest)) *MockCreateWebhookUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhookapp.CreateWebhookRequest))
+	})
+	return _c
+}
+
+func (_c *MockCreateWebhookUseCase_Execute_Call) Return(webhook1 *webhook.Webhook, err error) *MockCreateWebhookUseCase_Execute_Call {
+	_c.Call.Return(webhook1, err)
+	return _c
+}
+
+func (_c *MockCreateWebhookUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req webhookapp.CreateWebhookRequest) (*webhook.Webhook, error)) *MockCreateWebhookUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleteWebhookUseCase creates a new instance of MockDeleteWebhookUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleteWebhookUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleteWebhookUseCase {
+	mock := &MockDeleteWebhookUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleteWebhookUseCase is an autogenerated mock type for the DeleteWebhookUseCase type
+type MockDeleteWebhookUseCase struct {
+	mock.Mock
+}
+
+type MockDeleteWebhookUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleteWebhookUseCase) EXPECT() *MockDeleteWebhookUseCase_Expecter {
+	return &MockDeleteWebhookUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockDeleteWebhookUseCase
+func (_mock *MockDeleteWebhookUseCase) Execute(ctx context.Context, req webhookapp.DeleteWebhookRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhookapp.DeleteWebhookRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeleteWebhookUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDeleteWebhookUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockDeleteWebhookUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockDeleteWebhookUseCase_Execute_Call {
+	return &MockDeleteWebhookUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockDeleteWebhookUseCase_Execute_Call) Run(run func(ctx context.Context, req webhookapp.DeleteWebhookRequest)) *MockDeleteWebhookUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhookapp.DeleteWebhookRequest))
+	})
+	return _c
+}
+
+func (_c *MockDeleteWebhookUseCase_Execute_Call) Return(err error) *MockDeleteWebhookUseCase_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeleteWebhookUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req webhookapp.DeleteWebhookRequest) error) *MockDeleteWebhookUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDispatchWebhooksUseCase creates a new instance of MockDispatchWebhooksUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDispatchWebhooksUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDispatchWebhooksUseCase {
+	mock := &MockDispatchWebhooksUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDispatchWebhooksUseCase is an autogenerated mock type for the DispatchWebhooksUseCase type
+type MockDispatchWebhooksUseCase struct {
+	mock.Mock
+}
+
+type MockDispatchWebhooksUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDispatchWebhooksUseCase) EXPECT() *MockDispatchWebhooksUseCase_Expecter {
+	return &MockDispatchWebhooksUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockDispatchWebhooksUseCase
+func (_mock *MockDispatchWebhooksUseCase) Execute(ctx context.Context) (*webhookapp.DispatchWebhooksResult, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *webhookapp.DispatchWebhooksResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*webhookapp.DispatchWebhooksResult, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *webhookapp.DispatchWebhooksResult); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhookapp.DispatchWebhooksResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDispatchWebhooksUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDispatchWebhooksUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+func (_e *MockDispatchWebhooksUseCase_Expecter) Execute(ctx interface{}) *MockDispatchWebhooksUseCase_Execute_Call {
+	return &MockDispatchWebhooksUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *MockDispatchWebhooksUseCase_Execute_Call) Run(run func(ctx context.Context)) *MockDispatchWebhooksUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDispatchWebhooksUseCase_Execute_Call) Return(dispatchWebhooksResult *webhookapp.DispatchWebhooksResult, err error) *MockDispatchWebhooksUseCase_Execute_Call {
+	_c.Call.Return(dispatchWebhooksResult, err)
+	return _c
+}
+
+func (_c *MockDispatchWebhooksUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context) (*webhookapp.DispatchWebhooksResult, error)) *MockDispatchWebhooksUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListDeliveriesUseCase creates a new instance of MockListDeliveriesUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListDeliveriesUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListDeliveriesUseCase {
+	mock := &MockListDeliveriesUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListDeliveriesUseCase is an autogenerated mock type for the ListDeliveriesUseCase type
+type MockListDeliveriesUseCase struct {
+	mock.Mock
+}
+
+type MockListDeliveriesUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListDeliveriesUseCase) EXPECT() *MockListDeliveriesUseCase_Expecter {
+	return &MockListDeliveriesUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockListDeliveriesUseCase
+func (_mock *MockListDeliveriesUseCase) Execute(ctx context.Context, req webhookapp.ListDeliveriesRequest) (*webhookapp.DeliveryPage, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *webhookapp.DeliveryPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhookapp.ListDeliveriesRequest) (*webhookapp.DeliveryPage, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhookapp.ListDeliveriesRequest) *webhookapp.DeliveryPage); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhookapp.DeliveryPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, webhookapp.ListDeliveriesRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListDeliveriesUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListDeliveriesUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockListDeliveriesUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockListDeliveriesUseCase_Execute_Call {
+	return &MockListDeliveriesUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockListDeliveriesUseCase_Execute_Call) Run(run func(ctx context.Context, req webhookapp.ListDeliveriesRequest)) *MockListDeliveriesUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhookapp.ListDeliveriesRequest))
+	})
+	return _c
+}
+
+func (_c *MockListDeliveriesUseCase_Execute_Call) Return(deliveryPage *webhookapp.DeliveryPage, err error) *MockListDeliveriesUseCase_Execute_Call {
+	_c.Call.Return(deliveryPage, err)
+	return _c
+}
+
+func (_c *MockListDeliveriesUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req webhookapp.ListDeliveriesRequest) (*webhookapp.DeliveryPage, error)) *MockListDeliveriesUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListWebhooksUseCase creates a new instance of MockListWebhooksUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListWebhooksUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListWebhooksUseCase {
+	mock := &MockListWebhooksUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListWebhooksUseCase is an autogenerated mock type for the ListWebhooksUseCase type
+type MockListWebhooksUseCase struct {
+	mock.Mock
+}
+
+type MockListWebhooksUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListWebhooksUseCase) EXPECT() *MockListWebhooksUseCase_Expecter {
+	return &MockListWebhooksUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockListWebhooksUseCase
+func (_mock *MockListWebhooksUseCase) Execute(ctx context.Context) ([]*webhook.Webhook, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []*webhook.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*webhook.Webhook, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*webhook.Webhook); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListWebhooksUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListWebhooksUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+func (_e *MockListWebhooksUseCase_Expecter) Execute(ctx interface{}) *MockListWebhooksUseCase_Execute_Call {
+	return &MockListWebhooksUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *MockListWebhooksUseCase_Execute_Call) Run(run func(ctx context.Context)) *MockListWebhooksUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockListWebhooksUseCase_Execute_Call) Return(webhooks []*webhook.Webhook, err error) *MockListWebhooksUseCase_Execute_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockListWebhooksUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context) ([]*webhook.Webhook, error)) *MockListWebhooksUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTestWebhookUseCase creates a new instance of MockTestWebhookUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTestWebhookUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTestWebhookUseCase {
+	mock := &MockTestWebhookUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTestWebhookUseCase is an autogenerated mock type for the TestWebhookUseCase type
+type MockTestWebhookUseCase struct {
+	mock.Mock
+}
+
+type MockTestWebhookUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTestWebhookUseCase) EXPECT() *MockTestWebhookUseCase_Expecter {
+	return &MockTestWebhookUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockTestWebhookUseCase
+func (_mock *MockTestWebhookUseCase) Execute(ctx context.Context, req webhookapp.TestWebhookRequest) (*webhook.Delivery, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *webhook.Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhookapp.TestWebhookRequest) (*webhook.Delivery, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhookapp.TestWebhookRequest) *webhook.Delivery); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, webhookapp.TestWebhookRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTestWebhookUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockTestWebhookUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockTestWebhookUseCase_Expecter) Execute(ctx interface{}, req interface{}) *MockTestWebhookUseCase_Execute_Call {
+	return &MockTestWebhookUseCase_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockTestWebhookUseCase_Execute_Call) Run(run func(ctx context.Context, req webhookapp.TestWebhookRequest)) *MockTestWebhookUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhookapp.TestWebhookRequest))
+	})
+	return _c
+}
+
+func (_c *MockTestWebhookUseCase_Execute_Call) Return(delivery *webhook.Delivery, err error) *MockTestWebhookUseCase_Execute_Call {
+	_c.Call.Return(delivery, err)
+	return _c
+}
+
+func (_c *MockTestWebhookUseCase_Execute_Call) RunAndReturn(run func(ctx context.Context, req webhookapp.TestWebhookRequest) (*webhook.Delivery, error)) *MockTestWebhookUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,537 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_attachment
+
+import (
+	"context"
+	"io"
+
+	"github.com/iktakahiro/oniongo/internal/domain/attachment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAttachmentRepository creates a new instance of MockAttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type MockAttachmentRepository struct {
+	mock.Mock
+}
+
+type MockAttachmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentRepository) EXPECT() *MockAttachmentRepository_Expecter {
+	return &MockAttachmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockAttachmentRepository
+func (_mock *MockAttachmentRepository) Create(ctx context.Context, attachment1 *attachment.Attachment) error {
+	ret := _mock.Called(ctx, attachment1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *attachment.Attachment) error); ok {
+		r0 = returnFunc(ctx, attachment1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAttachmentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAttachmentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - attachment1
+func (_e *MockAttachmentRepository_Expecter) Create(ctx interface{}, attachment1 interface{}) *MockAttachmentRepository_Create_Call {
+	return &MockAttachmentRepository_Create_Call{Call: _e.mock.On("Create", ctx, attachment1)}
+}
+
+func (_c *MockAttachmentRepository_Create_Call) Run(run func(ctx context.Context, attachment1 *attachment.Attachment)) *MockAttachmentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*attachment.Attachment))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Create_Call) Return(err error) *MockAttachmentRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Create_Call) RunAndReturn(run func(ctx context.Context, attachment1 *attachment.Attachment) error) *MockAttachmentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockAttachmentRepository
+func (_mock *MockAttachmentRepository) Delete(ctx context.Context, id attachment.AttachmentID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachment.AttachmentID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAttachmentRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAttachmentRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockAttachmentRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockAttachmentRepository_Delete_Call {
+	return &MockAttachmentRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockAttachmentRepository_Delete_Call) Run(run func(ctx context.Context, id attachment.AttachmentID)) *MockAttachmentRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(attachment.AttachmentID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Delete_Call) Return(err error) *MockAttachmentRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id attachment.AttachmentID) error) *MockAttachmentRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockAttachmentRepository
+func (_mock *MockAttachmentRepository) FindByID(ctx context.Context, id attachment.AttachmentID) (*attachment.Attachment, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *attachment.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachment.AttachmentID) (*attachment.Attachment, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, attachment.AttachmentID) *attachment.Attachment); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*attachment.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, attachment.AttachmentID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockAttachmentRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockAttachmentRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockAttachmentRepository_FindByID_Call {
+	return &MockAttachmentRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockAttachmentRepository_FindByID_Call) Run(run func(ctx context.Context, id attachment.AttachmentID)) *MockAttachmentRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(attachment.AttachmentID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_FindByID_Call) Return(attachment1 *attachment.Attachment, err error) *MockAttachmentRepository_FindByID_Call {
+	_c.Call.Return(attachment1, err)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id attachment.AttachmentID) (*attachment.Attachment, error)) *MockAttachmentRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIDs provides a mock function for the type MockAttachmentRepository
+func (_mock *MockAttachmentRepository) FindByIDs(ctx context.Context, ids []attachment.AttachmentID) ([]*attachment.Attachment, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []*attachment.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []attachment.AttachmentID) ([]*attachment.Attachment, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []attachment.AttachmentID) []*attachment.Attachment); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*attachment.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []attachment.AttachmentID) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockAttachmentRepository_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockAttachmentRepository_Expecter) FindByIDs(ctx interface{}, ids interface{}) *MockAttachmentRepository_FindByIDs_Call {
+	return &MockAttachmentRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", ctx, ids)}
+}
+
+func (_c *MockAttachmentRepository_FindByIDs_Call) Run(run func(ctx context.Context, ids []attachment.AttachmentID)) *MockAttachmentRepository_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]attachment.AttachmentID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_FindByIDs_Call) Return(attachments []*attachment.Attachment, err error) *MockAttachmentRepository_FindByIDs_Call {
+	_c.Call.Return(attachments, err)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_FindByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []attachment.AttachmentID) ([]*attachment.Attachment, error)) *MockAttachmentRepository_FindByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTodoIDs provides a mock function for the type MockAttachmentRepository
+func (_mock *MockAttachmentRepository) FindByTodoIDs(ctx context.Context, todoIDs []todo.TodoID) ([]*attachment.Attachment, error) {
+	ret := _mock.Called(ctx, todoIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTodoIDs")
+	}
+
+	var r0 []*attachment.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []todo.TodoID) ([]*attachment.Attachment, error)); ok {
+		return returnFunc(ctx, todoIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []todo.TodoID) []*attachment.Attachment); ok {
+		r0 = returnFunc(ctx, todoIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*attachment.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []todo.TodoID) error); ok {
+		r1 = returnFunc(ctx, todoIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttachmentRepository_FindByTodoIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTodoIDs'
+type MockAttachmentRepository_FindByTodoIDs_Call struct {
+	*mock.Call
+}
+
+// FindByTodoIDs is a helper method to define mock.On call
+//   - ctx
+//   - todoIDs
+func (_e *MockAttachmentRepository_Expecter) FindByTodoIDs(ctx interface{}, todoIDs interface{}) *MockAttachmentRepository_FindByTodoIDs_Call {
+	return &MockAttachmentRepository_FindByTodoIDs_Call{Call: _e.mock.On("FindByTodoIDs", ctx, todoIDs)}
+}
+
+func (_c *MockAttachmentRepository_FindByTodoIDs_Call) Run(run func(ctx context.Context, todoIDs []todo.TodoID)) *MockAttachmentRepository_FindByTodoIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]todo.TodoID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_FindByTodoIDs_Call) Return(attachments []*attachment.Attachment, err error) *MockAttachmentRepository_FindByTodoIDs_Call {
+	_c.Call.Return(attachments, err)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_FindByTodoIDs_Call) RunAndReturn(run func(ctx context.Context, todoIDs []todo.TodoID) ([]*attachment.Attachment, error)) *MockAttachmentRepository_FindByTodoIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBlobStore creates a new instance of MockBlobStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBlobStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBlobStore {
+	mock := &MockBlobStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBlobStore is an autogenerated mock type for the BlobStore type
+type MockBlobStore struct {
+	mock.Mock
+}
+
+type MockBlobStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBlobStore) EXPECT() *MockBlobStore_Expecter {
+	return &MockBlobStore_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockBlobStore
+func (_mock *MockBlobStore) Delete(ctx context.Context, key string) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBlobStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockBlobStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - key
+func (_e *MockBlobStore_Expecter) Delete(ctx interface{}, key interface{}) *MockBlobStore_Delete_Call {
+	return &MockBlobStore_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *MockBlobStore_Delete_Call) Run(run func(ctx context.Context, key string)) *MockBlobStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Delete_Call) Return(err error) *MockBlobStore_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBlobStore_Delete_Call) RunAndReturn(run func(ctx context.Context, key string) error) *MockBlobStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockBlobStore
+func (_mock *MockBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBlobStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockBlobStore_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx
+//   - key
+func (_e *MockBlobStore_Expecter) Get(ctx interface{}, key interface{}) *MockBlobStore_Get_Call {
+	return &MockBlobStore_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockBlobStore_Get_Call) Run(run func(ctx context.Context, key string)) *MockBlobStore_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Get_Call) Return(readCloser io.ReadCloser, err error) *MockBlobStore_Get_Call {
+	_c.Call.Return(readCloser, err)
+	return _c
+}
+
+func (_c *MockBlobStore_Get_Call) RunAndReturn(run func(ctx context.Context, key string) (io.ReadCloser, error)) *MockBlobStore_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockBlobStore
+func (_mock *MockBlobStore) List(ctx context.Context) ([]attachment.BlobInfo, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []attachment.BlobInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]attachment.BlobInfo, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []attachment.BlobInfo); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]attachment.BlobInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBlobStore_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockBlobStore_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+func (_e *MockBlobStore_Expecter) List(ctx interface{}) *MockBlobStore_List_Call {
+	return &MockBlobStore_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockBlobStore_List_Call) Run(run func(ctx context.Context)) *MockBlobStore_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_List_Call) Return(blobInfos []attachment.BlobInfo, err error) *MockBlobStore_List_Call {
+	_c.Call.Return(blobInfos, err)
+	return _c
+}
+
+func (_c *MockBlobStore_List_Call) RunAndReturn(run func(ctx context.Context) ([]attachment.BlobInfo, error)) *MockBlobStore_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function for the type MockBlobStore
+func (_mock *MockBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	ret := _mock.Called(ctx, key, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, io.Reader) error); ok {
+		r0 = returnFunc(ctx, key, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBlobStore_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockBlobStore_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx
+//   - key
+//   - r
+func (_e *MockBlobStore_Expecter) Put(ctx interface{}, key interface{}, r interface{}) *MockBlobStore_Put_Call {
+	return &MockBlobStore_Put_Call{Call: _e.mock.On("Put", ctx, key, r)}
+}
+
+func (_c *MockBlobStore_Put_Call) Run(run func(ctx context.Context, key string, r io.Reader)) *MockBlobStore_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockBlobStore_Put_Call) Return(err error) *MockBlobStore_Put_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBlobStore_Put_Call) RunAndReturn(run func(ctx context.Context, key string, r io.Reader) error) *MockBlobStore_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,305 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_comment
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/domain/comment"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCommentRepository creates a new instance of MockCommentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCommentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommentRepository {
+	mock := &MockCommentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCommentRepository is an autogenerated mock type for the CommentRepository type
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+type MockCommentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCommentRepository) EXPECT() *MockCommentRepository_Expecter {
+	return &MockCommentRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountByTodoIDs provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) CountByTodoIDs(ctx context.Context, todoIDs []todo.TodoID) (map[todo.TodoID]int, error) {
+	ret := _mock.Called(ctx, todoIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByTodoIDs")
+	}
+
+	var r0 map[todo.TodoID]int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []todo.TodoID) (map[todo.TodoID]int, error)); ok {
+		return returnFunc(ctx, todoIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []todo.TodoID) map[todo.TodoID]int); ok {
+		r0 = returnFunc(ctx, todoIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[todo.TodoID]int)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []todo.TodoID) error); ok {
+		r1 = returnFunc(ctx, todoIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentRepository_CountByTodoIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByTodoIDs'
+type MockCommentRepository_CountByTodoIDs_Call struct {
+	*mock.Call
+}
+
+// CountByTodoIDs is a helper method to define mock.On call
+//   - ctx
+//   - todoIDs
+func (_e *MockCommentRepository_Expecter) CountByTodoIDs(ctx interface{}, todoIDs interface{}) *MockCommentRepository_CountByTodoIDs_Call {
+	return &MockCommentRepository_CountByTodoIDs_Call{Call: _e.mock.On("CountByTodoIDs", ctx, todoIDs)}
+}
+
+func (_c *MockCommentRepository_CountByTodoIDs_Call) Run(run func(ctx context.Context, todoIDs []todo.TodoID)) *MockCommentRepository_CountByTodoIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]todo.TodoID))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_CountByTodoIDs_Call) Return(todoIDToInt map[todo.TodoID]int, err error) *MockCommentRepository_CountByTodoIDs_Call {
+	_c.Call.Return(todoIDToInt, err)
+	return _c
+}
+
+func (_c *MockCommentRepository_CountByTodoIDs_Call) RunAndReturn(run func(ctx context.Context, todoIDs []todo.TodoID) (map[todo.TodoID]int, error)) *MockCommentRepository_CountByTodoIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) Create(ctx context.Context, comment1 *comment.Comment) error {
+	ret := _mock.Called(ctx, comment1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *comment.Comment) error); ok {
+		r0 = returnFunc(ctx, comment1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCommentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCommentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - comment1
+func (_e *MockCommentRepository_Expecter) Create(ctx interface{}, comment1 interface{}) *MockCommentRepository_Create_Call {
+	return &MockCommentRepository_Create_Call{Call: _e.mock.On("Create", ctx, comment1)}
+}
+
+func (_c *MockCommentRepository_Create_Call) Run(run func(ctx context.Context, comment1 *comment.Comment)) *MockCommentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*comment.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_Create_Call) Return(err error) *MockCommentRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentRepository_Create_Call) RunAndReturn(run func(ctx context.Context, comment1 *comment.Comment) error) *MockCommentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) FindByID(ctx context.Context, id comment.CommentID) (*comment.Comment, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *comment.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, comment.CommentID) (*comment.Comment, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, comment.CommentID) *comment.Comment); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*comment.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, comment.CommentID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockCommentRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockCommentRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockCommentRepository_FindByID_Call {
+	return &MockCommentRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockCommentRepository_FindByID_Call) Run(run func(ctx context.Context, id comment.CommentID)) *MockCommentRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(comment.CommentID))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_FindByID_Call) Return(comment1 *comment.Comment, err error) *MockCommentRepository_FindByID_Call {
+	_c.Call.Return(comment1, err)
+	return _c
+}
+
+func (_c *MockCommentRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id comment.CommentID) (*comment.Comment, error)) *MockCommentRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTodoID provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) FindByTodoID(ctx context.Context, todoID todo.TodoID, limit int, offset int) ([]*comment.Comment, error) {
+	ret := _mock.Called(ctx, todoID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTodoID")
+	}
+
+	var r0 []*comment.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID, int, int) ([]*comment.Comment, error)); ok {
+		return returnFunc(ctx, todoID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID, int, int) []*comment.Comment); ok {
+		r0 = returnFunc(ctx, todoID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*comment.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todo.TodoID, int, int) error); ok {
+		r1 = returnFunc(ctx, todoID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentRepository_FindByTodoID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTodoID'
+type MockCommentRepository_FindByTodoID_Call struct {
+	*mock.Call
+}
+
+// FindByTodoID is a helper method to define mock.On call
+//   - ctx
+//   - todoID
+//   - limit
+//   - offset
+func (_e *MockCommentRepository_Expecter) FindByTodoID(ctx interface{}, todoID interface{}, limit interface{}, offset interface{}) *MockCommentRepository_FindByTodoID_Call {
+	return &MockCommentRepository_FindByTodoID_Call{Call: _e.mock.On("FindByTodoID", ctx, todoID, limit, offset)}
+}
+
+func (_c *MockCommentRepository_FindByTodoID_Call) Run(run func(ctx context.Context, todoID todo.TodoID, limit int, offset int)) *MockCommentRepository_FindByTodoID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todo.TodoID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_FindByTodoID_Call) Return(comments []*comment.Comment, err error) *MockCommentRepository_FindByTodoID_Call {
+	_c.Call.Return(comments, err)
+	return _c
+}
+
+func (_c *MockCommentRepository_FindByTodoID_Call) RunAndReturn(run func(ctx context.Context, todoID todo.TodoID, limit int, offset int) ([]*comment.Comment, error)) *MockCommentRepository_FindByTodoID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) Update(ctx context.Context, comment1 *comment.Comment) error {
+	ret := _mock.Called(ctx, comment1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *comment.Comment) error); ok {
+		r0 = returnFunc(ctx, comment1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCommentRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCommentRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - comment1
+func (_e *MockCommentRepository_Expecter) Update(ctx interface{}, comment1 interface{}) *MockCommentRepository_Update_Call {
+	return &MockCommentRepository_Update_Call{Call: _e.mock.On("Update", ctx, comment1)}
+}
+
+func (_c *MockCommentRepository_Update_Call) Run(run func(ctx context.Context, comment1 *comment.Comment)) *MockCommentRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*comment.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_Update_Call) Return(err error) *MockCommentRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentRepository_Update_Call) RunAndReturn(run func(ctx context.Context, comment1 *comment.Comment) error) *MockCommentRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
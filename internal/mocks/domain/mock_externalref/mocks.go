@@ -0,0 +1,144 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_externalref
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/domain/externalref"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockExternalRefRepository creates a new instance of MockExternalRefRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExternalRefRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExternalRefRepository {
+	mock := &MockExternalRefRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExternalRefRepository is an autogenerated mock type for the ExternalRefRepository type
+type MockExternalRefRepository struct {
+	mock.Mock
+}
+
+type MockExternalRefRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExternalRefRepository) EXPECT() *MockExternalRefRepository_Expecter {
+	return &MockExternalRefRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockExternalRefRepository
+func (_mock *MockExternalRefRepository) Create(ctx context.Context, ref *externalref.ExternalRef) error {
+	ret := _mock.Called(ctx, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *externalref.ExternalRef) error); ok {
+		r0 = returnFunc(ctx, ref)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExternalRefRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockExternalRefRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - ref
+func (_e *MockExternalRefRepository_Expecter) Create(ctx interface{}, ref interface{}) *MockExternalRefRepository_Create_Call {
+	return &MockExternalRefRepository_Create_Call{Call: _e.mock.On("Create", ctx, ref)}
+}
+
+func (_c *MockExternalRefRepository_Create_Call) Run(run func(ctx context.Context, ref *externalref.ExternalRef)) *MockExternalRefRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*externalref.ExternalRef))
+	})
+	return _c
+}
+
+func (_c *MockExternalRefRepository_Create_Call) Return(err error) *MockExternalRefRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExternalRefRepository_Create_Call) RunAndReturn(run func(ctx context.Context, ref *externalref.ExternalRef) error) *MockExternalRefRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockExternalRefRepository
+func (_mock *MockExternalRefRepository) Find(ctx context.Context, source externalref.Source, kind externalref.Kind, externalID string) (*externalref.ExternalRef, error) {
+	ret := _mock.Called(ctx, source, kind, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *externalref.ExternalRef
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, externalref.Source, externalref.Kind, string) (*externalref.ExternalRef, error)); ok {
+		return returnFunc(ctx, source, kind, externalID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, externalref.Source, externalref.Kind, string) *externalref.ExternalRef); ok {
+		r0 = returnFunc(ctx, source, kind, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*externalref.ExternalRef)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, externalref.Source, externalref.Kind, string) error); ok {
+		r1 = returnFunc(ctx, source, kind, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExternalRefRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockExternalRefRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx
+//   - source
+//   - kind
+//   - externalID
+func (_e *MockExternalRefRepository_Expecter) Find(ctx interface{}, source interface{}, kind interface{}, externalID interface{}) *MockExternalRefRepository_Find_Call {
+	return &MockExternalRefRepository_Find_Call{Call: _e.mock.On("Find", ctx, source, kind, externalID)}
+}
+
+func (_c *MockExternalRefRepository_Find_Call) Run(run func(ctx context.Context, source externalref.Source, kind externalref.Kind, externalID string)) *MockExternalRefRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(externalref.Source), args[2].(externalref.Kind), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockExternalRefRepository_Find_Call) Return(externalRef *externalref.ExternalRef, err error) *MockExternalRefRepository_Find_Call {
+	_c.Call.Return(externalRef, err)
+	return _c
+}
+
+func (_c *MockExternalRefRepository_Find_Call) RunAndReturn(run func(ctx context.Context, source externalref.Source, kind externalref.Kind, externalID string) (*externalref.ExternalRef, error)) *MockExternalRefRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
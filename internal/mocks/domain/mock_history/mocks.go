@@ -0,0 +1,291 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_history
+
+import (
+	"context"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/history"
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	"github.com/iktakahiro/oniongo/internal/domain/todo"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockHistoryRepository creates a new instance of MockHistoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHistoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHistoryRepository {
+	mock := &MockHistoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockHistoryRepository is an autogenerated mock type for the HistoryRepository type
+type MockHistoryRepository struct {
+	mock.Mock
+}
+
+type MockHistoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHistoryRepository) EXPECT() *MockHistoryRepository_Expecter {
+	return &MockHistoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindByProjectID provides a mock function for the type MockHistoryRepository
+func (_mock *MockHistoryRepository) FindByProjectID(ctx context.Context, projectID project.ProjectID, limit int, offset int) ([]*history.HistoryEntry, error) {
+	ret := _mock.Called(ctx, projectID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProjectID")
+	}
+
+	var r0 []*history.HistoryEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, project.ProjectID, int, int) ([]*history.HistoryEntry, error)); ok {
+		return returnFunc(ctx, projectID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, project.ProjectID, int, int) []*history.HistoryEntry); ok {
+		r0 = returnFunc(ctx, projectID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*history.HistoryEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, project.ProjectID, int, int) error); ok {
+		r1 = returnFunc(ctx, projectID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHistoryRepository_FindByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProjectID'
+type MockHistoryRepository_FindByProjectID_Call struct {
+	*mock.Call
+}
+
+// FindByProjectID is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - limit
+//   - offset
+func (_e *MockHistoryRepository_Expecter) FindByProjectID(ctx interface{}, projectID interface{}, limit interface{}, offset interface{}) *MockHistoryRepository_FindByProjectID_Call {
+	return &MockHistoryRepository_FindByProjectID_Call{Call: _e.mock.On("FindByProjectID", ctx, projectID, limit, offset)}
+}
+
+func (_c *MockHistoryRepository_FindByProjectID_Call) Run(run func(ctx context.Context, projectID project.ProjectID, limit int, offset int)) *MockHistoryRepository_FindByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(project.ProjectID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockHistoryRepository_FindByProjectID_Call) Return(historyEntrys []*history.HistoryEntry, err error) *MockHistoryRepository_FindByProjectID_Call {
+	_c.Call.Return(historyEntrys, err)
+	return _c
+}
+
+func (_c *MockHistoryRepository_FindByProjectID_Call) RunAndReturn(run func(ctx context.Context, projectID project.ProjectID, limit int, offset int) ([]*history.HistoryEntry, error)) *MockHistoryRepository_FindByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTodoID provides a mock function for the type MockHistoryRepository
+func (_mock *MockHistoryRepository) FindByTodoID(ctx context.Context, todoID todo.TodoID, limit int, offset int) ([]*history.HistoryEntry, error) {
+	ret := _mock.Called(ctx, todoID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTodoID")
+	}
+
+	var r0 []*history.HistoryEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID, int, int) ([]*history.HistoryEntry, error)); ok {
+		return returnFunc(ctx, todoID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID, int, int) []*history.HistoryEntry); ok {
+		r0 = returnFunc(ctx, todoID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*history.HistoryEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todo.TodoID, int, int) error); ok {
+		r1 = returnFunc(ctx, todoID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHistoryRepository_FindByTodoID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTodoID'
+type MockHistoryRepository_FindByTodoID_Call struct {
+	*mock.Call
+}
+
+// FindByTodoID is a helper method to define mock.On call
+//   - ctx
+//   - todoID
+//   - limit
+//   - offset
+func (_e *MockHistoryRepository_Expecter) FindByTodoID(ctx interface{}, todoID interface{}, limit interface{}, offset interface{}) *MockHistoryRepository_FindByTodoID_Call {
+	return &MockHistoryRepository_FindByTodoID_Call{Call: _e.mock.On("FindByTodoID", ctx, todoID, limit, offset)}
+}
+
+func (_c *MockHistoryRepository_FindByTodoID_Call) Run(run func(ctx context.Context, todoID todo.TodoID, limit int, offset int)) *MockHistoryRepository_FindByTodoID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todo.TodoID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockHistoryRepository_FindByTodoID_Call) Return(historyEntrys []*history.HistoryEntry, err error) *MockHistoryRepository_FindByTodoID_Call {
+	_c.Call.Return(historyEntrys, err)
+	return _c
+}
+
+func (_c *MockHistoryRepository_FindByTodoID_Call) RunAndReturn(run func(ctx context.Context, todoID todo.TodoID, limit int, offset int) ([]*history.HistoryEntry, error)) *MockHistoryRepository_FindByTodoID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTodoIDUntil provides a mock function for the type MockHistoryRepository
+func (_mock *MockHistoryRepository) FindByTodoIDUntil(ctx context.Context, todoID todo.TodoID, until time.Time) ([]*history.HistoryEntry, error) {
+	ret := _mock.Called(ctx, todoID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTodoIDUntil")
+	}
+
+	var r0 []*history.HistoryEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID, time.Time) ([]*history.HistoryEntry, error)); ok {
+		return returnFunc(ctx, todoID, until)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID, time.Time) []*history.HistoryEntry); ok {
+		r0 = returnFunc(ctx, todoID, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*history.HistoryEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todo.TodoID, time.Time) error); ok {
+		r1 = returnFunc(ctx, todoID, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHistoryRepository_FindByTodoIDUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTodoIDUntil'
+type MockHistoryRepository_FindByTodoIDUntil_Call struct {
+	*mock.Call
+}
+
+// FindByTodoIDUntil is a helper method to define mock.On call
+//   - ctx
+//   - todoID
+//   - until
+func (_e *MockHistoryRepository_Expecter) FindByTodoIDUntil(ctx interface{}, todoID interface{}, until interface{}) *MockHistoryRepository_FindByTodoIDUntil_Call {
+	return &MockHistoryRepository_FindByTodoIDUntil_Call{Call: _e.mock.On("FindByTodoIDUntil", ctx, todoID, until)}
+}
+
+func (_c *MockHistoryRepository_FindByTodoIDUntil_Call) Run(run func(ctx context.Context, todoID todo.TodoID, until time.Time)) *MockHistoryRepository_FindByTodoIDUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todo.TodoID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockHistoryRepository_FindByTodoIDUntil_Call) Return(historyEntrys []*history.HistoryEntry, err error) *MockHistoryRepository_FindByTodoIDUntil_Call {
+	_c.Call.Return(historyEntrys, err)
+	return _c
+}
+
+func (_c *MockHistoryRepository_FindByTodoIDUntil_Call) RunAndReturn(run func(ctx context.Context, todoID todo.TodoID, until time.Time) ([]*history.HistoryEntry, error)) *MockHistoryRepository_FindByTodoIDUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEntryListener creates a new instance of MockEntryListener. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEntryListener(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEntryListener {
+	mock := &MockEntryListener{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEntryListener is an autogenerated mock type for the EntryListener type
+type MockEntryListener struct {
+	mock.Mock
+}
+
+type MockEntryListener_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEntryListener) EXPECT() *MockEntryListener_Expecter {
+	return &MockEntryListener_Expecter{mock: &_m.Mock}
+}
+
+// EntryRecorded provides a mock function for the type MockEntryListener
+func (_mock *MockEntryListener) EntryRecorded(ctx context.Context, entry *history.HistoryEntry) error {
+	ret := _mock.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EntryRecorded")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *history.HistoryEntry) error); ok {
+		r0 = returnFunc(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEntryListener_EntryRecorded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EntryRecorded'
+type MockEntryListener_EntryRecorded_Call struct {
+	*mock.Call
+}
+
+// EntryRecorded is a helper method to define mock.On call
+//   - ctx
+//   - entry
+func (_e *MockEntryListener_Expecter) EntryRecorded(ctx interface{}, entry interface{}) *MockEntryListener_EntryRecorded_Call {
+	return &MockEntryListener_EntryRecorded_Call{Call: _e.mock.On("EntryRecorded", ctx, entry)}
+}
+
+func (_c *MockEntryListener_EntryRecorded_Call) Run(run func(ctx context.Context, entry *history.HistoryEntry)) *MockEntryListener_EntryRecorded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*history.HistoryEntry))
+	})
+	return _c
+}
+
+func (_c *MockEntryListener_EntryRecorded_Call) Return(err error) *MockEntryListener_EntryRecorded_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEntryListener_EntryRecorded_Call) RunAndReturn(run func(ctx context.Context, entry *history.HistoryEntry) error) *MockEntryListener_EntryRecorded_Call {
+	_c.Call.Return(run)
+	return _c
+}
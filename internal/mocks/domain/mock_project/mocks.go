@@ -0,0 +1,244 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_project
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/domain/project"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockProjectRepository creates a new instance of MockProjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectRepository {
+	mock := &MockProjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectRepository is an autogenerated mock type for the ProjectRepository type
+type MockProjectRepository struct {
+	mock.Mock
+}
+
+type MockProjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectRepository) EXPECT() *MockProjectRepository_Expecter {
+	return &MockProjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) Create(ctx context.Context, project1 *project.Project) error {
+	ret := _mock.Called(ctx, project1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.Project) error); ok {
+		r0 = returnFunc(ctx, project1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProjectRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - project1
+func (_e *MockProjectRepository_Expecter) Create(ctx interface{}, project1 interface{}) *MockProjectRepository_Create_Call {
+	return &MockProjectRepository_Create_Call{Call: _e.mock.On("Create", ctx, project1)}
+}
+
+func (_c *MockProjectRepository_Create_Call) Run(run func(ctx context.Context, project1 *project.Project)) *MockProjectRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*project.Project))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Create_Call) Return(err error) *MockProjectRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectRepository_Create_Call) RunAndReturn(run func(ctx context.Context, project1 *project.Project) error) *MockProjectRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) Delete(ctx context.Context, id project.ProjectID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, project.ProjectID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockProjectRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockProjectRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockProjectRepository_Delete_Call {
+	return &MockProjectRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockProjectRepository_Delete_Call) Run(run func(ctx context.Context, id project.ProjectID)) *MockProjectRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(project.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Delete_Call) Return(err error) *MockProjectRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id project.ProjectID) error) *MockProjectRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) FindAll(ctx context.Context) ([]*project.Project, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []*project.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*project.Project, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*project.Project); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*project.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockProjectRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx
+func (_e *MockProjectRepository_Expecter) FindAll(ctx interface{}) *MockProjectRepository_FindAll_Call {
+	return &MockProjectRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+}
+
+func (_c *MockProjectRepository_FindAll_Call) Run(run func(ctx context.Context)) *MockProjectRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_FindAll_Call) Return(projects []*project.Project, err error) *MockProjectRepository_FindAll_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *MockProjectRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context) ([]*project.Project, error)) *MockProjectRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) FindByID(ctx context.Context, id project.ProjectID) (*project.Project, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *project.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, project.ProjectID) (*project.Project, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, project.ProjectID) *project.Project); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, project.ProjectID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockProjectRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockProjectRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockProjectRepository_FindByID_Call {
+	return &MockProjectRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockProjectRepository_FindByID_Call) Run(run func(ctx context.Context, id project.ProjectID)) *MockProjectRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(project.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_FindByID_Call) Return(project1 *project.Project, err error) *MockProjectRepository_FindByID_Call {
+	_c.Call.Return(project1, err)
+	return _c
+}
+
+func (_c *MockProjectRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id project.ProjectID) (*project.Project, error)) *MockProjectRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
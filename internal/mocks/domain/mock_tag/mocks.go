@@ -0,0 +1,345 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_tag
+
+import (
+	"context"
+
+	"github.com/iktakahiro/oniongo/internal/domain/tag"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockTagRepository creates a new instance of MockTagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTagRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTagRepository {
+	mock := &MockTagRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTagRepository is an autogenerated mock type for the TagRepository type
+type MockTagRepository struct {
+	mock.Mock
+}
+
+type MockTagRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTagRepository) EXPECT() *MockTagRepository_Expecter {
+	return &MockTagRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) Create(ctx context.Context, tag1 *tag.Tag) error {
+	ret := _mock.Called(ctx, tag1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *tag.Tag) error); ok {
+		r0 = returnFunc(ctx, tag1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTagRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - tag1
+func (_e *MockTagRepository_Expecter) Create(ctx interface{}, tag1 interface{}) *MockTagRepository_Create_Call {
+	return &MockTagRepository_Create_Call{Call: _e.mock.On("Create", ctx, tag1)}
+}
+
+func (_c *MockTagRepository_Create_Call) Run(run func(ctx context.Context, tag1 *tag.Tag)) *MockTagRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*tag.Tag))
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_Create_Call) Return(err error) *MockTagRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagRepository_Create_Call) RunAndReturn(run func(ctx context.Context, tag1 *tag.Tag) error) *MockTagRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) Delete(ctx context.Context, id tag.TagID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tag.TagID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTagRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockTagRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockTagRepository_Delete_Call {
+	return &MockTagRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockTagRepository_Delete_Call) Run(run func(ctx context.Context, id tag.TagID)) *MockTagRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.TagID))
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_Delete_Call) Return(err error) *MockTagRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id tag.TagID) error) *MockTagRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistsByName provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsByName")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_ExistsByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsByName'
+type MockTagRepository_ExistsByName_Call struct {
+	*mock.Call
+}
+
+// ExistsByName is a helper method to define mock.On call
+//   - ctx
+//   - name
+func (_e *MockTagRepository_Expecter) ExistsByName(ctx interface{}, name interface{}) *MockTagRepository_ExistsByName_Call {
+	return &MockTagRepository_ExistsByName_Call{Call: _e.mock.On("ExistsByName", ctx, name)}
+}
+
+func (_c *MockTagRepository_ExistsByName_Call) Run(run func(ctx context.Context, name string)) *MockTagRepository_ExistsByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_ExistsByName_Call) Return(b bool, err error) *MockTagRepository_ExistsByName_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockTagRepository_ExistsByName_Call) RunAndReturn(run func(ctx context.Context, name string) (bool, error)) *MockTagRepository_ExistsByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) FindAll(ctx context.Context) ([]*tag.Tag, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []*tag.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*tag.Tag, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*tag.Tag); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*tag.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockTagRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx
+func (_e *MockTagRepository_Expecter) FindAll(ctx interface{}) *MockTagRepository_FindAll_Call {
+	return &MockTagRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+}
+
+func (_c *MockTagRepository_FindAll_Call) Run(run func(ctx context.Context)) *MockTagRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_FindAll_Call) Return(tags []*tag.Tag, err error) *MockTagRepository_FindAll_Call {
+	_c.Call.Return(tags, err)
+	return _c
+}
+
+func (_c *MockTagRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context) ([]*tag.Tag, error)) *MockTagRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) FindByID(ctx context.Context, id tag.TagID) (*tag.Tag, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *tag.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tag.TagID) (*tag.Tag, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tag.TagID) *tag.Tag); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tag.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, tag.TagID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTagRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockTagRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockTagRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockTagRepository_FindByID_Call {
+	return &MockTagRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockTagRepository_FindByID_Call) Run(run func(ctx context.Context, id tag.TagID)) *MockTagRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.TagID))
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_FindByID_Call) Return(tag1 *tag.Tag, err error) *MockTagRepository_FindByID_Call {
+	_c.Call.Return(tag1, err)
+	return _c
+}
+
+func (_c *MockTagRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id tag.TagID) (*tag.Tag, error)) *MockTagRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockTagRepository
+func (_mock *MockTagRepository) Update(ctx context.Context, tag1 *tag.Tag) error {
+	ret := _mock.Called(ctx, tag1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *tag.Tag) error); ok {
+		r0 = returnFunc(ctx, tag1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTagRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockTagRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - tag1
+func (_e *MockTagRepository_Expecter) Update(ctx interface{}, tag1 interface{}) *MockTagRepository_Update_Call {
+	return &MockTagRepository_Update_Call{Call: _e.mock.On("Update", ctx, tag1)}
+}
+
+func (_c *MockTagRepository_Update_Call) Run(run func(ctx context.Context, tag1 *tag.Tag)) *MockTagRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*tag.Tag))
+	})
+	return _c
+}
+
+func (_c *MockTagRepository_Update_Call) Return(err error) *MockTagRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTagRepository_Update_Call) RunAndReturn(run func(ctx context.Context, tag1 *tag.Tag) error) *MockTagRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -131,8 +131,8 @@ func (_c *MockTodoRepository_Delete_Call) RunAndReturn(run func(ctx context.Cont
 }
 
 // FindAll provides a mock function for the type MockTodoRepository
-func (_mock *MockTodoRepository) FindAll(ctx context.Context) ([]*todo.Todo, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockTodoRepository) FindAll(ctx context.Context, filter todo.TodoFilter) ([]*todo.Todo, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -140,18 +140,18 @@ func (_mock *MockTodoRepository) FindAll(ctx context.Context) ([]*todo.Todo, err
 
 	var r0 []*todo.Todo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*todo.Todo, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoFilter) ([]*todo.Todo, error)); ok {
+		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*todo.Todo); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoFilter) []*todo.Todo); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*todo.Todo)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todo.TodoFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -165,13 +165,14 @@ type MockTodoRepository_FindAll_Call struct {
 
 // FindAll is a helper method to define mock.On call
 //   - ctx
-func (_e *MockTodoRepository_Expecter) FindAll(ctx interface{}) *MockTodoRepository_FindAll_Call {
-	return &MockTodoRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+//   - filter
+func (_e *MockTodoRepository_Expecter) FindAll(ctx interface{}, filter interface{}) *MockTodoRepository_FindAll_Call {
+	return &MockTodoRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, filter)}
 }
 
-func (_c *MockTodoRepository_FindAll_Call) Run(run func(ctx context.Context)) *MockTodoRepository_FindAll_Call {
+func (_c *MockTodoRepository_FindAll_Call) Run(run func(ctx context.Context, filter todo.TodoFilter)) *MockTodoRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(todo.TodoFilter))
 	})
 	return _c
 }
@@ -181,7 +182,64 @@ func (_c *MockTodoRepository_FindAll_Call) Return(todos []*todo.Todo, err error)
 	return _c
 }
 
-func (_c *MockTodoRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context) ([]*todo.Todo, error)) *MockTodoRepository_FindAll_Call {
+func (_c *MockTodoRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, filter todo.TodoFilter) ([]*todo.Todo, error)) *MockTodoRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAncestorIDs provides a mock function for the type MockTodoRepository
+func (_mock *MockTodoRepository) FindAncestorIDs(ctx context.Context, id todo.TodoID) ([]todo.TodoID, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAncestorIDs")
+	}
+
+	var r0 []todo.TodoID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID) ([]todo.TodoID, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID) []todo.TodoID); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.TodoID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todo.TodoID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoRepository_FindAncestorIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAncestorIDs'
+type MockTodoRepository_FindAncestorIDs_Call struct {
+	*mock.Call
+}
+
+// FindAncestorIDs is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockTodoRepository_Expecter) FindAncestorIDs(ctx interface{}, id interface{}) *MockTodoRepository_FindAncestorIDs_Call {
+	return &MockTodoRepository_FindAncestorIDs_Call{Call: _e.mock.On("FindAncestorIDs", ctx, id)}
+}
+
+func (_c *MockTodoRepository_FindAncestorIDs_Call) Run(run func(ctx context.Context, id todo.TodoID)) *MockTodoRepository_FindAncestorIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todo.TodoID))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_FindAncestorIDs_Call) Return(todoIDs []todo.TodoID, err error) *MockTodoRepository_FindAncestorIDs_Call {
+	_c.Call.Return(todoIDs, err)
+	return _c
+}
+
+func (_c *MockTodoRepository_FindAncestorIDs_Call) RunAndReturn(run func(ctx context.Context, id todo.TodoID) ([]todo.TodoID, error)) *MockTodoRepository_FindAncestorIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -243,6 +301,63 @@ func (_c *MockTodoRepository_FindByID_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// FindDescendants provides a mock function for the type MockTodoRepository
+func (_mock *MockTodoRepository) FindDescendants(ctx context.Context, id todo.TodoID) ([]*todo.Todo, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDescendants")
+	}
+
+	var r0 []*todo.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID) ([]*todo.Todo, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.TodoID) []*todo.Todo); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*todo.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todo.TodoID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoRepository_FindDescendants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDescendants'
+type MockTodoRepository_FindDescendants_Call struct {
+	*mock.Call
+}
+
+// FindDescendants is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockTodoRepository_Expecter) FindDescendants(ctx interface{}, id interface{}) *MockTodoRepository_FindDescendants_Call {
+	return &MockTodoRepository_FindDescendants_Call{Call: _e.mock.On("FindDescendants", ctx, id)}
+}
+
+func (_c *MockTodoRepository_FindDescendants_Call) Run(run func(ctx context.Context, id todo.TodoID)) *MockTodoRepository_FindDescendants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todo.TodoID))
+	})
+	return _c
+}
+
+func (_c *MockTodoRepository_FindDescendants_Call) Return(todos []*todo.Todo, err error) *MockTodoRepository_FindDescendants_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockTodoRepository_FindDescendants_Call) RunAndReturn(run func(ctx context.Context, id todo.TodoID) ([]*todo.Todo, error)) *MockTodoRepository_FindDescendants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockTodoRepository
 func (_mock *MockTodoRepository) Update(ctx context.Context, todo1 *todo.Todo) error {
 	ret := _mock.Called(ctx, todo1)
@@ -288,3 +403,89 @@ func (_c *MockTodoRepository_Update_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockTodoSearchRepository creates a new instance of MockTodoSearchRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoSearchRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoSearchRepository {
+	mock := &MockTodoSearchRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoSearchRepository is an autogenerated mock type for the TodoSearchRepository type
+type MockTodoSearchRepository struct {
+	mock.Mock
+}
+
+type MockTodoSearchRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoSearchRepository) EXPECT() *MockTodoSearchRepository_Expecter {
+	return &MockTodoSearchRepository_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function for the type MockTodoSearchRepository
+func (_mock *MockTodoSearchRepository) Search(ctx context.Context, query todo.SearchQuery, limit int, offset int) ([]*todo.SearchHit, error) {
+	ret := _mock.Called(ctx, query, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*todo.SearchHit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.SearchQuery, int, int) ([]*todo.SearchHit, error)); ok {
+		return returnFunc(ctx, query, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.SearchQuery, int, int) []*todo.SearchHit); ok {
+		r0 = returnFunc(ctx, query, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*todo.SearchHit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todo.SearchQuery, int, int) error); ok {
+		r1 = returnFunc(ctx, query, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTodoSearchRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockTodoSearchRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx
+//   - query
+//   - limit
+//   - offset
+func (_e *MockTodoSearchRepository_Expecter) Search(ctx interface{}, query interface{}, limit interface{}, offset interface{}) *MockTodoSearchRepository_Search_Call {
+	return &MockTodoSearchRepository_Search_Call{Call: _e.mock.On("Search", ctx, query, limit, offset)}
+}
+
+func (_c *MockTodoSearchRepository_Search_Call) Run(run func(ctx context.Context, query todo.SearchQuery, limit int, offset int)) *MockTodoSearchRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todo.SearchQuery), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockTodoSearchRepository_Search_Call) Return(searchHits []*todo.SearchHit, err error) *MockTodoSearchRepository_Search_Call {
+	_c.Call.Return(searchHits, err)
+	return _c
+}
+
+func (_c *MockTodoSearchRepository_Search_Call) RunAndReturn(run func(ctx context.Context, query todo.SearchQuery, limit int, offset int) ([]*todo.SearchHit, error)) *MockTodoSearchRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,840 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mock_webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/iktakahiro/oniongo/internal/domain/webhook"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSender creates a new instance of MockSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSender {
+	mock := &MockSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSender is an autogenerated mock type for the Sender type
+type MockSender struct {
+	mock.Mock
+}
+
+type MockSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSender) EXPECT() *MockSender_Expecter {
+	return &MockSender_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function for the type MockSender
+func (_mock *MockSender) Send(ctx context.Context, webhook1 *webhook.Webhook, delivery *webhook.Delivery) (int, error) {
+	ret := _mock.Called(ctx, webhook1, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *webhook.Webhook, *webhook.Delivery) (int, error)); ok {
+		return returnFunc(ctx, webhook1, delivery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *webhook.Webhook, *webhook.Delivery) int); ok {
+		r0 = returnFunc(ctx, webhook1, delivery)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *webhook.Webhook, *webhook.Delivery) error); ok {
+		r1 = returnFunc(ctx, webhook1, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx
+//   - webhook1
+//   - delivery
+func (_e *MockSender_Expecter) Send(ctx interface{}, webhook1 interface{}, delivery interface{}) *MockSender_Send_Call {
+	return &MockSender_Send_Call{Call: _e.mock.On("Send", ctx, webhook1, delivery)}
+}
+
+func (_c *MockSender_Send_Call) Run(run func(ctx context.Context, webhook1 *webhook.Webhook, delivery *webhook.Delivery)) *MockSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*webhook.Webhook), args[2].(*webhook.Delivery))
+	})
+	return _c
+}
+
+func (_c *MockSender_Send_Call) Return(n int, err error) *MockSender_Send_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSender_Send_Call) RunAndReturn(run func(ctx context.Context, webhook1 *webhook.Webhook, delivery *webhook.Delivery) (int, error)) *MockSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookRepository creates a new instance of MockWebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookRepository {
+	mock := &MockWebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookRepository is an autogenerated mock type for the WebhookRepository type
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+type MockWebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookRepository) EXPECT() *MockWebhookRepository_Expecter {
+	return &MockWebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) Create(ctx context.Context, webhook1 *webhook.Webhook) error {
+	ret := _mock.Called(ctx, webhook1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *webhook.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockWebhookRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - webhook1
+func (_e *MockWebhookRepository_Expecter) Create(ctx interface{}, webhook1 interface{}) *MockWebhookRepository_Create_Call {
+	return &MockWebhookRepository_Create_Call{Call: _e.mock.On("Create", ctx, webhook1)}
+}
+
+func (_c *MockWebhookRepository_Create_Call) Run(run func(ctx context.Context, webhook1 *webhook.Webhook)) *MockWebhookRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*webhook.Webhook))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_Create_Call) Return(err error) *MockWebhookRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_Create_Call) RunAndReturn(run func(ctx context.Context, webhook1 *webhook.Webhook) error) *MockWebhookRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) Delete(ctx context.Context, id webhook.WebhookID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhook.WebhookID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockWebhookRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockWebhookRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockWebhookRepository_Delete_Call {
+	return &MockWebhookRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockWebhookRepository_Delete_Call) Run(run func(ctx context.Context, id webhook.WebhookID)) *MockWebhookRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhook.WebhookID))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_Delete_Call) Return(err error) *MockWebhookRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id webhook.WebhookID) error) *MockWebhookRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) FindAll(ctx context.Context) ([]*webhook.Webhook, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []*webhook.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*webhook.Webhook, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*webhook.Webhook); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockWebhookRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx
+func (_e *MockWebhookRepository_Expecter) FindAll(ctx interface{}) *MockWebhookRepository_FindAll_Call {
+	return &MockWebhookRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+}
+
+func (_c *MockWebhookRepository_FindAll_Call) Run(run func(ctx context.Context)) *MockWebhookRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindAll_Call) Return(webhooks []*webhook.Webhook, err error) *MockWebhookRepository_FindAll_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context) ([]*webhook.Webhook, error)) *MockWebhookRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) FindByID(ctx context.Context, id webhook.WebhookID) (*webhook.Webhook, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *webhook.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhook.WebhookID) (*webhook.Webhook, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhook.WebhookID) *webhook.Webhook); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, webhook.WebhookID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockWebhookRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockWebhookRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockWebhookRepository_FindByID_Call {
+	return &MockWebhookRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockWebhookRepository_FindByID_Call) Run(run func(ctx context.Context, id webhook.WebhookID)) *MockWebhookRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhook.WebhookID))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindByID_Call) Return(webhook1 *webhook.Webhook, err error) *MockWebhookRepository_FindByID_Call {
+	_c.Call.Return(webhook1, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id webhook.WebhookID) (*webhook.Webhook, error)) *MockWebhookRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasEnabled provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) HasEnabled(ctx context.Context) (bool, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasEnabled")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_HasEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasEnabled'
+type MockWebhookRepository_HasEnabled_Call struct {
+	*mock.Call
+}
+
+// HasEnabled is a helper method to define mock.On call
+//   - ctx
+func (_e *MockWebhookRepository_Expecter) HasEnabled(ctx interface{}) *MockWebhookRepository_HasEnabled_Call {
+	return &MockWebhookRepository_HasEnabled_Call{Call: _e.mock.On("HasEnabled", ctx)}
+}
+
+func (_c *MockWebhookRepository_HasEnabled_Call) Run(run func(ctx context.Context)) *MockWebhookRepository_HasEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_HasEnabled_Call) Return(b bool, err error) *MockWebhookRepository_HasEnabled_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_HasEnabled_Call) RunAndReturn(run func(ctx context.Context) (bool, error)) *MockWebhookRepository_HasEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) Update(ctx context.Context, webhook1 *webhook.Webhook) error {
+	ret := _mock.Called(ctx, webhook1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *webhook.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook1)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockWebhookRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - webhook1
+func (_e *MockWebhookRepository_Expecter) Update(ctx interface{}, webhook1 interface{}) *MockWebhookRepository_Update_Call {
+	return &MockWebhookRepository_Update_Call{Call: _e.mock.On("Update", ctx, webhook1)}
+}
+
+func (_c *MockWebhookRepository_Update_Call) Run(run func(ctx context.Context, webhook1 *webhook.Webhook)) *MockWebhookRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*webhook.Webhook))
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_Update_Call) Return(err error) *MockWebhookRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_Update_Call) RunAndReturn(run func(ctx context.Context, webhook1 *webhook.Webhook) error) *MockWebhookRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEventRepository creates a new instance of MockEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventRepository {
+	mock := &MockEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventRepository is an autogenerated mock type for the EventRepository type
+type MockEventRepository struct {
+	mock.Mock
+}
+
+type MockEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventRepository) EXPECT() *MockEventRepository_Expecter {
+	return &MockEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockEventRepository
+func (_mock *MockEventRepository) Create(ctx context.Context, event *webhook.Event) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *webhook.Event) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockEventRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - event
+func (_e *MockEventRepository_Expecter) Create(ctx interface{}, event interface{}) *MockEventRepository_Create_Call {
+	return &MockEventRepository_Create_Call{Call: _e.mock.On("Create", ctx, event)}
+}
+
+func (_c *MockEventRepository_Create_Call) Run(run func(ctx context.Context, event *webhook.Event)) *MockEventRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*webhook.Event))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_Create_Call) Return(err error) *MockEventRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventRepository_Create_Call) RunAndReturn(run func(ctx context.Context, event *webhook.Event) error) *MockEventRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockEventRepository
+func (_mock *MockEventRepository) Delete(ctx context.Context, ids []webhook.EventID) error {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []webhook.EventID) error); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockEventRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockEventRepository_Expecter) Delete(ctx interface{}, ids interface{}) *MockEventRepository_Delete_Call {
+	return &MockEventRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, ids)}
+}
+
+func (_c *MockEventRepository_Delete_Call) Run(run func(ctx context.Context, ids []webhook.EventID)) *MockEventRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]webhook.EventID))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_Delete_Call) Return(err error) *MockEventRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, ids []webhook.EventID) error) *MockEventRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPending provides a mock function for the type MockEventRepository
+func (_mock *MockEventRepository) FindPending(ctx context.Context, limit int) ([]*webhook.Event, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPending")
+	}
+
+	var r0 []*webhook.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*webhook.Event, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*webhook.Event); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepository_FindPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPending'
+type MockEventRepository_FindPending_Call struct {
+	*mock.Call
+}
+
+// FindPending is a helper method to define mock.On call
+//   - ctx
+//   - limit
+func (_e *MockEventRepository_Expecter) FindPending(ctx interface{}, limit interface{}) *MockEventRepository_FindPending_Call {
+	return &MockEventRepository_FindPending_Call{Call: _e.mock.On("FindPending", ctx, limit)}
+}
+
+func (_c *MockEventRepository_FindPending_Call) Run(run func(ctx context.Context, limit int)) *MockEventRepository_FindPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_FindPending_Call) Return(events []*webhook.Event, err error) *MockEventRepository_FindPending_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockEventRepository_FindPending_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]*webhook.Event, error)) *MockEventRepository_FindPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeliveryRepository creates a new instance of MockDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeliveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeliveryRepository {
+	mock := &MockDeliveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeliveryRepository is an autogenerated mock type for the DeliveryRepository type
+type MockDeliveryRepository struct {
+	mock.Mock
+}
+
+type MockDeliveryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeliveryRepository) EXPECT() *MockDeliveryRepository_Expecter {
+	return &MockDeliveryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockDeliveryRepository
+func (_mock *MockDeliveryRepository) Create(ctx context.Context, delivery *webhook.Delivery) error {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *webhook.Delivery) error); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeliveryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockDeliveryRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx
+//   - delivery
+func (_e *MockDeliveryRepository_Expecter) Create(ctx interface{}, delivery interface{}) *MockDeliveryRepository_Create_Call {
+	return &MockDeliveryRepository_Create_Call{Call: _e.mock.On("Create", ctx, delivery)}
+}
+
+func (_c *MockDeliveryRepository_Create_Call) Run(run func(ctx context.Context, delivery *webhook.Delivery)) *MockDeliveryRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*webhook.Delivery))
+	})
+	return _c
+}
+
+func (_c *MockDeliveryRepository_Create_Call) Return(err error) *MockDeliveryRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeliveryRepository_Create_Call) RunAndReturn(run func(ctx context.Context, delivery *webhook.Delivery) error) *MockDeliveryRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByWebhookID provides a mock function for the type MockDeliveryRepository
+func (_mock *MockDeliveryRepository) FindByWebhookID(ctx context.Context, webhookID webhook.WebhookID, limit int, offset int) ([]*webhook.Delivery, error) {
+	ret := _mock.Called(ctx, webhookID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByWebhookID")
+	}
+
+	var r0 []*webhook.Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhook.WebhookID, int, int) ([]*webhook.Delivery, error)); ok {
+		return returnFunc(ctx, webhookID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, webhook.WebhookID, int, int) []*webhook.Delivery); ok {
+		r0 = returnFunc(ctx, webhookID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, webhook.WebhookID, int, int) error); ok {
+		r1 = returnFunc(ctx, webhookID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveryRepository_FindByWebhookID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByWebhookID'
+type MockDeliveryRepository_FindByWebhookID_Call struct {
+	*mock.Call
+}
+
+// FindByWebhookID is a helper method to define mock.On call
+//   - ctx
+//   - webhookID
+//   - limit
+//   - offset
+func (_e *MockDeliveryRepository_Expecter) FindByWebhookID(ctx interface{}, webhookID interface{}, limit interface{}, offset interface{}) *MockDeliveryRepository_FindByWebhookID_Call {
+	return &MockDeliveryRepository_FindByWebhookID_Call{Call: _e.mock.On("FindByWebhookID", ctx, webhookID, limit, offset)}
+}
+
+func (_c *MockDeliveryRepository_FindByWebhookID_Call) Run(run func(ctx context.Context, webhookID webhook.WebhookID, limit int, offset int)) *MockDeliveryRepository_FindByWebhookID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhook.WebhookID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockDeliveryRepository_FindByWebhookID_Call) Return(deliverys []*webhook.Delivery, err error) *MockDeliveryRepository_FindByWebhookID_Call {
+	_c.Call.Return(deliverys, err)
+	return _c
+}
+
+func (_c *MockDeliveryRepository_FindByWebhookID_Call) RunAndReturn(run func(ctx context.Context, webhookID webhook.WebhookID, limit int, offset int) ([]*webhook.Delivery, error)) *MockDeliveryRepository_FindByWebhookID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDue provides a mock function for the type MockDeliveryRepository
+func (_mock *MockDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	ret := _mock.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDue")
+	}
+
+	var r0 []*webhook.Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*webhook.Delivery, error)); ok {
+		return returnFunc(ctx, now, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []*webhook.Delivery); ok {
+		r0 = returnFunc(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveryRepository_FindDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDue'
+type MockDeliveryRepository_FindDue_Call struct {
+	*mock.Call
+}
+
+// FindDue is a helper method to define mock.On call
+//   - ctx
+//   - now
+//   - limit
+func (_e *MockDeliveryRepository_Expecter) FindDue(ctx interface{}, now interface{}, limit interface{}) *MockDeliveryRepository_FindDue_Call {
+	return &MockDeliveryRepository_FindDue_Call{Call: _e.mock.On("FindDue", ctx, now, limit)}
+}
+
+func (_c *MockDeliveryRepository_FindDue_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockDeliveryRepository_FindDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockDeliveryRepository_FindDue_Call) Return(deliverys []*webhook.Delivery, err error) *MockDeliveryRepository_FindDue_Call {
+	_c.Call.Return(deliverys, err)
+	return _c
+}
+
+func (_c *MockDeliveryRepository_FindDue_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error)) *MockDeliveryRepository_FindDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockDeliveryRepository
+func (_mock *MockDeliveryRepository) Update(ctx context.Context, delivery *webhook.Delivery) error {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *webhook.Delivery) error); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeliveryRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockDeliveryRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - delivery
+func (_e *MockDeliveryRepository_Expecter) Update(ctx interface{}, delivery interface{}) *MockDeliveryRepository_Update_Call {
+	return &MockDeliveryRepository_Update_Call{Call: _e.mock.On("Update", ctx, delivery)}
+}
+
+func (_c *MockDeliveryRepository_Update_Call) Run(run func(ctx context.Context, delivery *webhook.Delivery)) *MockDeliveryRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*webhook.Delivery))
+	})
+	return _c
+}
+
+func (_c *MockDeliveryRepository_Update_Call) Return(err error) *MockDeliveryRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeliveryRepository_Update_Call) RunAndReturn(run func(ctx context.Context, delivery *webhook.Delivery) error) *MockDeliveryRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}